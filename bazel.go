@@ -0,0 +1,108 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const bazelRule = `"""Generates Go parsers from peg grammars."""
+
+def _peg_parser_impl(ctx):
+    out = ctx.actions.declare_file(ctx.attr.out or ctx.file.src.basename + ".go")
+    args = ctx.actions.args()
+    args.add_all(ctx.attr.opts)
+    args.add("-output", out)
+    args.add(ctx.file.src)
+    ctx.actions.run(
+        executable = ctx.executable.peg,
+        arguments = [args],
+        inputs = [ctx.file.src],
+        outputs = [out],
+        mnemonic = "Peg",
+        progress_message = "Generating parser from %s" % ctx.file.src.short_path,
+    )
+    return [DefaultInfo(files = depset([out]))]
+
+peg_parser = rule(
+    implementation = _peg_parser_impl,
+    attrs = {
+        "src": attr.label(allow_single_file = [".peg"], mandatory = True),
+        "out": attr.string(doc = "The generated file, by default the grammar file name with .go appended."),
+        "opts": attr.string_list(doc = "The options passed to peg."),
+        "peg": attr.label(
+            default = "@com_github_pointlander_peg//:peg",
+            executable = True,
+            cfg = "exec",
+        ),
+    },
+)
+`
+
+// bazelOptions returns the options set on the command line, except for -output,
+// to be passed on to peg.
+func bazelOptions() []string {
+	var opts []string
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name != "output" {
+			opts = append(opts, "-"+f.Name+"="+f.Value.String())
+		}
+	})
+	return opts
+}
+
+// bazelTarget replaces the characters not allowed in target names.
+var bazelTarget = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// initBazel writes peg.bzl with the peg_parser rule into root, and prints a
+// BUILD snippet using it for every grammar below root, passing opts to peg.
+func initBazel(root string, opts []string, out io.Writer) error {
+	var grammars []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && path != root && (strings.HasPrefix(d.Name(), ".") || strings.HasPrefix(d.Name(), "bazel-") || d.Name() == "vendor") {
+			return filepath.SkipDir
+		}
+		if !d.IsDir() && strings.HasSuffix(path, ".peg") {
+			grammars = append(grammars, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writeOutput(filepath.Join(root, "peg.bzl"), []byte(bazelRule))
+	for _, grammar := range grammars {
+		dir, name := filepath.Split(grammar)
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "# %v\n", filepath.ToSlash(filepath.Join(rel, "BUILD.bazel")))
+		fmt.Fprintf(out, "load(\"//:peg.bzl\", \"peg_parser\")\n\n")
+		fmt.Fprintf(out, "peg_parser(\n")
+		fmt.Fprintf(out, "    name = %q,\n", bazelTarget.ReplaceAllString(strings.TrimSuffix(name, ".peg"), "_")+"_peg")
+		fmt.Fprintf(out, "    src = %q,\n", name)
+		if len(opts) > 0 {
+			quoted := make([]string, len(opts))
+			for i, opt := range opts {
+				quoted[i] = strconv.Quote(opt)
+			}
+			fmt.Fprintf(out, "    opts = [%v],\n", strings.Join(quoted, ", "))
+		}
+		fmt.Fprintf(out, ")\n\n")
+	}
+	return nil
+}
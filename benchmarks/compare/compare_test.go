@@ -0,0 +1,114 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package compare
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pointlander/peg/benchmarks/compare/participle"
+	"github.com/pointlander/peg/benchmarks/compare/pegexpression"
+	"github.com/pointlander/peg/benchmarks/compare/pegjson"
+	"github.com/pointlander/peg/benchmarks/compare/pigeon"
+)
+
+// parser parses the corpus of a benchmark with one of the generators.
+type parser struct {
+	name  string
+	parse func(string) error
+}
+
+var (
+	jsonParsers = []parser{
+		{"peg", func(document string) error {
+			j := &pegjson.JSON{Buffer: document}
+			j.Init()
+			return j.Parse()
+		}},
+		{"pigeon", pigeon.JSON},
+		{"participle", participle.JSON},
+	}
+	expressionParsers = []parser{
+		{"peg", func(expression string) error {
+			e := &pegexpression.Expression{Buffer: expression}
+			e.Init()
+			return e.Parse()
+		}},
+		{"pigeon", pigeon.Expression},
+		{"participle", participle.Expression},
+	}
+)
+
+// jsonCorpus returns a document of n records.
+func jsonCorpus(n int) string {
+	records := make([]string, n)
+	for i := range records {
+		records[i] = fmt.Sprintf(`{"id": %d, "name": "peg \"%d\"", "score": %d.5e-3, "tags": ["go", "parser", "é"], "fork": %v, "parent": null}`, i, i, i, i%2 == 0)
+	}
+	return "[\n  " + strings.Join(records, ",\n  ") + "\n]\n"
+}
+
+// expressionCorpus returns an expression of n terms, nested every tenth term.
+func expressionCorpus(n int) string {
+	expression := &strings.Builder{}
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			expression.WriteString(" " + string("+-*/%^"[i%6]) + " ")
+		}
+		if i%10 == 9 {
+			fmt.Fprintf(expression, "-(%d.25 - %d)", i, i)
+		} else {
+			fmt.Fprintf(expression, "%d", i)
+		}
+	}
+	return expression.String()
+}
+
+var corpora = []struct {
+	name    string
+	corpus  string
+	parsers []parser
+}{
+	{"JSON/small", jsonCorpus(10), jsonParsers},
+	{"JSON/large", jsonCorpus(10000), jsonParsers},
+	{"Expression/small", expressionCorpus(10), expressionParsers},
+	{"Expression/large", expressionCorpus(100000), expressionParsers},
+}
+
+func TestCompare(t *testing.T) {
+	invalid := map[string][]string{
+		"JSON":       {`[1,]`, `{"a" 1}`, `nul`, `"\x"`, `01`, `[] []`},
+		"Expression": {`1 +`, `(1`, `1 2`, `--`, `1.`, `a`},
+	}
+	for _, c := range corpora {
+		for _, p := range c.parsers {
+			if err := p.parse(c.corpus); err != nil {
+				t.Errorf("%s %s: %v", c.name, p.name, err)
+			}
+			for _, input := range invalid[strings.Split(c.name, "/")[0]] {
+				if err := p.parse(input); err == nil {
+					t.Errorf("%s %s accepted %q", c.name, p.name, input)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	for _, c := range corpora {
+		for _, p := range c.parsers {
+			b.Run(c.name+"/"+p.name, func(b *testing.B) {
+				b.SetBytes(int64(len(c.corpus)))
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if err := p.parse(c.corpus); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,14 @@
+module github.com/pointlander/peg/benchmarks/compare
+
+go 1.23
+
+require (
+	github.com/alecthomas/participle/v2 v2.1.4
+	github.com/mna/pigeon v1.2.0
+)
+
+require (
+	golang.org/x/mod v0.12.0 // indirect
+	golang.org/x/sys v0.12.0 // indirect
+	golang.org/x/tools v0.13.0 // indirect
+)
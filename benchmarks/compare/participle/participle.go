@@ -0,0 +1,100 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package participle parses the corpora of the comparison with participle,
+// from grammars matching the same languages as the peg grammars.
+package participle
+
+import (
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+type jsonValue struct {
+	Object  *jsonObject `parser:"@@"`
+	Array   *jsonArray  `parser:"| @@"`
+	String  *string     `parser:"| @String"`
+	Number  *string     `parser:"| @Number"`
+	Keyword *string     `parser:"| @('true' | 'false' | 'null')"`
+}
+
+type jsonObject struct {
+	Members []*jsonMember `parser:"'{' (@@ (',' @@)*)? '}'"`
+}
+
+type jsonMember struct {
+	Key   string     `parser:"@String ':'"`
+	Value *jsonValue `parser:"@@"`
+}
+
+type jsonArray struct {
+	Values []*jsonValue `parser:"'[' (@@ (',' @@)*)? ']'"`
+}
+
+var jsonParser = participle.MustBuild[jsonValue](
+	participle.Lexer(lexer.MustSimple([]lexer.SimpleRule{
+		{Name: "String", Pattern: `"(\\(["\\/bfnrt]|u[0-9a-fA-F]{4})|[^"\\\x00-\x1f])*"`},
+		{Name: "Number", Pattern: `-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?`},
+		{Name: "Keyword", Pattern: `[a-z]+`},
+		{Name: "Punctuation", Pattern: `[{}\[\]:,]`},
+		{Name: "Whitespace", Pattern: `[ \t\r\n]+`},
+	})),
+	participle.Elide("Whitespace"),
+)
+
+// JSON parses a JSON document.
+func JSON(document string) error {
+	_, err := jsonParser.ParseString("", document)
+	return err
+}
+
+type sum struct {
+	Left  *product   `parser:"@@"`
+	Right []*sumTerm `parser:"@@*"`
+}
+
+type sumTerm struct {
+	Operator string   `parser:"@('+' | '-')"`
+	Right    *product `parser:"@@"`
+}
+
+type product struct {
+	Left  *power         `parser:"@@"`
+	Right []*productTerm `parser:"@@*"`
+}
+
+type productTerm struct {
+	Operator string `parser:"@('*' | '/' | '%')"`
+	Right    *power `parser:"@@"`
+}
+
+type power struct {
+	Left  *unary   `parser:"@@"`
+	Right []*unary `parser:"('^' @@)*"`
+}
+
+type unary struct {
+	Negation *unary   `parser:"'-' @@"`
+	Primary  *primary `parser:"| @@"`
+}
+
+type primary struct {
+	Number *string `parser:"@Number"`
+	Sum    *sum    `parser:"| '(' @@ ')'"`
+}
+
+var expressionParser = participle.MustBuild[sum](
+	participle.Lexer(lexer.MustSimple([]lexer.SimpleRule{
+		{Name: "Number", Pattern: `[0-9]+(\.[0-9]+)?`},
+		{Name: "Operator", Pattern: `[-+*/%^()]`},
+		{Name: "Whitespace", Pattern: `[ \t\r\n]+`},
+	})),
+	participle.Elide("Whitespace"),
+)
+
+// Expression parses an arithmetic expression.
+func Expression(expression string) error {
+	_, err := expressionParser.ParseString("", expression)
+	return err
+}
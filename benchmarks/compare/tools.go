@@ -0,0 +1,13 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build tools
+// +build tools
+
+// Package compare compares the parsers generated by peg with the parsers of
+// other generators for Go on the same corpora. The parsers are generated, and
+// the comparison run, by "go run build.go bench-compare" in the repository.
+package compare
+
+import _ "github.com/mna/pigeon"
@@ -10,15 +10,12 @@ package main
 import (
 	"flag"
 	"fmt"
-	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"reflect"
 	"runtime"
 	"strings"
-	"text/template"
-	"time"
 )
 
 func main() {
@@ -30,8 +27,6 @@ func main() {
 	}
 
 	switch target {
-	case "buildinfo":
-		buildinfo()
 	case "peg":
 		peg()
 	case "clean":
@@ -40,78 +35,18 @@ func main() {
 		test()
 	case "bench":
 		bench()
+	case "bench-compare":
+		bench_compare()
 	case "help":
 		fmt.Println("go run build.go [target]")
 		fmt.Println(" peg - build peg from scratch")
 		fmt.Println(" clean - clean up")
 		fmt.Println(" test - run full test")
 		fmt.Println(" bench - run benchmark")
-		fmt.Println(" buildinfo - generate buildinfo.go")
+		fmt.Println(" bench-compare - compare with pigeon and participle")
 	}
 }
 
-const BuildinfoTemplate = `// Code Generated by "build.go buildinfo"  DO NOT EDIT.
-package main
-
-const (
-	// VERSION is the version of peg
-	VERSION = "{{.Version}}"
-	// BUILDTIME is the build time of peg
-	BUILDTIME = "{{.Buildtime}}"
-	// COMMIT is the commit hash of peg
-	COMMIT = "{{.Commit}}"
-	// IS_TAGGED is there a version
-	IS_TAGGED = {{.IsTagged}}
-)
-`
-
-func buildinfo() {
-	log.SetPrefix("buildinfo:")
-	type info struct {
-		Version   string
-		Buildtime string
-		Commit    string
-		IsTagged  bool
-	}
-	infFile, err := os.Create("buildinfo.go")
-	defer infFile.Close()
-	if err != nil {
-		log.Println("open buildinfo.go: fatal:", err)
-	}
-	var inf info = info{
-		Version: "unknown", // show this if we can't get the version
-	}
-	vers, err := exec.Command("git", "tag", "--contains").Output()
-	if err != nil {
-		log.Println("error:", err)
-	} else if len(vers) > 1 { // ignore any single newlines that might exist
-		inf.IsTagged = true
-		inf.Version = strings.TrimSuffix(string(vers), "\n")
-	} else {
-		vers, err = exec.Command("git", "tag", "--sort=v:refname", "--merged").Output()
-		if err != nil {
-			log.Println("error:", err)
-		} else if len(vers) > 1 {
-			tags := strings.Split(string(vers), "\n")
-			inf.Version = tags[len(tags)-1]
-		}
-	}
-
-	cmit, err := exec.Command("git", "rev-parse", "HEAD").Output()
-	if err != nil {
-		log.Println("error:", err)
-	}
-	inf.Commit = strings.TrimSuffix(string(cmit), "\n")
-	// slice the constant to remove the timezone specifier
-	inf.Buildtime = time.Now().UTC().Format(time.RFC3339[0:19])
-
-	err = template.Must(template.New("buildinfo").Parse(BuildinfoTemplate)).Execute(infFile, inf)
-	if err != nil {
-		log.Println("error: template:", err)
-	}
-	log.SetPrefix("")
-}
-
 var processed = make(map[string]bool)
 
 func done(file string, deps ...any) bool {
@@ -302,7 +237,7 @@ func peg3() bool {
 	defer chdir(wd)
 
 	deleteFilesWithSuffix(".peg.go")
-	command("./peg2", "../../peg.peg", "peg3.peg.go")
+	command("./peg2", "../../peg.peg", "peg3.peg.go", "main")
 	command("go", "", "", "build", "-tags", "bootstrap", "-o", "peg3")
 
 	return false
@@ -317,26 +252,30 @@ func peg_bootstrap() bool {
 	defer chdir(wd)
 
 	deleteFilesWithSuffix(".peg.go")
-	command("./peg3", "../../peg.peg", "peg-bootstrap.peg.go")
+	command("./peg3", "../../peg.peg", "peg-bootstrap.peg.go", "main")
 	command("go", "", "", "build", "-tags", "bootstrap", "-o", "peg-bootstrap")
 
 	return false
 }
 
 func peg_peg_go() bool {
-	if done("peg.peg.go", peg_bootstrap) {
+	if done("generator/peg.peg.go", peg_bootstrap) {
 		return true
 	}
 
-	command("cmd/peg-bootstrap/peg-bootstrap", "peg.peg", "peg.peg.go")
+	command("cmd/peg-bootstrap/peg-bootstrap", "peg.peg", "generator/peg.peg.go")
 	command("go", "", "", "build")
-	command("./peg", "", "", "-inline", "-switch", "peg.peg")
+
+	wd := chdir("generator")
+	defer chdir(wd)
+
+	command("../peg", "", "", "-inline", "-switch", "-expected", "-output", "peg.peg.go", "../peg.peg")
 
 	return false
 }
 
 func peg() bool {
-	if done("peg", peg_peg_go, "main.go") {
+	if done("peg", peg_peg_go, "main.go", "commands.go", "grammar.go", "bazel.go", "stress.go", "version.go", "migrate.go", "compare.go", "substitute.go", "generator/generator.go", "generator/format.go", "generator/fix.go") {
 		return true
 	}
 
@@ -348,11 +287,10 @@ func peg() bool {
 func clean() bool {
 	delete("bootstrap/bootstrap")
 
-	delete("grammars/c/c.peg.go")
-	delete("grammars/calculator/calculator.peg.go")
-	delete("grammars/fexl/fexl.peg.go")
-	delete("grammars/java/java_1_7.peg.go")
-	delete("grammars/long_test/long.peg.go")
+	delete("benchmarks/compare/pegexpression/expression.peg.go")
+	delete("benchmarks/compare/pegjson/json.peg.go")
+	delete("benchmarks/compare/pigeon/grammar.go")
+	delete("benchmarks/compare/bench.txt")
 
 	wd := chdir("cmd/peg-bootstrap/")
 	defer chdir(wd)
@@ -367,99 +305,125 @@ func clean() bool {
 	return false
 }
 
-func grammars_c() bool {
-	if done("grammars/c/c.peg.go", peg, "grammars/c/c.peg") {
+func test() bool {
+	if done("", peg) {
 		return true
 	}
 
-	wd := chdir("grammars/c/")
-	defer chdir(wd)
-
-	command("../../peg", "", "", "-switch", "-inline", "c.peg")
+	command("go", "", "", "generate", "./grammars/...")
+	command("go", "", "", "test", "-short", "./...")
 
 	return false
 }
 
-func grammars_calculator() bool {
-	if done("grammars/calculator/calculator.peg.go", peg, "grammars/calculator/calculator.peg") {
-		return true
-	}
-
-	wd := chdir("grammars/calculator/")
-	defer chdir(wd)
+func bench() bool {
+	peg()
 
-	command("../../peg", "", "", "-switch", "-inline", "calculator.peg")
+	command("go", "", "", "test", "-benchmem", "-bench", ".")
 
 	return false
 }
 
-func grammars_calculator_ast() bool {
-	if done("grammars/calculator_ast/calculator.peg.go", peg, "grammars/calculator_ast/calculator.peg") {
+func benchmarks_compare_pegexpression() bool {
+	if done("benchmarks/compare/pegexpression/expression.peg.go", peg, "benchmarks/compare/pegexpression/expression.peg") {
 		return true
 	}
 
-	wd := chdir("grammars/calculator_ast/")
+	wd := chdir("benchmarks/compare/pegexpression/")
 	defer chdir(wd)
 
-	command("../../peg", "", "", "-switch", "-inline", "calculator.peg")
+	command("../../../peg", "", "", "-switch", "-inline", "expression.peg")
 
 	return false
 }
 
-func grammars_fexl() bool {
-	if done("grammars/fexl/fexl.peg.go", peg, "grammars/fexl/fexl.peg") {
+func benchmarks_compare_pegjson() bool {
+	if done("benchmarks/compare/pegjson/json.peg.go", peg, "benchmarks/compare/pegjson/json.peg") {
 		return true
 	}
 
-	wd := chdir("grammars/fexl/")
+	wd := chdir("benchmarks/compare/pegjson/")
 	defer chdir(wd)
 
-	command("../../peg", "", "", "-switch", "-inline", "fexl.peg")
+	command("../../../peg", "", "", "-switch", "-inline", "json.peg")
 
 	return false
 }
 
-func grammars_java() bool {
-	if done("grammars/java/java_1_7.peg.go", peg, "grammars/java/java_1_7.peg") {
+func benchmarks_compare_pigeon() bool {
+	if done("benchmarks/compare/pigeon/grammar.go", "benchmarks/compare/pigeon/grammar.peg") {
 		return true
 	}
 
-	wd := chdir("grammars/java/")
+	wd := chdir("benchmarks/compare/")
 	defer chdir(wd)
 
-	command("../../peg", "", "", "-switch", "-inline", "java_1_7.peg")
+	command("go", "", "", "run", "github.com/mna/pigeon", "-optimize-parser", "-optimize-basic-latin",
+		"-alternate-entrypoints", "Expression", "-o", "pigeon/grammar.go", "pigeon/grammar.peg")
 
 	return false
 }
 
-func grammars_long_test() bool {
-	if done("grammars/long_test/long.peg.go", peg, "grammars/long_test/long.peg") {
-		return true
-	}
+// bench_compare runs the benchmarks of benchmarks/compare, which parse the
+// same corpora with peg, pigeon and participle, and prints a table of the
+// results. The output of go test is kept in benchmarks/compare/bench.txt for
+// benchstat.
+func bench_compare() bool {
+	done("", benchmarks_compare_pegexpression, benchmarks_compare_pegjson, benchmarks_compare_pigeon)
 
-	wd := chdir("grammars/long_test/")
+	wd := chdir("benchmarks/compare/")
 	defer chdir(wd)
 
-	command("../../peg", "", "", "-switch", "-inline", "long.peg")
-
-	return false
-}
+	command("go", "", "bench.txt", "test", "-run", "-", "-benchmem", "-bench", ".")
 
-func test() bool {
-	if done("", grammars_c, grammars_calculator, grammars_calculator_ast,
-		grammars_fexl, grammars_java, grammars_long_test) {
-		return true
+	output, err := os.ReadFile("bench.txt")
+	if err != nil {
+		panic(err)
+	}
+	var corpora, parsers []string
+	results := make(map[string]string)
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "BenchmarkCompare/") {
+			continue
+		}
+		name := strings.TrimPrefix(fields[0], "BenchmarkCompare/")
+		if i := strings.LastIndex(name, "-"); i > strings.LastIndex(name, "/") {
+			name = name[:i]
+		}
+		i := strings.LastIndex(name, "/")
+		corpus, parser := name[:i], name[i+1:]
+		if len(corpora) == 0 || corpora[len(corpora)-1] != corpus {
+			corpora = append(corpora, corpus)
+		}
+		found := false
+		for _, p := range parsers {
+			found = found || p == parser
+		}
+		if !found {
+			parsers = append(parsers, parser)
+		}
+		var speed, allocs string
+		for i := 1; i < len(fields); i++ {
+			switch fields[i] {
+			case "MB/s":
+				speed = fields[i-1]
+			case "allocs/op":
+				allocs = fields[i-1]
+			}
+		}
+		results[name] = fmt.Sprintf("%s MB/s, %s allocs", speed, allocs)
 	}
 
-	command("go", "", "", "test", "-short", "-tags", "grammars", "./...")
-
-	return false
-}
-
-func bench() bool {
-	peg()
-
-	command("go", "", "", "test", "-benchmem", "-bench", ".")
+	fmt.Printf("\n| corpus | %s |\n|---|", strings.Join(parsers, " | "))
+	fmt.Print(strings.Repeat("---|", len(parsers)) + "\n")
+	for _, corpus := range corpora {
+		fmt.Printf("| %s |", corpus)
+		for _, parser := range parsers {
+			fmt.Printf(" %s |", results[corpus+"/"+parser])
+		}
+		fmt.Println()
+	}
 
 	return false
 }
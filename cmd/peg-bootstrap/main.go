@@ -21,6 +21,10 @@ func main() {
 		log.Fatal(err)
 	}
 	p := &Peg{Tree: tree.New(false, false, false), Buffer: string(buffer)}
+	if len(os.Args) > 1 {
+		// peg.peg is in package generator, the stages of the bootstrap in main.
+		p.Package = os.Args[1]
+	}
 	p.Init(Pretty(true), Size(1<<15))
 	if err := p.Parse(); err != nil {
 		log.Fatal(err)
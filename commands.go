@@ -0,0 +1,441 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pointlander/peg/tree"
+)
+
+// A command is run by peg with the arguments following its name. The default
+// command, without a name, compiles the grammar.
+type command struct {
+	name string
+	// options are the options shown in the usage before the name.
+	options string
+	// args are the names of the arguments: "file" for a grammar, "directory",
+	// "shell" or "command". An optional argument is in brackets.
+	args  []string
+	usage string
+	run   func(args []string)
+}
+
+// commands are the commands of peg, in the order of the usage. They are set in
+// init, as completion and man refer to them.
+var commands []*command
+
+// shells are the shells completion writes scripts for.
+var shells = []string{"bash", "zsh", "fish"}
+
+func init() {
+	compile := func(name string) func(args []string) {
+		return func(args []string) {
+			generate(name, args[0])
+		}
+	}
+	commands = []*command{
+		{"", "[<option>]...", []string{"file"}, "the same as gen", compile("")},
+		{"gen", "[<option>]...", []string{"file"}, "compile the grammar in file to a Go parser", compile("")},
+		{"vet", "[<option>]...", []string{"file"}, "check the grammar without generating code, like -check-syntax", compile("vet")},
+		{"fmt", "", []string{"file"}, "rewrite the grammar in the canonical layout, with aligned choices, normalized classes and indented actions", compile("fmt")},
+		{"test", "[<option>]...", []string{"file"}, "run go test on the package of the parser without writing it", compile("test")},
+		{"graph", "", []string{"file"}, "print the rules and their references as a Graphviz digraph", compile("graph")},
+		{"serve-api", "[<option>]...", []string{"file"}, "also write an HTTP service serving the parser", compile("serve-api")},
+		{"textmate", "[<option>]...", []string{"file"}, "write a TextMate grammar for syntax highlighting", compile("textmate")},
+		{"lint", "[-fix]", []string{"file"}, "report common mistakes in the grammar, and fix them with -fix", compile("lint")},
+		{"migrate", "[-fix]", []string{"file"}, "print the changes updating the grammar from older syntax as a diff, and apply them with -fix", compile("migrate")},
+		{"compare-grammars", "[-inline] [-switch]", []string{"file", "file", "directory"}, "report the inputs in directory which the parsers of the two grammars accept or parse differently", func(args []string) {
+			compareGrammars(args[0], args[1], args[2])
+		}},
+		{"rewrite", "[<option>]... -rule <name> [-template <text>] [-fix]", []string{"file", "input"}, "print input with the matches of the rule replaced by the template, or write it with -fix", func(args []string) {
+			substitute(args[0], args[1])
+		}},
+		{"reduce", "[<option>]... [-slow <duration>] [-fix]", []string{"file", "input"}, "print a minimal input failing, panicking or parsing slowly like input, or write it with -fix", func(args []string) {
+			reduce(args[0], args[1])
+		}},
+		{"init-bazel", "[<option>]...", []string{"directory"}, "print the Bazel rules for the grammars in directory", func(args []string) {
+			if err := initBazel(args[0], bazelOptions(), os.Stdout); err != nil {
+				log.Fatal(err)
+			}
+		}},
+		{"build", "[<option>]... [-o <binary>]", []string{"file"}, "build the package of the parser without writing it", compile("build")},
+		{"stress", "[<option>]... [-depth <n>] [-width <n>] [-size <n>]", []string{"directory"}, "write a large grammar and input to directory, and compile it", compile("stress")},
+		{"completion", "", []string{"shell"}, "print the completion script for " + strings.Join(shells, ", "), func(args []string) {
+			if err := writeCompletion(args[0], os.Stdout); err != nil {
+				log.Fatal(err)
+			}
+		}},
+		{"tree-schema", "", nil, "print the JSON Schema of the syntax trees of the c-shared wrapper and the parse service", func([]string) {
+			fmt.Print(tree.TreeSchema)
+		}},
+		{"man", "", nil, "print the man page", func([]string) {
+			if err := writeMan(os.Stdout); err != nil {
+				log.Fatal(err)
+			}
+		}},
+		{"help", "", []string{"[command]"}, "print the usage of command, or of peg", func(args []string) {
+			flag.CommandLine.SetOutput(os.Stdout)
+			if len(args) == 0 {
+				flag.Usage()
+				return
+			}
+			if err := help(args[0], os.Stdout); err != nil {
+				log.Fatal(err)
+			}
+		}},
+	}
+	flag.Usage = usage
+}
+
+// lookupCommand returns the command for the arguments following the options.
+// A first argument naming a command selects it if the number of arguments
+// matches, and the default command is selected otherwise.
+func lookupCommand(args []string) (*command, []string) {
+	if len(args) > 0 {
+		if c := findCommand(args[0]); c != nil && c.accepts(len(args)-1) {
+			return c, args[1:]
+		}
+	}
+	if !commands[0].accepts(len(args)) {
+		return nil, nil
+	}
+	return commands[0], args
+}
+
+// findCommand returns the command called name, or nil.
+func findCommand(name string) *command {
+	for _, c := range commands[1:] {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// accepts reports whether the command takes n arguments.
+func (c *command) accepts(n int) bool {
+	required := 0
+	for _, arg := range c.args {
+		if !strings.HasPrefix(arg, "[") {
+			required++
+		}
+	}
+	return n >= required && n <= len(c.args)
+}
+
+// synopsis returns the usage line of the command.
+func (c *command) synopsis() string {
+	words := []string{"peg"}
+	for _, word := range []string{c.options, c.name} {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	for _, arg := range c.args {
+		if name, ok := strings.CutPrefix(arg, "["); ok {
+			words = append(words, "[<"+strings.TrimSuffix(name, "]")+">]")
+		} else {
+			words = append(words, "<"+arg+">")
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// argKind returns the kind of the argument arg, without the brackets of an
+// optional argument.
+func argKind(arg string) string {
+	return strings.Trim(arg, "[]")
+}
+
+// usage prints the commands and the options of peg.
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintln(out, "Usage:")
+	for _, c := range commands {
+		fmt.Fprintf(out, "  %v\n      %v\n", c.synopsis(), c.usage)
+	}
+	fmt.Fprintln(out, "\nOptions:")
+	flag.PrintDefaults()
+}
+
+// help writes the usage of the command called name, followed by the options
+// shared by all commands.
+func help(name string, out io.Writer) error {
+	c := findCommand(name)
+	if c == nil {
+		return fmt.Errorf("unknown command %q, run 'peg help' for the commands", name)
+	}
+	fmt.Fprintf(out, "Usage:\n  %v\n      %v\n", c.synopsis(), c.usage)
+	if c.options != "" {
+		fmt.Fprintln(out, "\nOptions:")
+		flag.CommandLine.SetOutput(out)
+		flag.PrintDefaults()
+	}
+	return nil
+}
+
+// option is a flag as described by completion scripts and the man page.
+type option struct {
+	name, value, usage string
+}
+
+// options returns the flags of peg, sorted by name. value is the name of the
+// value of flags which take one.
+func options() []option {
+	var opts []option
+	flag.VisitAll(func(f *flag.Flag) {
+		value, usage := flag.UnquoteUsage(f)
+		if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+			value = ""
+		}
+		opts = append(opts, option{f.Name, value, usage})
+	})
+	sort.Slice(opts, func(i, j int) bool {
+		return opts[i].name < opts[j].name
+	})
+	return opts
+}
+
+// commandNames returns the names of the commands.
+func commandNames() []string {
+	var names []string
+	for _, c := range commands[1:] {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+// writeCompletion writes the completion script for shell.
+func writeCompletion(shell string, out io.Writer) error {
+	switch shell {
+	case "bash":
+		return writeBashCompletion(out)
+	case "zsh":
+		return writeZshCompletion(out)
+	case "fish":
+		return writeFishCompletion(out)
+	}
+	return fmt.Errorf("unknown shell %q, expected one of %v", shell, strings.Join(shells, ", "))
+}
+
+func writeBashCompletion(out io.Writer) error {
+	var all, values []string
+	for _, o := range options() {
+		all = append(all, "-"+o.name)
+		if o.value != "" {
+			values = append(values, "-"+o.name)
+		}
+	}
+	names := strings.Join(commandNames(), " ")
+	complete := map[string]string{
+		"file":      `$(compgen -f -X '!*.peg' -- "$cur") $(compgen -d -- "$cur")`,
+		"directory": `$(compgen -d -- "$cur")`,
+		"shell":     `$(compgen -W "` + strings.Join(shells, " ") + `" -- "$cur")`,
+		"command":   `$(compgen -W "` + names + `" -- "$cur")`,
+	}
+
+	w := &errWriter{w: out}
+	w.printf("# bash completion for peg, generated by \"peg completion bash\".\n")
+	w.printf("# Load it with: source <(peg completion bash)\n\n")
+	w.printf("_peg() {\n")
+	w.printf("\tlocal cur=${COMP_WORDS[COMP_CWORD]} i\n")
+	w.printf("\tlocal -a args=()\n")
+	w.printf("\tcase ${COMP_WORDS[COMP_CWORD-1]} in\n")
+	w.printf("\t%v)\n", strings.Join(values, "|"))
+	w.printf("\t\tCOMPREPLY=($(compgen -f -- \"$cur\"))\n")
+	w.printf("\t\treturn\n")
+	w.printf("\t\t;;\n")
+	w.printf("\tesac\n")
+	w.printf("\tfor ((i = 1; i < COMP_CWORD; i++)); do\n")
+	w.printf("\t\tcase ${COMP_WORDS[i]} in\n")
+	w.printf("\t\t%v) ((i++)) ;;\n", strings.Join(values, "|"))
+	w.printf("\t\t-*) ;;\n")
+	w.printf("\t\t*) args+=(\"${COMP_WORDS[i]}\") ;;\n")
+	w.printf("\t\tesac\n")
+	w.printf("\tdone\n")
+	w.printf("\tif [[ $cur == -* ]]; then\n")
+	w.printf("\t\tCOMPREPLY=($(compgen -W \"%v\" -- \"$cur\"))\n", strings.Join(all, " "))
+	w.printf("\t\treturn\n")
+	w.printf("\tfi\n")
+	w.printf("\tif ((${#args[@]} == 0)); then\n")
+	w.printf("\t\tCOMPREPLY=(%v %v)\n", complete["command"], complete[commands[0].args[0]])
+	w.printf("\t\treturn\n")
+	w.printf("\tfi\n")
+	w.printf("\tcase ${#args[@]}:${args[0]} in\n")
+	for _, c := range commands[1:] {
+		for i, arg := range c.args {
+			w.printf("\t%v:%v) COMPREPLY=(%v) ;;\n", i+1, c.name, complete[argKind(arg)])
+		}
+	}
+	w.printf("\tesac\n")
+	w.printf("}\n\n")
+	w.printf("complete -o filenames -F _peg peg\n")
+	return w.err
+}
+
+func writeZshCompletion(out io.Writer) error {
+	quote := func(s string) string {
+		return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+	}
+	describe := strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`, ":", `\:`)
+	complete := map[string]string{
+		"file":      "_files -g '*.peg'",
+		"directory": "_files -/",
+		"shell":     "_values shell " + strings.Join(shells, " "),
+		"command":   "_describe command commands",
+	}
+
+	w := &errWriter{w: out}
+	w.printf("#compdef peg\n")
+	w.printf("# zsh completion for peg, generated by \"peg completion zsh\".\n")
+	w.printf("# Load it with: source <(peg completion zsh)\n\n")
+	w.printf("_peg() {\n")
+	w.printf("\tlocal state line\n")
+	w.printf("\tlocal -a commands=(\n")
+	for _, c := range commands[1:] {
+		w.printf("\t\t%v\n", quote(c.name+":"+c.usage))
+	}
+	w.printf("\t)\n")
+	w.printf("\t_arguments \\\n")
+	for _, o := range options() {
+		spec := "-" + o.name + "[" + describe.Replace(o.usage) + "]"
+		if o.value != "" {
+			spec += ":" + describe.Replace(o.value) + ":_files"
+		}
+		w.printf("\t\t%v \\\n", quote(spec))
+	}
+	w.printf("\t\t'1: :->command' \\\n")
+	w.printf("\t\t'*:: :->args'\n")
+	w.printf("\tcase $state in\n")
+	w.printf("\tcommand)\n")
+	w.printf("\t\t_describe command commands\n")
+	w.printf("\t\t%v\n", complete[commands[0].args[0]])
+	w.printf("\t\t;;\n")
+	w.printf("\targs)\n")
+	w.printf("\t\tcase $CURRENT:$line[1] in\n")
+	for _, c := range commands[1:] {
+		for i, arg := range c.args {
+			w.printf("\t\t%v:%v) %v ;;\n", i+2, c.name, complete[argKind(arg)])
+		}
+	}
+	w.printf("\t\tesac\n")
+	w.printf("\t\t;;\n")
+	w.printf("\tesac\n")
+	w.printf("}\n\n")
+	w.printf("compdef _peg peg\n")
+	return w.err
+}
+
+func writeFishCompletion(out io.Writer) error {
+	quote := func(s string) string {
+		return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s) + "'"
+	}
+	complete := map[string]string{
+		"file":      "-a '(__fish_complete_suffix .peg)'",
+		"directory": "-a '(__fish_complete_directories)'",
+		"shell":     "-a " + quote(strings.Join(shells, " ")),
+		"command":   "-a " + quote(strings.Join(commandNames(), " ")),
+	}
+
+	w := &errWriter{w: out}
+	w.printf("# fish completion for peg, generated by \"peg completion fish\".\n")
+	w.printf("# Load it with: peg completion fish | source\n\n")
+	w.printf("complete -c peg -f\n")
+	for _, o := range options() {
+		if o.value != "" {
+			w.printf("complete -c peg -o %v -r -F -d %v\n", o.name, quote(o.usage))
+		} else {
+			w.printf("complete -c peg -o %v -d %v\n", o.name, quote(o.usage))
+		}
+	}
+	w.printf("complete -c peg -n __fish_use_subcommand %v\n", complete[commands[0].args[0]])
+	for _, c := range commands[1:] {
+		w.printf("complete -c peg -n __fish_use_subcommand -a %v -d %v\n", c.name, quote(c.usage))
+		for _, arg := range c.args {
+			w.printf("complete -c peg -n '__fish_seen_subcommand_from %v' %v\n", c.name, complete[argKind(arg)])
+		}
+	}
+	return w.err
+}
+
+// writeMan writes the man page of peg, in roff.
+func writeMan(out io.Writer) error {
+	escape := func(s string) string {
+		s = strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(s)
+		if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+			s = `\&` + s
+		}
+		return s
+	}
+	synopsis := func(c *command) string {
+		words := []string{`\fBpeg\fR`}
+		if c.options != "" {
+			words = append(words, escape(c.options))
+		}
+		if c.name != "" {
+			words = append(words, `\fB`+escape(c.name)+`\fR`)
+		}
+		for _, arg := range c.args {
+			if arg != argKind(arg) {
+				words = append(words, `[\fI`+argKind(arg)+`\fR]`)
+			} else {
+				words = append(words, `\fI`+arg+`\fR`)
+			}
+		}
+		return strings.Join(words, " ")
+	}
+
+	w := &errWriter{w: out}
+	w.printf(".TH PEG 1 \"\" %q \"User Commands\"\n", "peg "+readBuildInfo().version)
+	w.printf(".SH NAME\n")
+	w.printf("peg \\- generate packrat parsers in Go from parsing expression grammars\n")
+	w.printf(".SH SYNOPSIS\n")
+	for i, c := range commands {
+		if i > 0 {
+			w.printf(".br\n")
+		}
+		w.printf("%v\n", synopsis(c))
+	}
+	w.printf(".SH DESCRIPTION\n")
+	w.printf("\\fBpeg\\fR compiles the parsing expression grammar in \\fIfile\\fR to a packrat parser in Go, written to \\fIfile\\fR.go unless \\fB\\-output\\fR is given.\n")
+	w.printf(".SH COMMANDS\n")
+	for _, c := range commands[1:] {
+		w.printf(".TP\n")
+		w.printf("%v\n", synopsis(c))
+		w.printf("%v\n", escape(c.usage))
+	}
+	w.printf(".SH OPTIONS\n")
+	for _, o := range options() {
+		w.printf(".TP\n")
+		if o.value != "" {
+			w.printf("\\fB\\-%v\\fR \\fI%v\\fR\n", escape(o.name), escape(o.value))
+		} else {
+			w.printf("\\fB\\-%v\\fR\n", escape(o.name))
+		}
+		w.printf("%v\n", escape(o.usage))
+	}
+	w.printf(".SH SEE ALSO\n")
+	w.printf("https://github.com/pointlander/peg\n")
+	return w.err
+}
+
+// errWriter keeps the first error of a sequence of writes.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *errWriter) printf(format string, a ...any) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.w, format, a...)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pointlander/peg/generator"
+)
+
+// compareGrammars parses the files below corpus with the parsers of the
+// grammars in oldFile and newFile, and reports the files which only one of
+// them accepts, or which they parse to different syntax trees.
+func compareGrammars(oldFile, newFile, corpus string) {
+	corpus, err := filepath.Abs(corpus)
+	if err != nil {
+		log.Fatal(err)
+	}
+	before, after := parseCorpus(oldFile, newFile, corpus), parseCorpus(newFile, oldFile, corpus)
+
+	names := make([]string, 0, len(before))
+	for name := range before {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	differ := 0
+	for _, name := range names {
+		a, b := before[name], after[name]
+		switch {
+		case a == b:
+			continue
+		case a == "rejected":
+			fmt.Printf("%v: rejected by %v, accepted by %v\n", name, oldFile, newFile)
+		case b == "rejected":
+			fmt.Printf("%v: accepted by %v, rejected by %v\n", name, oldFile, newFile)
+		default:
+			i := 0
+			for i < len(a) && i < len(b) && a[i] == b[i] {
+				i++
+			}
+			/* show the differing trees from the start of the node they differ in */
+			i = strings.LastIndexAny(a[:i], " (") + 1
+			fmt.Printf("%v: the syntax trees differ\n\t%v: %v\n\t%v: %v\n", name, oldFile, abbreviate(a[i:]), newFile, abbreviate(b[i:]))
+		}
+		differ++
+	}
+	fmt.Printf("%v of %v inputs differ\n", differ, len(names))
+	if differ > 0 {
+		os.Exit(1)
+	}
+}
+
+// parseCorpus generates the parser of the grammar in file, and returns the
+// results of its test written by CompileCompare for the files below corpus.
+// The files generated from the grammar other, which may be in the same
+// package, are left out.
+func parseCorpus(file, other, corpus string) map[string]string {
+	p := loadGrammar(file)
+	content := runGeneratedTest(p, file, other, "compare", p.CompileCompare, "PEG_COMPARE_CORPUS="+corpus)
+	parsed := make(map[string]string)
+	if err := json.Unmarshal(content, &parsed); err != nil {
+		log.Fatal(err)
+	}
+	return parsed
+}
+
+// loadGrammar parses the grammar in file, with the AST, for a parser of a
+// []byte Buffer with -bytes.
+func loadGrammar(file string) *generator.Peg {
+	buffer, err := os.ReadFile(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	opts := generatorOptions(file)
+	opts.NoAST, opts.Captures, opts.Warn = false, false, nil
+	p, err := generator.Parse(buffer, opts)
+	if err != nil {
+		log.Fatalf("%v: %v", file, err)
+	}
+	return p
+}
+
+// runGeneratedTest runs the test TestPeg<Name> written by compile for the
+// parser of the grammar in file into peg_<name>_test.go, next to the parser
+// generated on the fly, with the variables of env, and returns the content of the file named by
+// PEG_<NAME>_OUTPUT. The files generated from the grammar other, if not
+// empty, are left out of the package.
+func runGeneratedTest(p *generator.Peg, file, other, name string, compile func(out io.Writer) error, env ...string) []byte {
+	output, err := filepath.Abs(file + ".go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	generated := &bytes.Buffer{}
+	if err = p.Compile(output, os.Args, generated); err != nil {
+		log.Fatal(err)
+	}
+	test := &bytes.Buffer{}
+	if err = compile(test); err != nil {
+		log.Fatal(err)
+	}
+
+	dir, err := os.MkdirTemp("", "peg")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	replace := make(map[string]string)
+	for path, content := range map[string][]byte{
+		output: generated.Bytes(),
+		filepath.Join(filepath.Dir(output), "peg_"+name+"_test.go"): test.Bytes(),
+	} {
+		replacement := filepath.Join(dir, filepath.Base(path))
+		if err = os.WriteFile(replacement, content, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		replace[path] = replacement
+	}
+	if other != "" {
+		if other, err = filepath.Abs(other); err != nil {
+			log.Fatal(err)
+		}
+		for _, path := range []string{other + ".go", other + "_bench_test.go"} {
+			if _, ok := replace[path]; !ok && filepath.Dir(path) == filepath.Dir(output) {
+				replace[path] = ""
+			}
+		}
+	}
+	overlay, err := json.Marshal(map[string]map[string]string{"Replace": replace})
+	if err != nil {
+		log.Fatal(err)
+	}
+	overlayJSON := filepath.Join(dir, "overlay.json")
+	if err = os.WriteFile(overlayJSON, overlay, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	results, testOutput := filepath.Join(dir, "results"), &bytes.Buffer{}
+	title := strings.ToUpper(name[:1]) + name[1:]
+	run := exec.Command("go", "test", "-overlay", overlayJSON, "-count=1", "-run", "^TestPeg"+title+"$", ".")
+	run.Dir = filepath.Dir(output)
+	run.Env = append(append(os.Environ(), env...), "PEG_"+strings.ToUpper(name)+"_OUTPUT="+results)
+	run.Stdout, run.Stderr = testOutput, testOutput
+	if err = run.Run(); err != nil {
+		os.Stderr.Write(testOutput.Bytes())
+		os.RemoveAll(dir)
+		log.Fatalf("%v: %v", file, err)
+	}
+	content, err := os.ReadFile(results)
+	if err != nil {
+		os.RemoveAll(dir)
+		log.Fatal(err)
+	}
+	return content
+}
+
+// abbreviate shortens a syntax tree written by the compare test to a line.
+func abbreviate(s string) string {
+	if runes := []rune(s); len(runes) > 72 {
+		return string(runes[:72]) + "..."
+	}
+	return s
+}
@@ -0,0 +1,57 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pointlander/peg/tree"
+)
+
+// canonicalIR undoes in the expressions of ir the changes of Format,
+// which doesn't change what the grammar matches: the blanks of the code of
+// actions, predicates and state changes, ranges of a single character, and
+// repeated characters, ranges and Unicode properties within alternatives of
+// characters.
+func canonicalIR(ir *tree.IR) {
+	var canonical func(node *tree.IRNode) *tree.IRNode
+	canonical = func(node *tree.IRNode) *tree.IRNode {
+		for i, child := range node.Children {
+			node.Children[i] = canonical(child)
+		}
+		switch node.Type {
+		case "Action", "Predicate", "StateChange":
+			node.Text = strings.Join(strings.Fields(node.Text), " ")
+		case "Range":
+			if node.Children[0].Text == node.Children[1].Text {
+				return node.Children[0]
+			}
+		case "Alternate":
+			var children []*tree.IRNode
+			seen := make(map[string]bool)
+			for _, child := range node.Children {
+				if child.Type != "Character" && child.Type != "Range" && child.Type != "Property" {
+					return node
+				}
+				key := child.Type + " " + child.Text
+				for _, bound := range child.Children {
+					key += " " + bound.Text
+				}
+				if !seen[key] {
+					seen[key] = true
+					children = append(children, child)
+				}
+			}
+			if len(children) == 1 {
+				return children[0]
+			}
+			node.Children = children
+		}
+		return node
+	}
+	for i := range ir.Rules {
+		ir.Rules[i].Expression = canonical(ir.Rules[i].Expression)
+	}
+}
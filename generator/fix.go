@@ -0,0 +1,103 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"strings"
+
+	"github.com/pointlander/peg/tree"
+)
+
+// FixMissingEOF appends !. to the expression of the start rule, before the
+// spacing and comments following it, to fix tree.ErrMissingEOF.
+func (p *Peg) FixMissingEOF() {
+	var definition *node32
+	for node := p.AST().up; node != nil; node = node.next {
+		if node.pegRule == ruleDefinition {
+			definition = node
+			break
+		}
+	}
+	if definition == nil {
+		return
+	}
+	expression := definition.up
+	for expression != nil && expression.pegRule != ruleExpression {
+		expression = expression.next
+	}
+	if expression == nil {
+		return
+	}
+	begin, end, alternate := expression.begin, expression.end, false
+	var spacing func(node *node32)
+	spacing = func(node *node32) {
+		for ; node != nil; node = node.next {
+			if node.pegRule == ruleSpacing && node.end == expression.end && node.begin < end {
+				end = node.begin
+			}
+			spacing(node.up)
+		}
+	}
+	spacing(expression.up)
+	for node := expression.up; node != nil; node = node.next {
+		if node.pegRule == ruleSlash {
+			alternate = true
+		}
+	}
+	buffer := []rune(p.Buffer)
+	fixed := string(buffer[:begin])
+	if alternate {
+		fixed += "(" + string(buffer[begin:end]) + ")"
+	} else {
+		fixed += string(buffer[begin:end])
+	}
+	p.Buffer = fixed + " !." + string(buffer[end:])
+}
+
+// MigrateLiteralSuffix returns the grammar with a space between literals and
+// the s directly following them, if the grammar defines a rule named s.
+// Before the s suffix was added, 'a's matched 'a' followed by the rule s.
+func (p *Peg) MigrateLiteralSuffix() string {
+	defined := false
+	for _, element := range p.Slice() {
+		if element.GetType() == tree.TypeRule && element.String() == "s" {
+			defined = true
+		}
+	}
+	if !defined {
+		return p.Buffer
+	}
+
+	buffer := []rune(p.Buffer)
+	var suffixes []uint32
+	var literals func(node *node32)
+	literals = func(node *node32) {
+		for ; node != nil; node = node.next {
+			if node.pegRule != ruleLiteralBody {
+				literals(node.up)
+				continue
+			}
+			end := node.end
+			for child := node.up; child != nil; child = child.next {
+				if child.pegRule == ruleSpacing && child.end == node.end {
+					end = child.begin
+				}
+			}
+			if end >= 2 && buffer[end-1] == 's' && (buffer[end-2] == '\'' || buffer[end-2] == '"') {
+				suffixes = append(suffixes, end-1)
+			}
+		}
+	}
+	literals(p.AST())
+
+	migrated, last := &strings.Builder{}, uint32(0)
+	for _, suffix := range suffixes {
+		migrated.WriteString(string(buffer[last:suffix]))
+		migrated.WriteString(" ")
+		last = suffix
+	}
+	migrated.WriteString(string(buffer[last:]))
+	return migrated.String()
+}
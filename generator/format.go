@@ -0,0 +1,255 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pointlander/peg/tree"
+)
+
+// Format returns the grammar laid out by canonicalize, without trailing
+// spaces, with at most one blank line in a row, and ending with a single
+// newline. The text of literals is left alone.
+func (p *Peg) Format() string {
+	if canonical := canonicalize(p); canonical != p.Buffer {
+		q := &Peg{Tree: tree.New(false, false, false), Buffer: canonical}
+		_ = q.Init(Pretty(true), Size(1<<15))
+		if err := q.Parse(); err == nil {
+			p = q
+		}
+	}
+	buffer := []rune(p.Buffer)
+	protected := make([]bool, len(buffer))
+	var protect func(node *node32)
+	protect = func(node *node32) {
+		for ; node != nil; node = node.next {
+			switch node.pegRule {
+			case ruleAction, ruleLiteral, ruleClass, ruleInSet, ruleDirective:
+				end := node.end
+				var spacing func(node *node32)
+				spacing = func(node *node32) {
+					for ; node != nil; node = node.next {
+						if node.pegRule == ruleSpacing && node.end == end && node.begin < end {
+							end = node.begin
+						}
+						spacing(node.up)
+					}
+				}
+				spacing(node.up)
+				for i := node.begin; i < end; i++ {
+					protected[i] = true
+				}
+			}
+			protect(node.up)
+		}
+	}
+	protect(p.AST())
+
+	var out []rune
+	var kept []bool
+	newlines := 0
+	for i, r := range buffer {
+		if r == '\n' && !protected[i] {
+			for len(out) > 0 && (out[len(out)-1] == ' ' || out[len(out)-1] == '\t') && !kept[len(out)-1] {
+				out, kept = out[:len(out)-1], kept[:len(kept)-1]
+			}
+			if newlines++; newlines > 2 || len(out) == 0 {
+				continue
+			}
+		} else if r != ' ' && r != '\t' || protected[i] {
+			newlines = 0
+		}
+		out, kept = append(out, r), append(kept, protected[i])
+	}
+	for len(out) > 0 && !kept[len(out)-1] && strings.ContainsRune(" \t\n", out[len(out)-1]) {
+		out, kept = out[:len(out)-1], kept[:len(kept)-1]
+	}
+	return string(out) + "\n"
+}
+
+// canonicalize returns the grammar of p laid out the same way whoever wrote
+// it: the choices of a rule starting a line are aligned under the end of its
+// <-, the ranges of a character class matching a single character are written
+// as that character and repeated ranges are dropped, an action on one line
+// has one space inside its braces, and the code of an action on several lines
+// is indented one tab more than the line of its opening brace, which closes
+// it on a line of its own. Actions holding raw strings on several lines are
+// left alone, as are the actions of -> and of the declarations.
+func canonicalize(p *Peg) string {
+	buffer := []rune(p.Buffer)
+	type edit struct {
+		begin, end uint32
+		text       string
+	}
+	var edits []edit
+	/* the indentation of the lines of aligned choices */
+	indentation := make(map[uint32]string)
+
+	/* lineOf returns the beginning of the line of position, and the column
+	   of position with tabs every 8 columns */
+	lineOf := func(position uint32) (uint32, int) {
+		begin := position
+		for begin > 0 && buffer[begin-1] != '\n' {
+			begin--
+		}
+		return begin, width(string(buffer[begin:position]))
+	}
+	child := func(node *node32, rule pegRule) *node32 {
+		for node = node.up; node != nil && node.pegRule != rule; node = node.next {
+		}
+		return node
+	}
+
+	alignChoices := func(definition *node32) {
+		arrow, expression := child(definition, ruleLeftArrow), child(definition, ruleExpression)
+		if arrow == nil || expression == nil {
+			return
+		}
+		/* the choices start under the - of <-, or under ← */
+		_, column := lineOf(arrow.begin)
+		if buffer[arrow.begin] == '<' {
+			column++
+		}
+		for slash := expression.up; slash != nil; slash = slash.next {
+			if slash.pegRule != ruleSlash {
+				continue
+			}
+			line, _ := lineOf(slash.begin)
+			if strings.TrimLeft(string(buffer[line:slash.begin]), " \t") == "" {
+				indentation[line] = strings.Repeat(" ", column)
+				edits = append(edits, edit{line, slash.begin, indentation[line]})
+			}
+		}
+	}
+
+	normalizeClass := func(ranges *node32) {
+		var texts []string
+		seen := make(map[string]bool)
+		for r := ranges.up; r != nil; r = r.next {
+			text := string(buffer[r.begin:r.end])
+			if lower := r.up; lower != nil && lower.next != nil {
+				if upper := lower.next; string(buffer[lower.begin:lower.end]) == string(buffer[upper.begin:upper.end]) {
+					text = string(buffer[lower.begin:lower.end])
+				}
+			}
+			if !seen[text] {
+				seen[text] = true
+				texts = append(texts, text)
+			}
+		}
+		edits = append(edits, edit{ranges.begin, ranges.end, strings.Join(texts, "")})
+	}
+
+	layoutAction := func(action *node32) {
+		body := child(action, rulePegText)
+		if body == nil {
+			return
+		}
+		code := string(buffer[body.begin:body.end])
+		lines := strings.Split(code, "\n")
+		if len(lines) == 1 {
+			if code = strings.TrimSpace(code); code != "" {
+				code = " " + code + " "
+			}
+			edits = append(edits, edit{body.begin, body.end, code})
+			return
+		}
+		if strings.Contains(code, "`") {
+			return
+		}
+		line, _ := lineOf(action.begin)
+		base, aligned := indentation[line]
+		if !aligned {
+			base = string(buffer[line:action.begin])
+			base = base[:len(base)-len(strings.TrimLeft(base, " \t"))]
+		}
+
+		first, rest := strings.TrimSpace(lines[0]), lines[1:]
+		for len(rest) > 0 && strings.TrimSpace(rest[0]) == "" {
+			rest = rest[1:]
+		}
+		for len(rest) > 0 && strings.TrimSpace(rest[len(rest)-1]) == "" {
+			rest = rest[:len(rest)-1]
+		}
+		indent := -1
+		for _, line := range rest {
+			if strings.TrimSpace(line) != "" {
+				if w := width(line[:len(line)-len(strings.TrimLeft(line, " \t"))]); indent < 0 || w < indent {
+					indent = w
+				}
+			}
+		}
+		var out strings.Builder
+		if first != "" {
+			out.WriteString(" " + first)
+		}
+		for _, line := range rest {
+			code := strings.TrimSpace(line)
+			if code == "" {
+				out.WriteString("\n")
+				continue
+			}
+			relative := width(line[:len(line)-len(strings.TrimLeft(line, " \t"))]) - indent
+			out.WriteString("\n" + base + "\t" + strings.Repeat("\t", relative/8) + strings.Repeat(" ", relative%8) + code)
+		}
+		out.WriteString("\n" + base)
+		edits = append(edits, edit{body.begin, body.end, out.String()})
+	}
+
+	var walk func(node *node32)
+	walk = func(node *node32) {
+		for ; node != nil; node = node.next {
+			switch node.pegRule {
+			case ruleDefinition:
+				alignChoices(node)
+			case ruleRanges, ruleDoubleRanges:
+				normalizeClass(node)
+			case ruleAction:
+				layoutAction(node)
+			case ruleBuild:
+				continue
+			}
+			walk(node.up)
+		}
+	}
+	/* only the rules are walked, leaving the declarations alone */
+	if grammar := p.AST(); grammar != nil {
+		for definition := grammar.up; definition != nil; definition = definition.next {
+			if definition.pegRule == ruleDefinition {
+				walk(&node32{token32: definition.token32, up: definition.up})
+			}
+		}
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].begin < edits[j].begin })
+	var out strings.Builder
+	position := uint32(0)
+	for _, e := range edits {
+		if e.begin < position {
+			continue
+		}
+		out.WriteString(string(buffer[position:e.begin]))
+		out.WriteString(e.text)
+		position = e.end
+	}
+	out.WriteString(string(buffer[position:]))
+	return out.String()
+}
+
+// width returns the number of columns of the blanks s, with tabs every 8
+// columns.
+func width(s string) int {
+	column := 0
+	for _, r := range s {
+		if r == '\t' {
+			column += 8 - column%8
+		} else {
+			column++
+		}
+	}
+	return column
+}
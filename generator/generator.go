@@ -0,0 +1,138 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package generator generates parsers from grammars like the peg command, for
+// go generate wrappers and build tools which would otherwise run peg and parse
+// its output. Its parser of grammars, generated from peg.peg, is the one the
+// peg command uses.
+package generator
+
+import (
+	"bytes"
+
+	"github.com/pointlander/peg/tree"
+)
+
+//go:generate go run .. -inline -switch -expected -output peg.peg.go ../peg.peg
+
+// Options are the options of the peg command which Generate accepts.
+type Options struct {
+	// Inline, Switch, NoAST, Captures, CompactMemo, Bytes, Typed, NoPrint,
+	// Lines, Trace, Watchdog, Iterative, Incremental, Streaming, Concurrent,
+	// Substitution, Tolerant, Expected, NoMemoFailures, NoMemoSuccesses,
+	// Memo, Strict and Package are the flags of the same names.
+	Inline, Switch, NoAST, Captures bool
+	CompactMemo, Bytes, Typed       bool
+	NoPrint, Lines, Trace           bool
+	Watchdog, Iterative             bool
+	Incremental, Streaming          bool
+	Concurrent                      bool
+	Substitution                    bool
+	Tolerant, Expected              bool
+	NoMemoFailures, NoMemoSuccesses bool
+	Memo                            string
+	Strict                          bool
+	Package                         string
+	// DisabledWarnings and ErrorWarnings are the names of the warnings
+	// disabled, or treated as errors, like with -W.
+	DisabledWarnings, ErrorWarnings map[string]bool
+	// DisabledPasses are the names of the optimization passes skipped, like
+	// with -fno-<pass>.
+	DisabledPasses map[string]bool
+	// LoopRecursion runs the loop-recursion pass, which changes the syntax
+	// tree, like -O2.
+	LoopRecursion bool
+	// Warn receives the warnings which aren't treated as errors, which are
+	// dropped if it is nil.
+	Warn func(warning error)
+	// Dir is the directory the files given with %include are relative
+	// to, the current directory if empty.
+	Dir string
+	// File is the name of the generated file, in the positions of the
+	// errors found in the generated code.
+	File string
+	// Grammar is the name of the grammar file in the table of rules of the
+	// generated code.
+	Grammar string
+	// LineDirectives marks the actions with line directives pointing at
+	// Grammar, relative to the generated file, like -line-directives.
+	LineDirectives bool
+	// Args is the command line recorded in the generated code, starting
+	// with the name of the program, or just peg if empty.
+	Args []string
+}
+
+// Parse parses the grammar in src, and the files it includes with %include
+// relative to opts.Dir, into a parser whose tree is set up to compile it with
+// opts.
+func Parse(src []byte, opts Options) (*Peg, error) {
+	p := &Peg{Tree: tree.New(opts.Inline, opts.Switch, opts.NoAST || opts.Captures), Buffer: string(src)}
+	p.Strict = opts.Strict
+	p.Quiet = true
+	p.Report = opts.Warn
+	p.DisabledWarnings, p.ErrorWarnings = opts.DisabledWarnings, opts.ErrorWarnings
+	p.Package = opts.Package
+	p.DisabledPasses = opts.DisabledPasses
+	p.LoopRecursion = opts.LoopRecursion
+	p.CompactMemo = opts.CompactMemo
+	p.Captures = opts.Captures
+	p.Bytes = opts.Bytes
+	p.Typed = opts.Typed
+	p.NoPrint = opts.NoPrint
+	p.Lines = opts.Lines
+	p.Trace = opts.Trace
+	p.Watchdog = opts.Watchdog
+	p.Iterative = opts.Iterative
+	p.Incremental = opts.Incremental
+	p.Streaming = opts.Streaming
+	p.Concurrent = opts.Concurrent
+	p.Substitution = opts.Substitution
+	p.Tolerant = opts.Tolerant
+	p.Expected = opts.Expected
+	p.NoMemoFailures, p.NoMemoSuccesses = opts.NoMemoFailures, opts.NoMemoSuccesses
+	p.Memo = opts.Memo
+	p.GrammarFile = opts.Grammar
+	if opts.LineDirectives {
+		p.LineFile = opts.Grammar
+	}
+	_ = p.Init(Pretty(true), Size(1<<15))
+	if err := p.Parse(); err != nil {
+		return nil, err
+	}
+	p.Execute()
+	err := p.Include(opts.Dir, func(buffer string) (*tree.Tree, error) {
+		q, err := Parse([]byte(buffer), Options{})
+		if err != nil {
+			return nil, err
+		}
+		return q.Tree, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Generate returns the Go parser generated from the grammar in src. The
+// options given in the grammar with peg:flags comments are ignored, as opts
+// gives them all.
+func Generate(src []byte, opts Options) ([]byte, error) {
+	p, err := Parse(src, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	file, args := opts.File, opts.Args
+	if file == "" {
+		file = "peg.go"
+	}
+	if len(args) == 0 {
+		args = []string{"peg"}
+	}
+	out := &bytes.Buffer{}
+	if err = p.Compile(file, args, out); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pointlander/peg/tree"
+)
+
+func TestGenerate(t *testing.T) {
+	buffer, err := os.ReadFile("../peg.peg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := Generate(buffer, Options{
+		Inline:   true,
+		Switch:   true,
+		Expected: true,
+		File:     "peg.peg.go",
+		Grammar:  "peg.peg",
+		Args:     []string{"peg", "-inline", "-switch", "-expected", "-output", "peg.peg.go", "../peg.peg"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := os.ReadFile("peg.peg.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, generated) {
+		t.Error("code generated from ../peg.peg is not the same as peg.peg.go")
+	}
+}
+
+func TestGenerateProblems(t *testing.T) {
+	grammar := []byte(`package main
+type T Peg {}
+a <- 'a' !.
+b <- 'b'
+`)
+	var warnings []error
+	out, err := Generate(grammar, Options{Warn: func(warning error) { warnings = append(warnings, warning) }})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(out, []byte("// Code generated by peg. DO NOT EDIT.")) {
+		t.Errorf("got %q, expected the header of the generated code", out[:min(len(out), 64)])
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Error(), "rule 'b' defined but not used") {
+		t.Errorf("got %v, expected the unused rule 'b'", warnings)
+	}
+
+	if _, err = Generate(grammar, Options{ErrorWarnings: map[string]bool{tree.WarnUnused: true}}); err == nil {
+		t.Error("expected the unused rule 'b' to be an error")
+	}
+	if _, err = Generate([]byte("package main\ntype T Peg {}\na <- 'a\n"), Options{}); err == nil {
+		t.Error("expected a parse error")
+	}
+}
+
+func TestGenerateLineDirectives(t *testing.T) {
+	grammar := []byte("package main\ntype T Peg {}\na <- 'a' { _ = 1 } !.\n")
+	out, err := Generate(grammar, Options{File: "t.peg.go", Grammar: "t.peg", LineDirectives: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"\n//line t.peg:3\n", "\n//line t.peg.go:"} {
+		if !bytes.Contains(out, []byte(expected)) {
+			t.Errorf("%q missing from\n%s", expected, out)
+		}
+	}
+}
+
+func TestByteOffset(t *testing.T) {
+	buffer := "package p\n# größer\ntype T Peg {}\nStart <- 'ä' !.\n"
+	p := &Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	for node := p.AST().up; node != nil; node = node.next {
+		if node.pegRule != ruleDefinition {
+			continue
+		}
+		expected := string([]rune(buffer)[node.begin:node.end])
+		text := buffer[p.ByteOffset(int(node.begin)):p.ByteOffset(int(node.end))]
+		if text != expected || !strings.HasPrefix(text, "Start") {
+			t.Fatalf("got %q, expected %q", text, expected)
+		}
+		return
+	}
+	t.Fatal("Definition is missing")
+}
+
+func TestNormalizeCRLF(t *testing.T) {
+	buffer := "package p\r\n# comment\r\ntype T Peg {}\r\nStart <- 'a'\r\n  'b' !.\r\n"
+	parse := func(options ...func(*Peg) error) []token32 {
+		p := &Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+		_ = p.Init(append(options, Size(1<<15))...)
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		return p.Tokens()
+	}
+	expected, tokens := parse(), parse(NormalizeCRLF())
+	if len(tokens) != len(expected) {
+		t.Fatalf("got %d tokens, expected %d", len(tokens), len(expected))
+	}
+	for i := range tokens {
+		if tokens[i] != expected[i] {
+			t.Errorf("got %v, expected %v", tokens[i].String(), expected[i].String())
+		}
+	}
+}
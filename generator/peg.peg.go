@@ -0,0 +1,7342 @@
+// Code generated by peg -inline -switch -expected -output peg.peg.go ../peg.peg. DO NOT EDIT.
+
+// PE Grammar for PE Grammars
+//
+// Adapted from [1] by Ian Piumarta <first-name at last-name point com>.
+//
+// Best viewed using 140 columns monospaced with tabs every 8.
+//
+// [1] Bryan Ford.  "Parsing Expression Grammars: A Recognition-Based Syntactic
+//     Foundation."  Symposium on Principles of Programming Languages,
+//     January 14--16, 2004, Venice, Italy.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/pointlander/peg/tree"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	ruleGrammar
+	ruleDirective
+	ruleImport
+	ruleSingleImport
+	ruleMultiImport
+	ruleImportName
+	ruleDefinition
+	ruleBuild
+	ruleExpression
+	ruleSequence
+	rulePrefix
+	ruleHint
+	ruleSuffix
+	rulePrimary
+	ruleIdentifier
+	ruleIdentStart
+	ruleIdentCont
+	ruleLiteral
+	ruleLiteralBody
+	ruleClass
+	ruleRanges
+	ruleDoubleRanges
+	ruleRange
+	ruleDoubleRange
+	ruleProperty
+	ruleChar
+	ruleLiteralChar
+	ruleRawChar
+	ruleDoubleChar
+	ruleEscape
+	ruleHexDigit
+	ruleLeftArrow
+	ruleSlash
+	ruleAnd
+	ruleNot
+	ruleQuestion
+	ruleStar
+	rulePlus
+	ruleOpen
+	ruleClose
+	ruleDot
+	ruleSpaceComment
+	ruleSpacing
+	ruleMustSpacing
+	ruleComment
+	ruleSpace
+	ruleHeader
+	ruleHeaderSpaceComment
+	ruleHeaderComment
+	ruleEndOfLine
+	ruleEndOfFile
+	ruleAction
+	ruleActionBody
+	ruleKeywordSet
+	ruleKeywordName
+	ruleRecover
+	ruleInSet
+	ruleInBody
+	ruleBegin
+	ruleEnd
+	ruleAction0
+	ruleAction1
+	ruleAction2
+	ruleAction3
+	ruleAction4
+	ruleAction5
+	rulePegText
+	ruleAction6
+	ruleAction7
+	ruleAction8
+	ruleAction9
+	ruleAction10
+	ruleAction11
+	ruleAction12
+	ruleAction13
+	ruleAction14
+	ruleAction15
+	ruleAction16
+	ruleAction17
+	ruleAction18
+	ruleAction19
+	ruleAction20
+	ruleAction21
+	ruleAction22
+	ruleAction23
+	ruleAction24
+	ruleAction25
+	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
+	ruleAction37
+	ruleAction38
+	ruleAction39
+	ruleAction40
+	ruleAction41
+	ruleAction42
+	ruleAction43
+	ruleAction44
+	ruleAction45
+	ruleAction46
+	ruleAction47
+	ruleAction48
+	ruleAction49
+	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
+	ruleAction69
+	ruleAction70
+	ruleAction71
+	ruleAction72
+	ruleAction73
+	ruleAction74
+	ruleAction75
+	ruleAction76
+	ruleAction77
+	ruleAction78
+	ruleAction79
+	ruleAction80
+	ruleAction81
+	ruleAction82
+	ruleAction83
+	ruleAction84
+	ruleAction85
+	ruleAction86
+	ruleAction87
+	ruleAction88
+	ruleAction89
+	ruleAction90
+	ruleAction91
+	ruleAction92
+	ruleAction93
+	ruleAction94
+	ruleAction95
+	ruleAction96
+	ruleAction97
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"Grammar",
+	"Directive",
+	"Import",
+	"SingleImport",
+	"MultiImport",
+	"ImportName",
+	"Definition",
+	"Build",
+	"Expression",
+	"Sequence",
+	"Prefix",
+	"Hint",
+	"Suffix",
+	"Primary",
+	"Identifier",
+	"IdentStart",
+	"IdentCont",
+	"Literal",
+	"LiteralBody",
+	"Class",
+	"Ranges",
+	"DoubleRanges",
+	"Range",
+	"DoubleRange",
+	"Property",
+	"Char",
+	"LiteralChar",
+	"RawChar",
+	"DoubleChar",
+	"Escape",
+	"HexDigit",
+	"LeftArrow",
+	"Slash",
+	"And",
+	"Not",
+	"Question",
+	"Star",
+	"Plus",
+	"Open",
+	"Close",
+	"Dot",
+	"SpaceComment",
+	"Spacing",
+	"MustSpacing",
+	"Comment",
+	"Space",
+	"Header",
+	"HeaderSpaceComment",
+	"HeaderComment",
+	"EndOfLine",
+	"EndOfFile",
+	"Action",
+	"ActionBody",
+	"KeywordSet",
+	"KeywordName",
+	"Recover",
+	"InSet",
+	"InBody",
+	"Begin",
+	"End",
+	"Action0",
+	"Action1",
+	"Action2",
+	"Action3",
+	"Action4",
+	"Action5",
+	"PegText",
+	"Action6",
+	"Action7",
+	"Action8",
+	"Action9",
+	"Action10",
+	"Action11",
+	"Action12",
+	"Action13",
+	"Action14",
+	"Action15",
+	"Action16",
+	"Action17",
+	"Action18",
+	"Action19",
+	"Action20",
+	"Action21",
+	"Action22",
+	"Action23",
+	"Action24",
+	"Action25",
+	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
+	"Action37",
+	"Action38",
+	"Action39",
+	"Action40",
+	"Action41",
+	"Action42",
+	"Action43",
+	"Action44",
+	"Action45",
+	"Action46",
+	"Action47",
+	"Action48",
+	"Action49",
+	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
+	"Action69",
+	"Action70",
+	"Action71",
+	"Action72",
+	"Action73",
+	"Action74",
+	"Action75",
+	"Action76",
+	"Action77",
+	"Action78",
+	"Action79",
+	"Action80",
+	"Action81",
+	"Action82",
+	"Action83",
+	"Action84",
+	"Action85",
+	"Action86",
+	"Action87",
+	"Action88",
+	"Action89",
+	"Action90",
+	"Action91",
+	"Action92",
+	"Action93",
+	"Action94",
+	"Action95",
+	"Action96",
+	"Action97",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"Grammar", "peg.peg", 22, 27, false, false},
+	{"Directive", "peg.peg", 29, 70, false, true},
+	{"Import", "peg.peg", 72, 72, false, true},
+	{"SingleImport", "peg.peg", 73, 73, false, false},
+	{"MultiImport", "peg.peg", 74, 74, false, false},
+	{"ImportName", "peg.peg", 76, 76, true, false},
+	{"Definition", "peg.peg", 78, 79, false, true},
+	{"Build", "peg.peg", 80, 81, false, true},
+	{"Expression", "peg.peg", 82, 85, false, false},
+	{"Sequence", "peg.peg", 86, 87, false, false},
+	{"Prefix", "peg.peg", 88, 95, false, false},
+	{"Hint", "peg.peg", 96, 96, false, true},
+	{"Suffix", "peg.peg", 97, 100, false, false},
+	{"Primary", "peg.peg", 101, 109, false, true},
+	{"Identifier", "peg.peg", 114, 114, false, false},
+	{"IdentStart", "peg.peg", 115, 115, true, false},
+	{"IdentCont", "peg.peg", 116, 116, false, false},
+	{"Literal", "peg.peg", 117, 117, false, true},
+	{"LiteralBody", "peg.peg", 118, 127, false, true},
+	{"Class", "peg.peg", 128, 134, false, false},
+	{"Ranges", "peg.peg", 135, 136, false, false},
+	{"DoubleRanges", "peg.peg", 137, 138, false, false},
+	{"Range", "peg.peg", 139, 141, false, false},
+	{"DoubleRange", "peg.peg", 142, 144, false, false},
+	{"Property", "peg.peg", 145, 145, true, false},
+	{"Char", "peg.peg", 146, 147, false, false},
+	{"LiteralChar", "peg.peg", 148, 149, false, false},
+	{"RawChar", "peg.peg", 150, 150, true, false},
+	{"DoubleChar", "peg.peg", 151, 152, false, false},
+	{"Escape", "peg.peg", 153, 174, false, false},
+	{"HexDigit", "peg.peg", 175, 175, true, false},
+	{"LeftArrow", "peg.peg", 176, 176, false, false},
+	{"Slash", "peg.peg", 177, 177, false, false},
+	{"And", "peg.peg", 178, 178, false, false},
+	{"Not", "peg.peg", 179, 179, false, false},
+	{"Question", "peg.peg", 180, 180, false, true},
+	{"Star", "peg.peg", 181, 181, false, true},
+	{"Plus", "peg.peg", 182, 182, false, true},
+	{"Open", "peg.peg", 183, 183, false, false},
+	{"Close", "peg.peg", 184, 184, false, false},
+	{"Dot", "peg.peg", 185, 185, false, true},
+	{"SpaceComment", "peg.peg", 186, 186, false, false},
+	{"Spacing", "peg.peg", 187, 187, false, false},
+	{"MustSpacing", "peg.peg", 188, 188, false, false},
+	{"Comment", "peg.peg", 189, 189, false, true},
+	{"Space", "peg.peg", 190, 190, false, false},
+	{"Header", "peg.peg", 191, 191, false, true},
+	{"HeaderSpaceComment", "peg.peg", 192, 192, false, true},
+	{"HeaderComment", "peg.peg", 193, 193, false, true},
+	{"EndOfLine", "peg.peg", 194, 194, true, false},
+	{"EndOfFile", "peg.peg", 195, 195, true, true},
+	{"Action", "peg.peg", 196, 196, false, false},
+	{"ActionBody", "peg.peg", 197, 197, false, false},
+	{"KeywordSet", "peg.peg", 198, 199, false, true},
+	{"KeywordName", "peg.peg", 200, 201, false, false},
+	{"Recover", "peg.peg", 202, 202, false, true},
+	{"InSet", "peg.peg", 203, 203, false, false},
+	{"InBody", "peg.peg", 204, 204, false, false},
+	{"Begin", "peg.peg", 205, 205, false, true},
+	{"End", "peg.peg", 206, 206, false, true},
+	{"Action0", "", 0, 0, true, true},
+	{"Action1", "", 0, 0, true, true},
+	{"Action2", "", 0, 0, true, true},
+	{"Action3", "", 0, 0, true, true},
+	{"Action4", "", 0, 0, true, true},
+	{"Action5", "", 0, 0, true, true},
+	{"PegText", "", 0, 0, true, false},
+	{"Action6", "", 0, 0, true, true},
+	{"Action7", "", 0, 0, true, true},
+	{"Action8", "", 0, 0, true, true},
+	{"Action9", "", 0, 0, true, true},
+	{"Action10", "", 0, 0, true, true},
+	{"Action11", "", 0, 0, true, true},
+	{"Action12", "", 0, 0, true, true},
+	{"Action13", "", 0, 0, true, true},
+	{"Action14", "", 0, 0, true, true},
+	{"Action15", "", 0, 0, true, true},
+	{"Action16", "", 0, 0, true, true},
+	{"Action17", "", 0, 0, true, true},
+	{"Action18", "", 0, 0, true, true},
+	{"Action19", "", 0, 0, true, true},
+	{"Action20", "", 0, 0, true, true},
+	{"Action21", "", 0, 0, true, true},
+	{"Action22", "", 0, 0, true, true},
+	{"Action23", "", 0, 0, true, true},
+	{"Action24", "", 0, 0, true, true},
+	{"Action25", "", 0, 0, true, true},
+	{"Action26", "", 0, 0, true, true},
+	{"Action27", "", 0, 0, true, true},
+	{"Action28", "", 0, 0, true, true},
+	{"Action29", "", 0, 0, true, true},
+	{"Action30", "", 0, 0, true, true},
+	{"Action31", "", 0, 0, true, true},
+	{"Action32", "", 0, 0, true, true},
+	{"Action33", "", 0, 0, true, true},
+	{"Action34", "", 0, 0, true, true},
+	{"Action35", "", 0, 0, true, true},
+	{"Action36", "", 0, 0, true, true},
+	{"Action37", "", 0, 0, true, true},
+	{"Action38", "", 0, 0, true, true},
+	{"Action39", "", 0, 0, true, true},
+	{"Action40", "", 0, 0, true, true},
+	{"Action41", "", 0, 0, true, true},
+	{"Action42", "", 0, 0, true, true},
+	{"Action43", "", 0, 0, true, false},
+	{"Action44", "", 0, 0, true, false},
+	{"Action45", "", 0, 0, true, true},
+	{"Action46", "", 0, 0, true, true},
+	{"Action47", "", 0, 0, true, true},
+	{"Action48", "", 0, 0, true, true},
+	{"Action49", "", 0, 0, true, true},
+	{"Action50", "", 0, 0, true, true},
+	{"Action51", "", 0, 0, true, true},
+	{"Action52", "", 0, 0, true, true},
+	{"Action53", "", 0, 0, true, true},
+	{"Action54", "", 0, 0, true, true},
+	{"Action55", "", 0, 0, true, true},
+	{"Action56", "", 0, 0, true, true},
+	{"Action57", "", 0, 0, true, true},
+	{"Action58", "", 0, 0, true, true},
+	{"Action59", "", 0, 0, true, true},
+	{"Action60", "", 0, 0, true, true},
+	{"Action61", "", 0, 0, true, true},
+	{"Action62", "", 0, 0, true, true},
+	{"Action63", "", 0, 0, true, true},
+	{"Action64", "", 0, 0, true, true},
+	{"Action65", "", 0, 0, true, true},
+	{"Action66", "", 0, 0, true, true},
+	{"Action67", "", 0, 0, true, true},
+	{"Action68", "", 0, 0, true, true},
+	{"Action69", "", 0, 0, true, true},
+	{"Action70", "", 0, 0, true, true},
+	{"Action71", "", 0, 0, true, true},
+	{"Action72", "", 0, 0, true, true},
+	{"Action73", "", 0, 0, true, true},
+	{"Action74", "", 0, 0, true, true},
+	{"Action75", "", 0, 0, true, true},
+	{"Action76", "", 0, 0, true, true},
+	{"Action77", "", 0, 0, true, true},
+	{"Action78", "", 0, 0, true, true},
+	{"Action79", "", 0, 0, true, true},
+	{"Action80", "", 0, 0, true, true},
+	{"Action81", "", 0, 0, true, true},
+	{"Action82", "", 0, 0, true, true},
+	{"Action83", "", 0, 0, true, true},
+	{"Action84", "", 0, 0, true, true},
+	{"Action85", "", 0, 0, true, true},
+	{"Action86", "", 0, 0, true, true},
+	{"Action87", "", 0, 0, true, true},
+	{"Action88", "", 0, 0, true, true},
+	{"Action89", "", 0, 0, true, true},
+	{"Action90", "", 0, 0, true, true},
+	{"Action91", "", 0, 0, true, true},
+	{"Action92", "", 0, 0, true, true},
+	{"Action93", "", 0, 0, true, true},
+	{"Action94", "", 0, 0, true, true},
+	{"Action95", "", 0, 0, true, true},
+	{"Action96", "", 0, 0, true, true},
+	{"Action97", "", 0, 0, true, true},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type Peg struct {
+	*tree.Tree
+
+	Buffer         string
+	buffer         []rune
+	rules          [160]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*Peg) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *Peg) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *Peg) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *Peg) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *Peg) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *Peg) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *Peg) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *Peg) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *Peg) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// Completions returns the terminals which could continue the first offset
+// runes of the buffer. The parser is reset afterwards.
+func (p *Peg) Completions(offset int) []string {
+	buffer := p.Buffer
+	defer func() {
+		p.Buffer = buffer
+		p.Reset()
+	}()
+	if runes := []rune(buffer); offset < len(runes) {
+		p.Buffer = string(runes[:offset])
+	}
+	p.Reset()
+	_ = p.Parse()
+	if int(p.farthest) != len([]rune(p.Buffer)) {
+		return nil
+	}
+	return p.expectations()
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *Peg) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// ParsePartial parses like Parse, but if the input is invalid or incomplete
+// the syntax tree holds the rules matched before the farthest failure, below
+// the start rule, and the terminals expected at the failure are returned.
+func (p *Peg) ParsePartial(rule ...int) ([]string, error) {
+	p.partial = true
+	defer func() {
+		p.partial = false
+	}()
+	err := p.Parse(rule...)
+	if err == nil {
+		return nil, nil
+	}
+	r := 1
+	if len(rule) > 0 {
+		r = rule[0]
+	}
+	tokens, end := p.partialTokens, uint32(0)
+	for _, token := range tokens {
+		if token.end > end {
+			end = token.end
+		}
+	}
+	if end > 0 {
+		tokens = append(tokens, token32{pegRule: pegRule(r), begin: 0, end: end})
+	}
+	p.tokens32.tree, p.partialTokens = tokens, nil
+	return p.expectations(), err
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *Peg
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *Peg) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *Peg
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *Peg) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *Peg) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *Peg) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func (p *Peg) Execute() {
+	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
+	for _, token := range p.Tokens() {
+		switch token.pegRule {
+
+		case rulePegText:
+			begin, end = int(token.begin), int(token.end)
+			text = string(_buffer[begin:end])
+
+		case ruleAction0:
+			p.AddPackage(text)
+		case ruleAction1:
+			p.AddPeg(text)
+		case ruleAction2:
+			p.AddState(text)
+		case ruleAction3:
+			p.AddCaseInsensitive(text)
+		case ruleAction4:
+			p.SetCaseInsensitive()
+		case ruleAction5:
+			p.SetWord()
+		case ruleAction6:
+			p.SetNoMemo(text)
+		case ruleAction7:
+			p.AddNoMemo(text)
+		case ruleAction8:
+			p.AddMemo(text)
+		case ruleAction9:
+			p.SetMemoKey(text)
+		case ruleAction10:
+			p.AddMemoKey(text)
+		case ruleAction11:
+			p.AddRecovery(text)
+		case ruleAction12:
+			p.AddKind(text)
+		case ruleAction13:
+			p.SetKindConstant(text)
+		case ruleAction14:
+			p.AddBench(text)
+		case ruleAction15:
+			p.SetBenchSample(text)
+		case ruleAction16:
+			p.SetBenchFile(text)
+		case ruleAction17:
+			p.AddAccept(text)
+		case ruleAction18:
+			p.SetTestInput(text)
+		case ruleAction19:
+			p.AddReject(text)
+		case ruleAction20:
+			p.SetTestInput(text)
+		case ruleAction21:
+			p.SetTestPosition(text)
+		case ruleAction22:
+			p.AddSample(text)
+		case ruleAction23:
+			p.AddSampleFile(text)
+		case ruleAction24:
+			p.SetErrorType(text)
+		case ruleAction25:
+			p.SetErrorFields(text)
+		case ruleAction26:
+			p.SetStateFields(text)
+		case ruleAction27:
+			p.AddInclude(text)
+		case ruleAction28:
+			p.AddRename(text)
+		case ruleAction29:
+			p.SetRename(text)
+		case ruleAction30:
+			p.AddImport(text)
+		case ruleAction31:
+			p.AddRuleAt(buffer, begin, text)
+		case ruleAction32:
+			p.AddExpression()
+		case ruleAction33:
+			p.AddBuild(text)
+		case ruleAction34:
+			p.SetBuildFields(text)
+		case ruleAction35:
+			p.AddAlternate()
+		case ruleAction36:
+			p.AddNil()
+			p.AddAlternate()
+		case ruleAction37:
+			p.AddNil()
+		case ruleAction38:
+			p.AddSequence()
+		case ruleAction39:
+			p.AddPredicate(text)
+		case ruleAction40:
+			p.AddStateChange(text)
+		case ruleAction41:
+			p.AddIn(text)
+		case ruleAction42:
+			p.AddIn(text)
+			p.AddPeekNot()
+		case ruleAction43:
+			p.AddPeekFor()
+		case ruleAction44:
+			p.AddPeekNot()
+		case ruleAction45:
+			p.AddHint(buffer, begin, text)
+		case ruleAction46:
+			p.AddQuery()
+		case ruleAction47:
+			p.AddStar()
+		case ruleAction48:
+			p.AddPlus()
+		case ruleAction49:
+			p.AddName(text)
+		case ruleAction50:
+			p.AddDot()
+		case ruleAction51:
+			p.AddActionAt(buffer, begin, text)
+		case ruleAction52:
+			p.AddPush()
+		case ruleAction53:
+			p.AddLiteral()
+			p.AddWordBoundary()
+		case ruleAction54:
+			p.AddSequence()
+		case ruleAction55:
+			p.AddSequence()
+		case ruleAction56:
+			p.AddSequence()
+		case ruleAction57:
+			p.AddSequence()
+		case ruleAction58:
+			p.AddSequence()
+		case ruleAction59:
+			p.AddNotClass()
+		case ruleAction60:
+			p.AddNotClass()
+		case ruleAction61:
+			p.AddAlternate()
+		case ruleAction62:
+			p.AddAlternate()
+		case ruleAction63:
+			p.AddRange()
+		case ruleAction64:
+			p.AddDoubleRange()
+		case ruleAction65:
+			p.AddProperty(buffer, begin, text)
+		case ruleAction66:
+			p.AddCharacter(text)
+		case ruleAction67:
+			p.AddLiteralCharacter(text)
+		case ruleAction68:
+			p.AddCharacter(text)
+		case ruleAction69:
+			p.AddDoubleCharacter(text)
+		case ruleAction70:
+			p.AddCharacter("\a")
+		case ruleAction71:
+			p.AddCharacter("\b")
+		case ruleAction72:
+			p.AddCharacter("\x1B")
+		case ruleAction73:
+			p.AddCharacter("\f")
+		case ruleAction74:
+			p.AddCharacter("\n")
+		case ruleAction75:
+			p.AddCharacter("\r")
+		case ruleAction76:
+			p.AddCharacter("\t")
+		case ruleAction77:
+			p.AddCharacter("\v")
+		case ruleAction78:
+			p.AddCharacter("'")
+		case ruleAction79:
+			p.AddCharacter("\"")
+		case ruleAction80:
+			p.AddCharacter("[")
+		case ruleAction81:
+			p.AddCharacter("]")
+		case ruleAction82:
+			p.AddCharacter("-")
+		case ruleAction83:
+			p.AddUnicodeCharacter(buffer, begin, text)
+		case ruleAction84:
+			p.AddHexaCharacter(text)
+		case ruleAction85:
+			p.AddUnicodeCharacter(buffer, begin, text)
+		case ruleAction86:
+			p.AddUnicodeCharacter(buffer, begin, text)
+		case ruleAction87:
+			p.AddHexaCharacter(text)
+		case ruleAction88:
+			p.AddOctalCharacter(text)
+		case ruleAction89:
+			p.AddOctalCharacter(text)
+		case ruleAction90:
+			p.AddCharacter("\\")
+		case ruleAction91:
+			p.AddInvalidEscape(buffer, begin, text)
+		case ruleAction92:
+			p.AddSpace(text)
+		case ruleAction93:
+			p.AddComment(text)
+		case ruleAction94:
+			p.AddAlternate()
+		case ruleAction95:
+			p.AddKeyword(text)
+		case ruleAction96:
+			p.AddKeyword(text)
+		case ruleAction97:
+			p.AddRecover()
+
+		}
+	}
+	_, _, _, _, _ = buffer, _buffer, text, begin, end
+}
+
+func Pretty(pretty bool) func(*Peg) error {
+	return func(p *Peg) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*Peg) error {
+	return func(p *Peg) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*Peg) error {
+	return func(p *Peg) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*Peg) error {
+	return func(p *Peg) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*Peg) error {
+	return func(p *Peg) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+// TrackRules records the rules being matched, so that the Rules of a
+// SyntaxError name the rules at the farthest failure. Like MaxDepth it
+// slows every rule down a little.
+func TrackRules() func(*Peg) error {
+	return func(p *Peg) error {
+		p.trackRules = true
+		return nil
+	}
+}
+func Size(size int) func(*Peg) error {
+	return func(p *Peg) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*Peg) error {
+	return func(p *Peg) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *Peg) Init(options ...func(*Peg) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	fail := func(expected string) {
+		if position > p.farthest {
+			p.farthest, p.expected = position, p.expected[:0]
+			p.farthestHint = ""
+		}
+		if position == p.farthest {
+			if p.trackRules && len(p.expected) == 0 {
+				p.farthestRules = append(p.farthestRules[:0], stack...)
+			}
+			p.expected = append(p.expected, expected)
+			if p.partial {
+				p.partialTokens = append(p.partialTokens[:0], tree.tree[:tokenIndex]...)
+			}
+		}
+	}
+	_ = fail
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 Grammar <- <(Header ('p' 'a' 'c' 'k' 'a' 'g' 'e' MustSpacing Identifier Action0 Import* ('t' 'y' 'p' 'e') MustSpacing Identifier Action1 ('P' 'e' 'g') Spacing Action Action2 Directive*)? Definition+ EndOfFile)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				{
+					position2 := position
+				l3:
+					{
+						position4, tokenIndex4 := position, tokenIndex
+						{
+							position5 := position
+							{
+								position6, tokenIndex6 := position, tokenIndex
+								{
+									position8 := position
+									{
+										position9, tokenIndex9 := position, tokenIndex
+										if buffer[position] != rune('#') {
+											fail("'#'")
+											goto l10
+										}
+										position++
+										goto l9
+									l10:
+										position, tokenIndex = position9, tokenIndex9
+										if buffer[position] != rune('/') {
+											fail("'//'")
+											goto l7
+										}
+										position++
+										if buffer[position] != rune('/') {
+											fail("'/'")
+											goto l7
+										}
+										position++
+									}
+								l9:
+									{
+										position11 := position
+									l12:
+										{
+											position13, tokenIndex13 := position, tokenIndex
+											{
+												position14, tokenIndex14 := position, tokenIndex
+												if !_rules[ruleEndOfLine]() {
+													goto l14
+												}
+												goto l13
+											l14:
+												position, tokenIndex = position14, tokenIndex14
+											}
+											if !matchDot() {
+												fail(".")
+												goto l13
+											}
+											goto l12
+										l13:
+											position, tokenIndex = position13, tokenIndex13
+										}
+										add(rulePegText, position11)
+									}
+									{
+										add(ruleAction93, position)
+									}
+									if !_rules[ruleEndOfLine]() {
+										goto l7
+									}
+									add(ruleHeaderComment, position8)
+								}
+								goto l6
+							l7:
+								position, tokenIndex = position6, tokenIndex6
+								{
+									position16 := position
+									if !_rules[ruleSpace]() {
+										goto l4
+									}
+								l17:
+									{
+										position18, tokenIndex18 := position, tokenIndex
+										if !_rules[ruleSpace]() {
+											goto l18
+										}
+										goto l17
+									l18:
+										position, tokenIndex = position18, tokenIndex18
+									}
+									add(rulePegText, position16)
+								}
+								{
+									add(ruleAction92, position)
+								}
+							}
+						l6:
+							add(ruleHeaderSpaceComment, position5)
+						}
+						goto l3
+					l4:
+						position, tokenIndex = position4, tokenIndex4
+					}
+					add(ruleHeader, position2)
+				}
+				{
+					position20, tokenIndex20 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						fail("'package'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('a') {
+						fail("'a'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('c') {
+						fail("'c'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('k') {
+						fail("'k'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('a') {
+						fail("'a'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('g') {
+						fail("'g'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('e') {
+						fail("'e'")
+						goto l20
+					}
+					position++
+					if !_rules[ruleMustSpacing]() {
+						goto l20
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l20
+					}
+					{
+						add(ruleAction0, position)
+					}
+				l23:
+					{
+						position24, tokenIndex24 := position, tokenIndex
+						{
+							position25 := position
+							if buffer[position] != rune('i') {
+								fail("'import'")
+								goto l24
+							}
+							position++
+							if buffer[position] != rune('m') {
+								fail("'m'")
+								goto l24
+							}
+							position++
+							if buffer[position] != rune('p') {
+								fail("'p'")
+								goto l24
+							}
+							position++
+							if buffer[position] != rune('o') {
+								fail("'o'")
+								goto l24
+							}
+							position++
+							if buffer[position] != rune('r') {
+								fail("'r'")
+								goto l24
+							}
+							position++
+							if buffer[position] != rune('t') {
+								fail("'t'")
+								goto l24
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l24
+							}
+							{
+								position26, tokenIndex26 := position, tokenIndex
+								if !_rules[ruleMultiImport]() {
+									goto l27
+								}
+								goto l26
+							l27:
+								position, tokenIndex = position26, tokenIndex26
+								if !_rules[ruleSingleImport]() {
+									goto l24
+								}
+							}
+						l26:
+							if !_rules[ruleSpacing]() {
+								goto l24
+							}
+							add(ruleImport, position25)
+						}
+						goto l23
+					l24:
+						position, tokenIndex = position24, tokenIndex24
+					}
+					if buffer[position] != rune('t') {
+						fail("'type'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('y') {
+						fail("'y'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('p') {
+						fail("'p'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('e') {
+						fail("'e'")
+						goto l20
+					}
+					position++
+					if !_rules[ruleMustSpacing]() {
+						goto l20
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l20
+					}
+					{
+						add(ruleAction1, position)
+					}
+					if buffer[position] != rune('P') {
+						fail("'Peg'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('e') {
+						fail("'e'")
+						goto l20
+					}
+					position++
+					if buffer[position] != rune('g') {
+						fail("'g'")
+						goto l20
+					}
+					position++
+					if !_rules[ruleSpacing]() {
+						goto l20
+					}
+					if !_rules[ruleAction]() {
+						goto l20
+					}
+					{
+						add(ruleAction2, position)
+					}
+				l30:
+					{
+						position31, tokenIndex31 := position, tokenIndex
+						{
+							position32 := position
+							if buffer[position] != rune('%') {
+								fail("'%'")
+								goto l31
+							}
+							position++
+							{
+								switch buffer[position] {
+								case 'b':
+									fail("'c'")
+									fail("'w'")
+									fail("'n'")
+									fail("'m'")
+									fail("'r'")
+									fail("'a'")
+									fail("'s'")
+									fail("'e'")
+									fail("'i'")
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('n') {
+										fail("'n'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('c') {
+										fail("'c'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('h') {
+										fail("'h'")
+										goto l31
+									}
+									position++
+									{
+										position34, tokenIndex34 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l34
+										}
+										goto l31
+									l34:
+										position, tokenIndex = position34, tokenIndex34
+									}
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l31
+									}
+									{
+										add(ruleAction14, position)
+									}
+									{
+										position36, tokenIndex36 := position, tokenIndex
+										if buffer[position] != rune('`') {
+											fail("'`'")
+											goto l37
+										}
+										position++
+										{
+											position38 := position
+										l39:
+											{
+												position40, tokenIndex40 := position, tokenIndex
+												{
+													position41, tokenIndex41 := position, tokenIndex
+													if buffer[position] != rune('`') {
+														fail("'`'")
+														goto l41
+													}
+													position++
+													goto l40
+												l41:
+													position, tokenIndex = position41, tokenIndex41
+												}
+												if !matchDot() {
+													fail(".")
+													goto l40
+												}
+												goto l39
+											l40:
+												position, tokenIndex = position40, tokenIndex40
+											}
+											add(rulePegText, position38)
+										}
+										if buffer[position] != rune('`') {
+											fail("'`'")
+											goto l37
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l37
+										}
+										{
+											add(ruleAction15, position)
+										}
+										goto l36
+									l37:
+										position, tokenIndex = position36, tokenIndex36
+										if buffer[position] != rune('f') {
+											fail("'file('")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('i') {
+											fail("'i'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('l') {
+											fail("'l'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('(') {
+											fail("'('")
+											goto l31
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										if buffer[position] != rune('"') {
+											fail("'\"'")
+											goto l31
+										}
+										position++
+										{
+											position43 := position
+										l44:
+											{
+												position45, tokenIndex45 := position, tokenIndex
+												{
+													position46, tokenIndex46 := position, tokenIndex
+													if buffer[position] != rune('"') {
+														fail("'\"'")
+														goto l46
+													}
+													position++
+													goto l45
+												l46:
+													position, tokenIndex = position46, tokenIndex46
+												}
+												if !matchDot() {
+													fail(".")
+													goto l45
+												}
+												goto l44
+											l45:
+												position, tokenIndex = position45, tokenIndex45
+											}
+											add(rulePegText, position43)
+										}
+										if buffer[position] != rune('"') {
+											fail("'\"'")
+											goto l31
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										if buffer[position] != rune(')') {
+											fail("')'")
+											goto l31
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										{
+											add(ruleAction16, position)
+										}
+									}
+								l36:
+									break
+								case 'c':
+									fail("'w'")
+									fail("'n'")
+									fail("'m'")
+									fail("'r'")
+									fail("'b'")
+									fail("'a'")
+									fail("'s'")
+									fail("'e'")
+									fail("'i'")
+									position++
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('s') {
+										fail("'s'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('n') {
+										fail("'n'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('s') {
+										fail("'s'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('n') {
+										fail("'n'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('s') {
+										fail("'s'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('v') {
+										fail("'v'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l31
+									}
+									position++
+									{
+										position48, tokenIndex48 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l48
+										}
+										goto l31
+									l48:
+										position, tokenIndex = position48, tokenIndex48
+									}
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									{
+										position49, tokenIndex49 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l50
+										}
+										{
+											position53, tokenIndex53 := position, tokenIndex
+											if !_rules[ruleLeftArrow]() {
+												goto l53
+											}
+											goto l50
+										l53:
+											position, tokenIndex = position53, tokenIndex53
+										}
+										{
+											add(ruleAction3, position)
+										}
+									l51:
+										{
+											position52, tokenIndex52 := position, tokenIndex
+											if !_rules[ruleIdentifier]() {
+												goto l52
+											}
+											{
+												position55, tokenIndex55 := position, tokenIndex
+												if !_rules[ruleLeftArrow]() {
+													goto l55
+												}
+												goto l52
+											l55:
+												position, tokenIndex = position55, tokenIndex55
+											}
+											{
+												add(ruleAction3, position)
+											}
+											goto l51
+										l52:
+											position, tokenIndex = position52, tokenIndex52
+										}
+										goto l49
+									l50:
+										position, tokenIndex = position49, tokenIndex49
+										{
+											add(ruleAction4, position)
+										}
+									}
+								l49:
+									break
+								case 'e':
+									fail("'c'")
+									fail("'w'")
+									fail("'n'")
+									fail("'m'")
+									fail("'r'")
+									fail("'b'")
+									fail("'a'")
+									fail("'s'")
+									fail("'i'")
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('o') {
+										fail("'o'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l31
+									}
+									position++
+									{
+										position58, tokenIndex58 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l58
+										}
+										goto l31
+									l58:
+										position, tokenIndex = position58, tokenIndex58
+									}
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l31
+									}
+									{
+										add(ruleAction24, position)
+									}
+									if !_rules[ruleAction]() {
+										goto l31
+									}
+									{
+										add(ruleAction25, position)
+									}
+								case 'i':
+									fail("'c'")
+									fail("'w'")
+									fail("'n'")
+									fail("'m'")
+									fail("'r'")
+									fail("'b'")
+									fail("'a'")
+									fail("'s'")
+									fail("'e'")
+									position++
+									{
+										position61, tokenIndex61 := position, tokenIndex
+										if buffer[position] != rune('m') {
+											fail("'m'")
+											goto l62
+										}
+										position++
+										if buffer[position] != rune('p') {
+											fail("'p'")
+											goto l62
+										}
+										position++
+										if buffer[position] != rune('o') {
+											fail("'o'")
+											goto l62
+										}
+										position++
+										if buffer[position] != rune('r') {
+											fail("'r'")
+											goto l62
+										}
+										position++
+										if buffer[position] != rune('t') {
+											fail("'t'")
+											goto l62
+										}
+										position++
+										{
+											position63, tokenIndex63 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l63
+											}
+											goto l62
+										l63:
+											position, tokenIndex = position63, tokenIndex63
+										}
+										if !_rules[ruleSpacing]() {
+											goto l62
+										}
+										{
+											position64, tokenIndex64 := position, tokenIndex
+											if !_rules[ruleMultiImport]() {
+												goto l65
+											}
+											goto l64
+										l65:
+											position, tokenIndex = position64, tokenIndex64
+											if !_rules[ruleSingleImport]() {
+												goto l62
+											}
+										}
+									l64:
+										if !_rules[ruleSpacing]() {
+											goto l62
+										}
+										goto l61
+									l62:
+										position, tokenIndex = position61, tokenIndex61
+										if buffer[position] != rune('n') {
+											fail("'n'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('c') {
+											fail("'c'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('l') {
+											fail("'l'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('u') {
+											fail("'u'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('d') {
+											fail("'d'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l31
+										}
+										position++
+										{
+											position66, tokenIndex66 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l66
+											}
+											goto l31
+										l66:
+											position, tokenIndex = position66, tokenIndex66
+										}
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										if buffer[position] != rune('"') {
+											fail("'\"'")
+											goto l31
+										}
+										position++
+										{
+											position67 := position
+										l68:
+											{
+												position69, tokenIndex69 := position, tokenIndex
+												{
+													position70, tokenIndex70 := position, tokenIndex
+													if buffer[position] != rune('"') {
+														fail("'\"'")
+														goto l70
+													}
+													position++
+													goto l69
+												l70:
+													position, tokenIndex = position70, tokenIndex70
+												}
+												if !matchDot() {
+													fail(".")
+													goto l69
+												}
+												goto l68
+											l69:
+												position, tokenIndex = position69, tokenIndex69
+											}
+											add(rulePegText, position67)
+										}
+										if buffer[position] != rune('"') {
+											fail("'\"'")
+											goto l31
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										{
+											add(ruleAction27, position)
+										}
+									l72:
+										{
+											position73, tokenIndex73 := position, tokenIndex
+											if !_rules[ruleIdentifier]() {
+												goto l73
+											}
+											{
+												add(ruleAction28, position)
+											}
+											if buffer[position] != rune('=') {
+												fail("'='")
+												goto l73
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l73
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l73
+											}
+											{
+												add(ruleAction29, position)
+											}
+											goto l72
+										l73:
+											position, tokenIndex = position73, tokenIndex73
+										}
+									}
+								l61:
+									break
+								case 'm':
+									fail("'c'")
+									fail("'w'")
+									fail("'n'")
+									fail("'r'")
+									fail("'b'")
+									fail("'a'")
+									fail("'s'")
+									fail("'e'")
+									fail("'i'")
+									position++
+									{
+										position76, tokenIndex76 := position, tokenIndex
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l77
+										}
+										position++
+										if buffer[position] != rune('m') {
+											fail("'m'")
+											goto l77
+										}
+										position++
+										if buffer[position] != rune('o') {
+											fail("'o'")
+											goto l77
+										}
+										position++
+										{
+											position78, tokenIndex78 := position, tokenIndex
+											{
+												position80, tokenIndex80 := position, tokenIndex
+												if !_rules[ruleIdentCont]() {
+													goto l80
+												}
+												goto l79
+											l80:
+												position, tokenIndex = position80, tokenIndex80
+											}
+											if !_rules[ruleSpacing]() {
+												goto l79
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l79
+											}
+											{
+												position83, tokenIndex83 := position, tokenIndex
+												if !_rules[ruleLeftArrow]() {
+													goto l83
+												}
+												goto l79
+											l83:
+												position, tokenIndex = position83, tokenIndex83
+											}
+											{
+												add(ruleAction8, position)
+											}
+										l81:
+											{
+												position82, tokenIndex82 := position, tokenIndex
+												if !_rules[ruleIdentifier]() {
+													goto l82
+												}
+												{
+													position85, tokenIndex85 := position, tokenIndex
+													if !_rules[ruleLeftArrow]() {
+														goto l85
+													}
+													goto l82
+												l85:
+													position, tokenIndex = position85, tokenIndex85
+												}
+												{
+													add(ruleAction8, position)
+												}
+												goto l81
+											l82:
+												position, tokenIndex = position82, tokenIndex82
+											}
+											goto l78
+										l79:
+											position, tokenIndex = position78, tokenIndex78
+											if buffer[position] != rune('k') {
+												fail("'k'")
+												goto l77
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l77
+											}
+											position++
+											if buffer[position] != rune('y') {
+												fail("'y'")
+												goto l77
+											}
+											position++
+											{
+												position87, tokenIndex87 := position, tokenIndex
+												if !_rules[ruleIdentCont]() {
+													goto l87
+												}
+												goto l77
+											l87:
+												position, tokenIndex = position87, tokenIndex87
+											}
+											if !_rules[ruleSpacing]() {
+												goto l77
+											}
+											if !_rules[ruleAction]() {
+												goto l77
+											}
+											{
+												add(ruleAction9, position)
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l77
+											}
+											{
+												position91, tokenIndex91 := position, tokenIndex
+												if !_rules[ruleLeftArrow]() {
+													goto l91
+												}
+												goto l77
+											l91:
+												position, tokenIndex = position91, tokenIndex91
+											}
+											{
+												add(ruleAction10, position)
+											}
+										l89:
+											{
+												position90, tokenIndex90 := position, tokenIndex
+												if !_rules[ruleIdentifier]() {
+													goto l90
+												}
+												{
+													position93, tokenIndex93 := position, tokenIndex
+													if !_rules[ruleLeftArrow]() {
+														goto l93
+													}
+													goto l90
+												l93:
+													position, tokenIndex = position93, tokenIndex93
+												}
+												{
+													add(ruleAction10, position)
+												}
+												goto l89
+											l90:
+												position, tokenIndex = position90, tokenIndex90
+											}
+										}
+									l78:
+										goto l76
+									l77:
+										position, tokenIndex = position76, tokenIndex76
+										if buffer[position] != rune('a') {
+											fail("'a'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('p') {
+											fail("'p'")
+											goto l31
+										}
+										position++
+										{
+											position95, tokenIndex95 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l95
+											}
+											goto l31
+										l95:
+											position, tokenIndex = position95, tokenIndex95
+										}
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										if !_rules[ruleIdentifier]() {
+											goto l31
+										}
+										{
+											add(ruleAction12, position)
+										}
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l31
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										{
+											position97 := position
+											if !_rules[ruleIdentStart]() {
+												goto l31
+											}
+										l98:
+											{
+												position99, tokenIndex99 := position, tokenIndex
+												if !_rules[ruleIdentCont]() {
+													goto l99
+												}
+												goto l98
+											l99:
+												position, tokenIndex = position99, tokenIndex99
+											}
+											{
+												position100, tokenIndex100 := position, tokenIndex
+												if buffer[position] != rune('.') {
+													fail("'.'")
+													goto l100
+												}
+												position++
+												if !_rules[ruleIdentStart]() {
+													goto l100
+												}
+											l102:
+												{
+													position103, tokenIndex103 := position, tokenIndex
+													if !_rules[ruleIdentCont]() {
+														goto l103
+													}
+													goto l102
+												l103:
+													position, tokenIndex = position103, tokenIndex103
+												}
+												goto l101
+											l100:
+												position, tokenIndex = position100, tokenIndex100
+											}
+										l101:
+											add(rulePegText, position97)
+										}
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										{
+											add(ruleAction13, position)
+										}
+									}
+								l76:
+									break
+								case 'n':
+									fail("'c'")
+									fail("'w'")
+									fail("'m'")
+									fail("'r'")
+									fail("'b'")
+									fail("'a'")
+									fail("'s'")
+									fail("'e'")
+									fail("'i'")
+									position++
+									if buffer[position] != rune('o') {
+										fail("'o'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('m') {
+										fail("'m'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('m') {
+										fail("'m'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('o') {
+										fail("'o'")
+										goto l31
+									}
+									position++
+									{
+										position105, tokenIndex105 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l105
+										}
+										goto l31
+									l105:
+										position, tokenIndex = position105, tokenIndex105
+									}
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									{
+										position106 := position
+										{
+											position107, tokenIndex107 := position, tokenIndex
+											if buffer[position] != rune('f') {
+												fail("'failures'")
+												goto l108
+											}
+											position++
+											if buffer[position] != rune('a') {
+												fail("'a'")
+												goto l108
+											}
+											position++
+											if buffer[position] != rune('i') {
+												fail("'i'")
+												goto l108
+											}
+											position++
+											if buffer[position] != rune('l') {
+												fail("'l'")
+												goto l108
+											}
+											position++
+											if buffer[position] != rune('u') {
+												fail("'u'")
+												goto l108
+											}
+											position++
+											if buffer[position] != rune('r') {
+												fail("'r'")
+												goto l108
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l108
+											}
+											position++
+											if buffer[position] != rune('s') {
+												fail("'s'")
+												goto l108
+											}
+											position++
+											goto l107
+										l108:
+											position, tokenIndex = position107, tokenIndex107
+											if buffer[position] != rune('s') {
+												fail("'successes'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('u') {
+												fail("'u'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('c') {
+												fail("'c'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('c') {
+												fail("'c'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('s') {
+												fail("'s'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('s') {
+												fail("'s'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l31
+											}
+											position++
+											if buffer[position] != rune('s') {
+												fail("'s'")
+												goto l31
+											}
+											position++
+										}
+									l107:
+										add(rulePegText, position106)
+									}
+									{
+										position109, tokenIndex109 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l109
+										}
+										goto l31
+									l109:
+										position, tokenIndex = position109, tokenIndex109
+									}
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									{
+										add(ruleAction6, position)
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l31
+									}
+									{
+										position113, tokenIndex113 := position, tokenIndex
+										if !_rules[ruleLeftArrow]() {
+											goto l113
+										}
+										goto l31
+									l113:
+										position, tokenIndex = position113, tokenIndex113
+									}
+									{
+										add(ruleAction7, position)
+									}
+								l111:
+									{
+										position112, tokenIndex112 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l112
+										}
+										{
+											position115, tokenIndex115 := position, tokenIndex
+											if !_rules[ruleLeftArrow]() {
+												goto l115
+											}
+											goto l112
+										l115:
+											position, tokenIndex = position115, tokenIndex115
+										}
+										{
+											add(ruleAction7, position)
+										}
+										goto l111
+									l112:
+										position, tokenIndex = position112, tokenIndex112
+									}
+								case 'r':
+									fail("'c'")
+									fail("'w'")
+									fail("'n'")
+									fail("'m'")
+									fail("'b'")
+									fail("'a'")
+									fail("'s'")
+									fail("'e'")
+									fail("'i'")
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l31
+									}
+									position++
+									{
+										position117, tokenIndex117 := position, tokenIndex
+										if buffer[position] != rune('c') {
+											fail("'c'")
+											goto l118
+										}
+										position++
+										if buffer[position] != rune('o') {
+											fail("'o'")
+											goto l118
+										}
+										position++
+										if buffer[position] != rune('v') {
+											fail("'v'")
+											goto l118
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l118
+										}
+										position++
+										if buffer[position] != rune('r') {
+											fail("'r'")
+											goto l118
+										}
+										position++
+										if buffer[position] != rune('y') {
+											fail("'y'")
+											goto l118
+										}
+										position++
+										{
+											position119, tokenIndex119 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l119
+											}
+											goto l118
+										l119:
+											position, tokenIndex = position119, tokenIndex119
+										}
+										if !_rules[ruleSpacing]() {
+											goto l118
+										}
+										if !_rules[ruleIdentifier]() {
+											goto l118
+										}
+										{
+											position122, tokenIndex122 := position, tokenIndex
+											if !_rules[ruleLeftArrow]() {
+												goto l122
+											}
+											goto l118
+										l122:
+											position, tokenIndex = position122, tokenIndex122
+										}
+										{
+											add(ruleAction11, position)
+										}
+									l120:
+										{
+											position121, tokenIndex121 := position, tokenIndex
+											if !_rules[ruleIdentifier]() {
+												goto l121
+											}
+											{
+												position124, tokenIndex124 := position, tokenIndex
+												if !_rules[ruleLeftArrow]() {
+													goto l124
+												}
+												goto l121
+											l124:
+												position, tokenIndex = position124, tokenIndex124
+											}
+											{
+												add(ruleAction11, position)
+											}
+											goto l120
+										l121:
+											position, tokenIndex = position121, tokenIndex121
+										}
+										goto l117
+									l118:
+										position, tokenIndex = position117, tokenIndex117
+										if buffer[position] != rune('j') {
+											fail("'j'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('c') {
+											fail("'c'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('t') {
+											fail("'t'")
+											goto l31
+										}
+										position++
+										{
+											position126, tokenIndex126 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l126
+											}
+											goto l31
+										l126:
+											position, tokenIndex = position126, tokenIndex126
+										}
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										if !_rules[ruleIdentifier]() {
+											goto l31
+										}
+										{
+											add(ruleAction19, position)
+										}
+										if buffer[position] != rune('`') {
+											fail("'`'")
+											goto l31
+										}
+										position++
+										{
+											position128 := position
+										l129:
+											{
+												position130, tokenIndex130 := position, tokenIndex
+												{
+													position131, tokenIndex131 := position, tokenIndex
+													if buffer[position] != rune('`') {
+														fail("'`'")
+														goto l131
+													}
+													position++
+													goto l130
+												l131:
+													position, tokenIndex = position131, tokenIndex131
+												}
+												if !matchDot() {
+													fail(".")
+													goto l130
+												}
+												goto l129
+											l130:
+												position, tokenIndex = position130, tokenIndex130
+											}
+											add(rulePegText, position128)
+										}
+										if buffer[position] != rune('`') {
+											fail("'`'")
+											goto l31
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										{
+											add(ruleAction20, position)
+										}
+										{
+											position133, tokenIndex133 := position, tokenIndex
+											{
+												position135 := position
+												if c := buffer[position]; c < rune('0') || c > rune('9') {
+													fail("[0-9]")
+													goto l133
+												}
+												position++
+											l136:
+												{
+													position137, tokenIndex137 := position, tokenIndex
+													if c := buffer[position]; c < rune('0') || c > rune('9') {
+														fail("[0-9]")
+														goto l137
+													}
+													position++
+													goto l136
+												l137:
+													position, tokenIndex = position137, tokenIndex137
+												}
+												if buffer[position] != rune(':') {
+													fail("':'")
+													goto l133
+												}
+												position++
+												if c := buffer[position]; c < rune('0') || c > rune('9') {
+													fail("[0-9]")
+													goto l133
+												}
+												position++
+											l138:
+												{
+													position139, tokenIndex139 := position, tokenIndex
+													if c := buffer[position]; c < rune('0') || c > rune('9') {
+														fail("[0-9]")
+														goto l139
+													}
+													position++
+													goto l138
+												l139:
+													position, tokenIndex = position139, tokenIndex139
+												}
+												add(rulePegText, position135)
+											}
+											if !_rules[ruleSpacing]() {
+												goto l133
+											}
+											{
+												add(ruleAction21, position)
+											}
+											goto l134
+										l133:
+											position, tokenIndex = position133, tokenIndex133
+										}
+									l134:
+									}
+								l117:
+									break
+								case 's':
+									fail("'c'")
+									fail("'w'")
+									fail("'n'")
+									fail("'m'")
+									fail("'r'")
+									fail("'b'")
+									fail("'a'")
+									fail("'e'")
+									fail("'i'")
+									position++
+									{
+										position141, tokenIndex141 := position, tokenIndex
+										if buffer[position] != rune('a') {
+											fail("'a'")
+											goto l142
+										}
+										position++
+										if buffer[position] != rune('m') {
+											fail("'m'")
+											goto l142
+										}
+										position++
+										if buffer[position] != rune('p') {
+											fail("'p'")
+											goto l142
+										}
+										position++
+										if buffer[position] != rune('l') {
+											fail("'l'")
+											goto l142
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l142
+										}
+										position++
+										{
+											position143, tokenIndex143 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l143
+											}
+											goto l142
+										l143:
+											position, tokenIndex = position143, tokenIndex143
+										}
+										if !_rules[ruleSpacing]() {
+											goto l142
+										}
+										{
+											position144, tokenIndex144 := position, tokenIndex
+											if buffer[position] != rune('`') {
+												fail("'`'")
+												goto l145
+											}
+											position++
+											{
+												position146 := position
+											l147:
+												{
+													position148, tokenIndex148 := position, tokenIndex
+													{
+														position149, tokenIndex149 := position, tokenIndex
+														if buffer[position] != rune('`') {
+															fail("'`'")
+															goto l149
+														}
+														position++
+														goto l148
+													l149:
+														position, tokenIndex = position149, tokenIndex149
+													}
+													if !matchDot() {
+														fail(".")
+														goto l148
+													}
+													goto l147
+												l148:
+													position, tokenIndex = position148, tokenIndex148
+												}
+												add(rulePegText, position146)
+											}
+											if buffer[position] != rune('`') {
+												fail("'`'")
+												goto l145
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l145
+											}
+											{
+												add(ruleAction22, position)
+											}
+											goto l144
+										l145:
+											position, tokenIndex = position144, tokenIndex144
+											if buffer[position] != rune('f') {
+												fail("'file('")
+												goto l142
+											}
+											position++
+											if buffer[position] != rune('i') {
+												fail("'i'")
+												goto l142
+											}
+											position++
+											if buffer[position] != rune('l') {
+												fail("'l'")
+												goto l142
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l142
+											}
+											position++
+											if buffer[position] != rune('(') {
+												fail("'('")
+												goto l142
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l142
+											}
+											if buffer[position] != rune('"') {
+												fail("'\"'")
+												goto l142
+											}
+											position++
+											{
+												position151 := position
+											l152:
+												{
+													position153, tokenIndex153 := position, tokenIndex
+													{
+														position154, tokenIndex154 := position, tokenIndex
+														if buffer[position] != rune('"') {
+															fail("'\"'")
+															goto l154
+														}
+														position++
+														goto l153
+													l154:
+														position, tokenIndex = position154, tokenIndex154
+													}
+													if !matchDot() {
+														fail(".")
+														goto l153
+													}
+													goto l152
+												l153:
+													position, tokenIndex = position153, tokenIndex153
+												}
+												add(rulePegText, position151)
+											}
+											if buffer[position] != rune('"') {
+												fail("'\"'")
+												goto l142
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l142
+											}
+											if buffer[position] != rune(')') {
+												fail("')'")
+												goto l142
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l142
+											}
+											{
+												add(ruleAction23, position)
+											}
+										}
+									l144:
+										goto l141
+									l142:
+										position, tokenIndex = position141, tokenIndex141
+										if buffer[position] != rune('t') {
+											fail("'t'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('a') {
+											fail("'a'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('t') {
+											fail("'t'")
+											goto l31
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l31
+										}
+										position++
+										{
+											position156, tokenIndex156 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l156
+											}
+											goto l31
+										l156:
+											position, tokenIndex = position156, tokenIndex156
+										}
+										if !_rules[ruleSpacing]() {
+											goto l31
+										}
+										if !_rules[ruleAction]() {
+											goto l31
+										}
+										{
+											add(ruleAction26, position)
+										}
+									}
+								l141:
+									break
+								case 'w':
+									fail("'c'")
+									fail("'n'")
+									fail("'m'")
+									fail("'r'")
+									fail("'b'")
+									fail("'a'")
+									fail("'s'")
+									fail("'e'")
+									fail("'i'")
+									position++
+									if buffer[position] != rune('o') {
+										fail("'o'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('d') {
+										fail("'d'")
+										goto l31
+									}
+									position++
+									{
+										position158, tokenIndex158 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l158
+										}
+										goto l31
+									l158:
+										position, tokenIndex = position158, tokenIndex158
+									}
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									if !_rules[ruleClass]() {
+										goto l31
+									}
+									{
+										add(ruleAction5, position)
+									}
+								default:
+									fail("'c'")
+									fail("'w'")
+									fail("'n'")
+									fail("'m'")
+									fail("'r'")
+									fail("'b'")
+									fail("'s'")
+									fail("'e'")
+									fail("'i'")
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('c') {
+										fail("'c'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('c') {
+										fail("'c'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('p') {
+										fail("'p'")
+										goto l31
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l31
+									}
+									position++
+									{
+										position160, tokenIndex160 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l160
+										}
+										goto l31
+									l160:
+										position, tokenIndex = position160, tokenIndex160
+									}
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l31
+									}
+									{
+										add(ruleAction17, position)
+									}
+									if buffer[position] != rune('`') {
+										fail("'`'")
+										goto l31
+									}
+									position++
+									{
+										position162 := position
+									l163:
+										{
+											position164, tokenIndex164 := position, tokenIndex
+											{
+												position165, tokenIndex165 := position, tokenIndex
+												if buffer[position] != rune('`') {
+													fail("'`'")
+													goto l165
+												}
+												position++
+												goto l164
+											l165:
+												position, tokenIndex = position165, tokenIndex165
+											}
+											if !matchDot() {
+												fail(".")
+												goto l164
+											}
+											goto l163
+										l164:
+											position, tokenIndex = position164, tokenIndex164
+										}
+										add(rulePegText, position162)
+									}
+									if buffer[position] != rune('`') {
+										fail("'`'")
+										goto l31
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l31
+									}
+									{
+										add(ruleAction18, position)
+									}
+								}
+							}
+
+							add(ruleDirective, position32)
+						}
+						goto l30
+					l31:
+						position, tokenIndex = position31, tokenIndex31
+					}
+					goto l21
+				l20:
+					position, tokenIndex = position20, tokenIndex20
+				}
+			l21:
+				{
+					position169 := position
+					if !_rules[ruleIdentifier]() {
+						goto l0
+					}
+					{
+						add(ruleAction31, position)
+					}
+					if !_rules[ruleLeftArrow]() {
+						goto l0
+					}
+					if !_rules[ruleExpression]() {
+						goto l0
+					}
+					{
+						add(ruleAction32, position)
+					}
+					{
+						position172, tokenIndex172 := position, tokenIndex
+						{
+							position174 := position
+							if buffer[position] != rune('-') {
+								fail("'->'")
+								goto l172
+							}
+							position++
+							if buffer[position] != rune('>') {
+								fail("'>'")
+								goto l172
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l172
+							}
+							{
+								position175 := position
+								{
+									position176, tokenIndex176 := position, tokenIndex
+									if buffer[position] != rune('*') {
+										fail("'*'")
+										goto l176
+									}
+									position++
+									goto l177
+								l176:
+									position, tokenIndex = position176, tokenIndex176
+								}
+							l177:
+								if !_rules[ruleIdentStart]() {
+									goto l172
+								}
+							l178:
+								{
+									position179, tokenIndex179 := position, tokenIndex
+									if !_rules[ruleIdentCont]() {
+										goto l179
+									}
+									goto l178
+								l179:
+									position, tokenIndex = position179, tokenIndex179
+								}
+								{
+									position180, tokenIndex180 := position, tokenIndex
+									if buffer[position] != rune('.') {
+										fail("'.'")
+										goto l180
+									}
+									position++
+									if !_rules[ruleIdentStart]() {
+										goto l180
+									}
+								l182:
+									{
+										position183, tokenIndex183 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l183
+										}
+										goto l182
+									l183:
+										position, tokenIndex = position183, tokenIndex183
+									}
+									goto l181
+								l180:
+									position, tokenIndex = position180, tokenIndex180
+								}
+							l181:
+								add(rulePegText, position175)
+							}
+							if !_rules[ruleSpacing]() {
+								goto l172
+							}
+							{
+								add(ruleAction33, position)
+							}
+							if !_rules[ruleAction]() {
+								goto l172
+							}
+							{
+								add(ruleAction34, position)
+							}
+							add(ruleBuild, position174)
+						}
+						goto l173
+					l172:
+						position, tokenIndex = position172, tokenIndex172
+					}
+				l173:
+					{
+						position186, tokenIndex186 := position, tokenIndex
+						{
+							position187, tokenIndex187 := position, tokenIndex
+							if !_rules[ruleIdentifier]() {
+								goto l188
+							}
+							if !_rules[ruleLeftArrow]() {
+								goto l188
+							}
+							goto l187
+						l188:
+							position, tokenIndex = position187, tokenIndex187
+							{
+								position189, tokenIndex189 := position, tokenIndex
+								if !matchDot() {
+									fail(".")
+									goto l189
+								}
+								goto l0
+							l189:
+								position, tokenIndex = position189, tokenIndex189
+							}
+						}
+					l187:
+						position, tokenIndex = position186, tokenIndex186
+					}
+					add(ruleDefinition, position169)
+				}
+			l167:
+				{
+					position168, tokenIndex168 := position, tokenIndex
+					{
+						position190 := position
+						if !_rules[ruleIdentifier]() {
+							goto l168
+						}
+						{
+							add(ruleAction31, position)
+						}
+						if !_rules[ruleLeftArrow]() {
+							goto l168
+						}
+						if !_rules[ruleExpression]() {
+							goto l168
+						}
+						{
+							add(ruleAction32, position)
+						}
+						{
+							position193, tokenIndex193 := position, tokenIndex
+							{
+								position195 := position
+								if buffer[position] != rune('-') {
+									fail("'->'")
+									goto l193
+								}
+								position++
+								if buffer[position] != rune('>') {
+									fail("'>'")
+									goto l193
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l193
+								}
+								{
+									position196 := position
+									{
+										position197, tokenIndex197 := position, tokenIndex
+										if buffer[position] != rune('*') {
+											fail("'*'")
+											goto l197
+										}
+										position++
+										goto l198
+									l197:
+										position, tokenIndex = position197, tokenIndex197
+									}
+								l198:
+									if !_rules[ruleIdentStart]() {
+										goto l193
+									}
+								l199:
+									{
+										position200, tokenIndex200 := position, tokenIndex
+										if !_rules[ruleIdentCont]() {
+											goto l200
+										}
+										goto l199
+									l200:
+										position, tokenIndex = position200, tokenIndex200
+									}
+									{
+										position201, tokenIndex201 := position, tokenIndex
+										if buffer[position] != rune('.') {
+											fail("'.'")
+											goto l201
+										}
+										position++
+										if !_rules[ruleIdentStart]() {
+											goto l201
+										}
+									l203:
+										{
+											position204, tokenIndex204 := position, tokenIndex
+											if !_rules[ruleIdentCont]() {
+												goto l204
+											}
+											goto l203
+										l204:
+											position, tokenIndex = position204, tokenIndex204
+										}
+										goto l202
+									l201:
+										position, tokenIndex = position201, tokenIndex201
+									}
+								l202:
+									add(rulePegText, position196)
+								}
+								if !_rules[ruleSpacing]() {
+									goto l193
+								}
+								{
+									add(ruleAction33, position)
+								}
+								if !_rules[ruleAction]() {
+									goto l193
+								}
+								{
+									add(ruleAction34, position)
+								}
+								add(ruleBuild, position195)
+							}
+							goto l194
+						l193:
+							position, tokenIndex = position193, tokenIndex193
+						}
+					l194:
+						{
+							position207, tokenIndex207 := position, tokenIndex
+							{
+								position208, tokenIndex208 := position, tokenIndex
+								if !_rules[ruleIdentifier]() {
+									goto l209
+								}
+								if !_rules[ruleLeftArrow]() {
+									goto l209
+								}
+								goto l208
+							l209:
+								position, tokenIndex = position208, tokenIndex208
+								{
+									position210, tokenIndex210 := position, tokenIndex
+									if !matchDot() {
+										fail(".")
+										goto l210
+									}
+									goto l168
+								l210:
+									position, tokenIndex = position210, tokenIndex210
+								}
+							}
+						l208:
+							position, tokenIndex = position207, tokenIndex207
+						}
+						add(ruleDefinition, position190)
+					}
+					goto l167
+				l168:
+					position, tokenIndex = position168, tokenIndex168
+				}
+				{
+					position211 := position
+					{
+						position212, tokenIndex212 := position, tokenIndex
+						if !matchDot() {
+							fail(".")
+							goto l212
+						}
+						goto l0
+					l212:
+						position, tokenIndex = position212, tokenIndex212
+					}
+					add(ruleEndOfFile, position211)
+				}
+				add(ruleGrammar, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Directive <- <('%' ((&('b') %fail('c' 'w' 'n' 'm' 'r' 'a' 's' 'e' 'i') ('b' 'e' 'n' 'c' 'h' !IdentCont Spacing Identifier Action14 (('`' <(!'`' .)*> '`' Spacing Action15) / ('f' 'i' 'l' 'e' '(' Spacing '"' <(!'"' .)*> '"' Spacing ')' Spacing Action16)))) | (&('c') %fail('w' 'n' 'm' 'r' 'b' 'a' 's' 'e' 'i') ('c' 'a' 's' 'e' 'i' 'n' 's' 'e' 'n' 's' 'i' 't' 'i' 'v' 'e' !IdentCont Spacing ((Identifier !LeftArrow Action3)+ / Action4))) | (&('e') %fail('c' 'w' 'n' 'm' 'r' 'b' 'a' 's' 'i') ('e' 'r' 'r' 'o' 'r' !IdentCont Spacing Identifier Action24 Action Action25)) | (&('i') %fail('c' 'w' 'n' 'm' 'r' 'b' 'a' 's' 'e') ('i' (('m' 'p' 'o' 'r' 't' !IdentCont Spacing (MultiImport / SingleImport) Spacing) / ('n' 'c' 'l' 'u' 'd' 'e' !IdentCont Spacing '"' <(!'"' .)*> '"' Spacing Action27 (Identifier Action28 '=' Spacing Identifier Action29)*)))) | (&('m') %fail('c' 'w' 'n' 'r' 'b' 'a' 's' 'e' 'i') ('m' (('e' 'm' 'o' ((!IdentCont Spacing (Identifier !LeftArrow Action8)+) / ('k' 'e' 'y' !IdentCont Spacing Action Action9 (Identifier !LeftArrow Action10)+))) / ('a' 'p' !IdentCont Spacing Identifier Action12 '=' Spacing <(IdentStart IdentCont* ('.' IdentStart IdentCont*)?)> Spacing Action13)))) | (&('n') %fail('c' 'w' 'm' 'r' 'b' 'a' 's' 'e' 'i') ('n' 'o' 'm' 'e' 'm' 'o' !IdentCont Spacing <(('f' 'a' 'i' 'l' 'u' 'r' 'e' 's') / ('s' 'u' 'c' 'c' 'e' 's' 's' 'e' 's'))> !IdentCont Spacing Action6 (Identifier !LeftArrow Action7)+)) | (&('r') %fail('c' 'w' 'n' 'm' 'b' 'a' 's' 'e' 'i') ('r' 'e' (('c' 'o' 'v' 'e' 'r' 'y' !IdentCont Spacing (Identifier !LeftArrow Action11)+) / ('j' 'e' 'c' 't' !IdentCont Spacing Identifier Action19 '`' <(!'`' .)*> '`' Spacing Action20 (<([0-9]+ ':' [0-9]+)> Spacing Action21)?)))) | (&('s') %fail('c' 'w' 'n' 'm' 'r' 'b' 'a' 'e' 'i') ('s' (('a' 'm' 'p' 'l' 'e' !IdentCont Spacing (('`' <(!'`' .)*> '`' Spacing Action22) / ('f' 'i' 'l' 'e' '(' Spacing '"' <(!'"' .)*> '"' Spacing ')' Spacing Action23))) / ('t' 'a' 't' 'e' !IdentCont Spacing Action Action26)))) | (&('w') %fail('c' 'n' 'm' 'r' 'b' 'a' 's' 'e' 'i') ('w' 'o' 'r' 'd' !IdentCont Spacing Class Action5)) | (&('a') %fail('c' 'w' 'n' 'm' 'r' 'b' 's' 'e' 'i') ('a' 'c' 'c' 'e' 'p' 't' !IdentCont Spacing Identifier Action17 '`' <(!'`' .)*> '`' Spacing Action18))))> */
+		nil,
+		/* 2 Import <- <('i' 'm' 'p' 'o' 'r' 't' Spacing (MultiImport / SingleImport) Spacing)> */
+		nil,
+		/* 3 SingleImport <- <ImportName> */
+		func() bool {
+			memoized, ok := memoization[memoKey{3, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position215, tokenIndex215 := position, tokenIndex
+			{
+				position216 := position
+				if !_rules[ruleImportName]() {
+					goto l215
+				}
+				add(ruleSingleImport, position216)
+			}
+			memoize(3, position215, tokenIndex215, true)
+			return true
+		l215:
+			memoize(3, position215, tokenIndex215, false)
+			position, tokenIndex = position215, tokenIndex215
+			return false
+		},
+		/* 4 MultiImport <- <('(' Spacing (ImportName Spacing (';' Spacing)?)* ')')> */
+		func() bool {
+			memoized, ok := memoization[memoKey{4, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position217, tokenIndex217 := position, tokenIndex
+			{
+				position218 := position
+				if buffer[position] != rune('(') {
+					fail("'('")
+					goto l217
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l217
+				}
+			l219:
+				{
+					position220, tokenIndex220 := position, tokenIndex
+					if !_rules[ruleImportName]() {
+						goto l220
+					}
+					if !_rules[ruleSpacing]() {
+						goto l220
+					}
+					{
+						position221, tokenIndex221 := position, tokenIndex
+						if buffer[position] != rune(';') {
+							fail("';'")
+							goto l221
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l221
+						}
+						goto l222
+					l221:
+						position, tokenIndex = position221, tokenIndex221
+					}
+				l222:
+					goto l219
+				l220:
+					position, tokenIndex = position220, tokenIndex220
+				}
+				if buffer[position] != rune(')') {
+					fail("')'")
+					goto l217
+				}
+				position++
+				add(ruleMultiImport, position218)
+			}
+			memoize(4, position217, tokenIndex217, true)
+			return true
+		l217:
+			memoize(4, position217, tokenIndex217, false)
+			position, tokenIndex = position217, tokenIndex217
+			return false
+		},
+		/* 5 ImportName <- <('"' <((&('-') '-') | (&('.') '.') | (&('/') '/') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('-' '.' '/' [0-9] [A-Z] '_') [a-z]))+> '"' Action30)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{5, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position223, tokenIndex223 := position, tokenIndex
+			{
+				position224 := position
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l223
+				}
+				position++
+				{
+					position225 := position
+					{
+						switch buffer[position] {
+						case '-':
+							position++
+						case '.':
+							position++
+						case '/':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'-'")
+							fail("'.'")
+							fail("'/'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l223
+							}
+							position++
+						}
+					}
+
+				l226:
+					{
+						position227, tokenIndex227 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '-':
+								position++
+							case '.':
+								position++
+							case '/':
+								position++
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("'-'")
+								fail("'.'")
+								fail("'/'")
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l227
+								}
+								position++
+							}
+						}
+
+						goto l226
+					l227:
+						position, tokenIndex = position227, tokenIndex227
+					}
+					add(rulePegText, position225)
+				}
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l223
+				}
+				position++
+				{
+					add(ruleAction30, position)
+				}
+				add(ruleImportName, position224)
+			}
+			memoize(5, position223, tokenIndex223, true)
+			return true
+		l223:
+			memoize(5, position223, tokenIndex223, false)
+			position, tokenIndex = position223, tokenIndex223
+			return false
+		},
+		/* 6 Definition <- <(Identifier Action31 LeftArrow Expression Action32 Build? &((Identifier LeftArrow) / !.))> */
+		nil,
+		/* 7 Build <- <('-' '>' Spacing <('*'? IdentStart IdentCont* ('.' IdentStart IdentCont*)?)> Spacing Action33 Action Action34)> */
+		nil,
+		/* 8 Expression <- <((Sequence (Slash Sequence Action35)* (Slash Action36)?) / Action37)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{8, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position233, tokenIndex233 := position, tokenIndex
+			{
+				position234 := position
+				{
+					position235, tokenIndex235 := position, tokenIndex
+					if !_rules[ruleSequence]() {
+						goto l236
+					}
+				l237:
+					{
+						position238, tokenIndex238 := position, tokenIndex
+						if !_rules[ruleSlash]() {
+							goto l238
+						}
+						if !_rules[ruleSequence]() {
+							goto l238
+						}
+						{
+							add(ruleAction35, position)
+						}
+						goto l237
+					l238:
+						position, tokenIndex = position238, tokenIndex238
+					}
+					{
+						position240, tokenIndex240 := position, tokenIndex
+						if !_rules[ruleSlash]() {
+							goto l240
+						}
+						{
+							add(ruleAction36, position)
+						}
+						goto l241
+					l240:
+						position, tokenIndex = position240, tokenIndex240
+					}
+				l241:
+					goto l235
+				l236:
+					position, tokenIndex = position235, tokenIndex235
+					{
+						add(ruleAction37, position)
+					}
+				}
+			l235:
+				add(ruleExpression, position234)
+			}
+			memoize(8, position233, tokenIndex233, true)
+			return true
+		},
+		/* 9 Sequence <- <(Prefix (Prefix Action38)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{9, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position244, tokenIndex244 := position, tokenIndex
+			{
+				position245 := position
+				if !_rules[rulePrefix]() {
+					goto l244
+				}
+			l246:
+				{
+					position247, tokenIndex247 := position, tokenIndex
+					if !_rules[rulePrefix]() {
+						goto l247
+					}
+					{
+						add(ruleAction38, position)
+					}
+					goto l246
+				l247:
+					position, tokenIndex = position247, tokenIndex247
+				}
+				add(ruleSequence, position245)
+			}
+			memoize(9, position244, tokenIndex244, true)
+			return true
+		l244:
+			memoize(9, position244, tokenIndex244, false)
+			position, tokenIndex = position244, tokenIndex244
+			return false
+		},
+		/* 10 Prefix <- <((&('!') %fail('%hint' '&' '"' '`' '\'' '%keyword' '%recover' '(' '.' '<' '[' '{' [A-Z] '_' [a-z]) (Not ((&('%') %fail('{') ((InSet Action42) / (Suffix Action44))) | (&('{') %fail('%in') ((Action Action40) / (Suffix Action44))) | (&('"' | '\'' | '(' | '.' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('{' '%in') (Suffix Action44))))) | (&('%') %fail('&' '!') (Hint / Suffix)) | (&('&') %fail('%hint' '!' '"' '`' '\'' '%keyword' '%recover' '(' '.' '<' '[' '{' [A-Z] '_' [a-z]) (And ((&('%') %fail('{') ((InSet Action41) / (Suffix Action43))) | (&('{') %fail('%in') ((Action Action39) / (Suffix Action43))) | (&('"' | '\'' | '(' | '.' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('{' '%in') (Suffix Action43))))) | (&('"' | '\'' | '(' | '.' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '{') %fail('%hint' '&' '!') Suffix))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{10, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position249, tokenIndex249 := position, tokenIndex
+			{
+				position250 := position
+				{
+					switch buffer[position] {
+					case '!':
+						fail("'%hint'")
+						fail("'&'")
+						fail("'\"'")
+						fail("'`'")
+						fail("'\\''")
+						fail("'%keyword'")
+						fail("'%recover'")
+						fail("'('")
+						fail("'.'")
+						fail("'<'")
+						fail("'['")
+						fail("'{'")
+						fail("[A-Z]")
+						fail("'_'")
+						fail("[a-z]")
+						if !_rules[ruleNot]() {
+							goto l249
+						}
+						{
+							switch buffer[position] {
+							case '%':
+								fail("'{'")
+								{
+									position253, tokenIndex253 := position, tokenIndex
+									if !_rules[ruleInSet]() {
+										goto l254
+									}
+									{
+										add(ruleAction42, position)
+									}
+									goto l253
+								l254:
+									position, tokenIndex = position253, tokenIndex253
+									if !_rules[ruleSuffix]() {
+										goto l249
+									}
+									if !_rules[ruleAction44]() {
+										goto l249
+									}
+								}
+							l253:
+								break
+							case '{':
+								fail("'%in'")
+								{
+									position256, tokenIndex256 := position, tokenIndex
+									if !_rules[ruleAction]() {
+										goto l257
+									}
+									{
+										add(ruleAction40, position)
+									}
+									goto l256
+								l257:
+									position, tokenIndex = position256, tokenIndex256
+									if !_rules[ruleSuffix]() {
+										goto l249
+									}
+									if !_rules[ruleAction44]() {
+										goto l249
+									}
+								}
+							l256:
+								break
+							default:
+								fail("'{'")
+								fail("'%in'")
+								if !_rules[ruleSuffix]() {
+									goto l249
+								}
+								if !_rules[ruleAction44]() {
+									goto l249
+								}
+							}
+						}
+
+					case '%':
+						fail("'&'")
+						fail("'!'")
+						{
+							position259, tokenIndex259 := position, tokenIndex
+							{
+								position261 := position
+								if buffer[position] != rune('%') {
+									fail("'%hint'")
+									goto l260
+								}
+								position++
+								if buffer[position] != rune('h') {
+									fail("'h'")
+									goto l260
+								}
+								position++
+								if buffer[position] != rune('i') {
+									fail("'i'")
+									goto l260
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l260
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l260
+								}
+								position++
+								{
+									position262, tokenIndex262 := position, tokenIndex
+									if !_rules[ruleIdentCont]() {
+										goto l262
+									}
+									goto l260
+								l262:
+									position, tokenIndex = position262, tokenIndex262
+								}
+								if !_rules[ruleSpacing]() {
+									goto l260
+								}
+								{
+									position263 := position
+									if buffer[position] != rune('"') {
+										fail("'\"'")
+										goto l260
+									}
+									position++
+								l264:
+									{
+										position265, tokenIndex265 := position, tokenIndex
+										{
+											position266, tokenIndex266 := position, tokenIndex
+											if buffer[position] != rune('\\') {
+												fail("'\\\\'")
+												goto l267
+											}
+											position++
+											if !matchDot() {
+												fail(".")
+												goto l267
+											}
+											goto l266
+										l267:
+											position, tokenIndex = position266, tokenIndex266
+											{
+												position268, tokenIndex268 := position, tokenIndex
+												if buffer[position] != rune('"') {
+													fail("'\"'")
+													goto l268
+												}
+												position++
+												goto l265
+											l268:
+												position, tokenIndex = position268, tokenIndex268
+											}
+											if !matchDot() {
+												fail(".")
+												goto l265
+											}
+										}
+									l266:
+										goto l264
+									l265:
+										position, tokenIndex = position265, tokenIndex265
+									}
+									if buffer[position] != rune('"') {
+										fail("'\"'")
+										goto l260
+									}
+									position++
+									add(rulePegText, position263)
+								}
+								if !_rules[ruleSpacing]() {
+									goto l260
+								}
+								{
+									add(ruleAction45, position)
+								}
+								add(ruleHint, position261)
+							}
+							goto l259
+						l260:
+							position, tokenIndex = position259, tokenIndex259
+							if !_rules[ruleSuffix]() {
+								goto l249
+							}
+						}
+					l259:
+						break
+					case '&':
+						fail("'%hint'")
+						fail("'!'")
+						fail("'\"'")
+						fail("'`'")
+						fail("'\\''")
+						fail("'%keyword'")
+						fail("'%recover'")
+						fail("'('")
+						fail("'.'")
+						fail("'<'")
+						fail("'['")
+						fail("'{'")
+						fail("[A-Z]")
+						fail("'_'")
+						fail("[a-z]")
+						if !_rules[ruleAnd]() {
+							goto l249
+						}
+						{
+							switch buffer[position] {
+							case '%':
+								fail("'{'")
+								{
+									position271, tokenIndex271 := position, tokenIndex
+									if !_rules[ruleInSet]() {
+										goto l272
+									}
+									{
+										add(ruleAction41, position)
+									}
+									goto l271
+								l272:
+									position, tokenIndex = position271, tokenIndex271
+									if !_rules[ruleSuffix]() {
+										goto l249
+									}
+									if !_rules[ruleAction43]() {
+										goto l249
+									}
+								}
+							l271:
+								break
+							case '{':
+								fail("'%in'")
+								{
+									position274, tokenIndex274 := position, tokenIndex
+									if !_rules[ruleAction]() {
+										goto l275
+									}
+									{
+										add(ruleAction39, position)
+									}
+									goto l274
+								l275:
+									position, tokenIndex = position274, tokenIndex274
+									if !_rules[ruleSuffix]() {
+										goto l249
+									}
+									if !_rules[ruleAction43]() {
+										goto l249
+									}
+								}
+							l274:
+								break
+							default:
+								fail("'{'")
+								fail("'%in'")
+								if !_rules[ruleSuffix]() {
+									goto l249
+								}
+								if !_rules[ruleAction43]() {
+									goto l249
+								}
+							}
+						}
+
+					default:
+						fail("'%hint'")
+						fail("'&'")
+						fail("'!'")
+						if !_rules[ruleSuffix]() {
+							goto l249
+						}
+					}
+				}
+
+				add(rulePrefix, position250)
+			}
+			memoize(10, position249, tokenIndex249, true)
+			return true
+		l249:
+			memoize(10, position249, tokenIndex249, false)
+			position, tokenIndex = position249, tokenIndex249
+			return false
+		},
+		/* 11 Hint <- <('%' 'h' 'i' 'n' 't' !IdentCont Spacing <('"' (('\\' .) / (!'"' .))* '"')> Spacing Action45)> */
+		nil,
+		/* 12 Suffix <- <(Primary ((&('*') (Star Action47)) | (&('+') (Plus Action48)) | (&('?') %fail('*' '+') (Question Action46)))?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{12, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position278, tokenIndex278 := position, tokenIndex
+			{
+				position279 := position
+				{
+					position280 := position
+					{
+						switch buffer[position] {
+						case '"', '\'', '`':
+							fail("[A-Z]")
+							fail("'_'")
+							fail("[a-z]")
+							fail("'('")
+							fail("'['")
+							fail("'.'")
+							fail("'{'")
+							fail("'%keyword'")
+							fail("'%recover'")
+							fail("'<'")
+							{
+								position282 := position
+								{
+									position283 := position
+									{
+										switch buffer[position] {
+										case '"':
+											position++
+											{
+												position285, tokenIndex285 := position, tokenIndex
+												{
+													position287, tokenIndex287 := position, tokenIndex
+													{
+														position289, tokenIndex289 := position, tokenIndex
+														if buffer[position] != rune('"') {
+															fail("'\"'")
+															goto l289
+														}
+														position++
+														goto l287
+													l289:
+														position, tokenIndex = position289, tokenIndex289
+													}
+													if !_rules[ruleChar]() {
+														goto l287
+													}
+													goto l288
+												l287:
+													position, tokenIndex = position287, tokenIndex287
+												}
+											l288:
+											l290:
+												{
+													position291, tokenIndex291 := position, tokenIndex
+													{
+														position292, tokenIndex292 := position, tokenIndex
+														if buffer[position] != rune('"') {
+															fail("'\"'")
+															goto l292
+														}
+														position++
+														goto l291
+													l292:
+														position, tokenIndex = position292, tokenIndex292
+													}
+													if !_rules[ruleChar]() {
+														goto l291
+													}
+													{
+														add(ruleAction56, position)
+													}
+													goto l290
+												l291:
+													position, tokenIndex = position291, tokenIndex291
+												}
+												if buffer[position] != rune('"') {
+													fail("'\"'")
+													goto l286
+												}
+												position++
+												if buffer[position] != rune('s') {
+													fail("'s'")
+													goto l286
+												}
+												position++
+												{
+													position294, tokenIndex294 := position, tokenIndex
+													if !_rules[ruleIdentCont]() {
+														goto l294
+													}
+													goto l286
+												l294:
+													position, tokenIndex = position294, tokenIndex294
+												}
+												if !_rules[ruleSpacing]() {
+													goto l286
+												}
+												goto l285
+											l286:
+												position, tokenIndex = position285, tokenIndex285
+												{
+													position295, tokenIndex295 := position, tokenIndex
+													{
+														position297, tokenIndex297 := position, tokenIndex
+														if buffer[position] != rune('"') {
+															fail("'\"'")
+															goto l297
+														}
+														position++
+														goto l295
+													l297:
+														position, tokenIndex = position297, tokenIndex297
+													}
+													if !_rules[ruleDoubleChar]() {
+														goto l295
+													}
+													goto l296
+												l295:
+													position, tokenIndex = position295, tokenIndex295
+												}
+											l296:
+											l298:
+												{
+													position299, tokenIndex299 := position, tokenIndex
+													{
+														position300, tokenIndex300 := position, tokenIndex
+														if buffer[position] != rune('"') {
+															fail("'\"'")
+															goto l300
+														}
+														position++
+														goto l299
+													l300:
+														position, tokenIndex = position300, tokenIndex300
+													}
+													if !_rules[ruleDoubleChar]() {
+														goto l299
+													}
+													{
+														add(ruleAction57, position)
+													}
+													goto l298
+												l299:
+													position, tokenIndex = position299, tokenIndex299
+												}
+												if buffer[position] != rune('"') {
+													fail("'\"'")
+													goto l278
+												}
+												position++
+												if !_rules[ruleSpacing]() {
+													goto l278
+												}
+											}
+										l285:
+											break
+										case '`':
+											position++
+											{
+												position302, tokenIndex302 := position, tokenIndex
+												{
+													position304, tokenIndex304 := position, tokenIndex
+													if buffer[position] != rune('`') {
+														fail("'`'")
+														goto l304
+													}
+													position++
+													goto l302
+												l304:
+													position, tokenIndex = position304, tokenIndex304
+												}
+												if !_rules[ruleRawChar]() {
+													goto l302
+												}
+												goto l303
+											l302:
+												position, tokenIndex = position302, tokenIndex302
+											}
+										l303:
+										l305:
+											{
+												position306, tokenIndex306 := position, tokenIndex
+												{
+													position307, tokenIndex307 := position, tokenIndex
+													if buffer[position] != rune('`') {
+														fail("'`'")
+														goto l307
+													}
+													position++
+													goto l306
+												l307:
+													position, tokenIndex = position307, tokenIndex307
+												}
+												if !_rules[ruleRawChar]() {
+													goto l306
+												}
+												{
+													add(ruleAction58, position)
+												}
+												goto l305
+											l306:
+												position, tokenIndex = position306, tokenIndex306
+											}
+											if buffer[position] != rune('`') {
+												fail("'`'")
+												goto l278
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l278
+											}
+										default:
+											fail("'\"'")
+											fail("'`'")
+											if buffer[position] != rune('\'') {
+												fail("'\\''")
+												goto l278
+											}
+											position++
+											{
+												position309, tokenIndex309 := position, tokenIndex
+												{
+													position311, tokenIndex311 := position, tokenIndex
+													{
+														position313, tokenIndex313 := position, tokenIndex
+														if buffer[position] != rune('\'') {
+															fail("'\\''")
+															goto l313
+														}
+														position++
+														goto l311
+													l313:
+														position, tokenIndex = position313, tokenIndex313
+													}
+													if !_rules[ruleChar]() {
+														goto l311
+													}
+													goto l312
+												l311:
+													position, tokenIndex = position311, tokenIndex311
+												}
+											l312:
+											l314:
+												{
+													position315, tokenIndex315 := position, tokenIndex
+													{
+														position316, tokenIndex316 := position, tokenIndex
+														if buffer[position] != rune('\'') {
+															fail("'\\''")
+															goto l316
+														}
+														position++
+														goto l315
+													l316:
+														position, tokenIndex = position316, tokenIndex316
+													}
+													if !_rules[ruleChar]() {
+														goto l315
+													}
+													{
+														add(ruleAction54, position)
+													}
+													goto l314
+												l315:
+													position, tokenIndex = position315, tokenIndex315
+												}
+												if buffer[position] != rune('\'') {
+													fail("'\\''")
+													goto l310
+												}
+												position++
+												if buffer[position] != rune('s') {
+													fail("'s'")
+													goto l310
+												}
+												position++
+												{
+													position318, tokenIndex318 := position, tokenIndex
+													if !_rules[ruleIdentCont]() {
+														goto l318
+													}
+													goto l310
+												l318:
+													position, tokenIndex = position318, tokenIndex318
+												}
+												if !_rules[ruleSpacing]() {
+													goto l310
+												}
+												goto l309
+											l310:
+												position, tokenIndex = position309, tokenIndex309
+												{
+													position319, tokenIndex319 := position, tokenIndex
+													{
+														position321, tokenIndex321 := position, tokenIndex
+														if buffer[position] != rune('\'') {
+															fail("'\\''")
+															goto l321
+														}
+														position++
+														goto l319
+													l321:
+														position, tokenIndex = position321, tokenIndex321
+													}
+													if !_rules[ruleLiteralChar]() {
+														goto l319
+													}
+													goto l320
+												l319:
+													position, tokenIndex = position319, tokenIndex319
+												}
+											l320:
+											l322:
+												{
+													position323, tokenIndex323 := position, tokenIndex
+													{
+														position324, tokenIndex324 := position, tokenIndex
+														if buffer[position] != rune('\'') {
+															fail("'\\''")
+															goto l324
+														}
+														position++
+														goto l323
+													l324:
+														position, tokenIndex = position324, tokenIndex324
+													}
+													if !_rules[ruleLiteralChar]() {
+														goto l323
+													}
+													{
+														add(ruleAction55, position)
+													}
+													goto l322
+												l323:
+													position, tokenIndex = position323, tokenIndex323
+												}
+												if buffer[position] != rune('\'') {
+													fail("'\\''")
+													goto l278
+												}
+												position++
+												if !_rules[ruleSpacing]() {
+													goto l278
+												}
+											}
+										l309:
+											break
+										}
+									}
+
+									add(ruleLiteralBody, position283)
+								}
+								{
+									add(ruleAction53, position)
+								}
+								add(ruleLiteral, position282)
+							}
+						case '%':
+							fail("[A-Z]")
+							fail("'_'")
+							fail("[a-z]")
+							fail("'('")
+							fail("'\"'")
+							fail("'`'")
+							fail("'\\''")
+							fail("'['")
+							fail("'.'")
+							fail("'{'")
+							fail("'<'")
+							{
+								position327, tokenIndex327 := position, tokenIndex
+								{
+									position329 := position
+									if buffer[position] != rune('%') {
+										fail("'%keyword'")
+										goto l328
+									}
+									position++
+									if buffer[position] != rune('k') {
+										fail("'k'")
+										goto l328
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l328
+									}
+									position++
+									if buffer[position] != rune('y') {
+										fail("'y'")
+										goto l328
+									}
+									position++
+									if buffer[position] != rune('w') {
+										fail("'w'")
+										goto l328
+									}
+									position++
+									if buffer[position] != rune('o') {
+										fail("'o'")
+										goto l328
+									}
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l328
+									}
+									position++
+									if buffer[position] != rune('d') {
+										fail("'d'")
+										goto l328
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l328
+									}
+									if !_rules[ruleOpen]() {
+										goto l328
+									}
+									if !_rules[ruleKeywordName]() {
+										goto l328
+									}
+								l330:
+									{
+										position331, tokenIndex331 := position, tokenIndex
+										if buffer[position] != rune(',') {
+											fail("','")
+											goto l331
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l331
+										}
+										if !_rules[ruleKeywordName]() {
+											goto l331
+										}
+										{
+											add(ruleAction94, position)
+										}
+										goto l330
+									l331:
+										position, tokenIndex = position331, tokenIndex331
+									}
+									if !_rules[ruleClose]() {
+										goto l328
+									}
+									add(ruleKeywordSet, position329)
+								}
+								goto l327
+							l328:
+								position, tokenIndex = position327, tokenIndex327
+								{
+									position333 := position
+									if buffer[position] != rune('%') {
+										fail("'%recover'")
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('c') {
+										fail("'c'")
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('o') {
+										fail("'o'")
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('v') {
+										fail("'v'")
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l278
+									}
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l278
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l278
+									}
+									if !_rules[ruleOpen]() {
+										goto l278
+									}
+									if !_rules[ruleExpression]() {
+										goto l278
+									}
+									if buffer[position] != rune(',') {
+										fail("','")
+										goto l278
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l278
+									}
+									if !_rules[ruleExpression]() {
+										goto l278
+									}
+									if !_rules[ruleClose]() {
+										goto l278
+									}
+									{
+										add(ruleAction97, position)
+									}
+									add(ruleRecover, position333)
+								}
+							}
+						l327:
+							break
+						case '(':
+							fail("[A-Z]")
+							fail("'_'")
+							fail("[a-z]")
+							fail("'\"'")
+							fail("'`'")
+							fail("'\\''")
+							fail("'['")
+							fail("'.'")
+							fail("'{'")
+							fail("'%keyword'")
+							fail("'%recover'")
+							fail("'<'")
+							if !_rules[ruleOpen]() {
+								goto l278
+							}
+							if !_rules[ruleExpression]() {
+								goto l278
+							}
+							if !_rules[ruleClose]() {
+								goto l278
+							}
+						case '.':
+							fail("[A-Z]")
+							fail("'_'")
+							fail("[a-z]")
+							fail("'('")
+							fail("'\"'")
+							fail("'`'")
+							fail("'\\''")
+							fail("'['")
+							fail("'{'")
+							fail("'%keyword'")
+							fail("'%recover'")
+							fail("'<'")
+							{
+								position335 := position
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l278
+								}
+								add(ruleDot, position335)
+							}
+							{
+								add(ruleAction50, position)
+							}
+						case '<':
+							fail("[A-Z]")
+							fail("'_'")
+							fail("[a-z]")
+							fail("'('")
+							fail("'\"'")
+							fail("'`'")
+							fail("'\\''")
+							fail("'['")
+							fail("'.'")
+							fail("'{'")
+							fail("'%keyword'")
+							fail("'%recover'")
+							{
+								position337 := position
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l278
+								}
+								add(ruleBegin, position337)
+							}
+							if !_rules[ruleExpression]() {
+								goto l278
+							}
+							{
+								position338 := position
+								if buffer[position] != rune('>') {
+									fail("'>'")
+									goto l278
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l278
+								}
+								add(ruleEnd, position338)
+							}
+							{
+								add(ruleAction52, position)
+							}
+						case '[':
+							fail("[A-Z]")
+							fail("'_'")
+							fail("[a-z]")
+							fail("'('")
+							fail("'\"'")
+							fail("'`'")
+							fail("'\\''")
+							fail("'.'")
+							fail("'{'")
+							fail("'%keyword'")
+							fail("'%recover'")
+							fail("'<'")
+							if !_rules[ruleClass]() {
+								goto l278
+							}
+						case '{':
+							fail("[A-Z]")
+							fail("'_'")
+							fail("[a-z]")
+							fail("'('")
+							fail("'\"'")
+							fail("'`'")
+							fail("'\\''")
+							fail("'['")
+							fail("'.'")
+							fail("'%keyword'")
+							fail("'%recover'")
+							fail("'<'")
+							if !_rules[ruleAction]() {
+								goto l278
+							}
+							{
+								add(ruleAction51, position)
+							}
+						default:
+							fail("'('")
+							fail("'\"'")
+							fail("'`'")
+							fail("'\\''")
+							fail("'['")
+							fail("'.'")
+							fail("'{'")
+							fail("'%keyword'")
+							fail("'%recover'")
+							fail("'<'")
+							if !_rules[ruleIdentifier]() {
+								goto l278
+							}
+							{
+								position341, tokenIndex341 := position, tokenIndex
+								if !_rules[ruleLeftArrow]() {
+									goto l341
+								}
+								goto l278
+							l341:
+								position, tokenIndex = position341, tokenIndex341
+							}
+							{
+								add(ruleAction49, position)
+							}
+						}
+					}
+
+					add(rulePrimary, position280)
+				}
+				{
+					position343, tokenIndex343 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '*':
+							{
+								position346 := position
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l343
+								}
+								add(ruleStar, position346)
+							}
+							{
+								add(ruleAction47, position)
+							}
+						case '+':
+							{
+								position348 := position
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l343
+								}
+								add(rulePlus, position348)
+							}
+							{
+								add(ruleAction48, position)
+							}
+						default:
+							fail("'*'")
+							fail("'+'")
+							{
+								position350 := position
+								if buffer[position] != rune('?') {
+									fail("'?'")
+									goto l343
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l343
+								}
+								add(ruleQuestion, position350)
+							}
+							{
+								add(ruleAction46, position)
+							}
+						}
+					}
+
+					goto l344
+				l343:
+					position, tokenIndex = position343, tokenIndex343
+				}
+			l344:
+				add(ruleSuffix, position279)
+			}
+			memoize(12, position278, tokenIndex278, true)
+			return true
+		l278:
+			memoize(12, position278, tokenIndex278, false)
+			position, tokenIndex = position278, tokenIndex278
+			return false
+		},
+		/* 13 Primary <- <((&('"' | '\'' | '`') %fail([A-Z] '_' [a-z] '(' '[' '.' '{' '%keyword' '%recover' '<') Literal) | (&('%') %fail([A-Z] '_' [a-z] '(' '"' '`' '\'' '[' '.' '{' '<') (KeywordSet / Recover)) | (&('(') %fail([A-Z] '_' [a-z] '"' '`' '\'' '[' '.' '{' '%keyword' '%recover' '<') (Open Expression Close)) | (&('.') %fail([A-Z] '_' [a-z] '(' '"' '`' '\'' '[' '{' '%keyword' '%recover' '<') (Dot Action50)) | (&('<') %fail([A-Z] '_' [a-z] '(' '"' '`' '\'' '[' '.' '{' '%keyword' '%recover') (Begin Expression End Action52)) | (&('[') %fail([A-Z] '_' [a-z] '(' '"' '`' '\'' '.' '{' '%keyword' '%recover' '<') Class) | (&('{') %fail([A-Z] '_' [a-z] '(' '"' '`' '\'' '[' '.' '%keyword' '%recover' '<') (Action Action51)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('(' '"' '`' '\'' '[' '.' '{' '%keyword' '%recover' '<') (Identifier !LeftArrow Action49)))> */
+		nil,
+		/* 14 Identifier <- <(<(IdentStart IdentCont*)> Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{14, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position353, tokenIndex353 := position, tokenIndex
+			{
+				position354 := position
+				{
+					position355 := position
+					if !_rules[ruleIdentStart]() {
+						goto l353
+					}
+				l356:
+					{
+						position357, tokenIndex357 := position, tokenIndex
+						if !_rules[ruleIdentCont]() {
+							goto l357
+						}
+						goto l356
+					l357:
+						position, tokenIndex = position357, tokenIndex357
+					}
+					add(rulePegText, position355)
+				}
+				if !_rules[ruleSpacing]() {
+					goto l353
+				}
+				add(ruleIdentifier, position354)
+			}
+			memoize(14, position353, tokenIndex353, true)
+			return true
+		l353:
+			memoize(14, position353, tokenIndex353, false)
+			position, tokenIndex = position353, tokenIndex353
+			return false
+		},
+		/* 15 IdentStart <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([A-Z] '_') [a-z]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{15, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position358, tokenIndex358 := position, tokenIndex
+			{
+				position359 := position
+				{
+					switch buffer[position] {
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						position++
+					case '_':
+						position++
+					default:
+						fail("[A-Z]")
+						fail("'_'")
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							fail("[a-z]")
+							goto l358
+						}
+						position++
+					}
+				}
+
+				add(ruleIdentStart, position359)
+			}
+			memoize(15, position358, tokenIndex358, true)
+			return true
+		l358:
+			memoize(15, position358, tokenIndex358, false)
+			position, tokenIndex = position358, tokenIndex358
+			return false
+		},
+		/* 16 IdentCont <- <(IdentStart / [0-9])> */
+		func() bool {
+			memoized, ok := memoization[memoKey{16, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position361, tokenIndex361 := position, tokenIndex
+			{
+				position362 := position
+				{
+					position363, tokenIndex363 := position, tokenIndex
+					if !_rules[ruleIdentStart]() {
+						goto l364
+					}
+					goto l363
+				l364:
+					position, tokenIndex = position363, tokenIndex363
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						fail("[0-9]")
+						goto l361
+					}
+					position++
+				}
+			l363:
+				add(ruleIdentCont, position362)
+			}
+			memoize(16, position361, tokenIndex361, true)
+			return true
+		l361:
+			memoize(16, position361, tokenIndex361, false)
+			position, tokenIndex = position361, tokenIndex361
+			return false
+		},
+		/* 17 Literal <- <(LiteralBody Action53)> */
+		nil,
+		/* 18 LiteralBody <- <((&('"') ('"' (((!'"' Char)? (!'"' Char Action56)* '"' 's' !IdentCont Spacing) / ((!'"' DoubleChar)? (!'"' DoubleChar Action57)* '"' Spacing)))) | (&('`') ('`' (!'`' RawChar)? (!'`' RawChar Action58)* '`' Spacing)) | (&('\'') %fail('"' '`') ('\'' (((!'\'' Char)? (!'\'' Char Action54)* '\'' 's' !IdentCont Spacing) / ((!'\'' LiteralChar)? (!'\'' LiteralChar Action55)* '\'' Spacing)))))> */
+		nil,
+		/* 19 Class <- <(('[' (('[' (('^' DoubleRanges Action59) / DoubleRanges)? ']' ']') / ((('^' Ranges Action60) / Ranges)? ']'))) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{19, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position367, tokenIndex367 := position, tokenIndex
+			{
+				position368 := position
+				if buffer[position] != rune('[') {
+					fail("'['")
+					goto l367
+				}
+				position++
+				{
+					position369, tokenIndex369 := position, tokenIndex
+					if buffer[position] != rune('[') {
+						fail("'['")
+						goto l370
+					}
+					position++
+					{
+						position371, tokenIndex371 := position, tokenIndex
+						{
+							position373, tokenIndex373 := position, tokenIndex
+							if buffer[position] != rune('^') {
+								fail("'^'")
+								goto l374
+							}
+							position++
+							if !_rules[ruleDoubleRanges]() {
+								goto l374
+							}
+							{
+								add(ruleAction59, position)
+							}
+							goto l373
+						l374:
+							position, tokenIndex = position373, tokenIndex373
+							if !_rules[ruleDoubleRanges]() {
+								goto l371
+							}
+						}
+					l373:
+						goto l372
+					l371:
+						position, tokenIndex = position371, tokenIndex371
+					}
+				l372:
+					if buffer[position] != rune(']') {
+						fail("']]'")
+						goto l370
+					}
+					position++
+					if buffer[position] != rune(']') {
+						fail("']'")
+						goto l370
+					}
+					position++
+					goto l369
+				l370:
+					position, tokenIndex = position369, tokenIndex369
+					{
+						position376, tokenIndex376 := position, tokenIndex
+						{
+							position378, tokenIndex378 := position, tokenIndex
+							if buffer[position] != rune('^') {
+								fail("'^'")
+								goto l379
+							}
+							position++
+							if !_rules[ruleRanges]() {
+								goto l379
+							}
+							{
+								add(ruleAction60, position)
+							}
+							goto l378
+						l379:
+							position, tokenIndex = position378, tokenIndex378
+							if !_rules[ruleRanges]() {
+								goto l376
+							}
+						}
+					l378:
+						goto l377
+					l376:
+						position, tokenIndex = position376, tokenIndex376
+					}
+				l377:
+					if buffer[position] != rune(']') {
+						fail("']'")
+						goto l367
+					}
+					position++
+				}
+			l369:
+				if !_rules[ruleSpacing]() {
+					goto l367
+				}
+				add(ruleClass, position368)
+			}
+			memoize(19, position367, tokenIndex367, true)
+			return true
+		l367:
+			memoize(19, position367, tokenIndex367, false)
+			position, tokenIndex = position367, tokenIndex367
+			return false
+		},
+		/* 20 Ranges <- <(!']' Range (!']' Range Action61)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{20, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position381, tokenIndex381 := position, tokenIndex
+			{
+				position382 := position
+				{
+					position383, tokenIndex383 := position, tokenIndex
+					if buffer[position] != rune(']') {
+						fail("']'")
+						goto l383
+					}
+					position++
+					goto l381
+				l383:
+					position, tokenIndex = position383, tokenIndex383
+				}
+				if !_rules[ruleRange]() {
+					goto l381
+				}
+			l384:
+				{
+					position385, tokenIndex385 := position, tokenIndex
+					{
+						position386, tokenIndex386 := position, tokenIndex
+						if buffer[position] != rune(']') {
+							fail("']'")
+							goto l386
+						}
+						position++
+						goto l385
+					l386:
+						position, tokenIndex = position386, tokenIndex386
+					}
+					if !_rules[ruleRange]() {
+						goto l385
+					}
+					{
+						add(ruleAction61, position)
+					}
+					goto l384
+				l385:
+					position, tokenIndex = position385, tokenIndex385
+				}
+				add(ruleRanges, position382)
+			}
+			memoize(20, position381, tokenIndex381, true)
+			return true
+		l381:
+			memoize(20, position381, tokenIndex381, false)
+			position, tokenIndex = position381, tokenIndex381
+			return false
+		},
+		/* 21 DoubleRanges <- <(!(']' ']') DoubleRange (!(']' ']') DoubleRange Action62)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{21, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position388, tokenIndex388 := position, tokenIndex
+			{
+				position389 := position
+				{
+					position390, tokenIndex390 := position, tokenIndex
+					if buffer[position] != rune(']') {
+						fail("']]'")
+						goto l390
+					}
+					position++
+					if buffer[position] != rune(']') {
+						fail("']'")
+						goto l390
+					}
+					position++
+					goto l388
+				l390:
+					position, tokenIndex = position390, tokenIndex390
+				}
+				if !_rules[ruleDoubleRange]() {
+					goto l388
+				}
+			l391:
+				{
+					position392, tokenIndex392 := position, tokenIndex
+					{
+						position393, tokenIndex393 := position, tokenIndex
+						if buffer[position] != rune(']') {
+							fail("']]'")
+							goto l393
+						}
+						position++
+						if buffer[position] != rune(']') {
+							fail("']'")
+							goto l393
+						}
+						position++
+						goto l392
+					l393:
+						position, tokenIndex = position393, tokenIndex393
+					}
+					if !_rules[ruleDoubleRange]() {
+						goto l392
+					}
+					{
+						add(ruleAction62, position)
+					}
+					goto l391
+				l392:
+					position, tokenIndex = position392, tokenIndex392
+				}
+				add(ruleDoubleRanges, position389)
+			}
+			memoize(21, position388, tokenIndex388, true)
+			return true
+		l388:
+			memoize(21, position388, tokenIndex388, false)
+			position, tokenIndex = position388, tokenIndex388
+			return false
+		},
+		/* 22 Range <- <(Property / (Char (('-' Char Action63) / )))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{22, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position395, tokenIndex395 := position, tokenIndex
+			{
+				position396 := position
+				{
+					position397, tokenIndex397 := position, tokenIndex
+					if !_rules[ruleProperty]() {
+						goto l398
+					}
+					goto l397
+				l398:
+					position, tokenIndex = position397, tokenIndex397
+					if !_rules[ruleChar]() {
+						goto l395
+					}
+					{
+						position399, tokenIndex399 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							fail("'-'")
+							goto l400
+						}
+						position++
+						if !_rules[ruleChar]() {
+							goto l400
+						}
+						{
+							add(ruleAction63, position)
+						}
+						goto l399
+					l400:
+						position, tokenIndex = position399, tokenIndex399
+					}
+				l399:
+				}
+			l397:
+				add(ruleRange, position396)
+			}
+			memoize(22, position395, tokenIndex395, true)
+			return true
+		l395:
+			memoize(22, position395, tokenIndex395, false)
+			position, tokenIndex = position395, tokenIndex395
+			return false
+		},
+		/* 23 DoubleRange <- <(Property / (Char '-' Char Action64) / DoubleChar)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{23, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position402, tokenIndex402 := position, tokenIndex
+			{
+				position403 := position
+				{
+					position404, tokenIndex404 := position, tokenIndex
+					if !_rules[ruleProperty]() {
+						goto l405
+					}
+					goto l404
+				l405:
+					position, tokenIndex = position404, tokenIndex404
+					if !_rules[ruleChar]() {
+						goto l406
+					}
+					if buffer[position] != rune('-') {
+						fail("'-'")
+						goto l406
+					}
+					position++
+					if !_rules[ruleChar]() {
+						goto l406
+					}
+					{
+						add(ruleAction64, position)
+					}
+					goto l404
+				l406:
+					position, tokenIndex = position404, tokenIndex404
+					if !_rules[ruleDoubleChar]() {
+						goto l402
+					}
+				}
+			l404:
+				add(ruleDoubleRange, position403)
+			}
+			memoize(23, position402, tokenIndex402, true)
+			return true
+		l402:
+			memoize(23, position402, tokenIndex402, false)
+			position, tokenIndex = position402, tokenIndex402
+			return false
+		},
+		/* 24 Property <- <('\\' <(('p' / 'P') (('{' ((&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([A-Z] '_') [a-z]))+ '}') / [A-Z]))> Action65)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{24, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position408, tokenIndex408 := position, tokenIndex
+			{
+				position409 := position
+				if buffer[position] != rune('\\') {
+					fail("'\\\\'")
+					goto l408
+				}
+				position++
+				{
+					position410 := position
+					{
+						position411, tokenIndex411 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							fail("'p'")
+							goto l412
+						}
+						position++
+						goto l411
+					l412:
+						position, tokenIndex = position411, tokenIndex411
+						if buffer[position] != rune('P') {
+							fail("'P'")
+							goto l408
+						}
+						position++
+					}
+				l411:
+					{
+						position413, tokenIndex413 := position, tokenIndex
+						if buffer[position] != rune('{') {
+							fail("'{'")
+							goto l414
+						}
+						position++
+						{
+							switch buffer[position] {
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l414
+								}
+								position++
+							}
+						}
+
+					l415:
+						{
+							position416, tokenIndex416 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l416
+									}
+									position++
+								}
+							}
+
+							goto l415
+						l416:
+							position, tokenIndex = position416, tokenIndex416
+						}
+						if buffer[position] != rune('}') {
+							fail("'}'")
+							goto l414
+						}
+						position++
+						goto l413
+					l414:
+						position, tokenIndex = position413, tokenIndex413
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							fail("[A-Z]")
+							goto l408
+						}
+						position++
+					}
+				l413:
+					add(rulePegText, position410)
+				}
+				{
+					add(ruleAction65, position)
+				}
+				add(ruleProperty, position409)
+			}
+			memoize(24, position408, tokenIndex408, true)
+			return true
+		l408:
+			memoize(24, position408, tokenIndex408, false)
+			position, tokenIndex = position408, tokenIndex408
+			return false
+		},
+		/* 25 Char <- <(Escape / (!'\\' <.> Action66))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{25, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position420, tokenIndex420 := position, tokenIndex
+			{
+				position421 := position
+				{
+					position422, tokenIndex422 := position, tokenIndex
+					if !_rules[ruleEscape]() {
+						goto l423
+					}
+					goto l422
+				l423:
+					position, tokenIndex = position422, tokenIndex422
+					{
+						position424, tokenIndex424 := position, tokenIndex
+						if buffer[position] != rune('\\') {
+							fail("'\\\\'")
+							goto l424
+						}
+						position++
+						goto l420
+					l424:
+						position, tokenIndex = position424, tokenIndex424
+					}
+					{
+						position425 := position
+						if !matchDot() {
+							fail(".")
+							goto l420
+						}
+						add(rulePegText, position425)
+					}
+					{
+						add(ruleAction66, position)
+					}
+				}
+			l422:
+				add(ruleChar, position421)
+			}
+			memoize(25, position420, tokenIndex420, true)
+			return true
+		l420:
+			memoize(25, position420, tokenIndex420, false)
+			position, tokenIndex = position420, tokenIndex420
+			return false
+		},
+		/* 26 LiteralChar <- <(Escape / (!'\\' <.> Action67))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{26, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position427, tokenIndex427 := position, tokenIndex
+			{
+				position428 := position
+				{
+					position429, tokenIndex429 := position, tokenIndex
+					if !_rules[ruleEscape]() {
+						goto l430
+					}
+					goto l429
+				l430:
+					position, tokenIndex = position429, tokenIndex429
+					{
+						position431, tokenIndex431 := position, tokenIndex
+						if buffer[position] != rune('\\') {
+							fail("'\\\\'")
+							goto l431
+						}
+						position++
+						goto l427
+					l431:
+						position, tokenIndex = position431, tokenIndex431
+					}
+					{
+						position432 := position
+						if !matchDot() {
+							fail(".")
+							goto l427
+						}
+						add(rulePegText, position432)
+					}
+					{
+						add(ruleAction67, position)
+					}
+				}
+			l429:
+				add(ruleLiteralChar, position428)
+			}
+			memoize(26, position427, tokenIndex427, true)
+			return true
+		l427:
+			memoize(26, position427, tokenIndex427, false)
+			position, tokenIndex = position427, tokenIndex427
+			return false
+		},
+		/* 27 RawChar <- <(<.> Action68)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{27, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position434, tokenIndex434 := position, tokenIndex
+			{
+				position435 := position
+				{
+					position436 := position
+					if !matchDot() {
+						fail(".")
+						goto l434
+					}
+					add(rulePegText, position436)
+				}
+				{
+					add(ruleAction68, position)
+				}
+				add(ruleRawChar, position435)
+			}
+			memoize(27, position434, tokenIndex434, true)
+			return true
+		l434:
+			memoize(27, position434, tokenIndex434, false)
+			position, tokenIndex = position434, tokenIndex434
+			return false
+		},
+		/* 28 DoubleChar <- <(Escape / (!'\\' <.> Action69))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{28, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position438, tokenIndex438 := position, tokenIndex
+			{
+				position439 := position
+				{
+					position440, tokenIndex440 := position, tokenIndex
+					if !_rules[ruleEscape]() {
+						goto l441
+					}
+					goto l440
+				l441:
+					position, tokenIndex = position440, tokenIndex440
+					{
+						position442, tokenIndex442 := position, tokenIndex
+						if buffer[position] != rune('\\') {
+							fail("'\\\\'")
+							goto l442
+						}
+						position++
+						goto l438
+					l442:
+						position, tokenIndex = position442, tokenIndex442
+					}
+					{
+						position443 := position
+						if !matchDot() {
+							fail(".")
+							goto l438
+						}
+						add(rulePegText, position443)
+					}
+					{
+						add(ruleAction69, position)
+					}
+				}
+			l440:
+				add(ruleDoubleChar, position439)
+			}
+			memoize(28, position438, tokenIndex438, true)
+			return true
+		l438:
+			memoize(28, position438, tokenIndex438, false)
+			position, tokenIndex = position438, tokenIndex438
+			return false
+		},
+		/* 29 Escape <- <('\\' ((('a' / 'A') Action70) / (('b' / 'B') Action71) / (('e' / 'E') Action72) / (('f' / 'F') Action73) / (('n' / 'N') Action74) / (('r' / 'R') Action75) / (('t' / 'T') Action76) / (('v' / 'V') Action77) / ('\'' Action78) / ('"' Action79) / ('[' Action80) / (']' Action81) / ('-' Action82) / ('x' (('{' <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-F] [a-f]) [0-9]))+> '}' Action83) / (<(HexDigit HexDigit)> Action84))) / ('u' <(HexDigit HexDigit HexDigit HexDigit)> Action85) / ('U' <(HexDigit HexDigit HexDigit HexDigit HexDigit HexDigit HexDigit HexDigit)> Action86) / ('0' ('x' / 'X') <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-F] [a-f]) [0-9]))+> Action87) / (<([0-3] [0-7] [0-7])> Action88) / (<([0-7] [0-7]?)> Action89) / ('\\' Action90) / (<.> Action91)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{29, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position445, tokenIndex445 := position, tokenIndex
+			{
+				position446 := position
+				if buffer[position] != rune('\\') {
+					fail("'\\\\'")
+					goto l445
+				}
+				position++
+				{
+					position447, tokenIndex447 := position, tokenIndex
+					{
+						position449, tokenIndex449 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							fail("'a'")
+							goto l450
+						}
+						position++
+						goto l449
+					l450:
+						position, tokenIndex = position449, tokenIndex449
+						if buffer[position] != rune('A') {
+							fail("'A'")
+							goto l448
+						}
+						position++
+					}
+				l449:
+					{
+						add(ruleAction70, position)
+					}
+					goto l447
+				l448:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position453, tokenIndex453 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							fail("'b'")
+							goto l454
+						}
+						position++
+						goto l453
+					l454:
+						position, tokenIndex = position453, tokenIndex453
+						if buffer[position] != rune('B') {
+							fail("'B'")
+							goto l452
+						}
+						position++
+					}
+				l453:
+					{
+						add(ruleAction71, position)
+					}
+					goto l447
+				l452:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position457, tokenIndex457 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							fail("'e'")
+							goto l458
+						}
+						position++
+						goto l457
+					l458:
+						position, tokenIndex = position457, tokenIndex457
+						if buffer[position] != rune('E') {
+							fail("'E'")
+							goto l456
+						}
+						position++
+					}
+				l457:
+					{
+						add(ruleAction72, position)
+					}
+					goto l447
+				l456:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position461, tokenIndex461 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							fail("'f'")
+							goto l462
+						}
+						position++
+						goto l461
+					l462:
+						position, tokenIndex = position461, tokenIndex461
+						if buffer[position] != rune('F') {
+							fail("'F'")
+							goto l460
+						}
+						position++
+					}
+				l461:
+					{
+						add(ruleAction73, position)
+					}
+					goto l447
+				l460:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position465, tokenIndex465 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							fail("'n'")
+							goto l466
+						}
+						position++
+						goto l465
+					l466:
+						position, tokenIndex = position465, tokenIndex465
+						if buffer[position] != rune('N') {
+							fail("'N'")
+							goto l464
+						}
+						position++
+					}
+				l465:
+					{
+						add(ruleAction74, position)
+					}
+					goto l447
+				l464:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position469, tokenIndex469 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							fail("'r'")
+							goto l470
+						}
+						position++
+						goto l469
+					l470:
+						position, tokenIndex = position469, tokenIndex469
+						if buffer[position] != rune('R') {
+							fail("'R'")
+							goto l468
+						}
+						position++
+					}
+				l469:
+					{
+						add(ruleAction75, position)
+					}
+					goto l447
+				l468:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position473, tokenIndex473 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							fail("'t'")
+							goto l474
+						}
+						position++
+						goto l473
+					l474:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('T') {
+							fail("'T'")
+							goto l472
+						}
+						position++
+					}
+				l473:
+					{
+						add(ruleAction76, position)
+					}
+					goto l447
+				l472:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position477, tokenIndex477 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							fail("'v'")
+							goto l478
+						}
+						position++
+						goto l477
+					l478:
+						position, tokenIndex = position477, tokenIndex477
+						if buffer[position] != rune('V') {
+							fail("'V'")
+							goto l476
+						}
+						position++
+					}
+				l477:
+					{
+						add(ruleAction77, position)
+					}
+					goto l447
+				l476:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('\'') {
+						fail("'\\''")
+						goto l480
+					}
+					position++
+					{
+						add(ruleAction78, position)
+					}
+					goto l447
+				l480:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('"') {
+						fail("'\"'")
+						goto l482
+					}
+					position++
+					{
+						add(ruleAction79, position)
+					}
+					goto l447
+				l482:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('[') {
+						fail("'['")
+						goto l484
+					}
+					position++
+					{
+						add(ruleAction80, position)
+					}
+					goto l447
+				l484:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune(']') {
+						fail("']'")
+						goto l486
+					}
+					position++
+					{
+						add(ruleAction81, position)
+					}
+					goto l447
+				l486:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('-') {
+						fail("'-'")
+						goto l488
+					}
+					position++
+					{
+						add(ruleAction82, position)
+					}
+					goto l447
+				l488:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('x') {
+						fail("'x'")
+						goto l490
+					}
+					position++
+					{
+						position491, tokenIndex491 := position, tokenIndex
+						if buffer[position] != rune('{') {
+							fail("'{'")
+							goto l492
+						}
+						position++
+						{
+							position493 := position
+							{
+								switch buffer[position] {
+								case 'A', 'B', 'C', 'D', 'E', 'F':
+									position++
+								case 'a', 'b', 'c', 'd', 'e', 'f':
+									position++
+								default:
+									fail("[A-F]")
+									fail("[a-f]")
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										fail("[0-9]")
+										goto l492
+									}
+									position++
+								}
+							}
+
+						l494:
+							{
+								position495, tokenIndex495 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case 'A', 'B', 'C', 'D', 'E', 'F':
+										position++
+									case 'a', 'b', 'c', 'd', 'e', 'f':
+										position++
+									default:
+										fail("[A-F]")
+										fail("[a-f]")
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											fail("[0-9]")
+											goto l495
+										}
+										position++
+									}
+								}
+
+								goto l494
+							l495:
+								position, tokenIndex = position495, tokenIndex495
+							}
+							add(rulePegText, position493)
+						}
+						if buffer[position] != rune('}') {
+							fail("'}'")
+							goto l492
+						}
+						position++
+						{
+							add(ruleAction83, position)
+						}
+						goto l491
+					l492:
+						position, tokenIndex = position491, tokenIndex491
+						{
+							position499 := position
+							if !_rules[ruleHexDigit]() {
+								goto l490
+							}
+							if !_rules[ruleHexDigit]() {
+								goto l490
+							}
+							add(rulePegText, position499)
+						}
+						{
+							add(ruleAction84, position)
+						}
+					}
+				l491:
+					goto l447
+				l490:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('u') {
+						fail("'u'")
+						goto l501
+					}
+					position++
+					{
+						position502 := position
+						if !_rules[ruleHexDigit]() {
+							goto l501
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l501
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l501
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l501
+						}
+						add(rulePegText, position502)
+					}
+					{
+						add(ruleAction85, position)
+					}
+					goto l447
+				l501:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('U') {
+						fail("'U'")
+						goto l504
+					}
+					position++
+					{
+						position505 := position
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						if !_rules[ruleHexDigit]() {
+							goto l504
+						}
+						add(rulePegText, position505)
+					}
+					{
+						add(ruleAction86, position)
+					}
+					goto l447
+				l504:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('0') {
+						fail("'0x'")
+						goto l507
+					}
+					position++
+					{
+						position508, tokenIndex508 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							fail("'x'")
+							goto l509
+						}
+						position++
+						goto l508
+					l509:
+						position, tokenIndex = position508, tokenIndex508
+						if buffer[position] != rune('X') {
+							fail("'X'")
+							goto l507
+						}
+						position++
+					}
+				l508:
+					{
+						position510 := position
+						{
+							switch buffer[position] {
+							case 'A', 'B', 'C', 'D', 'E', 'F':
+								position++
+							case 'a', 'b', 'c', 'd', 'e', 'f':
+								position++
+							default:
+								fail("[A-F]")
+								fail("[a-f]")
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									fail("[0-9]")
+									goto l507
+								}
+								position++
+							}
+						}
+
+					l511:
+						{
+							position512, tokenIndex512 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case 'A', 'B', 'C', 'D', 'E', 'F':
+									position++
+								case 'a', 'b', 'c', 'd', 'e', 'f':
+									position++
+								default:
+									fail("[A-F]")
+									fail("[a-f]")
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										fail("[0-9]")
+										goto l512
+									}
+									position++
+								}
+							}
+
+							goto l511
+						l512:
+							position, tokenIndex = position512, tokenIndex512
+						}
+						add(rulePegText, position510)
+					}
+					{
+						add(ruleAction87, position)
+					}
+					goto l447
+				l507:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position517 := position
+						if c := buffer[position]; c < rune('0') || c > rune('3') {
+							fail("[0-3]")
+							goto l516
+						}
+						position++
+						if c := buffer[position]; c < rune('0') || c > rune('7') {
+							fail("[0-7]")
+							goto l516
+						}
+						position++
+						if c := buffer[position]; c < rune('0') || c > rune('7') {
+							fail("[0-7]")
+							goto l516
+						}
+						position++
+						add(rulePegText, position517)
+					}
+					{
+						add(ruleAction88, position)
+					}
+					goto l447
+				l516:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position520 := position
+						if c := buffer[position]; c < rune('0') || c > rune('7') {
+							fail("[0-7]")
+							goto l519
+						}
+						position++
+						{
+							position521, tokenIndex521 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('7') {
+								fail("[0-7]")
+								goto l521
+							}
+							position++
+							goto l522
+						l521:
+							position, tokenIndex = position521, tokenIndex521
+						}
+					l522:
+						add(rulePegText, position520)
+					}
+					{
+						add(ruleAction89, position)
+					}
+					goto l447
+				l519:
+					position, tokenIndex = position447, tokenIndex447
+					if buffer[position] != rune('\\') {
+						fail("'\\\\'")
+						goto l524
+					}
+					position++
+					{
+						add(ruleAction90, position)
+					}
+					goto l447
+				l524:
+					position, tokenIndex = position447, tokenIndex447
+					{
+						position526 := position
+						if !matchDot() {
+							fail(".")
+							goto l445
+						}
+						add(rulePegText, position526)
+					}
+					{
+						add(ruleAction91, position)
+					}
+				}
+			l447:
+				add(ruleEscape, position446)
+			}
+			memoize(29, position445, tokenIndex445, true)
+			return true
+		l445:
+			memoize(29, position445, tokenIndex445, false)
+			position, tokenIndex = position445, tokenIndex445
+			return false
+		},
+		/* 30 HexDigit <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-F] [a-f]) [0-9]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{30, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position528, tokenIndex528 := position, tokenIndex
+			{
+				position529 := position
+				{
+					switch buffer[position] {
+					case 'A', 'B', 'C', 'D', 'E', 'F':
+						position++
+					case 'a', 'b', 'c', 'd', 'e', 'f':
+						position++
+					default:
+						fail("[A-F]")
+						fail("[a-f]")
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							fail("[0-9]")
+							goto l528
+						}
+						position++
+					}
+				}
+
+				add(ruleHexDigit, position529)
+			}
+			memoize(30, position528, tokenIndex528, true)
+			return true
+		l528:
+			memoize(30, position528, tokenIndex528, false)
+			position, tokenIndex = position528, tokenIndex528
+			return false
+		},
+		/* 31 LeftArrow <- <((('<' '-') / '←') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{31, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position531, tokenIndex531 := position, tokenIndex
+			{
+				position532 := position
+				{
+					position533, tokenIndex533 := position, tokenIndex
+					if buffer[position] != rune('<') {
+						fail("'<-'")
+						goto l534
+					}
+					position++
+					if buffer[position] != rune('-') {
+						fail("'-'")
+						goto l534
+					}
+					position++
+					goto l533
+				l534:
+					position, tokenIndex = position533, tokenIndex533
+					if buffer[position] != rune('←') {
+						fail("'←'")
+						goto l531
+					}
+					position++
+				}
+			l533:
+				if !_rules[ruleSpacing]() {
+					goto l531
+				}
+				add(ruleLeftArrow, position532)
+			}
+			memoize(31, position531, tokenIndex531, true)
+			return true
+		l531:
+			memoize(31, position531, tokenIndex531, false)
+			position, tokenIndex = position531, tokenIndex531
+			return false
+		},
+		/* 32 Slash <- <('/' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{32, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position535, tokenIndex535 := position, tokenIndex
+			{
+				position536 := position
+				if buffer[position] != rune('/') {
+					fail("'/'")
+					goto l535
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l535
+				}
+				add(ruleSlash, position536)
+			}
+			memoize(32, position535, tokenIndex535, true)
+			return true
+		l535:
+			memoize(32, position535, tokenIndex535, false)
+			position, tokenIndex = position535, tokenIndex535
+			return false
+		},
+		/* 33 And <- <('&' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{33, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position537, tokenIndex537 := position, tokenIndex
+			{
+				position538 := position
+				if buffer[position] != rune('&') {
+					fail("'&'")
+					goto l537
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l537
+				}
+				add(ruleAnd, position538)
+			}
+			memoize(33, position537, tokenIndex537, true)
+			return true
+		l537:
+			memoize(33, position537, tokenIndex537, false)
+			position, tokenIndex = position537, tokenIndex537
+			return false
+		},
+		/* 34 Not <- <('!' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{34, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position539, tokenIndex539 := position, tokenIndex
+			{
+				position540 := position
+				if buffer[position] != rune('!') {
+					fail("'!'")
+					goto l539
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l539
+				}
+				add(ruleNot, position540)
+			}
+			memoize(34, position539, tokenIndex539, true)
+			return true
+		l539:
+			memoize(34, position539, tokenIndex539, false)
+			position, tokenIndex = position539, tokenIndex539
+			return false
+		},
+		/* 35 Question <- <('?' Spacing)> */
+		nil,
+		/* 36 Star <- <('*' Spacing)> */
+		nil,
+		/* 37 Plus <- <('+' Spacing)> */
+		nil,
+		/* 38 Open <- <('(' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{38, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position544, tokenIndex544 := position, tokenIndex
+			{
+				position545 := position
+				if buffer[position] != rune('(') {
+					fail("'('")
+					goto l544
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l544
+				}
+				add(ruleOpen, position545)
+			}
+			memoize(38, position544, tokenIndex544, true)
+			return true
+		l544:
+			memoize(38, position544, tokenIndex544, false)
+			position, tokenIndex = position544, tokenIndex544
+			return false
+		},
+		/* 39 Close <- <(')' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{39, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position546, tokenIndex546 := position, tokenIndex
+			{
+				position547 := position
+				if buffer[position] != rune(')') {
+					fail("')'")
+					goto l546
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l546
+				}
+				add(ruleClose, position547)
+			}
+			memoize(39, position546, tokenIndex546, true)
+			return true
+		l546:
+			memoize(39, position546, tokenIndex546, false)
+			position, tokenIndex = position546, tokenIndex546
+			return false
+		},
+		/* 40 Dot <- <('.' Spacing)> */
+		nil,
+		/* 41 SpaceComment <- <(Space / Comment)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{41, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position549, tokenIndex549 := position, tokenIndex
+			{
+				position550 := position
+				{
+					position551, tokenIndex551 := position, tokenIndex
+					if !_rules[ruleSpace]() {
+						goto l552
+					}
+					goto l551
+				l552:
+					position, tokenIndex = position551, tokenIndex551
+					{
+						position553 := position
+						{
+							position554, tokenIndex554 := position, tokenIndex
+							if buffer[position] != rune('#') {
+								fail("'#'")
+								goto l555
+							}
+							position++
+							goto l554
+						l555:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('/') {
+								fail("'//'")
+								goto l549
+							}
+							position++
+							if buffer[position] != rune('/') {
+								fail("'/'")
+								goto l549
+							}
+							position++
+						}
+					l554:
+					l556:
+						{
+							position557, tokenIndex557 := position, tokenIndex
+							{
+								position558, tokenIndex558 := position, tokenIndex
+								if !_rules[ruleEndOfLine]() {
+									goto l558
+								}
+								goto l557
+							l558:
+								position, tokenIndex = position558, tokenIndex558
+							}
+							if !matchDot() {
+								fail(".")
+								goto l557
+							}
+							goto l556
+						l557:
+							position, tokenIndex = position557, tokenIndex557
+						}
+						if !_rules[ruleEndOfLine]() {
+							goto l549
+						}
+						add(ruleComment, position553)
+					}
+				}
+			l551:
+				add(ruleSpaceComment, position550)
+			}
+			memoize(41, position549, tokenIndex549, true)
+			return true
+		l549:
+			memoize(41, position549, tokenIndex549, false)
+			position, tokenIndex = position549, tokenIndex549
+			return false
+		},
+		/* 42 Spacing <- <SpaceComment*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{42, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position559, tokenIndex559 := position, tokenIndex
+			{
+				position560 := position
+			l561:
+				{
+					position562, tokenIndex562 := position, tokenIndex
+					if !_rules[ruleSpaceComment]() {
+						goto l562
+					}
+					goto l561
+				l562:
+					position, tokenIndex = position562, tokenIndex562
+				}
+				add(ruleSpacing, position560)
+			}
+			memoize(42, position559, tokenIndex559, true)
+			return true
+		},
+		/* 43 MustSpacing <- <SpaceComment+> */
+		func() bool {
+			memoized, ok := memoization[memoKey{43, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position563, tokenIndex563 := position, tokenIndex
+			{
+				position564 := position
+				if !_rules[ruleSpaceComment]() {
+					goto l563
+				}
+			l565:
+				{
+					position566, tokenIndex566 := position, tokenIndex
+					if !_rules[ruleSpaceComment]() {
+						goto l566
+					}
+					goto l565
+				l566:
+					position, tokenIndex = position566, tokenIndex566
+				}
+				add(ruleMustSpacing, position564)
+			}
+			memoize(43, position563, tokenIndex563, true)
+			return true
+		l563:
+			memoize(43, position563, tokenIndex563, false)
+			position, tokenIndex = position563, tokenIndex563
+			return false
+		},
+		/* 44 Comment <- <(('#' / ('/' '/')) (!EndOfLine .)* EndOfLine)> */
+		nil,
+		/* 45 Space <- <((&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') %fail('\t' ' ') EndOfLine))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{45, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position568, tokenIndex568 := position, tokenIndex
+			{
+				position569 := position
+				{
+					switch buffer[position] {
+					case '\t':
+						position++
+					case ' ':
+						position++
+					default:
+						fail("'\\t'")
+						fail("' '")
+						if !_rules[ruleEndOfLine]() {
+							goto l568
+						}
+					}
+				}
+
+				add(ruleSpace, position569)
+			}
+			memoize(45, position568, tokenIndex568, true)
+			return true
+		l568:
+			memoize(45, position568, tokenIndex568, false)
+			position, tokenIndex = position568, tokenIndex568
+			return false
+		},
+		/* 46 Header <- <HeaderSpaceComment*> */
+		nil,
+		/* 47 HeaderSpaceComment <- <(HeaderComment / (<Space+> Action92))> */
+		nil,
+		/* 48 HeaderComment <- <(('#' / ('/' '/')) <(!EndOfLine .)*> Action93 EndOfLine)> */
+		nil,
+		/* 49 EndOfLine <- <((&('\r') %fail('\n') ('\r' ('\n' / ))) | (&('\n') %fail('\r\n' '\r') '\n'))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{49, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position574, tokenIndex574 := position, tokenIndex
+			{
+				position575 := position
+				{
+					switch buffer[position] {
+					case '\r':
+						fail("'\\n'")
+						position++
+						{
+							position577, tokenIndex577 := position, tokenIndex
+							if buffer[position] != rune('\n') {
+								fail("'\\n'")
+								goto l578
+							}
+							position++
+							goto l577
+						l578:
+							position, tokenIndex = position577, tokenIndex577
+						}
+					l577:
+						break
+					default:
+						fail("'\\r\\n'")
+						fail("'\\r'")
+						if buffer[position] != rune('\n') {
+							fail("'\\n'")
+							goto l574
+						}
+						position++
+					}
+				}
+
+				add(ruleEndOfLine, position575)
+			}
+			memoize(49, position574, tokenIndex574, true)
+			return true
+		l574:
+			memoize(49, position574, tokenIndex574, false)
+			position, tokenIndex = position574, tokenIndex574
+			return false
+		},
+		/* 50 EndOfFile <- <!.> */
+		nil,
+		/* 51 Action <- <('{' <ActionBody*> '}' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{51, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position580, tokenIndex580 := position, tokenIndex
+			{
+				position581 := position
+				if buffer[position] != rune('{') {
+					fail("'{'")
+					goto l580
+				}
+				position++
+				{
+					position582 := position
+				l583:
+					{
+						position584, tokenIndex584 := position, tokenIndex
+						if !_rules[ruleActionBody]() {
+							goto l584
+						}
+						goto l583
+					l584:
+						position, tokenIndex = position584, tokenIndex584
+					}
+					add(rulePegText, position582)
+				}
+				if buffer[position] != rune('}') {
+					fail("'}'")
+					goto l580
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l580
+				}
+				add(ruleAction, position581)
+			}
+			memoize(51, position580, tokenIndex580, true)
+			return true
+		l580:
+			memoize(51, position580, tokenIndex580, false)
+			position, tokenIndex = position580, tokenIndex580
+			return false
+		},
+		/* 52 ActionBody <- <((&('{') %fail([^{}]) ('{' ActionBody* '}')) | (&([\x00-z] | '|' | [~-\U0010ffff]) %fail('{') [^{}]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{52, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position585, tokenIndex585 := position, tokenIndex
+			{
+				position586 := position
+				{
+					switch buffer[position] {
+					case '{':
+						fail("[^{}]")
+						position++
+					l588:
+						{
+							position589, tokenIndex589 := position, tokenIndex
+							if !_rules[ruleActionBody]() {
+								goto l589
+							}
+							goto l588
+						l589:
+							position, tokenIndex = position589, tokenIndex589
+						}
+						if buffer[position] != rune('}') {
+							fail("'}'")
+							goto l585
+						}
+						position++
+					default:
+						fail("'{'")
+						if c := buffer[position]; c == endSymbol || c == rune('{') || c == rune('}') {
+							fail("[^{}]")
+							goto l585
+						}
+						position++
+					}
+				}
+
+				add(ruleActionBody, position586)
+			}
+			memoize(52, position585, tokenIndex585, true)
+			return true
+		l585:
+			memoize(52, position585, tokenIndex585, false)
+			position, tokenIndex = position585, tokenIndex585
+			return false
+		},
+		/* 53 KeywordSet <- <('%' 'k' 'e' 'y' 'w' 'o' 'r' 'd' Spacing Open KeywordName (',' Spacing KeywordName Action94)* Close)> */
+		nil,
+		/* 54 KeywordName <- <(('\'' <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z]))+> '\'' Spacing Action95) / ('"' <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z]))+> '"' Spacing Action96))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{54, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position591, tokenIndex591 := position, tokenIndex
+			{
+				position592 := position
+				{
+					position593, tokenIndex593 := position, tokenIndex
+					if buffer[position] != rune('\'') {
+						fail("'\\''")
+						goto l594
+					}
+					position++
+					{
+						position595 := position
+						{
+							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l594
+								}
+								position++
+							}
+						}
+
+					l596:
+						{
+							position597, tokenIndex597 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l597
+									}
+									position++
+								}
+							}
+
+							goto l596
+						l597:
+							position, tokenIndex = position597, tokenIndex597
+						}
+						add(rulePegText, position595)
+					}
+					if buffer[position] != rune('\'') {
+						fail("'\\''")
+						goto l594
+					}
+					position++
+					if !_rules[ruleSpacing]() {
+						goto l594
+					}
+					{
+						add(ruleAction95, position)
+					}
+					goto l593
+				l594:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('"') {
+						fail("'\"'")
+						goto l591
+					}
+					position++
+					{
+						position601 := position
+						{
+							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l591
+								}
+								position++
+							}
+						}
+
+					l602:
+						{
+							position603, tokenIndex603 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l603
+									}
+									position++
+								}
+							}
+
+							goto l602
+						l603:
+							position, tokenIndex = position603, tokenIndex603
+						}
+						add(rulePegText, position601)
+					}
+					if buffer[position] != rune('"') {
+						fail("'\"'")
+						goto l591
+					}
+					position++
+					if !_rules[ruleSpacing]() {
+						goto l591
+					}
+					{
+						add(ruleAction96, position)
+					}
+				}
+			l593:
+				add(ruleKeywordName, position592)
+			}
+			memoize(54, position591, tokenIndex591, true)
+			return true
+		l591:
+			memoize(54, position591, tokenIndex591, false)
+			position, tokenIndex = position591, tokenIndex591
+			return false
+		},
+		/* 55 Recover <- <('%' 'r' 'e' 'c' 'o' 'v' 'e' 'r' Spacing Open Expression ',' Spacing Expression Close Action97)> */
+		nil,
+		/* 56 InSet <- <('%' 'i' 'n' Spacing '(' <InBody*> ')' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{56, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position608, tokenIndex608 := position, tokenIndex
+			{
+				position609 := position
+				if buffer[position] != rune('%') {
+					fail("'%in'")
+					goto l608
+				}
+				position++
+				if buffer[position] != rune('i') {
+					fail("'i'")
+					goto l608
+				}
+				position++
+				if buffer[position] != rune('n') {
+					fail("'n'")
+					goto l608
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l608
+				}
+				if buffer[position] != rune('(') {
+					fail("'('")
+					goto l608
+				}
+				position++
+				{
+					position610 := position
+				l611:
+					{
+						position612, tokenIndex612 := position, tokenIndex
+						if !_rules[ruleInBody]() {
+							goto l612
+						}
+						goto l611
+					l612:
+						position, tokenIndex = position612, tokenIndex612
+					}
+					add(rulePegText, position610)
+				}
+				if buffer[position] != rune(')') {
+					fail("')'")
+					goto l608
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l608
+				}
+				add(ruleInSet, position609)
+			}
+			memoize(56, position608, tokenIndex608, true)
+			return true
+		l608:
+			memoize(56, position608, tokenIndex608, false)
+			position, tokenIndex = position608, tokenIndex608
+			return false
+		},
+		/* 57 InBody <- <((&('(') %fail([^()]) ('(' InBody* ')')) | (&('\x00' | '\x01' | '\x02' | '\x03' | '\x04' | '\x05' | '\x06' | '\a' | '\b' | '\t' | '\n' | '\v' | '\f' | '\r' | '\x0e' | '\x0f' | '\x10' | '\x11' | '\x12' | '\x13' | '\x14' | '\x15' | '\x16' | '\x17' | '\x18' | '\x19' | '\x1a' | '\x1b' | '\x1c' | '\x1d' | '\x1e' | '\x1f' | ' ' | '!' | '"' | '#' | '$' | '%' | '&' | '\'' | [*-\U0010ffff]) %fail('(') [^()]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{57, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position613, tokenIndex613 := position, tokenIndex
+			{
+				position614 := position
+				{
+					switch buffer[position] {
+					case '(':
+						fail("[^()]")
+						position++
+					l616:
+						{
+							position617, tokenIndex617 := position, tokenIndex
+							if !_rules[ruleInBody]() {
+								goto l617
+							}
+							goto l616
+						l617:
+							position, tokenIndex = position617, tokenIndex617
+						}
+						if buffer[position] != rune(')') {
+							fail("')'")
+							goto l613
+						}
+						position++
+					default:
+						fail("'('")
+						if c := buffer[position]; c == endSymbol || c == rune('(') || c == rune(')') {
+							fail("[^()]")
+							goto l613
+						}
+						position++
+					}
+				}
+
+				add(ruleInBody, position614)
+			}
+			memoize(57, position613, tokenIndex613, true)
+			return true
+		l613:
+			memoize(57, position613, tokenIndex613, false)
+			position, tokenIndex = position613, tokenIndex613
+			return false
+		},
+		/* 58 Begin <- <('<' Spacing)> */
+		nil,
+		/* 59 End <- <('>' Spacing)> */
+		nil,
+		/* 61 Action0 <- <{ p.AddPackage(text) }> */
+		nil,
+		/* 62 Action1 <- <{ p.AddPeg(text) }> */
+		nil,
+		/* 63 Action2 <- <{ p.AddState(text) }> */
+		nil,
+		/* 64 Action3 <- <{ p.AddCaseInsensitive(text) }> */
+		nil,
+		/* 65 Action4 <- <{ p.SetCaseInsensitive() }> */
+		nil,
+		/* 66 Action5 <- <{ p.SetWord() }> */
+		nil,
+		nil,
+		/* 68 Action6 <- <{ p.SetNoMemo(text) }> */
+		nil,
+		/* 69 Action7 <- <{ p.AddNoMemo(text) }> */
+		nil,
+		/* 70 Action8 <- <{ p.AddMemo(text) }> */
+		nil,
+		/* 71 Action9 <- <{ p.SetMemoKey(text) }> */
+		nil,
+		/* 72 Action10 <- <{ p.AddMemoKey(text) }> */
+		nil,
+		/* 73 Action11 <- <{ p.AddRecovery(text) }> */
+		nil,
+		/* 74 Action12 <- <{ p.AddKind(text) }> */
+		nil,
+		/* 75 Action13 <- <{ p.SetKindConstant(text) }> */
+		nil,
+		/* 76 Action14 <- <{ p.AddBench(text) }> */
+		nil,
+		/* 77 Action15 <- <{ p.SetBenchSample(text) }> */
+		nil,
+		/* 78 Action16 <- <{ p.SetBenchFile(text) }> */
+		nil,
+		/* 79 Action17 <- <{ p.AddAccept(text) }> */
+		nil,
+		/* 80 Action18 <- <{ p.SetTestInput(text) }> */
+		nil,
+		/* 81 Action19 <- <{ p.AddReject(text) }> */
+		nil,
+		/* 82 Action20 <- <{ p.SetTestInput(text) }> */
+		nil,
+		/* 83 Action21 <- <{ p.SetTestPosition(text) }> */
+		nil,
+		/* 84 Action22 <- <{ p.AddSample(text) }> */
+		nil,
+		/* 85 Action23 <- <{ p.AddSampleFile(text) }> */
+		nil,
+		/* 86 Action24 <- <{ p.SetErrorType(text) }> */
+		nil,
+		/* 87 Action25 <- <{ p.SetErrorFields(text) }> */
+		nil,
+		/* 88 Action26 <- <{ p.SetStateFields(text) }> */
+		nil,
+		/* 89 Action27 <- <{ p.AddInclude(text) }> */
+		nil,
+		/* 90 Action28 <- <{ p.AddRename(text) }> */
+		nil,
+		/* 91 Action29 <- <{ p.SetRename(text) }> */
+		nil,
+		/* 92 Action30 <- <{ p.AddImport(text) }> */
+		nil,
+		/* 93 Action31 <- <{ p.AddRuleAt(buffer, begin, text) }> */
+		nil,
+		/* 94 Action32 <- <{ p.AddExpression() }> */
+		nil,
+		/* 95 Action33 <- <{ p.AddBuild(text) }> */
+		nil,
+		/* 96 Action34 <- <{ p.SetBuildFields(text) }> */
+		nil,
+		/* 97 Action35 <- <{ p.AddAlternate() }> */
+		nil,
+		/* 98 Action36 <- <{ p.AddNil(); p.AddAlternate() }> */
+		nil,
+		/* 99 Action37 <- <{ p.AddNil() }> */
+		nil,
+		/* 100 Action38 <- <{ p.AddSequence() }> */
+		nil,
+		/* 101 Action39 <- <{ p.AddPredicate(text) }> */
+		nil,
+		/* 102 Action40 <- <{ p.AddStateChange(text) }> */
+		nil,
+		/* 103 Action41 <- <{ p.AddIn(text) }> */
+		nil,
+		/* 104 Action42 <- <{ p.AddIn(text); p.AddPeekNot() }> */
+		nil,
+		/* 105 Action43 <- <{ p.AddPeekFor() }> */
+		func() bool {
+			memoized, ok := memoization[memoKey{105, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position663, tokenIndex663 := position, tokenIndex
+			{
+				add(ruleAction43, position)
+			}
+			memoize(105, position663, tokenIndex663, true)
+			return true
+		},
+		/* 106 Action44 <- <{ p.AddPeekNot() }> */
+		func() bool {
+			memoized, ok := memoization[memoKey{106, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position665, tokenIndex665 := position, tokenIndex
+			{
+				add(ruleAction44, position)
+			}
+			memoize(106, position665, tokenIndex665, true)
+			return true
+		},
+		/* 107 Action45 <- <{ p.AddHint(buffer, begin, text) }> */
+		nil,
+		/* 108 Action46 <- <{ p.AddQuery() }> */
+		nil,
+		/* 109 Action47 <- <{ p.AddStar() }> */
+		nil,
+		/* 110 Action48 <- <{ p.AddPlus() }> */
+		nil,
+		/* 111 Action49 <- <{ p.AddName(text) }> */
+		nil,
+		/* 112 Action50 <- <{ p.AddDot() }> */
+		nil,
+		/* 113 Action51 <- <{ p.AddActionAt(buffer, begin, text) }> */
+		nil,
+		/* 114 Action52 <- <{ p.AddPush() }> */
+		nil,
+		/* 115 Action53 <- <{ p.AddLiteral(); p.AddWordBoundary() }> */
+		nil,
+		/* 116 Action54 <- <{ p.AddSequence() }> */
+		nil,
+		/* 117 Action55 <- <{ p.AddSequence() }> */
+		nil,
+		/* 118 Action56 <- <{ p.AddSequence() }> */
+		nil,
+		/* 119 Action57 <- <{ p.AddSequence() }> */
+		nil,
+		/* 120 Action58 <- <{ p.AddSequence() }> */
+		nil,
+		/* 121 Action59 <- <{ p.AddNotClass() }> */
+		nil,
+		/* 122 Action60 <- <{ p.AddNotClass() }> */
+		nil,
+		/* 123 Action61 <- <{ p.AddAlternate() }> */
+		nil,
+		/* 124 Action62 <- <{ p.AddAlternate() }> */
+		nil,
+		/* 125 Action63 <- <{ p.AddRange() }> */
+		nil,
+		/* 126 Action64 <- <{ p.AddDoubleRange() }> */
+		nil,
+		/* 127 Action65 <- <{ p.AddProperty(buffer, begin, text) }> */
+		nil,
+		/* 128 Action66 <- <{ p.AddCharacter(text) }> */
+		nil,
+		/* 129 Action67 <- <{ p.AddLiteralCharacter(text) }> */
+		nil,
+		/* 130 Action68 <- <{ p.AddCharacter(text) }> */
+		nil,
+		/* 131 Action69 <- <{ p.AddDoubleCharacter(text) }> */
+		nil,
+		/* 132 Action70 <- <{ p.AddCharacter("\a") }> */
+		nil,
+		/* 133 Action71 <- <{ p.AddCharacter("\b") }> */
+		nil,
+		/* 134 Action72 <- <{ p.AddCharacter("\x1B") }> */
+		nil,
+		/* 135 Action73 <- <{ p.AddCharacter("\f") }> */
+		nil,
+		/* 136 Action74 <- <{ p.AddCharacter("\n") }> */
+		nil,
+		/* 137 Action75 <- <{ p.AddCharacter("\r") }> */
+		nil,
+		/* 138 Action76 <- <{ p.AddCharacter("\t") }> */
+		nil,
+		/* 139 Action77 <- <{ p.AddCharacter("\v") }> */
+		nil,
+		/* 140 Action78 <- <{ p.AddCharacter("'") }> */
+		nil,
+		/* 141 Action79 <- <{ p.AddCharacter("\"") }> */
+		nil,
+		/* 142 Action80 <- <{ p.AddCharacter("[") }> */
+		nil,
+		/* 143 Action81 <- <{ p.AddCharacter("]") }> */
+		nil,
+		/* 144 Action82 <- <{ p.AddCharacter("-") }> */
+		nil,
+		/* 145 Action83 <- <{ p.AddUnicodeCharacter(buffer, begin, text) }> */
+		nil,
+		/* 146 Action84 <- <{ p.AddHexaCharacter(text) }> */
+		nil,
+		/* 147 Action85 <- <{ p.AddUnicodeCharacter(buffer, begin, text) }> */
+		nil,
+		/* 148 Action86 <- <{ p.AddUnicodeCharacter(buffer, begin, text) }> */
+		nil,
+		/* 149 Action87 <- <{ p.AddHexaCharacter(text) }> */
+		nil,
+		/* 150 Action88 <- <{ p.AddOctalCharacter(text) }> */
+		nil,
+		/* 151 Action89 <- <{ p.AddOctalCharacter(text) }> */
+		nil,
+		/* 152 Action90 <- <{ p.AddCharacter("\\") }> */
+		nil,
+		/* 153 Action91 <- <{ p.AddInvalidEscape(buffer, begin, text) }> */
+		nil,
+		/* 154 Action92 <- <{ p.AddSpace(text) }> */
+		nil,
+		/* 155 Action93 <- <{ p.AddComment(text) }> */
+		nil,
+		/* 156 Action94 <- <{ p.AddAlternate() }> */
+		nil,
+		/* 157 Action95 <- <{ p.AddKeyword(text) }> */
+		nil,
+		/* 158 Action96 <- <{ p.AddKeyword(text) }> */
+		nil,
+		/* 159 Action97 <- <{ p.AddRecover() }> */
+		nil,
+	}
+	if p.maxDepth > 0 || p.trackRules {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule, name := rule, rul3s[i]
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				if p.trackRules {
+					stack = append(stack, name)
+					defer func() { stack = stack[:len(stack)-1] }()
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
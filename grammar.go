@@ -0,0 +1,38 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	_ "embed"
+
+	"github.com/pointlander/peg/generator"
+	"github.com/pointlander/peg/tree"
+)
+
+//go:embed peg.peg
+var metaGrammar string
+
+// ParseGrammar parses the peg grammar in buffer and returns its syntax tree.
+func ParseGrammar(buffer string) (*tree.Tree, error) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	if err := p.Init(generator.Size(1 << 15)); err != nil {
+		return nil, err
+	}
+	if err := p.Parse(); err != nil {
+		return nil, err
+	}
+	p.Execute()
+	return p.Tree, nil
+}
+
+// MetaGrammar returns the syntax tree of peg.peg, the grammar of peg grammars
+// that peg itself is generated from.
+func MetaGrammar() *tree.Tree {
+	t, err := ParseGrammar(metaGrammar)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
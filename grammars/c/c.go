@@ -0,0 +1,55 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run github.com/pointlander/peg -switch -inline c.peg
+
+// Package c parses C99 source files, without the preprocessor, with the
+// parser generated from c.peg.
+package c
+
+import "unicode"
+
+// Typedef enters name into the table of TypedefNames, as a typedef
+// declaration before the parsed source would, for example for the types
+// declared by headers.
+func (c *C) Typedef(name string) {
+	if c.typedefs == nil {
+		c.typedefs = make(map[string]bool)
+	}
+	c.typedefs[name] = true
+}
+
+func (c *C) isTypedef(name string) bool {
+	return c.typedefs[name]
+}
+
+func (c *C) beginDeclaration() {
+	c.typedef, c.name, c.names = false, "", c.names[:0]
+}
+
+// declarator records the Identifier of a DirectDeclarator as the name of the
+// InitDeclarator, unless it already has one.
+func (c *C) declarator(name string) {
+	if c.name == "" {
+		c.name = name
+	}
+}
+
+func (c *C) endDeclaration() {
+	if !c.typedef {
+		return
+	}
+	for _, name := range c.names {
+		c.Typedef(name)
+	}
+}
+
+// identifier returns the identifier at the start of buffer.
+func identifier(buffer []rune) string {
+	end := 0
+	for end < len(buffer) && (buffer[end] == '_' || unicode.IsLetter(buffer[end]) || unicode.IsDigit(buffer[end])) {
+		end++
+	}
+	return string(buffer[:end])
+}
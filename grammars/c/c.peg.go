@@ -0,0 +1,10200 @@
+// Code generated by peg -switch -inline c.peg. DO NOT EDIT.
+
+//===========================================================================
+//
+//  Parsing Expression Grammar of C for Mouse 1.1 - 1.5.
+//  Based on standard ISO/IEC 9899.1999:TC2, without preprocessor.
+//  Upgraded to ISO/IEC 9899:2011 (C11).
+//
+//---------------------------------------------------------------------------
+//
+//  Copyright (C) 2007, 2009, 2010 by Roman R Redziejowski (www.romanredz.se).
+//
+//  The author gives unlimited permission to copy and distribute
+//  this file, with or without modifications, as long as this notice
+//  is preserved, and any changes are properly documented.
+//
+//  This file is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of
+//  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//---------------------------------------------------------------------------
+//
+//  Latest update 2010-11-19
+//
+//---------------------------------------------------------------------------
+//
+//  Modifications to the standard grammar:
+//
+//    Defined # as start of line comment.
+//    Added FunctionSpecifier "_stdcall".
+//    Added TypeQualifier "__declspec()".
+//    Added TypeSpecifier "__attribute__()".
+//    Added the C11 keywords _Alignas, _Alignof, _Atomic, _Generic,
+//    _Noreturn, _Static_assert and _Thread_local, with AlignmentSpecifier,
+//    AtomicTypeSpecifier, StaticAssertDeclaration and GenericSelection.
+//    Added the C11 prefixes u8, u and U of string literals and u and U
+//    of character constants.
+//    The scope of TypedefNames is not implemented.
+//
+//---------------------------------------------------------------------------
+//
+//  Implementation of typedefs.
+//
+//  A TypedefName is an Identifier that has been declared as such
+//  by a previous typedef declaration. It can be used as TypeSpecifier
+//  in DeclarationSpecifiers and SpecifierQualifierList.
+//  Recognizing it as such is essential for correct parsing.
+//  In other contexts, TypedefName is treated as an ordinary Identifier.
+//
+//  According to 6.7.2, comment 2, of the Standard, TypedefName can appear
+//  in DeclarationSpecifiers or SpecifierQualifierList at most once,
+//  and then as the only TypeSpecifier. To make sure that an Identifer
+//  is recognized as TypedefName only in these contexts, definitions
+//  of these items are changed as follows:
+//
+//  - TypedefName is removed as an alternative of TypeSpecifier.
+//
+//  - DeclarationSpecifiers and SpecifierQualifierList are redefined
+//    to allow either single TypedefName or one or more TypeSpecifiers.
+//
+//  The parser maintains the table of TypedefNames in its state,
+//  with the state changes !{} and the predicates &{} run while parsing.
+//
+//  TypedefName is an Identifier with a predicate that is true
+//  iff the Identifier is in the table.
+//  That means TypedefName is accepted iff it is in the table.
+//
+//  According to 6.7.7, comment 3, of the Standard,
+//  in a Declaration whose StorageClassSpecifier is TYPEDEF,
+//  each Declarator defines an Identifier to be a TypedefName.
+//  These Identifiers are entered into the table as follows.
+//
+//  - Declaration starts with clearing the state of the declaration.
+//
+//  - StorageClassSpecifier TYPEDEF notes that the declaration
+//    is a typedef.
+//
+//  - Each DirectDeclarator starts with either Identifier
+//    or Declarator in parentheses. The first such Identifier
+//    of an InitDeclarator is its name; the Identifiers of the
+//    parameters that follow are not.
+//
+//  - Each InitDeclarator adds its name to the names of the declaration.
+//
+//  - Declaration ends with entering the names into the typedef table
+//    if the declaration is a typedef.
+//
+//  Successes of the rules with state changes are not memoized,
+//  so that the state changes are run again when a Declaration
+//  is parsed after a FunctionDefinition failed at the same position.
+//  The C method Typedef enters names, such as those declared by
+//  headers, into the table before parsing.
+//
+//
+//---------------------------------------------------------------------------
+//
+//  Change log
+//    2009-07-13 Posted on Internet.
+//    2010-11-19 Removed superfluous '?' after 'Spacing'.
+//
+//
+//---------------------------------------------------------------------------
+//
+//    2013-02-21 Modified to work with github.com/pointlander/peg
+//    2026-10-16 Upgraded to C11, TypedefNames recognized while parsing.
+//
+//===========================================================================
+
+//-------------------------------------------------------------------------
+//  A.2.4  External definitions
+//-------------------------------------------------------------------------
+
+package c
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint16
+
+const (
+	ruleUnknown pegRule = iota
+	ruleTranslationUnit
+	ruleExternalDeclaration
+	ruleFunctionDefinition
+	ruleDeclarationList
+	ruleDeclaration
+	ruleDeclarationSpecifiers
+	ruleInitDeclaratorList
+	ruleInitDeclarator
+	ruleStorageClassSpecifier
+	ruleTypeSpecifier
+	ruleStructOrUnionSpecifier
+	ruleStructOrUnion
+	ruleStructDeclaration
+	ruleSpecifierQualifierList
+	ruleStructDeclaratorList
+	ruleStructDeclarator
+	ruleEnumSpecifier
+	ruleEnumeratorList
+	ruleEnumerator
+	ruleTypeQualifier
+	ruleFunctionSpecifier
+	ruleAtomicTypeSpecifier
+	ruleAlignmentSpecifier
+	ruleStaticAssertDeclaration
+	ruleDeclarator
+	ruleDirectDeclarator
+	rulePointer
+	ruleParameterTypeList
+	ruleParameterList
+	ruleParameterDeclaration
+	ruleIdentifierList
+	ruleTypeName
+	ruleAbstractDeclarator
+	ruleDirectAbstractDeclarator
+	ruleTypedefName
+	ruleInitializer
+	ruleInitializerList
+	ruleDesignation
+	ruleDesignator
+	ruleStatement
+	ruleLabeledStatement
+	ruleCompoundStatement
+	ruleExpressionStatement
+	ruleSelectionStatement
+	ruleIterationStatement
+	ruleJumpStatement
+	rulePrimaryExpression
+	ruleGenericSelection
+	ruleGenericAssocList
+	ruleGenericAssociation
+	rulePostfixExpression
+	ruleArgumentExpressionList
+	ruleUnaryExpression
+	ruleUnaryOperator
+	ruleCastExpression
+	ruleMultiplicativeExpression
+	ruleAdditiveExpression
+	ruleShiftExpression
+	ruleRelationalExpression
+	ruleEqualityExpression
+	ruleANDExpression
+	ruleExclusiveORExpression
+	ruleInclusiveORExpression
+	ruleLogicalANDExpression
+	ruleLogicalORExpression
+	ruleConditionalExpression
+	ruleAssignmentExpression
+	ruleAssignmentOperator
+	ruleExpression
+	ruleConstantExpression
+	ruleSpacing
+	ruleWhiteSpace
+	ruleLongComment
+	ruleLineComment
+	rulePragma
+	ruleAUTO
+	ruleBREAK
+	ruleCASE
+	ruleCHAR
+	ruleCONST
+	ruleCONTINUE
+	ruleDEFAULT
+	ruleDOUBLE
+	ruleDO
+	ruleELSE
+	ruleENUM
+	ruleEXTERN
+	ruleFLOAT
+	ruleFOR
+	ruleGOTO
+	ruleIF
+	ruleINT
+	ruleINLINE
+	ruleLONG
+	ruleREGISTER
+	ruleRESTRICT
+	ruleRETURN
+	ruleSHORT
+	ruleSIGNED
+	ruleSIZEOF
+	ruleSTATIC
+	ruleSTRUCT
+	ruleSWITCH
+	ruleTYPEDEF
+	ruleUNION
+	ruleUNSIGNED
+	ruleVOID
+	ruleVOLATILE
+	ruleWHILE
+	ruleBOOL
+	ruleCOMPLEX
+	ruleSTDCALL
+	ruleDECLSPEC
+	ruleATTRIBUTE
+	ruleALIGNAS
+	ruleALIGNOF
+	ruleATOMIC
+	ruleGENERIC
+	ruleNORETURN
+	ruleSTATICASSERT
+	ruleTHREADLOCAL
+	ruleKeyword
+	ruleIdentifier
+	ruleIdNondigit
+	ruleIdChar
+	ruleUniversalCharacter
+	ruleHexQuad
+	ruleConstant
+	ruleIntegerConstant
+	ruleDecimalConstant
+	ruleOctalConstant
+	ruleHexConstant
+	ruleHexPrefix
+	ruleHexDigit
+	ruleIntegerSuffix
+	ruleLsuffix
+	ruleFloatConstant
+	ruleDecimalFloatConstant
+	ruleHexFloatConstant
+	ruleFraction
+	ruleHexFraction
+	ruleExponent
+	ruleBinaryExponent
+	ruleFloatSuffix
+	ruleEnumerationConstant
+	ruleCharacterConstant
+	ruleChar
+	ruleEscape
+	ruleSimpleEscape
+	ruleOctalEscape
+	ruleHexEscape
+	ruleStringLiteral
+	ruleStringChar
+	ruleLBRK
+	ruleRBRK
+	ruleLPAR
+	ruleRPAR
+	ruleLWING
+	ruleRWING
+	ruleDOT
+	rulePTR
+	ruleINC
+	ruleDEC
+	ruleAND
+	ruleSTAR
+	rulePLUS
+	ruleMINUS
+	ruleTILDA
+	ruleBANG
+	ruleDIV
+	ruleMOD
+	ruleLEFT
+	ruleRIGHT
+	ruleLT
+	ruleGT
+	ruleLE
+	ruleGE
+	ruleEQUEQU
+	ruleBANGEQU
+	ruleHAT
+	ruleOR
+	ruleANDAND
+	ruleOROR
+	ruleQUERY
+	ruleCOLON
+	ruleSEMI
+	ruleELLIPSIS
+	ruleEQU
+	ruleSTAREQU
+	ruleDIVEQU
+	ruleMODEQU
+	rulePLUSEQU
+	ruleMINUSEQU
+	ruleLEFTEQU
+	ruleRIGHTEQU
+	ruleANDEQU
+	ruleHATEQU
+	ruleOREQU
+	ruleCOMMA
+	ruleEOT
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"TranslationUnit",
+	"ExternalDeclaration",
+	"FunctionDefinition",
+	"DeclarationList",
+	"Declaration",
+	"DeclarationSpecifiers",
+	"InitDeclaratorList",
+	"InitDeclarator",
+	"StorageClassSpecifier",
+	"TypeSpecifier",
+	"StructOrUnionSpecifier",
+	"StructOrUnion",
+	"StructDeclaration",
+	"SpecifierQualifierList",
+	"StructDeclaratorList",
+	"StructDeclarator",
+	"EnumSpecifier",
+	"EnumeratorList",
+	"Enumerator",
+	"TypeQualifier",
+	"FunctionSpecifier",
+	"AtomicTypeSpecifier",
+	"AlignmentSpecifier",
+	"StaticAssertDeclaration",
+	"Declarator",
+	"DirectDeclarator",
+	"Pointer",
+	"ParameterTypeList",
+	"ParameterList",
+	"ParameterDeclaration",
+	"IdentifierList",
+	"TypeName",
+	"AbstractDeclarator",
+	"DirectAbstractDeclarator",
+	"TypedefName",
+	"Initializer",
+	"InitializerList",
+	"Designation",
+	"Designator",
+	"Statement",
+	"LabeledStatement",
+	"CompoundStatement",
+	"ExpressionStatement",
+	"SelectionStatement",
+	"IterationStatement",
+	"JumpStatement",
+	"PrimaryExpression",
+	"GenericSelection",
+	"GenericAssocList",
+	"GenericAssociation",
+	"PostfixExpression",
+	"ArgumentExpressionList",
+	"UnaryExpression",
+	"UnaryOperator",
+	"CastExpression",
+	"MultiplicativeExpression",
+	"AdditiveExpression",
+	"ShiftExpression",
+	"RelationalExpression",
+	"EqualityExpression",
+	"ANDExpression",
+	"ExclusiveORExpression",
+	"InclusiveORExpression",
+	"LogicalANDExpression",
+	"LogicalORExpression",
+	"ConditionalExpression",
+	"AssignmentExpression",
+	"AssignmentOperator",
+	"Expression",
+	"ConstantExpression",
+	"Spacing",
+	"WhiteSpace",
+	"LongComment",
+	"LineComment",
+	"Pragma",
+	"AUTO",
+	"BREAK",
+	"CASE",
+	"CHAR",
+	"CONST",
+	"CONTINUE",
+	"DEFAULT",
+	"DOUBLE",
+	"DO",
+	"ELSE",
+	"ENUM",
+	"EXTERN",
+	"FLOAT",
+	"FOR",
+	"GOTO",
+	"IF",
+	"INT",
+	"INLINE",
+	"LONG",
+	"REGISTER",
+	"RESTRICT",
+	"RETURN",
+	"SHORT",
+	"SIGNED",
+	"SIZEOF",
+	"STATIC",
+	"STRUCT",
+	"SWITCH",
+	"TYPEDEF",
+	"UNION",
+	"UNSIGNED",
+	"VOID",
+	"VOLATILE",
+	"WHILE",
+	"BOOL",
+	"COMPLEX",
+	"STDCALL",
+	"DECLSPEC",
+	"ATTRIBUTE",
+	"ALIGNAS",
+	"ALIGNOF",
+	"ATOMIC",
+	"GENERIC",
+	"NORETURN",
+	"STATICASSERT",
+	"THREADLOCAL",
+	"Keyword",
+	"Identifier",
+	"IdNondigit",
+	"IdChar",
+	"UniversalCharacter",
+	"HexQuad",
+	"Constant",
+	"IntegerConstant",
+	"DecimalConstant",
+	"OctalConstant",
+	"HexConstant",
+	"HexPrefix",
+	"HexDigit",
+	"IntegerSuffix",
+	"Lsuffix",
+	"FloatConstant",
+	"DecimalFloatConstant",
+	"HexFloatConstant",
+	"Fraction",
+	"HexFraction",
+	"Exponent",
+	"BinaryExponent",
+	"FloatSuffix",
+	"EnumerationConstant",
+	"CharacterConstant",
+	"Char",
+	"Escape",
+	"SimpleEscape",
+	"OctalEscape",
+	"HexEscape",
+	"StringLiteral",
+	"StringChar",
+	"LBRK",
+	"RBRK",
+	"LPAR",
+	"RPAR",
+	"LWING",
+	"RWING",
+	"DOT",
+	"PTR",
+	"INC",
+	"DEC",
+	"AND",
+	"STAR",
+	"PLUS",
+	"MINUS",
+	"TILDA",
+	"BANG",
+	"DIV",
+	"MOD",
+	"LEFT",
+	"RIGHT",
+	"LT",
+	"GT",
+	"LE",
+	"GE",
+	"EQUEQU",
+	"BANGEQU",
+	"HAT",
+	"OR",
+	"ANDAND",
+	"OROR",
+	"QUERY",
+	"COLON",
+	"SEMI",
+	"ELLIPSIS",
+	"EQU",
+	"STAREQU",
+	"DIVEQU",
+	"MODEQU",
+	"PLUSEQU",
+	"MINUSEQU",
+	"LEFTEQU",
+	"RIGHTEQU",
+	"ANDEQU",
+	"HATEQU",
+	"OREQU",
+	"COMMA",
+	"EOT",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"TranslationUnit", "c.peg", 125, 125, false, false},
+	{"ExternalDeclaration", "c.peg", 127, 127, false, true},
+	{"FunctionDefinition", "c.peg", 129, 129, false, true},
+	{"DeclarationList", "c.peg", 131, 131, false, true},
+	{"Declaration", "c.peg", 138, 142, false, false},
+	{"DeclarationSpecifiers", "c.peg", 144, 162, false, false},
+	{"InitDeclaratorList", "c.peg", 164, 164, false, true},
+	{"InitDeclarator", "c.peg", 166, 170, false, false},
+	{"StorageClassSpecifier", "c.peg", 172, 179, false, false},
+	{"TypeSpecifier", "c.peg", 181, 195, false, false},
+	{"StructOrUnionSpecifier", "c.peg", 197, 201, false, false},
+	{"StructOrUnion", "c.peg", 203, 203, false, true},
+	{"StructDeclaration", "c.peg", 205, 207, false, true},
+	{"SpecifierQualifierList", "c.peg", 209, 217, false, false},
+	{"StructDeclaratorList", "c.peg", 219, 219, false, true},
+	{"StructDeclarator", "c.peg", 221, 223, false, false},
+	{"EnumSpecifier", "c.peg", 225, 229, false, true},
+	{"EnumeratorList", "c.peg", 231, 231, false, true},
+	{"Enumerator", "c.peg", 233, 233, false, false},
+	{"TypeQualifier", "c.peg", 235, 240, false, false},
+	{"FunctionSpecifier", "c.peg", 242, 242, false, false},
+	{"AtomicTypeSpecifier", "c.peg", 244, 244, false, true},
+	{"AlignmentSpecifier", "c.peg", 246, 246, false, false},
+	{"StaticAssertDeclaration", "c.peg", 248, 249, false, false},
+	{"Declarator", "c.peg", 251, 251, false, false},
+	{"DirectDeclarator", "c.peg", 253, 265, false, true},
+	{"Pointer", "c.peg", 267, 267, false, false},
+	{"ParameterTypeList", "c.peg", 269, 269, false, false},
+	{"ParameterList", "c.peg", 271, 271, false, true},
+	{"ParameterDeclaration", "c.peg", 273, 277, false, false},
+	{"IdentifierList", "c.peg", 279, 279, false, true},
+	{"TypeName", "c.peg", 281, 281, false, false},
+	{"AbstractDeclarator", "c.peg", 283, 285, false, false},
+	{"DirectAbstractDeclarator", "c.peg", 287, 294, false, true},
+	{"TypedefName", "c.peg", 296, 299, false, false},
+	{"Initializer", "c.peg", 301, 303, false, false},
+	{"InitializerList", "c.peg", 305, 305, false, false},
+	{"Designation", "c.peg", 307, 307, false, false},
+	{"Designator", "c.peg", 309, 311, false, true},
+	{"Statement", "c.peg", 318, 324, false, false},
+	{"LabeledStatement", "c.peg", 326, 329, false, true},
+	{"CompoundStatement", "c.peg", 331, 331, false, false},
+	{"ExpressionStatement", "c.peg", 333, 333, false, true},
+	{"SelectionStatement", "c.peg", 335, 337, false, true},
+	{"IterationStatement", "c.peg", 339, 343, false, true},
+	{"JumpStatement", "c.peg", 345, 349, false, true},
+	{"PrimaryExpression", "c.peg", 356, 361, false, true},
+	{"GenericSelection", "c.peg", 363, 363, false, true},
+	{"GenericAssocList", "c.peg", 365, 365, false, true},
+	{"GenericAssociation", "c.peg", 367, 371, false, false},
+	{"PostfixExpression", "c.peg", 373, 383, false, true},
+	{"ArgumentExpressionList", "c.peg", 385, 385, false, true},
+	{"UnaryExpression", "c.peg", 387, 393, false, false},
+	{"UnaryOperator", "c.peg", 395, 401, false, true},
+	{"CastExpression", "c.peg", 403, 403, false, false},
+	{"MultiplicativeExpression", "c.peg", 405, 405, false, false},
+	{"AdditiveExpression", "c.peg", 407, 407, false, false},
+	{"ShiftExpression", "c.peg", 409, 409, false, false},
+	{"RelationalExpression", "c.peg", 411, 411, false, false},
+	{"EqualityExpression", "c.peg", 413, 413, false, false},
+	{"ANDExpression", "c.peg", 415, 415, false, false},
+	{"ExclusiveORExpression", "c.peg", 417, 417, false, false},
+	{"InclusiveORExpression", "c.peg", 419, 419, false, false},
+	{"LogicalANDExpression", "c.peg", 421, 421, false, false},
+	{"LogicalORExpression", "c.peg", 423, 423, false, false},
+	{"ConditionalExpression", "c.peg", 425, 425, false, false},
+	{"AssignmentExpression", "c.peg", 427, 429, false, false},
+	{"AssignmentOperator", "c.peg", 431, 442, false, true},
+	{"Expression", "c.peg", 444, 444, false, false},
+	{"ConstantExpression", "c.peg", 446, 446, false, false},
+	{"Spacing", "c.peg", 455, 460, false, false},
+	{"WhiteSpace", "c.peg", 462, 462, true, true},
+	{"LongComment", "c.peg", 464, 464, true, true},
+	{"LineComment", "c.peg", 466, 466, true, true},
+	{"Pragma", "c.peg", 468, 468, true, true},
+	{"AUTO", "c.peg", 475, 475, false, true},
+	{"BREAK", "c.peg", 476, 476, false, true},
+	{"CASE", "c.peg", 477, 477, false, true},
+	{"CHAR", "c.peg", 478, 478, false, true},
+	{"CONST", "c.peg", 479, 479, false, true},
+	{"CONTINUE", "c.peg", 480, 480, false, true},
+	{"DEFAULT", "c.peg", 481, 481, false, false},
+	{"DOUBLE", "c.peg", 482, 482, false, true},
+	{"DO", "c.peg", 483, 483, false, true},
+	{"ELSE", "c.peg", 484, 484, false, true},
+	{"ENUM", "c.peg", 485, 485, false, true},
+	{"EXTERN", "c.peg", 486, 486, false, true},
+	{"FLOAT", "c.peg", 487, 487, false, true},
+	{"FOR", "c.peg", 488, 488, false, true},
+	{"GOTO", "c.peg", 489, 489, false, true},
+	{"IF", "c.peg", 490, 490, false, true},
+	{"INT", "c.peg", 491, 491, false, true},
+	{"INLINE", "c.peg", 492, 492, false, true},
+	{"LONG", "c.peg", 493, 493, false, true},
+	{"REGISTER", "c.peg", 494, 494, false, true},
+	{"RESTRICT", "c.peg", 495, 495, false, true},
+	{"RETURN", "c.peg", 496, 496, false, true},
+	{"SHORT", "c.peg", 497, 497, false, true},
+	{"SIGNED", "c.peg", 498, 498, false, true},
+	{"SIZEOF", "c.peg", 499, 499, false, true},
+	{"STATIC", "c.peg", 500, 500, false, false},
+	{"STRUCT", "c.peg", 501, 501, false, true},
+	{"SWITCH", "c.peg", 502, 502, false, true},
+	{"TYPEDEF", "c.peg", 503, 503, false, true},
+	{"UNION", "c.peg", 504, 504, false, true},
+	{"UNSIGNED", "c.peg", 505, 505, false, true},
+	{"VOID", "c.peg", 506, 506, false, true},
+	{"VOLATILE", "c.peg", 507, 507, false, true},
+	{"WHILE", "c.peg", 508, 508, false, false},
+	{"BOOL", "c.peg", 509, 509, false, true},
+	{"COMPLEX", "c.peg", 510, 510, false, true},
+	{"STDCALL", "c.peg", 511, 511, false, true},
+	{"DECLSPEC", "c.peg", 512, 512, false, true},
+	{"ATTRIBUTE", "c.peg", 513, 513, false, true},
+	{"ALIGNAS", "c.peg", 514, 514, false, true},
+	{"ALIGNOF", "c.peg", 515, 515, false, true},
+	{"ATOMIC", "c.peg", 516, 516, false, false},
+	{"GENERIC", "c.peg", 517, 517, false, true},
+	{"NORETURN", "c.peg", 518, 518, false, true},
+	{"STATICASSERT", "c.peg", 519, 519, false, true},
+	{"THREADLOCAL", "c.peg", 520, 520, false, true},
+	{"Keyword", "c.peg", 522, 571, false, true},
+	{"Identifier", "c.peg", 580, 580, false, false},
+	{"IdNondigit", "c.peg", 582, 584, false, true},
+	{"IdChar", "c.peg", 586, 588, false, false},
+	{"UniversalCharacter", "c.peg", 595, 597, false, false},
+	{"HexQuad", "c.peg", 599, 599, false, false},
+	{"Constant", "c.peg", 606, 610, false, true},
+	{"IntegerConstant", "c.peg", 612, 617, false, true},
+	{"DecimalConstant", "c.peg", 619, 619, true, true},
+	{"OctalConstant", "c.peg", 621, 621, true, true},
+	{"HexConstant", "c.peg", 623, 623, false, true},
+	{"HexPrefix", "c.peg", 625, 625, true, false},
+	{"HexDigit", "c.peg", 627, 627, true, false},
+	{"IntegerSuffix", "c.peg", 629, 631, false, true},
+	{"Lsuffix", "c.peg", 633, 636, true, false},
+	{"FloatConstant", "c.peg", 638, 642, false, true},
+	{"DecimalFloatConstant", "c.peg", 644, 646, false, true},
+	{"HexFloatConstant", "c.peg", 648, 650, false, true},
+	{"Fraction", "c.peg", 652, 654, true, true},
+	{"HexFraction", "c.peg", 656, 658, false, true},
+	{"Exponent", "c.peg", 660, 660, true, false},
+	{"BinaryExponent", "c.peg", 662, 662, true, false},
+	{"FloatSuffix", "c.peg", 664, 664, true, true},
+	{"EnumerationConstant", "c.peg", 666, 666, false, false},
+	{"CharacterConstant", "c.peg", 668, 668, false, true},
+	{"Char", "c.peg", 670, 670, false, true},
+	{"Escape", "c.peg", 672, 676, false, false},
+	{"SimpleEscape", "c.peg", 678, 678, true, true},
+	{"OctalEscape", "c.peg", 679, 679, true, true},
+	{"HexEscape", "c.peg", 680, 680, false, true},
+	{"StringLiteral", "c.peg", 687, 687, false, false},
+	{"StringChar", "c.peg", 689, 689, false, true},
+	{"LBRK", "c.peg", 696, 696, false, false},
+	{"RBRK", "c.peg", 697, 697, false, false},
+	{"LPAR", "c.peg", 698, 698, false, false},
+	{"RPAR", "c.peg", 699, 699, false, false},
+	{"LWING", "c.peg", 700, 700, false, false},
+	{"RWING", "c.peg", 701, 701, false, false},
+	{"DOT", "c.peg", 702, 702, false, false},
+	{"PTR", "c.peg", 703, 703, false, true},
+	{"INC", "c.peg", 704, 704, false, false},
+	{"DEC", "c.peg", 705, 705, false, false},
+	{"AND", "c.peg", 706, 706, false, false},
+	{"STAR", "c.peg", 707, 707, false, false},
+	{"PLUS", "c.peg", 708, 708, false, false},
+	{"MINUS", "c.peg", 709, 709, false, false},
+	{"TILDA", "c.peg", 710, 710, false, true},
+	{"BANG", "c.peg", 711, 711, false, true},
+	{"DIV", "c.peg", 712, 712, false, true},
+	{"MOD", "c.peg", 713, 713, false, true},
+	{"LEFT", "c.peg", 714, 714, false, true},
+	{"RIGHT", "c.peg", 715, 715, false, true},
+	{"LT", "c.peg", 716, 716, false, true},
+	{"GT", "c.peg", 717, 717, false, true},
+	{"LE", "c.peg", 718, 718, false, true},
+	{"GE", "c.peg", 719, 719, false, true},
+	{"EQUEQU", "c.peg", 720, 720, false, true},
+	{"BANGEQU", "c.peg", 721, 721, false, true},
+	{"HAT", "c.peg", 722, 722, false, true},
+	{"OR", "c.peg", 723, 723, false, true},
+	{"ANDAND", "c.peg", 724, 724, false, true},
+	{"OROR", "c.peg", 725, 725, false, true},
+	{"QUERY", "c.peg", 726, 726, false, true},
+	{"COLON", "c.peg", 727, 727, false, false},
+	{"SEMI", "c.peg", 728, 728, false, false},
+	{"ELLIPSIS", "c.peg", 729, 729, false, true},
+	{"EQU", "c.peg", 730, 730, false, false},
+	{"STAREQU", "c.peg", 731, 731, false, true},
+	{"DIVEQU", "c.peg", 732, 732, false, true},
+	{"MODEQU", "c.peg", 733, 733, false, true},
+	{"PLUSEQU", "c.peg", 734, 734, false, true},
+	{"MINUSEQU", "c.peg", 735, 735, false, true},
+	{"LEFTEQU", "c.peg", 736, 736, false, true},
+	{"RIGHTEQU", "c.peg", 737, 737, false, true},
+	{"ANDEQU", "c.peg", 738, 738, false, true},
+	{"HATEQU", "c.peg", 739, 739, false, true},
+	{"OREQU", "c.peg", 740, 740, false, true},
+	{"COMMA", "c.peg", 741, 741, false, false},
+	{"EOT", "c.peg", 743, 743, true, true},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type C struct {
+	typedefs map[string]bool
+	begin    uint32
+	typedef  bool
+	name     string
+	names    []string
+
+	Buffer         string
+	buffer         []rune
+	rules          [201]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*C) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *C) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *C) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *C) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *C) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *C) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *C) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *C) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *C) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *C) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *C
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *C) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *C
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *C) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *C) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *C) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func Pretty(pretty bool) func(*C) error {
+	return func(p *C) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*C) error {
+	return func(p *C) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*C) error {
+	return func(p *C) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*C) error {
+	return func(p *C) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*C) error {
+	return func(p *C) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+func Size(size int) func(*C) error {
+	return func(p *C) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*C) error {
+	return func(p *C) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *C) Init(options ...func(*C) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.farthest = max.end
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 TranslationUnit <- <(Spacing (ExternalDeclaration / SEMI)* EOT)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[ruleSpacing]() {
+					goto l0
+				}
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					{
+						position4, tokenIndex4 := position, tokenIndex
+						{
+							position6 := position
+							{
+								position7, tokenIndex7 := position, tokenIndex
+								{
+									position9 := position
+									if !_rules[ruleDeclarationSpecifiers]() {
+										goto l8
+									}
+									if !_rules[ruleDeclarator]() {
+										goto l8
+									}
+									{
+										position10, tokenIndex10 := position, tokenIndex
+										{
+											position12 := position
+											if !_rules[ruleDeclaration]() {
+												goto l10
+											}
+										l13:
+											{
+												position14, tokenIndex14 := position, tokenIndex
+												if !_rules[ruleDeclaration]() {
+													goto l14
+												}
+												goto l13
+											l14:
+												position, tokenIndex = position14, tokenIndex14
+											}
+											add(ruleDeclarationList, position12)
+										}
+										goto l11
+									l10:
+										position, tokenIndex = position10, tokenIndex10
+									}
+								l11:
+									if !_rules[ruleCompoundStatement]() {
+										goto l8
+									}
+									add(ruleFunctionDefinition, position9)
+								}
+								goto l7
+							l8:
+								position, tokenIndex = position7, tokenIndex7
+								if !_rules[ruleDeclaration]() {
+									goto l5
+								}
+							}
+						l7:
+							add(ruleExternalDeclaration, position6)
+						}
+						goto l4
+					l5:
+						position, tokenIndex = position4, tokenIndex4
+						if !_rules[ruleSEMI]() {
+							goto l3
+						}
+					}
+				l4:
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				{
+					position15 := position
+					{
+						position16, tokenIndex16 := position, tokenIndex
+						if !matchDot() {
+							goto l16
+						}
+						goto l0
+					l16:
+						position, tokenIndex = position16, tokenIndex16
+					}
+					add(ruleEOT, position15)
+				}
+				add(ruleTranslationUnit, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 ExternalDeclaration <- <(FunctionDefinition / Declaration)> */
+		nil,
+		/* 2 FunctionDefinition <- <(DeclarationSpecifiers Declarator DeclarationList? CompoundStatement)> */
+		nil,
+		/* 3 DeclarationList <- <Declaration+> */
+		nil,
+		/* 4 Declaration <- <(StaticAssertDeclaration / (!{ p.beginDeclaration() } DeclarationSpecifiers InitDeclaratorList? SEMI !{ p.endDeclaration() }))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{4, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position20, tokenIndex20 := position, tokenIndex
+			{
+				position21 := position
+				{
+					position22, tokenIndex22 := position, tokenIndex
+					if !_rules[ruleStaticAssertDeclaration]() {
+						goto l23
+					}
+					goto l22
+				l23:
+					position, tokenIndex = position22, tokenIndex22
+					p.beginDeclaration()
+					if !_rules[ruleDeclarationSpecifiers]() {
+						goto l20
+					}
+					{
+						position24, tokenIndex24 := position, tokenIndex
+						{
+							position26 := position
+							if !_rules[ruleInitDeclarator]() {
+								goto l24
+							}
+						l27:
+							{
+								position28, tokenIndex28 := position, tokenIndex
+								if !_rules[ruleCOMMA]() {
+									goto l28
+								}
+								if !_rules[ruleInitDeclarator]() {
+									goto l28
+								}
+								goto l27
+							l28:
+								position, tokenIndex = position28, tokenIndex28
+							}
+							add(ruleInitDeclaratorList, position26)
+						}
+						goto l25
+					l24:
+						position, tokenIndex = position24, tokenIndex24
+					}
+				l25:
+					if !_rules[ruleSEMI]() {
+						goto l20
+					}
+					p.endDeclaration()
+				}
+			l22:
+				add(ruleDeclaration, position21)
+			}
+			memoize(4, position20, tokenIndex20, true)
+			return true
+		l20:
+			memoize(4, position20, tokenIndex20, false)
+			position, tokenIndex = position20, tokenIndex20
+			return false
+		},
+		/* 5 DeclarationSpecifiers <- <(((StorageClassSpecifier / TypeQualifier / FunctionSpecifier / AlignmentSpecifier)* TypedefName (StorageClassSpecifier / TypeQualifier / FunctionSpecifier / AlignmentSpecifier)*) / (StorageClassSpecifier / TypeSpecifier / TypeQualifier / FunctionSpecifier / AlignmentSpecifier)+)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{5, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position29, tokenIndex29 := position, tokenIndex
+			{
+				position30 := position
+				{
+					position31, tokenIndex31 := position, tokenIndex
+				l33:
+					{
+						position34, tokenIndex34 := position, tokenIndex
+						{
+							position35, tokenIndex35 := position, tokenIndex
+							if !_rules[ruleStorageClassSpecifier]() {
+								goto l36
+							}
+							goto l35
+						l36:
+							position, tokenIndex = position35, tokenIndex35
+							if !_rules[ruleTypeQualifier]() {
+								goto l37
+							}
+							goto l35
+						l37:
+							position, tokenIndex = position35, tokenIndex35
+							if !_rules[ruleFunctionSpecifier]() {
+								goto l38
+							}
+							goto l35
+						l38:
+							position, tokenIndex = position35, tokenIndex35
+							if !_rules[ruleAlignmentSpecifier]() {
+								goto l34
+							}
+						}
+					l35:
+						goto l33
+					l34:
+						position, tokenIndex = position34, tokenIndex34
+					}
+					if !_rules[ruleTypedefName]() {
+						goto l32
+					}
+				l39:
+					{
+						position40, tokenIndex40 := position, tokenIndex
+						{
+							position41, tokenIndex41 := position, tokenIndex
+							if !_rules[ruleStorageClassSpecifier]() {
+								goto l42
+							}
+							goto l41
+						l42:
+							position, tokenIndex = position41, tokenIndex41
+							if !_rules[ruleTypeQualifier]() {
+								goto l43
+							}
+							goto l41
+						l43:
+							position, tokenIndex = position41, tokenIndex41
+							if !_rules[ruleFunctionSpecifier]() {
+								goto l44
+							}
+							goto l41
+						l44:
+							position, tokenIndex = position41, tokenIndex41
+							if !_rules[ruleAlignmentSpecifier]() {
+								goto l40
+							}
+						}
+					l41:
+						goto l39
+					l40:
+						position, tokenIndex = position40, tokenIndex40
+					}
+					goto l31
+				l32:
+					position, tokenIndex = position31, tokenIndex31
+					{
+						position47, tokenIndex47 := position, tokenIndex
+						if !_rules[ruleStorageClassSpecifier]() {
+							goto l48
+						}
+						goto l47
+					l48:
+						position, tokenIndex = position47, tokenIndex47
+						if !_rules[ruleTypeSpecifier]() {
+							goto l49
+						}
+						goto l47
+					l49:
+						position, tokenIndex = position47, tokenIndex47
+						if !_rules[ruleTypeQualifier]() {
+							goto l50
+						}
+						goto l47
+					l50:
+						position, tokenIndex = position47, tokenIndex47
+						if !_rules[ruleFunctionSpecifier]() {
+							goto l51
+						}
+						goto l47
+					l51:
+						position, tokenIndex = position47, tokenIndex47
+						if !_rules[ruleAlignmentSpecifier]() {
+							goto l29
+						}
+					}
+				l47:
+				l45:
+					{
+						position46, tokenIndex46 := position, tokenIndex
+						{
+							position52, tokenIndex52 := position, tokenIndex
+							if !_rules[ruleStorageClassSpecifier]() {
+								goto l53
+							}
+							goto l52
+						l53:
+							position, tokenIndex = position52, tokenIndex52
+							if !_rules[ruleTypeSpecifier]() {
+								goto l54
+							}
+							goto l52
+						l54:
+							position, tokenIndex = position52, tokenIndex52
+							if !_rules[ruleTypeQualifier]() {
+								goto l55
+							}
+							goto l52
+						l55:
+							position, tokenIndex = position52, tokenIndex52
+							if !_rules[ruleFunctionSpecifier]() {
+								goto l56
+							}
+							goto l52
+						l56:
+							position, tokenIndex = position52, tokenIndex52
+							if !_rules[ruleAlignmentSpecifier]() {
+								goto l46
+							}
+						}
+					l52:
+						goto l45
+					l46:
+						position, tokenIndex = position46, tokenIndex46
+					}
+				}
+			l31:
+				add(ruleDeclarationSpecifiers, position30)
+			}
+			return true
+		l29:
+			memoize(5, position29, tokenIndex29, false)
+			position, tokenIndex = position29, tokenIndex29
+			return false
+		},
+		/* 6 InitDeclaratorList <- <(InitDeclarator (COMMA InitDeclarator)*)> */
+		nil,
+		/* 7 InitDeclarator <- <(!{ p.name = "" } Declarator !{ p.names = append(p.names, p.name) } (EQU Initializer)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{7, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position58, tokenIndex58 := position, tokenIndex
+			{
+				position59 := position
+				p.name = ""
+				if !_rules[ruleDeclarator]() {
+					goto l58
+				}
+				p.names = append(p.names, p.name)
+				{
+					position60, tokenIndex60 := position, tokenIndex
+					if !_rules[ruleEQU]() {
+						goto l60
+					}
+					if !_rules[ruleInitializer]() {
+						goto l60
+					}
+					goto l61
+				l60:
+					position, tokenIndex = position60, tokenIndex60
+				}
+			l61:
+				add(ruleInitDeclarator, position59)
+			}
+			return true
+		l58:
+			memoize(7, position58, tokenIndex58, false)
+			position, tokenIndex = position58, tokenIndex58
+			return false
+		},
+		/* 8 StorageClassSpecifier <- <((&('a') %fail('typedef' 'extern' 'static' '_Thread_local' 'register' '__attribute__') AUTO) | (&('e') %fail('typedef' 'static' '_Thread_local' 'auto' 'register' '__attribute__') EXTERN) | (&('r') %fail('typedef' 'extern' 'static' '_Thread_local' 'auto' '__attribute__') REGISTER) | (&('s') %fail('typedef' 'extern' '_Thread_local' 'auto' 'register' '__attribute__') STATIC) | (&('t') %fail('extern' 'static' '_Thread_local' 'auto' 'register' '__attribute__') (TYPEDEF !{ p.typedef = true })) | (&('_') %fail('typedef' 'extern' 'static' 'auto' 'register') (THREADLOCAL / (ATTRIBUTE LPAR LPAR (!RPAR .)* RPAR RPAR))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{8, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position62, tokenIndex62 := position, tokenIndex
+			{
+				position63 := position
+				{
+					switch buffer[position] {
+					case 'a':
+						{
+							position65 := position
+							position++
+							if buffer[position] != rune('u') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l62
+							}
+							position++
+							{
+								position66, tokenIndex66 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l66
+								}
+								goto l62
+							l66:
+								position, tokenIndex = position66, tokenIndex66
+							}
+							if !_rules[ruleSpacing]() {
+								goto l62
+							}
+							add(ruleAUTO, position65)
+						}
+					case 'e':
+						{
+							position67 := position
+							position++
+							if buffer[position] != rune('x') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l62
+							}
+							position++
+							{
+								position68, tokenIndex68 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l68
+								}
+								goto l62
+							l68:
+								position, tokenIndex = position68, tokenIndex68
+							}
+							if !_rules[ruleSpacing]() {
+								goto l62
+							}
+							add(ruleEXTERN, position67)
+						}
+					case 'r':
+						{
+							position69 := position
+							position++
+							if buffer[position] != rune('e') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l62
+							}
+							position++
+							{
+								position70, tokenIndex70 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l70
+								}
+								goto l62
+							l70:
+								position, tokenIndex = position70, tokenIndex70
+							}
+							if !_rules[ruleSpacing]() {
+								goto l62
+							}
+							add(ruleREGISTER, position69)
+						}
+					case 's':
+						if !_rules[ruleSTATIC]() {
+							goto l62
+						}
+					case 't':
+						{
+							position71 := position
+							position++
+							if buffer[position] != rune('y') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l62
+							}
+							position++
+							if buffer[position] != rune('f') {
+								goto l62
+							}
+							position++
+							{
+								position72, tokenIndex72 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l72
+								}
+								goto l62
+							l72:
+								position, tokenIndex = position72, tokenIndex72
+							}
+							if !_rules[ruleSpacing]() {
+								goto l62
+							}
+							add(ruleTYPEDEF, position71)
+						}
+						p.typedef = true
+					default:
+						{
+							position73, tokenIndex73 := position, tokenIndex
+							{
+								position75 := position
+								if buffer[position] != rune('_') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('T') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('_') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l74
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l74
+								}
+								position++
+								{
+									position76, tokenIndex76 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l76
+									}
+									goto l74
+								l76:
+									position, tokenIndex = position76, tokenIndex76
+								}
+								if !_rules[ruleSpacing]() {
+									goto l74
+								}
+								add(ruleTHREADLOCAL, position75)
+							}
+							goto l73
+						l74:
+							position, tokenIndex = position73, tokenIndex73
+							{
+								position77 := position
+								if buffer[position] != rune('_') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('_') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('b') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('_') {
+									goto l62
+								}
+								position++
+								if buffer[position] != rune('_') {
+									goto l62
+								}
+								position++
+								{
+									position78, tokenIndex78 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l78
+									}
+									goto l62
+								l78:
+									position, tokenIndex = position78, tokenIndex78
+								}
+								if !_rules[ruleSpacing]() {
+									goto l62
+								}
+								add(ruleATTRIBUTE, position77)
+							}
+							if !_rules[ruleLPAR]() {
+								goto l62
+							}
+							if !_rules[ruleLPAR]() {
+								goto l62
+							}
+						l79:
+							{
+								position80, tokenIndex80 := position, tokenIndex
+								{
+									position81, tokenIndex81 := position, tokenIndex
+									if !_rules[ruleRPAR]() {
+										goto l81
+									}
+									goto l80
+								l81:
+									position, tokenIndex = position81, tokenIndex81
+								}
+								if !matchDot() {
+									goto l80
+								}
+								goto l79
+							l80:
+								position, tokenIndex = position80, tokenIndex80
+							}
+							if !_rules[ruleRPAR]() {
+								goto l62
+							}
+							if !_rules[ruleRPAR]() {
+								goto l62
+							}
+						}
+					l73:
+						break
+					}
+				}
+
+				add(ruleStorageClassSpecifier, position63)
+			}
+			return true
+		l62:
+			memoize(8, position62, tokenIndex62, false)
+			position, tokenIndex = position62, tokenIndex62
+			return false
+		},
+		/* 9 TypeSpecifier <- <((&('c') %fail('void' 'short' 'int' 'long' 'float' 'double' 'signed' 'unsigned' '_Bool' '_Complex' '_Atomic' 'struct' 'union' 'enum') CHAR) | (&('d') %fail('void' 'char' 'short' 'int' 'long' 'float' 'signed' 'unsigned' '_Bool' '_Complex' '_Atomic' 'struct' 'union' 'enum') DOUBLE) | (&('e') %fail('void' 'char' 'short' 'int' 'long' 'float' 'double' 'signed' 'unsigned' '_Bool' '_Complex' '_Atomic' 'struct' 'union') EnumSpecifier) | (&('f') %fail('void' 'char' 'short' 'int' 'long' 'double' 'signed' 'unsigned' '_Bool' '_Complex' '_Atomic' 'struct' 'union' 'enum') FLOAT) | (&('i') %fail('void' 'char' 'short' 'long' 'float' 'double' 'signed' 'unsigned' '_Bool' '_Complex' '_Atomic' 'struct' 'union' 'enum') INT) | (&('l') %fail('void' 'char' 'short' 'int' 'float' 'double' 'signed' 'unsigned' '_Bool' '_Complex' '_Atomic' 'struct' 'union' 'enum') LONG) | (&('s') %fail('void' 'char' 'int' 'long' 'float' 'double' 'unsigned' '_Bool' '_Complex' '_Atomic' 'enum') (SHORT / SIGNED / StructOrUnionSpecifier)) | (&('u') %fail('void' 'char' 'short' 'int' 'long' 'float' 'double' 'signed' '_Bool' '_Complex' '_Atomic' 'enum') (UNSIGNED / StructOrUnionSpecifier)) | (&('v') %fail('char' 'short' 'int' 'long' 'float' 'double' 'signed' 'unsigned' '_Bool' '_Complex' '_Atomic' 'struct' 'union' 'enum') VOID) | (&('_') %fail('void' 'char' 'short' 'int' 'long' 'float' 'double' 'signed' 'unsigned' 'struct' 'union' 'enum') (BOOL / COMPLEX / AtomicTypeSpecifier)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{9, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position82, tokenIndex82 := position, tokenIndex
+			{
+				position83 := position
+				{
+					switch buffer[position] {
+					case 'c':
+						{
+							position85 := position
+							position++
+							if buffer[position] != rune('h') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l82
+							}
+							position++
+							{
+								position86, tokenIndex86 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l86
+								}
+								goto l82
+							l86:
+								position, tokenIndex = position86, tokenIndex86
+							}
+							if !_rules[ruleSpacing]() {
+								goto l82
+							}
+							add(ruleCHAR, position85)
+						}
+					case 'd':
+						{
+							position87 := position
+							position++
+							if buffer[position] != rune('o') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('b') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l82
+							}
+							position++
+							{
+								position88, tokenIndex88 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l88
+								}
+								goto l82
+							l88:
+								position, tokenIndex = position88, tokenIndex88
+							}
+							if !_rules[ruleSpacing]() {
+								goto l82
+							}
+							add(ruleDOUBLE, position87)
+						}
+					case 'e':
+						{
+							position89 := position
+							{
+								position90 := position
+								position++
+								if buffer[position] != rune('n') {
+									goto l82
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l82
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l82
+								}
+								position++
+								{
+									position91, tokenIndex91 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l91
+									}
+									goto l82
+								l91:
+									position, tokenIndex = position91, tokenIndex91
+								}
+								if !_rules[ruleSpacing]() {
+									goto l82
+								}
+								add(ruleENUM, position90)
+							}
+							{
+								position92, tokenIndex92 := position, tokenIndex
+								{
+									position94, tokenIndex94 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l94
+									}
+									goto l95
+								l94:
+									position, tokenIndex = position94, tokenIndex94
+								}
+							l95:
+								if !_rules[ruleLWING]() {
+									goto l93
+								}
+								{
+									position96 := position
+									if !_rules[ruleEnumerator]() {
+										goto l93
+									}
+								l97:
+									{
+										position98, tokenIndex98 := position, tokenIndex
+										if !_rules[ruleCOMMA]() {
+											goto l98
+										}
+										if !_rules[ruleEnumerator]() {
+											goto l98
+										}
+										goto l97
+									l98:
+										position, tokenIndex = position98, tokenIndex98
+									}
+									add(ruleEnumeratorList, position96)
+								}
+								{
+									position99, tokenIndex99 := position, tokenIndex
+									if !_rules[ruleCOMMA]() {
+										goto l99
+									}
+									goto l100
+								l99:
+									position, tokenIndex = position99, tokenIndex99
+								}
+							l100:
+								if !_rules[ruleRWING]() {
+									goto l93
+								}
+								goto l92
+							l93:
+								position, tokenIndex = position92, tokenIndex92
+								if !_rules[ruleIdentifier]() {
+									goto l82
+								}
+							}
+						l92:
+							add(ruleEnumSpecifier, position89)
+						}
+					case 'f':
+						{
+							position101 := position
+							position++
+							if buffer[position] != rune('l') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l82
+							}
+							position++
+							{
+								position102, tokenIndex102 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l102
+								}
+								goto l82
+							l102:
+								position, tokenIndex = position102, tokenIndex102
+							}
+							if !_rules[ruleSpacing]() {
+								goto l82
+							}
+							add(ruleFLOAT, position101)
+						}
+					case 'i':
+						{
+							position103 := position
+							position++
+							if buffer[position] != rune('n') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l82
+							}
+							position++
+							{
+								position104, tokenIndex104 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l104
+								}
+								goto l82
+							l104:
+								position, tokenIndex = position104, tokenIndex104
+							}
+							if !_rules[ruleSpacing]() {
+								goto l82
+							}
+							add(ruleINT, position103)
+						}
+					case 'l':
+						{
+							position105 := position
+							position++
+							if buffer[position] != rune('o') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('g') {
+								goto l82
+							}
+							position++
+							{
+								position106, tokenIndex106 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l106
+								}
+								goto l82
+							l106:
+								position, tokenIndex = position106, tokenIndex106
+							}
+							if !_rules[ruleSpacing]() {
+								goto l82
+							}
+							add(ruleLONG, position105)
+						}
+					case 's':
+						{
+							position107, tokenIndex107 := position, tokenIndex
+							{
+								position109 := position
+								if buffer[position] != rune('s') {
+									goto l108
+								}
+								position++
+								if buffer[position] != rune('h') {
+									goto l108
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l108
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l108
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l108
+								}
+								position++
+								{
+									position110, tokenIndex110 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l110
+									}
+									goto l108
+								l110:
+									position, tokenIndex = position110, tokenIndex110
+								}
+								if !_rules[ruleSpacing]() {
+									goto l108
+								}
+								add(ruleSHORT, position109)
+							}
+							goto l107
+						l108:
+							position, tokenIndex = position107, tokenIndex107
+							{
+								position112 := position
+								if buffer[position] != rune('s') {
+									goto l111
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l111
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l111
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l111
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l111
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l111
+								}
+								position++
+								{
+									position113, tokenIndex113 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l113
+									}
+									goto l111
+								l113:
+									position, tokenIndex = position113, tokenIndex113
+								}
+								if !_rules[ruleSpacing]() {
+									goto l111
+								}
+								add(ruleSIGNED, position112)
+							}
+							goto l107
+						l111:
+							position, tokenIndex = position107, tokenIndex107
+							if !_rules[ruleStructOrUnionSpecifier]() {
+								goto l82
+							}
+						}
+					l107:
+						break
+					case 'u':
+						{
+							position114, tokenIndex114 := position, tokenIndex
+							{
+								position116 := position
+								if buffer[position] != rune('u') {
+									goto l115
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l115
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l115
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l115
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l115
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l115
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l115
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l115
+								}
+								position++
+								{
+									position117, tokenIndex117 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l117
+									}
+									goto l115
+								l117:
+									position, tokenIndex = position117, tokenIndex117
+								}
+								if !_rules[ruleSpacing]() {
+									goto l115
+								}
+								add(ruleUNSIGNED, position116)
+							}
+							goto l114
+						l115:
+							position, tokenIndex = position114, tokenIndex114
+							if !_rules[ruleStructOrUnionSpecifier]() {
+								goto l82
+							}
+						}
+					l114:
+						break
+					case 'v':
+						{
+							position118 := position
+							position++
+							if buffer[position] != rune('o') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l82
+							}
+							position++
+							if buffer[position] != rune('d') {
+								goto l82
+							}
+							position++
+							{
+								position119, tokenIndex119 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l119
+								}
+								goto l82
+							l119:
+								position, tokenIndex = position119, tokenIndex119
+							}
+							if !_rules[ruleSpacing]() {
+								goto l82
+							}
+							add(ruleVOID, position118)
+						}
+					default:
+						{
+							position120, tokenIndex120 := position, tokenIndex
+							{
+								position122 := position
+								if buffer[position] != rune('_') {
+									goto l121
+								}
+								position++
+								if buffer[position] != rune('B') {
+									goto l121
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l121
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l121
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l121
+								}
+								position++
+								{
+									position123, tokenIndex123 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l123
+									}
+									goto l121
+								l123:
+									position, tokenIndex = position123, tokenIndex123
+								}
+								if !_rules[ruleSpacing]() {
+									goto l121
+								}
+								add(ruleBOOL, position122)
+							}
+							goto l120
+						l121:
+							position, tokenIndex = position120, tokenIndex120
+							{
+								position125 := position
+								if buffer[position] != rune('_') {
+									goto l124
+								}
+								position++
+								if buffer[position] != rune('C') {
+									goto l124
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l124
+								}
+								position++
+								if buffer[position] != rune('m') {
+									goto l124
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l124
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l124
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l124
+								}
+								position++
+								if buffer[position] != rune('x') {
+									goto l124
+								}
+								position++
+								{
+									position126, tokenIndex126 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l126
+									}
+									goto l124
+								l126:
+									position, tokenIndex = position126, tokenIndex126
+								}
+								if !_rules[ruleSpacing]() {
+									goto l124
+								}
+								add(ruleCOMPLEX, position125)
+							}
+							goto l120
+						l124:
+							position, tokenIndex = position120, tokenIndex120
+							{
+								position127 := position
+								if !_rules[ruleATOMIC]() {
+									goto l82
+								}
+								if !_rules[ruleLPAR]() {
+									goto l82
+								}
+								if !_rules[ruleTypeName]() {
+									goto l82
+								}
+								if !_rules[ruleRPAR]() {
+									goto l82
+								}
+								add(ruleAtomicTypeSpecifier, position127)
+							}
+						}
+					l120:
+						break
+					}
+				}
+
+				add(ruleTypeSpecifier, position83)
+			}
+			memoize(9, position82, tokenIndex82, true)
+			return true
+		l82:
+			memoize(9, position82, tokenIndex82, false)
+			position, tokenIndex = position82, tokenIndex82
+			return false
+		},
+		/* 10 StructOrUnionSpecifier <- <(StructOrUnion ((Identifier? LWING StructDeclaration* RWING) / Identifier))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{10, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position128, tokenIndex128 := position, tokenIndex
+			{
+				position129 := position
+				{
+					position130 := position
+					{
+						position131, tokenIndex131 := position, tokenIndex
+						{
+							position133 := position
+							if buffer[position] != rune('s') {
+								goto l132
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l132
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l132
+							}
+							position++
+							if buffer[position] != rune('u') {
+								goto l132
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l132
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l132
+							}
+							position++
+							{
+								position134, tokenIndex134 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l134
+								}
+								goto l132
+							l134:
+								position, tokenIndex = position134, tokenIndex134
+							}
+							if !_rules[ruleSpacing]() {
+								goto l132
+							}
+							add(ruleSTRUCT, position133)
+						}
+						goto l131
+					l132:
+						position, tokenIndex = position131, tokenIndex131
+						{
+							position135 := position
+							if buffer[position] != rune('u') {
+								goto l128
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l128
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l128
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l128
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l128
+							}
+							position++
+							{
+								position136, tokenIndex136 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l136
+								}
+								goto l128
+							l136:
+								position, tokenIndex = position136, tokenIndex136
+							}
+							if !_rules[ruleSpacing]() {
+								goto l128
+							}
+							add(ruleUNION, position135)
+						}
+					}
+				l131:
+					add(ruleStructOrUnion, position130)
+				}
+				{
+					position137, tokenIndex137 := position, tokenIndex
+					{
+						position139, tokenIndex139 := position, tokenIndex
+						if !_rules[ruleIdentifier]() {
+							goto l139
+						}
+						goto l140
+					l139:
+						position, tokenIndex = position139, tokenIndex139
+					}
+				l140:
+					if !_rules[ruleLWING]() {
+						goto l138
+					}
+				l141:
+					{
+						position142, tokenIndex142 := position, tokenIndex
+						{
+							position143 := position
+							{
+								position144, tokenIndex144 := position, tokenIndex
+								if !_rules[ruleStaticAssertDeclaration]() {
+									goto l145
+								}
+								goto l144
+							l145:
+								position, tokenIndex = position144, tokenIndex144
+								{
+									position146, tokenIndex146 := position, tokenIndex
+									if !_rules[ruleSpecifierQualifierList]() {
+										goto l146
+									}
+									{
+										position148, tokenIndex148 := position, tokenIndex
+										{
+											position150 := position
+											if !_rules[ruleStructDeclarator]() {
+												goto l148
+											}
+										l151:
+											{
+												position152, tokenIndex152 := position, tokenIndex
+												if !_rules[ruleCOMMA]() {
+													goto l152
+												}
+												if !_rules[ruleStructDeclarator]() {
+													goto l152
+												}
+												goto l151
+											l152:
+												position, tokenIndex = position152, tokenIndex152
+											}
+											add(ruleStructDeclaratorList, position150)
+										}
+										goto l149
+									l148:
+										position, tokenIndex = position148, tokenIndex148
+									}
+								l149:
+									goto l147
+								l146:
+									position, tokenIndex = position146, tokenIndex146
+								}
+							l147:
+								if !_rules[ruleSEMI]() {
+									goto l142
+								}
+							}
+						l144:
+							add(ruleStructDeclaration, position143)
+						}
+						goto l141
+					l142:
+						position, tokenIndex = position142, tokenIndex142
+					}
+					if !_rules[ruleRWING]() {
+						goto l138
+					}
+					goto l137
+				l138:
+					position, tokenIndex = position137, tokenIndex137
+					if !_rules[ruleIdentifier]() {
+						goto l128
+					}
+				}
+			l137:
+				add(ruleStructOrUnionSpecifier, position129)
+			}
+			memoize(10, position128, tokenIndex128, true)
+			return true
+		l128:
+			memoize(10, position128, tokenIndex128, false)
+			position, tokenIndex = position128, tokenIndex128
+			return false
+		},
+		/* 11 StructOrUnion <- <(STRUCT / UNION)> */
+		nil,
+		/* 12 StructDeclaration <- <(StaticAssertDeclaration / ((SpecifierQualifierList StructDeclaratorList?)? SEMI))> */
+		nil,
+		/* 13 SpecifierQualifierList <- <(((TypeQualifier / AlignmentSpecifier)* TypedefName (TypeQualifier / AlignmentSpecifier)*) / (TypeSpecifier / TypeQualifier / AlignmentSpecifier)+)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{13, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position155, tokenIndex155 := position, tokenIndex
+			{
+				position156 := position
+				{
+					position157, tokenIndex157 := position, tokenIndex
+				l159:
+					{
+						position160, tokenIndex160 := position, tokenIndex
+						{
+							position161, tokenIndex161 := position, tokenIndex
+							if !_rules[ruleTypeQualifier]() {
+								goto l162
+							}
+							goto l161
+						l162:
+							position, tokenIndex = position161, tokenIndex161
+							if !_rules[ruleAlignmentSpecifier]() {
+								goto l160
+							}
+						}
+					l161:
+						goto l159
+					l160:
+						position, tokenIndex = position160, tokenIndex160
+					}
+					if !_rules[ruleTypedefName]() {
+						goto l158
+					}
+				l163:
+					{
+						position164, tokenIndex164 := position, tokenIndex
+						{
+							position165, tokenIndex165 := position, tokenIndex
+							if !_rules[ruleTypeQualifier]() {
+								goto l166
+							}
+							goto l165
+						l166:
+							position, tokenIndex = position165, tokenIndex165
+							if !_rules[ruleAlignmentSpecifier]() {
+								goto l164
+							}
+						}
+					l165:
+						goto l163
+					l164:
+						position, tokenIndex = position164, tokenIndex164
+					}
+					goto l157
+				l158:
+					position, tokenIndex = position157, tokenIndex157
+					{
+						position169, tokenIndex169 := position, tokenIndex
+						if !_rules[ruleTypeSpecifier]() {
+							goto l170
+						}
+						goto l169
+					l170:
+						position, tokenIndex = position169, tokenIndex169
+						if !_rules[ruleTypeQualifier]() {
+							goto l171
+						}
+						goto l169
+					l171:
+						position, tokenIndex = position169, tokenIndex169
+						if !_rules[ruleAlignmentSpecifier]() {
+							goto l155
+						}
+					}
+				l169:
+				l167:
+					{
+						position168, tokenIndex168 := position, tokenIndex
+						{
+							position172, tokenIndex172 := position, tokenIndex
+							if !_rules[ruleTypeSpecifier]() {
+								goto l173
+							}
+							goto l172
+						l173:
+							position, tokenIndex = position172, tokenIndex172
+							if !_rules[ruleTypeQualifier]() {
+								goto l174
+							}
+							goto l172
+						l174:
+							position, tokenIndex = position172, tokenIndex172
+							if !_rules[ruleAlignmentSpecifier]() {
+								goto l168
+							}
+						}
+					l172:
+						goto l167
+					l168:
+						position, tokenIndex = position168, tokenIndex168
+					}
+				}
+			l157:
+				add(ruleSpecifierQualifierList, position156)
+			}
+			memoize(13, position155, tokenIndex155, true)
+			return true
+		l155:
+			memoize(13, position155, tokenIndex155, false)
+			position, tokenIndex = position155, tokenIndex155
+			return false
+		},
+		/* 14 StructDeclaratorList <- <(StructDeclarator (COMMA StructDeclarator)*)> */
+		nil,
+		/* 15 StructDeclarator <- <((Declarator? COLON ConstantExpression) / Declarator)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{15, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position176, tokenIndex176 := position, tokenIndex
+			{
+				position177 := position
+				{
+					position178, tokenIndex178 := position, tokenIndex
+					{
+						position180, tokenIndex180 := position, tokenIndex
+						if !_rules[ruleDeclarator]() {
+							goto l180
+						}
+						goto l181
+					l180:
+						position, tokenIndex = position180, tokenIndex180
+					}
+				l181:
+					if !_rules[ruleCOLON]() {
+						goto l179
+					}
+					if !_rules[ruleConstantExpression]() {
+						goto l179
+					}
+					goto l178
+				l179:
+					position, tokenIndex = position178, tokenIndex178
+					if !_rules[ruleDeclarator]() {
+						goto l176
+					}
+				}
+			l178:
+				add(ruleStructDeclarator, position177)
+			}
+			memoize(15, position176, tokenIndex176, true)
+			return true
+		l176:
+			memoize(15, position176, tokenIndex176, false)
+			position, tokenIndex = position176, tokenIndex176
+			return false
+		},
+		/* 16 EnumSpecifier <- <(ENUM ((Identifier? LWING EnumeratorList COMMA? RWING) / Identifier))> */
+		nil,
+		/* 17 EnumeratorList <- <(Enumerator (COMMA Enumerator)*)> */
+		nil,
+		/* 18 Enumerator <- <(EnumerationConstant (EQU ConstantExpression)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{18, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position184, tokenIndex184 := position, tokenIndex
+			{
+				position185 := position
+				if !_rules[ruleEnumerationConstant]() {
+					goto l184
+				}
+				{
+					position186, tokenIndex186 := position, tokenIndex
+					if !_rules[ruleEQU]() {
+						goto l186
+					}
+					if !_rules[ruleConstantExpression]() {
+						goto l186
+					}
+					goto l187
+				l186:
+					position, tokenIndex = position186, tokenIndex186
+				}
+			l187:
+				add(ruleEnumerator, position185)
+			}
+			memoize(18, position184, tokenIndex184, true)
+			return true
+		l184:
+			memoize(18, position184, tokenIndex184, false)
+			position, tokenIndex = position184, tokenIndex184
+			return false
+		},
+		/* 19 TypeQualifier <- <((&('c') %fail('restrict' 'volatile' '_Atomic' '__declspec') CONST) | (&('r') %fail('const' 'volatile' '_Atomic' '__declspec') RESTRICT) | (&('v') %fail('const' 'restrict' '_Atomic' '__declspec') VOLATILE) | (&('_') %fail('const' 'restrict' 'volatile') (ATOMIC / (DECLSPEC LPAR Identifier RPAR))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{19, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position188, tokenIndex188 := position, tokenIndex
+			{
+				position189 := position
+				{
+					switch buffer[position] {
+					case 'c':
+						{
+							position191 := position
+							position++
+							if buffer[position] != rune('o') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l188
+							}
+							position++
+							{
+								position192, tokenIndex192 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l192
+								}
+								goto l188
+							l192:
+								position, tokenIndex = position192, tokenIndex192
+							}
+							if !_rules[ruleSpacing]() {
+								goto l188
+							}
+							add(ruleCONST, position191)
+						}
+					case 'r':
+						{
+							position193 := position
+							position++
+							if buffer[position] != rune('e') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('s') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('c') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l188
+							}
+							position++
+							{
+								position194, tokenIndex194 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l194
+								}
+								goto l188
+							l194:
+								position, tokenIndex = position194, tokenIndex194
+							}
+							if !_rules[ruleSpacing]() {
+								goto l188
+							}
+							add(ruleRESTRICT, position193)
+						}
+					case 'v':
+						{
+							position195 := position
+							position++
+							if buffer[position] != rune('o') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('a') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l188
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l188
+							}
+							position++
+							{
+								position196, tokenIndex196 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l196
+								}
+								goto l188
+							l196:
+								position, tokenIndex = position196, tokenIndex196
+							}
+							if !_rules[ruleSpacing]() {
+								goto l188
+							}
+							add(ruleVOLATILE, position195)
+						}
+					default:
+						{
+							position197, tokenIndex197 := position, tokenIndex
+							if !_rules[ruleATOMIC]() {
+								goto l198
+							}
+							goto l197
+						l198:
+							position, tokenIndex = position197, tokenIndex197
+							{
+								position199 := position
+								if buffer[position] != rune('_') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('_') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l188
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l188
+								}
+								position++
+								{
+									position200, tokenIndex200 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l200
+									}
+									goto l188
+								l200:
+									position, tokenIndex = position200, tokenIndex200
+								}
+								if !_rules[ruleSpacing]() {
+									goto l188
+								}
+								add(ruleDECLSPEC, position199)
+							}
+							if !_rules[ruleLPAR]() {
+								goto l188
+							}
+							if !_rules[ruleIdentifier]() {
+								goto l188
+							}
+							if !_rules[ruleRPAR]() {
+								goto l188
+							}
+						}
+					l197:
+						break
+					}
+				}
+
+				add(ruleTypeQualifier, position189)
+			}
+			memoize(19, position188, tokenIndex188, true)
+			return true
+		l188:
+			memoize(19, position188, tokenIndex188, false)
+			position, tokenIndex = position188, tokenIndex188
+			return false
+		},
+		/* 20 FunctionSpecifier <- <((&('i') %fail('_Noreturn' '_stdcall') INLINE) | (&('_') %fail('inline') (NORETURN / STDCALL)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{20, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position201, tokenIndex201 := position, tokenIndex
+			{
+				position202 := position
+				{
+					switch buffer[position] {
+					case 'i':
+						{
+							position204 := position
+							position++
+							if buffer[position] != rune('n') {
+								goto l201
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l201
+							}
+							position++
+							if buffer[position] != rune('i') {
+								goto l201
+							}
+							position++
+							if buffer[position] != rune('n') {
+								goto l201
+							}
+							position++
+							if buffer[position] != rune('e') {
+								goto l201
+							}
+							position++
+							{
+								position205, tokenIndex205 := position, tokenIndex
+								if !_rules[ruleIdChar]() {
+									goto l205
+								}
+								goto l201
+							l205:
+								position, tokenIndex = position205, tokenIndex205
+							}
+							if !_rules[ruleSpacing]() {
+								goto l201
+							}
+							add(ruleINLINE, position204)
+						}
+					default:
+						{
+							position206, tokenIndex206 := position, tokenIndex
+							{
+								position208 := position
+								if buffer[position] != rune('_') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('N') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l207
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l207
+								}
+								position++
+								{
+									position209, tokenIndex209 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l209
+									}
+									goto l207
+								l209:
+									position, tokenIndex = position209, tokenIndex209
+								}
+								if !_rules[ruleSpacing]() {
+									goto l207
+								}
+								add(ruleNORETURN, position208)
+							}
+							goto l206
+						l207:
+							position, tokenIndex = position206, tokenIndex206
+							{
+								position210 := position
+								if buffer[position] != rune('_') {
+									goto l201
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l201
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l201
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l201
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l201
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l201
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l201
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l201
+								}
+								position++
+								{
+									position211, tokenIndex211 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l211
+									}
+									goto l201
+								l211:
+									position, tokenIndex = position211, tokenIndex211
+								}
+								if !_rules[ruleSpacing]() {
+									goto l201
+								}
+								add(ruleSTDCALL, position210)
+							}
+						}
+					l206:
+						break
+					}
+				}
+
+				add(ruleFunctionSpecifier, position202)
+			}
+			memoize(20, position201, tokenIndex201, true)
+			return true
+		l201:
+			memoize(20, position201, tokenIndex201, false)
+			position, tokenIndex = position201, tokenIndex201
+			return false
+		},
+		/* 21 AtomicTypeSpecifier <- <(ATOMIC LPAR TypeName RPAR)> */
+		nil,
+		/* 22 AlignmentSpecifier <- <(ALIGNAS LPAR (TypeName / ConstantExpression) RPAR)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{22, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position213, tokenIndex213 := position, tokenIndex
+			{
+				position214 := position
+				{
+					position215 := position
+					if buffer[position] != rune('_') {
+						goto l213
+					}
+					position++
+					if buffer[position] != rune('A') {
+						goto l213
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l213
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l213
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l213
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l213
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l213
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l213
+					}
+					position++
+					{
+						position216, tokenIndex216 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l216
+						}
+						goto l213
+					l216:
+						position, tokenIndex = position216, tokenIndex216
+					}
+					if !_rules[ruleSpacing]() {
+						goto l213
+					}
+					add(ruleALIGNAS, position215)
+				}
+				if !_rules[ruleLPAR]() {
+					goto l213
+				}
+				{
+					position217, tokenIndex217 := position, tokenIndex
+					if !_rules[ruleTypeName]() {
+						goto l218
+					}
+					goto l217
+				l218:
+					position, tokenIndex = position217, tokenIndex217
+					if !_rules[ruleConstantExpression]() {
+						goto l213
+					}
+				}
+			l217:
+				if !_rules[ruleRPAR]() {
+					goto l213
+				}
+				add(ruleAlignmentSpecifier, position214)
+			}
+			memoize(22, position213, tokenIndex213, true)
+			return true
+		l213:
+			memoize(22, position213, tokenIndex213, false)
+			position, tokenIndex = position213, tokenIndex213
+			return false
+		},
+		/* 23 StaticAssertDeclaration <- <(STATICASSERT LPAR ConstantExpression COMMA StringLiteral RPAR SEMI)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{23, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position219, tokenIndex219 := position, tokenIndex
+			{
+				position220 := position
+				{
+					position221 := position
+					if buffer[position] != rune('_') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('S') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l219
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l219
+					}
+					position++
+					{
+						position222, tokenIndex222 := position, tokenIndex
+						if !_rules[ruleIdChar]() {
+							goto l222
+						}
+						goto l219
+					l222:
+						position, tokenIndex = position222, tokenIndex222
+					}
+					if !_rules[ruleSpacing]() {
+						goto l219
+					}
+					add(ruleSTATICASSERT, position221)
+				}
+				if !_rules[ruleLPAR]() {
+					goto l219
+				}
+				if !_rules[ruleConstantExpression]() {
+					goto l219
+				}
+				if !_rules[ruleCOMMA]() {
+					goto l219
+				}
+				if !_rules[ruleStringLiteral]() {
+					goto l219
+				}
+				if !_rules[ruleRPAR]() {
+					goto l219
+				}
+				if !_rules[ruleSEMI]() {
+					goto l219
+				}
+				add(ruleStaticAssertDeclaration, position220)
+			}
+			memoize(23, position219, tokenIndex219, true)
+			return true
+		l219:
+			memoize(23, position219, tokenIndex219, false)
+			position, tokenIndex = position219, tokenIndex219
+			return false
+		},
+		/* 24 Declarator <- <(Pointer? DirectDeclarator)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{24, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position223, tokenIndex223 := position, tokenIndex
+			{
+				position224 := position
+				{
+					position225, tokenIndex225 := position, tokenIndex
+					if !_rules[rulePointer]() {
+						goto l225
+					}
+					goto l226
+				l225:
+					position, tokenIndex = position225, tokenIndex225
+				}
+			l226:
+				{
+					position227 := position
+					{
+						position228, tokenIndex228 := position, tokenIndex
+						p.begin = position
+						if !_rules[ruleIdentifier]() {
+							goto l229
+						}
+						p.declarator(identifier(buffer[p.begin:]))
+						goto l228
+					l229:
+						position, tokenIndex = position228, tokenIndex228
+						if !_rules[ruleLPAR]() {
+							goto l223
+						}
+						if !_rules[ruleDeclarator]() {
+							goto l223
+						}
+						if !_rules[ruleRPAR]() {
+							goto l223
+						}
+					}
+				l228:
+				l230:
+					{
+						position231, tokenIndex231 := position, tokenIndex
+						{
+							position232, tokenIndex232 := position, tokenIndex
+							if !_rules[ruleLBRK]() {
+								goto l233
+							}
+							{
+								position234, tokenIndex234 := position, tokenIndex
+							l236:
+								{
+									position237, tokenIndex237 := position, tokenIndex
+									if !_rules[ruleTypeQualifier]() {
+										goto l237
+									}
+									goto l236
+								l237:
+									position, tokenIndex = position237, tokenIndex237
+								}
+								{
+									position238, tokenIndex238 := position, tokenIndex
+									if !_rules[ruleAssignmentExpression]() {
+										goto l238
+									}
+									goto l239
+								l238:
+									position, tokenIndex = position238, tokenIndex238
+								}
+							l239:
+								if !_rules[ruleRBRK]() {
+									goto l235
+								}
+								goto l234
+							l235:
+								position, tokenIndex = position234, tokenIndex234
+								if !_rules[ruleSTATIC]() {
+									goto l240
+								}
+							l241:
+								{
+									position242, tokenIndex242 := position, tokenIndex
+									if !_rules[ruleTypeQualifier]() {
+										goto l242
+									}
+									goto l241
+								l242:
+									position, tokenIndex = position242, tokenIndex242
+								}
+								if !_rules[ruleAssignmentExpression]() {
+									goto l240
+								}
+								if !_rules[ruleRBRK]() {
+									goto l240
+								}
+								goto l234
+							l240:
+								position, tokenIndex = position234, tokenIndex234
+								if !_rules[ruleTypeQualifier]() {
+									goto l243
+								}
+							l244:
+								{
+									position245, tokenIndex245 := position, tokenIndex
+									if !_rules[ruleTypeQualifier]() {
+										goto l245
+									}
+									goto l244
+								l245:
+									position, tokenIndex = position245, tokenIndex245
+								}
+								if !_rules[ruleSTATIC]() {
+									goto l243
+								}
+								if !_rules[ruleAssignmentExpression]() {
+									goto l243
+								}
+								if !_rules[ruleRBRK]() {
+									goto l243
+								}
+								goto l234
+							l243:
+								position, tokenIndex = position234, tokenIndex234
+							l246:
+								{
+									position247, tokenIndex247 := position, tokenIndex
+									if !_rules[ruleTypeQualifier]() {
+										goto l247
+									}
+									goto l246
+								l247:
+									position, tokenIndex = position247, tokenIndex247
+								}
+								if !_rules[ruleSTAR]() {
+									goto l233
+								}
+								if !_rules[ruleRBRK]() {
+									goto l233
+								}
+							}
+						l234:
+							goto l232
+						l233:
+							position, tokenIndex = position232, tokenIndex232
+							if !_rules[ruleLPAR]() {
+								goto l231
+							}
+							{
+								position248, tokenIndex248 := position, tokenIndex
+								if !_rules[ruleParameterTypeList]() {
+									goto l249
+								}
+								if !_rules[ruleRPAR]() {
+									goto l249
+								}
+								goto l248
+							l249:
+								position, tokenIndex = position248, tokenIndex248
+								{
+									position250, tokenIndex250 := position, tokenIndex
+									{
+										position252 := position
+										if !_rules[ruleIdentifier]() {
+											goto l250
+										}
+									l253:
+										{
+											position254, tokenIndex254 := position, tokenIndex
+											if !_rules[ruleCOMMA]() {
+												goto l254
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l254
+											}
+											goto l253
+										l254:
+											position, tokenIndex = position254, tokenIndex254
+										}
+										add(ruleIdentifierList, position252)
+									}
+									goto l251
+								l250:
+									position, tokenIndex = position250, tokenIndex250
+								}
+							l251:
+								if !_rules[ruleRPAR]() {
+									goto l231
+								}
+							}
+						l248:
+						}
+					l232:
+						goto l230
+					l231:
+						position, tokenIndex = position231, tokenIndex231
+					}
+					add(ruleDirectDeclarator, position227)
+				}
+				add(ruleDeclarator, position224)
+			}
+			return true
+		l223:
+			memoize(24, position223, tokenIndex223, false)
+			position, tokenIndex = position223, tokenIndex223
+			return false
+		},
+		/* 25 DirectDeclarator <- <(((!{ p.begin = position } Identifier !{ p.declarator(identifier(buffer[p.begin:])) }) / (LPAR Declarator RPAR)) ((LBRK ((TypeQualifier* AssignmentExpression? RBRK) / (STATIC TypeQualifier* AssignmentExpression RBRK) / (TypeQualifier+ STATIC AssignmentExpression RBRK) / (TypeQualifier* STAR RBRK))) / (LPAR ((ParameterTypeList RPAR) / (IdentifierList? RPAR))))*)> */
+		nil,
+		/* 26 Pointer <- <(STAR TypeQualifier*)+> */
+		func() bool {
+			memoized, ok := memoization[memoKey{26, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position256, tokenIndex256 := position, tokenIndex
+			{
+				position257 := position
+				if !_rules[ruleSTAR]() {
+					goto l256
+				}
+			l260:
+				{
+					position261, tokenIndex261 := position, tokenIndex
+					if !_rules[ruleTypeQualifier]() {
+						goto l261
+					}
+					goto l260
+				l261:
+					position, tokenIndex = position261, tokenIndex261
+				}
+			l258:
+				{
+					position259, tokenIndex259 := position, tokenIndex
+					if !_rules[ruleSTAR]() {
+						goto l259
+					}
+				l262:
+					{
+						position263, tokenIndex263 := position, tokenIndex
+						if !_rules[ruleTypeQualifier]() {
+							goto l263
+						}
+						goto l262
+					l263:
+						position, tokenIndex = position263, tokenIndex263
+					}
+					goto l258
+				l259:
+					position, tokenIndex = position259, tokenIndex259
+				}
+				add(rulePointer, position257)
+			}
+			memoize(26, position256, tokenIndex256, true)
+			return true
+		l256:
+			memoize(26, position256, tokenIndex256, false)
+			position, tokenIndex = position256, tokenIndex256
+			return false
+		},
+		/* 27 ParameterTypeList <- <(ParameterList (COMMA ELLIPSIS)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{27, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position264, tokenIndex264 := position, tokenIndex
+			{
+				position265 := position
+				{
+					position266 := position
+					if !_rules[ruleParameterDeclaration]() {
+						goto l264
+					}
+				l267:
+					{
+						position268, tokenIndex268 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l268
+						}
+						if !_rules[ruleParameterDeclaration]() {
+							goto l268
+						}
+						goto l267
+					l268:
+						position, tokenIndex = position268, tokenIndex268
+					}
+					add(ruleParameterList, position266)
+				}
+				{
+					position269, tokenIndex269 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l269
+					}
+					{
+						position271 := position
+						if buffer[position] != rune('.') {
+							goto l269
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l269
+						}
+						position++
+						if buffer[position] != rune('.') {
+							goto l269
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l269
+						}
+						add(ruleELLIPSIS, position271)
+					}
+					goto l270
+				l269:
+					position, tokenIndex = position269, tokenIndex269
+				}
+			l270:
+				add(ruleParameterTypeList, position265)
+			}
+			memoize(27, position264, tokenIndex264, true)
+			return true
+		l264:
+			memoize(27, position264, tokenIndex264, false)
+			position, tokenIndex = position264, tokenIndex264
+			return false
+		},
+		/* 28 ParameterList <- <(ParameterDeclaration (COMMA ParameterDeclaration)*)> */
+		nil,
+		/* 29 ParameterDeclaration <- <(DeclarationSpecifiers (Declarator / AbstractDeclarator)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{29, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position273, tokenIndex273 := position, tokenIndex
+			{
+				position274 := position
+				if !_rules[ruleDeclarationSpecifiers]() {
+					goto l273
+				}
+				{
+					position275, tokenIndex275 := position, tokenIndex
+					{
+						position277, tokenIndex277 := position, tokenIndex
+						if !_rules[ruleDeclarator]() {
+							goto l278
+						}
+						goto l277
+					l278:
+						position, tokenIndex = position277, tokenIndex277
+						if !_rules[ruleAbstractDeclarator]() {
+							goto l275
+						}
+					}
+				l277:
+					goto l276
+				l275:
+					position, tokenIndex = position275, tokenIndex275
+				}
+			l276:
+				add(ruleParameterDeclaration, position274)
+			}
+			memoize(29, position273, tokenIndex273, true)
+			return true
+		l273:
+			memoize(29, position273, tokenIndex273, false)
+			position, tokenIndex = position273, tokenIndex273
+			return false
+		},
+		/* 30 IdentifierList <- <(Identifier (COMMA Identifier)*)> */
+		nil,
+		/* 31 TypeName <- <(SpecifierQualifierList AbstractDeclarator?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{31, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position280, tokenIndex280 := position, tokenIndex
+			{
+				position281 := position
+				if !_rules[ruleSpecifierQualifierList]() {
+					goto l280
+				}
+				{
+					position282, tokenIndex282 := position, tokenIndex
+					if !_rules[ruleAbstractDeclarator]() {
+						goto l282
+					}
+					goto l283
+				l282:
+					position, tokenIndex = position282, tokenIndex282
+				}
+			l283:
+				add(ruleTypeName, position281)
+			}
+			memoize(31, position280, tokenIndex280, true)
+			return true
+		l280:
+			memoize(31, position280, tokenIndex280, false)
+			position, tokenIndex = position280, tokenIndex280
+			return false
+		},
+		/* 32 AbstractDeclarator <- <((Pointer? DirectAbstractDeclarator) / Pointer)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{32, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position284, tokenIndex284 := position, tokenIndex
+			{
+				position285 := position
+				{
+					position286, tokenIndex286 := position, tokenIndex
+					{
+						position288, tokenIndex288 := position, tokenIndex
+						if !_rules[rulePointer]() {
+							goto l288
+						}
+						goto l289
+					l288:
+						position, tokenIndex = position288, tokenIndex288
+					}
+				l289:
+					{
+						position290 := position
+						{
+							switch buffer[position] {
+							case '[':
+								if !_rules[ruleLBRK]() {
+									goto l287
+								}
+								{
+									position292, tokenIndex292 := position, tokenIndex
+									{
+										position294, tokenIndex294 := position, tokenIndex
+										if !_rules[ruleAssignmentExpression]() {
+											goto l295
+										}
+										goto l294
+									l295:
+										position, tokenIndex = position294, tokenIndex294
+										if !_rules[ruleSTAR]() {
+											goto l292
+										}
+									}
+								l294:
+									goto l293
+								l292:
+									position, tokenIndex = position292, tokenIndex292
+								}
+							l293:
+								if !_rules[ruleRBRK]() {
+									goto l287
+								}
+							default:
+								if !_rules[ruleLPAR]() {
+									goto l287
+								}
+								{
+									position296, tokenIndex296 := position, tokenIndex
+									if !_rules[ruleAbstractDeclarator]() {
+										goto l297
+									}
+									if !_rules[ruleRPAR]() {
+										goto l297
+									}
+									goto l296
+								l297:
+									position, tokenIndex = position296, tokenIndex296
+									{
+										position298, tokenIndex298 := position, tokenIndex
+										if !_rules[ruleParameterTypeList]() {
+											goto l298
+										}
+										goto l299
+									l298:
+										position, tokenIndex = position298, tokenIndex298
+									}
+								l299:
+									if !_rules[ruleRPAR]() {
+										goto l287
+									}
+								}
+							l296:
+								break
+							}
+						}
+
+					l300:
+						{
+							position301, tokenIndex301 := position, tokenIndex
+							{
+								position302, tokenIndex302 := position, tokenIndex
+								if !_rules[ruleLBRK]() {
+									goto l303
+								}
+								{
+									position304, tokenIndex304 := position, tokenIndex
+									{
+										position306, tokenIndex306 := position, tokenIndex
+										if !_rules[ruleAssignmentExpression]() {
+											goto l307
+										}
+										goto l306
+									l307:
+										position, tokenIndex = position306, tokenIndex306
+										if !_rules[ruleSTAR]() {
+											goto l304
+										}
+									}
+								l306:
+									goto l305
+								l304:
+									position, tokenIndex = position304, tokenIndex304
+								}
+							l305:
+								if !_rules[ruleRBRK]() {
+									goto l303
+								}
+								goto l302
+							l303:
+								position, tokenIndex = position302, tokenIndex302
+								if !_rules[ruleLPAR]() {
+									goto l301
+								}
+								{
+									position308, tokenIndex308 := position, tokenIndex
+									if !_rules[ruleParameterTypeList]() {
+										goto l308
+									}
+									goto l309
+								l308:
+									position, tokenIndex = position308, tokenIndex308
+								}
+							l309:
+								if !_rules[ruleRPAR]() {
+									goto l301
+								}
+							}
+						l302:
+							goto l300
+						l301:
+							position, tokenIndex = position301, tokenIndex301
+						}
+						add(ruleDirectAbstractDeclarator, position290)
+					}
+					goto l286
+				l287:
+					position, tokenIndex = position286, tokenIndex286
+					if !_rules[rulePointer]() {
+						goto l284
+					}
+				}
+			l286:
+				add(ruleAbstractDeclarator, position285)
+			}
+			memoize(32, position284, tokenIndex284, true)
+			return true
+		l284:
+			memoize(32, position284, tokenIndex284, false)
+			position, tokenIndex = position284, tokenIndex284
+			return false
+		},
+		/* 33 DirectAbstractDeclarator <- <(((&('[') %fail('(') (LBRK (AssignmentExpression / STAR)? RBRK)) | (&('(') %fail('[') (LPAR ((AbstractDeclarator RPAR) / (ParameterTypeList? RPAR))))) ((LBRK (AssignmentExpression / STAR)? RBRK) / (LPAR ParameterTypeList? RPAR))*)> */
+		nil,
+		/* 34 TypedefName <- <(!{ p.begin = position } Identifier &{ p.isTypedef(identifier(buffer[p.begin:])) })> */
+		func() bool {
+			memoized, ok := memoization[memoKey{34, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position311, tokenIndex311 := position, tokenIndex
+			{
+				position312 := position
+				p.begin = position
+				if !_rules[ruleIdentifier]() {
+					goto l311
+				}
+				if !(p.isTypedef(identifier(buffer[p.begin:]))) {
+					goto l311
+				}
+				add(ruleTypedefName, position312)
+			}
+			memoize(34, position311, tokenIndex311, true)
+			return true
+		l311:
+			memoize(34, position311, tokenIndex311, false)
+			position, tokenIndex = position311, tokenIndex311
+			return false
+		},
+		/* 35 Initializer <- <(AssignmentExpression / (LWING InitializerList COMMA? RWING))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{35, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position313, tokenIndex313 := position, tokenIndex
+			{
+				position314 := position
+				{
+					position315, tokenIndex315 := position, tokenIndex
+					if !_rules[ruleAssignmentExpression]() {
+						goto l316
+					}
+					goto l315
+				l316:
+					position, tokenIndex = position315, tokenIndex315
+					if !_rules[ruleLWING]() {
+						goto l313
+					}
+					if !_rules[ruleInitializerList]() {
+						goto l313
+					}
+					{
+						position317, tokenIndex317 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l317
+						}
+						goto l318
+					l317:
+						position, tokenIndex = position317, tokenIndex317
+					}
+				l318:
+					if !_rules[ruleRWING]() {
+						goto l313
+					}
+				}
+			l315:
+				add(ruleInitializer, position314)
+			}
+			memoize(35, position313, tokenIndex313, true)
+			return true
+		l313:
+			memoize(35, position313, tokenIndex313, false)
+			position, tokenIndex = position313, tokenIndex313
+			return false
+		},
+		/* 36 InitializerList <- <(Designation? Initializer (COMMA Designation? Initializer)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{36, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position319, tokenIndex319 := position, tokenIndex
+			{
+				position320 := position
+				{
+					position321, tokenIndex321 := position, tokenIndex
+					if !_rules[ruleDesignation]() {
+						goto l321
+					}
+					goto l322
+				l321:
+					position, tokenIndex = position321, tokenIndex321
+				}
+			l322:
+				if !_rules[ruleInitializer]() {
+					goto l319
+				}
+			l323:
+				{
+					position324, tokenIndex324 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l324
+					}
+					{
+						position325, tokenIndex325 := position, tokenIndex
+						if !_rules[ruleDesignation]() {
+							goto l325
+						}
+						goto l326
+					l325:
+						position, tokenIndex = position325, tokenIndex325
+					}
+				l326:
+					if !_rules[ruleInitializer]() {
+						goto l324
+					}
+					goto l323
+				l324:
+					position, tokenIndex = position324, tokenIndex324
+				}
+				add(ruleInitializerList, position320)
+			}
+			memoize(36, position319, tokenIndex319, true)
+			return true
+		l319:
+			memoize(36, position319, tokenIndex319, false)
+			position, tokenIndex = position319, tokenIndex319
+			return false
+		},
+		/* 37 Designation <- <(Designator+ EQU)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{37, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position327, tokenIndex327 := position, tokenIndex
+			{
+				position328 := position
+				{
+					position331 := position
+					{
+						position332, tokenIndex332 := position, tokenIndex
+						if !_rules[ruleLBRK]() {
+							goto l333
+						}
+						if !_rules[ruleConstantExpression]() {
+							goto l333
+						}
+						if !_rules[ruleRBRK]() {
+							goto l333
+						}
+						goto l332
+					l333:
+						position, tokenIndex = position332, tokenIndex332
+						if !_rules[ruleDOT]() {
+							goto l327
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l327
+						}
+					}
+				l332:
+					add(ruleDesignator, position331)
+				}
+			l329:
+				{
+					position330, tokenIndex330 := position, tokenIndex
+					{
+						position334 := position
+						{
+							position335, tokenIndex335 := position, tokenIndex
+							if !_rules[ruleLBRK]() {
+								goto l336
+							}
+							if !_rules[ruleConstantExpression]() {
+								goto l336
+							}
+							if !_rules[ruleRBRK]() {
+								goto l336
+							}
+							goto l335
+						l336:
+							position, tokenIndex = position335, tokenIndex335
+							if !_rules[ruleDOT]() {
+								goto l330
+							}
+							if !_rules[ruleIdentifier]() {
+								goto l330
+							}
+						}
+					l335:
+						add(ruleDesignator, position334)
+					}
+					goto l329
+				l330:
+					position, tokenIndex = position330, tokenIndex330
+				}
+				if !_rules[ruleEQU]() {
+					goto l327
+				}
+				add(ruleDesignation, position328)
+			}
+			memoize(37, position327, tokenIndex327, true)
+			return true
+		l327:
+			memoize(37, position327, tokenIndex327, false)
+			position, tokenIndex = position327, tokenIndex327
+			return false
+		},
+		/* 38 Designator <- <((LBRK ConstantExpression RBRK) / (DOT Identifier))> */
+		nil,
+		/* 39 Statement <- <(LabeledStatement / ExpressionStatement / ((&('d' | 'f' | 'w') IterationStatement) | (&('i' | 's') SelectionStatement) | (&('{') CompoundStatement) | (&('b' | 'c' | 'g' | 'r') %fail('do' 'for' 'while' 'if' 'switch' '{') JumpStatement)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{39, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position338, tokenIndex338 := position, tokenIndex
+			{
+				position339 := position
+				{
+					position340, tokenIndex340 := position, tokenIndex
+					{
+						position342 := position
+						{
+							switch buffer[position] {
+							case 'c':
+								{
+									position344, tokenIndex344 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l345
+									}
+									if !_rules[ruleCOLON]() {
+										goto l345
+									}
+									if !_rules[ruleStatement]() {
+										goto l345
+									}
+									goto l344
+								l345:
+									position, tokenIndex = position344, tokenIndex344
+									{
+										position346 := position
+										if buffer[position] != rune('c') {
+											goto l341
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l341
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l341
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l341
+										}
+										position++
+										{
+											position347, tokenIndex347 := position, tokenIndex
+											if !_rules[ruleIdChar]() {
+												goto l347
+											}
+											goto l341
+										l347:
+											position, tokenIndex = position347, tokenIndex347
+										}
+										if !_rules[ruleSpacing]() {
+											goto l341
+										}
+										add(ruleCASE, position346)
+									}
+									if !_rules[ruleConstantExpression]() {
+										goto l341
+									}
+									if !_rules[ruleCOLON]() {
+										goto l341
+									}
+									if !_rules[ruleStatement]() {
+										goto l341
+									}
+								}
+							l344:
+								break
+							case 'd':
+								{
+									position348, tokenIndex348 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l349
+									}
+									if !_rules[ruleCOLON]() {
+										goto l349
+									}
+									if !_rules[ruleStatement]() {
+										goto l349
+									}
+									goto l348
+								l349:
+									position, tokenIndex = position348, tokenIndex348
+									if !_rules[ruleDEFAULT]() {
+										goto l341
+									}
+									if !_rules[ruleCOLON]() {
+										goto l341
+									}
+									if !_rules[ruleStatement]() {
+										goto l341
+									}
+								}
+							l348:
+								break
+							default:
+								if !_rules[ruleIdentifier]() {
+									goto l341
+								}
+								if !_rules[ruleCOLON]() {
+									goto l341
+								}
+								if !_rules[ruleStatement]() {
+									goto l341
+								}
+							}
+						}
+
+						add(ruleLabeledStatement, position342)
+					}
+					goto l340
+				l341:
+					position, tokenIndex = position340, tokenIndex340
+					{
+						position351 := position
+						{
+							position352, tokenIndex352 := position, tokenIndex
+							if !_rules[ruleExpression]() {
+								goto l352
+							}
+							goto l353
+						l352:
+							position, tokenIndex = position352, tokenIndex352
+						}
+					l353:
+						if !_rules[ruleSEMI]() {
+							goto l350
+						}
+						add(ruleExpressionStatement, position351)
+					}
+					goto l340
+				l350:
+					position, tokenIndex = position340, tokenIndex340
+					{
+						switch buffer[position] {
+						case 'd', 'f', 'w':
+							{
+								position355 := position
+								{
+									switch buffer[position] {
+									case 'd':
+										{
+											position357 := position
+											position++
+											if buffer[position] != rune('o') {
+												goto l338
+											}
+											position++
+											{
+												position358, tokenIndex358 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l358
+												}
+												goto l338
+											l358:
+												position, tokenIndex = position358, tokenIndex358
+											}
+											if !_rules[ruleSpacing]() {
+												goto l338
+											}
+											add(ruleDO, position357)
+										}
+										if !_rules[ruleStatement]() {
+											goto l338
+										}
+										if !_rules[ruleWHILE]() {
+											goto l338
+										}
+										if !_rules[ruleLPAR]() {
+											goto l338
+										}
+										if !_rules[ruleExpression]() {
+											goto l338
+										}
+										if !_rules[ruleRPAR]() {
+											goto l338
+										}
+										if !_rules[ruleSEMI]() {
+											goto l338
+										}
+									case 'f':
+										{
+											position359 := position
+											position++
+											if buffer[position] != rune('o') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l338
+											}
+											position++
+											{
+												position360, tokenIndex360 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l360
+												}
+												goto l338
+											l360:
+												position, tokenIndex = position360, tokenIndex360
+											}
+											if !_rules[ruleSpacing]() {
+												goto l338
+											}
+											add(ruleFOR, position359)
+										}
+										if !_rules[ruleLPAR]() {
+											goto l338
+										}
+										{
+											position361, tokenIndex361 := position, tokenIndex
+											{
+												position363, tokenIndex363 := position, tokenIndex
+												if !_rules[ruleExpression]() {
+													goto l363
+												}
+												goto l364
+											l363:
+												position, tokenIndex = position363, tokenIndex363
+											}
+										l364:
+											if !_rules[ruleSEMI]() {
+												goto l362
+											}
+											{
+												position365, tokenIndex365 := position, tokenIndex
+												if !_rules[ruleExpression]() {
+													goto l365
+												}
+												goto l366
+											l365:
+												position, tokenIndex = position365, tokenIndex365
+											}
+										l366:
+											if !_rules[ruleSEMI]() {
+												goto l362
+											}
+											{
+												position367, tokenIndex367 := position, tokenIndex
+												if !_rules[ruleExpression]() {
+													goto l367
+												}
+												goto l368
+											l367:
+												position, tokenIndex = position367, tokenIndex367
+											}
+										l368:
+											if !_rules[ruleRPAR]() {
+												goto l362
+											}
+											if !_rules[ruleStatement]() {
+												goto l362
+											}
+											goto l361
+										l362:
+											position, tokenIndex = position361, tokenIndex361
+											if !_rules[ruleDeclaration]() {
+												goto l338
+											}
+											{
+												position369, tokenIndex369 := position, tokenIndex
+												if !_rules[ruleExpression]() {
+													goto l369
+												}
+												goto l370
+											l369:
+												position, tokenIndex = position369, tokenIndex369
+											}
+										l370:
+											if !_rules[ruleSEMI]() {
+												goto l338
+											}
+											{
+												position371, tokenIndex371 := position, tokenIndex
+												if !_rules[ruleExpression]() {
+													goto l371
+												}
+												goto l372
+											l371:
+												position, tokenIndex = position371, tokenIndex371
+											}
+										l372:
+											if !_rules[ruleRPAR]() {
+												goto l338
+											}
+											if !_rules[ruleStatement]() {
+												goto l338
+											}
+										}
+									l361:
+										break
+									default:
+										if !_rules[ruleWHILE]() {
+											goto l338
+										}
+										if !_rules[ruleLPAR]() {
+											goto l338
+										}
+										if !_rules[ruleExpression]() {
+											goto l338
+										}
+										if !_rules[ruleRPAR]() {
+											goto l338
+										}
+										if !_rules[ruleStatement]() {
+											goto l338
+										}
+									}
+								}
+
+								add(ruleIterationStatement, position355)
+							}
+						case 'i', 's':
+							{
+								position373 := position
+								{
+									position374, tokenIndex374 := position, tokenIndex
+									{
+										position376 := position
+										if buffer[position] != rune('i') {
+											goto l375
+										}
+										position++
+										if buffer[position] != rune('f') {
+											goto l375
+										}
+										position++
+										{
+											position377, tokenIndex377 := position, tokenIndex
+											if !_rules[ruleIdChar]() {
+												goto l377
+											}
+											goto l375
+										l377:
+											position, tokenIndex = position377, tokenIndex377
+										}
+										if !_rules[ruleSpacing]() {
+											goto l375
+										}
+										add(ruleIF, position376)
+									}
+									if !_rules[ruleLPAR]() {
+										goto l375
+									}
+									if !_rules[ruleExpression]() {
+										goto l375
+									}
+									if !_rules[ruleRPAR]() {
+										goto l375
+									}
+									if !_rules[ruleStatement]() {
+										goto l375
+									}
+									{
+										position378, tokenIndex378 := position, tokenIndex
+										{
+											position380 := position
+											if buffer[position] != rune('e') {
+												goto l378
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l378
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l378
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l378
+											}
+											position++
+											{
+												position381, tokenIndex381 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l381
+												}
+												goto l378
+											l381:
+												position, tokenIndex = position381, tokenIndex381
+											}
+											if !_rules[ruleSpacing]() {
+												goto l378
+											}
+											add(ruleELSE, position380)
+										}
+										if !_rules[ruleStatement]() {
+											goto l378
+										}
+										goto l379
+									l378:
+										position, tokenIndex = position378, tokenIndex378
+									}
+								l379:
+									goto l374
+								l375:
+									position, tokenIndex = position374, tokenIndex374
+									{
+										position382 := position
+										if buffer[position] != rune('s') {
+											goto l338
+										}
+										position++
+										if buffer[position] != rune('w') {
+											goto l338
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l338
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l338
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l338
+										}
+										position++
+										if buffer[position] != rune('h') {
+											goto l338
+										}
+										position++
+										{
+											position383, tokenIndex383 := position, tokenIndex
+											if !_rules[ruleIdChar]() {
+												goto l383
+											}
+											goto l338
+										l383:
+											position, tokenIndex = position383, tokenIndex383
+										}
+										if !_rules[ruleSpacing]() {
+											goto l338
+										}
+										add(ruleSWITCH, position382)
+									}
+									if !_rules[ruleLPAR]() {
+										goto l338
+									}
+									if !_rules[ruleExpression]() {
+										goto l338
+									}
+									if !_rules[ruleRPAR]() {
+										goto l338
+									}
+									if !_rules[ruleStatement]() {
+										goto l338
+									}
+								}
+							l374:
+								add(ruleSelectionStatement, position373)
+							}
+						case '{':
+							if !_rules[ruleCompoundStatement]() {
+								goto l338
+							}
+						default:
+							{
+								position384 := position
+								{
+									switch buffer[position] {
+									case 'b':
+										{
+											position386 := position
+											position++
+											if buffer[position] != rune('r') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('a') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('k') {
+												goto l338
+											}
+											position++
+											{
+												position387, tokenIndex387 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l387
+												}
+												goto l338
+											l387:
+												position, tokenIndex = position387, tokenIndex387
+											}
+											if !_rules[ruleSpacing]() {
+												goto l338
+											}
+											add(ruleBREAK, position386)
+										}
+										if !_rules[ruleSEMI]() {
+											goto l338
+										}
+									case 'c':
+										{
+											position388 := position
+											position++
+											if buffer[position] != rune('o') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l338
+											}
+											position++
+											{
+												position389, tokenIndex389 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l389
+												}
+												goto l338
+											l389:
+												position, tokenIndex = position389, tokenIndex389
+											}
+											if !_rules[ruleSpacing]() {
+												goto l338
+											}
+											add(ruleCONTINUE, position388)
+										}
+										if !_rules[ruleSEMI]() {
+											goto l338
+										}
+									case 'r':
+										{
+											position390 := position
+											position++
+											if buffer[position] != rune('e') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l338
+											}
+											position++
+											{
+												position391, tokenIndex391 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l391
+												}
+												goto l338
+											l391:
+												position, tokenIndex = position391, tokenIndex391
+											}
+											if !_rules[ruleSpacing]() {
+												goto l338
+											}
+											add(ruleRETURN, position390)
+										}
+										{
+											position392, tokenIndex392 := position, tokenIndex
+											if !_rules[ruleExpression]() {
+												goto l392
+											}
+											goto l393
+										l392:
+											position, tokenIndex = position392, tokenIndex392
+										}
+									l393:
+										if !_rules[ruleSEMI]() {
+											goto l338
+										}
+									default:
+										{
+											position394 := position
+											if buffer[position] != rune('g') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l338
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l338
+											}
+											position++
+											{
+												position395, tokenIndex395 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l395
+												}
+												goto l338
+											l395:
+												position, tokenIndex = position395, tokenIndex395
+											}
+											if !_rules[ruleSpacing]() {
+												goto l338
+											}
+											add(ruleGOTO, position394)
+										}
+										if !_rules[ruleIdentifier]() {
+											goto l338
+										}
+										if !_rules[ruleSEMI]() {
+											goto l338
+										}
+									}
+								}
+
+								add(ruleJumpStatement, position384)
+							}
+						}
+					}
+
+				}
+			l340:
+				add(ruleStatement, position339)
+			}
+			memoize(39, position338, tokenIndex338, true)
+			return true
+		l338:
+			memoize(39, position338, tokenIndex338, false)
+			position, tokenIndex = position338, tokenIndex338
+			return false
+		},
+		/* 40 LabeledStatement <- <((&('c') %fail('default') ((Identifier COLON Statement) / (CASE ConstantExpression COLON Statement))) | (&('d') %fail('case') ((Identifier COLON Statement) / (DEFAULT COLON Statement))) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '\\' | '_' | 'a' | 'b' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('case' 'default') (Identifier COLON Statement)))> */
+		nil,
+		/* 41 CompoundStatement <- <(LWING (Declaration / Statement)* RWING)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{41, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position397, tokenIndex397 := position, tokenIndex
+			{
+				position398 := position
+				if !_rules[ruleLWING]() {
+					goto l397
+				}
+			l399:
+				{
+					position400, tokenIndex400 := position, tokenIndex
+					{
+						position401, tokenIndex401 := position, tokenIndex
+						if !_rules[ruleDeclaration]() {
+							goto l402
+						}
+						goto l401
+					l402:
+						position, tokenIndex = position401, tokenIndex401
+						if !_rules[ruleStatement]() {
+							goto l400
+						}
+					}
+				l401:
+					goto l399
+				l400:
+					position, tokenIndex = position400, tokenIndex400
+				}
+				if !_rules[ruleRWING]() {
+					goto l397
+				}
+				add(ruleCompoundStatement, position398)
+			}
+			memoize(41, position397, tokenIndex397, true)
+			return true
+		l397:
+			memoize(41, position397, tokenIndex397, false)
+			position, tokenIndex = position397, tokenIndex397
+			return false
+		},
+		/* 42 ExpressionStatement <- <(Expression? SEMI)> */
+		nil,
+		/* 43 SelectionStatement <- <((IF LPAR Expression RPAR Statement (ELSE Statement)?) / (SWITCH LPAR Expression RPAR Statement))> */
+		nil,
+		/* 44 IterationStatement <- <((&('d') (DO Statement WHILE LPAR Expression RPAR SEMI)) | (&('f') (FOR LPAR ((Expression? SEMI Expression? SEMI Expression? RPAR Statement) / (Declaration Expression? SEMI Expression? RPAR Statement)))) | (&('w') %fail('do' 'for') (WHILE LPAR Expression RPAR Statement)))> */
+		nil,
+		/* 45 JumpStatement <- <((&('b') (BREAK SEMI)) | (&('c') (CONTINUE SEMI)) | (&('r') (RETURN Expression? SEMI)) | (&('g') %fail('break' 'continue' 'return') (GOTO Identifier SEMI)))> */
+		nil,
+		/* 46 PrimaryExpression <- <(StringLiteral / Constant / Identifier / (LPAR Expression RPAR) / GenericSelection)> */
+		nil,
+		/* 47 GenericSelection <- <(GENERIC LPAR AssignmentExpression COMMA GenericAssocList RPAR)> */
+		nil,
+		/* 48 GenericAssocList <- <(GenericAssociation (COMMA GenericAssociation)*)> */
+		nil,
+		/* 49 GenericAssociation <- <((TypeName / DEFAULT) COLON AssignmentExpression)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{49, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position410, tokenIndex410 := position, tokenIndex
+			{
+				position411 := position
+				{
+					position412, tokenIndex412 := position, tokenIndex
+					if !_rules[ruleTypeName]() {
+						goto l413
+					}
+					goto l412
+				l413:
+					position, tokenIndex = position412, tokenIndex412
+					if !_rules[ruleDEFAULT]() {
+						goto l410
+					}
+				}
+			l412:
+				if !_rules[ruleCOLON]() {
+					goto l410
+				}
+				if !_rules[ruleAssignmentExpression]() {
+					goto l410
+				}
+				add(ruleGenericAssociation, position411)
+			}
+			memoize(49, position410, tokenIndex410, true)
+			return true
+		l410:
+			memoize(49, position410, tokenIndex410, false)
+			position, tokenIndex = position410, tokenIndex410
+			return false
+		},
+		/* 50 PostfixExpression <- <((PrimaryExpression / (LPAR TypeName RPAR LWING InitializerList COMMA? RWING)) ((&('+') %fail('[' '(' '.' '->' '--') INC) | (&('-') %fail('[' '(' '.' '++') ((PTR Identifier) / DEC)) | (&('.') %fail('[' '(' '->' '++' '--') (DOT Identifier)) | (&('[') %fail('(' '.' '->' '++' '--') (LBRK Expression RBRK)) | (&('(') %fail('[' '.' '->' '++' '--') (LPAR ArgumentExpressionList? RPAR)))*)> */
+		nil,
+		/* 51 ArgumentExpressionList <- <(AssignmentExpression (COMMA AssignmentExpression)*)> */
+		nil,
+		/* 52 UnaryExpression <- <(PostfixExpression / (INC UnaryExpression) / (DEC UnaryExpression) / ((&('_') (ALIGNOF LPAR TypeName RPAR)) | (&('s') (SIZEOF (UnaryExpression / (LPAR TypeName RPAR)))) | (&('!' | '&' | '*' | '+' | '-' | '~') %fail('_Alignof' 'sizeof') (UnaryOperator CastExpression))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{52, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position416, tokenIndex416 := position, tokenIndex
+			{
+				position417 := position
+				{
+					position418, tokenIndex418 := position, tokenIndex
+					{
+						position420 := position
+						{
+							position421, tokenIndex421 := position, tokenIndex
+							{
+								position423 := position
+								{
+									position424, tokenIndex424 := position, tokenIndex
+									if !_rules[ruleStringLiteral]() {
+										goto l425
+									}
+									goto l424
+								l425:
+									position, tokenIndex = position424, tokenIndex424
+									{
+										position427 := position
+										{
+											position428, tokenIndex428 := position, tokenIndex
+											{
+												position430 := position
+												{
+													position431, tokenIndex431 := position, tokenIndex
+													{
+														position433 := position
+														{
+															position434, tokenIndex434 := position, tokenIndex
+															{
+																position436 := position
+																{
+																	position437, tokenIndex437 := position, tokenIndex
+																l439:
+																	{
+																		position440, tokenIndex440 := position, tokenIndex
+																		if c := buffer[position]; c < rune('0') || c > rune('9') {
+																			goto l440
+																		}
+																		position++
+																		goto l439
+																	l440:
+																		position, tokenIndex = position440, tokenIndex440
+																	}
+																	if buffer[position] != rune('.') {
+																		goto l438
+																	}
+																	position++
+																	if c := buffer[position]; c < rune('0') || c > rune('9') {
+																		goto l438
+																	}
+																	position++
+																l441:
+																	{
+																		position442, tokenIndex442 := position, tokenIndex
+																		if c := buffer[position]; c < rune('0') || c > rune('9') {
+																			goto l442
+																		}
+																		position++
+																		goto l441
+																	l442:
+																		position, tokenIndex = position442, tokenIndex442
+																	}
+																	goto l437
+																l438:
+																	position, tokenIndex = position437, tokenIndex437
+																	if c := buffer[position]; c < rune('0') || c > rune('9') {
+																		goto l435
+																	}
+																	position++
+																l443:
+																	{
+																		position444, tokenIndex444 := position, tokenIndex
+																		if c := buffer[position]; c < rune('0') || c > rune('9') {
+																			goto l444
+																		}
+																		position++
+																		goto l443
+																	l444:
+																		position, tokenIndex = position444, tokenIndex444
+																	}
+																	if buffer[position] != rune('.') {
+																		goto l435
+																	}
+																	position++
+																}
+															l437:
+																add(ruleFraction, position436)
+															}
+															{
+																position445, tokenIndex445 := position, tokenIndex
+																if !_rules[ruleExponent]() {
+																	goto l445
+																}
+																goto l446
+															l445:
+																position, tokenIndex = position445, tokenIndex445
+															}
+														l446:
+															goto l434
+														l435:
+															position, tokenIndex = position434, tokenIndex434
+															if c := buffer[position]; c < rune('0') || c > rune('9') {
+																goto l432
+															}
+															position++
+														l447:
+															{
+																position448, tokenIndex448 := position, tokenIndex
+																if c := buffer[position]; c < rune('0') || c > rune('9') {
+																	goto l448
+																}
+																position++
+																goto l447
+															l448:
+																position, tokenIndex = position448, tokenIndex448
+															}
+															if !_rules[ruleExponent]() {
+																goto l432
+															}
+														}
+													l434:
+														add(ruleDecimalFloatConstant, position433)
+													}
+													goto l431
+												l432:
+													position, tokenIndex = position431, tokenIndex431
+													{
+														position449 := position
+														if !_rules[ruleHexPrefix]() {
+															goto l429
+														}
+														{
+															position450, tokenIndex450 := position, tokenIndex
+															{
+																position452 := position
+																{
+																	position453, tokenIndex453 := position, tokenIndex
+																l455:
+																	{
+																		position456, tokenIndex456 := position, tokenIndex
+																		if !_rules[ruleHexDigit]() {
+																			goto l456
+																		}
+																		goto l455
+																	l456:
+																		position, tokenIndex = position456, tokenIndex456
+																	}
+																	if buffer[position] != rune('.') {
+																		goto l454
+																	}
+																	position++
+																	if !_rules[ruleHexDigit]() {
+																		goto l454
+																	}
+																l457:
+																	{
+																		position458, tokenIndex458 := position, tokenIndex
+																		if !_rules[ruleHexDigit]() {
+																			goto l458
+																		}
+																		goto l457
+																	l458:
+																		position, tokenIndex = position458, tokenIndex458
+																	}
+																	goto l453
+																l454:
+																	position, tokenIndex = position453, tokenIndex453
+																	if !_rules[ruleHexDigit]() {
+																		goto l451
+																	}
+																l459:
+																	{
+																		position460, tokenIndex460 := position, tokenIndex
+																		if !_rules[ruleHexDigit]() {
+																			goto l460
+																		}
+																		goto l459
+																	l460:
+																		position, tokenIndex = position460, tokenIndex460
+																	}
+																	if buffer[position] != rune('.') {
+																		goto l451
+																	}
+																	position++
+																}
+															l453:
+																add(ruleHexFraction, position452)
+															}
+															{
+																position461, tokenIndex461 := position, tokenIndex
+																if !_rules[ruleBinaryExponent]() {
+																	goto l461
+																}
+																goto l462
+															l461:
+																position, tokenIndex = position461, tokenIndex461
+															}
+														l462:
+															goto l450
+														l451:
+															position, tokenIndex = position450, tokenIndex450
+															if !_rules[ruleHexDigit]() {
+																goto l429
+															}
+														l463:
+															{
+																position464, tokenIndex464 := position, tokenIndex
+																if !_rules[ruleHexDigit]() {
+																	goto l464
+																}
+																goto l463
+															l464:
+																position, tokenIndex = position464, tokenIndex464
+															}
+															if !_rules[ruleBinaryExponent]() {
+																goto l429
+															}
+														}
+													l450:
+														add(ruleHexFloatConstant, position449)
+													}
+												}
+											l431:
+												{
+													position465, tokenIndex465 := position, tokenIndex
+													{
+														position467 := position
+														{
+															switch buffer[position] {
+															case 'F':
+																position++
+															case 'L':
+																position++
+															case 'l':
+																position++
+															default:
+																if buffer[position] != rune('f') {
+																	goto l465
+																}
+																position++
+															}
+														}
+
+														add(ruleFloatSuffix, position467)
+													}
+													goto l466
+												l465:
+													position, tokenIndex = position465, tokenIndex465
+												}
+											l466:
+												if !_rules[ruleSpacing]() {
+													goto l429
+												}
+												add(ruleFloatConstant, position430)
+											}
+											goto l428
+										l429:
+											position, tokenIndex = position428, tokenIndex428
+											{
+												position470 := position
+												{
+													switch buffer[position] {
+													case '0':
+														{
+															position472, tokenIndex472 := position, tokenIndex
+															{
+																position474 := position
+																if !_rules[ruleHexPrefix]() {
+																	goto l473
+																}
+																if !_rules[ruleHexDigit]() {
+																	goto l473
+																}
+															l475:
+																{
+																	position476, tokenIndex476 := position, tokenIndex
+																	if !_rules[ruleHexDigit]() {
+																		goto l476
+																	}
+																	goto l475
+																l476:
+																	position, tokenIndex = position476, tokenIndex476
+																}
+																add(ruleHexConstant, position474)
+															}
+															goto l472
+														l473:
+															position, tokenIndex = position472, tokenIndex472
+															{
+																position477 := position
+																if buffer[position] != rune('0') {
+																	goto l469
+																}
+																position++
+															l478:
+																{
+																	position479, tokenIndex479 := position, tokenIndex
+																	if c := buffer[position]; c < rune('0') || c > rune('7') {
+																		goto l479
+																	}
+																	position++
+																	goto l478
+																l479:
+																	position, tokenIndex = position479, tokenIndex479
+																}
+																add(ruleOctalConstant, position477)
+															}
+														}
+													l472:
+														break
+													default:
+														{
+															position480 := position
+															if c := buffer[position]; c < rune('1') || c > rune('9') {
+																goto l469
+															}
+															position++
+														l481:
+															{
+																position482, tokenIndex482 := position, tokenIndex
+																if c := buffer[position]; c < rune('0') || c > rune('9') {
+																	goto l482
+																}
+																position++
+																goto l481
+															l482:
+																position, tokenIndex = position482, tokenIndex482
+															}
+															add(ruleDecimalConstant, position480)
+														}
+													}
+												}
+
+												{
+													position483, tokenIndex483 := position, tokenIndex
+													{
+														position485 := position
+														{
+															position486, tokenIndex486 := position, tokenIndex
+															{
+																position488, tokenIndex488 := position, tokenIndex
+																if buffer[position] != rune('u') {
+																	goto l489
+																}
+																position++
+																goto l488
+															l489:
+																position, tokenIndex = position488, tokenIndex488
+																if buffer[position] != rune('U') {
+																	goto l487
+																}
+																position++
+															}
+														l488:
+															{
+																position490, tokenIndex490 := position, tokenIndex
+																if !_rules[ruleLsuffix]() {
+																	goto l490
+																}
+																goto l491
+															l490:
+																position, tokenIndex = position490, tokenIndex490
+															}
+														l491:
+															goto l486
+														l487:
+															position, tokenIndex = position486, tokenIndex486
+															if !_rules[ruleLsuffix]() {
+																goto l483
+															}
+															{
+																position492, tokenIndex492 := position, tokenIndex
+																{
+																	position494, tokenIndex494 := position, tokenIndex
+																	if buffer[position] != rune('u') {
+																		goto l495
+																	}
+																	position++
+																	goto l494
+																l495:
+																	position, tokenIndex = position494, tokenIndex494
+																	if buffer[position] != rune('U') {
+																		goto l492
+																	}
+																	position++
+																}
+															l494:
+																goto l493
+															l492:
+																position, tokenIndex = position492, tokenIndex492
+															}
+														l493:
+														}
+													l486:
+														add(ruleIntegerSuffix, position485)
+													}
+													goto l484
+												l483:
+													position, tokenIndex = position483, tokenIndex483
+												}
+											l484:
+												if !_rules[ruleSpacing]() {
+													goto l469
+												}
+												add(ruleIntegerConstant, position470)
+											}
+											goto l428
+										l469:
+											position, tokenIndex = position428, tokenIndex428
+											{
+												position497 := position
+												{
+													position498, tokenIndex498 := position, tokenIndex
+													{
+														switch buffer[position] {
+														case 'U':
+															position++
+														case 'u':
+															position++
+														default:
+															if buffer[position] != rune('L') {
+																goto l498
+															}
+															position++
+														}
+													}
+
+													goto l499
+												l498:
+													position, tokenIndex = position498, tokenIndex498
+												}
+											l499:
+												if buffer[position] != rune('\'') {
+													goto l496
+												}
+												position++
+											l501:
+												{
+													position502, tokenIndex502 := position, tokenIndex
+													{
+														position503 := position
+														{
+															position504, tokenIndex504 := position, tokenIndex
+															if !_rules[ruleEscape]() {
+																goto l505
+															}
+															goto l504
+														l505:
+															position, tokenIndex = position504, tokenIndex504
+															{
+																position506, tokenIndex506 := position, tokenIndex
+																{
+																	switch buffer[position] {
+																	case '\n':
+																		position++
+																	case '\\':
+																		position++
+																	default:
+																		if buffer[position] != rune('\'') {
+																			goto l506
+																		}
+																		position++
+																	}
+																}
+
+																goto l502
+															l506:
+																position, tokenIndex = position506, tokenIndex506
+															}
+															if !matchDot() {
+																goto l502
+															}
+														}
+													l504:
+														add(ruleChar, position503)
+													}
+													goto l501
+												l502:
+													position, tokenIndex = position502, tokenIndex502
+												}
+												if buffer[position] != rune('\'') {
+													goto l496
+												}
+												position++
+												if !_rules[ruleSpacing]() {
+													goto l496
+												}
+												add(ruleCharacterConstant, position497)
+											}
+											goto l428
+										l496:
+											position, tokenIndex = position428, tokenIndex428
+											if !_rules[ruleEnumerationConstant]() {
+												goto l426
+											}
+										}
+									l428:
+										add(ruleConstant, position427)
+									}
+									goto l424
+								l426:
+									position, tokenIndex = position424, tokenIndex424
+									if !_rules[ruleIdentifier]() {
+										goto l508
+									}
+									goto l424
+								l508:
+									position, tokenIndex = position424, tokenIndex424
+									if !_rules[ruleLPAR]() {
+										goto l509
+									}
+									if !_rules[ruleExpression]() {
+										goto l509
+									}
+									if !_rules[ruleRPAR]() {
+										goto l509
+									}
+									goto l424
+								l509:
+									position, tokenIndex = position424, tokenIndex424
+									{
+										position510 := position
+										{
+											position511 := position
+											if buffer[position] != rune('_') {
+												goto l422
+											}
+											position++
+											if buffer[position] != rune('G') {
+												goto l422
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l422
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l422
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l422
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l422
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l422
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l422
+											}
+											position++
+											{
+												position512, tokenIndex512 := position, tokenIndex
+												if !_rules[ruleIdChar]() {
+													goto l512
+												}
+												goto l422
+											l512:
+												position, tokenIndex = position512, tokenIndex512
+											}
+											if !_rules[ruleSpacing]() {
+												goto l422
+											}
+											add(ruleGENERIC, position511)
+										}
+										if !_rules[ruleLPAR]() {
+											goto l422
+										}
+										if !_rules[ruleAssignmentExpression]() {
+											goto l422
+										}
+										if !_rules[ruleCOMMA]() {
+											goto l422
+										}
+										{
+											position513 := position
+											if !_rules[ruleGenericAssociation]() {
+												goto l422
+											}
+										l514:
+											{
+												position515, tokenIndex515 := position, tokenIndex
+												if !_rules[ruleCOMMA]() {
+													goto l515
+												}
+												if !_rules[ruleGenericAssociation]() {
+													goto l515
+												}
+												goto l514
+											l515:
+												position, tokenIndex = position515, tokenIndex515
+											}
+											add(ruleGenericAssocList, position513)
+										}
+										if !_rules[ruleRPAR]() {
+											goto l422
+										}
+										add(ruleGenericSelection, position510)
+									}
+								}
+							l424:
+								add(rulePrimaryExpression, position423)
+							}
+							goto l421
+						l422:
+							position, tokenIndex = position421, tokenIndex421
+							if !_rules[ruleLPAR]() {
+								goto l419
+							}
+							if !_rules[ruleTypeName]() {
+								goto l419
+							}
+							if !_rules[ruleRPAR]() {
+								goto l419
+							}
+							if !_rules[ruleLWING]() {
+								goto l419
+							}
+							if !_rules[ruleInitializerList]() {
+								goto l419
+							}
+							{
+								position516, tokenIndex516 := position, tokenIndex
+								if !_rules[ruleCOMMA]() {
+									goto l516
+								}
+								goto l517
+							l516:
+								position, tokenIndex = position516, tokenIndex516
+							}
+						l517:
+							if !_rules[ruleRWING]() {
+								goto l419
+							}
+						}
+					l421:
+					l518:
+						{
+							position519, tokenIndex519 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '+':
+									if !_rules[ruleINC]() {
+										goto l519
+									}
+								case '-':
+									{
+										position521, tokenIndex521 := position, tokenIndex
+										{
+											position523 := position
+											if buffer[position] != rune('-') {
+												goto l522
+											}
+											position++
+											if buffer[position] != rune('>') {
+												goto l522
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l522
+											}
+											add(rulePTR, position523)
+										}
+										if !_rules[ruleIdentifier]() {
+											goto l522
+										}
+										goto l521
+									l522:
+										position, tokenIndex = position521, tokenIndex521
+										if !_rules[ruleDEC]() {
+											goto l519
+										}
+									}
+								l521:
+									break
+								case '.':
+									if !_rules[ruleDOT]() {
+										goto l519
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l519
+									}
+								case '[':
+									if !_rules[ruleLBRK]() {
+										goto l519
+									}
+									if !_rules[ruleExpression]() {
+										goto l519
+									}
+									if !_rules[ruleRBRK]() {
+										goto l519
+									}
+								default:
+									if !_rules[ruleLPAR]() {
+										goto l519
+									}
+									{
+										position524, tokenIndex524 := position, tokenIndex
+										{
+											position526 := position
+											if !_rules[ruleAssignmentExpression]() {
+												goto l524
+											}
+										l527:
+											{
+												position528, tokenIndex528 := position, tokenIndex
+												if !_rules[ruleCOMMA]() {
+													goto l528
+												}
+												if !_rules[ruleAssignmentExpression]() {
+													goto l528
+												}
+												goto l527
+											l528:
+												position, tokenIndex = position528, tokenIndex528
+											}
+											add(ruleArgumentExpressionList, position526)
+										}
+										goto l525
+									l524:
+										position, tokenIndex = position524, tokenIndex524
+									}
+								l525:
+									if !_rules[ruleRPAR]() {
+										goto l519
+									}
+								}
+							}
+
+							goto l518
+						l519:
+							position, tokenIndex = position519, tokenIndex519
+						}
+						add(rulePostfixExpression, position420)
+					}
+					goto l418
+				l419:
+					position, tokenIndex = position418, tokenIndex418
+					if !_rules[ruleINC]() {
+						goto l529
+					}
+					if !_rules[ruleUnaryExpression]() {
+						goto l529
+					}
+					goto l418
+				l529:
+					position, tokenIndex = position418, tokenIndex418
+					if !_rules[ruleDEC]() {
+						goto l530
+					}
+					if !_rules[ruleUnaryExpression]() {
+						goto l530
+					}
+					goto l418
+				l530:
+					position, tokenIndex = position418, tokenIndex418
+					{
+						switch buffer[position] {
+						case '_':
+							{
+								position532 := position
+								position++
+								if buffer[position] != rune('A') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l416
+								}
+								position++
+								{
+									position533, tokenIndex533 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l533
+									}
+									goto l416
+								l533:
+									position, tokenIndex = position533, tokenIndex533
+								}
+								if !_rules[ruleSpacing]() {
+									goto l416
+								}
+								add(ruleALIGNOF, position532)
+							}
+							if !_rules[ruleLPAR]() {
+								goto l416
+							}
+							if !_rules[ruleTypeName]() {
+								goto l416
+							}
+							if !_rules[ruleRPAR]() {
+								goto l416
+							}
+						case 's':
+							{
+								position534 := position
+								position++
+								if buffer[position] != rune('i') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('z') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l416
+								}
+								position++
+								{
+									position535, tokenIndex535 := position, tokenIndex
+									if !_rules[ruleIdChar]() {
+										goto l535
+									}
+									goto l416
+								l535:
+									position, tokenIndex = position535, tokenIndex535
+								}
+								if !_rules[ruleSpacing]() {
+									goto l416
+								}
+								add(ruleSIZEOF, position534)
+							}
+							{
+								position536, tokenIndex536 := position, tokenIndex
+								if !_rules[ruleUnaryExpression]() {
+									goto l537
+								}
+								goto l536
+							l537:
+								position, tokenIndex = position536, tokenIndex536
+								if !_rules[ruleLPAR]() {
+									goto l416
+								}
+								if !_rules[ruleTypeName]() {
+									goto l416
+								}
+								if !_rules[ruleRPAR]() {
+									goto l416
+								}
+							}
+						l536:
+							break
+						default:
+							{
+								position538 := position
+								{
+									switch buffer[position] {
+									case '!':
+										{
+											position540 := position
+											position++
+											{
+												position541, tokenIndex541 := position, tokenIndex
+												if buffer[position] != rune('=') {
+													goto l541
+												}
+												position++
+												goto l416
+											l541:
+												position, tokenIndex = position541, tokenIndex541
+											}
+											if !_rules[ruleSpacing]() {
+												goto l416
+											}
+											add(ruleBANG, position540)
+										}
+									case '*':
+										if !_rules[ruleSTAR]() {
+											goto l416
+										}
+									case '+':
+										if !_rules[rulePLUS]() {
+											goto l416
+										}
+									case '-':
+										if !_rules[ruleMINUS]() {
+											goto l416
+										}
+									case '~':
+										{
+											position542 := position
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l416
+											}
+											add(ruleTILDA, position542)
+										}
+									default:
+										if !_rules[ruleAND]() {
+											goto l416
+										}
+									}
+								}
+
+								add(ruleUnaryOperator, position538)
+							}
+							if !_rules[ruleCastExpression]() {
+								goto l416
+							}
+						}
+					}
+
+				}
+			l418:
+				add(ruleUnaryExpression, position417)
+			}
+			memoize(52, position416, tokenIndex416, true)
+			return true
+		l416:
+			memoize(52, position416, tokenIndex416, false)
+			position, tokenIndex = position416, tokenIndex416
+			return false
+		},
+		/* 53 UnaryOperator <- <((&('!') BANG) | (&('*') STAR) | (&('+') PLUS) | (&('-') MINUS) | (&('~') TILDA) | (&('&') %fail('!' '*' '+' '-' '~') AND))> */
+		nil,
+		/* 54 CastExpression <- <((LPAR TypeName RPAR CastExpression) / UnaryExpression)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{54, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position544, tokenIndex544 := position, tokenIndex
+			{
+				position545 := position
+				{
+					position546, tokenIndex546 := position, tokenIndex
+					if !_rules[ruleLPAR]() {
+						goto l547
+					}
+					if !_rules[ruleTypeName]() {
+						goto l547
+					}
+					if !_rules[ruleRPAR]() {
+						goto l547
+					}
+					if !_rules[ruleCastExpression]() {
+						goto l547
+					}
+					goto l546
+				l547:
+					position, tokenIndex = position546, tokenIndex546
+					if !_rules[ruleUnaryExpression]() {
+						goto l544
+					}
+				}
+			l546:
+				add(ruleCastExpression, position545)
+			}
+			memoize(54, position544, tokenIndex544, true)
+			return true
+		l544:
+			memoize(54, position544, tokenIndex544, false)
+			position, tokenIndex = position544, tokenIndex544
+			return false
+		},
+		/* 55 MultiplicativeExpression <- <(CastExpression (((&('%') MOD) | (&('/') DIV) | (&('*') %fail('%' '/') STAR)) CastExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{55, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position548, tokenIndex548 := position, tokenIndex
+			{
+				position549 := position
+				if !_rules[ruleCastExpression]() {
+					goto l548
+				}
+			l550:
+				{
+					position551, tokenIndex551 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '%':
+							{
+								position553 := position
+								position++
+								{
+									position554, tokenIndex554 := position, tokenIndex
+									{
+										position555, tokenIndex555 := position, tokenIndex
+										if buffer[position] != rune('=') {
+											goto l556
+										}
+										position++
+										goto l555
+									l556:
+										position, tokenIndex = position555, tokenIndex555
+										if buffer[position] != rune('>') {
+											goto l554
+										}
+										position++
+									}
+								l555:
+									goto l551
+								l554:
+									position, tokenIndex = position554, tokenIndex554
+								}
+								if !_rules[ruleSpacing]() {
+									goto l551
+								}
+								add(ruleMOD, position553)
+							}
+						case '/':
+							{
+								position557 := position
+								position++
+								{
+									position558, tokenIndex558 := position, tokenIndex
+									if buffer[position] != rune('=') {
+										goto l558
+									}
+									position++
+									goto l551
+								l558:
+									position, tokenIndex = position558, tokenIndex558
+								}
+								if !_rules[ruleSpacing]() {
+									goto l551
+								}
+								add(ruleDIV, position557)
+							}
+						default:
+							if !_rules[ruleSTAR]() {
+								goto l551
+							}
+						}
+					}
+
+					if !_rules[ruleCastExpression]() {
+						goto l551
+					}
+					goto l550
+				l551:
+					position, tokenIndex = position551, tokenIndex551
+				}
+				add(ruleMultiplicativeExpression, position549)
+			}
+			memoize(55, position548, tokenIndex548, true)
+			return true
+		l548:
+			memoize(55, position548, tokenIndex548, false)
+			position, tokenIndex = position548, tokenIndex548
+			return false
+		},
+		/* 56 AdditiveExpression <- <(MultiplicativeExpression ((PLUS / MINUS) MultiplicativeExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{56, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position559, tokenIndex559 := position, tokenIndex
+			{
+				position560 := position
+				if !_rules[ruleMultiplicativeExpression]() {
+					goto l559
+				}
+			l561:
+				{
+					position562, tokenIndex562 := position, tokenIndex
+					{
+						position563, tokenIndex563 := position, tokenIndex
+						if !_rules[rulePLUS]() {
+							goto l564
+						}
+						goto l563
+					l564:
+						position, tokenIndex = position563, tokenIndex563
+						if !_rules[ruleMINUS]() {
+							goto l562
+						}
+					}
+				l563:
+					if !_rules[ruleMultiplicativeExpression]() {
+						goto l562
+					}
+					goto l561
+				l562:
+					position, tokenIndex = position562, tokenIndex562
+				}
+				add(ruleAdditiveExpression, position560)
+			}
+			memoize(56, position559, tokenIndex559, true)
+			return true
+		l559:
+			memoize(56, position559, tokenIndex559, false)
+			position, tokenIndex = position559, tokenIndex559
+			return false
+		},
+		/* 57 ShiftExpression <- <(AdditiveExpression ((LEFT / RIGHT) AdditiveExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{57, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position565, tokenIndex565 := position, tokenIndex
+			{
+				position566 := position
+				if !_rules[ruleAdditiveExpression]() {
+					goto l565
+				}
+			l567:
+				{
+					position568, tokenIndex568 := position, tokenIndex
+					{
+						position569, tokenIndex569 := position, tokenIndex
+						{
+							position571 := position
+							if buffer[position] != rune('<') {
+								goto l570
+							}
+							position++
+							if buffer[position] != rune('<') {
+								goto l570
+							}
+							position++
+							{
+								position572, tokenIndex572 := position, tokenIndex
+								if buffer[position] != rune('=') {
+									goto l572
+								}
+								position++
+								goto l570
+							l572:
+								position, tokenIndex = position572, tokenIndex572
+							}
+							if !_rules[ruleSpacing]() {
+								goto l570
+							}
+							add(ruleLEFT, position571)
+						}
+						goto l569
+					l570:
+						position, tokenIndex = position569, tokenIndex569
+						{
+							position573 := position
+							if buffer[position] != rune('>') {
+								goto l568
+							}
+							position++
+							if buffer[position] != rune('>') {
+								goto l568
+							}
+							position++
+							{
+								position574, tokenIndex574 := position, tokenIndex
+								if buffer[position] != rune('=') {
+									goto l574
+								}
+								position++
+								goto l568
+							l574:
+								position, tokenIndex = position574, tokenIndex574
+							}
+							if !_rules[ruleSpacing]() {
+								goto l568
+							}
+							add(ruleRIGHT, position573)
+						}
+					}
+				l569:
+					if !_rules[ruleAdditiveExpression]() {
+						goto l568
+					}
+					goto l567
+				l568:
+					position, tokenIndex = position568, tokenIndex568
+				}
+				add(ruleShiftExpression, position566)
+			}
+			memoize(57, position565, tokenIndex565, true)
+			return true
+		l565:
+			memoize(57, position565, tokenIndex565, false)
+			position, tokenIndex = position565, tokenIndex565
+			return false
+		},
+		/* 58 RelationalExpression <- <(ShiftExpression (((&('>') %fail('<=' '<') (GE / GT)) | (&('<') %fail('>=' '>') (LE / LT))) ShiftExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{58, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position575, tokenIndex575 := position, tokenIndex
+			{
+				position576 := position
+				if !_rules[ruleShiftExpression]() {
+					goto l575
+				}
+			l577:
+				{
+					position578, tokenIndex578 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '>':
+							{
+								position580, tokenIndex580 := position, tokenIndex
+								{
+									position582 := position
+									if buffer[position] != rune('>') {
+										goto l581
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l581
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l581
+									}
+									add(ruleGE, position582)
+								}
+								goto l580
+							l581:
+								position, tokenIndex = position580, tokenIndex580
+								{
+									position583 := position
+									if buffer[position] != rune('>') {
+										goto l578
+									}
+									position++
+									{
+										position584, tokenIndex584 := position, tokenIndex
+										if buffer[position] != rune('=') {
+											goto l584
+										}
+										position++
+										goto l578
+									l584:
+										position, tokenIndex = position584, tokenIndex584
+									}
+									if !_rules[ruleSpacing]() {
+										goto l578
+									}
+									add(ruleGT, position583)
+								}
+							}
+						l580:
+							break
+						default:
+							{
+								position585, tokenIndex585 := position, tokenIndex
+								{
+									position587 := position
+									if buffer[position] != rune('<') {
+										goto l586
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l586
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l586
+									}
+									add(ruleLE, position587)
+								}
+								goto l585
+							l586:
+								position, tokenIndex = position585, tokenIndex585
+								{
+									position588 := position
+									if buffer[position] != rune('<') {
+										goto l578
+									}
+									position++
+									{
+										position589, tokenIndex589 := position, tokenIndex
+										if buffer[position] != rune('=') {
+											goto l589
+										}
+										position++
+										goto l578
+									l589:
+										position, tokenIndex = position589, tokenIndex589
+									}
+									if !_rules[ruleSpacing]() {
+										goto l578
+									}
+									add(ruleLT, position588)
+								}
+							}
+						l585:
+							break
+						}
+					}
+
+					if !_rules[ruleShiftExpression]() {
+						goto l578
+					}
+					goto l577
+				l578:
+					position, tokenIndex = position578, tokenIndex578
+				}
+				add(ruleRelationalExpression, position576)
+			}
+			memoize(58, position575, tokenIndex575, true)
+			return true
+		l575:
+			memoize(58, position575, tokenIndex575, false)
+			position, tokenIndex = position575, tokenIndex575
+			return false
+		},
+		/* 59 EqualityExpression <- <(RelationalExpression ((EQUEQU / BANGEQU) RelationalExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{59, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position590, tokenIndex590 := position, tokenIndex
+			{
+				position591 := position
+				if !_rules[ruleRelationalExpression]() {
+					goto l590
+				}
+			l592:
+				{
+					position593, tokenIndex593 := position, tokenIndex
+					{
+						position594, tokenIndex594 := position, tokenIndex
+						{
+							position596 := position
+							if buffer[position] != rune('=') {
+								goto l595
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l595
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l595
+							}
+							add(ruleEQUEQU, position596)
+						}
+						goto l594
+					l595:
+						position, tokenIndex = position594, tokenIndex594
+						{
+							position597 := position
+							if buffer[position] != rune('!') {
+								goto l593
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l593
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l593
+							}
+							add(ruleBANGEQU, position597)
+						}
+					}
+				l594:
+					if !_rules[ruleRelationalExpression]() {
+						goto l593
+					}
+					goto l592
+				l593:
+					position, tokenIndex = position593, tokenIndex593
+				}
+				add(ruleEqualityExpression, position591)
+			}
+			memoize(59, position590, tokenIndex590, true)
+			return true
+		l590:
+			memoize(59, position590, tokenIndex590, false)
+			position, tokenIndex = position590, tokenIndex590
+			return false
+		},
+		/* 60 ANDExpression <- <(EqualityExpression (AND EqualityExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{60, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position598, tokenIndex598 := position, tokenIndex
+			{
+				position599 := position
+				if !_rules[ruleEqualityExpression]() {
+					goto l598
+				}
+			l600:
+				{
+					position601, tokenIndex601 := position, tokenIndex
+					if !_rules[ruleAND]() {
+						goto l601
+					}
+					if !_rules[ruleEqualityExpression]() {
+						goto l601
+					}
+					goto l600
+				l601:
+					position, tokenIndex = position601, tokenIndex601
+				}
+				add(ruleANDExpression, position599)
+			}
+			memoize(60, position598, tokenIndex598, true)
+			return true
+		l598:
+			memoize(60, position598, tokenIndex598, false)
+			position, tokenIndex = position598, tokenIndex598
+			return false
+		},
+		/* 61 ExclusiveORExpression <- <(ANDExpression (HAT ANDExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{61, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position602, tokenIndex602 := position, tokenIndex
+			{
+				position603 := position
+				if !_rules[ruleANDExpression]() {
+					goto l602
+				}
+			l604:
+				{
+					position605, tokenIndex605 := position, tokenIndex
+					{
+						position606 := position
+						if buffer[position] != rune('^') {
+							goto l605
+						}
+						position++
+						{
+							position607, tokenIndex607 := position, tokenIndex
+							if buffer[position] != rune('=') {
+								goto l607
+							}
+							position++
+							goto l605
+						l607:
+							position, tokenIndex = position607, tokenIndex607
+						}
+						if !_rules[ruleSpacing]() {
+							goto l605
+						}
+						add(ruleHAT, position606)
+					}
+					if !_rules[ruleANDExpression]() {
+						goto l605
+					}
+					goto l604
+				l605:
+					position, tokenIndex = position605, tokenIndex605
+				}
+				add(ruleExclusiveORExpression, position603)
+			}
+			memoize(61, position602, tokenIndex602, true)
+			return true
+		l602:
+			memoize(61, position602, tokenIndex602, false)
+			position, tokenIndex = position602, tokenIndex602
+			return false
+		},
+		/* 62 InclusiveORExpression <- <(ExclusiveORExpression (OR ExclusiveORExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{62, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position608, tokenIndex608 := position, tokenIndex
+			{
+				position609 := position
+				if !_rules[ruleExclusiveORExpression]() {
+					goto l608
+				}
+			l610:
+				{
+					position611, tokenIndex611 := position, tokenIndex
+					{
+						position612 := position
+						if buffer[position] != rune('|') {
+							goto l611
+						}
+						position++
+						{
+							position613, tokenIndex613 := position, tokenIndex
+							if buffer[position] != rune('=') {
+								goto l613
+							}
+							position++
+							goto l611
+						l613:
+							position, tokenIndex = position613, tokenIndex613
+						}
+						if !_rules[ruleSpacing]() {
+							goto l611
+						}
+						add(ruleOR, position612)
+					}
+					if !_rules[ruleExclusiveORExpression]() {
+						goto l611
+					}
+					goto l610
+				l611:
+					position, tokenIndex = position611, tokenIndex611
+				}
+				add(ruleInclusiveORExpression, position609)
+			}
+			memoize(62, position608, tokenIndex608, true)
+			return true
+		l608:
+			memoize(62, position608, tokenIndex608, false)
+			position, tokenIndex = position608, tokenIndex608
+			return false
+		},
+		/* 63 LogicalANDExpression <- <(InclusiveORExpression (ANDAND InclusiveORExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{63, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position614, tokenIndex614 := position, tokenIndex
+			{
+				position615 := position
+				if !_rules[ruleInclusiveORExpression]() {
+					goto l614
+				}
+			l616:
+				{
+					position617, tokenIndex617 := position, tokenIndex
+					{
+						position618 := position
+						if buffer[position] != rune('&') {
+							goto l617
+						}
+						position++
+						if buffer[position] != rune('&') {
+							goto l617
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l617
+						}
+						add(ruleANDAND, position618)
+					}
+					if !_rules[ruleInclusiveORExpression]() {
+						goto l617
+					}
+					goto l616
+				l617:
+					position, tokenIndex = position617, tokenIndex617
+				}
+				add(ruleLogicalANDExpression, position615)
+			}
+			memoize(63, position614, tokenIndex614, true)
+			return true
+		l614:
+			memoize(63, position614, tokenIndex614, false)
+			position, tokenIndex = position614, tokenIndex614
+			return false
+		},
+		/* 64 LogicalORExpression <- <(LogicalANDExpression (OROR LogicalANDExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{64, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position619, tokenIndex619 := position, tokenIndex
+			{
+				position620 := position
+				if !_rules[ruleLogicalANDExpression]() {
+					goto l619
+				}
+			l621:
+				{
+					position622, tokenIndex622 := position, tokenIndex
+					{
+						position623 := position
+						if buffer[position] != rune('|') {
+							goto l622
+						}
+						position++
+						if buffer[position] != rune('|') {
+							goto l622
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l622
+						}
+						add(ruleOROR, position623)
+					}
+					if !_rules[ruleLogicalANDExpression]() {
+						goto l622
+					}
+					goto l621
+				l622:
+					position, tokenIndex = position622, tokenIndex622
+				}
+				add(ruleLogicalORExpression, position620)
+			}
+			memoize(64, position619, tokenIndex619, true)
+			return true
+		l619:
+			memoize(64, position619, tokenIndex619, false)
+			position, tokenIndex = position619, tokenIndex619
+			return false
+		},
+		/* 65 ConditionalExpression <- <(LogicalORExpression (QUERY Expression COLON LogicalORExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{65, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position624, tokenIndex624 := position, tokenIndex
+			{
+				position625 := position
+				if !_rules[ruleLogicalORExpression]() {
+					goto l624
+				}
+			l626:
+				{
+					position627, tokenIndex627 := position, tokenIndex
+					{
+						position628 := position
+						if buffer[position] != rune('?') {
+							goto l627
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l627
+						}
+						add(ruleQUERY, position628)
+					}
+					if !_rules[ruleExpression]() {
+						goto l627
+					}
+					if !_rules[ruleCOLON]() {
+						goto l627
+					}
+					if !_rules[ruleLogicalORExpression]() {
+						goto l627
+					}
+					goto l626
+				l627:
+					position, tokenIndex = position627, tokenIndex627
+				}
+				add(ruleConditionalExpression, position625)
+			}
+			memoize(65, position624, tokenIndex624, true)
+			return true
+		l624:
+			memoize(65, position624, tokenIndex624, false)
+			position, tokenIndex = position624, tokenIndex624
+			return false
+		},
+		/* 66 AssignmentExpression <- <((UnaryExpression AssignmentOperator AssignmentExpression) / ConditionalExpression)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{66, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position629, tokenIndex629 := position, tokenIndex
+			{
+				position630 := position
+				{
+					position631, tokenIndex631 := position, tokenIndex
+					if !_rules[ruleUnaryExpression]() {
+						goto l632
+					}
+					{
+						position633 := position
+						{
+							switch buffer[position] {
+							case '%':
+								{
+									position635 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleMODEQU, position635)
+								}
+							case '&':
+								{
+									position636 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleANDEQU, position636)
+								}
+							case '*':
+								{
+									position637 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleSTAREQU, position637)
+								}
+							case '+':
+								{
+									position638 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(rulePLUSEQU, position638)
+								}
+							case '-':
+								{
+									position639 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleMINUSEQU, position639)
+								}
+							case '/':
+								{
+									position640 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleDIVEQU, position640)
+								}
+							case '<':
+								{
+									position641 := position
+									position++
+									if buffer[position] != rune('<') {
+										goto l632
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleLEFTEQU, position641)
+								}
+							case '>':
+								{
+									position642 := position
+									position++
+									if buffer[position] != rune('>') {
+										goto l632
+									}
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleRIGHTEQU, position642)
+								}
+							case '^':
+								{
+									position643 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleHATEQU, position643)
+								}
+							case '|':
+								{
+									position644 := position
+									position++
+									if buffer[position] != rune('=') {
+										goto l632
+									}
+									position++
+									if !_rules[ruleSpacing]() {
+										goto l632
+									}
+									add(ruleOREQU, position644)
+								}
+							default:
+								if !_rules[ruleEQU]() {
+									goto l632
+								}
+							}
+						}
+
+						add(ruleAssignmentOperator, position633)
+					}
+					if !_rules[ruleAssignmentExpression]() {
+						goto l632
+					}
+					goto l631
+				l632:
+					position, tokenIndex = position631, tokenIndex631
+					if !_rules[ruleConditionalExpression]() {
+						goto l629
+					}
+				}
+			l631:
+				add(ruleAssignmentExpression, position630)
+			}
+			memoize(66, position629, tokenIndex629, true)
+			return true
+		l629:
+			memoize(66, position629, tokenIndex629, false)
+			position, tokenIndex = position629, tokenIndex629
+			return false
+		},
+		/* 67 AssignmentOperator <- <((&('%') MODEQU) | (&('&') ANDEQU) | (&('*') STAREQU) | (&('+') PLUSEQU) | (&('-') MINUSEQU) | (&('/') DIVEQU) | (&('<') LEFTEQU) | (&('>') RIGHTEQU) | (&('^') HATEQU) | (&('|') OREQU) | (&('=') %fail('%=' '&=' '*=' '+=' '-=' '/=' '<<=' '>>=' '^=' '|=') EQU))> */
+		nil,
+		/* 68 Expression <- <(AssignmentExpression (COMMA AssignmentExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{68, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position646, tokenIndex646 := position, tokenIndex
+			{
+				position647 := position
+				if !_rules[ruleAssignmentExpression]() {
+					goto l646
+				}
+			l648:
+				{
+					position649, tokenIndex649 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l649
+					}
+					if !_rules[ruleAssignmentExpression]() {
+						goto l649
+					}
+					goto l648
+				l649:
+					position, tokenIndex = position649, tokenIndex649
+				}
+				add(ruleExpression, position647)
+			}
+			memoize(68, position646, tokenIndex646, true)
+			return true
+		l646:
+			memoize(68, position646, tokenIndex646, false)
+			position, tokenIndex = position646, tokenIndex646
+			return false
+		},
+		/* 69 ConstantExpression <- <ConditionalExpression> */
+		func() bool {
+			memoized, ok := memoization[memoKey{69, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position650, tokenIndex650 := position, tokenIndex
+			{
+				position651 := position
+				if !_rules[ruleConditionalExpression]() {
+					goto l650
+				}
+				add(ruleConstantExpression, position651)
+			}
+			memoize(69, position650, tokenIndex650, true)
+			return true
+		l650:
+			memoize(69, position650, tokenIndex650, false)
+			position, tokenIndex = position650, tokenIndex650
+			return false
+		},
+		/* 70 Spacing <- <((&('#') %fail('\t' '\n' '\r' ' ' '/*' '//') Pragma) | (&('/') %fail('\t' '\n' '\r' ' ' '#') (LongComment / LineComment)) | (&('\t' | '\n' | '\r' | ' ') %fail('/*' '//' '#') WhiteSpace))*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{70, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position652, tokenIndex652 := position, tokenIndex
+			{
+				position653 := position
+			l654:
+				{
+					position655, tokenIndex655 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '#':
+							{
+								position657 := position
+								position++
+							l658:
+								{
+									position659, tokenIndex659 := position, tokenIndex
+									{
+										position660, tokenIndex660 := position, tokenIndex
+										if buffer[position] != rune('\n') {
+											goto l660
+										}
+										position++
+										goto l659
+									l660:
+										position, tokenIndex = position660, tokenIndex660
+									}
+									if !matchDot() {
+										goto l659
+									}
+									goto l658
+								l659:
+									position, tokenIndex = position659, tokenIndex659
+								}
+								add(rulePragma, position657)
+							}
+						case '/':
+							{
+								position661, tokenIndex661 := position, tokenIndex
+								{
+									position663 := position
+									if buffer[position] != rune('/') {
+										goto l662
+									}
+									position++
+									if buffer[position] != rune('*') {
+										goto l662
+									}
+									position++
+								l664:
+									{
+										position665, tokenIndex665 := position, tokenIndex
+										{
+											position666, tokenIndex666 := position, tokenIndex
+											if buffer[position] != rune('*') {
+												goto l666
+											}
+											position++
+											if buffer[position] != rune('/') {
+												goto l666
+											}
+											position++
+											goto l665
+										l666:
+											position, tokenIndex = position666, tokenIndex666
+										}
+										if !matchDot() {
+											goto l665
+										}
+										goto l664
+									l665:
+										position, tokenIndex = position665, tokenIndex665
+									}
+									if buffer[position] != rune('*') {
+										goto l662
+									}
+									position++
+									if buffer[position] != rune('/') {
+										goto l662
+									}
+									position++
+									add(ruleLongComment, position663)
+								}
+								goto l661
+							l662:
+								position, tokenIndex = position661, tokenIndex661
+								{
+									position667 := position
+									if buffer[position] != rune('/') {
+										goto l655
+									}
+									position++
+									if buffer[position] != rune('/') {
+										goto l655
+									}
+									position++
+								l668:
+									{
+										position669, tokenIndex669 := position, tokenIndex
+										{
+											position670, tokenIndex670 := position, tokenIndex
+											if buffer[position] != rune('\n') {
+												goto l670
+											}
+											position++
+											goto l669
+										l670:
+											position, tokenIndex = position670, tokenIndex670
+										}
+										if !matchDot() {
+											goto l669
+										}
+										goto l668
+									l669:
+										position, tokenIndex = position669, tokenIndex669
+									}
+									add(ruleLineComment, position667)
+								}
+							}
+						l661:
+							break
+						default:
+							{
+								position671 := position
+								{
+									switch buffer[position] {
+									case '\t':
+										position++
+									case '\n':
+										position++
+									case '\r':
+										position++
+									default:
+										if buffer[position] != rune(' ') {
+											goto l655
+										}
+										position++
+									}
+								}
+
+								add(ruleWhiteSpace, position671)
+							}
+						}
+					}
+
+					goto l654
+				l655:
+					position, tokenIndex = position655, tokenIndex655
+				}
+				add(ruleSpacing, position653)
+			}
+			memoize(70, position652, tokenIndex652, true)
+			return true
+		},
+		/* 71 WhiteSpace <- <((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&(' ') %fail('\t' '\n' '\r') ' '))> */
+		nil,
+		/* 72 LongComment <- <('/' '*' (!('*' '/') .)* ('*' '/'))> */
+		nil,
+		/* 73 LineComment <- <('/' '/' (!'\n' .)*)> */
+		nil,
+		/* 74 Pragma <- <('#' (!'\n' .)*)> */
+		nil,
+		/* 75 AUTO <- <('a' 'u' 't' 'o' !IdChar Spacing)> */
+		nil,
+		/* 76 BREAK <- <('b' 'r' 'e' 'a' 'k' !IdChar Spacing)> */
+		nil,
+		/* 77 CASE <- <('c' 'a' 's' 'e' !IdChar Spacing)> */
+		nil,
+		/* 78 CHAR <- <('c' 'h' 'a' 'r' !IdChar Spacing)> */
+		nil,
+		/* 79 CONST <- <('c' 'o' 'n' 's' 't' !IdChar Spacing)> */
+		nil,
+		/* 80 CONTINUE <- <('c' 'o' 'n' 't' 'i' 'n' 'u' 'e' !IdChar Spacing)> */
+		nil,
+		/* 81 DEFAULT <- <('d' 'e' 'f' 'a' 'u' 'l' 't' !IdChar Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{81, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position683, tokenIndex683 := position, tokenIndex
+			{
+				position684 := position
+				if buffer[position] != rune('d') {
+					goto l683
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l683
+				}
+				position++
+				if buffer[position] != rune('f') {
+					goto l683
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l683
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l683
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l683
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l683
+				}
+				position++
+				{
+					position685, tokenIndex685 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l685
+					}
+					goto l683
+				l685:
+					position, tokenIndex = position685, tokenIndex685
+				}
+				if !_rules[ruleSpacing]() {
+					goto l683
+				}
+				add(ruleDEFAULT, position684)
+			}
+			memoize(81, position683, tokenIndex683, true)
+			return true
+		l683:
+			memoize(81, position683, tokenIndex683, false)
+			position, tokenIndex = position683, tokenIndex683
+			return false
+		},
+		/* 82 DOUBLE <- <('d' 'o' 'u' 'b' 'l' 'e' !IdChar Spacing)> */
+		nil,
+		/* 83 DO <- <('d' 'o' !IdChar Spacing)> */
+		nil,
+		/* 84 ELSE <- <('e' 'l' 's' 'e' !IdChar Spacing)> */
+		nil,
+		/* 85 ENUM <- <('e' 'n' 'u' 'm' !IdChar Spacing)> */
+		nil,
+		/* 86 EXTERN <- <('e' 'x' 't' 'e' 'r' 'n' !IdChar Spacing)> */
+		nil,
+		/* 87 FLOAT <- <('f' 'l' 'o' 'a' 't' !IdChar Spacing)> */
+		nil,
+		/* 88 FOR <- <('f' 'o' 'r' !IdChar Spacing)> */
+		nil,
+		/* 89 GOTO <- <('g' 'o' 't' 'o' !IdChar Spacing)> */
+		nil,
+		/* 90 IF <- <('i' 'f' !IdChar Spacing)> */
+		nil,
+		/* 91 INT <- <('i' 'n' 't' !IdChar Spacing)> */
+		nil,
+		/* 92 INLINE <- <('i' 'n' 'l' 'i' 'n' 'e' !IdChar Spacing)> */
+		nil,
+		/* 93 LONG <- <('l' 'o' 'n' 'g' !IdChar Spacing)> */
+		nil,
+		/* 94 REGISTER <- <('r' 'e' 'g' 'i' 's' 't' 'e' 'r' !IdChar Spacing)> */
+		nil,
+		/* 95 RESTRICT <- <('r' 'e' 's' 't' 'r' 'i' 'c' 't' !IdChar Spacing)> */
+		nil,
+		/* 96 RETURN <- <('r' 'e' 't' 'u' 'r' 'n' !IdChar Spacing)> */
+		nil,
+		/* 97 SHORT <- <('s' 'h' 'o' 'r' 't' !IdChar Spacing)> */
+		nil,
+		/* 98 SIGNED <- <('s' 'i' 'g' 'n' 'e' 'd' !IdChar Spacing)> */
+		nil,
+		/* 99 SIZEOF <- <('s' 'i' 'z' 'e' 'o' 'f' !IdChar Spacing)> */
+		nil,
+		/* 100 STATIC <- <('s' 't' 'a' 't' 'i' 'c' !IdChar Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{100, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position704, tokenIndex704 := position, tokenIndex
+			{
+				position705 := position
+				if buffer[position] != rune('s') {
+					goto l704
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l704
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l704
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l704
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l704
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l704
+				}
+				position++
+				{
+					position706, tokenIndex706 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l706
+					}
+					goto l704
+				l706:
+					position, tokenIndex = position706, tokenIndex706
+				}
+				if !_rules[ruleSpacing]() {
+					goto l704
+				}
+				add(ruleSTATIC, position705)
+			}
+			memoize(100, position704, tokenIndex704, true)
+			return true
+		l704:
+			memoize(100, position704, tokenIndex704, false)
+			position, tokenIndex = position704, tokenIndex704
+			return false
+		},
+		/* 101 STRUCT <- <('s' 't' 'r' 'u' 'c' 't' !IdChar Spacing)> */
+		nil,
+		/* 102 SWITCH <- <('s' 'w' 'i' 't' 'c' 'h' !IdChar Spacing)> */
+		nil,
+		/* 103 TYPEDEF <- <('t' 'y' 'p' 'e' 'd' 'e' 'f' !IdChar Spacing)> */
+		nil,
+		/* 104 UNION <- <('u' 'n' 'i' 'o' 'n' !IdChar Spacing)> */
+		nil,
+		/* 105 UNSIGNED <- <('u' 'n' 's' 'i' 'g' 'n' 'e' 'd' !IdChar Spacing)> */
+		nil,
+		/* 106 VOID <- <('v' 'o' 'i' 'd' !IdChar Spacing)> */
+		nil,
+		/* 107 VOLATILE <- <('v' 'o' 'l' 'a' 't' 'i' 'l' 'e' !IdChar Spacing)> */
+		nil,
+		/* 108 WHILE <- <('w' 'h' 'i' 'l' 'e' !IdChar Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{108, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position714, tokenIndex714 := position, tokenIndex
+			{
+				position715 := position
+				if buffer[position] != rune('w') {
+					goto l714
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l714
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l714
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l714
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l714
+				}
+				position++
+				{
+					position716, tokenIndex716 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l716
+					}
+					goto l714
+				l716:
+					position, tokenIndex = position716, tokenIndex716
+				}
+				if !_rules[ruleSpacing]() {
+					goto l714
+				}
+				add(ruleWHILE, position715)
+			}
+			memoize(108, position714, tokenIndex714, true)
+			return true
+		l714:
+			memoize(108, position714, tokenIndex714, false)
+			position, tokenIndex = position714, tokenIndex714
+			return false
+		},
+		/* 109 BOOL <- <('_' 'B' 'o' 'o' 'l' !IdChar Spacing)> */
+		nil,
+		/* 110 COMPLEX <- <('_' 'C' 'o' 'm' 'p' 'l' 'e' 'x' !IdChar Spacing)> */
+		nil,
+		/* 111 STDCALL <- <('_' 's' 't' 'd' 'c' 'a' 'l' 'l' !IdChar Spacing)> */
+		nil,
+		/* 112 DECLSPEC <- <('_' '_' 'd' 'e' 'c' 'l' 's' 'p' 'e' 'c' !IdChar Spacing)> */
+		nil,
+		/* 113 ATTRIBUTE <- <('_' '_' 'a' 't' 't' 'r' 'i' 'b' 'u' 't' 'e' '_' '_' !IdChar Spacing)> */
+		nil,
+		/* 114 ALIGNAS <- <('_' 'A' 'l' 'i' 'g' 'n' 'a' 's' !IdChar Spacing)> */
+		nil,
+		/* 115 ALIGNOF <- <('_' 'A' 'l' 'i' 'g' 'n' 'o' 'f' !IdChar Spacing)> */
+		nil,
+		/* 116 ATOMIC <- <('_' 'A' 't' 'o' 'm' 'i' 'c' !IdChar Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{116, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position724, tokenIndex724 := position, tokenIndex
+			{
+				position725 := position
+				if buffer[position] != rune('_') {
+					goto l724
+				}
+				position++
+				if buffer[position] != rune('A') {
+					goto l724
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l724
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l724
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l724
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l724
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l724
+				}
+				position++
+				{
+					position726, tokenIndex726 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l726
+					}
+					goto l724
+				l726:
+					position, tokenIndex = position726, tokenIndex726
+				}
+				if !_rules[ruleSpacing]() {
+					goto l724
+				}
+				add(ruleATOMIC, position725)
+			}
+			memoize(116, position724, tokenIndex724, true)
+			return true
+		l724:
+			memoize(116, position724, tokenIndex724, false)
+			position, tokenIndex = position724, tokenIndex724
+			return false
+		},
+		/* 117 GENERIC <- <('_' 'G' 'e' 'n' 'e' 'r' 'i' 'c' !IdChar Spacing)> */
+		nil,
+		/* 118 NORETURN <- <('_' 'N' 'o' 'r' 'e' 't' 'u' 'r' 'n' !IdChar Spacing)> */
+		nil,
+		/* 119 STATICASSERT <- <('_' 'S' 't' 'a' 't' 'i' 'c' '_' 'a' 's' 's' 'e' 'r' 't' !IdChar Spacing)> */
+		nil,
+		/* 120 THREADLOCAL <- <('_' 'T' 'h' 'r' 'e' 'a' 'd' '_' 'l' 'o' 'c' 'a' 'l' !IdChar Spacing)> */
+		nil,
+		/* 121 Keyword <- <(((&('_') ('_' ((&('A') ('A' (('l' 'i' 'g' 'n' (('a' 's') / ('o' 'f'))) / ('t' 'o' 'm' 'i' 'c')))) | (&('C') ('C' 'o' 'm' 'p' 'l' 'e' 'x')) | (&('G') ('G' 'e' 'n' 'e' 'r' 'i' 'c')) | (&('I') ('I' 'm' 'a' 'g' 'i' 'n' 'a' 'r' 'y')) | (&('N') ('N' 'o' 'r' 'e' 't' 'u' 'r' 'n')) | (&('S') ('S' 't' 'a' 't' 'i' 'c' '_' 'a' 's' 's' 'e' 'r' 't')) | (&('T') ('T' 'h' 'r' 'e' 'a' 'd' '_' 'l' 'o' 'c' 'a' 'l')) | (&('_') ('_' (('d' 'e' 'c' 'l' 's' 'p' 'e' 'c') / ('a' 't' 't' 'r' 'i' 'b' 'u' 't' 'e' '_' '_')))) | (&('s') ('s' 't' 'd' 'c' 'a' 'l' 'l')) | (&('B') %fail('A' 'C' 'G' 'I' 'N' 'S' 'T' '_' 's') ('B' 'o' 'o' 'l'))))) | (&('b') ('b' 'r' 'e' 'a' 'k')) | (&('c') ('c' ((&('h') ('h' 'a' 'r')) | (&('o') ('o' 'n' (('s' 't') / ('t' 'i' 'n' 'u' 'e')))) | (&('a') %fail('h' 'o') ('a' 's' 'e'))))) | (&('d') ('d' (('e' 'f' 'a' 'u' 'l' 't') / ('o' (('u' 'b' 'l' 'e') / ))))) | (&('e') ('e' ((&('n') ('n' 'u' 'm')) | (&('x') ('x' 't' 'e' 'r' 'n')) | (&('l') %fail('n' 'x') ('l' 's' 'e'))))) | (&('f') ('f' (('l' 'o' 'a' 't') / ('o' 'r')))) | (&('g') ('g' 'o' 't' 'o')) | (&('i') ('i' ('f' / ('n' ('t' / ('l' 'i' 'n' 'e')))))) | (&('l') ('l' 'o' 'n' 'g')) | (&('r') ('r' 'e' ((&('s') ('s' 't' 'r' 'i' 'c' 't')) | (&('t') ('t' 'u' 'r' 'n')) | (&('g') %fail('s' 't') ('g' 'i' 's' 't' 'e' 'r'))))) | (&('s') ('s' ((&('i') ('i' (('g' 'n' 'e' 'd') / ('z' 'e' 'o' 'f')))) | (&('t') ('t' (('a' 't' 'i' 'c') / ('r' 'u' 'c' 't')))) | (&('w') ('w' 'i' 't' 'c' 'h')) | (&('h') %fail('i' 't' 'w') ('h' 'o' 'r' 't'))))) | (&('t') ('t' 'y' 'p' 'e' 'd' 'e' 'f')) | (&('u') ('u' 'n' (('i' 'o' 'n') / ('s' 'i' 'g' 'n' 'e' 'd')))) | (&('v') ('v' 'o' (('i' 'd') / ('l' 'a' 't' 'i' 'l' 'e')))) | (&('w') ('w' 'h' 'i' 'l' 'e')) | (&('a') %fail('_' 'break' 'c' 'd' 'e' 'f' 'goto' 'i' 'long' 'r' 's' 'typedef' 'u' 'v' 'while') ('a' 'u' 't' 'o'))) !IdChar)> */
+		nil,
+		/* 122 Identifier <- <(!Keyword IdNondigit IdChar* Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{122, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position732, tokenIndex732 := position, tokenIndex
+			{
+				position733 := position
+				{
+					position734, tokenIndex734 := position, tokenIndex
+					{
+						position735 := position
+						{
+							switch buffer[position] {
+							case '_':
+								position++
+								{
+									switch buffer[position] {
+									case 'A':
+										position++
+										{
+											position738, tokenIndex738 := position, tokenIndex
+											if buffer[position] != rune('l') {
+												goto l739
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l739
+											}
+											position++
+											if buffer[position] != rune('g') {
+												goto l739
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l739
+											}
+											position++
+											{
+												position740, tokenIndex740 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													goto l741
+												}
+												position++
+												if buffer[position] != rune('s') {
+													goto l741
+												}
+												position++
+												goto l740
+											l741:
+												position, tokenIndex = position740, tokenIndex740
+												if buffer[position] != rune('o') {
+													goto l739
+												}
+												position++
+												if buffer[position] != rune('f') {
+													goto l739
+												}
+												position++
+											}
+										l740:
+											goto l738
+										l739:
+											position, tokenIndex = position738, tokenIndex738
+											if buffer[position] != rune('t') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('m') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l734
+											}
+											position++
+										}
+									l738:
+										break
+									case 'C':
+										position++
+										if buffer[position] != rune('o') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('m') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('p') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('x') {
+											goto l734
+										}
+										position++
+									case 'G':
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l734
+										}
+										position++
+									case 'I':
+										position++
+										if buffer[position] != rune('m') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('g') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('y') {
+											goto l734
+										}
+										position++
+									case 'N':
+										position++
+										if buffer[position] != rune('o') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('u') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l734
+										}
+										position++
+									case 'S':
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('_') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+									case 'T':
+										position++
+										if buffer[position] != rune('h') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('d') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('_') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+									case '_':
+										position++
+										{
+											position742, tokenIndex742 := position, tokenIndex
+											if buffer[position] != rune('d') {
+												goto l743
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l743
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l743
+											}
+											position++
+											if buffer[position] != rune('l') {
+												goto l743
+											}
+											position++
+											if buffer[position] != rune('s') {
+												goto l743
+											}
+											position++
+											if buffer[position] != rune('p') {
+												goto l743
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l743
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l743
+											}
+											position++
+											goto l742
+										l743:
+											position, tokenIndex = position742, tokenIndex742
+											if buffer[position] != rune('a') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('r') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('b') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('_') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('_') {
+												goto l734
+											}
+											position++
+										}
+									l742:
+										break
+									case 's':
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('d') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+									default:
+										if buffer[position] != rune('B') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+									}
+								}
+
+							case 'b':
+								position++
+								if buffer[position] != rune('r') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('k') {
+									goto l734
+								}
+								position++
+							case 'c':
+								position++
+								{
+									switch buffer[position] {
+									case 'h':
+										position++
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+									case 'o':
+										position++
+										if buffer[position] != rune('n') {
+											goto l734
+										}
+										position++
+										{
+											position745, tokenIndex745 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l746
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l746
+											}
+											position++
+											goto l745
+										l746:
+											position, tokenIndex = position745, tokenIndex745
+											if buffer[position] != rune('t') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l734
+											}
+											position++
+										}
+									l745:
+										break
+									default:
+										if buffer[position] != rune('a') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+									}
+								}
+
+							case 'd':
+								position++
+								{
+									position747, tokenIndex747 := position, tokenIndex
+									if buffer[position] != rune('e') {
+										goto l748
+									}
+									position++
+									if buffer[position] != rune('f') {
+										goto l748
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l748
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l748
+									}
+									position++
+									if buffer[position] != rune('l') {
+										goto l748
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l748
+									}
+									position++
+									goto l747
+								l748:
+									position, tokenIndex = position747, tokenIndex747
+									if buffer[position] != rune('o') {
+										goto l734
+									}
+									position++
+									{
+										position749, tokenIndex749 := position, tokenIndex
+										if buffer[position] != rune('u') {
+											goto l750
+										}
+										position++
+										if buffer[position] != rune('b') {
+											goto l750
+										}
+										position++
+										if buffer[position] != rune('l') {
+											goto l750
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l750
+										}
+										position++
+										goto l749
+									l750:
+										position, tokenIndex = position749, tokenIndex749
+									}
+								l749:
+								}
+							l747:
+								break
+							case 'e':
+								position++
+								{
+									switch buffer[position] {
+									case 'n':
+										position++
+										if buffer[position] != rune('u') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('m') {
+											goto l734
+										}
+										position++
+									case 'x':
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l734
+										}
+										position++
+									default:
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+									}
+								}
+
+							case 'f':
+								position++
+								{
+									position752, tokenIndex752 := position, tokenIndex
+									if buffer[position] != rune('l') {
+										goto l753
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l753
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l753
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l753
+									}
+									position++
+									goto l752
+								l753:
+									position, tokenIndex = position752, tokenIndex752
+									if buffer[position] != rune('o') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l734
+									}
+									position++
+								}
+							l752:
+								break
+							case 'g':
+								position++
+								if buffer[position] != rune('o') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l734
+								}
+								position++
+							case 'i':
+								position++
+								{
+									position754, tokenIndex754 := position, tokenIndex
+									if buffer[position] != rune('f') {
+										goto l755
+									}
+									position++
+									goto l754
+								l755:
+									position, tokenIndex = position754, tokenIndex754
+									if buffer[position] != rune('n') {
+										goto l734
+									}
+									position++
+									{
+										position756, tokenIndex756 := position, tokenIndex
+										if buffer[position] != rune('t') {
+											goto l757
+										}
+										position++
+										goto l756
+									l757:
+										position, tokenIndex = position756, tokenIndex756
+										if buffer[position] != rune('l') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+									}
+								l756:
+								}
+							l754:
+								break
+							case 'l':
+								position++
+								if buffer[position] != rune('o') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('g') {
+									goto l734
+								}
+								position++
+							case 'r':
+								position++
+								if buffer[position] != rune('e') {
+									goto l734
+								}
+								position++
+								{
+									switch buffer[position] {
+									case 's':
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+									case 't':
+										position++
+										if buffer[position] != rune('u') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('n') {
+											goto l734
+										}
+										position++
+									default:
+										if buffer[position] != rune('g') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('i') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('s') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('e') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+									}
+								}
+
+							case 's':
+								position++
+								{
+									switch buffer[position] {
+									case 'i':
+										position++
+										{
+											position760, tokenIndex760 := position, tokenIndex
+											if buffer[position] != rune('g') {
+												goto l761
+											}
+											position++
+											if buffer[position] != rune('n') {
+												goto l761
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l761
+											}
+											position++
+											if buffer[position] != rune('d') {
+												goto l761
+											}
+											position++
+											goto l760
+										l761:
+											position, tokenIndex = position760, tokenIndex760
+											if buffer[position] != rune('z') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('e') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('o') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('f') {
+												goto l734
+											}
+											position++
+										}
+									l760:
+										break
+									case 't':
+										position++
+										{
+											position762, tokenIndex762 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l763
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l763
+											}
+											position++
+											if buffer[position] != rune('i') {
+												goto l763
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l763
+											}
+											position++
+											goto l762
+										l763:
+											position, tokenIndex = position762, tokenIndex762
+											if buffer[position] != rune('r') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('u') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('c') {
+												goto l734
+											}
+											position++
+											if buffer[position] != rune('t') {
+												goto l734
+											}
+											position++
+										}
+									l762:
+										break
+									case 'w':
+										position++
+										if buffer[position] != rune('i') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('c') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('h') {
+											goto l734
+										}
+										position++
+									default:
+										if buffer[position] != rune('h') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('o') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('r') {
+											goto l734
+										}
+										position++
+										if buffer[position] != rune('t') {
+											goto l734
+										}
+										position++
+									}
+								}
+
+							case 't':
+								position++
+								if buffer[position] != rune('y') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('p') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('d') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l734
+								}
+								position++
+							case 'u':
+								position++
+								if buffer[position] != rune('n') {
+									goto l734
+								}
+								position++
+								{
+									position764, tokenIndex764 := position, tokenIndex
+									if buffer[position] != rune('i') {
+										goto l765
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l765
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l765
+									}
+									position++
+									goto l764
+								l765:
+									position, tokenIndex = position764, tokenIndex764
+									if buffer[position] != rune('s') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('g') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('d') {
+										goto l734
+									}
+									position++
+								}
+							l764:
+								break
+							case 'v':
+								position++
+								if buffer[position] != rune('o') {
+									goto l734
+								}
+								position++
+								{
+									position766, tokenIndex766 := position, tokenIndex
+									if buffer[position] != rune('i') {
+										goto l767
+									}
+									position++
+									if buffer[position] != rune('d') {
+										goto l767
+									}
+									position++
+									goto l766
+								l767:
+									position, tokenIndex = position766, tokenIndex766
+									if buffer[position] != rune('l') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('l') {
+										goto l734
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l734
+									}
+									position++
+								}
+							l766:
+								break
+							case 'w':
+								position++
+								if buffer[position] != rune('h') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('i') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('l') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l734
+								}
+								position++
+							default:
+								if buffer[position] != rune('a') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('u') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l734
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l734
+								}
+								position++
+							}
+						}
+
+						{
+							position768, tokenIndex768 := position, tokenIndex
+							if !_rules[ruleIdChar]() {
+								goto l768
+							}
+							goto l734
+						l768:
+							position, tokenIndex = position768, tokenIndex768
+						}
+						add(ruleKeyword, position735)
+					}
+					goto l732
+				l734:
+					position, tokenIndex = position734, tokenIndex734
+				}
+				{
+					position769 := position
+					{
+						switch buffer[position] {
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '\\':
+							if !_rules[ruleUniversalCharacter]() {
+								goto l732
+							}
+						case '_':
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l732
+							}
+							position++
+						}
+					}
+
+					add(ruleIdNondigit, position769)
+				}
+			l771:
+				{
+					position772, tokenIndex772 := position, tokenIndex
+					if !_rules[ruleIdChar]() {
+						goto l772
+					}
+					goto l771
+				l772:
+					position, tokenIndex = position772, tokenIndex772
+				}
+				if !_rules[ruleSpacing]() {
+					goto l732
+				}
+				add(ruleIdentifier, position733)
+			}
+			memoize(122, position732, tokenIndex732, true)
+			return true
+		l732:
+			memoize(122, position732, tokenIndex732, false)
+			position, tokenIndex = position732, tokenIndex732
+			return false
+		},
+		/* 123 IdNondigit <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('\\') UniversalCharacter) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([A-Z] '\\' '_') [a-z]))> */
+		nil,
+		/* 124 IdChar <- <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('\\') UniversalCharacter) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '\\' '_') [a-z]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{124, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position774, tokenIndex774 := position, tokenIndex
+			{
+				position775 := position
+				{
+					switch buffer[position] {
+					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+						position++
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						position++
+					case '\\':
+						if !_rules[ruleUniversalCharacter]() {
+							goto l774
+						}
+					case '_':
+						position++
+					default:
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l774
+						}
+						position++
+					}
+				}
+
+				add(ruleIdChar, position775)
+			}
+			memoize(124, position774, tokenIndex774, true)
+			return true
+		l774:
+			memoize(124, position774, tokenIndex774, false)
+			position, tokenIndex = position774, tokenIndex774
+			return false
+		},
+		/* 125 UniversalCharacter <- <('\\' (('u' HexQuad) / ('U' HexQuad HexQuad)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{125, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position777, tokenIndex777 := position, tokenIndex
+			{
+				position778 := position
+				if buffer[position] != rune('\\') {
+					goto l777
+				}
+				position++
+				{
+					position779, tokenIndex779 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l780
+					}
+					position++
+					if !_rules[ruleHexQuad]() {
+						goto l780
+					}
+					goto l779
+				l780:
+					position, tokenIndex = position779, tokenIndex779
+					if buffer[position] != rune('U') {
+						goto l777
+					}
+					position++
+					if !_rules[ruleHexQuad]() {
+						goto l777
+					}
+					if !_rules[ruleHexQuad]() {
+						goto l777
+					}
+				}
+			l779:
+				add(ruleUniversalCharacter, position778)
+			}
+			memoize(125, position777, tokenIndex777, true)
+			return true
+		l777:
+			memoize(125, position777, tokenIndex777, false)
+			position, tokenIndex = position777, tokenIndex777
+			return false
+		},
+		/* 126 HexQuad <- <(HexDigit HexDigit HexDigit HexDigit)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{126, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position781, tokenIndex781 := position, tokenIndex
+			{
+				position782 := position
+				if !_rules[ruleHexDigit]() {
+					goto l781
+				}
+				if !_rules[ruleHexDigit]() {
+					goto l781
+				}
+				if !_rules[ruleHexDigit]() {
+					goto l781
+				}
+				if !_rules[ruleHexDigit]() {
+					goto l781
+				}
+				add(ruleHexQuad, position782)
+			}
+			memoize(126, position781, tokenIndex781, true)
+			return true
+		l781:
+			memoize(126, position781, tokenIndex781, false)
+			position, tokenIndex = position781, tokenIndex781
+			return false
+		},
+		/* 127 Constant <- <(FloatConstant / IntegerConstant / CharacterConstant / EnumerationConstant)> */
+		nil,
+		/* 128 IntegerConstant <- <(((&('0') %fail([1-9]) (HexConstant / OctalConstant)) | (&('1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail('0') DecimalConstant)) IntegerSuffix? Spacing)> */
+		nil,
+		/* 129 DecimalConstant <- <([1-9] [0-9]*)> */
+		nil,
+		/* 130 OctalConstant <- <('0' [0-7]*)> */
+		nil,
+		/* 131 HexConstant <- <(HexPrefix HexDigit+)> */
+		nil,
+		/* 132 HexPrefix <- <('0' ('x' / 'X'))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{132, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position788, tokenIndex788 := position, tokenIndex
+			{
+				position789 := position
+				if buffer[position] != rune('0') {
+					goto l788
+				}
+				position++
+				{
+					position790, tokenIndex790 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l791
+					}
+					position++
+					goto l790
+				l791:
+					position, tokenIndex = position790, tokenIndex790
+					if buffer[position] != rune('X') {
+						goto l788
+					}
+					position++
+				}
+			l790:
+				add(ruleHexPrefix, position789)
+			}
+			memoize(132, position788, tokenIndex788, true)
+			return true
+		l788:
+			memoize(132, position788, tokenIndex788, false)
+			position, tokenIndex = position788, tokenIndex788
+			return false
+		},
+		/* 133 HexDigit <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-F] [a-f]) [0-9]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{133, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position792, tokenIndex792 := position, tokenIndex
+			{
+				position793 := position
+				{
+					switch buffer[position] {
+					case 'A', 'B', 'C', 'D', 'E', 'F':
+						position++
+					case 'a', 'b', 'c', 'd', 'e', 'f':
+						position++
+					default:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l792
+						}
+						position++
+					}
+				}
+
+				add(ruleHexDigit, position793)
+			}
+			memoize(133, position792, tokenIndex792, true)
+			return true
+		l792:
+			memoize(133, position792, tokenIndex792, false)
+			position, tokenIndex = position792, tokenIndex792
+			return false
+		},
+		/* 134 IntegerSuffix <- <((('u' / 'U') Lsuffix?) / (Lsuffix ('u' / 'U')?))> */
+		nil,
+		/* 135 Lsuffix <- <((&('l') %fail('LL') (('l' 'l') / ('l' / 'L'))) | (&('L') %fail('ll') (('L' 'L') / ('l' / 'L'))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{135, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position796, tokenIndex796 := position, tokenIndex
+			{
+				position797 := position
+				{
+					switch buffer[position] {
+					case 'l':
+						{
+							position799, tokenIndex799 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l800
+							}
+							position++
+							if buffer[position] != rune('l') {
+								goto l800
+							}
+							position++
+							goto l799
+						l800:
+							position, tokenIndex = position799, tokenIndex799
+							{
+								position801, tokenIndex801 := position, tokenIndex
+								if buffer[position] != rune('l') {
+									goto l802
+								}
+								position++
+								goto l801
+							l802:
+								position, tokenIndex = position801, tokenIndex801
+								if buffer[position] != rune('L') {
+									goto l796
+								}
+								position++
+							}
+						l801:
+						}
+					l799:
+						break
+					default:
+						{
+							position803, tokenIndex803 := position, tokenIndex
+							if buffer[position] != rune('L') {
+								goto l804
+							}
+							position++
+							if buffer[position] != rune('L') {
+								goto l804
+							}
+							position++
+							goto l803
+						l804:
+							position, tokenIndex = position803, tokenIndex803
+							{
+								position805, tokenIndex805 := position, tokenIndex
+								if buffer[position] != rune('l') {
+									goto l806
+								}
+								position++
+								goto l805
+							l806:
+								position, tokenIndex = position805, tokenIndex805
+								if buffer[position] != rune('L') {
+									goto l796
+								}
+								position++
+							}
+						l805:
+						}
+					l803:
+						break
+					}
+				}
+
+				add(ruleLsuffix, position797)
+			}
+			memoize(135, position796, tokenIndex796, true)
+			return true
+		l796:
+			memoize(135, position796, tokenIndex796, false)
+			position, tokenIndex = position796, tokenIndex796
+			return false
+		},
+		/* 136 FloatConstant <- <((DecimalFloatConstant / HexFloatConstant) FloatSuffix? Spacing)> */
+		nil,
+		/* 137 DecimalFloatConstant <- <((Fraction Exponent?) / ([0-9]+ Exponent))> */
+		nil,
+		/* 138 HexFloatConstant <- <(HexPrefix ((HexFraction BinaryExponent?) / (HexDigit+ BinaryExponent)))> */
+		nil,
+		/* 139 Fraction <- <(([0-9]* '.' [0-9]+) / ([0-9]+ '.'))> */
+		nil,
+		/* 140 HexFraction <- <((HexDigit* '.' HexDigit+) / (HexDigit+ '.'))> */
+		nil,
+		/* 141 Exponent <- <(('e' / 'E') ('+' / '-')? [0-9]+)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{141, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position812, tokenIndex812 := position, tokenIndex
+			{
+				position813 := position
+				{
+					position814, tokenIndex814 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l815
+					}
+					position++
+					goto l814
+				l815:
+					position, tokenIndex = position814, tokenIndex814
+					if buffer[position] != rune('E') {
+						goto l812
+					}
+					position++
+				}
+			l814:
+				{
+					position816, tokenIndex816 := position, tokenIndex
+					{
+						position818, tokenIndex818 := position, tokenIndex
+						if buffer[position] != rune('+') {
+							goto l819
+						}
+						position++
+						goto l818
+					l819:
+						position, tokenIndex = position818, tokenIndex818
+						if buffer[position] != rune('-') {
+							goto l816
+						}
+						position++
+					}
+				l818:
+					goto l817
+				l816:
+					position, tokenIndex = position816, tokenIndex816
+				}
+			l817:
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l812
+				}
+				position++
+			l820:
+				{
+					position821, tokenIndex821 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l821
+					}
+					position++
+					goto l820
+				l821:
+					position, tokenIndex = position821, tokenIndex821
+				}
+				add(ruleExponent, position813)
+			}
+			memoize(141, position812, tokenIndex812, true)
+			return true
+		l812:
+			memoize(141, position812, tokenIndex812, false)
+			position, tokenIndex = position812, tokenIndex812
+			return false
+		},
+		/* 142 BinaryExponent <- <(('p' / 'P') ('+' / '-')? [0-9]+)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{142, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position822, tokenIndex822 := position, tokenIndex
+			{
+				position823 := position
+				{
+					position824, tokenIndex824 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l825
+					}
+					position++
+					goto l824
+				l825:
+					position, tokenIndex = position824, tokenIndex824
+					if buffer[position] != rune('P') {
+						goto l822
+					}
+					position++
+				}
+			l824:
+				{
+					position826, tokenIndex826 := position, tokenIndex
+					{
+						position828, tokenIndex828 := position, tokenIndex
+						if buffer[position] != rune('+') {
+							goto l829
+						}
+						position++
+						goto l828
+					l829:
+						position, tokenIndex = position828, tokenIndex828
+						if buffer[position] != rune('-') {
+							goto l826
+						}
+						position++
+					}
+				l828:
+					goto l827
+				l826:
+					position, tokenIndex = position826, tokenIndex826
+				}
+			l827:
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l822
+				}
+				position++
+			l830:
+				{
+					position831, tokenIndex831 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l831
+					}
+					position++
+					goto l830
+				l831:
+					position, tokenIndex = position831, tokenIndex831
+				}
+				add(ruleBinaryExponent, position823)
+			}
+			memoize(142, position822, tokenIndex822, true)
+			return true
+		l822:
+			memoize(142, position822, tokenIndex822, false)
+			position, tokenIndex = position822, tokenIndex822
+			return false
+		},
+		/* 143 FloatSuffix <- <((&('F') 'F') | (&('L') 'L') | (&('l') 'l') | (&('f') %fail('F' 'L' 'l') 'f'))> */
+		nil,
+		/* 144 EnumerationConstant <- <Identifier> */
+		func() bool {
+			memoized, ok := memoization[memoKey{144, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position833, tokenIndex833 := position, tokenIndex
+			{
+				position834 := position
+				if !_rules[ruleIdentifier]() {
+					goto l833
+				}
+				add(ruleEnumerationConstant, position834)
+			}
+			memoize(144, position833, tokenIndex833, true)
+			return true
+		l833:
+			memoize(144, position833, tokenIndex833, false)
+			position, tokenIndex = position833, tokenIndex833
+			return false
+		},
+		/* 145 CharacterConstant <- <(((&('U') 'U') | (&('u') 'u') | (&('L') %fail('U' 'u') 'L'))? '\'' Char* '\'' Spacing)> */
+		nil,
+		/* 146 Char <- <(Escape / (!((&('\n') '\n') | (&('\\') '\\') | (&('\'') %fail('\n' '\\') '\'')) .))> */
+		nil,
+		/* 147 Escape <- <(SimpleEscape / OctalEscape / HexEscape / UniversalCharacter)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{147, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position837, tokenIndex837 := position, tokenIndex
+			{
+				position838 := position
+				{
+					position839, tokenIndex839 := position, tokenIndex
+					{
+						position841 := position
+						if buffer[position] != rune('\\') {
+							goto l840
+						}
+						position++
+						{
+							switch buffer[position] {
+							case '"':
+								position++
+							case '%':
+								position++
+							case '?':
+								position++
+							case '\\':
+								position++
+							case 'a':
+								position++
+							case 'b':
+								position++
+							case 'f':
+								position++
+							case 'n':
+								position++
+							case 'r':
+								position++
+							case 't':
+								position++
+							case 'v':
+								position++
+							default:
+								if buffer[position] != rune('\'') {
+									goto l840
+								}
+								position++
+							}
+						}
+
+						add(ruleSimpleEscape, position841)
+					}
+					goto l839
+				l840:
+					position, tokenIndex = position839, tokenIndex839
+					{
+						position844 := position
+						if buffer[position] != rune('\\') {
+							goto l843
+						}
+						position++
+						if c := buffer[position]; c < rune('0') || c > rune('7') {
+							goto l843
+						}
+						position++
+						{
+							position845, tokenIndex845 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('7') {
+								goto l845
+							}
+							position++
+							goto l846
+						l845:
+							position, tokenIndex = position845, tokenIndex845
+						}
+					l846:
+						{
+							position847, tokenIndex847 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('7') {
+								goto l847
+							}
+							position++
+							goto l848
+						l847:
+							position, tokenIndex = position847, tokenIndex847
+						}
+					l848:
+						add(ruleOctalEscape, position844)
+					}
+					goto l839
+				l843:
+					position, tokenIndex = position839, tokenIndex839
+					{
+						position850 := position
+						if buffer[position] != rune('\\') {
+							goto l849
+						}
+						position++
+						if buffer[position] != rune('x') {
+							goto l849
+						}
+						position++
+						if !_rules[ruleHexDigit]() {
+							goto l849
+						}
+					l851:
+						{
+							position852, tokenIndex852 := position, tokenIndex
+							if !_rules[ruleHexDigit]() {
+								goto l852
+							}
+							goto l851
+						l852:
+							position, tokenIndex = position852, tokenIndex852
+						}
+						add(ruleHexEscape, position850)
+					}
+					goto l839
+				l849:
+					position, tokenIndex = position839, tokenIndex839
+					if !_rules[ruleUniversalCharacter]() {
+						goto l837
+					}
+				}
+			l839:
+				add(ruleEscape, position838)
+			}
+			memoize(147, position837, tokenIndex837, true)
+			return true
+		l837:
+			memoize(147, position837, tokenIndex837, false)
+			position, tokenIndex = position837, tokenIndex837
+			return false
+		},
+		/* 148 SimpleEscape <- <('\\' ((&('"') '"') | (&('%') '%') | (&('?') '?') | (&('\\') '\\') | (&('a') 'a') | (&('b') 'b') | (&('f') 'f') | (&('n') 'n') | (&('r') 'r') | (&('t') 't') | (&('v') 'v') | (&('\'') %fail('"' '%' '?' '\\' 'a' 'b' 'f' 'n' 'r' 't' 'v') '\'')))> */
+		nil,
+		/* 149 OctalEscape <- <('\\' [0-7] [0-7]? [0-7]?)> */
+		nil,
+		/* 150 HexEscape <- <('\\' 'x' HexDigit+)> */
+		nil,
+		/* 151 StringLiteral <- <((('u' '8') / ((&('U') 'U') | (&('u') 'u') | (&('L') %fail('U' 'u') 'L')))? '"' StringChar* '"' Spacing)+> */
+		func() bool {
+			memoized, ok := memoization[memoKey{151, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position856, tokenIndex856 := position, tokenIndex
+			{
+				position857 := position
+				{
+					position860, tokenIndex860 := position, tokenIndex
+					{
+						position862, tokenIndex862 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l863
+						}
+						position++
+						if buffer[position] != rune('8') {
+							goto l863
+						}
+						position++
+						goto l862
+					l863:
+						position, tokenIndex = position862, tokenIndex862
+						{
+							switch buffer[position] {
+							case 'U':
+								position++
+							case 'u':
+								position++
+							default:
+								if buffer[position] != rune('L') {
+									goto l860
+								}
+								position++
+							}
+						}
+
+					}
+				l862:
+					goto l861
+				l860:
+					position, tokenIndex = position860, tokenIndex860
+				}
+			l861:
+				if buffer[position] != rune('"') {
+					goto l856
+				}
+				position++
+			l865:
+				{
+					position866, tokenIndex866 := position, tokenIndex
+					{
+						position867 := position
+						{
+							position868, tokenIndex868 := position, tokenIndex
+							if !_rules[ruleEscape]() {
+								goto l869
+							}
+							goto l868
+						l869:
+							position, tokenIndex = position868, tokenIndex868
+							{
+								position870, tokenIndex870 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '\n':
+										position++
+									case '\\':
+										position++
+									default:
+										if buffer[position] != rune('"') {
+											goto l870
+										}
+										position++
+									}
+								}
+
+								goto l866
+							l870:
+								position, tokenIndex = position870, tokenIndex870
+							}
+							if !matchDot() {
+								goto l866
+							}
+						}
+					l868:
+						add(ruleStringChar, position867)
+					}
+					goto l865
+				l866:
+					position, tokenIndex = position866, tokenIndex866
+				}
+				if buffer[position] != rune('"') {
+					goto l856
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l856
+				}
+			l858:
+				{
+					position859, tokenIndex859 := position, tokenIndex
+					{
+						position872, tokenIndex872 := position, tokenIndex
+						{
+							position874, tokenIndex874 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l875
+							}
+							position++
+							if buffer[position] != rune('8') {
+								goto l875
+							}
+							position++
+							goto l874
+						l875:
+							position, tokenIndex = position874, tokenIndex874
+							{
+								switch buffer[position] {
+								case 'U':
+									position++
+								case 'u':
+									position++
+								default:
+									if buffer[position] != rune('L') {
+										goto l872
+									}
+									position++
+								}
+							}
+
+						}
+					l874:
+						goto l873
+					l872:
+						position, tokenIndex = position872, tokenIndex872
+					}
+				l873:
+					if buffer[position] != rune('"') {
+						goto l859
+					}
+					position++
+				l877:
+					{
+						position878, tokenIndex878 := position, tokenIndex
+						{
+							position879 := position
+							{
+								position880, tokenIndex880 := position, tokenIndex
+								if !_rules[ruleEscape]() {
+									goto l881
+								}
+								goto l880
+							l881:
+								position, tokenIndex = position880, tokenIndex880
+								{
+									position882, tokenIndex882 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '\n':
+											position++
+										case '\\':
+											position++
+										default:
+											if buffer[position] != rune('"') {
+												goto l882
+											}
+											position++
+										}
+									}
+
+									goto l878
+								l882:
+									position, tokenIndex = position882, tokenIndex882
+								}
+								if !matchDot() {
+									goto l878
+								}
+							}
+						l880:
+							add(ruleStringChar, position879)
+						}
+						goto l877
+					l878:
+						position, tokenIndex = position878, tokenIndex878
+					}
+					if buffer[position] != rune('"') {
+						goto l859
+					}
+					position++
+					if !_rules[ruleSpacing]() {
+						goto l859
+					}
+					goto l858
+				l859:
+					position, tokenIndex = position859, tokenIndex859
+				}
+				add(ruleStringLiteral, position857)
+			}
+			memoize(151, position856, tokenIndex856, true)
+			return true
+		l856:
+			memoize(151, position856, tokenIndex856, false)
+			position, tokenIndex = position856, tokenIndex856
+			return false
+		},
+		/* 152 StringChar <- <(Escape / (!((&('\n') '\n') | (&('\\') '\\') | (&('"') %fail('\n' '\\') '"')) .))> */
+		nil,
+		/* 153 LBRK <- <('[' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{153, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position885, tokenIndex885 := position, tokenIndex
+			{
+				position886 := position
+				if buffer[position] != rune('[') {
+					goto l885
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l885
+				}
+				add(ruleLBRK, position886)
+			}
+			memoize(153, position885, tokenIndex885, true)
+			return true
+		l885:
+			memoize(153, position885, tokenIndex885, false)
+			position, tokenIndex = position885, tokenIndex885
+			return false
+		},
+		/* 154 RBRK <- <(']' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{154, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position887, tokenIndex887 := position, tokenIndex
+			{
+				position888 := position
+				if buffer[position] != rune(']') {
+					goto l887
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l887
+				}
+				add(ruleRBRK, position888)
+			}
+			memoize(154, position887, tokenIndex887, true)
+			return true
+		l887:
+			memoize(154, position887, tokenIndex887, false)
+			position, tokenIndex = position887, tokenIndex887
+			return false
+		},
+		/* 155 LPAR <- <('(' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{155, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position889, tokenIndex889 := position, tokenIndex
+			{
+				position890 := position
+				if buffer[position] != rune('(') {
+					goto l889
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l889
+				}
+				add(ruleLPAR, position890)
+			}
+			memoize(155, position889, tokenIndex889, true)
+			return true
+		l889:
+			memoize(155, position889, tokenIndex889, false)
+			position, tokenIndex = position889, tokenIndex889
+			return false
+		},
+		/* 156 RPAR <- <(')' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{156, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position891, tokenIndex891 := position, tokenIndex
+			{
+				position892 := position
+				if buffer[position] != rune(')') {
+					goto l891
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l891
+				}
+				add(ruleRPAR, position892)
+			}
+			memoize(156, position891, tokenIndex891, true)
+			return true
+		l891:
+			memoize(156, position891, tokenIndex891, false)
+			position, tokenIndex = position891, tokenIndex891
+			return false
+		},
+		/* 157 LWING <- <('{' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{157, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position893, tokenIndex893 := position, tokenIndex
+			{
+				position894 := position
+				if buffer[position] != rune('{') {
+					goto l893
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l893
+				}
+				add(ruleLWING, position894)
+			}
+			memoize(157, position893, tokenIndex893, true)
+			return true
+		l893:
+			memoize(157, position893, tokenIndex893, false)
+			position, tokenIndex = position893, tokenIndex893
+			return false
+		},
+		/* 158 RWING <- <('}' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{158, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position895, tokenIndex895 := position, tokenIndex
+			{
+				position896 := position
+				if buffer[position] != rune('}') {
+					goto l895
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l895
+				}
+				add(ruleRWING, position896)
+			}
+			memoize(158, position895, tokenIndex895, true)
+			return true
+		l895:
+			memoize(158, position895, tokenIndex895, false)
+			position, tokenIndex = position895, tokenIndex895
+			return false
+		},
+		/* 159 DOT <- <('.' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{159, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position897, tokenIndex897 := position, tokenIndex
+			{
+				position898 := position
+				if buffer[position] != rune('.') {
+					goto l897
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l897
+				}
+				add(ruleDOT, position898)
+			}
+			memoize(159, position897, tokenIndex897, true)
+			return true
+		l897:
+			memoize(159, position897, tokenIndex897, false)
+			position, tokenIndex = position897, tokenIndex897
+			return false
+		},
+		/* 160 PTR <- <('-' '>' Spacing)> */
+		nil,
+		/* 161 INC <- <('+' '+' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{161, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position900, tokenIndex900 := position, tokenIndex
+			{
+				position901 := position
+				if buffer[position] != rune('+') {
+					goto l900
+				}
+				position++
+				if buffer[position] != rune('+') {
+					goto l900
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l900
+				}
+				add(ruleINC, position901)
+			}
+			memoize(161, position900, tokenIndex900, true)
+			return true
+		l900:
+			memoize(161, position900, tokenIndex900, false)
+			position, tokenIndex = position900, tokenIndex900
+			return false
+		},
+		/* 162 DEC <- <('-' '-' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{162, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position902, tokenIndex902 := position, tokenIndex
+			{
+				position903 := position
+				if buffer[position] != rune('-') {
+					goto l902
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l902
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l902
+				}
+				add(ruleDEC, position903)
+			}
+			memoize(162, position902, tokenIndex902, true)
+			return true
+		l902:
+			memoize(162, position902, tokenIndex902, false)
+			position, tokenIndex = position902, tokenIndex902
+			return false
+		},
+		/* 163 AND <- <('&' !'&' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{163, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position904, tokenIndex904 := position, tokenIndex
+			{
+				position905 := position
+				if buffer[position] != rune('&') {
+					goto l904
+				}
+				position++
+				{
+					position906, tokenIndex906 := position, tokenIndex
+					if buffer[position] != rune('&') {
+						goto l906
+					}
+					position++
+					goto l904
+				l906:
+					position, tokenIndex = position906, tokenIndex906
+				}
+				if !_rules[ruleSpacing]() {
+					goto l904
+				}
+				add(ruleAND, position905)
+			}
+			memoize(163, position904, tokenIndex904, true)
+			return true
+		l904:
+			memoize(163, position904, tokenIndex904, false)
+			position, tokenIndex = position904, tokenIndex904
+			return false
+		},
+		/* 164 STAR <- <('*' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{164, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position907, tokenIndex907 := position, tokenIndex
+			{
+				position908 := position
+				if buffer[position] != rune('*') {
+					goto l907
+				}
+				position++
+				{
+					position909, tokenIndex909 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l909
+					}
+					position++
+					goto l907
+				l909:
+					position, tokenIndex = position909, tokenIndex909
+				}
+				if !_rules[ruleSpacing]() {
+					goto l907
+				}
+				add(ruleSTAR, position908)
+			}
+			memoize(164, position907, tokenIndex907, true)
+			return true
+		l907:
+			memoize(164, position907, tokenIndex907, false)
+			position, tokenIndex = position907, tokenIndex907
+			return false
+		},
+		/* 165 PLUS <- <('+' !('+' / '=') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{165, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position910, tokenIndex910 := position, tokenIndex
+			{
+				position911 := position
+				if buffer[position] != rune('+') {
+					goto l910
+				}
+				position++
+				{
+					position912, tokenIndex912 := position, tokenIndex
+					{
+						position913, tokenIndex913 := position, tokenIndex
+						if buffer[position] != rune('+') {
+							goto l914
+						}
+						position++
+						goto l913
+					l914:
+						position, tokenIndex = position913, tokenIndex913
+						if buffer[position] != rune('=') {
+							goto l912
+						}
+						position++
+					}
+				l913:
+					goto l910
+				l912:
+					position, tokenIndex = position912, tokenIndex912
+				}
+				if !_rules[ruleSpacing]() {
+					goto l910
+				}
+				add(rulePLUS, position911)
+			}
+			memoize(165, position910, tokenIndex910, true)
+			return true
+		l910:
+			memoize(165, position910, tokenIndex910, false)
+			position, tokenIndex = position910, tokenIndex910
+			return false
+		},
+		/* 166 MINUS <- <('-' !((&('=') '=') | (&('>') '>') | (&('-') %fail('=' '>') '-')) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{166, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position915, tokenIndex915 := position, tokenIndex
+			{
+				position916 := position
+				if buffer[position] != rune('-') {
+					goto l915
+				}
+				position++
+				{
+					position917, tokenIndex917 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '=':
+							position++
+						case '>':
+							position++
+						default:
+							if buffer[position] != rune('-') {
+								goto l917
+							}
+							position++
+						}
+					}
+
+					goto l915
+				l917:
+					position, tokenIndex = position917, tokenIndex917
+				}
+				if !_rules[ruleSpacing]() {
+					goto l915
+				}
+				add(ruleMINUS, position916)
+			}
+			memoize(166, position915, tokenIndex915, true)
+			return true
+		l915:
+			memoize(166, position915, tokenIndex915, false)
+			position, tokenIndex = position915, tokenIndex915
+			return false
+		},
+		/* 167 TILDA <- <('~' Spacing)> */
+		nil,
+		/* 168 BANG <- <('!' !'=' Spacing)> */
+		nil,
+		/* 169 DIV <- <('/' !'=' Spacing)> */
+		nil,
+		/* 170 MOD <- <('%' !('=' / '>') Spacing)> */
+		nil,
+		/* 171 LEFT <- <('<' '<' !'=' Spacing)> */
+		nil,
+		/* 172 RIGHT <- <('>' '>' !'=' Spacing)> */
+		nil,
+		/* 173 LT <- <('<' !'=' Spacing)> */
+		nil,
+		/* 174 GT <- <('>' !'=' Spacing)> */
+		nil,
+		/* 175 LE <- <('<' '=' Spacing)> */
+		nil,
+		/* 176 GE <- <('>' '=' Spacing)> */
+		nil,
+		/* 177 EQUEQU <- <('=' '=' Spacing)> */
+		nil,
+		/* 178 BANGEQU <- <('!' '=' Spacing)> */
+		nil,
+		/* 179 HAT <- <('^' !'=' Spacing)> */
+		nil,
+		/* 180 OR <- <('|' !'=' Spacing)> */
+		nil,
+		/* 181 ANDAND <- <('&' '&' Spacing)> */
+		nil,
+		/* 182 OROR <- <('|' '|' Spacing)> */
+		nil,
+		/* 183 QUERY <- <('?' Spacing)> */
+		nil,
+		/* 184 COLON <- <(':' !'>' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{184, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position936, tokenIndex936 := position, tokenIndex
+			{
+				position937 := position
+				if buffer[position] != rune(':') {
+					goto l936
+				}
+				position++
+				{
+					position938, tokenIndex938 := position, tokenIndex
+					if buffer[position] != rune('>') {
+						goto l938
+					}
+					position++
+					goto l936
+				l938:
+					position, tokenIndex = position938, tokenIndex938
+				}
+				if !_rules[ruleSpacing]() {
+					goto l936
+				}
+				add(ruleCOLON, position937)
+			}
+			memoize(184, position936, tokenIndex936, true)
+			return true
+		l936:
+			memoize(184, position936, tokenIndex936, false)
+			position, tokenIndex = position936, tokenIndex936
+			return false
+		},
+		/* 185 SEMI <- <(';' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{185, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position939, tokenIndex939 := position, tokenIndex
+			{
+				position940 := position
+				if buffer[position] != rune(';') {
+					goto l939
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l939
+				}
+				add(ruleSEMI, position940)
+			}
+			memoize(185, position939, tokenIndex939, true)
+			return true
+		l939:
+			memoize(185, position939, tokenIndex939, false)
+			position, tokenIndex = position939, tokenIndex939
+			return false
+		},
+		/* 186 ELLIPSIS <- <('.' '.' '.' Spacing)> */
+		nil,
+		/* 187 EQU <- <('=' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{187, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position942, tokenIndex942 := position, tokenIndex
+			{
+				position943 := position
+				if buffer[position] != rune('=') {
+					goto l942
+				}
+				position++
+				{
+					position944, tokenIndex944 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l944
+					}
+					position++
+					goto l942
+				l944:
+					position, tokenIndex = position944, tokenIndex944
+				}
+				if !_rules[ruleSpacing]() {
+					goto l942
+				}
+				add(ruleEQU, position943)
+			}
+			memoize(187, position942, tokenIndex942, true)
+			return true
+		l942:
+			memoize(187, position942, tokenIndex942, false)
+			position, tokenIndex = position942, tokenIndex942
+			return false
+		},
+		/* 188 STAREQU <- <('*' '=' Spacing)> */
+		nil,
+		/* 189 DIVEQU <- <('/' '=' Spacing)> */
+		nil,
+		/* 190 MODEQU <- <('%' '=' Spacing)> */
+		nil,
+		/* 191 PLUSEQU <- <('+' '=' Spacing)> */
+		nil,
+		/* 192 MINUSEQU <- <('-' '=' Spacing)> */
+		nil,
+		/* 193 LEFTEQU <- <('<' '<' '=' Spacing)> */
+		nil,
+		/* 194 RIGHTEQU <- <('>' '>' '=' Spacing)> */
+		nil,
+		/* 195 ANDEQU <- <('&' '=' Spacing)> */
+		nil,
+		/* 196 HATEQU <- <('^' '=' Spacing)> */
+		nil,
+		/* 197 OREQU <- <('|' '=' Spacing)> */
+		nil,
+		/* 198 COMMA <- <(',' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{198, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position955, tokenIndex955 := position, tokenIndex
+			{
+				position956 := position
+				if buffer[position] != rune(',') {
+					goto l955
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l955
+				}
+				add(ruleCOMMA, position956)
+			}
+			memoize(198, position955, tokenIndex955, true)
+			return true
+		l955:
+			memoize(198, position955, tokenIndex955, false)
+			position, tokenIndex = position955, tokenIndex955
+			return false
+		},
+		/* 199 EOT <- <!.> */
+		nil,
+	}
+	if p.maxDepth > 0 {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule := rule
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
@@ -2,10 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build grammars
-// +build grammars
-
-package main
+package c
 
 import (
 	"fmt"
@@ -87,7 +84,7 @@ func TestCParsing_Expressions5(t *testing.T) {
 }
 
 func TestCParsing_Expressions6(t *testing.T) {
-	parseC_4t(t, `int a(){return (in)0;}`)
+	parseC_4t(t, `typedef int in; int a(){return (in)0;}`)
 }
 
 func TestCParsing_Expressions7(t *testing.T) {
@@ -96,11 +93,11 @@ func TestCParsing_Expressions7(t *testing.T) {
 }
 
 func TestCParsing_Cast0(t *testing.T) {
-	parseC_4t(t, `int a(){(cast)0;}`)
+	parseC_4t(t, `typedef int cast; int a(){(cast)0;}`)
 }
 
 func TestCParsing_Cast1(t *testing.T) {
-	parseC_4t(t, `int a(){(m*)(rsp);}`)
+	parseC_4t(t, `typedef struct m m; int a(){(m*)(rsp);}`)
 	parseC_4t(t, `int a(){(struct m*)(rsp);}`)
 }
 
@@ -158,6 +155,9 @@ func TestCParsing_Long(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping c parsing long test")
 	}
+	if _, err := os.Stat("c/"); err != nil {
+		t.Skip("skipping c parsing long test without sources in c/")
+	}
 
 	var walk func(name string)
 	walk = func(name string) {
@@ -213,5 +213,57 @@ func TestCParsing_Long(t *testing.T) {
 }
 
 func TestCParsing_WideString(t *testing.T) {
-	parseC_4t(t, `wchar_t *msg = L"Hello";`)
+	parseC_4t(t, `typedef int wchar_t; wchar_t *msg = L"Hello";`)
+}
+
+func hasRule(c *C, rule pegRule) bool {
+	for _, token := range c.Tokens() {
+		if token.pegRule == rule {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCParsing_Typedef(t *testing.T) {
+	c := parseC_4t(t, `typedef int T; int a(){ T * x; }`)
+	if !hasRule(c, ruleDeclaration) || hasRule(c, ruleMultiplicativeExpression) {
+		t.Error("T * x should be a declaration")
+	}
+	c = parseC_4t(t, `int T, x; int a(){ T * x; }`)
+	if !hasRule(c, ruleMultiplicativeExpression) {
+		t.Error("T * x should be a multiplication")
+	}
+	c = parseC_4t(t, `typedef int T; int a(int b){ return (T)-b; }`)
+	if !hasRule(c, ruleTypeName) {
+		t.Error("(T)-b should be a cast")
+	}
+	c = parseC_4t(t, `int T, b; int a(){ return (T)-b; }`)
+	if hasRule(c, ruleTypeName) {
+		t.Error("(T)-b should be a subtraction")
+	}
+	parseC_4t(t, `typedef int (*F)(int x), G[2]; F f; G g; int a(){ x * y; }`)
+	noParseC_4t(t, `typedef int (*F)(int x); x y;`)
+	noParseC_4t(t, `int T; T x;`)
+
+	c = &C{Buffer: `size_t n;`}
+	c.Init()
+	c.Typedef("size_t")
+	if err := c.Parse(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCParsing_C11(t *testing.T) {
+	parseC_4t(t, `_Static_assert(sizeof(int) == 4, "int");
+struct s { _Static_assert(1, u8"member"); union { int a; float b; }; };
+_Thread_local _Alignas(16) int aligned;
+_Alignas(long) char c;
+_Atomic(int) counter;
+_Atomic int flag;
+_Noreturn void stop(void);
+int a(){
+	return _Generic(counter, int: 1, default: 0) + _Alignof(int) + u'x' + U"y"[0];
+}`)
+	noParseC_4t(t, `int _Generic;`)
 }
@@ -2,20 +2,24 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build grammars
-// +build grammars
+//go:generate go run github.com/pointlander/peg -switch -inline calculator.peg
 
-package main
+// Package calculator computes arithmetic expressions in the actions of the
+// parser generated from calculator.peg.
+package calculator
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"math/big"
+	"strconv"
 )
 
 type Type uint8
 
 const (
-	TypeNumber Type = iota
-	TypeNegation
+	TypeNegation Type = iota
 	TypeAdd
 	TypeSubtract
 	TypeMultiply
@@ -24,82 +28,136 @@ const (
 	TypeExponentiation
 )
 
-type ByteCode struct {
-	T     Type
-	Value *big.Int
-}
-
-func (code *ByteCode) String() string {
-	switch code.T {
-	case TypeNumber:
-		return code.Value.String()
-	case TypeAdd:
-		return "+"
-	case TypeNegation, TypeSubtract:
-		return "-"
-	case TypeMultiply:
-		return "*"
-	case TypeDivide:
-		return "/"
-	case TypeModulus:
-		return "%"
-	case TypeExponentiation:
-		return "^"
-	}
-	return ""
-}
+// ErrDivisionByZero is the error of a division or modulus by zero.
+var ErrDivisionByZero = errors.New("division by zero")
 
+// Expression computes the value of an expression in the actions of the
+// grammar, with integers of any size or, if Float is set, with float64. The
+// first error of the actions stops the computation and is returned with the
+// value.
 type Expression struct {
-	Code []ByteCode
-	Top  int
+	Float  bool
+	ints   []*big.Int
+	floats []float64
+	err    error
 }
 
-func (e *Expression) Init(expression string) {
-	e.Code = make([]ByteCode, len(expression))
+func (e *Expression) Init() {
+	e.ints, e.floats, e.err = e.ints[:0], e.floats[:0], nil
 }
 
-func (e *Expression) AddOperator(operator Type) {
-	code, top := e.Code, e.Top
-	e.Top++
-	code[top].T = operator
+func (e *Expression) AddValue(value string) {
+	if e.err != nil {
+		return
+	}
+	if e.Float {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			e.err = err
+			return
+		}
+		e.floats = append(e.floats, f)
+		return
+	}
+	i, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		e.err = fmt.Errorf("%v is not an integer", value)
+		return
+	}
+	e.ints = append(e.ints, i)
 }
 
-func (e *Expression) AddValue(value string) {
-	code, top := e.Code, e.Top
-	e.Top++
-	code[top].Value = new(big.Int)
-	code[top].Value.SetString(value, 10)
+func (e *Expression) AddOperator(operator Type) {
+	if e.err != nil {
+		return
+	}
+	if e.Float {
+		e.floats, e.err = applyFloat(e.floats, operator)
+	} else {
+		e.ints, e.err = applyInt(e.ints, operator)
+	}
 }
 
-func (e *Expression) Evaluate() *big.Int {
-	stack, top := make([]big.Int, len(e.Code)), 0
-	for _, code := range e.Code[0:e.Top] {
-		switch code.T {
-		case TypeNumber:
-			stack[top].Set(code.Value)
-			top++
-			continue
-		case TypeNegation:
-			a := &stack[top-1]
-			a.Neg(a)
-			continue
+func applyInt(stack []*big.Int, operator Type) ([]*big.Int, error) {
+	if operator == TypeNegation {
+		a := stack[len(stack)-1]
+		a.Neg(a)
+		return stack, nil
+	}
+	a, b := stack[len(stack)-2], stack[len(stack)-1]
+	stack = stack[:len(stack)-1]
+	switch operator {
+	case TypeAdd:
+		a.Add(a, b)
+	case TypeSubtract:
+		a.Sub(a, b)
+	case TypeMultiply:
+		a.Mul(a, b)
+	case TypeDivide, TypeModulus:
+		if b.Sign() == 0 {
+			return stack, ErrDivisionByZero
 		}
-		a, b := &stack[top-2], &stack[top-1]
-		top--
-		switch code.T {
-		case TypeAdd:
-			a.Add(a, b)
-		case TypeSubtract:
-			a.Sub(a, b)
-		case TypeMultiply:
-			a.Mul(a, b)
-		case TypeDivide:
+		if operator == TypeDivide {
 			a.Div(a, b)
-		case TypeModulus:
+		} else {
 			a.Mod(a, b)
-		case TypeExponentiation:
-			a.Exp(a, b, nil)
 		}
+	case TypeExponentiation:
+		if b.Sign() < 0 {
+			return stack, fmt.Errorf("negative exponent %v", b)
+		}
+		a.Exp(a, b, nil)
+	}
+	return stack, nil
+}
+
+func applyFloat(stack []float64, operator Type) ([]float64, error) {
+	if operator == TypeNegation {
+		stack[len(stack)-1] = -stack[len(stack)-1]
+		return stack, nil
+	}
+	a, b := &stack[len(stack)-2], stack[len(stack)-1]
+	stack = stack[:len(stack)-1]
+	switch operator {
+	case TypeAdd:
+		*a += b
+	case TypeSubtract:
+		*a -= b
+	case TypeMultiply:
+		*a *= b
+	case TypeDivide, TypeModulus:
+		if b == 0 {
+			return stack, ErrDivisionByZero
+		}
+		if operator == TypeDivide {
+			*a /= b
+		} else {
+			*a = math.Mod(*a, b)
+		}
+	case TypeExponentiation:
+		*a = math.Pow(*a, b)
+	}
+	return stack, nil
+}
+
+// Int returns the integer value of the expression.
+func (e *Expression) Int() (*big.Int, error) {
+	if e.Float {
+		return nil, errors.New("the expression was computed with floats")
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.ints[0], nil
+}
+
+// Float64 returns the value of the expression computed with Float set.
+func (e *Expression) Float64() (float64, error) {
+	if !e.Float {
+		return 0, errors.New("the expression was computed with integers")
+	}
+	if e.err != nil {
+		return 0, e.err
 	}
-	return &stack[0]
+	return e.floats[0], nil
 }
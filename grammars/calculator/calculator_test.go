@@ -2,12 +2,11 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build grammars
-// +build grammars
-
-package main
+package calculator
 
 import (
+	"errors"
+	"math"
 	"math/big"
 	"testing"
 )
@@ -16,12 +15,59 @@ func TestCalculator(t *testing.T) {
 	expression := "( 1 - -3 ) / 3 + 2 * ( 3 + -4 ) + 3 % 2^2"
 	calc := &Calculator{Buffer: expression}
 	calc.Init()
-	calc.Expression.Init(expression)
+	calc.Expression.Init()
+	if err := calc.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	calc.Execute()
+	if value, err := calc.Int(); err != nil || value.Cmp(big.NewInt(2)) != 0 {
+		t.Fatalf("got %v %v, expected 2", value, err)
+	}
+}
+
+func TestCalculatorFloat(t *testing.T) {
+	expression := "1.5 * 4 / 8 - 2^0.5"
+	calc := &Calculator{Buffer: expression, Expression: Expression{Float: true}}
+	calc.Init()
+	if err := calc.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	calc.Execute()
+	expected := 0.75 - math.Pow(2, 0.5)
+	if value, err := calc.Float64(); err != nil || value != expected {
+		t.Fatalf("got %v %v, expected %v", value, err, expected)
+	}
+	if _, err := calc.Int(); err == nil {
+		t.Fatal("got an integer computed with floats")
+	}
+}
+
+func TestCalculatorErrors(t *testing.T) {
+	for _, float := range []bool{false, true} {
+		for _, expression := range []string{"1 / ( 2 - 2 )", "1 + 3 % 0 * 2"} {
+			calc := &Calculator{Buffer: expression, Expression: Expression{Float: float}}
+			calc.Init()
+			if err := calc.Parse(); err != nil {
+				t.Fatal(err)
+			}
+			calc.Execute()
+			_, err := calc.Int()
+			if float {
+				_, err = calc.Float64()
+			}
+			if !errors.Is(err, ErrDivisionByZero) {
+				t.Errorf("%s with float %v: got %v, expected a division by zero", expression, float, err)
+			}
+		}
+	}
+
+	calc := &Calculator{Buffer: "1.5"}
+	calc.Init()
 	if err := calc.Parse(); err != nil {
 		t.Fatal(err)
 	}
 	calc.Execute()
-	if calc.Evaluate().Cmp(big.NewInt(2)) != 0 {
-		t.Fatal("got incorrect result")
+	if _, err := calc.Int(); err == nil {
+		t.Fatal("got an integer for a fraction")
 	}
 }
@@ -2,10 +2,11 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build grammars
-// +build grammars
+//go:generate go run github.com/pointlander/peg -switch -inline calculator.peg
 
-package main
+// Package calculator computes arithmetic expressions by walking the syntax
+// tree of the parser generated from calculator.peg.
+package calculator
 
 import (
 	"math/big"
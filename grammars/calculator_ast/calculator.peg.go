@@ -0,0 +1,1180 @@
+// Code generated by peg -switch -inline calculator.peg. DO NOT EDIT.
+
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package calculator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	rulee
+	rulee1
+	rulee2
+	rulee3
+	rulee4
+	rulevalue
+	rulenumber
+	rulesub
+	ruleadd
+	ruleminus
+	rulemultiply
+	ruledivide
+	rulemodulus
+	ruleexponentiation
+	ruleopen
+	ruleclose
+	rulesp
+	rulePegText
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"e",
+	"e1",
+	"e2",
+	"e3",
+	"e4",
+	"value",
+	"number",
+	"sub",
+	"add",
+	"minus",
+	"multiply",
+	"divide",
+	"modulus",
+	"exponentiation",
+	"open",
+	"close",
+	"sp",
+	"PegText",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"e", "calculator.peg", 10, 10, false, false},
+	{"e1", "calculator.peg", 11, 13, false, false},
+	{"e2", "calculator.peg", 14, 17, false, false},
+	{"e3", "calculator.peg", 18, 19, false, false},
+	{"e4", "calculator.peg", 20, 21, false, false},
+	{"value", "calculator.peg", 22, 23, false, false},
+	{"number", "calculator.peg", 24, 24, false, true},
+	{"sub", "calculator.peg", 25, 25, false, true},
+	{"add", "calculator.peg", 26, 26, false, true},
+	{"minus", "calculator.peg", 27, 27, false, false},
+	{"multiply", "calculator.peg", 28, 28, false, true},
+	{"divide", "calculator.peg", 29, 29, false, true},
+	{"modulus", "calculator.peg", 30, 30, false, true},
+	{"exponentiation", "calculator.peg", 31, 31, false, true},
+	{"open", "calculator.peg", 32, 32, false, true},
+	{"close", "calculator.peg", 33, 33, false, true},
+	{"sp", "calculator.peg", 34, 34, true, false},
+	{"PegText", "", 0, 0, true, false},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type Calculator struct {
+	Buffer         string
+	buffer         []rune
+	rules          [19]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*Calculator) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *Calculator) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *Calculator) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *Calculator) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *Calculator) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *Calculator) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *Calculator) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *Calculator) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *Calculator) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *Calculator) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *Calculator
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *Calculator) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *Calculator
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *Calculator) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *Calculator) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *Calculator) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func Pretty(pretty bool) func(*Calculator) error {
+	return func(p *Calculator) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*Calculator) error {
+	return func(p *Calculator) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*Calculator) error {
+	return func(p *Calculator) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*Calculator) error {
+	return func(p *Calculator) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*Calculator) error {
+	return func(p *Calculator) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+func Size(size int) func(*Calculator) error {
+	return func(p *Calculator) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*Calculator) error {
+	return func(p *Calculator) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *Calculator) Init(options ...func(*Calculator) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.farthest = max.end
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 e <- <(sp e1 !.)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[rulesp]() {
+					goto l0
+				}
+				if !_rules[rulee1]() {
+					goto l0
+				}
+				{
+					position2, tokenIndex2 := position, tokenIndex
+					if !matchDot() {
+						goto l2
+					}
+					goto l0
+				l2:
+					position, tokenIndex = position2, tokenIndex2
+				}
+				add(rulee, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 e1 <- <(e2 ((add e2) / (minus e2))*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{1, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position3, tokenIndex3 := position, tokenIndex
+			{
+				position4 := position
+				if !_rules[rulee2]() {
+					goto l3
+				}
+			l5:
+				{
+					position6, tokenIndex6 := position, tokenIndex
+					{
+						position7, tokenIndex7 := position, tokenIndex
+						{
+							position9 := position
+							if buffer[position] != rune('+') {
+								goto l8
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l8
+							}
+							add(ruleadd, position9)
+						}
+						if !_rules[rulee2]() {
+							goto l8
+						}
+						goto l7
+					l8:
+						position, tokenIndex = position7, tokenIndex7
+						if !_rules[ruleminus]() {
+							goto l6
+						}
+						if !_rules[rulee2]() {
+							goto l6
+						}
+					}
+				l7:
+					goto l5
+				l6:
+					position, tokenIndex = position6, tokenIndex6
+				}
+				add(rulee1, position4)
+			}
+			memoize(1, position3, tokenIndex3, true)
+			return true
+		l3:
+			memoize(1, position3, tokenIndex3, false)
+			position, tokenIndex = position3, tokenIndex3
+			return false
+		},
+		/* 2 e2 <- <(e3 ((&('%') (modulus e3)) | (&('/') (divide e3)) | (&('*') %fail('%' '/') (multiply e3)))*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{2, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position10, tokenIndex10 := position, tokenIndex
+			{
+				position11 := position
+				if !_rules[rulee3]() {
+					goto l10
+				}
+			l12:
+				{
+					position13, tokenIndex13 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '%':
+							{
+								position15 := position
+								position++
+								if !_rules[rulesp]() {
+									goto l13
+								}
+								add(rulemodulus, position15)
+							}
+							if !_rules[rulee3]() {
+								goto l13
+							}
+						case '/':
+							{
+								position16 := position
+								position++
+								if !_rules[rulesp]() {
+									goto l13
+								}
+								add(ruledivide, position16)
+							}
+							if !_rules[rulee3]() {
+								goto l13
+							}
+						default:
+							{
+								position17 := position
+								if buffer[position] != rune('*') {
+									goto l13
+								}
+								position++
+								if !_rules[rulesp]() {
+									goto l13
+								}
+								add(rulemultiply, position17)
+							}
+							if !_rules[rulee3]() {
+								goto l13
+							}
+						}
+					}
+
+					goto l12
+				l13:
+					position, tokenIndex = position13, tokenIndex13
+				}
+				add(rulee2, position11)
+			}
+			memoize(2, position10, tokenIndex10, true)
+			return true
+		l10:
+			memoize(2, position10, tokenIndex10, false)
+			position, tokenIndex = position10, tokenIndex10
+			return false
+		},
+		/* 3 e3 <- <(e4 (exponentiation e4)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{3, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position18, tokenIndex18 := position, tokenIndex
+			{
+				position19 := position
+				if !_rules[rulee4]() {
+					goto l18
+				}
+			l20:
+				{
+					position21, tokenIndex21 := position, tokenIndex
+					{
+						position22 := position
+						if buffer[position] != rune('^') {
+							goto l21
+						}
+						position++
+						if !_rules[rulesp]() {
+							goto l21
+						}
+						add(ruleexponentiation, position22)
+					}
+					if !_rules[rulee4]() {
+						goto l21
+					}
+					goto l20
+				l21:
+					position, tokenIndex = position21, tokenIndex21
+				}
+				add(rulee3, position19)
+			}
+			memoize(3, position18, tokenIndex18, true)
+			return true
+		l18:
+			memoize(3, position18, tokenIndex18, false)
+			position, tokenIndex = position18, tokenIndex18
+			return false
+		},
+		/* 4 e4 <- <((minus value) / value)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{4, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position23, tokenIndex23 := position, tokenIndex
+			{
+				position24 := position
+				{
+					position25, tokenIndex25 := position, tokenIndex
+					if !_rules[ruleminus]() {
+						goto l26
+					}
+					if !_rules[rulevalue]() {
+						goto l26
+					}
+					goto l25
+				l26:
+					position, tokenIndex = position25, tokenIndex25
+					if !_rules[rulevalue]() {
+						goto l23
+					}
+				}
+			l25:
+				add(rulee4, position24)
+			}
+			memoize(4, position23, tokenIndex23, true)
+			return true
+		l23:
+			memoize(4, position23, tokenIndex23, false)
+			position, tokenIndex = position23, tokenIndex23
+			return false
+		},
+		/* 5 value <- <(number / sub)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{5, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position27, tokenIndex27 := position, tokenIndex
+			{
+				position28 := position
+				{
+					position29, tokenIndex29 := position, tokenIndex
+					{
+						position31 := position
+						{
+							position32 := position
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l30
+							}
+							position++
+						l33:
+							{
+								position34, tokenIndex34 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l34
+								}
+								position++
+								goto l33
+							l34:
+								position, tokenIndex = position34, tokenIndex34
+							}
+							add(rulePegText, position32)
+						}
+						if !_rules[rulesp]() {
+							goto l30
+						}
+						add(rulenumber, position31)
+					}
+					goto l29
+				l30:
+					position, tokenIndex = position29, tokenIndex29
+					{
+						position35 := position
+						{
+							position36 := position
+							if buffer[position] != rune('(') {
+								goto l27
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l27
+							}
+							add(ruleopen, position36)
+						}
+						if !_rules[rulee1]() {
+							goto l27
+						}
+						{
+							position37 := position
+							if buffer[position] != rune(')') {
+								goto l27
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l27
+							}
+							add(ruleclose, position37)
+						}
+						add(rulesub, position35)
+					}
+				}
+			l29:
+				add(rulevalue, position28)
+			}
+			memoize(5, position27, tokenIndex27, true)
+			return true
+		l27:
+			memoize(5, position27, tokenIndex27, false)
+			position, tokenIndex = position27, tokenIndex27
+			return false
+		},
+		/* 6 number <- <(<[0-9]+> sp)> */
+		nil,
+		/* 7 sub <- <(open e1 close)> */
+		nil,
+		/* 8 add <- <('+' sp)> */
+		nil,
+		/* 9 minus <- <('-' sp)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{9, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position41, tokenIndex41 := position, tokenIndex
+			{
+				position42 := position
+				if buffer[position] != rune('-') {
+					goto l41
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l41
+				}
+				add(ruleminus, position42)
+			}
+			memoize(9, position41, tokenIndex41, true)
+			return true
+		l41:
+			memoize(9, position41, tokenIndex41, false)
+			position, tokenIndex = position41, tokenIndex41
+			return false
+		},
+		/* 10 multiply <- <('*' sp)> */
+		nil,
+		/* 11 divide <- <('/' sp)> */
+		nil,
+		/* 12 modulus <- <('%' sp)> */
+		nil,
+		/* 13 exponentiation <- <('^' sp)> */
+		nil,
+		/* 14 open <- <('(' sp)> */
+		nil,
+		/* 15 close <- <(')' sp)> */
+		nil,
+		/* 16 sp <- <(' ' / '\t')*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{16, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position49, tokenIndex49 := position, tokenIndex
+			{
+				position50 := position
+			l51:
+				{
+					position52, tokenIndex52 := position, tokenIndex
+					{
+						position53, tokenIndex53 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l54
+						}
+						position++
+						goto l53
+					l54:
+						position, tokenIndex = position53, tokenIndex53
+						if buffer[position] != rune('\t') {
+							goto l52
+						}
+						position++
+					}
+				l53:
+					goto l51
+				l52:
+					position, tokenIndex = position52, tokenIndex52
+				}
+				add(rulesp, position50)
+			}
+			memoize(16, position49, tokenIndex49, true)
+			return true
+		},
+		nil,
+	}
+	if p.maxDepth > 0 {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule := rule
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run github.com/pointlander/peg -switch -inline -streaming csv.peg
+
+// Package csv reads comma separated values with the parser generated from
+// csv.peg.
+package csv
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Records returns the records of the parsed file, along with an error for
+// every malformed field skipped, which becomes empty.
+func (c *CSV) Records() ([][]string, []error) {
+	var records [][]string
+	var errors []error
+	for node := c.AST().up; node != nil; node = node.next {
+		if node.pegRule != ruleLine {
+			continue
+		}
+		for node := node.up; node != nil; node = node.next {
+			if node.pegRule == ruleRecord {
+				record, errs := c.record(node)
+				records, errors = append(records, record), append(errors, errs...)
+			}
+		}
+	}
+	return records, errors
+}
+
+// record returns the record parsed into node, along with an error for every
+// malformed field skipped. Empty records, like blank lines, aren't in the
+// syntax tree.
+func (c *CSV) record(node *node32) ([]string, []error) {
+	var errors []error
+	record := []string{""}
+	for field := node.up; field != nil; field = field.next {
+		switch field.pegRule {
+		case ruleComma:
+			record = append(record, "")
+		case ruleField:
+			value := field.up
+			switch value.pegRule {
+			case ruleQuoted:
+				text := string(c.buffer[value.begin+1 : value.end-1])
+				record[len(record)-1] = strings.ReplaceAll(text, `""`, `"`)
+			case ruleBare:
+				record[len(record)-1] = string(c.buffer[value.begin:value.end])
+			case ruleInvalid:
+				errors = append(errors, fmt.Errorf("invalid field %q at offset %v",
+					string(c.buffer[value.begin:value.end]), value.begin))
+			}
+		}
+	}
+	return record, errors
+}
@@ -0,0 +1,1359 @@
+// Code generated by peg -switch -inline -streaming csv.peg. DO NOT EDIT.
+
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	ruleFile
+	ruleLine
+	ruleRecord
+	ruleField
+	ruleQuoted
+	ruleBare
+	ruleInvalid
+	ruleSeparator
+	ruleComma
+	ruleEndOfLine
+	ruleEndOfFile
+	rulePegText
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"File",
+	"Line",
+	"Record",
+	"Field",
+	"Quoted",
+	"Bare",
+	"Invalid",
+	"Separator",
+	"Comma",
+	"EndOfLine",
+	"EndOfFile",
+	"PegText",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"File", "csv.peg", 12, 12, false, false},
+	{"Line", "csv.peg", 13, 13, false, false},
+	{"Record", "csv.peg", 14, 14, false, true},
+	{"Field", "csv.peg", 15, 17, false, false},
+	{"Quoted", "csv.peg", 18, 18, true, true},
+	{"Bare", "csv.peg", 19, 19, true, true},
+	{"Invalid", "csv.peg", 23, 23, false, true},
+	{"Separator", "csv.peg", 24, 24, false, false},
+	{"Comma", "csv.peg", 25, 25, true, false},
+	{"EndOfLine", "csv.peg", 26, 26, true, false},
+	{"EndOfFile", "csv.peg", 27, 27, true, false},
+	{"PegText", "", 0, 0, true, false},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type CSV struct {
+	Buffer         string
+	buffer         []rune
+	rules          [13]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*CSV) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *CSV) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *CSV) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *CSV) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// ParseReader parses the input read from r as a series of matches of rule,
+// for inputs too large to hold in memory such as multi-gigabyte logs. Buffer
+// holds a window of the input from the beginning of the current match, which
+// grows while the parser looks at its end, and slides past each match.
+// Memory is thus bounded only by the longest match, and a rule which never
+// stops before the end holds the whole input. While the farthest position
+// the parser reached is the end of the window, the window is parsed again
+// from scratch once it has doubled, so that a match spanning many reads is
+// parsed a number of times logarithmic in its length, in time linear in it,
+// at the cost of waiting for the input doubling the window. After each match
+// handle is called with the byte offset of the window into the input, while
+// Buffer, the syntax tree and the captures describe the match. Positions are
+// relative to the window, and a rule matching the empty input is an error.
+func (p *CSV) ParseReader(r io.Reader, rule pegRule, handle func(offset int) error) error {
+	var window []byte
+	chunk := make([]byte, 1<<16)
+	/* want is the size of the window from which it is parsed next */
+	offset, eof, want := 0, false, 1
+	for {
+		for !eof && len(window) < want {
+			n, err := r.Read(chunk)
+			window = append(window, chunk[:n]...)
+			if err == io.EOF {
+				eof = true
+			} else if err != nil {
+				return err
+			}
+		}
+		complete := len(window)
+		if !eof {
+			/* leave a rune split by the read for the next one */
+			for i := len(window) - 1; i >= 0 && i >= len(window)-utf8.UTFMax; i-- {
+				if utf8.RuneStart(window[i]) {
+					if !utf8.FullRune(window[i:]) {
+						complete = i
+					}
+					break
+				}
+			}
+		}
+		if complete == 0 {
+			if eof {
+				return nil
+			}
+			want = len(window) + 1
+			continue
+		}
+
+		p.Buffer = string(window[:complete])
+		p.Reset()
+		err := p.Parse(int(rule))
+		/* the parser looked at the end of the window, which more input may change */
+		if !eof && int(p.farthest) >= len(p.buffer)-1 {
+			want = 2 * len(window)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("at byte offset %v: %w", offset, err)
+		}
+		end := p.ByteOffset(int(p.matched))
+		if end == 0 {
+			return fmt.Errorf("rule '%v' matched the empty input at byte offset %v", rul3s[rule], offset)
+		}
+		if err = handle(offset); err != nil {
+			return err
+		}
+		window = append(window[:0], window[end:]...)
+		offset, want = offset+end, 0
+	}
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *CSV) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *CSV) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *CSV) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *CSV) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *CSV) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// Completions returns the terminals which could continue the first offset
+// runes of the buffer. The parser is reset afterwards.
+func (p *CSV) Completions(offset int) []string {
+	buffer := p.Buffer
+	defer func() {
+		p.Buffer = buffer
+		p.Reset()
+	}()
+	if runes := []rune(buffer); offset < len(runes) {
+		p.Buffer = string(runes[:offset])
+	}
+	p.Reset()
+	_ = p.Parse()
+	if int(p.farthest) != len([]rune(p.Buffer)) {
+		return nil
+	}
+	return p.expectations()
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *CSV) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// ParsePartial parses like Parse, but if the input is invalid or incomplete
+// the syntax tree holds the rules matched before the farthest failure, below
+// the start rule, and the terminals expected at the failure are returned.
+func (p *CSV) ParsePartial(rule ...int) ([]string, error) {
+	p.partial = true
+	defer func() {
+		p.partial = false
+	}()
+	err := p.Parse(rule...)
+	if err == nil {
+		return nil, nil
+	}
+	r := 1
+	if len(rule) > 0 {
+		r = rule[0]
+	}
+	tokens, end := p.partialTokens, uint32(0)
+	for _, token := range tokens {
+		if token.end > end {
+			end = token.end
+		}
+	}
+	if end > 0 {
+		tokens = append(tokens, token32{pegRule: pegRule(r), begin: 0, end: end})
+	}
+	p.tokens32.tree, p.partialTokens = tokens, nil
+	return p.expectations(), err
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *CSV
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *CSV) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *CSV
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *CSV) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *CSV) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *CSV) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+// Diagnostic is an error the grammar recovered from: a match of Label, a rule
+// marked with %recovery, beginning at the rune offset Position.
+type Diagnostic struct {
+	Position int
+	Label    pegRule
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%v at %v", rul3s[d.Label], d.Position)
+}
+
+// Diagnostics returns the errors recovered from in the last parse, in the
+// order of the input. Unlike the syntax tree, they include empty matches, such
+// as those of a rule reporting a missing token.
+func (p *CSV) Diagnostics() []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, token := range p.Tokens() {
+		switch token.pegRule {
+		case ruleInvalid:
+			diagnostics = append(diagnostics, Diagnostic{int(token.begin), token.pegRule})
+		}
+	}
+	sort.SliceStable(diagnostics, func(i, j int) bool { return diagnostics[i].Position < diagnostics[j].Position })
+	return diagnostics
+}
+
+// CheckRecovery parses input, into which tests inject errors, and returns an
+// error describing how its diagnostics differ from expected, compared as
+// sets, and which rules of present are missing from its syntax tree, so that
+// tests can check how the grammar recovers. It returns the parse error if the
+// grammar doesn't recover.
+func (p *CSV) CheckRecovery(input string, expected []Diagnostic, present ...pegRule) error {
+	p.Buffer = input
+	p.Reset()
+	if err := p.Parse(); err != nil {
+		return err
+	}
+	var problems []string
+	diagnostics, counts := p.Diagnostics(), make(map[Diagnostic]int)
+	for _, diagnostic := range expected {
+		counts[diagnostic]--
+	}
+	for _, diagnostic := range diagnostics {
+		counts[diagnostic]++
+	}
+	for _, diagnostic := range diagnostics {
+		if counts[diagnostic] > 0 {
+			problems = append(problems, fmt.Sprintf("unexpected %v", diagnostic))
+			counts[diagnostic]--
+		}
+	}
+	for _, diagnostic := range expected {
+		if counts[diagnostic] < 0 {
+			problems = append(problems, fmt.Sprintf("missing %v", diagnostic))
+			counts[diagnostic]++
+		}
+	}
+	matched := make(map[pegRule]bool)
+	for _, token := range p.Tokens() {
+		/* empty matches aren't in the syntax tree */
+		if token.begin < token.end {
+			matched[token.pegRule] = true
+		}
+	}
+	for _, rule := range present {
+		if !matched[rule] {
+			problems = append(problems, fmt.Sprintf("no %v in the syntax tree", rul3s[rule]))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	var message bytes.Buffer
+	for i, problem := range problems {
+		if i > 0 {
+			message.WriteString(", ")
+		}
+		message.WriteString(problem)
+	}
+	return fmt.Errorf("recovery: %v", message.String())
+}
+
+func Pretty(pretty bool) func(*CSV) error {
+	return func(p *CSV) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*CSV) error {
+	return func(p *CSV) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*CSV) error {
+	return func(p *CSV) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*CSV) error {
+	return func(p *CSV) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*CSV) error {
+	return func(p *CSV) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+// TrackRules records the rules being matched, so that the Rules of a
+// SyntaxError name the rules at the farthest failure. Like MaxDepth it
+// slows every rule down a little.
+func TrackRules() func(*CSV) error {
+	return func(p *CSV) error {
+		p.trackRules = true
+		return nil
+	}
+}
+func Size(size int) func(*CSV) error {
+	return func(p *CSV) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*CSV) error {
+	return func(p *CSV) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *CSV) Init(options ...func(*CSV) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	fail := func(expected string) {
+		if position > p.farthest {
+			p.farthest, p.expected = position, p.expected[:0]
+			p.farthestHint = ""
+		}
+		if position == p.farthest {
+			if p.trackRules && len(p.expected) == 0 {
+				p.farthestRules = append(p.farthestRules[:0], stack...)
+			}
+			p.expected = append(p.expected, expected)
+			if p.partial {
+				p.partialTokens = append(p.partialTokens[:0], tree.tree[:tokenIndex]...)
+			}
+		}
+	}
+	_ = fail
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 File <- <(Line (!EndOfFile Line)* EndOfFile)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[ruleLine]() {
+					goto l0
+				}
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					{
+						position4, tokenIndex4 := position, tokenIndex
+						if !_rules[ruleEndOfFile]() {
+							goto l4
+						}
+						goto l3
+					l4:
+						position, tokenIndex = position4, tokenIndex4
+					}
+					if !_rules[ruleLine]() {
+						goto l3
+					}
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				if !_rules[ruleEndOfFile]() {
+					goto l0
+				}
+				add(ruleFile, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Line <- <(Record (EndOfLine / EndOfFile))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{1, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position5, tokenIndex5 := position, tokenIndex
+			{
+				position6 := position
+				{
+					position7 := position
+					if !_rules[ruleField]() {
+						goto l5
+					}
+				l8:
+					{
+						position9, tokenIndex9 := position, tokenIndex
+						if !_rules[ruleComma]() {
+							goto l9
+						}
+						if !_rules[ruleField]() {
+							goto l9
+						}
+						goto l8
+					l9:
+						position, tokenIndex = position9, tokenIndex9
+					}
+					add(ruleRecord, position7)
+				}
+				{
+					position10, tokenIndex10 := position, tokenIndex
+					if !_rules[ruleEndOfLine]() {
+						goto l11
+					}
+					goto l10
+				l11:
+					position, tokenIndex = position10, tokenIndex10
+					if !_rules[ruleEndOfFile]() {
+						goto l5
+					}
+				}
+			l10:
+				add(ruleLine, position6)
+			}
+			memoize(1, position5, tokenIndex5, true)
+			return true
+		l5:
+			memoize(1, position5, tokenIndex5, false)
+			position, tokenIndex = position5, tokenIndex5
+			return false
+		},
+		/* 2 Record <- <(Field (Comma Field)*)> */
+		nil,
+		/* 3 Field <- <((Quoted &Separator) / (Bare &Separator) / Invalid)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{3, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position13, tokenIndex13 := position, tokenIndex
+			{
+				position14 := position
+				{
+					position15, tokenIndex15 := position, tokenIndex
+					{
+						position17 := position
+						if buffer[position] != rune('"') {
+							fail("'\"'")
+							goto l16
+						}
+						position++
+						{
+							position18 := position
+						l19:
+							{
+								position20, tokenIndex20 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '"':
+										fail("[^\"]")
+										position++
+										if buffer[position] != rune('"') {
+											fail("'\"'")
+											goto l20
+										}
+										position++
+									default:
+										fail("'\"\"'")
+										if c := buffer[position]; c == endSymbol || c == rune('"') {
+											fail("[^\"]")
+											goto l20
+										}
+										position++
+									}
+								}
+
+								goto l19
+							l20:
+								position, tokenIndex = position20, tokenIndex20
+							}
+							add(rulePegText, position18)
+						}
+						if buffer[position] != rune('"') {
+							fail("'\"'")
+							goto l16
+						}
+						position++
+						add(ruleQuoted, position17)
+					}
+					{
+						position22, tokenIndex22 := position, tokenIndex
+						if !_rules[ruleSeparator]() {
+							goto l16
+						}
+						position, tokenIndex = position22, tokenIndex22
+					}
+					goto l15
+				l16:
+					position, tokenIndex = position15, tokenIndex15
+					{
+						position24 := position
+					l25:
+						{
+							position26, tokenIndex26 := position, tokenIndex
+							if c := buffer[position]; c == endSymbol || c == rune(',') || c == rune('"') || c == rune('\r') || c == rune('\n') {
+								fail("[^,\"\\r\\n]")
+								goto l26
+							}
+							position++
+							goto l25
+						l26:
+							position, tokenIndex = position26, tokenIndex26
+						}
+						add(ruleBare, position24)
+					}
+					{
+						position27, tokenIndex27 := position, tokenIndex
+						if !_rules[ruleSeparator]() {
+							goto l23
+						}
+						position, tokenIndex = position27, tokenIndex27
+					}
+					goto l15
+				l23:
+					position, tokenIndex = position15, tokenIndex15
+					{
+						position28 := position
+						{
+							position31, tokenIndex31 := position, tokenIndex
+							if !_rules[ruleSeparator]() {
+								goto l31
+							}
+							goto l13
+						l31:
+							position, tokenIndex = position31, tokenIndex31
+						}
+						if !matchDot() {
+							fail(".")
+							goto l13
+						}
+					l29:
+						{
+							position30, tokenIndex30 := position, tokenIndex
+							{
+								position32, tokenIndex32 := position, tokenIndex
+								if !_rules[ruleSeparator]() {
+									goto l32
+								}
+								goto l30
+							l32:
+								position, tokenIndex = position32, tokenIndex32
+							}
+							if !matchDot() {
+								fail(".")
+								goto l30
+							}
+							goto l29
+						l30:
+							position, tokenIndex = position30, tokenIndex30
+						}
+						add(ruleInvalid, position28)
+					}
+				}
+			l15:
+				add(ruleField, position14)
+			}
+			memoize(3, position13, tokenIndex13, true)
+			return true
+		l13:
+			memoize(3, position13, tokenIndex13, false)
+			position, tokenIndex = position13, tokenIndex13
+			return false
+		},
+		/* 4 Quoted <- <('"' <((&('"') %fail([^"]) ('"' '"')) | (&('\x00' | '\x01' | '\x02' | '\x03' | '\x04' | '\x05' | '\x06' | '\a' | '\b' | '\t' | '\n' | '\v' | '\f' | '\r' | '\x0e' | '\x0f' | '\x10' | '\x11' | '\x12' | '\x13' | '\x14' | '\x15' | '\x16' | '\x17' | '\x18' | '\x19' | '\x1a' | '\x1b' | '\x1c' | '\x1d' | '\x1e' | '\x1f' | ' ' | '!' | [#-\U0010ffff]) %fail('""') [^"]))*> '"')> */
+		nil,
+		/* 5 Bare <- <[^,"\r\n]*> */
+		nil,
+		/* 6 Invalid <- <(!Separator .)+> */
+		nil,
+		/* 7 Separator <- <(Comma / EndOfLine / EndOfFile)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{7, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position36, tokenIndex36 := position, tokenIndex
+			{
+				position37 := position
+				{
+					position38, tokenIndex38 := position, tokenIndex
+					if !_rules[ruleComma]() {
+						goto l39
+					}
+					goto l38
+				l39:
+					position, tokenIndex = position38, tokenIndex38
+					if !_rules[ruleEndOfLine]() {
+						goto l40
+					}
+					goto l38
+				l40:
+					position, tokenIndex = position38, tokenIndex38
+					if !_rules[ruleEndOfFile]() {
+						goto l36
+					}
+				}
+			l38:
+				add(ruleSeparator, position37)
+			}
+			memoize(7, position36, tokenIndex36, true)
+			return true
+		l36:
+			memoize(7, position36, tokenIndex36, false)
+			position, tokenIndex = position36, tokenIndex36
+			return false
+		},
+		/* 8 Comma <- <','> */
+		func() bool {
+			memoized, ok := memoization[memoKey{8, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position41, tokenIndex41 := position, tokenIndex
+			{
+				position42 := position
+				if buffer[position] != rune(',') {
+					fail("','")
+					goto l41
+				}
+				position++
+				add(ruleComma, position42)
+			}
+			memoize(8, position41, tokenIndex41, true)
+			return true
+		l41:
+			memoize(8, position41, tokenIndex41, false)
+			position, tokenIndex = position41, tokenIndex41
+			return false
+		},
+		/* 9 EndOfLine <- <(('\r' '\n') / '\n')> */
+		func() bool {
+			memoized, ok := memoization[memoKey{9, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position43, tokenIndex43 := position, tokenIndex
+			{
+				position44 := position
+				{
+					position45, tokenIndex45 := position, tokenIndex
+					if buffer[position] != rune('\r') {
+						fail("'\\r\\n'")
+						goto l46
+					}
+					position++
+					if buffer[position] != rune('\n') {
+						fail("'\\n'")
+						goto l46
+					}
+					position++
+					goto l45
+				l46:
+					position, tokenIndex = position45, tokenIndex45
+					if buffer[position] != rune('\n') {
+						fail("'\\n'")
+						goto l43
+					}
+					position++
+				}
+			l45:
+				add(ruleEndOfLine, position44)
+			}
+			memoize(9, position43, tokenIndex43, true)
+			return true
+		l43:
+			memoize(9, position43, tokenIndex43, false)
+			position, tokenIndex = position43, tokenIndex43
+			return false
+		},
+		/* 10 EndOfFile <- <!.> */
+		func() bool {
+			memoized, ok := memoization[memoKey{10, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position47, tokenIndex47 := position, tokenIndex
+			{
+				position48 := position
+				{
+					position49, tokenIndex49 := position, tokenIndex
+					if !matchDot() {
+						fail(".")
+						goto l49
+					}
+					goto l47
+				l49:
+					position, tokenIndex = position49, tokenIndex49
+				}
+				add(ruleEndOfFile, position48)
+			}
+			memoize(10, position47, tokenIndex47, true)
+			return true
+		l47:
+			memoize(10, position47, tokenIndex47, false)
+			position, tokenIndex = position47, tokenIndex47
+			return false
+		},
+		nil,
+	}
+	if p.maxDepth > 0 || p.trackRules {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule, name := rule, rul3s[i]
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				if p.trackRules {
+					stack = append(stack, name)
+					defer func() { stack = stack[:len(stack)-1] }()
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
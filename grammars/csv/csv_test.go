@@ -0,0 +1,128 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package csv
+
+import (
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCSV(t *testing.T) {
+	files := []string{
+		"a",
+		"a,b,c\n",
+		"a,,c\r\n1,2,3\r\n",
+		",\n,\n",
+		"\"quoted, with \"\"quotes\"\"\",\"multi\nline\"\nx,y\n",
+		"é,ü\n",
+	}
+	for _, file := range files {
+		c := &CSV{Buffer: file}
+		c.Init()
+		if err := c.Parse(); err != nil {
+			t.Fatalf("%q: %v", file, err)
+		}
+		records, errors := c.Records()
+		if len(errors) > 0 {
+			t.Fatalf("%q: %v", file, errors)
+		}
+		reader := csv.NewReader(strings.NewReader(file))
+		reader.FieldsPerRecord = -1
+		expected, err := reader.ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(records, expected) {
+			t.Errorf("%q: got %q, expected %q", file, records, expected)
+		}
+	}
+}
+
+func TestCSVRecovery(t *testing.T) {
+	c := &CSV{Buffer: "a,\"b\"c,d\ne,f\"g,h\n"}
+	c.Init()
+	if err := c.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	records, errors := c.Records()
+	expected := [][]string{{"a", "", "d"}, {"e", "", "h"}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("got %q, expected %q", records, expected)
+	}
+	if len(errors) != 2 {
+		t.Errorf("got %v, expected 2 errors", errors)
+	}
+}
+
+func TestCSVRecoveryDiagnostics(t *testing.T) {
+	c := &CSV{}
+	c.Init()
+	err := c.CheckRecovery("\"q\",x\"y\na,\"b\"c\n",
+		[]Diagnostic{{4, ruleInvalid}, {10, ruleInvalid}}, ruleRecord, ruleQuoted, ruleBare)
+	if err != nil {
+		t.Error(err)
+	}
+	err = c.CheckRecovery("a,b\"\n", []Diagnostic{{1, ruleInvalid}}, ruleQuoted)
+	expected := "recovery: unexpected Invalid at 2, missing Invalid at 1, no Quoted in the syntax tree"
+	if err == nil || err.Error() != expected {
+		t.Errorf("got %v, expected %v", err, expected)
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	file := "a,b\n\"multi\nline\",é\r\n\nc,d"
+	c := &CSV{}
+	c.Init()
+	var records [][]string
+	var offsets []int
+	err := c.ParseReader(iotest.OneByteReader(strings.NewReader(file)), ruleLine, func(offset int) error {
+		for node := c.AST().up; node != nil; node = node.next {
+			if node.pegRule == ruleRecord {
+				record, errors := c.record(node)
+				if len(errors) > 0 {
+					t.Fatal(errors)
+				}
+				records, offsets = append(records, record), append(offsets, offset)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{{"a", "b"}, {"multi\nline", "é"}, {"c", "d"}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("got %q, expected %q", records, expected)
+	}
+	if !reflect.DeepEqual(offsets, []int{0, 4, 22}) {
+		t.Errorf("got offsets %v, expected [0 4 22]", offsets)
+	}
+
+	stop := errors.New("stop")
+	err = c.ParseReader(strings.NewReader(file), ruleLine, func(int) error { return stop })
+	if err != stop {
+		t.Errorf("got %v, expected the error of the handler", err)
+	}
+}
+
+func BenchmarkCSV(b *testing.B) {
+	file := strings.Repeat("peg,1000,\"go, parser\",false\n", 1000)
+	c := &CSV{Buffer: file}
+	c.Init()
+	b.SetBytes(int64(len(file)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.Reset()
+		if err := c.Parse(); err != nil {
+			b.Fatal(err)
+		}
+		c.Records()
+	}
+}
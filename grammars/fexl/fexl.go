@@ -0,0 +1,8 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run github.com/pointlander/peg -switch -inline fexl.peg
+
+// Package fexl parses Fexl programs with the parser generated from fexl.peg.
+package fexl
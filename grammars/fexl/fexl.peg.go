@@ -0,0 +1,1309 @@
+// Code generated by peg -switch -inline fexl.peg. DO NOT EDIT.
+
+// fexl
+
+package fexl
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	ruleFexl
+	ruleInput
+	ruleExpression
+	ruleComment
+	ruleDefinition
+	ruleRecursive
+	ruleArgument
+	ruleTerm
+	ruleSymbol
+	ruleString
+	ruleComplex
+	ruletilde
+	ruleopen
+	ruleclose
+	rulews
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"Fexl",
+	"Input",
+	"Expression",
+	"Comment",
+	"Definition",
+	"Recursive",
+	"Argument",
+	"Term",
+	"Symbol",
+	"String",
+	"Complex",
+	"tilde",
+	"open",
+	"close",
+	"ws",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"Fexl", "fexl.peg", 9, 9, false, false},
+	{"Input", "fexl.peg", 11, 11, true, true},
+	{"Expression", "fexl.peg", 13, 13, false, false},
+	{"Comment", "fexl.peg", 15, 15, false, true},
+	{"Definition", "fexl.peg", 17, 17, false, true},
+	{"Recursive", "fexl.peg", 19, 19, false, true},
+	{"Argument", "fexl.peg", 21, 21, false, true},
+	{"Term", "fexl.peg", 23, 23, false, false},
+	{"Symbol", "fexl.peg", 25, 25, false, false},
+	{"String", "fexl.peg", 27, 27, false, true},
+	{"Complex", "fexl.peg", 29, 29, false, true},
+	{"tilde", "fexl.peg", 31, 31, true, true},
+	{"open", "fexl.peg", 33, 33, false, true},
+	{"close", "fexl.peg", 35, 35, false, true},
+	{"ws", "fexl.peg", 37, 37, true, false},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type Fexl struct {
+	Buffer         string
+	buffer         []rune
+	rules          [16]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*Fexl) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *Fexl) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *Fexl) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *Fexl) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *Fexl) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *Fexl) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *Fexl) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *Fexl) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *Fexl) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *Fexl) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *Fexl
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *Fexl) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *Fexl
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *Fexl) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *Fexl) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *Fexl) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func Pretty(pretty bool) func(*Fexl) error {
+	return func(p *Fexl) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*Fexl) error {
+	return func(p *Fexl) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*Fexl) error {
+	return func(p *Fexl) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*Fexl) error {
+	return func(p *Fexl) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*Fexl) error {
+	return func(p *Fexl) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+func Size(size int) func(*Fexl) error {
+	return func(p *Fexl) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*Fexl) error {
+	return func(p *Fexl) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *Fexl) Init(options ...func(*Fexl) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.farthest = max.end
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 Fexl <- <(ws Expression+ Input? !.)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[rulews]() {
+					goto l0
+				}
+				if !_rules[ruleExpression]() {
+					goto l0
+				}
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					if !_rules[ruleExpression]() {
+						goto l3
+					}
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				{
+					position4, tokenIndex4 := position, tokenIndex
+					{
+						position6 := position
+						if buffer[position] != rune('\\') {
+							goto l4
+						}
+						position++
+						if buffer[position] != rune('\\') {
+							goto l4
+						}
+						position++
+					l7:
+						{
+							position8, tokenIndex8 := position, tokenIndex
+							if !matchDot() {
+								goto l8
+							}
+							goto l7
+						l8:
+							position, tokenIndex = position8, tokenIndex8
+						}
+						add(ruleInput, position6)
+					}
+					goto l5
+				l4:
+					position, tokenIndex = position4, tokenIndex4
+				}
+			l5:
+				{
+					position9, tokenIndex9 := position, tokenIndex
+					if !matchDot() {
+						goto l9
+					}
+					goto l0
+				l9:
+					position, tokenIndex = position9, tokenIndex9
+				}
+				add(ruleFexl, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Input <- <('\\' '\\' .*)> */
+		nil,
+		/* 2 Expression <- <(Comment / (';' ws Expression*) / Definition / Argument / Term)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{2, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position11, tokenIndex11 := position, tokenIndex
+			{
+				position12 := position
+				{
+					position13, tokenIndex13 := position, tokenIndex
+					{
+						position15 := position
+						if buffer[position] != rune('#') {
+							goto l14
+						}
+						position++
+					l16:
+						{
+							position17, tokenIndex17 := position, tokenIndex
+							{
+								position18, tokenIndex18 := position, tokenIndex
+								{
+									position19, tokenIndex19 := position, tokenIndex
+									if buffer[position] != rune('\n') {
+										goto l20
+									}
+									position++
+									goto l19
+								l20:
+									position, tokenIndex = position19, tokenIndex19
+									if buffer[position] != rune('\r') {
+										goto l18
+									}
+									position++
+								}
+							l19:
+								goto l17
+							l18:
+								position, tokenIndex = position18, tokenIndex18
+							}
+							if !matchDot() {
+								goto l17
+							}
+							goto l16
+						l17:
+							position, tokenIndex = position17, tokenIndex17
+						}
+						if !_rules[rulews]() {
+							goto l14
+						}
+						add(ruleComment, position15)
+					}
+					goto l13
+				l14:
+					position, tokenIndex = position13, tokenIndex13
+					if buffer[position] != rune(';') {
+						goto l21
+					}
+					position++
+					if !_rules[rulews]() {
+						goto l21
+					}
+				l22:
+					{
+						position23, tokenIndex23 := position, tokenIndex
+						if !_rules[ruleExpression]() {
+							goto l23
+						}
+						goto l22
+					l23:
+						position, tokenIndex = position23, tokenIndex23
+					}
+					goto l13
+				l21:
+					position, tokenIndex = position13, tokenIndex13
+					{
+						position25 := position
+						{
+							position26, tokenIndex26 := position, tokenIndex
+							if buffer[position] != rune('\\') {
+								goto l27
+							}
+							position++
+							if !_rules[ruleSymbol]() {
+								goto l27
+							}
+							if buffer[position] != rune('=') {
+								goto l27
+							}
+							position++
+							if !_rules[rulews]() {
+								goto l27
+							}
+							if !_rules[ruleTerm]() {
+								goto l27
+							}
+							goto l26
+						l27:
+							position, tokenIndex = position26, tokenIndex26
+							{
+								position28 := position
+								if buffer[position] != rune('\\') {
+									goto l24
+								}
+								position++
+								if !_rules[ruleSymbol]() {
+									goto l24
+								}
+								if buffer[position] != rune('=') {
+									goto l24
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l24
+								}
+								position++
+								if !_rules[rulews]() {
+									goto l24
+								}
+								if !_rules[ruleTerm]() {
+									goto l24
+								}
+								add(ruleRecursive, position28)
+							}
+						}
+					l26:
+						add(ruleDefinition, position25)
+					}
+					goto l13
+				l24:
+					position, tokenIndex = position13, tokenIndex13
+					{
+						position30 := position
+						if buffer[position] != rune('\\') {
+							goto l29
+						}
+						position++
+						if !_rules[ruleSymbol]() {
+							goto l29
+						}
+						add(ruleArgument, position30)
+					}
+					goto l13
+				l29:
+					position, tokenIndex = position13, tokenIndex13
+					if !_rules[ruleTerm]() {
+						goto l11
+					}
+				}
+			l13:
+				add(ruleExpression, position12)
+			}
+			memoize(2, position11, tokenIndex11, true)
+			return true
+		l11:
+			memoize(2, position11, tokenIndex11, false)
+			position, tokenIndex = position11, tokenIndex11
+			return false
+		},
+		/* 3 Comment <- <('#' (!('\n' / '\r') .)* ws)> */
+		nil,
+		/* 4 Definition <- <(('\\' Symbol '=' ws Term) / Recursive)> */
+		nil,
+		/* 5 Recursive <- <('\\' Symbol ('=' '=') ws Term)> */
+		nil,
+		/* 6 Argument <- <('\\' Symbol)> */
+		nil,
+		/* 7 Term <- <((open Expression+ close) / Symbol)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{7, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position35, tokenIndex35 := position, tokenIndex
+			{
+				position36 := position
+				{
+					position37, tokenIndex37 := position, tokenIndex
+					{
+						position39 := position
+						if buffer[position] != rune('(') {
+							goto l38
+						}
+						position++
+						if !_rules[rulews]() {
+							goto l38
+						}
+						add(ruleopen, position39)
+					}
+					if !_rules[ruleExpression]() {
+						goto l38
+					}
+				l40:
+					{
+						position41, tokenIndex41 := position, tokenIndex
+						if !_rules[ruleExpression]() {
+							goto l41
+						}
+						goto l40
+					l41:
+						position, tokenIndex = position41, tokenIndex41
+					}
+					{
+						position42 := position
+						if buffer[position] != rune(')') {
+							goto l38
+						}
+						position++
+						if !_rules[rulews]() {
+							goto l38
+						}
+						add(ruleclose, position42)
+					}
+					goto l37
+				l38:
+					position, tokenIndex = position37, tokenIndex37
+					if !_rules[ruleSymbol]() {
+						goto l35
+					}
+				}
+			l37:
+				add(ruleTerm, position36)
+			}
+			memoize(7, position35, tokenIndex35, true)
+			return true
+		l35:
+			memoize(7, position35, tokenIndex35, false)
+			position, tokenIndex = position35, tokenIndex35
+			return false
+		},
+		/* 8 Symbol <- <((String / (!((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&('"') '"') | (&('(') '(') | (&(')') ')') | (&(';') ';') | (&('=') '=') | (&('\\') '\\') | (&('~') '~') | (&(' ') %fail('\t' '\n' '\r' '"' '(' ')' ';' '=' '\\' '~') ' ')) .)+) ws)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{8, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position43, tokenIndex43 := position, tokenIndex
+			{
+				position44 := position
+				{
+					position45, tokenIndex45 := position, tokenIndex
+					{
+						position47 := position
+						{
+							position48, tokenIndex48 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l49
+							}
+							position++
+						l50:
+							{
+								position51, tokenIndex51 := position, tokenIndex
+								{
+									position52, tokenIndex52 := position, tokenIndex
+									if buffer[position] != rune('"') {
+										goto l52
+									}
+									position++
+									goto l51
+								l52:
+									position, tokenIndex = position52, tokenIndex52
+								}
+								if !matchDot() {
+									goto l51
+								}
+								goto l50
+							l51:
+								position, tokenIndex = position51, tokenIndex51
+							}
+							if buffer[position] != rune('"') {
+								goto l49
+							}
+							position++
+							goto l48
+						l49:
+							position, tokenIndex = position48, tokenIndex48
+							{
+								position53 := position
+								{
+									position54 := position
+									if buffer[position] != rune('~') {
+										goto l46
+									}
+									position++
+									add(ruletilde, position54)
+								}
+								if buffer[position] != rune('@') {
+									goto l46
+								}
+								position++
+							l55:
+								{
+									position56, tokenIndex56 := position, tokenIndex
+									{
+										position57, tokenIndex57 := position, tokenIndex
+										if buffer[position] != rune('@') {
+											goto l57
+										}
+										position++
+										goto l56
+									l57:
+										position, tokenIndex = position57, tokenIndex57
+									}
+									if !matchDot() {
+										goto l56
+									}
+									goto l55
+								l56:
+									position, tokenIndex = position56, tokenIndex56
+								}
+								if buffer[position] != rune('@') {
+									goto l46
+								}
+								position++
+								add(ruleComplex, position53)
+							}
+						}
+					l48:
+						add(ruleString, position47)
+					}
+					goto l45
+				l46:
+					position, tokenIndex = position45, tokenIndex45
+					{
+						position60, tokenIndex60 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '\t':
+								position++
+							case '\n':
+								position++
+							case '\r':
+								position++
+							case '"':
+								position++
+							case '(':
+								position++
+							case ')':
+								position++
+							case ';':
+								position++
+							case '=':
+								position++
+							case '\\':
+								position++
+							case '~':
+								position++
+							default:
+								if buffer[position] != rune(' ') {
+									goto l60
+								}
+								position++
+							}
+						}
+
+						goto l43
+					l60:
+						position, tokenIndex = position60, tokenIndex60
+					}
+					if !matchDot() {
+						goto l43
+					}
+				l58:
+					{
+						position59, tokenIndex59 := position, tokenIndex
+						{
+							position62, tokenIndex62 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '\t':
+									position++
+								case '\n':
+									position++
+								case '\r':
+									position++
+								case '"':
+									position++
+								case '(':
+									position++
+								case ')':
+									position++
+								case ';':
+									position++
+								case '=':
+									position++
+								case '\\':
+									position++
+								case '~':
+									position++
+								default:
+									if buffer[position] != rune(' ') {
+										goto l62
+									}
+									position++
+								}
+							}
+
+							goto l59
+						l62:
+							position, tokenIndex = position62, tokenIndex62
+						}
+						if !matchDot() {
+							goto l59
+						}
+						goto l58
+					l59:
+						position, tokenIndex = position59, tokenIndex59
+					}
+				}
+			l45:
+				if !_rules[rulews]() {
+					goto l43
+				}
+				add(ruleSymbol, position44)
+			}
+			memoize(8, position43, tokenIndex43, true)
+			return true
+		l43:
+			memoize(8, position43, tokenIndex43, false)
+			position, tokenIndex = position43, tokenIndex43
+			return false
+		},
+		/* 9 String <- <(('"' (!'"' .)* '"') / Complex)> */
+		nil,
+		/* 10 Complex <- <(tilde '@' (!'@' .)* '@')> */
+		nil,
+		/* 11 tilde <- <'~'> */
+		nil,
+		/* 12 open <- <('(' ws)> */
+		nil,
+		/* 13 close <- <(')' ws)> */
+		nil,
+		/* 14 ws <- <((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&(' ') %fail('\t' '\n' '\r') ' '))*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{14, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position69, tokenIndex69 := position, tokenIndex
+			{
+				position70 := position
+			l71:
+				{
+					position72, tokenIndex72 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '\t':
+							position++
+						case '\n':
+							position++
+						case '\r':
+							position++
+						default:
+							if buffer[position] != rune(' ') {
+								goto l72
+							}
+							position++
+						}
+					}
+
+					goto l71
+				l72:
+					position, tokenIndex = position72, tokenIndex72
+				}
+				add(rulews, position70)
+			}
+			memoize(14, position69, tokenIndex69, true)
+			return true
+		},
+	}
+	if p.maxDepth > 0 {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule := rule
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
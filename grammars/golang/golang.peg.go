@@ -0,0 +1,8650 @@
+// Code generated by peg -switch -inline golang.peg. DO NOT EDIT.
+
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// A grammar of the Go programming language, following the Go specification
+// (https://go.dev/ref/spec), generics included.
+//
+// Semicolons are inserted as the specification describes: the tokens after
+// which a newline ends a statement (identifiers, literals, the keywords
+// break, continue, fallthrough and return, the operators ++ and -- and the
+// closing ), ] and }) are followed by Blank, which doesn't cross a newline,
+// and all other tokens are followed by Spacing. EOS then matches an explicit
+// semicolon, a newline, or the closing ) or } that allows omitting it.
+//
+// A composite literal whose type is a type name is ambiguous with the block
+// of an if, for or switch statement, so the expressions between the keyword
+// and the block are matched by the Header rules, which don't match such
+// literals unless they are enclosed in parentheses, brackets or braces.
+
+package golang
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	ruleSourceFile
+	rulePackageClause
+	ruleImportDecl
+	ruleImportSpec
+	ruleTopLevelDecl
+	ruleDeclaration
+	ruleConstDecl
+	ruleConstSpec
+	ruleTypeDecl
+	ruleTypeSpec
+	ruleTypeParameters
+	ruleTypeParamDecl
+	ruleVarDecl
+	ruleVarSpec
+	ruleFunctionDecl
+	ruleMethodDecl
+	ruleType
+	ruleTypeName
+	ruleTypeArgs
+	ruleTypeLit
+	ruleArrayType
+	ruleSliceType
+	ruleStructType
+	ruleFieldDecl
+	ruleEmbeddedField
+	rulePointerType
+	ruleFunctionType
+	ruleSignature
+	ruleResult
+	ruleParameters
+	ruleParameterDecl
+	ruleInterfaceType
+	ruleInterfaceElem
+	ruleTypeElem
+	ruleTypeTerm
+	ruleMapType
+	ruleChannelType
+	ruleExpression
+	ruleUnaryExpr
+	rulePrimaryExpr
+	ruleOperand
+	ruleSuffix
+	ruleIndex
+	ruleSlice
+	ruleArguments
+	ruleExpressionList
+	ruleIdentifierList
+	ruleCompositeLit
+	ruleLiteralType
+	ruleLiteralValue
+	ruleKeyedElement
+	ruleElement
+	ruleFunctionLit
+	ruleBinaryOp
+	ruleUnaryOp
+	ruleHeaderExpression
+	ruleHeaderUnaryExpr
+	ruleHeaderPrimaryExpr
+	ruleHeaderOperand
+	ruleHeaderExpressionList
+	ruleHeaderSimpleStmt
+	ruleBlock
+	ruleStatementList
+	ruleStatement
+	ruleSimpleStmt
+	ruleAssignOp
+	ruleLabeledStmt
+	ruleGoStmt
+	ruleDeferStmt
+	ruleReturnStmt
+	ruleBreakStmt
+	ruleContinueStmt
+	ruleGotoStmt
+	ruleFallthroughStmt
+	ruleIfStmt
+	ruleSwitchStmt
+	ruleTypeSwitchGuard
+	ruleCaseClause
+	ruleSelectStmt
+	ruleCommClause
+	ruleForStmt
+	ruleForClause
+	ruleRangeClause
+	ruleBlank
+	ruleSpacing
+	ruleComment
+	ruleEOS
+	ruleEOF
+	ruleIdentifier
+	ruleLetter
+	ruleBasicLit
+	ruleImaginary
+	ruleInt
+	ruleFloat
+	ruleHexMantissa
+	ruleDecimals
+	ruleExponent
+	ruleHexDigit
+	ruleRune
+	ruleString
+	rulePACKAGE
+	ruleIMPORT
+	ruleCONST
+	ruleTYPE
+	ruleVAR
+	ruleFUNC
+	ruleSTRUCT
+	ruleINTERFACE
+	ruleMAP
+	ruleCHAN
+	ruleGO
+	ruleDEFER
+	ruleRETURN
+	ruleBREAK
+	ruleCONTINUE
+	ruleGOTO
+	ruleFALLTHROUGH
+	ruleIF
+	ruleELSE
+	ruleSWITCH
+	ruleCASE
+	ruleDEFAULT
+	ruleSELECT
+	ruleFOR
+	ruleRANGE
+	ruleLPAR
+	ruleRPAR
+	ruleLBRACK
+	ruleRBRACK
+	ruleLBRACE
+	ruleRBRACE
+	ruleCOMMA
+	ruleSEMI
+	ruleCOLON
+	ruleDOT
+	ruleELLIPSIS
+	ruleDEFINE
+	ruleASSIGN
+	ruleARROW
+	ruleTILDE
+	ruleINC
+	ruleDEC
+	ruleOROR
+	ruleANDAND
+	ruleEQL
+	ruleNEQ
+	ruleLEQ
+	ruleGEQ
+	ruleLSS
+	ruleGTR
+	ruleADD
+	ruleSUB
+	ruleOR
+	ruleXOR
+	ruleMUL
+	ruleQUO
+	ruleREM
+	ruleSHL
+	ruleSHR
+	ruleANDNOT
+	ruleAND
+	ruleNOT
+	ruleADDASSIGN
+	ruleSUBASSIGN
+	ruleMULASSIGN
+	ruleQUOASSIGN
+	ruleREMASSIGN
+	ruleANDASSIGN
+	ruleORASSIGN
+	ruleXORASSIGN
+	ruleSHLASSIGN
+	ruleSHRASSIGN
+	ruleANDNOTASSIGN
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"SourceFile",
+	"PackageClause",
+	"ImportDecl",
+	"ImportSpec",
+	"TopLevelDecl",
+	"Declaration",
+	"ConstDecl",
+	"ConstSpec",
+	"TypeDecl",
+	"TypeSpec",
+	"TypeParameters",
+	"TypeParamDecl",
+	"VarDecl",
+	"VarSpec",
+	"FunctionDecl",
+	"MethodDecl",
+	"Type",
+	"TypeName",
+	"TypeArgs",
+	"TypeLit",
+	"ArrayType",
+	"SliceType",
+	"StructType",
+	"FieldDecl",
+	"EmbeddedField",
+	"PointerType",
+	"FunctionType",
+	"Signature",
+	"Result",
+	"Parameters",
+	"ParameterDecl",
+	"InterfaceType",
+	"InterfaceElem",
+	"TypeElem",
+	"TypeTerm",
+	"MapType",
+	"ChannelType",
+	"Expression",
+	"UnaryExpr",
+	"PrimaryExpr",
+	"Operand",
+	"Suffix",
+	"Index",
+	"Slice",
+	"Arguments",
+	"ExpressionList",
+	"IdentifierList",
+	"CompositeLit",
+	"LiteralType",
+	"LiteralValue",
+	"KeyedElement",
+	"Element",
+	"FunctionLit",
+	"BinaryOp",
+	"UnaryOp",
+	"HeaderExpression",
+	"HeaderUnaryExpr",
+	"HeaderPrimaryExpr",
+	"HeaderOperand",
+	"HeaderExpressionList",
+	"HeaderSimpleStmt",
+	"Block",
+	"StatementList",
+	"Statement",
+	"SimpleStmt",
+	"AssignOp",
+	"LabeledStmt",
+	"GoStmt",
+	"DeferStmt",
+	"ReturnStmt",
+	"BreakStmt",
+	"ContinueStmt",
+	"GotoStmt",
+	"FallthroughStmt",
+	"IfStmt",
+	"SwitchStmt",
+	"TypeSwitchGuard",
+	"CaseClause",
+	"SelectStmt",
+	"CommClause",
+	"ForStmt",
+	"ForClause",
+	"RangeClause",
+	"Blank",
+	"Spacing",
+	"Comment",
+	"EOS",
+	"EOF",
+	"Identifier",
+	"Letter",
+	"BasicLit",
+	"Imaginary",
+	"Int",
+	"Float",
+	"HexMantissa",
+	"Decimals",
+	"Exponent",
+	"HexDigit",
+	"Rune",
+	"String",
+	"PACKAGE",
+	"IMPORT",
+	"CONST",
+	"TYPE",
+	"VAR",
+	"FUNC",
+	"STRUCT",
+	"INTERFACE",
+	"MAP",
+	"CHAN",
+	"GO",
+	"DEFER",
+	"RETURN",
+	"BREAK",
+	"CONTINUE",
+	"GOTO",
+	"FALLTHROUGH",
+	"IF",
+	"ELSE",
+	"SWITCH",
+	"CASE",
+	"DEFAULT",
+	"SELECT",
+	"FOR",
+	"RANGE",
+	"LPAR",
+	"RPAR",
+	"LBRACK",
+	"RBRACK",
+	"LBRACE",
+	"RBRACE",
+	"COMMA",
+	"SEMI",
+	"COLON",
+	"DOT",
+	"ELLIPSIS",
+	"DEFINE",
+	"ASSIGN",
+	"ARROW",
+	"TILDE",
+	"INC",
+	"DEC",
+	"OROR",
+	"ANDAND",
+	"EQL",
+	"NEQ",
+	"LEQ",
+	"GEQ",
+	"LSS",
+	"GTR",
+	"ADD",
+	"SUB",
+	"OR",
+	"XOR",
+	"MUL",
+	"QUO",
+	"REM",
+	"SHL",
+	"SHR",
+	"ANDNOT",
+	"AND",
+	"NOT",
+	"ADDASSIGN",
+	"SUBASSIGN",
+	"MULASSIGN",
+	"QUOASSIGN",
+	"REMASSIGN",
+	"ANDASSIGN",
+	"ORASSIGN",
+	"XORASSIGN",
+	"SHLASSIGN",
+	"SHRASSIGN",
+	"ANDNOTASSIGN",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"SourceFile", "golang.peg", 27, 27, false, false},
+	{"PackageClause", "golang.peg", 29, 29, false, true},
+	{"ImportDecl", "golang.peg", 31, 31, false, true},
+	{"ImportSpec", "golang.peg", 32, 32, false, false},
+	{"TopLevelDecl", "golang.peg", 39, 39, false, true},
+	{"Declaration", "golang.peg", 41, 41, false, false},
+	{"ConstDecl", "golang.peg", 43, 43, false, true},
+	{"ConstSpec", "golang.peg", 44, 44, false, false},
+	{"TypeDecl", "golang.peg", 46, 46, false, true},
+	{"TypeSpec", "golang.peg", 47, 47, false, false},
+	{"TypeParameters", "golang.peg", 49, 49, false, false},
+	{"TypeParamDecl", "golang.peg", 50, 50, false, false},
+	{"VarDecl", "golang.peg", 52, 52, false, true},
+	{"VarSpec", "golang.peg", 53, 53, false, false},
+	{"FunctionDecl", "golang.peg", 55, 55, false, true},
+	{"MethodDecl", "golang.peg", 56, 56, false, true},
+	{"Type", "golang.peg", 63, 63, false, false},
+	{"TypeName", "golang.peg", 64, 64, false, false},
+	{"TypeArgs", "golang.peg", 65, 65, false, false},
+	{"TypeLit", "golang.peg", 67, 75, false, false},
+	{"ArrayType", "golang.peg", 77, 77, false, false},
+	{"SliceType", "golang.peg", 78, 78, false, false},
+	{"StructType", "golang.peg", 80, 80, false, false},
+	{"FieldDecl", "golang.peg", 81, 81, false, true},
+	{"EmbeddedField", "golang.peg", 82, 82, false, true},
+	{"PointerType", "golang.peg", 84, 84, false, true},
+	{"FunctionType", "golang.peg", 86, 86, false, true},
+	{"Signature", "golang.peg", 87, 87, false, false},
+	{"Result", "golang.peg", 88, 88, false, true},
+	{"Parameters", "golang.peg", 89, 89, false, false},
+	{"ParameterDecl", "golang.peg", 90, 90, false, false},
+	{"InterfaceType", "golang.peg", 92, 92, false, true},
+	{"InterfaceElem", "golang.peg", 93, 93, false, true},
+	{"TypeElem", "golang.peg", 94, 94, false, false},
+	{"TypeTerm", "golang.peg", 95, 95, false, false},
+	{"MapType", "golang.peg", 97, 97, false, false},
+	{"ChannelType", "golang.peg", 99, 99, false, true},
+	{"Expression", "golang.peg", 106, 106, false, false},
+	{"UnaryExpr", "golang.peg", 107, 107, false, false},
+	{"PrimaryExpr", "golang.peg", 108, 108, false, true},
+	{"Operand", "golang.peg", 112, 119, false, true},
+	{"Suffix", "golang.peg", 121, 126, false, false},
+	{"Index", "golang.peg", 128, 128, false, true},
+	{"Slice", "golang.peg", 129, 129, false, true},
+	{"Arguments", "golang.peg", 130, 130, false, true},
+	{"ExpressionList", "golang.peg", 132, 132, false, false},
+	{"IdentifierList", "golang.peg", 133, 133, false, false},
+	{"CompositeLit", "golang.peg", 135, 135, false, false},
+	{"LiteralType", "golang.peg", 136, 141, false, true},
+	{"LiteralValue", "golang.peg", 142, 142, false, false},
+	{"KeyedElement", "golang.peg", 143, 143, false, false},
+	{"Element", "golang.peg", 144, 144, false, false},
+	{"FunctionLit", "golang.peg", 146, 146, false, false},
+	{"BinaryOp", "golang.peg", 148, 152, false, false},
+	{"UnaryOp", "golang.peg", 154, 154, false, false},
+	{"HeaderExpression", "golang.peg", 161, 161, false, false},
+	{"HeaderUnaryExpr", "golang.peg", 162, 162, false, false},
+	{"HeaderPrimaryExpr", "golang.peg", 163, 163, false, false},
+	{"HeaderOperand", "golang.peg", 165, 172, false, true},
+	{"HeaderExpressionList", "golang.peg", 174, 174, false, false},
+	{"HeaderSimpleStmt", "golang.peg", 176, 181, false, false},
+	{"Block", "golang.peg", 188, 188, false, false},
+	{"StatementList", "golang.peg", 189, 189, false, false},
+	{"Statement", "golang.peg", 191, 206, false, false},
+	{"SimpleStmt", "golang.peg", 208, 213, false, false},
+	{"AssignOp", "golang.peg", 215, 217, false, false},
+	{"LabeledStmt", "golang.peg", 219, 219, false, false},
+	{"GoStmt", "golang.peg", 220, 220, false, true},
+	{"DeferStmt", "golang.peg", 221, 221, false, true},
+	{"ReturnStmt", "golang.peg", 222, 222, false, true},
+	{"BreakStmt", "golang.peg", 223, 223, false, true},
+	{"ContinueStmt", "golang.peg", 224, 224, false, true},
+	{"GotoStmt", "golang.peg", 225, 225, false, true},
+	{"FallthroughStmt", "golang.peg", 226, 226, false, true},
+	{"IfStmt", "golang.peg", 228, 228, false, false},
+	{"SwitchStmt", "golang.peg", 230, 232, false, true},
+	{"TypeSwitchGuard", "golang.peg", 233, 233, false, true},
+	{"CaseClause", "golang.peg", 234, 234, false, true},
+	{"SelectStmt", "golang.peg", 236, 236, false, true},
+	{"CommClause", "golang.peg", 237, 237, false, true},
+	{"ForStmt", "golang.peg", 239, 239, false, true},
+	{"ForClause", "golang.peg", 240, 240, false, true},
+	{"RangeClause", "golang.peg", 241, 241, false, true},
+	{"Blank", "golang.peg", 250, 250, true, false},
+	{"Spacing", "golang.peg", 251, 251, false, false},
+	{"Comment", "golang.peg", 252, 252, true, true},
+	{"EOS", "golang.peg", 255, 259, false, false},
+	{"EOF", "golang.peg", 261, 261, true, false},
+	{"Identifier", "golang.peg", 263, 263, false, false},
+	{"Letter", "golang.peg", 264, 264, true, false},
+	{"BasicLit", "golang.peg", 266, 266, false, false},
+	{"Imaginary", "golang.peg", 267, 267, false, false},
+	{"Int", "golang.peg", 268, 273, false, false},
+	{"Float", "golang.peg", 274, 278, false, false},
+	{"HexMantissa", "golang.peg", 279, 281, false, true},
+	{"Decimals", "golang.peg", 282, 282, true, false},
+	{"Exponent", "golang.peg", 283, 283, false, false},
+	{"HexDigit", "golang.peg", 284, 284, true, false},
+	{"Rune", "golang.peg", 285, 285, true, true},
+	{"String", "golang.peg", 286, 286, false, false},
+	{"PACKAGE", "golang.peg", 288, 288, false, true},
+	{"IMPORT", "golang.peg", 289, 289, false, true},
+	{"CONST", "golang.peg", 290, 290, false, true},
+	{"TYPE", "golang.peg", 291, 291, false, false},
+	{"VAR", "golang.peg", 292, 292, false, true},
+	{"FUNC", "golang.peg", 293, 293, false, false},
+	{"STRUCT", "golang.peg", 294, 294, false, true},
+	{"INTERFACE", "golang.peg", 295, 295, false, true},
+	{"MAP", "golang.peg", 296, 296, false, true},
+	{"CHAN", "golang.peg", 297, 297, false, false},
+	{"GO", "golang.peg", 298, 298, false, true},
+	{"DEFER", "golang.peg", 299, 299, false, true},
+	{"RETURN", "golang.peg", 300, 300, false, true},
+	{"BREAK", "golang.peg", 301, 301, false, true},
+	{"CONTINUE", "golang.peg", 302, 302, false, true},
+	{"GOTO", "golang.peg", 303, 303, false, true},
+	{"FALLTHROUGH", "golang.peg", 304, 304, false, true},
+	{"IF", "golang.peg", 305, 305, false, true},
+	{"ELSE", "golang.peg", 306, 306, false, true},
+	{"SWITCH", "golang.peg", 307, 307, false, true},
+	{"CASE", "golang.peg", 308, 308, false, false},
+	{"DEFAULT", "golang.peg", 309, 309, false, false},
+	{"SELECT", "golang.peg", 310, 310, false, true},
+	{"FOR", "golang.peg", 311, 311, false, true},
+	{"RANGE", "golang.peg", 312, 312, false, true},
+	{"LPAR", "golang.peg", 314, 314, false, false},
+	{"RPAR", "golang.peg", 315, 315, false, false},
+	{"LBRACK", "golang.peg", 316, 316, false, false},
+	{"RBRACK", "golang.peg", 317, 317, false, false},
+	{"LBRACE", "golang.peg", 318, 318, false, false},
+	{"RBRACE", "golang.peg", 319, 319, false, false},
+	{"COMMA", "golang.peg", 320, 320, false, false},
+	{"SEMI", "golang.peg", 321, 321, false, false},
+	{"COLON", "golang.peg", 322, 322, false, false},
+	{"DOT", "golang.peg", 323, 323, false, false},
+	{"ELLIPSIS", "golang.peg", 324, 324, false, false},
+	{"DEFINE", "golang.peg", 325, 325, false, false},
+	{"ASSIGN", "golang.peg", 326, 326, false, false},
+	{"ARROW", "golang.peg", 327, 327, false, false},
+	{"TILDE", "golang.peg", 328, 328, false, false},
+	{"INC", "golang.peg", 329, 329, false, false},
+	{"DEC", "golang.peg", 330, 330, false, false},
+	{"OROR", "golang.peg", 332, 332, false, true},
+	{"ANDAND", "golang.peg", 333, 333, false, true},
+	{"EQL", "golang.peg", 334, 334, false, true},
+	{"NEQ", "golang.peg", 335, 335, false, true},
+	{"LEQ", "golang.peg", 336, 336, false, true},
+	{"GEQ", "golang.peg", 337, 337, false, true},
+	{"LSS", "golang.peg", 338, 338, false, true},
+	{"GTR", "golang.peg", 339, 339, false, true},
+	{"ADD", "golang.peg", 340, 340, false, false},
+	{"SUB", "golang.peg", 341, 341, false, false},
+	{"OR", "golang.peg", 342, 342, false, false},
+	{"XOR", "golang.peg", 343, 343, false, false},
+	{"MUL", "golang.peg", 344, 344, false, false},
+	{"QUO", "golang.peg", 345, 345, false, true},
+	{"REM", "golang.peg", 346, 346, false, true},
+	{"SHL", "golang.peg", 347, 347, false, true},
+	{"SHR", "golang.peg", 348, 348, false, true},
+	{"ANDNOT", "golang.peg", 349, 349, false, true},
+	{"AND", "golang.peg", 350, 350, false, false},
+	{"NOT", "golang.peg", 351, 351, false, true},
+	{"ADDASSIGN", "golang.peg", 353, 353, false, true},
+	{"SUBASSIGN", "golang.peg", 354, 354, false, true},
+	{"MULASSIGN", "golang.peg", 355, 355, false, true},
+	{"QUOASSIGN", "golang.peg", 356, 356, false, true},
+	{"REMASSIGN", "golang.peg", 357, 357, false, true},
+	{"ANDASSIGN", "golang.peg", 358, 358, false, true},
+	{"ORASSIGN", "golang.peg", 359, 359, false, true},
+	{"XORASSIGN", "golang.peg", 360, 360, false, true},
+	{"SHLASSIGN", "golang.peg", 361, 361, false, true},
+	{"SHRASSIGN", "golang.peg", 362, 362, false, true},
+	{"ANDNOTASSIGN", "golang.peg", 363, 363, false, true},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type Go struct {
+	Buffer         string
+	buffer         []rune
+	rules          [174]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*Go) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *Go) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *Go) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *Go) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *Go) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *Go) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *Go) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *Go) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *Go) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *Go) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *Go
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *Go) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *Go
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *Go) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *Go) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *Go) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func Pretty(pretty bool) func(*Go) error {
+	return func(p *Go) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*Go) error {
+	return func(p *Go) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*Go) error {
+	return func(p *Go) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*Go) error {
+	return func(p *Go) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*Go) error {
+	return func(p *Go) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+func Size(size int) func(*Go) error {
+	return func(p *Go) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*Go) error {
+	return func(p *Go) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *Go) Init(options ...func(*Go) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.farthest = max.end
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 SourceFile <- <(Spacing PackageClause EOS (ImportDecl EOS)* (TopLevelDecl EOS)* EOF)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[ruleSpacing]() {
+					goto l0
+				}
+				{
+					position2 := position
+					{
+						position3 := position
+						if buffer[position] != rune('p') {
+							goto l0
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l0
+						}
+						position++
+						if buffer[position] != rune('c') {
+							goto l0
+						}
+						position++
+						if buffer[position] != rune('k') {
+							goto l0
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l0
+						}
+						position++
+						if buffer[position] != rune('g') {
+							goto l0
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l0
+						}
+						position++
+						{
+							position4, tokenIndex4 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l4
+									}
+									position++
+								}
+							}
+
+							goto l0
+						l4:
+							position, tokenIndex = position4, tokenIndex4
+						}
+						if !_rules[ruleSpacing]() {
+							goto l0
+						}
+						add(rulePACKAGE, position3)
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l0
+					}
+					add(rulePackageClause, position2)
+				}
+				if !_rules[ruleEOS]() {
+					goto l0
+				}
+			l6:
+				{
+					position7, tokenIndex7 := position, tokenIndex
+					{
+						position8 := position
+						{
+							position9 := position
+							if buffer[position] != rune('i') {
+								goto l7
+							}
+							position++
+							if buffer[position] != rune('m') {
+								goto l7
+							}
+							position++
+							if buffer[position] != rune('p') {
+								goto l7
+							}
+							position++
+							if buffer[position] != rune('o') {
+								goto l7
+							}
+							position++
+							if buffer[position] != rune('r') {
+								goto l7
+							}
+							position++
+							if buffer[position] != rune('t') {
+								goto l7
+							}
+							position++
+							{
+								position10, tokenIndex10 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									case '_':
+										position++
+									default:
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l10
+										}
+										position++
+									}
+								}
+
+								goto l7
+							l10:
+								position, tokenIndex = position10, tokenIndex10
+							}
+							if !_rules[ruleSpacing]() {
+								goto l7
+							}
+							add(ruleIMPORT, position9)
+						}
+						{
+							position12, tokenIndex12 := position, tokenIndex
+							if !_rules[ruleImportSpec]() {
+								goto l13
+							}
+							goto l12
+						l13:
+							position, tokenIndex = position12, tokenIndex12
+							if !_rules[ruleLPAR]() {
+								goto l7
+							}
+						l14:
+							{
+								position15, tokenIndex15 := position, tokenIndex
+								if !_rules[ruleImportSpec]() {
+									goto l15
+								}
+								if !_rules[ruleEOS]() {
+									goto l15
+								}
+								goto l14
+							l15:
+								position, tokenIndex = position15, tokenIndex15
+							}
+							if !_rules[ruleRPAR]() {
+								goto l7
+							}
+						}
+					l12:
+						add(ruleImportDecl, position8)
+					}
+					if !_rules[ruleEOS]() {
+						goto l7
+					}
+					goto l6
+				l7:
+					position, tokenIndex = position7, tokenIndex7
+				}
+			l16:
+				{
+					position17, tokenIndex17 := position, tokenIndex
+					{
+						position18 := position
+						{
+							switch buffer[position] {
+							case 'f':
+								{
+									position20, tokenIndex20 := position, tokenIndex
+									{
+										position22 := position
+										if !_rules[ruleFUNC]() {
+											goto l21
+										}
+										if !_rules[ruleIdentifier]() {
+											goto l21
+										}
+										{
+											position23, tokenIndex23 := position, tokenIndex
+											if !_rules[ruleTypeParameters]() {
+												goto l23
+											}
+											goto l24
+										l23:
+											position, tokenIndex = position23, tokenIndex23
+										}
+									l24:
+										if !_rules[ruleSignature]() {
+											goto l21
+										}
+										{
+											position25, tokenIndex25 := position, tokenIndex
+											if !_rules[ruleBlock]() {
+												goto l25
+											}
+											goto l26
+										l25:
+											position, tokenIndex = position25, tokenIndex25
+										}
+									l26:
+										add(ruleFunctionDecl, position22)
+									}
+									goto l20
+								l21:
+									position, tokenIndex = position20, tokenIndex20
+									{
+										position27 := position
+										if !_rules[ruleFUNC]() {
+											goto l17
+										}
+										if !_rules[ruleParameters]() {
+											goto l17
+										}
+										if !_rules[ruleIdentifier]() {
+											goto l17
+										}
+										{
+											position28, tokenIndex28 := position, tokenIndex
+											if !_rules[ruleTypeParameters]() {
+												goto l28
+											}
+											goto l29
+										l28:
+											position, tokenIndex = position28, tokenIndex28
+										}
+									l29:
+										if !_rules[ruleSignature]() {
+											goto l17
+										}
+										{
+											position30, tokenIndex30 := position, tokenIndex
+											if !_rules[ruleBlock]() {
+												goto l30
+											}
+											goto l31
+										l30:
+											position, tokenIndex = position30, tokenIndex30
+										}
+									l31:
+										add(ruleMethodDecl, position27)
+									}
+								}
+							l20:
+								break
+							default:
+								if !_rules[ruleDeclaration]() {
+									goto l17
+								}
+							}
+						}
+
+						add(ruleTopLevelDecl, position18)
+					}
+					if !_rules[ruleEOS]() {
+						goto l17
+					}
+					goto l16
+				l17:
+					position, tokenIndex = position17, tokenIndex17
+				}
+				if !_rules[ruleEOF]() {
+					goto l0
+				}
+				add(ruleSourceFile, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 PackageClause <- <(PACKAGE Identifier)> */
+		nil,
+		/* 2 ImportDecl <- <(IMPORT (ImportSpec / (LPAR (ImportSpec EOS)* RPAR)))> */
+		nil,
+		/* 3 ImportSpec <- <(((&('.') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff]) DOT) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('.') Identifier))? String)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{3, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position34, tokenIndex34 := position, tokenIndex
+			{
+				position35 := position
+				{
+					position36, tokenIndex36 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '.':
+							if !_rules[ruleDOT]() {
+								goto l36
+							}
+						default:
+							if !_rules[ruleIdentifier]() {
+								goto l36
+							}
+						}
+					}
+
+					goto l37
+				l36:
+					position, tokenIndex = position36, tokenIndex36
+				}
+			l37:
+				if !_rules[ruleString]() {
+					goto l34
+				}
+				add(ruleImportSpec, position35)
+			}
+			memoize(3, position34, tokenIndex34, true)
+			return true
+		l34:
+			memoize(3, position34, tokenIndex34, false)
+			position, tokenIndex = position34, tokenIndex34
+			return false
+		},
+		/* 4 TopLevelDecl <- <((&('f') %fail('type' 'var' 'const') (FunctionDecl / MethodDecl)) | (&('c' | 't' | 'v') %fail('func') Declaration))> */
+		nil,
+		/* 5 Declaration <- <((&('t') TypeDecl) | (&('v') VarDecl) | (&('c') %fail('type' 'var') ConstDecl))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{5, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position40, tokenIndex40 := position, tokenIndex
+			{
+				position41 := position
+				{
+					switch buffer[position] {
+					case 't':
+						{
+							position43 := position
+							if !_rules[ruleTYPE]() {
+								goto l40
+							}
+							{
+								switch buffer[position] {
+								case '(':
+									if !_rules[ruleLPAR]() {
+										goto l40
+									}
+								l45:
+									{
+										position46, tokenIndex46 := position, tokenIndex
+										if !_rules[ruleTypeSpec]() {
+											goto l46
+										}
+										if !_rules[ruleEOS]() {
+											goto l46
+										}
+										goto l45
+									l46:
+										position, tokenIndex = position46, tokenIndex46
+									}
+									if !_rules[ruleRPAR]() {
+										goto l40
+									}
+								default:
+									if !_rules[ruleTypeSpec]() {
+										goto l40
+									}
+								}
+							}
+
+							add(ruleTypeDecl, position43)
+						}
+					case 'v':
+						{
+							position47 := position
+							{
+								position48 := position
+								position++
+								if buffer[position] != rune('a') {
+									goto l40
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l40
+								}
+								position++
+								{
+									position49, tokenIndex49 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												goto l49
+											}
+											position++
+										}
+									}
+
+									goto l40
+								l49:
+									position, tokenIndex = position49, tokenIndex49
+								}
+								if !_rules[ruleSpacing]() {
+									goto l40
+								}
+								add(ruleVAR, position48)
+							}
+							{
+								switch buffer[position] {
+								case '(':
+									if !_rules[ruleLPAR]() {
+										goto l40
+									}
+								l52:
+									{
+										position53, tokenIndex53 := position, tokenIndex
+										if !_rules[ruleVarSpec]() {
+											goto l53
+										}
+										if !_rules[ruleEOS]() {
+											goto l53
+										}
+										goto l52
+									l53:
+										position, tokenIndex = position53, tokenIndex53
+									}
+									if !_rules[ruleRPAR]() {
+										goto l40
+									}
+								default:
+									if !_rules[ruleVarSpec]() {
+										goto l40
+									}
+								}
+							}
+
+							add(ruleVarDecl, position47)
+						}
+					default:
+						{
+							position54 := position
+							{
+								position55 := position
+								if buffer[position] != rune('c') {
+									goto l40
+								}
+								position++
+								if buffer[position] != rune('o') {
+									goto l40
+								}
+								position++
+								if buffer[position] != rune('n') {
+									goto l40
+								}
+								position++
+								if buffer[position] != rune('s') {
+									goto l40
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l40
+								}
+								position++
+								{
+									position56, tokenIndex56 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												goto l56
+											}
+											position++
+										}
+									}
+
+									goto l40
+								l56:
+									position, tokenIndex = position56, tokenIndex56
+								}
+								if !_rules[ruleSpacing]() {
+									goto l40
+								}
+								add(ruleCONST, position55)
+							}
+							{
+								switch buffer[position] {
+								case '(':
+									if !_rules[ruleLPAR]() {
+										goto l40
+									}
+								l59:
+									{
+										position60, tokenIndex60 := position, tokenIndex
+										if !_rules[ruleConstSpec]() {
+											goto l60
+										}
+										if !_rules[ruleEOS]() {
+											goto l60
+										}
+										goto l59
+									l60:
+										position, tokenIndex = position60, tokenIndex60
+									}
+									if !_rules[ruleRPAR]() {
+										goto l40
+									}
+								default:
+									if !_rules[ruleConstSpec]() {
+										goto l40
+									}
+								}
+							}
+
+							add(ruleConstDecl, position54)
+						}
+					}
+				}
+
+				add(ruleDeclaration, position41)
+			}
+			memoize(5, position40, tokenIndex40, true)
+			return true
+		l40:
+			memoize(5, position40, tokenIndex40, false)
+			position, tokenIndex = position40, tokenIndex40
+			return false
+		},
+		/* 6 ConstDecl <- <(CONST ((&('(') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff]) (LPAR (ConstSpec EOS)* RPAR)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('(') ConstSpec)))> */
+		nil,
+		/* 7 ConstSpec <- <(IdentifierList (Type? ASSIGN ExpressionList)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{7, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position62, tokenIndex62 := position, tokenIndex
+			{
+				position63 := position
+				if !_rules[ruleIdentifierList]() {
+					goto l62
+				}
+				{
+					position64, tokenIndex64 := position, tokenIndex
+					{
+						position66, tokenIndex66 := position, tokenIndex
+						if !_rules[ruleType]() {
+							goto l66
+						}
+						goto l67
+					l66:
+						position, tokenIndex = position66, tokenIndex66
+					}
+				l67:
+					if !_rules[ruleASSIGN]() {
+						goto l64
+					}
+					if !_rules[ruleExpressionList]() {
+						goto l64
+					}
+					goto l65
+				l64:
+					position, tokenIndex = position64, tokenIndex64
+				}
+			l65:
+				add(ruleConstSpec, position63)
+			}
+			memoize(7, position62, tokenIndex62, true)
+			return true
+		l62:
+			memoize(7, position62, tokenIndex62, false)
+			position, tokenIndex = position62, tokenIndex62
+			return false
+		},
+		/* 8 TypeDecl <- <(TYPE ((&('(') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff]) (LPAR (TypeSpec EOS)* RPAR)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('(') TypeSpec)))> */
+		nil,
+		/* 9 TypeSpec <- <(Identifier TypeParameters? ASSIGN? Type)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{9, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position69, tokenIndex69 := position, tokenIndex
+			{
+				position70 := position
+				if !_rules[ruleIdentifier]() {
+					goto l69
+				}
+				{
+					position71, tokenIndex71 := position, tokenIndex
+					if !_rules[ruleTypeParameters]() {
+						goto l71
+					}
+					goto l72
+				l71:
+					position, tokenIndex = position71, tokenIndex71
+				}
+			l72:
+				{
+					position73, tokenIndex73 := position, tokenIndex
+					if !_rules[ruleASSIGN]() {
+						goto l73
+					}
+					goto l74
+				l73:
+					position, tokenIndex = position73, tokenIndex73
+				}
+			l74:
+				if !_rules[ruleType]() {
+					goto l69
+				}
+				add(ruleTypeSpec, position70)
+			}
+			memoize(9, position69, tokenIndex69, true)
+			return true
+		l69:
+			memoize(9, position69, tokenIndex69, false)
+			position, tokenIndex = position69, tokenIndex69
+			return false
+		},
+		/* 10 TypeParameters <- <(LBRACK TypeParamDecl (COMMA TypeParamDecl)* COMMA? RBRACK)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{10, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position75, tokenIndex75 := position, tokenIndex
+			{
+				position76 := position
+				if !_rules[ruleLBRACK]() {
+					goto l75
+				}
+				if !_rules[ruleTypeParamDecl]() {
+					goto l75
+				}
+			l77:
+				{
+					position78, tokenIndex78 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l78
+					}
+					if !_rules[ruleTypeParamDecl]() {
+						goto l78
+					}
+					goto l77
+				l78:
+					position, tokenIndex = position78, tokenIndex78
+				}
+				{
+					position79, tokenIndex79 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l79
+					}
+					goto l80
+				l79:
+					position, tokenIndex = position79, tokenIndex79
+				}
+			l80:
+				if !_rules[ruleRBRACK]() {
+					goto l75
+				}
+				add(ruleTypeParameters, position76)
+			}
+			memoize(10, position75, tokenIndex75, true)
+			return true
+		l75:
+			memoize(10, position75, tokenIndex75, false)
+			position, tokenIndex = position75, tokenIndex75
+			return false
+		},
+		/* 11 TypeParamDecl <- <(IdentifierList TypeElem)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{11, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position81, tokenIndex81 := position, tokenIndex
+			{
+				position82 := position
+				if !_rules[ruleIdentifierList]() {
+					goto l81
+				}
+				if !_rules[ruleTypeElem]() {
+					goto l81
+				}
+				add(ruleTypeParamDecl, position82)
+			}
+			memoize(11, position81, tokenIndex81, true)
+			return true
+		l81:
+			memoize(11, position81, tokenIndex81, false)
+			position, tokenIndex = position81, tokenIndex81
+			return false
+		},
+		/* 12 VarDecl <- <(VAR ((&('(') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff]) (LPAR (VarSpec EOS)* RPAR)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('(') VarSpec)))> */
+		nil,
+		/* 13 VarSpec <- <(IdentifierList ((&('=') %fail('(' '*' '[' 'func' 'interface' 'map' 'struct' 'chan' '<-' [A-Z] '_' [a-z] [\u0080-\U0010ffff]) (ASSIGN ExpressionList)) | (&('(' | '*' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('=') (Type (ASSIGN ExpressionList)?))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{13, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position84, tokenIndex84 := position, tokenIndex
+			{
+				position85 := position
+				if !_rules[ruleIdentifierList]() {
+					goto l84
+				}
+				{
+					switch buffer[position] {
+					case '=':
+						if !_rules[ruleASSIGN]() {
+							goto l84
+						}
+						if !_rules[ruleExpressionList]() {
+							goto l84
+						}
+					default:
+						if !_rules[ruleType]() {
+							goto l84
+						}
+						{
+							position87, tokenIndex87 := position, tokenIndex
+							if !_rules[ruleASSIGN]() {
+								goto l87
+							}
+							if !_rules[ruleExpressionList]() {
+								goto l87
+							}
+							goto l88
+						l87:
+							position, tokenIndex = position87, tokenIndex87
+						}
+					l88:
+						break
+					}
+				}
+
+				add(ruleVarSpec, position85)
+			}
+			memoize(13, position84, tokenIndex84, true)
+			return true
+		l84:
+			memoize(13, position84, tokenIndex84, false)
+			position, tokenIndex = position84, tokenIndex84
+			return false
+		},
+		/* 14 FunctionDecl <- <(FUNC Identifier TypeParameters? Signature Block?)> */
+		nil,
+		/* 15 MethodDecl <- <(FUNC Parameters Identifier TypeParameters? Signature Block?)> */
+		nil,
+		/* 16 Type <- <((&('(') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff] '*' '[' 'func' 'interface' 'map' 'struct' 'chan' '<-') (LPAR Type RPAR)) | (&('*' | '<' | '[') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff] '(') TypeLit) | (&('c' | 'f' | 'i' | 'm' | 's') %fail('(') ((TypeName TypeArgs?) / TypeLit)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'd' | 'e' | 'g' | 'h' | 'j' | 'k' | 'l' | 'n' | 'o' | 'p' | 'q' | 'r' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('*' '[' 'func' 'interface' 'map' 'struct' 'chan' '<-' '(') (TypeName TypeArgs?)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{16, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position91, tokenIndex91 := position, tokenIndex
+			{
+				position92 := position
+				{
+					switch buffer[position] {
+					case '(':
+						if !_rules[ruleLPAR]() {
+							goto l91
+						}
+						if !_rules[ruleType]() {
+							goto l91
+						}
+						if !_rules[ruleRPAR]() {
+							goto l91
+						}
+					case '*', '<', '[':
+						if !_rules[ruleTypeLit]() {
+							goto l91
+						}
+					case 'c', 'f', 'i', 'm', 's':
+						{
+							position94, tokenIndex94 := position, tokenIndex
+							if !_rules[ruleTypeName]() {
+								goto l95
+							}
+							{
+								position96, tokenIndex96 := position, tokenIndex
+								if !_rules[ruleTypeArgs]() {
+									goto l96
+								}
+								goto l97
+							l96:
+								position, tokenIndex = position96, tokenIndex96
+							}
+						l97:
+							goto l94
+						l95:
+							position, tokenIndex = position94, tokenIndex94
+							if !_rules[ruleTypeLit]() {
+								goto l91
+							}
+						}
+					l94:
+						break
+					default:
+						if !_rules[ruleTypeName]() {
+							goto l91
+						}
+						{
+							position98, tokenIndex98 := position, tokenIndex
+							if !_rules[ruleTypeArgs]() {
+								goto l98
+							}
+							goto l99
+						l98:
+							position, tokenIndex = position98, tokenIndex98
+						}
+					l99:
+						break
+					}
+				}
+
+				add(ruleType, position92)
+			}
+			memoize(16, position91, tokenIndex91, true)
+			return true
+		l91:
+			memoize(16, position91, tokenIndex91, false)
+			position, tokenIndex = position91, tokenIndex91
+			return false
+		},
+		/* 17 TypeName <- <(Identifier (DOT Identifier)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{17, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position100, tokenIndex100 := position, tokenIndex
+			{
+				position101 := position
+				if !_rules[ruleIdentifier]() {
+					goto l100
+				}
+				{
+					position102, tokenIndex102 := position, tokenIndex
+					if !_rules[ruleDOT]() {
+						goto l102
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l102
+					}
+					goto l103
+				l102:
+					position, tokenIndex = position102, tokenIndex102
+				}
+			l103:
+				add(ruleTypeName, position101)
+			}
+			memoize(17, position100, tokenIndex100, true)
+			return true
+		l100:
+			memoize(17, position100, tokenIndex100, false)
+			position, tokenIndex = position100, tokenIndex100
+			return false
+		},
+		/* 18 TypeArgs <- <(LBRACK Type (COMMA Type)* COMMA? RBRACK)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{18, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position104, tokenIndex104 := position, tokenIndex
+			{
+				position105 := position
+				if !_rules[ruleLBRACK]() {
+					goto l104
+				}
+				if !_rules[ruleType]() {
+					goto l104
+				}
+			l106:
+				{
+					position107, tokenIndex107 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l107
+					}
+					if !_rules[ruleType]() {
+						goto l107
+					}
+					goto l106
+				l107:
+					position, tokenIndex = position107, tokenIndex107
+				}
+				{
+					position108, tokenIndex108 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l108
+					}
+					goto l109
+				l108:
+					position, tokenIndex = position108, tokenIndex108
+				}
+			l109:
+				if !_rules[ruleRBRACK]() {
+					goto l104
+				}
+				add(ruleTypeArgs, position105)
+			}
+			memoize(18, position104, tokenIndex104, true)
+			return true
+		l104:
+			memoize(18, position104, tokenIndex104, false)
+			position, tokenIndex = position104, tokenIndex104
+			return false
+		},
+		/* 19 TypeLit <- <((&('*') %fail('[' 'struct' 'func' 'interface' 'map' 'chan' '<-') PointerType) | (&('[') %fail('struct' '*' 'func' 'interface' 'map' 'chan' '<-') (ArrayType / SliceType)) | (&('f') %fail('[' 'struct' '*' 'interface' 'map' 'chan' '<-') FunctionType) | (&('i') %fail('[' 'struct' '*' 'func' 'map' 'chan' '<-') InterfaceType) | (&('m') %fail('[' 'struct' '*' 'func' 'interface' 'chan' '<-') MapType) | (&('s') %fail('[' '*' 'func' 'interface' 'map' 'chan' '<-') StructType) | (&('<' | 'c') %fail('[' 'struct' '*' 'func' 'interface' 'map') ChannelType))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{19, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position110, tokenIndex110 := position, tokenIndex
+			{
+				position111 := position
+				{
+					switch buffer[position] {
+					case '*':
+						{
+							position113 := position
+							if !_rules[ruleMUL]() {
+								goto l110
+							}
+							if !_rules[ruleType]() {
+								goto l110
+							}
+							add(rulePointerType, position113)
+						}
+					case '[':
+						{
+							position114, tokenIndex114 := position, tokenIndex
+							if !_rules[ruleArrayType]() {
+								goto l115
+							}
+							goto l114
+						l115:
+							position, tokenIndex = position114, tokenIndex114
+							if !_rules[ruleSliceType]() {
+								goto l110
+							}
+						}
+					l114:
+						break
+					case 'f':
+						{
+							position116 := position
+							if !_rules[ruleFUNC]() {
+								goto l110
+							}
+							if !_rules[ruleSignature]() {
+								goto l110
+							}
+							add(ruleFunctionType, position116)
+						}
+					case 'i':
+						{
+							position117 := position
+							{
+								position118 := position
+								position++
+								if buffer[position] != rune('n') {
+									goto l110
+								}
+								position++
+								if buffer[position] != rune('t') {
+									goto l110
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l110
+								}
+								position++
+								if buffer[position] != rune('r') {
+									goto l110
+								}
+								position++
+								if buffer[position] != rune('f') {
+									goto l110
+								}
+								position++
+								if buffer[position] != rune('a') {
+									goto l110
+								}
+								position++
+								if buffer[position] != rune('c') {
+									goto l110
+								}
+								position++
+								if buffer[position] != rune('e') {
+									goto l110
+								}
+								position++
+								{
+									position119, tokenIndex119 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												goto l119
+											}
+											position++
+										}
+									}
+
+									goto l110
+								l119:
+									position, tokenIndex = position119, tokenIndex119
+								}
+								if !_rules[ruleSpacing]() {
+									goto l110
+								}
+								add(ruleINTERFACE, position118)
+							}
+							if !_rules[ruleLBRACE]() {
+								goto l110
+							}
+						l121:
+							{
+								position122, tokenIndex122 := position, tokenIndex
+								{
+									position123 := position
+									{
+										position124, tokenIndex124 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l125
+										}
+										if !_rules[ruleSignature]() {
+											goto l125
+										}
+										goto l124
+									l125:
+										position, tokenIndex = position124, tokenIndex124
+										if !_rules[ruleTypeElem]() {
+											goto l122
+										}
+									}
+								l124:
+									add(ruleInterfaceElem, position123)
+								}
+								if !_rules[ruleEOS]() {
+									goto l122
+								}
+								goto l121
+							l122:
+								position, tokenIndex = position122, tokenIndex122
+							}
+							if !_rules[ruleRBRACE]() {
+								goto l110
+							}
+							add(ruleInterfaceType, position117)
+						}
+					case 'm':
+						if !_rules[ruleMapType]() {
+							goto l110
+						}
+					case 's':
+						if !_rules[ruleStructType]() {
+							goto l110
+						}
+					default:
+						{
+							position126 := position
+							{
+								position127, tokenIndex127 := position, tokenIndex
+								if !_rules[ruleCHAN]() {
+									goto l128
+								}
+								{
+									position129, tokenIndex129 := position, tokenIndex
+									if !_rules[ruleARROW]() {
+										goto l129
+									}
+									goto l130
+								l129:
+									position, tokenIndex = position129, tokenIndex129
+								}
+							l130:
+								goto l127
+							l128:
+								position, tokenIndex = position127, tokenIndex127
+								if !_rules[ruleARROW]() {
+									goto l110
+								}
+								if !_rules[ruleCHAN]() {
+									goto l110
+								}
+							}
+						l127:
+							if !_rules[ruleType]() {
+								goto l110
+							}
+							add(ruleChannelType, position126)
+						}
+					}
+				}
+
+				add(ruleTypeLit, position111)
+			}
+			memoize(19, position110, tokenIndex110, true)
+			return true
+		l110:
+			memoize(19, position110, tokenIndex110, false)
+			position, tokenIndex = position110, tokenIndex110
+			return false
+		},
+		/* 20 ArrayType <- <(LBRACK (ELLIPSIS / Expression) RBRACK Type)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{20, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position131, tokenIndex131 := position, tokenIndex
+			{
+				position132 := position
+				if !_rules[ruleLBRACK]() {
+					goto l131
+				}
+				{
+					position133, tokenIndex133 := position, tokenIndex
+					if !_rules[ruleELLIPSIS]() {
+						goto l134
+					}
+					goto l133
+				l134:
+					position, tokenIndex = position133, tokenIndex133
+					if !_rules[ruleExpression]() {
+						goto l131
+					}
+				}
+			l133:
+				if !_rules[ruleRBRACK]() {
+					goto l131
+				}
+				if !_rules[ruleType]() {
+					goto l131
+				}
+				add(ruleArrayType, position132)
+			}
+			memoize(20, position131, tokenIndex131, true)
+			return true
+		l131:
+			memoize(20, position131, tokenIndex131, false)
+			position, tokenIndex = position131, tokenIndex131
+			return false
+		},
+		/* 21 SliceType <- <(LBRACK RBRACK Type)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{21, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position135, tokenIndex135 := position, tokenIndex
+			{
+				position136 := position
+				if !_rules[ruleLBRACK]() {
+					goto l135
+				}
+				if !_rules[ruleRBRACK]() {
+					goto l135
+				}
+				if !_rules[ruleType]() {
+					goto l135
+				}
+				add(ruleSliceType, position136)
+			}
+			memoize(21, position135, tokenIndex135, true)
+			return true
+		l135:
+			memoize(21, position135, tokenIndex135, false)
+			position, tokenIndex = position135, tokenIndex135
+			return false
+		},
+		/* 22 StructType <- <(STRUCT LBRACE (FieldDecl EOS)* RBRACE)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{22, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position137, tokenIndex137 := position, tokenIndex
+			{
+				position138 := position
+				{
+					position139 := position
+					if buffer[position] != rune('s') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l137
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l137
+					}
+					position++
+					{
+						position140, tokenIndex140 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l140
+								}
+								position++
+							}
+						}
+
+						goto l137
+					l140:
+						position, tokenIndex = position140, tokenIndex140
+					}
+					if !_rules[ruleSpacing]() {
+						goto l137
+					}
+					add(ruleSTRUCT, position139)
+				}
+				if !_rules[ruleLBRACE]() {
+					goto l137
+				}
+			l142:
+				{
+					position143, tokenIndex143 := position, tokenIndex
+					{
+						position144 := position
+						{
+							position145, tokenIndex145 := position, tokenIndex
+							if !_rules[ruleIdentifierList]() {
+								goto l146
+							}
+							if !_rules[ruleType]() {
+								goto l146
+							}
+							goto l145
+						l146:
+							position, tokenIndex = position145, tokenIndex145
+							{
+								position147 := position
+								{
+									position148, tokenIndex148 := position, tokenIndex
+									if !_rules[ruleMUL]() {
+										goto l148
+									}
+									goto l149
+								l148:
+									position, tokenIndex = position148, tokenIndex148
+								}
+							l149:
+								if !_rules[ruleTypeName]() {
+									goto l143
+								}
+								{
+									position150, tokenIndex150 := position, tokenIndex
+									if !_rules[ruleTypeArgs]() {
+										goto l150
+									}
+									goto l151
+								l150:
+									position, tokenIndex = position150, tokenIndex150
+								}
+							l151:
+								add(ruleEmbeddedField, position147)
+							}
+						}
+					l145:
+						{
+							position152, tokenIndex152 := position, tokenIndex
+							if !_rules[ruleString]() {
+								goto l152
+							}
+							goto l153
+						l152:
+							position, tokenIndex = position152, tokenIndex152
+						}
+					l153:
+						add(ruleFieldDecl, position144)
+					}
+					if !_rules[ruleEOS]() {
+						goto l143
+					}
+					goto l142
+				l143:
+					position, tokenIndex = position143, tokenIndex143
+				}
+				if !_rules[ruleRBRACE]() {
+					goto l137
+				}
+				add(ruleStructType, position138)
+			}
+			memoize(22, position137, tokenIndex137, true)
+			return true
+		l137:
+			memoize(22, position137, tokenIndex137, false)
+			position, tokenIndex = position137, tokenIndex137
+			return false
+		},
+		/* 23 FieldDecl <- <(((IdentifierList Type) / EmbeddedField) String?)> */
+		nil,
+		/* 24 EmbeddedField <- <(MUL? TypeName TypeArgs?)> */
+		nil,
+		/* 25 PointerType <- <(MUL Type)> */
+		nil,
+		/* 26 FunctionType <- <(FUNC Signature)> */
+		nil,
+		/* 27 Signature <- <(Parameters Result?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{27, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position158, tokenIndex158 := position, tokenIndex
+			{
+				position159 := position
+				if !_rules[ruleParameters]() {
+					goto l158
+				}
+				{
+					position160, tokenIndex160 := position, tokenIndex
+					{
+						position162 := position
+						{
+							position163, tokenIndex163 := position, tokenIndex
+							if !_rules[ruleParameters]() {
+								goto l164
+							}
+							goto l163
+						l164:
+							position, tokenIndex = position163, tokenIndex163
+							if !_rules[ruleType]() {
+								goto l160
+							}
+						}
+					l163:
+						add(ruleResult, position162)
+					}
+					goto l161
+				l160:
+					position, tokenIndex = position160, tokenIndex160
+				}
+			l161:
+				add(ruleSignature, position159)
+			}
+			memoize(27, position158, tokenIndex158, true)
+			return true
+		l158:
+			memoize(27, position158, tokenIndex158, false)
+			position, tokenIndex = position158, tokenIndex158
+			return false
+		},
+		/* 28 Result <- <(Parameters / Type)> */
+		nil,
+		/* 29 Parameters <- <(LPAR (ParameterDecl (COMMA ParameterDecl)* COMMA?)? RPAR)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{29, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position166, tokenIndex166 := position, tokenIndex
+			{
+				position167 := position
+				if !_rules[ruleLPAR]() {
+					goto l166
+				}
+				{
+					position168, tokenIndex168 := position, tokenIndex
+					if !_rules[ruleParameterDecl]() {
+						goto l168
+					}
+				l170:
+					{
+						position171, tokenIndex171 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l171
+						}
+						if !_rules[ruleParameterDecl]() {
+							goto l171
+						}
+						goto l170
+					l171:
+						position, tokenIndex = position171, tokenIndex171
+					}
+					{
+						position172, tokenIndex172 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l172
+						}
+						goto l173
+					l172:
+						position, tokenIndex = position172, tokenIndex172
+					}
+				l173:
+					goto l169
+				l168:
+					position, tokenIndex = position168, tokenIndex168
+				}
+			l169:
+				if !_rules[ruleRPAR]() {
+					goto l166
+				}
+				add(ruleParameters, position167)
+			}
+			memoize(29, position166, tokenIndex166, true)
+			return true
+		l166:
+			memoize(29, position166, tokenIndex166, false)
+			position, tokenIndex = position166, tokenIndex166
+			return false
+		},
+		/* 30 ParameterDecl <- <((IdentifierList ELLIPSIS? Type) / (ELLIPSIS? Type))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{30, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position174, tokenIndex174 := position, tokenIndex
+			{
+				position175 := position
+				{
+					position176, tokenIndex176 := position, tokenIndex
+					if !_rules[ruleIdentifierList]() {
+						goto l177
+					}
+					{
+						position178, tokenIndex178 := position, tokenIndex
+						if !_rules[ruleELLIPSIS]() {
+							goto l178
+						}
+						goto l179
+					l178:
+						position, tokenIndex = position178, tokenIndex178
+					}
+				l179:
+					if !_rules[ruleType]() {
+						goto l177
+					}
+					goto l176
+				l177:
+					position, tokenIndex = position176, tokenIndex176
+					{
+						position180, tokenIndex180 := position, tokenIndex
+						if !_rules[ruleELLIPSIS]() {
+							goto l180
+						}
+						goto l181
+					l180:
+						position, tokenIndex = position180, tokenIndex180
+					}
+				l181:
+					if !_rules[ruleType]() {
+						goto l174
+					}
+				}
+			l176:
+				add(ruleParameterDecl, position175)
+			}
+			memoize(30, position174, tokenIndex174, true)
+			return true
+		l174:
+			memoize(30, position174, tokenIndex174, false)
+			position, tokenIndex = position174, tokenIndex174
+			return false
+		},
+		/* 31 InterfaceType <- <(INTERFACE LBRACE (InterfaceElem EOS)* RBRACE)> */
+		nil,
+		/* 32 InterfaceElem <- <((Identifier Signature) / TypeElem)> */
+		nil,
+		/* 33 TypeElem <- <(TypeTerm (OR TypeTerm)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{33, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position184, tokenIndex184 := position, tokenIndex
+			{
+				position185 := position
+				if !_rules[ruleTypeTerm]() {
+					goto l184
+				}
+			l186:
+				{
+					position187, tokenIndex187 := position, tokenIndex
+					if !_rules[ruleOR]() {
+						goto l187
+					}
+					if !_rules[ruleTypeTerm]() {
+						goto l187
+					}
+					goto l186
+				l187:
+					position, tokenIndex = position187, tokenIndex187
+				}
+				add(ruleTypeElem, position185)
+			}
+			memoize(33, position184, tokenIndex184, true)
+			return true
+		l184:
+			memoize(33, position184, tokenIndex184, false)
+			position, tokenIndex = position184, tokenIndex184
+			return false
+		},
+		/* 34 TypeTerm <- <(TILDE? Type)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{34, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position188, tokenIndex188 := position, tokenIndex
+			{
+				position189 := position
+				{
+					position190, tokenIndex190 := position, tokenIndex
+					if !_rules[ruleTILDE]() {
+						goto l190
+					}
+					goto l191
+				l190:
+					position, tokenIndex = position190, tokenIndex190
+				}
+			l191:
+				if !_rules[ruleType]() {
+					goto l188
+				}
+				add(ruleTypeTerm, position189)
+			}
+			memoize(34, position188, tokenIndex188, true)
+			return true
+		l188:
+			memoize(34, position188, tokenIndex188, false)
+			position, tokenIndex = position188, tokenIndex188
+			return false
+		},
+		/* 35 MapType <- <(MAP LBRACK Type RBRACK Type)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{35, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position192, tokenIndex192 := position, tokenIndex
+			{
+				position193 := position
+				{
+					position194 := position
+					if buffer[position] != rune('m') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l192
+					}
+					position++
+					{
+						position195, tokenIndex195 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l195
+								}
+								position++
+							}
+						}
+
+						goto l192
+					l195:
+						position, tokenIndex = position195, tokenIndex195
+					}
+					if !_rules[ruleSpacing]() {
+						goto l192
+					}
+					add(ruleMAP, position194)
+				}
+				if !_rules[ruleLBRACK]() {
+					goto l192
+				}
+				if !_rules[ruleType]() {
+					goto l192
+				}
+				if !_rules[ruleRBRACK]() {
+					goto l192
+				}
+				if !_rules[ruleType]() {
+					goto l192
+				}
+				add(ruleMapType, position193)
+			}
+			memoize(35, position192, tokenIndex192, true)
+			return true
+		l192:
+			memoize(35, position192, tokenIndex192, false)
+			position, tokenIndex = position192, tokenIndex192
+			return false
+		},
+		/* 36 ChannelType <- <(((CHAN ARROW?) / (ARROW CHAN)) Type)> */
+		nil,
+		/* 37 Expression <- <(UnaryExpr (BinaryOp UnaryExpr)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{37, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position198, tokenIndex198 := position, tokenIndex
+			{
+				position199 := position
+				if !_rules[ruleUnaryExpr]() {
+					goto l198
+				}
+			l200:
+				{
+					position201, tokenIndex201 := position, tokenIndex
+					if !_rules[ruleBinaryOp]() {
+						goto l201
+					}
+					if !_rules[ruleUnaryExpr]() {
+						goto l201
+					}
+					goto l200
+				l201:
+					position, tokenIndex = position201, tokenIndex201
+				}
+				add(ruleExpression, position199)
+			}
+			memoize(37, position198, tokenIndex198, true)
+			return true
+		l198:
+			memoize(37, position198, tokenIndex198, false)
+			position, tokenIndex = position198, tokenIndex198
+			return false
+		},
+		/* 38 UnaryExpr <- <((UnaryOp UnaryExpr) / PrimaryExpr)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{38, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position202, tokenIndex202 := position, tokenIndex
+			{
+				position203 := position
+				{
+					position204, tokenIndex204 := position, tokenIndex
+					if !_rules[ruleUnaryOp]() {
+						goto l205
+					}
+					if !_rules[ruleUnaryExpr]() {
+						goto l205
+					}
+					goto l204
+				l205:
+					position, tokenIndex = position204, tokenIndex204
+					{
+						position206 := position
+						{
+							position207 := position
+							{
+								switch buffer[position] {
+								case '"', '\'', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '`':
+									if !_rules[ruleBasicLit]() {
+										goto l202
+									}
+								case '(':
+									if !_rules[ruleLPAR]() {
+										goto l202
+									}
+									{
+										position209, tokenIndex209 := position, tokenIndex
+										if !_rules[ruleExpression]() {
+											goto l210
+										}
+										if !_rules[ruleRPAR]() {
+											goto l210
+										}
+										goto l209
+									l210:
+										position, tokenIndex = position209, tokenIndex209
+										if !_rules[ruleType]() {
+											goto l202
+										}
+										if !_rules[ruleRPAR]() {
+											goto l202
+										}
+									}
+								l209:
+									break
+								case '*', '<':
+									if !_rules[ruleTypeLit]() {
+										goto l202
+									}
+								case '[':
+									{
+										position211, tokenIndex211 := position, tokenIndex
+										if !_rules[ruleCompositeLit]() {
+											goto l212
+										}
+										goto l211
+									l212:
+										position, tokenIndex = position211, tokenIndex211
+										if !_rules[ruleTypeLit]() {
+											goto l202
+										}
+									}
+								l211:
+									break
+								case 'c', 'i', 'm', 's':
+									{
+										position213, tokenIndex213 := position, tokenIndex
+										if !_rules[ruleCompositeLit]() {
+											goto l214
+										}
+										goto l213
+									l214:
+										position, tokenIndex = position213, tokenIndex213
+										if !_rules[ruleIdentifier]() {
+											goto l215
+										}
+										goto l213
+									l215:
+										position, tokenIndex = position213, tokenIndex213
+										if !_rules[ruleTypeLit]() {
+											goto l202
+										}
+									}
+								l213:
+									break
+								case 'f':
+									{
+										position216, tokenIndex216 := position, tokenIndex
+										if !_rules[ruleCompositeLit]() {
+											goto l217
+										}
+										goto l216
+									l217:
+										position, tokenIndex = position216, tokenIndex216
+										if !_rules[ruleFunctionLit]() {
+											goto l218
+										}
+										goto l216
+									l218:
+										position, tokenIndex = position216, tokenIndex216
+										if !_rules[ruleIdentifier]() {
+											goto l219
+										}
+										goto l216
+									l219:
+										position, tokenIndex = position216, tokenIndex216
+										if !_rules[ruleTypeLit]() {
+											goto l202
+										}
+									}
+								l216:
+									break
+								default:
+									{
+										position220, tokenIndex220 := position, tokenIndex
+										if !_rules[ruleCompositeLit]() {
+											goto l221
+										}
+										goto l220
+									l221:
+										position, tokenIndex = position220, tokenIndex220
+										if !_rules[ruleIdentifier]() {
+											goto l202
+										}
+									}
+								l220:
+									break
+								}
+							}
+
+							add(ruleOperand, position207)
+						}
+					l222:
+						{
+							position223, tokenIndex223 := position, tokenIndex
+							if !_rules[ruleSuffix]() {
+								goto l223
+							}
+							goto l222
+						l223:
+							position, tokenIndex = position223, tokenIndex223
+						}
+						add(rulePrimaryExpr, position206)
+					}
+				}
+			l204:
+				add(ruleUnaryExpr, position203)
+			}
+			memoize(38, position202, tokenIndex202, true)
+			return true
+		l202:
+			memoize(38, position202, tokenIndex202, false)
+			position, tokenIndex = position202, tokenIndex202
+			return false
+		},
+		/* 39 PrimaryExpr <- <(Operand Suffix*)> */
+		nil,
+		/* 40 Operand <- <((&('"' | '\'' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '`') %fail('[' 'map' [A-Z] '_' [a-z] [\u0080-\U0010ffff] 'struct' 'func' '(' '*' 'interface' 'chan' '<-') BasicLit) | (&('(') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] '[' 'map' [A-Z] '_' [a-z] [\u0080-\U0010ffff] 'struct' 'func' '*' 'interface' 'chan' '<-') (LPAR ((Expression RPAR) / (Type RPAR)))) | (&('*' | '<') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] '[' 'map' [A-Z] '_' [a-z] [\u0080-\U0010ffff] 'struct' 'func' '(') TypeLit) | (&('[') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'func' [A-Z] '_' [a-z] [\u0080-\U0010ffff] '(') (CompositeLit / TypeLit)) | (&('c' | 'i' | 'm' | 's') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'func' '(') (CompositeLit / Identifier / TypeLit)) | (&('f') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] '(') (CompositeLit / FunctionLit / Identifier / TypeLit)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'd' | 'e' | 'g' | 'h' | 'j' | 'k' | 'l' | 'n' | 'o' | 'p' | 'q' | 'r' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'func' '(' '[' 'struct' '*' 'interface' 'map' 'chan' '<-') (CompositeLit / Identifier)))> */
+		nil,
+		/* 41 Suffix <- <((&('.') %fail('[' '(') (DOT ((&('(') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff]) (LPAR Type RPAR)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('(') Identifier)))) | (&('[') %fail('.' '(') (Index / Slice)) | (&('(') %fail('.' '[') Arguments))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{41, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position226, tokenIndex226 := position, tokenIndex
+			{
+				position227 := position
+				{
+					switch buffer[position] {
+					case '.':
+						if !_rules[ruleDOT]() {
+							goto l226
+						}
+						{
+							switch buffer[position] {
+							case '(':
+								if !_rules[ruleLPAR]() {
+									goto l226
+								}
+								if !_rules[ruleType]() {
+									goto l226
+								}
+								if !_rules[ruleRPAR]() {
+									goto l226
+								}
+							default:
+								if !_rules[ruleIdentifier]() {
+									goto l226
+								}
+							}
+						}
+
+					case '[':
+						{
+							position230, tokenIndex230 := position, tokenIndex
+							{
+								position232 := position
+								if !_rules[ruleLBRACK]() {
+									goto l231
+								}
+								if !_rules[ruleExpression]() {
+									goto l231
+								}
+							l233:
+								{
+									position234, tokenIndex234 := position, tokenIndex
+									if !_rules[ruleCOMMA]() {
+										goto l234
+									}
+									if !_rules[ruleExpression]() {
+										goto l234
+									}
+									goto l233
+								l234:
+									position, tokenIndex = position234, tokenIndex234
+								}
+								{
+									position235, tokenIndex235 := position, tokenIndex
+									if !_rules[ruleCOMMA]() {
+										goto l235
+									}
+									goto l236
+								l235:
+									position, tokenIndex = position235, tokenIndex235
+								}
+							l236:
+								if !_rules[ruleRBRACK]() {
+									goto l231
+								}
+								add(ruleIndex, position232)
+							}
+							goto l230
+						l231:
+							position, tokenIndex = position230, tokenIndex230
+							{
+								position237 := position
+								if !_rules[ruleLBRACK]() {
+									goto l226
+								}
+								{
+									position238, tokenIndex238 := position, tokenIndex
+									if !_rules[ruleExpression]() {
+										goto l238
+									}
+									goto l239
+								l238:
+									position, tokenIndex = position238, tokenIndex238
+								}
+							l239:
+								if !_rules[ruleCOLON]() {
+									goto l226
+								}
+								{
+									position240, tokenIndex240 := position, tokenIndex
+									if !_rules[ruleExpression]() {
+										goto l240
+									}
+									goto l241
+								l240:
+									position, tokenIndex = position240, tokenIndex240
+								}
+							l241:
+								{
+									position242, tokenIndex242 := position, tokenIndex
+									if !_rules[ruleCOLON]() {
+										goto l242
+									}
+									if !_rules[ruleExpression]() {
+										goto l242
+									}
+									goto l243
+								l242:
+									position, tokenIndex = position242, tokenIndex242
+								}
+							l243:
+								if !_rules[ruleRBRACK]() {
+									goto l226
+								}
+								add(ruleSlice, position237)
+							}
+						}
+					l230:
+						break
+					default:
+						{
+							position244 := position
+							if !_rules[ruleLPAR]() {
+								goto l226
+							}
+							{
+								position245, tokenIndex245 := position, tokenIndex
+								if !_rules[ruleExpressionList]() {
+									goto l245
+								}
+								{
+									position247, tokenIndex247 := position, tokenIndex
+									if !_rules[ruleELLIPSIS]() {
+										goto l247
+									}
+									goto l248
+								l247:
+									position, tokenIndex = position247, tokenIndex247
+								}
+							l248:
+								{
+									position249, tokenIndex249 := position, tokenIndex
+									if !_rules[ruleCOMMA]() {
+										goto l249
+									}
+									goto l250
+								l249:
+									position, tokenIndex = position249, tokenIndex249
+								}
+							l250:
+								goto l246
+							l245:
+								position, tokenIndex = position245, tokenIndex245
+							}
+						l246:
+							if !_rules[ruleRPAR]() {
+								goto l226
+							}
+							add(ruleArguments, position244)
+						}
+					}
+				}
+
+				add(ruleSuffix, position227)
+			}
+			memoize(41, position226, tokenIndex226, true)
+			return true
+		l226:
+			memoize(41, position226, tokenIndex226, false)
+			position, tokenIndex = position226, tokenIndex226
+			return false
+		},
+		/* 42 Index <- <(LBRACK Expression (COMMA Expression)* COMMA? RBRACK)> */
+		nil,
+		/* 43 Slice <- <(LBRACK Expression? COLON Expression? (COLON Expression)? RBRACK)> */
+		nil,
+		/* 44 Arguments <- <(LPAR (ExpressionList ELLIPSIS? COMMA?)? RPAR)> */
+		nil,
+		/* 45 ExpressionList <- <(Expression (COMMA Expression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{45, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position254, tokenIndex254 := position, tokenIndex
+			{
+				position255 := position
+				if !_rules[ruleExpression]() {
+					goto l254
+				}
+			l256:
+				{
+					position257, tokenIndex257 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l257
+					}
+					if !_rules[ruleExpression]() {
+						goto l257
+					}
+					goto l256
+				l257:
+					position, tokenIndex = position257, tokenIndex257
+				}
+				add(ruleExpressionList, position255)
+			}
+			memoize(45, position254, tokenIndex254, true)
+			return true
+		l254:
+			memoize(45, position254, tokenIndex254, false)
+			position, tokenIndex = position254, tokenIndex254
+			return false
+		},
+		/* 46 IdentifierList <- <(Identifier (COMMA Identifier)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{46, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position258, tokenIndex258 := position, tokenIndex
+			{
+				position259 := position
+				if !_rules[ruleIdentifier]() {
+					goto l258
+				}
+			l260:
+				{
+					position261, tokenIndex261 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l261
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l261
+					}
+					goto l260
+				l261:
+					position, tokenIndex = position261, tokenIndex261
+				}
+				add(ruleIdentifierList, position259)
+			}
+			memoize(46, position258, tokenIndex258, true)
+			return true
+		l258:
+			memoize(46, position258, tokenIndex258, false)
+			position, tokenIndex = position258, tokenIndex258
+			return false
+		},
+		/* 47 CompositeLit <- <(LiteralType LiteralValue)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{47, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position262, tokenIndex262 := position, tokenIndex
+			{
+				position263 := position
+				{
+					position264 := position
+					{
+						switch buffer[position] {
+						case '[':
+							{
+								position266, tokenIndex266 := position, tokenIndex
+								if !_rules[ruleArrayType]() {
+									goto l267
+								}
+								goto l266
+							l267:
+								position, tokenIndex = position266, tokenIndex266
+								if !_rules[ruleSliceType]() {
+									goto l262
+								}
+							}
+						l266:
+							break
+						case 'm':
+							{
+								position268, tokenIndex268 := position, tokenIndex
+								if !_rules[ruleMapType]() {
+									goto l269
+								}
+								goto l268
+							l269:
+								position, tokenIndex = position268, tokenIndex268
+								if !_rules[ruleTypeName]() {
+									goto l262
+								}
+								{
+									position270, tokenIndex270 := position, tokenIndex
+									if !_rules[ruleTypeArgs]() {
+										goto l270
+									}
+									goto l271
+								l270:
+									position, tokenIndex = position270, tokenIndex270
+								}
+							l271:
+							}
+						l268:
+							break
+						case 's':
+							{
+								position272, tokenIndex272 := position, tokenIndex
+								if !_rules[ruleStructType]() {
+									goto l273
+								}
+								goto l272
+							l273:
+								position, tokenIndex = position272, tokenIndex272
+								if !_rules[ruleTypeName]() {
+									goto l262
+								}
+								{
+									position274, tokenIndex274 := position, tokenIndex
+									if !_rules[ruleTypeArgs]() {
+										goto l274
+									}
+									goto l275
+								l274:
+									position, tokenIndex = position274, tokenIndex274
+								}
+							l275:
+							}
+						l272:
+							break
+						default:
+							if !_rules[ruleTypeName]() {
+								goto l262
+							}
+							{
+								position276, tokenIndex276 := position, tokenIndex
+								if !_rules[ruleTypeArgs]() {
+									goto l276
+								}
+								goto l277
+							l276:
+								position, tokenIndex = position276, tokenIndex276
+							}
+						l277:
+							break
+						}
+					}
+
+					add(ruleLiteralType, position264)
+				}
+				if !_rules[ruleLiteralValue]() {
+					goto l262
+				}
+				add(ruleCompositeLit, position263)
+			}
+			memoize(47, position262, tokenIndex262, true)
+			return true
+		l262:
+			memoize(47, position262, tokenIndex262, false)
+			position, tokenIndex = position262, tokenIndex262
+			return false
+		},
+		/* 48 LiteralType <- <((&('[') %fail('struct' 'map' [A-Z] '_' [a-z] [\u0080-\U0010ffff]) (ArrayType / SliceType)) | (&('m') %fail('struct' '[') (MapType / (TypeName TypeArgs?))) | (&('s') %fail('[' 'map') (StructType / (TypeName TypeArgs?))) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'n' | 'o' | 'p' | 'q' | 'r' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('struct' '[' 'map') (TypeName TypeArgs?)))> */
+		nil,
+		/* 49 LiteralValue <- <(LBRACE (KeyedElement (COMMA KeyedElement)* COMMA?)? RBRACE)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{49, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position279, tokenIndex279 := position, tokenIndex
+			{
+				position280 := position
+				if !_rules[ruleLBRACE]() {
+					goto l279
+				}
+				{
+					position281, tokenIndex281 := position, tokenIndex
+					if !_rules[ruleKeyedElement]() {
+						goto l281
+					}
+				l283:
+					{
+						position284, tokenIndex284 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l284
+						}
+						if !_rules[ruleKeyedElement]() {
+							goto l284
+						}
+						goto l283
+					l284:
+						position, tokenIndex = position284, tokenIndex284
+					}
+					{
+						position285, tokenIndex285 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l285
+						}
+						goto l286
+					l285:
+						position, tokenIndex = position285, tokenIndex285
+					}
+				l286:
+					goto l282
+				l281:
+					position, tokenIndex = position281, tokenIndex281
+				}
+			l282:
+				if !_rules[ruleRBRACE]() {
+					goto l279
+				}
+				add(ruleLiteralValue, position280)
+			}
+			memoize(49, position279, tokenIndex279, true)
+			return true
+		l279:
+			memoize(49, position279, tokenIndex279, false)
+			position, tokenIndex = position279, tokenIndex279
+			return false
+		},
+		/* 50 KeyedElement <- <((Element COLON)? Element)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{50, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position287, tokenIndex287 := position, tokenIndex
+			{
+				position288 := position
+				{
+					position289, tokenIndex289 := position, tokenIndex
+					if !_rules[ruleElement]() {
+						goto l289
+					}
+					if !_rules[ruleCOLON]() {
+						goto l289
+					}
+					goto l290
+				l289:
+					position, tokenIndex = position289, tokenIndex289
+				}
+			l290:
+				if !_rules[ruleElement]() {
+					goto l287
+				}
+				add(ruleKeyedElement, position288)
+			}
+			memoize(50, position287, tokenIndex287, true)
+			return true
+		l287:
+			memoize(50, position287, tokenIndex287, false)
+			position, tokenIndex = position287, tokenIndex287
+			return false
+		},
+		/* 51 Element <- <((&('{') %fail('!' '&' '*' '-' '<-' '^' '~' '+' '"' '`' '\'' '.' '0' [0-9] [1-9] '[' 'map' [A-Z] '_' [a-z] [\u0080-\U0010ffff] 'struct' 'func' '(' 'interface' 'chan') LiteralValue) | (&('!' | '"' | '&' | '\'' | '(' | '*' | '+' | '-' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '~' | [\u0080-\U0010ffff]) %fail('{') Expression))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{51, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position291, tokenIndex291 := position, tokenIndex
+			{
+				position292 := position
+				{
+					switch buffer[position] {
+					case '{':
+						if !_rules[ruleLiteralValue]() {
+							goto l291
+						}
+					default:
+						if !_rules[ruleExpression]() {
+							goto l291
+						}
+					}
+				}
+
+				add(ruleElement, position292)
+			}
+			memoize(51, position291, tokenIndex291, true)
+			return true
+		l291:
+			memoize(51, position291, tokenIndex291, false)
+			position, tokenIndex = position291, tokenIndex291
+			return false
+		},
+		/* 52 FunctionLit <- <(FUNC Signature Block)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{52, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position294, tokenIndex294 := position, tokenIndex
+			{
+				position295 := position
+				if !_rules[ruleFUNC]() {
+					goto l294
+				}
+				if !_rules[ruleSignature]() {
+					goto l294
+				}
+				if !_rules[ruleBlock]() {
+					goto l294
+				}
+				add(ruleFunctionLit, position295)
+			}
+			memoize(52, position294, tokenIndex294, true)
+			return true
+		l294:
+			memoize(52, position294, tokenIndex294, false)
+			position, tokenIndex = position294, tokenIndex294
+			return false
+		},
+		/* 53 BinaryOp <- <((&('%') %fail('||' '&&' '==' '!=' '<=' '>=' '<' '>' '+' '-' '|' '^' '*' '/' '<<' '>>' '&^' '&') REM) | (&('&') %fail('||' '==' '!=' '<=' '>=' '<' '>' '+' '-' '|' '^' '*' '/' '%' '<<' '>>') (ANDAND / ANDNOT / AND)) | (&('*') %fail('||' '&&' '==' '!=' '<=' '>=' '<' '>' '+' '-' '|' '^' '/' '%' '<<' '>>' '&^' '&') MUL) | (&('+') %fail('||' '&&' '==' '!=' '<=' '>=' '<' '>' '-' '|' '^' '*' '/' '%' '<<' '>>' '&^' '&') ADD) | (&('-') %fail('||' '&&' '==' '!=' '<=' '>=' '<' '>' '+' '|' '^' '*' '/' '%' '<<' '>>' '&^' '&') SUB) | (&('/') %fail('||' '&&' '==' '!=' '<=' '>=' '<' '>' '+' '-' '|' '^' '*' '%' '<<' '>>' '&^' '&') QUO) | (&('<') %fail('||' '&&' '==' '!=' '>=' '>' '+' '-' '|' '^' '*' '/' '%' '>>' '&^' '&') (LEQ / LSS / SHL)) | (&('=') %fail('||' '&&' '!=' '<=' '>=' '<' '>' '+' '-' '|' '^' '*' '/' '%' '<<' '>>' '&^' '&') EQL) | (&('>') %fail('||' '&&' '==' '!=' '<=' '<' '+' '-' '|' '^' '*' '/' '%' '<<' '&^' '&') (GEQ / GTR / SHR)) | (&('^') %fail('||' '&&' '==' '!=' '<=' '>=' '<' '>' '+' '-' '|' '*' '/' '%' '<<' '>>' '&^' '&') XOR) | (&('|') %fail('&&' '==' '!=' '<=' '>=' '<' '>' '+' '-' '^' '*' '/' '%' '<<' '>>' '&^' '&') (OROR / OR)) | (&('!') %fail('||' '&&' '==' '<=' '>=' '<' '>' '+' '-' '|' '^' '*' '/' '%' '<<' '>>' '&^' '&') NEQ))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{53, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position296, tokenIndex296 := position, tokenIndex
+			{
+				position297 := position
+				{
+					switch buffer[position] {
+					case '%':
+						{
+							position299 := position
+							position++
+							{
+								position300, tokenIndex300 := position, tokenIndex
+								if buffer[position] != rune('=') {
+									goto l300
+								}
+								position++
+								goto l296
+							l300:
+								position, tokenIndex = position300, tokenIndex300
+							}
+							if !_rules[ruleSpacing]() {
+								goto l296
+							}
+							add(ruleREM, position299)
+						}
+					case '&':
+						{
+							position301, tokenIndex301 := position, tokenIndex
+							{
+								position303 := position
+								if buffer[position] != rune('&') {
+									goto l302
+								}
+								position++
+								if buffer[position] != rune('&') {
+									goto l302
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l302
+								}
+								add(ruleANDAND, position303)
+							}
+							goto l301
+						l302:
+							position, tokenIndex = position301, tokenIndex301
+							{
+								position305 := position
+								if buffer[position] != rune('&') {
+									goto l304
+								}
+								position++
+								if buffer[position] != rune('^') {
+									goto l304
+								}
+								position++
+								{
+									position306, tokenIndex306 := position, tokenIndex
+									if buffer[position] != rune('=') {
+										goto l306
+									}
+									position++
+									goto l304
+								l306:
+									position, tokenIndex = position306, tokenIndex306
+								}
+								if !_rules[ruleSpacing]() {
+									goto l304
+								}
+								add(ruleANDNOT, position305)
+							}
+							goto l301
+						l304:
+							position, tokenIndex = position301, tokenIndex301
+							if !_rules[ruleAND]() {
+								goto l296
+							}
+						}
+					l301:
+						break
+					case '*':
+						if !_rules[ruleMUL]() {
+							goto l296
+						}
+					case '+':
+						if !_rules[ruleADD]() {
+							goto l296
+						}
+					case '-':
+						if !_rules[ruleSUB]() {
+							goto l296
+						}
+					case '/':
+						{
+							position307 := position
+							position++
+							{
+								position308, tokenIndex308 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '*':
+										position++
+									case '=':
+										position++
+									default:
+										if buffer[position] != rune('/') {
+											goto l308
+										}
+										position++
+									}
+								}
+
+								goto l296
+							l308:
+								position, tokenIndex = position308, tokenIndex308
+							}
+							if !_rules[ruleSpacing]() {
+								goto l296
+							}
+							add(ruleQUO, position307)
+						}
+					case '<':
+						{
+							position310, tokenIndex310 := position, tokenIndex
+							{
+								position312 := position
+								if buffer[position] != rune('<') {
+									goto l311
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l311
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l311
+								}
+								add(ruleLEQ, position312)
+							}
+							goto l310
+						l311:
+							position, tokenIndex = position310, tokenIndex310
+							{
+								position314 := position
+								if buffer[position] != rune('<') {
+									goto l313
+								}
+								position++
+								{
+									position315, tokenIndex315 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '<':
+											position++
+										case '=':
+											position++
+										default:
+											if buffer[position] != rune('-') {
+												goto l315
+											}
+											position++
+										}
+									}
+
+									goto l313
+								l315:
+									position, tokenIndex = position315, tokenIndex315
+								}
+								if !_rules[ruleSpacing]() {
+									goto l313
+								}
+								add(ruleLSS, position314)
+							}
+							goto l310
+						l313:
+							position, tokenIndex = position310, tokenIndex310
+							{
+								position317 := position
+								if buffer[position] != rune('<') {
+									goto l296
+								}
+								position++
+								if buffer[position] != rune('<') {
+									goto l296
+								}
+								position++
+								{
+									position318, tokenIndex318 := position, tokenIndex
+									if buffer[position] != rune('=') {
+										goto l318
+									}
+									position++
+									goto l296
+								l318:
+									position, tokenIndex = position318, tokenIndex318
+								}
+								if !_rules[ruleSpacing]() {
+									goto l296
+								}
+								add(ruleSHL, position317)
+							}
+						}
+					l310:
+						break
+					case '=':
+						{
+							position319 := position
+							position++
+							if buffer[position] != rune('=') {
+								goto l296
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l296
+							}
+							add(ruleEQL, position319)
+						}
+					case '>':
+						{
+							position320, tokenIndex320 := position, tokenIndex
+							{
+								position322 := position
+								if buffer[position] != rune('>') {
+									goto l321
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l321
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l321
+								}
+								add(ruleGEQ, position322)
+							}
+							goto l320
+						l321:
+							position, tokenIndex = position320, tokenIndex320
+							{
+								position324 := position
+								if buffer[position] != rune('>') {
+									goto l323
+								}
+								position++
+								{
+									position325, tokenIndex325 := position, tokenIndex
+									{
+										position326, tokenIndex326 := position, tokenIndex
+										if buffer[position] != rune('>') {
+											goto l327
+										}
+										position++
+										goto l326
+									l327:
+										position, tokenIndex = position326, tokenIndex326
+										if buffer[position] != rune('=') {
+											goto l325
+										}
+										position++
+									}
+								l326:
+									goto l323
+								l325:
+									position, tokenIndex = position325, tokenIndex325
+								}
+								if !_rules[ruleSpacing]() {
+									goto l323
+								}
+								add(ruleGTR, position324)
+							}
+							goto l320
+						l323:
+							position, tokenIndex = position320, tokenIndex320
+							{
+								position328 := position
+								if buffer[position] != rune('>') {
+									goto l296
+								}
+								position++
+								if buffer[position] != rune('>') {
+									goto l296
+								}
+								position++
+								{
+									position329, tokenIndex329 := position, tokenIndex
+									if buffer[position] != rune('=') {
+										goto l329
+									}
+									position++
+									goto l296
+								l329:
+									position, tokenIndex = position329, tokenIndex329
+								}
+								if !_rules[ruleSpacing]() {
+									goto l296
+								}
+								add(ruleSHR, position328)
+							}
+						}
+					l320:
+						break
+					case '^':
+						if !_rules[ruleXOR]() {
+							goto l296
+						}
+					case '|':
+						{
+							position330, tokenIndex330 := position, tokenIndex
+							{
+								position332 := position
+								if buffer[position] != rune('|') {
+									goto l331
+								}
+								position++
+								if buffer[position] != rune('|') {
+									goto l331
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l331
+								}
+								add(ruleOROR, position332)
+							}
+							goto l330
+						l331:
+							position, tokenIndex = position330, tokenIndex330
+							if !_rules[ruleOR]() {
+								goto l296
+							}
+						}
+					l330:
+						break
+					default:
+						{
+							position333 := position
+							if buffer[position] != rune('!') {
+								goto l296
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l296
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l296
+							}
+							add(ruleNEQ, position333)
+						}
+					}
+				}
+
+				add(ruleBinaryOp, position297)
+			}
+			memoize(53, position296, tokenIndex296, true)
+			return true
+		l296:
+			memoize(53, position296, tokenIndex296, false)
+			position, tokenIndex = position296, tokenIndex296
+			return false
+		},
+		/* 54 UnaryOp <- <((&('!') NOT) | (&('&') AND) | (&('*') MUL) | (&('-') SUB) | (&('<') ARROW) | (&('^') XOR) | (&('~') TILDE) | (&('+') %fail('!' '&' '*' '-' '<-' '^' '~') ADD))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{54, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position334, tokenIndex334 := position, tokenIndex
+			{
+				position335 := position
+				{
+					switch buffer[position] {
+					case '!':
+						{
+							position337 := position
+							position++
+							{
+								position338, tokenIndex338 := position, tokenIndex
+								if buffer[position] != rune('=') {
+									goto l338
+								}
+								position++
+								goto l334
+							l338:
+								position, tokenIndex = position338, tokenIndex338
+							}
+							if !_rules[ruleSpacing]() {
+								goto l334
+							}
+							add(ruleNOT, position337)
+						}
+					case '&':
+						if !_rules[ruleAND]() {
+							goto l334
+						}
+					case '*':
+						if !_rules[ruleMUL]() {
+							goto l334
+						}
+					case '-':
+						if !_rules[ruleSUB]() {
+							goto l334
+						}
+					case '<':
+						if !_rules[ruleARROW]() {
+							goto l334
+						}
+					case '^':
+						if !_rules[ruleXOR]() {
+							goto l334
+						}
+					case '~':
+						if !_rules[ruleTILDE]() {
+							goto l334
+						}
+					default:
+						if !_rules[ruleADD]() {
+							goto l334
+						}
+					}
+				}
+
+				add(ruleUnaryOp, position335)
+			}
+			memoize(54, position334, tokenIndex334, true)
+			return true
+		l334:
+			memoize(54, position334, tokenIndex334, false)
+			position, tokenIndex = position334, tokenIndex334
+			return false
+		},
+		/* 55 HeaderExpression <- <(HeaderUnaryExpr (BinaryOp HeaderUnaryExpr)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{55, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position339, tokenIndex339 := position, tokenIndex
+			{
+				position340 := position
+				if !_rules[ruleHeaderUnaryExpr]() {
+					goto l339
+				}
+			l341:
+				{
+					position342, tokenIndex342 := position, tokenIndex
+					if !_rules[ruleBinaryOp]() {
+						goto l342
+					}
+					if !_rules[ruleHeaderUnaryExpr]() {
+						goto l342
+					}
+					goto l341
+				l342:
+					position, tokenIndex = position342, tokenIndex342
+				}
+				add(ruleHeaderExpression, position340)
+			}
+			memoize(55, position339, tokenIndex339, true)
+			return true
+		l339:
+			memoize(55, position339, tokenIndex339, false)
+			position, tokenIndex = position339, tokenIndex339
+			return false
+		},
+		/* 56 HeaderUnaryExpr <- <((UnaryOp HeaderUnaryExpr) / HeaderPrimaryExpr)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{56, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position343, tokenIndex343 := position, tokenIndex
+			{
+				position344 := position
+				{
+					position345, tokenIndex345 := position, tokenIndex
+					if !_rules[ruleUnaryOp]() {
+						goto l346
+					}
+					if !_rules[ruleHeaderUnaryExpr]() {
+						goto l346
+					}
+					goto l345
+				l346:
+					position, tokenIndex = position345, tokenIndex345
+					if !_rules[ruleHeaderPrimaryExpr]() {
+						goto l343
+					}
+				}
+			l345:
+				add(ruleHeaderUnaryExpr, position344)
+			}
+			memoize(56, position343, tokenIndex343, true)
+			return true
+		l343:
+			memoize(56, position343, tokenIndex343, false)
+			position, tokenIndex = position343, tokenIndex343
+			return false
+		},
+		/* 57 HeaderPrimaryExpr <- <(HeaderOperand Suffix*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{57, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position347, tokenIndex347 := position, tokenIndex
+			{
+				position348 := position
+				{
+					position349 := position
+					{
+						switch buffer[position] {
+						case '"', '\'', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '`':
+							if !_rules[ruleBasicLit]() {
+								goto l347
+							}
+						case '(':
+							if !_rules[ruleLPAR]() {
+								goto l347
+							}
+							{
+								position351, tokenIndex351 := position, tokenIndex
+								if !_rules[ruleExpression]() {
+									goto l352
+								}
+								if !_rules[ruleRPAR]() {
+									goto l352
+								}
+								goto l351
+							l352:
+								position, tokenIndex = position351, tokenIndex351
+								if !_rules[ruleType]() {
+									goto l347
+								}
+								if !_rules[ruleRPAR]() {
+									goto l347
+								}
+							}
+						l351:
+							break
+						case '*', '<':
+							if !_rules[ruleTypeLit]() {
+								goto l347
+							}
+						case '[':
+							{
+								position353, tokenIndex353 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case 'm':
+										if !_rules[ruleMapType]() {
+											goto l354
+										}
+									case 's':
+										if !_rules[ruleStructType]() {
+											goto l354
+										}
+									default:
+										{
+											position356, tokenIndex356 := position, tokenIndex
+											if !_rules[ruleArrayType]() {
+												goto l357
+											}
+											goto l356
+										l357:
+											position, tokenIndex = position356, tokenIndex356
+											if !_rules[ruleSliceType]() {
+												goto l354
+											}
+										}
+									l356:
+										break
+									}
+								}
+
+								if !_rules[ruleLiteralValue]() {
+									goto l354
+								}
+								goto l353
+							l354:
+								position, tokenIndex = position353, tokenIndex353
+								if !_rules[ruleTypeLit]() {
+									goto l347
+								}
+							}
+						l353:
+							break
+						case 'c', 'i':
+							{
+								position358, tokenIndex358 := position, tokenIndex
+								if !_rules[ruleIdentifier]() {
+									goto l359
+								}
+								goto l358
+							l359:
+								position, tokenIndex = position358, tokenIndex358
+								if !_rules[ruleTypeLit]() {
+									goto l347
+								}
+							}
+						l358:
+							break
+						case 'f':
+							{
+								position360, tokenIndex360 := position, tokenIndex
+								if !_rules[ruleFunctionLit]() {
+									goto l361
+								}
+								goto l360
+							l361:
+								position, tokenIndex = position360, tokenIndex360
+								if !_rules[ruleIdentifier]() {
+									goto l362
+								}
+								goto l360
+							l362:
+								position, tokenIndex = position360, tokenIndex360
+								if !_rules[ruleTypeLit]() {
+									goto l347
+								}
+							}
+						l360:
+							break
+						case 'm', 's':
+							{
+								position363, tokenIndex363 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case 'm':
+										if !_rules[ruleMapType]() {
+											goto l364
+										}
+									case 's':
+										if !_rules[ruleStructType]() {
+											goto l364
+										}
+									default:
+										{
+											position366, tokenIndex366 := position, tokenIndex
+											if !_rules[ruleArrayType]() {
+												goto l367
+											}
+											goto l366
+										l367:
+											position, tokenIndex = position366, tokenIndex366
+											if !_rules[ruleSliceType]() {
+												goto l364
+											}
+										}
+									l366:
+										break
+									}
+								}
+
+								if !_rules[ruleLiteralValue]() {
+									goto l364
+								}
+								goto l363
+							l364:
+								position, tokenIndex = position363, tokenIndex363
+								if !_rules[ruleIdentifier]() {
+									goto l368
+								}
+								goto l363
+							l368:
+								position, tokenIndex = position363, tokenIndex363
+								if !_rules[ruleTypeLit]() {
+									goto l347
+								}
+							}
+						l363:
+							break
+						default:
+							if !_rules[ruleIdentifier]() {
+								goto l347
+							}
+						}
+					}
+
+					add(ruleHeaderOperand, position349)
+				}
+			l369:
+				{
+					position370, tokenIndex370 := position, tokenIndex
+					if !_rules[ruleSuffix]() {
+						goto l370
+					}
+					goto l369
+				l370:
+					position, tokenIndex = position370, tokenIndex370
+				}
+				add(ruleHeaderPrimaryExpr, position348)
+			}
+			memoize(57, position347, tokenIndex347, true)
+			return true
+		l347:
+			memoize(57, position347, tokenIndex347, false)
+			position, tokenIndex = position347, tokenIndex347
+			return false
+		},
+		/* 58 HeaderOperand <- <((&('"' | '\'' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '`') %fail('map' 'struct' '[' 'func' [A-Z] '_' [a-z] [\u0080-\U0010ffff] '(' '*' 'interface' 'chan' '<-') BasicLit) | (&('(') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'map' 'struct' '[' 'func' [A-Z] '_' [a-z] [\u0080-\U0010ffff] '*' 'interface' 'chan' '<-') (LPAR ((Expression RPAR) / (Type RPAR)))) | (&('*' | '<') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'map' 'struct' '[' 'func' [A-Z] '_' [a-z] [\u0080-\U0010ffff] '(') TypeLit) | (&('[') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'func' [A-Z] '_' [a-z] [\u0080-\U0010ffff] '(') ((((&('m') %fail('struct' '[') MapType) | (&('s') %fail('[' 'map') StructType) | (&('[') %fail('struct' 'map') (ArrayType / SliceType))) LiteralValue) / TypeLit)) | (&('c' | 'i') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'map' 'struct' '[' 'func' '(') (Identifier / TypeLit)) | (&('f') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'map' 'struct' '[' '(') (FunctionLit / Identifier / TypeLit)) | (&('m' | 's') %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'func' '(') ((((&('m') %fail('struct' '[') MapType) | (&('s') %fail('[' 'map') StructType) | (&('[') %fail('struct' 'map') (ArrayType / SliceType))) LiteralValue) / Identifier / TypeLit)) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'd' | 'e' | 'g' | 'h' | 'j' | 'k' | 'l' | 'n' | 'o' | 'p' | 'q' | 'r' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('"' '`' '\'' '.' '0' [0-9] [1-9] 'map' 'struct' '[' 'func' '(' '*' 'interface' 'chan' '<-') Identifier))> */
+		nil,
+		/* 59 HeaderExpressionList <- <(HeaderExpression (COMMA HeaderExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{59, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position372, tokenIndex372 := position, tokenIndex
+			{
+				position373 := position
+				if !_rules[ruleHeaderExpression]() {
+					goto l372
+				}
+			l374:
+				{
+					position375, tokenIndex375 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l375
+					}
+					if !_rules[ruleHeaderExpression]() {
+						goto l375
+					}
+					goto l374
+				l375:
+					position, tokenIndex = position375, tokenIndex375
+				}
+				add(ruleHeaderExpressionList, position373)
+			}
+			memoize(59, position372, tokenIndex372, true)
+			return true
+		l372:
+			memoize(59, position372, tokenIndex372, false)
+			position, tokenIndex = position372, tokenIndex372
+			return false
+		},
+		/* 60 HeaderSimpleStmt <- <((IdentifierList DEFINE HeaderExpressionList) / (HeaderExpressionList AssignOp HeaderExpressionList) / (HeaderExpression ((&('+' | '-') (INC / DEC)) | (&('<') (ARROW HeaderExpression)) | (&() %fail('++' '--' '<-') ))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{60, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position376, tokenIndex376 := position, tokenIndex
+			{
+				position377 := position
+				{
+					position378, tokenIndex378 := position, tokenIndex
+					if !_rules[ruleIdentifierList]() {
+						goto l379
+					}
+					if !_rules[ruleDEFINE]() {
+						goto l379
+					}
+					if !_rules[ruleHeaderExpressionList]() {
+						goto l379
+					}
+					goto l378
+				l379:
+					position, tokenIndex = position378, tokenIndex378
+					if !_rules[ruleHeaderExpressionList]() {
+						goto l380
+					}
+					if !_rules[ruleAssignOp]() {
+						goto l380
+					}
+					if !_rules[ruleHeaderExpressionList]() {
+						goto l380
+					}
+					goto l378
+				l380:
+					position, tokenIndex = position378, tokenIndex378
+					if !_rules[ruleHeaderExpression]() {
+						goto l376
+					}
+					{
+						switch buffer[position] {
+						case '+', '-':
+							{
+								position382, tokenIndex382 := position, tokenIndex
+								if !_rules[ruleINC]() {
+									goto l383
+								}
+								goto l382
+							l383:
+								position, tokenIndex = position382, tokenIndex382
+								if !_rules[ruleDEC]() {
+									goto l376
+								}
+							}
+						l382:
+							break
+						case '<':
+							if !_rules[ruleARROW]() {
+								goto l376
+							}
+							if !_rules[ruleHeaderExpression]() {
+								goto l376
+							}
+						default:
+						}
+					}
+
+				}
+			l378:
+				add(ruleHeaderSimpleStmt, position377)
+			}
+			memoize(60, position376, tokenIndex376, true)
+			return true
+		l376:
+			memoize(60, position376, tokenIndex376, false)
+			position, tokenIndex = position376, tokenIndex376
+			return false
+		},
+		/* 61 Block <- <(LBRACE StatementList RBRACE)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{61, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position384, tokenIndex384 := position, tokenIndex
+			{
+				position385 := position
+				if !_rules[ruleLBRACE]() {
+					goto l384
+				}
+				if !_rules[ruleStatementList]() {
+					goto l384
+				}
+				if !_rules[ruleRBRACE]() {
+					goto l384
+				}
+				add(ruleBlock, position385)
+			}
+			memoize(61, position384, tokenIndex384, true)
+			return true
+		l384:
+			memoize(61, position384, tokenIndex384, false)
+			position, tokenIndex = position384, tokenIndex384
+			return false
+		},
+		/* 62 StatementList <- <((&(';') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff] '!' '&' '*' '-' '<-' '^' '~' '+' '"' '`' '\'' '.' '0' [0-9] [1-9] '[' 'map' 'struct' 'func' '(' 'interface' 'chan' 'break' 'const' 'type' 'var' 'continue' 'defer' 'fallthrough' 'for' 'go' 'goto' 'if' 'return' 'switch' 'select' '{') SEMI) | (&('!' | '"' | '&' | '\'' | '(' | '*' | '+' | '-' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '{' | '~' | [\u0080-\U0010ffff]) %fail(';') (Statement EOS)))*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{62, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position386, tokenIndex386 := position, tokenIndex
+			{
+				position387 := position
+			l388:
+				{
+					position389, tokenIndex389 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case ';':
+							if !_rules[ruleSEMI]() {
+								goto l389
+							}
+						default:
+							if !_rules[ruleStatement]() {
+								goto l389
+							}
+							if !_rules[ruleEOS]() {
+								goto l389
+							}
+						}
+					}
+
+					goto l388
+				l389:
+					position, tokenIndex = position389, tokenIndex389
+				}
+				add(ruleStatementList, position387)
+			}
+			memoize(62, position386, tokenIndex386, true)
+			return true
+		},
+		/* 63 Statement <- <((&('!' | '"' | '&' | '\'' | '(' | '*' | '+' | '-' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '<' | '[' | '^' | '`' | '~') %fail('const' 'type' 'var' [A-Z] '_' [a-z] [\u0080-\U0010ffff] 'go' 'return' 'break' 'continue' 'goto' 'fallthrough' '{' 'if' 'switch' 'select' 'for' 'defer') SimpleStmt) | (&('b') %fail('const' 'type' 'var' 'go' 'return' 'continue' 'goto' 'fallthrough' '{' 'if' 'switch' 'select' 'for' 'defer') (LabeledStmt / BreakStmt / SimpleStmt)) | (&('c') %fail('go' 'return' 'break' 'goto' 'fallthrough' '{' 'if' 'switch' 'select' 'for' 'defer') (Declaration / LabeledStmt / ContinueStmt / SimpleStmt)) | (&('d') %fail('const' 'type' 'var' 'go' 'return' 'break' 'continue' 'goto' 'fallthrough' '{' 'if' 'switch' 'select' 'for') (LabeledStmt / DeferStmt / SimpleStmt)) | (&('f') %fail('const' 'type' 'var' 'go' 'return' 'break' 'continue' 'goto' '{' 'if' 'switch' 'select' 'defer') (LabeledStmt / FallthroughStmt / ForStmt / SimpleStmt)) | (&('g') %fail('const' 'type' 'var' 'return' 'break' 'continue' 'fallthrough' '{' 'if' 'switch' 'select' 'for' 'defer') (LabeledStmt / GoStmt / GotoStmt / SimpleStmt)) | (&('i') %fail('const' 'type' 'var' 'go' 'return' 'break' 'continue' 'goto' 'fallthrough' '{' 'switch' 'select' 'for' 'defer') (LabeledStmt / IfStmt / SimpleStmt)) | (&('r') %fail('const' 'type' 'var' 'go' 'break' 'continue' 'goto' 'fallthrough' '{' 'if' 'switch' 'select' 'for' 'defer') (LabeledStmt / ReturnStmt / SimpleStmt)) | (&('s') %fail('const' 'type' 'var' 'go' 'return' 'break' 'continue' 'goto' 'fallthrough' '{' 'if' 'for' 'defer') (LabeledStmt / SwitchStmt / SelectStmt / SimpleStmt)) | (&('t' | 'v') %fail('go' 'return' 'break' 'continue' 'goto' 'fallthrough' '{' 'if' 'switch' 'select' 'for' 'defer') (Declaration / LabeledStmt / SimpleStmt)) | (&('{') %fail('const' 'type' 'var' [A-Z] '_' [a-z] [\u0080-\U0010ffff] 'go' 'return' 'break' 'continue' 'goto' 'fallthrough' 'if' 'switch' 'select' 'for' 'defer' '!' '&' '*' '-' '<-' '^' '~' '+' '"' '`' '\'' '.' '0' [0-9] [1-9] '[' 'map' 'struct' 'func' '(' 'interface' 'chan') Block) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'e' | 'h' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'u' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail('const' 'type' 'var' 'go' 'return' 'break' 'continue' 'goto' 'fallthrough' '{' 'if' 'switch' 'select' 'for' 'defer') (LabeledStmt / SimpleStmt)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{63, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position391, tokenIndex391 := position, tokenIndex
+			{
+				position392 := position
+				{
+					switch buffer[position] {
+					case '!', '"', '&', '\'', '(', '*', '+', '-', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '<', '[', '^', '`', '~':
+						if !_rules[ruleSimpleStmt]() {
+							goto l391
+						}
+					case 'b':
+						{
+							position394, tokenIndex394 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l395
+							}
+							goto l394
+						l395:
+							position, tokenIndex = position394, tokenIndex394
+							{
+								position397 := position
+								{
+									position398 := position
+									if buffer[position] != rune('b') {
+										goto l396
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l396
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l396
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l396
+									}
+									position++
+									if buffer[position] != rune('k') {
+										goto l396
+									}
+									position++
+									{
+										position399, tokenIndex399 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l399
+												}
+												position++
+											}
+										}
+
+										goto l396
+									l399:
+										position, tokenIndex = position399, tokenIndex399
+									}
+									if !_rules[ruleBlank]() {
+										goto l396
+									}
+									add(ruleBREAK, position398)
+								}
+								{
+									position401, tokenIndex401 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l401
+									}
+									goto l402
+								l401:
+									position, tokenIndex = position401, tokenIndex401
+								}
+							l402:
+								add(ruleBreakStmt, position397)
+							}
+							goto l394
+						l396:
+							position, tokenIndex = position394, tokenIndex394
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l394:
+						break
+					case 'c':
+						{
+							position403, tokenIndex403 := position, tokenIndex
+							if !_rules[ruleDeclaration]() {
+								goto l404
+							}
+							goto l403
+						l404:
+							position, tokenIndex = position403, tokenIndex403
+							if !_rules[ruleLabeledStmt]() {
+								goto l405
+							}
+							goto l403
+						l405:
+							position, tokenIndex = position403, tokenIndex403
+							{
+								position407 := position
+								{
+									position408 := position
+									if buffer[position] != rune('c') {
+										goto l406
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l406
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l406
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l406
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l406
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l406
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l406
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l406
+									}
+									position++
+									{
+										position409, tokenIndex409 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l409
+												}
+												position++
+											}
+										}
+
+										goto l406
+									l409:
+										position, tokenIndex = position409, tokenIndex409
+									}
+									if !_rules[ruleBlank]() {
+										goto l406
+									}
+									add(ruleCONTINUE, position408)
+								}
+								{
+									position411, tokenIndex411 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l411
+									}
+									goto l412
+								l411:
+									position, tokenIndex = position411, tokenIndex411
+								}
+							l412:
+								add(ruleContinueStmt, position407)
+							}
+							goto l403
+						l406:
+							position, tokenIndex = position403, tokenIndex403
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l403:
+						break
+					case 'd':
+						{
+							position413, tokenIndex413 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l414
+							}
+							goto l413
+						l414:
+							position, tokenIndex = position413, tokenIndex413
+							{
+								position416 := position
+								{
+									position417 := position
+									if buffer[position] != rune('d') {
+										goto l415
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l415
+									}
+									position++
+									if buffer[position] != rune('f') {
+										goto l415
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l415
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l415
+									}
+									position++
+									{
+										position418, tokenIndex418 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l418
+												}
+												position++
+											}
+										}
+
+										goto l415
+									l418:
+										position, tokenIndex = position418, tokenIndex418
+									}
+									if !_rules[ruleSpacing]() {
+										goto l415
+									}
+									add(ruleDEFER, position417)
+								}
+								if !_rules[ruleExpression]() {
+									goto l415
+								}
+								add(ruleDeferStmt, position416)
+							}
+							goto l413
+						l415:
+							position, tokenIndex = position413, tokenIndex413
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l413:
+						break
+					case 'f':
+						{
+							position420, tokenIndex420 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l421
+							}
+							goto l420
+						l421:
+							position, tokenIndex = position420, tokenIndex420
+							{
+								position423 := position
+								{
+									position424 := position
+									if buffer[position] != rune('f') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('a') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('l') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('l') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('h') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('g') {
+										goto l422
+									}
+									position++
+									if buffer[position] != rune('h') {
+										goto l422
+									}
+									position++
+									{
+										position425, tokenIndex425 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l425
+												}
+												position++
+											}
+										}
+
+										goto l422
+									l425:
+										position, tokenIndex = position425, tokenIndex425
+									}
+									if !_rules[ruleBlank]() {
+										goto l422
+									}
+									add(ruleFALLTHROUGH, position424)
+								}
+								add(ruleFallthroughStmt, position423)
+							}
+							goto l420
+						l422:
+							position, tokenIndex = position420, tokenIndex420
+							{
+								position428 := position
+								{
+									position429 := position
+									if buffer[position] != rune('f') {
+										goto l427
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l427
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l427
+									}
+									position++
+									{
+										position430, tokenIndex430 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l430
+												}
+												position++
+											}
+										}
+
+										goto l427
+									l430:
+										position, tokenIndex = position430, tokenIndex430
+									}
+									if !_rules[ruleSpacing]() {
+										goto l427
+									}
+									add(ruleFOR, position429)
+								}
+								{
+									position432, tokenIndex432 := position, tokenIndex
+									{
+										position434, tokenIndex434 := position, tokenIndex
+										{
+											position436 := position
+											{
+												position437, tokenIndex437 := position, tokenIndex
+												if !_rules[ruleHeaderSimpleStmt]() {
+													goto l437
+												}
+												goto l438
+											l437:
+												position, tokenIndex = position437, tokenIndex437
+											}
+										l438:
+											if !_rules[ruleSEMI]() {
+												goto l435
+											}
+											{
+												position439, tokenIndex439 := position, tokenIndex
+												if !_rules[ruleHeaderExpression]() {
+													goto l439
+												}
+												goto l440
+											l439:
+												position, tokenIndex = position439, tokenIndex439
+											}
+										l440:
+											if !_rules[ruleSEMI]() {
+												goto l435
+											}
+											{
+												position441, tokenIndex441 := position, tokenIndex
+												if !_rules[ruleHeaderSimpleStmt]() {
+													goto l441
+												}
+												goto l442
+											l441:
+												position, tokenIndex = position441, tokenIndex441
+											}
+										l442:
+											add(ruleForClause, position436)
+										}
+										goto l434
+									l435:
+										position, tokenIndex = position434, tokenIndex434
+										{
+											position444 := position
+											{
+												position445, tokenIndex445 := position, tokenIndex
+												{
+													position447, tokenIndex447 := position, tokenIndex
+													if !_rules[ruleIdentifierList]() {
+														goto l448
+													}
+													if !_rules[ruleDEFINE]() {
+														goto l448
+													}
+													goto l447
+												l448:
+													position, tokenIndex = position447, tokenIndex447
+													if !_rules[ruleHeaderExpressionList]() {
+														goto l445
+													}
+													if !_rules[ruleASSIGN]() {
+														goto l445
+													}
+												}
+											l447:
+												goto l446
+											l445:
+												position, tokenIndex = position445, tokenIndex445
+											}
+										l446:
+											{
+												position449 := position
+												if buffer[position] != rune('r') {
+													goto l443
+												}
+												position++
+												if buffer[position] != rune('a') {
+													goto l443
+												}
+												position++
+												if buffer[position] != rune('n') {
+													goto l443
+												}
+												position++
+												if buffer[position] != rune('g') {
+													goto l443
+												}
+												position++
+												if buffer[position] != rune('e') {
+													goto l443
+												}
+												position++
+												{
+													position450, tokenIndex450 := position, tokenIndex
+													{
+														switch buffer[position] {
+														case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+															position++
+														case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+															position++
+														case '_':
+															position++
+														default:
+															if c := buffer[position]; c < rune('a') || c > rune('z') {
+																goto l450
+															}
+															position++
+														}
+													}
+
+													goto l443
+												l450:
+													position, tokenIndex = position450, tokenIndex450
+												}
+												if !_rules[ruleSpacing]() {
+													goto l443
+												}
+												add(ruleRANGE, position449)
+											}
+											if !_rules[ruleHeaderExpression]() {
+												goto l443
+											}
+											add(ruleRangeClause, position444)
+										}
+										goto l434
+									l443:
+										position, tokenIndex = position434, tokenIndex434
+										if !_rules[ruleHeaderExpression]() {
+											goto l432
+										}
+									}
+								l434:
+									goto l433
+								l432:
+									position, tokenIndex = position432, tokenIndex432
+								}
+							l433:
+								if !_rules[ruleBlock]() {
+									goto l427
+								}
+								add(ruleForStmt, position428)
+							}
+							goto l420
+						l427:
+							position, tokenIndex = position420, tokenIndex420
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l420:
+						break
+					case 'g':
+						{
+							position452, tokenIndex452 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l453
+							}
+							goto l452
+						l453:
+							position, tokenIndex = position452, tokenIndex452
+							{
+								position455 := position
+								{
+									position456 := position
+									if buffer[position] != rune('g') {
+										goto l454
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l454
+									}
+									position++
+									{
+										position457, tokenIndex457 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l457
+												}
+												position++
+											}
+										}
+
+										goto l454
+									l457:
+										position, tokenIndex = position457, tokenIndex457
+									}
+									if !_rules[ruleSpacing]() {
+										goto l454
+									}
+									add(ruleGO, position456)
+								}
+								if !_rules[ruleExpression]() {
+									goto l454
+								}
+								add(ruleGoStmt, position455)
+							}
+							goto l452
+						l454:
+							position, tokenIndex = position452, tokenIndex452
+							{
+								position460 := position
+								{
+									position461 := position
+									if buffer[position] != rune('g') {
+										goto l459
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l459
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l459
+									}
+									position++
+									if buffer[position] != rune('o') {
+										goto l459
+									}
+									position++
+									{
+										position462, tokenIndex462 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l462
+												}
+												position++
+											}
+										}
+
+										goto l459
+									l462:
+										position, tokenIndex = position462, tokenIndex462
+									}
+									if !_rules[ruleSpacing]() {
+										goto l459
+									}
+									add(ruleGOTO, position461)
+								}
+								if !_rules[ruleIdentifier]() {
+									goto l459
+								}
+								add(ruleGotoStmt, position460)
+							}
+							goto l452
+						l459:
+							position, tokenIndex = position452, tokenIndex452
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l452:
+						break
+					case 'i':
+						{
+							position464, tokenIndex464 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l465
+							}
+							goto l464
+						l465:
+							position, tokenIndex = position464, tokenIndex464
+							if !_rules[ruleIfStmt]() {
+								goto l466
+							}
+							goto l464
+						l466:
+							position, tokenIndex = position464, tokenIndex464
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l464:
+						break
+					case 'r':
+						{
+							position467, tokenIndex467 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l468
+							}
+							goto l467
+						l468:
+							position, tokenIndex = position467, tokenIndex467
+							{
+								position470 := position
+								{
+									position471 := position
+									if buffer[position] != rune('r') {
+										goto l469
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l469
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l469
+									}
+									position++
+									if buffer[position] != rune('u') {
+										goto l469
+									}
+									position++
+									if buffer[position] != rune('r') {
+										goto l469
+									}
+									position++
+									if buffer[position] != rune('n') {
+										goto l469
+									}
+									position++
+									{
+										position472, tokenIndex472 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l472
+												}
+												position++
+											}
+										}
+
+										goto l469
+									l472:
+										position, tokenIndex = position472, tokenIndex472
+									}
+									if !_rules[ruleBlank]() {
+										goto l469
+									}
+									add(ruleRETURN, position471)
+								}
+								{
+									position474, tokenIndex474 := position, tokenIndex
+									if !_rules[ruleExpressionList]() {
+										goto l474
+									}
+									goto l475
+								l474:
+									position, tokenIndex = position474, tokenIndex474
+								}
+							l475:
+								add(ruleReturnStmt, position470)
+							}
+							goto l467
+						l469:
+							position, tokenIndex = position467, tokenIndex467
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l467:
+						break
+					case 's':
+						{
+							position476, tokenIndex476 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l477
+							}
+							goto l476
+						l477:
+							position, tokenIndex = position476, tokenIndex476
+							{
+								position479 := position
+								{
+									position480 := position
+									if buffer[position] != rune('s') {
+										goto l478
+									}
+									position++
+									if buffer[position] != rune('w') {
+										goto l478
+									}
+									position++
+									if buffer[position] != rune('i') {
+										goto l478
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l478
+									}
+									position++
+									if buffer[position] != rune('c') {
+										goto l478
+									}
+									position++
+									if buffer[position] != rune('h') {
+										goto l478
+									}
+									position++
+									{
+										position481, tokenIndex481 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l481
+												}
+												position++
+											}
+										}
+
+										goto l478
+									l481:
+										position, tokenIndex = position481, tokenIndex481
+									}
+									if !_rules[ruleSpacing]() {
+										goto l478
+									}
+									add(ruleSWITCH, position480)
+								}
+								{
+									position483, tokenIndex483 := position, tokenIndex
+									if !_rules[ruleHeaderSimpleStmt]() {
+										goto l483
+									}
+									if !_rules[ruleSEMI]() {
+										goto l483
+									}
+									goto l484
+								l483:
+									position, tokenIndex = position483, tokenIndex483
+								}
+							l484:
+								{
+									position485, tokenIndex485 := position, tokenIndex
+									{
+										position487, tokenIndex487 := position, tokenIndex
+										{
+											position489 := position
+											{
+												position490, tokenIndex490 := position, tokenIndex
+												if !_rules[ruleIdentifier]() {
+													goto l490
+												}
+												if !_rules[ruleDEFINE]() {
+													goto l490
+												}
+												goto l491
+											l490:
+												position, tokenIndex = position490, tokenIndex490
+											}
+										l491:
+											if !_rules[ruleHeaderPrimaryExpr]() {
+												goto l488
+											}
+											if !_rules[ruleDOT]() {
+												goto l488
+											}
+											if !_rules[ruleLPAR]() {
+												goto l488
+											}
+											if !_rules[ruleTYPE]() {
+												goto l488
+											}
+											if !_rules[ruleRPAR]() {
+												goto l488
+											}
+											add(ruleTypeSwitchGuard, position489)
+										}
+										goto l487
+									l488:
+										position, tokenIndex = position487, tokenIndex487
+										if !_rules[ruleHeaderExpression]() {
+											goto l485
+										}
+									}
+								l487:
+									goto l486
+								l485:
+									position, tokenIndex = position485, tokenIndex485
+								}
+							l486:
+								if !_rules[ruleLBRACE]() {
+									goto l478
+								}
+							l492:
+								{
+									position493, tokenIndex493 := position, tokenIndex
+									{
+										position494 := position
+										{
+											position495, tokenIndex495 := position, tokenIndex
+											if !_rules[ruleCASE]() {
+												goto l496
+											}
+											if !_rules[ruleExpressionList]() {
+												goto l496
+											}
+											goto l495
+										l496:
+											position, tokenIndex = position495, tokenIndex495
+											if !_rules[ruleDEFAULT]() {
+												goto l493
+											}
+										}
+									l495:
+										if !_rules[ruleCOLON]() {
+											goto l493
+										}
+										if !_rules[ruleStatementList]() {
+											goto l493
+										}
+										add(ruleCaseClause, position494)
+									}
+									goto l492
+								l493:
+									position, tokenIndex = position493, tokenIndex493
+								}
+								if !_rules[ruleRBRACE]() {
+									goto l478
+								}
+								add(ruleSwitchStmt, position479)
+							}
+							goto l476
+						l478:
+							position, tokenIndex = position476, tokenIndex476
+							{
+								position498 := position
+								{
+									position499 := position
+									if buffer[position] != rune('s') {
+										goto l497
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l497
+									}
+									position++
+									if buffer[position] != rune('l') {
+										goto l497
+									}
+									position++
+									if buffer[position] != rune('e') {
+										goto l497
+									}
+									position++
+									if buffer[position] != rune('c') {
+										goto l497
+									}
+									position++
+									if buffer[position] != rune('t') {
+										goto l497
+									}
+									position++
+									{
+										position500, tokenIndex500 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													goto l500
+												}
+												position++
+											}
+										}
+
+										goto l497
+									l500:
+										position, tokenIndex = position500, tokenIndex500
+									}
+									if !_rules[ruleSpacing]() {
+										goto l497
+									}
+									add(ruleSELECT, position499)
+								}
+								if !_rules[ruleLBRACE]() {
+									goto l497
+								}
+							l502:
+								{
+									position503, tokenIndex503 := position, tokenIndex
+									{
+										position504 := position
+										{
+											position505, tokenIndex505 := position, tokenIndex
+											if !_rules[ruleCASE]() {
+												goto l506
+											}
+											if !_rules[ruleSimpleStmt]() {
+												goto l506
+											}
+											goto l505
+										l506:
+											position, tokenIndex = position505, tokenIndex505
+											if !_rules[ruleDEFAULT]() {
+												goto l503
+											}
+										}
+									l505:
+										if !_rules[ruleCOLON]() {
+											goto l503
+										}
+										if !_rules[ruleStatementList]() {
+											goto l503
+										}
+										add(ruleCommClause, position504)
+									}
+									goto l502
+								l503:
+									position, tokenIndex = position503, tokenIndex503
+								}
+								if !_rules[ruleRBRACE]() {
+									goto l497
+								}
+								add(ruleSelectStmt, position498)
+							}
+							goto l476
+						l497:
+							position, tokenIndex = position476, tokenIndex476
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l476:
+						break
+					case 't', 'v':
+						{
+							position507, tokenIndex507 := position, tokenIndex
+							if !_rules[ruleDeclaration]() {
+								goto l508
+							}
+							goto l507
+						l508:
+							position, tokenIndex = position507, tokenIndex507
+							if !_rules[ruleLabeledStmt]() {
+								goto l509
+							}
+							goto l507
+						l509:
+							position, tokenIndex = position507, tokenIndex507
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l507:
+						break
+					case '{':
+						if !_rules[ruleBlock]() {
+							goto l391
+						}
+					default:
+						{
+							position510, tokenIndex510 := position, tokenIndex
+							if !_rules[ruleLabeledStmt]() {
+								goto l511
+							}
+							goto l510
+						l511:
+							position, tokenIndex = position510, tokenIndex510
+							if !_rules[ruleSimpleStmt]() {
+								goto l391
+							}
+						}
+					l510:
+						break
+					}
+				}
+
+				add(ruleStatement, position392)
+			}
+			memoize(63, position391, tokenIndex391, true)
+			return true
+		l391:
+			memoize(63, position391, tokenIndex391, false)
+			position, tokenIndex = position391, tokenIndex391
+			return false
+		},
+		/* 64 SimpleStmt <- <((IdentifierList DEFINE ExpressionList) / (ExpressionList AssignOp ExpressionList) / (Expression ((&('+' | '-') (INC / DEC)) | (&('<') (ARROW Expression)) | (&() %fail('++' '--' '<-') ))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{64, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position512, tokenIndex512 := position, tokenIndex
+			{
+				position513 := position
+				{
+					position514, tokenIndex514 := position, tokenIndex
+					if !_rules[ruleIdentifierList]() {
+						goto l515
+					}
+					if !_rules[ruleDEFINE]() {
+						goto l515
+					}
+					if !_rules[ruleExpressionList]() {
+						goto l515
+					}
+					goto l514
+				l515:
+					position, tokenIndex = position514, tokenIndex514
+					if !_rules[ruleExpressionList]() {
+						goto l516
+					}
+					if !_rules[ruleAssignOp]() {
+						goto l516
+					}
+					if !_rules[ruleExpressionList]() {
+						goto l516
+					}
+					goto l514
+				l516:
+					position, tokenIndex = position514, tokenIndex514
+					if !_rules[ruleExpression]() {
+						goto l512
+					}
+					{
+						switch buffer[position] {
+						case '+', '-':
+							{
+								position518, tokenIndex518 := position, tokenIndex
+								if !_rules[ruleINC]() {
+									goto l519
+								}
+								goto l518
+							l519:
+								position, tokenIndex = position518, tokenIndex518
+								if !_rules[ruleDEC]() {
+									goto l512
+								}
+							}
+						l518:
+							break
+						case '<':
+							if !_rules[ruleARROW]() {
+								goto l512
+							}
+							if !_rules[ruleExpression]() {
+								goto l512
+							}
+						default:
+						}
+					}
+
+				}
+			l514:
+				add(ruleSimpleStmt, position513)
+			}
+			memoize(64, position512, tokenIndex512, true)
+			return true
+		l512:
+			memoize(64, position512, tokenIndex512, false)
+			position, tokenIndex = position512, tokenIndex512
+			return false
+		},
+		/* 65 AssignOp <- <((&('&') %fail('=' '+=' '-=' '*=' '/=' '%=' '|=' '^=' '<<=' '>>=') (ANDASSIGN / ANDNOTASSIGN)) | (&('*') %fail('=' '+=' '-=' '/=' '%=' '&=' '|=' '^=' '<<=' '>>=' '&^=') MULASSIGN) | (&('+') %fail('=' '-=' '*=' '/=' '%=' '&=' '|=' '^=' '<<=' '>>=' '&^=') ADDASSIGN) | (&('-') %fail('=' '+=' '*=' '/=' '%=' '&=' '|=' '^=' '<<=' '>>=' '&^=') SUBASSIGN) | (&('/') %fail('=' '+=' '-=' '*=' '%=' '&=' '|=' '^=' '<<=' '>>=' '&^=') QUOASSIGN) | (&('<') %fail('=' '+=' '-=' '*=' '/=' '%=' '&=' '|=' '^=' '>>=' '&^=') SHLASSIGN) | (&('=') %fail('+=' '-=' '*=' '/=' '%=' '&=' '|=' '^=' '<<=' '>>=' '&^=') ASSIGN) | (&('>') %fail('=' '+=' '-=' '*=' '/=' '%=' '&=' '|=' '^=' '<<=' '&^=') SHRASSIGN) | (&('^') %fail('=' '+=' '-=' '*=' '/=' '%=' '&=' '|=' '<<=' '>>=' '&^=') XORASSIGN) | (&('|') %fail('=' '+=' '-=' '*=' '/=' '%=' '&=' '^=' '<<=' '>>=' '&^=') ORASSIGN) | (&('%') %fail('=' '+=' '-=' '*=' '/=' '&=' '|=' '^=' '<<=' '>>=' '&^=') REMASSIGN))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{65, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position520, tokenIndex520 := position, tokenIndex
+			{
+				position521 := position
+				{
+					switch buffer[position] {
+					case '&':
+						{
+							position523, tokenIndex523 := position, tokenIndex
+							{
+								position525 := position
+								if buffer[position] != rune('&') {
+									goto l524
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l524
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l524
+								}
+								add(ruleANDASSIGN, position525)
+							}
+							goto l523
+						l524:
+							position, tokenIndex = position523, tokenIndex523
+							{
+								position526 := position
+								if buffer[position] != rune('&') {
+									goto l520
+								}
+								position++
+								if buffer[position] != rune('^') {
+									goto l520
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l520
+								}
+								position++
+								if !_rules[ruleSpacing]() {
+									goto l520
+								}
+								add(ruleANDNOTASSIGN, position526)
+							}
+						}
+					l523:
+						break
+					case '*':
+						{
+							position527 := position
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleMULASSIGN, position527)
+						}
+					case '+':
+						{
+							position528 := position
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleADDASSIGN, position528)
+						}
+					case '-':
+						{
+							position529 := position
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleSUBASSIGN, position529)
+						}
+					case '/':
+						{
+							position530 := position
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleQUOASSIGN, position530)
+						}
+					case '<':
+						{
+							position531 := position
+							position++
+							if buffer[position] != rune('<') {
+								goto l520
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleSHLASSIGN, position531)
+						}
+					case '=':
+						if !_rules[ruleASSIGN]() {
+							goto l520
+						}
+					case '>':
+						{
+							position532 := position
+							position++
+							if buffer[position] != rune('>') {
+								goto l520
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleSHRASSIGN, position532)
+						}
+					case '^':
+						{
+							position533 := position
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleXORASSIGN, position533)
+						}
+					case '|':
+						{
+							position534 := position
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleORASSIGN, position534)
+						}
+					default:
+						{
+							position535 := position
+							if buffer[position] != rune('%') {
+								goto l520
+							}
+							position++
+							if buffer[position] != rune('=') {
+								goto l520
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l520
+							}
+							add(ruleREMASSIGN, position535)
+						}
+					}
+				}
+
+				add(ruleAssignOp, position521)
+			}
+			memoize(65, position520, tokenIndex520, true)
+			return true
+		l520:
+			memoize(65, position520, tokenIndex520, false)
+			position, tokenIndex = position520, tokenIndex520
+			return false
+		},
+		/* 66 LabeledStmt <- <(Identifier COLON Statement?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{66, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position536, tokenIndex536 := position, tokenIndex
+			{
+				position537 := position
+				if !_rules[ruleIdentifier]() {
+					goto l536
+				}
+				if !_rules[ruleCOLON]() {
+					goto l536
+				}
+				{
+					position538, tokenIndex538 := position, tokenIndex
+					if !_rules[ruleStatement]() {
+						goto l538
+					}
+					goto l539
+				l538:
+					position, tokenIndex = position538, tokenIndex538
+				}
+			l539:
+				add(ruleLabeledStmt, position537)
+			}
+			memoize(66, position536, tokenIndex536, true)
+			return true
+		l536:
+			memoize(66, position536, tokenIndex536, false)
+			position, tokenIndex = position536, tokenIndex536
+			return false
+		},
+		/* 67 GoStmt <- <(GO Expression)> */
+		nil,
+		/* 68 DeferStmt <- <(DEFER Expression)> */
+		nil,
+		/* 69 ReturnStmt <- <(RETURN ExpressionList?)> */
+		nil,
+		/* 70 BreakStmt <- <(BREAK Identifier?)> */
+		nil,
+		/* 71 ContinueStmt <- <(CONTINUE Identifier?)> */
+		nil,
+		/* 72 GotoStmt <- <(GOTO Identifier)> */
+		nil,
+		/* 73 FallthroughStmt <- <FALLTHROUGH> */
+		nil,
+		/* 74 IfStmt <- <(IF (HeaderSimpleStmt SEMI)? HeaderExpression Block (ELSE (IfStmt / Block))?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{74, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position547, tokenIndex547 := position, tokenIndex
+			{
+				position548 := position
+				{
+					position549 := position
+					if buffer[position] != rune('i') {
+						goto l547
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l547
+					}
+					position++
+					{
+						position550, tokenIndex550 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l550
+								}
+								position++
+							}
+						}
+
+						goto l547
+					l550:
+						position, tokenIndex = position550, tokenIndex550
+					}
+					if !_rules[ruleSpacing]() {
+						goto l547
+					}
+					add(ruleIF, position549)
+				}
+				{
+					position552, tokenIndex552 := position, tokenIndex
+					if !_rules[ruleHeaderSimpleStmt]() {
+						goto l552
+					}
+					if !_rules[ruleSEMI]() {
+						goto l552
+					}
+					goto l553
+				l552:
+					position, tokenIndex = position552, tokenIndex552
+				}
+			l553:
+				if !_rules[ruleHeaderExpression]() {
+					goto l547
+				}
+				if !_rules[ruleBlock]() {
+					goto l547
+				}
+				{
+					position554, tokenIndex554 := position, tokenIndex
+					{
+						position556 := position
+						if buffer[position] != rune('e') {
+							goto l554
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l554
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l554
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l554
+						}
+						position++
+						{
+							position557, tokenIndex557 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l557
+									}
+									position++
+								}
+							}
+
+							goto l554
+						l557:
+							position, tokenIndex = position557, tokenIndex557
+						}
+						if !_rules[ruleSpacing]() {
+							goto l554
+						}
+						add(ruleELSE, position556)
+					}
+					{
+						position559, tokenIndex559 := position, tokenIndex
+						if !_rules[ruleIfStmt]() {
+							goto l560
+						}
+						goto l559
+					l560:
+						position, tokenIndex = position559, tokenIndex559
+						if !_rules[ruleBlock]() {
+							goto l554
+						}
+					}
+				l559:
+					goto l555
+				l554:
+					position, tokenIndex = position554, tokenIndex554
+				}
+			l555:
+				add(ruleIfStmt, position548)
+			}
+			memoize(74, position547, tokenIndex547, true)
+			return true
+		l547:
+			memoize(74, position547, tokenIndex547, false)
+			position, tokenIndex = position547, tokenIndex547
+			return false
+		},
+		/* 75 SwitchStmt <- <(SWITCH (HeaderSimpleStmt SEMI)? (TypeSwitchGuard / HeaderExpression)? LBRACE CaseClause* RBRACE)> */
+		nil,
+		/* 76 TypeSwitchGuard <- <((Identifier DEFINE)? HeaderPrimaryExpr DOT LPAR TYPE RPAR)> */
+		nil,
+		/* 77 CaseClause <- <(((CASE ExpressionList) / DEFAULT) COLON StatementList)> */
+		nil,
+		/* 78 SelectStmt <- <(SELECT LBRACE CommClause* RBRACE)> */
+		nil,
+		/* 79 CommClause <- <(((CASE SimpleStmt) / DEFAULT) COLON StatementList)> */
+		nil,
+		/* 80 ForStmt <- <(FOR (ForClause / RangeClause / HeaderExpression)? Block)> */
+		nil,
+		/* 81 ForClause <- <(HeaderSimpleStmt? SEMI HeaderExpression? SEMI HeaderSimpleStmt?)> */
+		nil,
+		/* 82 RangeClause <- <(((IdentifierList DEFINE) / (HeaderExpressionList ASSIGN))? RANGE HeaderExpression)> */
+		nil,
+		/* 83 Blank <- <((&('\t') '\t') | (&('\r') '\r') | (&('/') ('/' '*' (!('*' '/') !'\n' .)* ('*' '/'))) | (&(' ') %fail('\t' '\r' '/*') ' '))*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{83, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position569, tokenIndex569 := position, tokenIndex
+			{
+				position570 := position
+			l571:
+				{
+					position572, tokenIndex572 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '\t':
+							position++
+						case '\r':
+							position++
+						case '/':
+							position++
+							if buffer[position] != rune('*') {
+								goto l572
+							}
+							position++
+						l574:
+							{
+								position575, tokenIndex575 := position, tokenIndex
+								{
+									position576, tokenIndex576 := position, tokenIndex
+									if buffer[position] != rune('*') {
+										goto l576
+									}
+									position++
+									if buffer[position] != rune('/') {
+										goto l576
+									}
+									position++
+									goto l575
+								l576:
+									position, tokenIndex = position576, tokenIndex576
+								}
+								{
+									position577, tokenIndex577 := position, tokenIndex
+									if buffer[position] != rune('\n') {
+										goto l577
+									}
+									position++
+									goto l575
+								l577:
+									position, tokenIndex = position577, tokenIndex577
+								}
+								if !matchDot() {
+									goto l575
+								}
+								goto l574
+							l575:
+								position, tokenIndex = position575, tokenIndex575
+							}
+							if buffer[position] != rune('*') {
+								goto l572
+							}
+							position++
+							if buffer[position] != rune('/') {
+								goto l572
+							}
+							position++
+						default:
+							if buffer[position] != rune(' ') {
+								goto l572
+							}
+							position++
+						}
+					}
+
+					goto l571
+				l572:
+					position, tokenIndex = position572, tokenIndex572
+				}
+				add(ruleBlank, position570)
+			}
+			memoize(83, position569, tokenIndex569, true)
+			return true
+		},
+		/* 84 Spacing <- <((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&('/') Comment) | (&(' ') %fail('\t' '\n' '\r' '/') ' '))*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{84, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position578, tokenIndex578 := position, tokenIndex
+			{
+				position579 := position
+			l580:
+				{
+					position581, tokenIndex581 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '\t':
+							position++
+						case '\n':
+							position++
+						case '\r':
+							position++
+						case '/':
+							{
+								position583 := position
+								position++
+								{
+									position584, tokenIndex584 := position, tokenIndex
+									if buffer[position] != rune('/') {
+										goto l585
+									}
+									position++
+								l586:
+									{
+										position587, tokenIndex587 := position, tokenIndex
+										{
+											position588, tokenIndex588 := position, tokenIndex
+											if buffer[position] != rune('\n') {
+												goto l588
+											}
+											position++
+											goto l587
+										l588:
+											position, tokenIndex = position588, tokenIndex588
+										}
+										if !matchDot() {
+											goto l587
+										}
+										goto l586
+									l587:
+										position, tokenIndex = position587, tokenIndex587
+									}
+									goto l584
+								l585:
+									position, tokenIndex = position584, tokenIndex584
+									if buffer[position] != rune('*') {
+										goto l581
+									}
+									position++
+								l589:
+									{
+										position590, tokenIndex590 := position, tokenIndex
+										{
+											position591, tokenIndex591 := position, tokenIndex
+											if buffer[position] != rune('*') {
+												goto l591
+											}
+											position++
+											if buffer[position] != rune('/') {
+												goto l591
+											}
+											position++
+											goto l590
+										l591:
+											position, tokenIndex = position591, tokenIndex591
+										}
+										if !matchDot() {
+											goto l590
+										}
+										goto l589
+									l590:
+										position, tokenIndex = position590, tokenIndex590
+									}
+									if buffer[position] != rune('*') {
+										goto l581
+									}
+									position++
+									if buffer[position] != rune('/') {
+										goto l581
+									}
+									position++
+								}
+							l584:
+								add(ruleComment, position583)
+							}
+						default:
+							if buffer[position] != rune(' ') {
+								goto l581
+							}
+							position++
+						}
+					}
+
+					goto l580
+				l581:
+					position, tokenIndex = position581, tokenIndex581
+				}
+				add(ruleSpacing, position579)
+			}
+			memoize(84, position578, tokenIndex578, true)
+			return true
+		},
+		/* 85 Comment <- <('/' (('/' (!'\n' .)*) / ('*' (!('*' '/') .)* '*' '/')))> */
+		nil,
+		/* 86 EOS <- <(SEMI / (((&('/') %fail('\n') ('/' (('/' (!'\n' .)*) / ('*' (!('*' '/') .)* '*' '/')))) | (&('\n') %fail('//' '/*') '\n')) Spacing) / &(')' / '}') / EOF)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{86, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position593, tokenIndex593 := position, tokenIndex
+			{
+				position594 := position
+				{
+					position595, tokenIndex595 := position, tokenIndex
+					if !_rules[ruleSEMI]() {
+						goto l596
+					}
+					goto l595
+				l596:
+					position, tokenIndex = position595, tokenIndex595
+					{
+						switch buffer[position] {
+						case '/':
+							position++
+							{
+								position599, tokenIndex599 := position, tokenIndex
+								if buffer[position] != rune('/') {
+									goto l600
+								}
+								position++
+							l601:
+								{
+									position602, tokenIndex602 := position, tokenIndex
+									{
+										position603, tokenIndex603 := position, tokenIndex
+										if buffer[position] != rune('\n') {
+											goto l603
+										}
+										position++
+										goto l602
+									l603:
+										position, tokenIndex = position603, tokenIndex603
+									}
+									if !matchDot() {
+										goto l602
+									}
+									goto l601
+								l602:
+									position, tokenIndex = position602, tokenIndex602
+								}
+								goto l599
+							l600:
+								position, tokenIndex = position599, tokenIndex599
+								if buffer[position] != rune('*') {
+									goto l597
+								}
+								position++
+							l604:
+								{
+									position605, tokenIndex605 := position, tokenIndex
+									{
+										position606, tokenIndex606 := position, tokenIndex
+										if buffer[position] != rune('*') {
+											goto l606
+										}
+										position++
+										if buffer[position] != rune('/') {
+											goto l606
+										}
+										position++
+										goto l605
+									l606:
+										position, tokenIndex = position606, tokenIndex606
+									}
+									if !matchDot() {
+										goto l605
+									}
+									goto l604
+								l605:
+									position, tokenIndex = position605, tokenIndex605
+								}
+								if buffer[position] != rune('*') {
+									goto l597
+								}
+								position++
+								if buffer[position] != rune('/') {
+									goto l597
+								}
+								position++
+							}
+						l599:
+							break
+						default:
+							if buffer[position] != rune('\n') {
+								goto l597
+							}
+							position++
+						}
+					}
+
+					if !_rules[ruleSpacing]() {
+						goto l597
+					}
+					goto l595
+				l597:
+					position, tokenIndex = position595, tokenIndex595
+					{
+						position608, tokenIndex608 := position, tokenIndex
+						{
+							position609, tokenIndex609 := position, tokenIndex
+							if buffer[position] != rune(')') {
+								goto l610
+							}
+							position++
+							goto l609
+						l610:
+							position, tokenIndex = position609, tokenIndex609
+							if buffer[position] != rune('}') {
+								goto l607
+							}
+							position++
+						}
+					l609:
+						position, tokenIndex = position608, tokenIndex608
+					}
+					goto l595
+				l607:
+					position, tokenIndex = position595, tokenIndex595
+					if !_rules[ruleEOF]() {
+						goto l593
+					}
+				}
+			l595:
+				add(ruleEOS, position594)
+			}
+			memoize(86, position593, tokenIndex593, true)
+			return true
+		l593:
+			memoize(86, position593, tokenIndex593, false)
+			position, tokenIndex = position593, tokenIndex593
+			return false
+		},
+		/* 87 EOF <- <!.> */
+		func() bool {
+			memoized, ok := memoization[memoKey{87, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position611, tokenIndex611 := position, tokenIndex
+			{
+				position612 := position
+				{
+					position613, tokenIndex613 := position, tokenIndex
+					if !matchDot() {
+						goto l613
+					}
+					goto l611
+				l613:
+					position, tokenIndex = position613, tokenIndex613
+				}
+				add(ruleEOF, position612)
+			}
+			memoize(87, position611, tokenIndex611, true)
+			return true
+		l611:
+			memoize(87, position611, tokenIndex611, false)
+			position, tokenIndex = position611, tokenIndex611
+			return false
+		},
+		/* 88 Identifier <- <(Letter ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-Z] '_' [a-z] [\u0080-\U0010ffff]) [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | [\u0080-\U0010ffff]) %fail([0-9]) Letter))* !%in(keywords) Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{88, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position614, tokenIndex614 := position, tokenIndex
+			{
+				position615 := position
+				if !_rules[ruleLetter]() {
+					goto l614
+				}
+			l616:
+				{
+					position617, tokenIndex617 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						default:
+							if !_rules[ruleLetter]() {
+								goto l617
+							}
+						}
+					}
+
+					goto l616
+				l617:
+					position, tokenIndex = position617, tokenIndex617
+				}
+				{
+					position619, tokenIndex619 := position, tokenIndex
+					if _, ok := (keywords)[string(buffer[position615:position])]; !ok {
+						goto l619
+					}
+					goto l614
+				l619:
+					position, tokenIndex = position619, tokenIndex619
+				}
+				if !_rules[ruleBlank]() {
+					goto l614
+				}
+				add(ruleIdentifier, position615)
+			}
+			memoize(88, position614, tokenIndex614, true)
+			return true
+		l614:
+			memoize(88, position614, tokenIndex614, false)
+			position, tokenIndex = position614, tokenIndex614
+			return false
+		},
+		/* 89 Letter <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') %fail([a-z] '_' [\u0080-\U0010ffff]) [A-Z]) | (&('_') %fail([a-z] [A-Z] [\u0080-\U0010ffff]) '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([A-Z] '_' [\u0080-\U0010ffff]) [a-z]) | (&([\u0080-\U0010ffff]) %fail([a-z] [A-Z] '_') [\u0080-\U0010ffff]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{89, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position620, tokenIndex620 := position, tokenIndex
+			{
+				position621 := position
+				{
+					switch buffer[position] {
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						position++
+					case '_':
+						position++
+					case 'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z':
+						position++
+					default:
+						if c := buffer[position]; c < rune('\u0080') || c > rune('\U0010ffff') {
+							goto l620
+						}
+						position++
+					}
+				}
+
+				add(ruleLetter, position621)
+			}
+			memoize(89, position620, tokenIndex620, true)
+			return true
+		l620:
+			memoize(89, position620, tokenIndex620, false)
+			position, tokenIndex = position620, tokenIndex620
+			return false
+		},
+		/* 90 BasicLit <- <(((&('"' | '`') %fail('.' '0' [0-9] [1-9] '\'') String) | (&('\'') %fail('.' '0' [0-9] [1-9] '"' '`') Rune) | (&('.') %fail('0' [1-9] '\'' '"' '`') (Imaginary / Float)) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail('\'' '"' '`') (Imaginary / Float / Int))) Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{90, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position623, tokenIndex623 := position, tokenIndex
+			{
+				position624 := position
+				{
+					switch buffer[position] {
+					case '"', '`':
+						if !_rules[ruleString]() {
+							goto l623
+						}
+					case '\'':
+						{
+							position626 := position
+							position++
+							{
+								position627, tokenIndex627 := position, tokenIndex
+								if buffer[position] != rune('\\') {
+									goto l628
+								}
+								position++
+								if !matchDot() {
+									goto l628
+								}
+							l629:
+								{
+									position630, tokenIndex630 := position, tokenIndex
+									{
+										position631, tokenIndex631 := position, tokenIndex
+										{
+											position632, tokenIndex632 := position, tokenIndex
+											if buffer[position] != rune('\'') {
+												goto l633
+											}
+											position++
+											goto l632
+										l633:
+											position, tokenIndex = position632, tokenIndex632
+											if buffer[position] != rune('\n') {
+												goto l631
+											}
+											position++
+										}
+									l632:
+										goto l630
+									l631:
+										position, tokenIndex = position631, tokenIndex631
+									}
+									if !matchDot() {
+										goto l630
+									}
+									goto l629
+								l630:
+									position, tokenIndex = position630, tokenIndex630
+								}
+								goto l627
+							l628:
+								position, tokenIndex = position627, tokenIndex627
+								{
+									position634, tokenIndex634 := position, tokenIndex
+									{
+										position635, tokenIndex635 := position, tokenIndex
+										if buffer[position] != rune('\'') {
+											goto l636
+										}
+										position++
+										goto l635
+									l636:
+										position, tokenIndex = position635, tokenIndex635
+										if buffer[position] != rune('\n') {
+											goto l634
+										}
+										position++
+									}
+								l635:
+									goto l623
+								l634:
+									position, tokenIndex = position634, tokenIndex634
+								}
+								if !matchDot() {
+									goto l623
+								}
+							}
+						l627:
+							if buffer[position] != rune('\'') {
+								goto l623
+							}
+							position++
+							add(ruleRune, position626)
+						}
+					case '.':
+						{
+							position637, tokenIndex637 := position, tokenIndex
+							if !_rules[ruleImaginary]() {
+								goto l638
+							}
+							goto l637
+						l638:
+							position, tokenIndex = position637, tokenIndex637
+							if !_rules[ruleFloat]() {
+								goto l623
+							}
+						}
+					l637:
+						break
+					default:
+						{
+							position639, tokenIndex639 := position, tokenIndex
+							if !_rules[ruleImaginary]() {
+								goto l640
+							}
+							goto l639
+						l640:
+							position, tokenIndex = position639, tokenIndex639
+							if !_rules[ruleFloat]() {
+								goto l641
+							}
+							goto l639
+						l641:
+							position, tokenIndex = position639, tokenIndex639
+							if !_rules[ruleInt]() {
+								goto l623
+							}
+						}
+					l639:
+						break
+					}
+				}
+
+				if !_rules[ruleBlank]() {
+					goto l623
+				}
+				add(ruleBasicLit, position624)
+			}
+			memoize(90, position623, tokenIndex623, true)
+			return true
+		l623:
+			memoize(90, position623, tokenIndex623, false)
+			position, tokenIndex = position623, tokenIndex623
+			return false
+		},
+		/* 91 Imaginary <- <((Float / Decimals / Int) 'i')> */
+		func() bool {
+			memoized, ok := memoization[memoKey{91, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position642, tokenIndex642 := position, tokenIndex
+			{
+				position643 := position
+				{
+					position644, tokenIndex644 := position, tokenIndex
+					if !_rules[ruleFloat]() {
+						goto l645
+					}
+					goto l644
+				l645:
+					position, tokenIndex = position644, tokenIndex644
+					if !_rules[ruleDecimals]() {
+						goto l646
+					}
+					goto l644
+				l646:
+					position, tokenIndex = position644, tokenIndex644
+					if !_rules[ruleInt]() {
+						goto l642
+					}
+				}
+			l644:
+				if buffer[position] != rune('i') {
+					goto l642
+				}
+				position++
+				add(ruleImaginary, position643)
+			}
+			memoize(91, position642, tokenIndex642, true)
+			return true
+		l642:
+			memoize(91, position642, tokenIndex642, false)
+			position, tokenIndex = position642, tokenIndex642
+			return false
+		},
+		/* 92 Int <- <((&('0') %fail([1-9]) ('0' (((&('B' | 'b') (('b' / 'B') ('_'? ('0' / '1'))+)) | (&('X' | 'x') (('x' / 'X') ('_'? HexDigit)+)) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | 'O' | '_' | 'o') %fail('b' 'B' 'x' 'X') (('o' / 'O')? ('_'? [0-7])+))) / ))) | (&('1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail('0') ([1-9] ('_'? [0-9])*)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{92, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position647, tokenIndex647 := position, tokenIndex
+			{
+				position648 := position
+				{
+					switch buffer[position] {
+					case '0':
+						position++
+						{
+							position650, tokenIndex650 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case 'B', 'b':
+									{
+										position653, tokenIndex653 := position, tokenIndex
+										if buffer[position] != rune('b') {
+											goto l654
+										}
+										position++
+										goto l653
+									l654:
+										position, tokenIndex = position653, tokenIndex653
+										if buffer[position] != rune('B') {
+											goto l651
+										}
+										position++
+									}
+								l653:
+									{
+										position657, tokenIndex657 := position, tokenIndex
+										if buffer[position] != rune('_') {
+											goto l657
+										}
+										position++
+										goto l658
+									l657:
+										position, tokenIndex = position657, tokenIndex657
+									}
+								l658:
+									{
+										position659, tokenIndex659 := position, tokenIndex
+										if buffer[position] != rune('0') {
+											goto l660
+										}
+										position++
+										goto l659
+									l660:
+										position, tokenIndex = position659, tokenIndex659
+										if buffer[position] != rune('1') {
+											goto l651
+										}
+										position++
+									}
+								l659:
+								l655:
+									{
+										position656, tokenIndex656 := position, tokenIndex
+										{
+											position661, tokenIndex661 := position, tokenIndex
+											if buffer[position] != rune('_') {
+												goto l661
+											}
+											position++
+											goto l662
+										l661:
+											position, tokenIndex = position661, tokenIndex661
+										}
+									l662:
+										{
+											position663, tokenIndex663 := position, tokenIndex
+											if buffer[position] != rune('0') {
+												goto l664
+											}
+											position++
+											goto l663
+										l664:
+											position, tokenIndex = position663, tokenIndex663
+											if buffer[position] != rune('1') {
+												goto l656
+											}
+											position++
+										}
+									l663:
+										goto l655
+									l656:
+										position, tokenIndex = position656, tokenIndex656
+									}
+								case 'X', 'x':
+									{
+										position665, tokenIndex665 := position, tokenIndex
+										if buffer[position] != rune('x') {
+											goto l666
+										}
+										position++
+										goto l665
+									l666:
+										position, tokenIndex = position665, tokenIndex665
+										if buffer[position] != rune('X') {
+											goto l651
+										}
+										position++
+									}
+								l665:
+									{
+										position669, tokenIndex669 := position, tokenIndex
+										if buffer[position] != rune('_') {
+											goto l669
+										}
+										position++
+										goto l670
+									l669:
+										position, tokenIndex = position669, tokenIndex669
+									}
+								l670:
+									if !_rules[ruleHexDigit]() {
+										goto l651
+									}
+								l667:
+									{
+										position668, tokenIndex668 := position, tokenIndex
+										{
+											position671, tokenIndex671 := position, tokenIndex
+											if buffer[position] != rune('_') {
+												goto l671
+											}
+											position++
+											goto l672
+										l671:
+											position, tokenIndex = position671, tokenIndex671
+										}
+									l672:
+										if !_rules[ruleHexDigit]() {
+											goto l668
+										}
+										goto l667
+									l668:
+										position, tokenIndex = position668, tokenIndex668
+									}
+								default:
+									{
+										position673, tokenIndex673 := position, tokenIndex
+										{
+											position675, tokenIndex675 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l676
+											}
+											position++
+											goto l675
+										l676:
+											position, tokenIndex = position675, tokenIndex675
+											if buffer[position] != rune('O') {
+												goto l673
+											}
+											position++
+										}
+									l675:
+										goto l674
+									l673:
+										position, tokenIndex = position673, tokenIndex673
+									}
+								l674:
+									{
+										position679, tokenIndex679 := position, tokenIndex
+										if buffer[position] != rune('_') {
+											goto l679
+										}
+										position++
+										goto l680
+									l679:
+										position, tokenIndex = position679, tokenIndex679
+									}
+								l680:
+									if c := buffer[position]; c < rune('0') || c > rune('7') {
+										goto l651
+									}
+									position++
+								l677:
+									{
+										position678, tokenIndex678 := position, tokenIndex
+										{
+											position681, tokenIndex681 := position, tokenIndex
+											if buffer[position] != rune('_') {
+												goto l681
+											}
+											position++
+											goto l682
+										l681:
+											position, tokenIndex = position681, tokenIndex681
+										}
+									l682:
+										if c := buffer[position]; c < rune('0') || c > rune('7') {
+											goto l678
+										}
+										position++
+										goto l677
+									l678:
+										position, tokenIndex = position678, tokenIndex678
+									}
+								}
+							}
+
+							goto l650
+						l651:
+							position, tokenIndex = position650, tokenIndex650
+						}
+					l650:
+						break
+					default:
+						if c := buffer[position]; c < rune('1') || c > rune('9') {
+							goto l647
+						}
+						position++
+					l683:
+						{
+							position684, tokenIndex684 := position, tokenIndex
+							{
+								position685, tokenIndex685 := position, tokenIndex
+								if buffer[position] != rune('_') {
+									goto l685
+								}
+								position++
+								goto l686
+							l685:
+								position, tokenIndex = position685, tokenIndex685
+							}
+						l686:
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l684
+							}
+							position++
+							goto l683
+						l684:
+							position, tokenIndex = position684, tokenIndex684
+						}
+					}
+				}
+
+				add(ruleInt, position648)
+			}
+			memoize(92, position647, tokenIndex647, true)
+			return true
+		l647:
+			memoize(92, position647, tokenIndex647, false)
+			position, tokenIndex = position647, tokenIndex647
+			return false
+		},
+		/* 93 Float <- <((&('.') %fail('0' [0-9]) ('.' Decimals Exponent?)) | (&('0') %fail('.') (('0' ('x' / 'X') HexMantissa ('p' / 'P') ('+' / '-')? Decimals) / (Decimals (('.' Decimals? Exponent?) / Exponent)))) | (&('1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail('0' '.') (Decimals (('.' Decimals? Exponent?) / Exponent))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{93, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position687, tokenIndex687 := position, tokenIndex
+			{
+				position688 := position
+				{
+					switch buffer[position] {
+					case '.':
+						position++
+						if !_rules[ruleDecimals]() {
+							goto l687
+						}
+						{
+							position690, tokenIndex690 := position, tokenIndex
+							if !_rules[ruleExponent]() {
+								goto l690
+							}
+							goto l691
+						l690:
+							position, tokenIndex = position690, tokenIndex690
+						}
+					l691:
+						break
+					case '0':
+						{
+							position692, tokenIndex692 := position, tokenIndex
+							if buffer[position] != rune('0') {
+								goto l693
+							}
+							position++
+							{
+								position694, tokenIndex694 := position, tokenIndex
+								if buffer[position] != rune('x') {
+									goto l695
+								}
+								position++
+								goto l694
+							l695:
+								position, tokenIndex = position694, tokenIndex694
+								if buffer[position] != rune('X') {
+									goto l693
+								}
+								position++
+							}
+						l694:
+							{
+								position696 := position
+								{
+									position697, tokenIndex697 := position, tokenIndex
+									{
+										position701, tokenIndex701 := position, tokenIndex
+										if buffer[position] != rune('_') {
+											goto l701
+										}
+										position++
+										goto l702
+									l701:
+										position, tokenIndex = position701, tokenIndex701
+									}
+								l702:
+									if !_rules[ruleHexDigit]() {
+										goto l698
+									}
+								l699:
+									{
+										position700, tokenIndex700 := position, tokenIndex
+										{
+											position703, tokenIndex703 := position, tokenIndex
+											if buffer[position] != rune('_') {
+												goto l703
+											}
+											position++
+											goto l704
+										l703:
+											position, tokenIndex = position703, tokenIndex703
+										}
+									l704:
+										if !_rules[ruleHexDigit]() {
+											goto l700
+										}
+										goto l699
+									l700:
+										position, tokenIndex = position700, tokenIndex700
+									}
+									{
+										position705, tokenIndex705 := position, tokenIndex
+										if buffer[position] != rune('.') {
+											goto l705
+										}
+										position++
+										if !_rules[ruleHexDigit]() {
+											goto l705
+										}
+									l707:
+										{
+											position708, tokenIndex708 := position, tokenIndex
+											{
+												position709, tokenIndex709 := position, tokenIndex
+												if buffer[position] != rune('_') {
+													goto l709
+												}
+												position++
+												goto l710
+											l709:
+												position, tokenIndex = position709, tokenIndex709
+											}
+										l710:
+											if !_rules[ruleHexDigit]() {
+												goto l708
+											}
+											goto l707
+										l708:
+											position, tokenIndex = position708, tokenIndex708
+										}
+										goto l706
+									l705:
+										position, tokenIndex = position705, tokenIndex705
+									}
+								l706:
+									goto l697
+								l698:
+									position, tokenIndex = position697, tokenIndex697
+									if buffer[position] != rune('.') {
+										goto l693
+									}
+									position++
+									if !_rules[ruleHexDigit]() {
+										goto l693
+									}
+								l711:
+									{
+										position712, tokenIndex712 := position, tokenIndex
+										{
+											position713, tokenIndex713 := position, tokenIndex
+											if buffer[position] != rune('_') {
+												goto l713
+											}
+											position++
+											goto l714
+										l713:
+											position, tokenIndex = position713, tokenIndex713
+										}
+									l714:
+										if !_rules[ruleHexDigit]() {
+											goto l712
+										}
+										goto l711
+									l712:
+										position, tokenIndex = position712, tokenIndex712
+									}
+								}
+							l697:
+								add(ruleHexMantissa, position696)
+							}
+							{
+								position715, tokenIndex715 := position, tokenIndex
+								if buffer[position] != rune('p') {
+									goto l716
+								}
+								position++
+								goto l715
+							l716:
+								position, tokenIndex = position715, tokenIndex715
+								if buffer[position] != rune('P') {
+									goto l693
+								}
+								position++
+							}
+						l715:
+							{
+								position717, tokenIndex717 := position, tokenIndex
+								{
+									position719, tokenIndex719 := position, tokenIndex
+									if buffer[position] != rune('+') {
+										goto l720
+									}
+									position++
+									goto l719
+								l720:
+									position, tokenIndex = position719, tokenIndex719
+									if buffer[position] != rune('-') {
+										goto l717
+									}
+									position++
+								}
+							l719:
+								goto l718
+							l717:
+								position, tokenIndex = position717, tokenIndex717
+							}
+						l718:
+							if !_rules[ruleDecimals]() {
+								goto l693
+							}
+							goto l692
+						l693:
+							position, tokenIndex = position692, tokenIndex692
+							if !_rules[ruleDecimals]() {
+								goto l687
+							}
+							{
+								position721, tokenIndex721 := position, tokenIndex
+								if buffer[position] != rune('.') {
+									goto l722
+								}
+								position++
+								{
+									position723, tokenIndex723 := position, tokenIndex
+									if !_rules[ruleDecimals]() {
+										goto l723
+									}
+									goto l724
+								l723:
+									position, tokenIndex = position723, tokenIndex723
+								}
+							l724:
+								{
+									position725, tokenIndex725 := position, tokenIndex
+									if !_rules[ruleExponent]() {
+										goto l725
+									}
+									goto l726
+								l725:
+									position, tokenIndex = position725, tokenIndex725
+								}
+							l726:
+								goto l721
+							l722:
+								position, tokenIndex = position721, tokenIndex721
+								if !_rules[ruleExponent]() {
+									goto l687
+								}
+							}
+						l721:
+						}
+					l692:
+						break
+					default:
+						if !_rules[ruleDecimals]() {
+							goto l687
+						}
+						{
+							position727, tokenIndex727 := position, tokenIndex
+							if buffer[position] != rune('.') {
+								goto l728
+							}
+							position++
+							{
+								position729, tokenIndex729 := position, tokenIndex
+								if !_rules[ruleDecimals]() {
+									goto l729
+								}
+								goto l730
+							l729:
+								position, tokenIndex = position729, tokenIndex729
+							}
+						l730:
+							{
+								position731, tokenIndex731 := position, tokenIndex
+								if !_rules[ruleExponent]() {
+									goto l731
+								}
+								goto l732
+							l731:
+								position, tokenIndex = position731, tokenIndex731
+							}
+						l732:
+							goto l727
+						l728:
+							position, tokenIndex = position727, tokenIndex727
+							if !_rules[ruleExponent]() {
+								goto l687
+							}
+						}
+					l727:
+						break
+					}
+				}
+
+				add(ruleFloat, position688)
+			}
+			memoize(93, position687, tokenIndex687, true)
+			return true
+		l687:
+			memoize(93, position687, tokenIndex687, false)
+			position, tokenIndex = position687, tokenIndex687
+			return false
+		},
+		/* 94 HexMantissa <- <((('_'? HexDigit)+ ('.' HexDigit ('_'? HexDigit)*)?) / ('.' HexDigit ('_'? HexDigit)*))> */
+		nil,
+		/* 95 Decimals <- <([0-9] ('_'? [0-9])*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{95, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position734, tokenIndex734 := position, tokenIndex
+			{
+				position735 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l734
+				}
+				position++
+			l736:
+				{
+					position737, tokenIndex737 := position, tokenIndex
+					{
+						position738, tokenIndex738 := position, tokenIndex
+						if buffer[position] != rune('_') {
+							goto l738
+						}
+						position++
+						goto l739
+					l738:
+						position, tokenIndex = position738, tokenIndex738
+					}
+				l739:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l737
+					}
+					position++
+					goto l736
+				l737:
+					position, tokenIndex = position737, tokenIndex737
+				}
+				add(ruleDecimals, position735)
+			}
+			memoize(95, position734, tokenIndex734, true)
+			return true
+		l734:
+			memoize(95, position734, tokenIndex734, false)
+			position, tokenIndex = position734, tokenIndex734
+			return false
+		},
+		/* 96 Exponent <- <(('e' / 'E') ('+' / '-')? Decimals)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{96, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position740, tokenIndex740 := position, tokenIndex
+			{
+				position741 := position
+				{
+					position742, tokenIndex742 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l743
+					}
+					position++
+					goto l742
+				l743:
+					position, tokenIndex = position742, tokenIndex742
+					if buffer[position] != rune('E') {
+						goto l740
+					}
+					position++
+				}
+			l742:
+				{
+					position744, tokenIndex744 := position, tokenIndex
+					{
+						position746, tokenIndex746 := position, tokenIndex
+						if buffer[position] != rune('+') {
+							goto l747
+						}
+						position++
+						goto l746
+					l747:
+						position, tokenIndex = position746, tokenIndex746
+						if buffer[position] != rune('-') {
+							goto l744
+						}
+						position++
+					}
+				l746:
+					goto l745
+				l744:
+					position, tokenIndex = position744, tokenIndex744
+				}
+			l745:
+				if !_rules[ruleDecimals]() {
+					goto l740
+				}
+				add(ruleExponent, position741)
+			}
+			memoize(96, position740, tokenIndex740, true)
+			return true
+		l740:
+			memoize(96, position740, tokenIndex740, false)
+			position, tokenIndex = position740, tokenIndex740
+			return false
+		},
+		/* 97 HexDigit <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-F] [a-f]) [0-9]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{97, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position748, tokenIndex748 := position, tokenIndex
+			{
+				position749 := position
+				{
+					switch buffer[position] {
+					case 'A', 'B', 'C', 'D', 'E', 'F':
+						position++
+					case 'a', 'b', 'c', 'd', 'e', 'f':
+						position++
+					default:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l748
+						}
+						position++
+					}
+				}
+
+				add(ruleHexDigit, position749)
+			}
+			memoize(97, position748, tokenIndex748, true)
+			return true
+		l748:
+			memoize(97, position748, tokenIndex748, false)
+			position, tokenIndex = position748, tokenIndex748
+			return false
+		},
+		/* 98 Rune <- <('\'' (('\\' . (!('\'' / '\n') .)*) / (!('\'' / '\n') .)) '\'')> */
+		nil,
+		/* 99 String <- <((('"' (('\\' .) / (!((&('\n') '\n') | (&('\\') '\\') | (&('"') %fail('\n' '\\') '"')) .))* '"') / ('`' (!'`' .)* '`')) Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{99, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position752, tokenIndex752 := position, tokenIndex
+			{
+				position753 := position
+				{
+					position754, tokenIndex754 := position, tokenIndex
+					if buffer[position] != rune('"') {
+						goto l755
+					}
+					position++
+				l756:
+					{
+						position757, tokenIndex757 := position, tokenIndex
+						{
+							position758, tokenIndex758 := position, tokenIndex
+							if buffer[position] != rune('\\') {
+								goto l759
+							}
+							position++
+							if !matchDot() {
+								goto l759
+							}
+							goto l758
+						l759:
+							position, tokenIndex = position758, tokenIndex758
+							{
+								position760, tokenIndex760 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '\n':
+										position++
+									case '\\':
+										position++
+									default:
+										if buffer[position] != rune('"') {
+											goto l760
+										}
+										position++
+									}
+								}
+
+								goto l757
+							l760:
+								position, tokenIndex = position760, tokenIndex760
+							}
+							if !matchDot() {
+								goto l757
+							}
+						}
+					l758:
+						goto l756
+					l757:
+						position, tokenIndex = position757, tokenIndex757
+					}
+					if buffer[position] != rune('"') {
+						goto l755
+					}
+					position++
+					goto l754
+				l755:
+					position, tokenIndex = position754, tokenIndex754
+					if buffer[position] != rune('`') {
+						goto l752
+					}
+					position++
+				l762:
+					{
+						position763, tokenIndex763 := position, tokenIndex
+						{
+							position764, tokenIndex764 := position, tokenIndex
+							if buffer[position] != rune('`') {
+								goto l764
+							}
+							position++
+							goto l763
+						l764:
+							position, tokenIndex = position764, tokenIndex764
+						}
+						if !matchDot() {
+							goto l763
+						}
+						goto l762
+					l763:
+						position, tokenIndex = position763, tokenIndex763
+					}
+					if buffer[position] != rune('`') {
+						goto l752
+					}
+					position++
+				}
+			l754:
+				if !_rules[ruleBlank]() {
+					goto l752
+				}
+				add(ruleString, position753)
+			}
+			memoize(99, position752, tokenIndex752, true)
+			return true
+		l752:
+			memoize(99, position752, tokenIndex752, false)
+			position, tokenIndex = position752, tokenIndex752
+			return false
+		},
+		/* 100 PACKAGE <- <('p' 'a' 'c' 'k' 'a' 'g' 'e' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 101 IMPORT <- <('i' 'm' 'p' 'o' 'r' 't' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 102 CONST <- <('c' 'o' 'n' 's' 't' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 103 TYPE <- <('t' 'y' 'p' 'e' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{103, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position768, tokenIndex768 := position, tokenIndex
+			{
+				position769 := position
+				if buffer[position] != rune('t') {
+					goto l768
+				}
+				position++
+				if buffer[position] != rune('y') {
+					goto l768
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l768
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l768
+				}
+				position++
+				{
+					position770, tokenIndex770 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l770
+							}
+							position++
+						}
+					}
+
+					goto l768
+				l770:
+					position, tokenIndex = position770, tokenIndex770
+				}
+				if !_rules[ruleSpacing]() {
+					goto l768
+				}
+				add(ruleTYPE, position769)
+			}
+			memoize(103, position768, tokenIndex768, true)
+			return true
+		l768:
+			memoize(103, position768, tokenIndex768, false)
+			position, tokenIndex = position768, tokenIndex768
+			return false
+		},
+		/* 104 VAR <- <('v' 'a' 'r' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 105 FUNC <- <('f' 'u' 'n' 'c' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{105, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position773, tokenIndex773 := position, tokenIndex
+			{
+				position774 := position
+				if buffer[position] != rune('f') {
+					goto l773
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l773
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l773
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l773
+				}
+				position++
+				{
+					position775, tokenIndex775 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l775
+							}
+							position++
+						}
+					}
+
+					goto l773
+				l775:
+					position, tokenIndex = position775, tokenIndex775
+				}
+				if !_rules[ruleSpacing]() {
+					goto l773
+				}
+				add(ruleFUNC, position774)
+			}
+			memoize(105, position773, tokenIndex773, true)
+			return true
+		l773:
+			memoize(105, position773, tokenIndex773, false)
+			position, tokenIndex = position773, tokenIndex773
+			return false
+		},
+		/* 106 STRUCT <- <('s' 't' 'r' 'u' 'c' 't' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 107 INTERFACE <- <('i' 'n' 't' 'e' 'r' 'f' 'a' 'c' 'e' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 108 MAP <- <('m' 'a' 'p' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 109 CHAN <- <('c' 'h' 'a' 'n' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{109, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position780, tokenIndex780 := position, tokenIndex
+			{
+				position781 := position
+				if buffer[position] != rune('c') {
+					goto l780
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l780
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l780
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l780
+				}
+				position++
+				{
+					position782, tokenIndex782 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l782
+							}
+							position++
+						}
+					}
+
+					goto l780
+				l782:
+					position, tokenIndex = position782, tokenIndex782
+				}
+				if !_rules[ruleSpacing]() {
+					goto l780
+				}
+				add(ruleCHAN, position781)
+			}
+			memoize(109, position780, tokenIndex780, true)
+			return true
+		l780:
+			memoize(109, position780, tokenIndex780, false)
+			position, tokenIndex = position780, tokenIndex780
+			return false
+		},
+		/* 110 GO <- <('g' 'o' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 111 DEFER <- <('d' 'e' 'f' 'e' 'r' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 112 RETURN <- <('r' 'e' 't' 'u' 'r' 'n' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Blank)> */
+		nil,
+		/* 113 BREAK <- <('b' 'r' 'e' 'a' 'k' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Blank)> */
+		nil,
+		/* 114 CONTINUE <- <('c' 'o' 'n' 't' 'i' 'n' 'u' 'e' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Blank)> */
+		nil,
+		/* 115 GOTO <- <('g' 'o' 't' 'o' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 116 FALLTHROUGH <- <('f' 'a' 'l' 'l' 't' 'h' 'r' 'o' 'u' 'g' 'h' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Blank)> */
+		nil,
+		/* 117 IF <- <('i' 'f' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 118 ELSE <- <('e' 'l' 's' 'e' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 119 SWITCH <- <('s' 'w' 'i' 't' 'c' 'h' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 120 CASE <- <('c' 'a' 's' 'e' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{120, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position794, tokenIndex794 := position, tokenIndex
+			{
+				position795 := position
+				if buffer[position] != rune('c') {
+					goto l794
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l794
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l794
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l794
+				}
+				position++
+				{
+					position796, tokenIndex796 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l796
+							}
+							position++
+						}
+					}
+
+					goto l794
+				l796:
+					position, tokenIndex = position796, tokenIndex796
+				}
+				if !_rules[ruleSpacing]() {
+					goto l794
+				}
+				add(ruleCASE, position795)
+			}
+			memoize(120, position794, tokenIndex794, true)
+			return true
+		l794:
+			memoize(120, position794, tokenIndex794, false)
+			position, tokenIndex = position794, tokenIndex794
+			return false
+		},
+		/* 121 DEFAULT <- <('d' 'e' 'f' 'a' 'u' 'l' 't' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{121, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position798, tokenIndex798 := position, tokenIndex
+			{
+				position799 := position
+				if buffer[position] != rune('d') {
+					goto l798
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l798
+				}
+				position++
+				if buffer[position] != rune('f') {
+					goto l798
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l798
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l798
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l798
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l798
+				}
+				position++
+				{
+					position800, tokenIndex800 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l800
+							}
+							position++
+						}
+					}
+
+					goto l798
+				l800:
+					position, tokenIndex = position800, tokenIndex800
+				}
+				if !_rules[ruleSpacing]() {
+					goto l798
+				}
+				add(ruleDEFAULT, position799)
+			}
+			memoize(121, position798, tokenIndex798, true)
+			return true
+		l798:
+			memoize(121, position798, tokenIndex798, false)
+			position, tokenIndex = position798, tokenIndex798
+			return false
+		},
+		/* 122 SELECT <- <('s' 'e' 'l' 'e' 'c' 't' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 123 FOR <- <('f' 'o' 'r' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 124 RANGE <- <('r' 'a' 'n' 'g' 'e' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z] '_') [a-z])) Spacing)> */
+		nil,
+		/* 125 LPAR <- <('(' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{125, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position805, tokenIndex805 := position, tokenIndex
+			{
+				position806 := position
+				if buffer[position] != rune('(') {
+					goto l805
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l805
+				}
+				add(ruleLPAR, position806)
+			}
+			memoize(125, position805, tokenIndex805, true)
+			return true
+		l805:
+			memoize(125, position805, tokenIndex805, false)
+			position, tokenIndex = position805, tokenIndex805
+			return false
+		},
+		/* 126 RPAR <- <(')' Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{126, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position807, tokenIndex807 := position, tokenIndex
+			{
+				position808 := position
+				if buffer[position] != rune(')') {
+					goto l807
+				}
+				position++
+				if !_rules[ruleBlank]() {
+					goto l807
+				}
+				add(ruleRPAR, position808)
+			}
+			memoize(126, position807, tokenIndex807, true)
+			return true
+		l807:
+			memoize(126, position807, tokenIndex807, false)
+			position, tokenIndex = position807, tokenIndex807
+			return false
+		},
+		/* 127 LBRACK <- <('[' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{127, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position809, tokenIndex809 := position, tokenIndex
+			{
+				position810 := position
+				if buffer[position] != rune('[') {
+					goto l809
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l809
+				}
+				add(ruleLBRACK, position810)
+			}
+			memoize(127, position809, tokenIndex809, true)
+			return true
+		l809:
+			memoize(127, position809, tokenIndex809, false)
+			position, tokenIndex = position809, tokenIndex809
+			return false
+		},
+		/* 128 RBRACK <- <(']' Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{128, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position811, tokenIndex811 := position, tokenIndex
+			{
+				position812 := position
+				if buffer[position] != rune(']') {
+					goto l811
+				}
+				position++
+				if !_rules[ruleBlank]() {
+					goto l811
+				}
+				add(ruleRBRACK, position812)
+			}
+			memoize(128, position811, tokenIndex811, true)
+			return true
+		l811:
+			memoize(128, position811, tokenIndex811, false)
+			position, tokenIndex = position811, tokenIndex811
+			return false
+		},
+		/* 129 LBRACE <- <('{' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{129, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position813, tokenIndex813 := position, tokenIndex
+			{
+				position814 := position
+				if buffer[position] != rune('{') {
+					goto l813
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l813
+				}
+				add(ruleLBRACE, position814)
+			}
+			memoize(129, position813, tokenIndex813, true)
+			return true
+		l813:
+			memoize(129, position813, tokenIndex813, false)
+			position, tokenIndex = position813, tokenIndex813
+			return false
+		},
+		/* 130 RBRACE <- <('}' Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{130, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position815, tokenIndex815 := position, tokenIndex
+			{
+				position816 := position
+				if buffer[position] != rune('}') {
+					goto l815
+				}
+				position++
+				if !_rules[ruleBlank]() {
+					goto l815
+				}
+				add(ruleRBRACE, position816)
+			}
+			memoize(130, position815, tokenIndex815, true)
+			return true
+		l815:
+			memoize(130, position815, tokenIndex815, false)
+			position, tokenIndex = position815, tokenIndex815
+			return false
+		},
+		/* 131 COMMA <- <(',' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{131, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position817, tokenIndex817 := position, tokenIndex
+			{
+				position818 := position
+				if buffer[position] != rune(',') {
+					goto l817
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l817
+				}
+				add(ruleCOMMA, position818)
+			}
+			memoize(131, position817, tokenIndex817, true)
+			return true
+		l817:
+			memoize(131, position817, tokenIndex817, false)
+			position, tokenIndex = position817, tokenIndex817
+			return false
+		},
+		/* 132 SEMI <- <(';' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{132, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position819, tokenIndex819 := position, tokenIndex
+			{
+				position820 := position
+				if buffer[position] != rune(';') {
+					goto l819
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l819
+				}
+				add(ruleSEMI, position820)
+			}
+			memoize(132, position819, tokenIndex819, true)
+			return true
+		l819:
+			memoize(132, position819, tokenIndex819, false)
+			position, tokenIndex = position819, tokenIndex819
+			return false
+		},
+		/* 133 COLON <- <(':' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{133, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position821, tokenIndex821 := position, tokenIndex
+			{
+				position822 := position
+				if buffer[position] != rune(':') {
+					goto l821
+				}
+				position++
+				{
+					position823, tokenIndex823 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l823
+					}
+					position++
+					goto l821
+				l823:
+					position, tokenIndex = position823, tokenIndex823
+				}
+				if !_rules[ruleSpacing]() {
+					goto l821
+				}
+				add(ruleCOLON, position822)
+			}
+			memoize(133, position821, tokenIndex821, true)
+			return true
+		l821:
+			memoize(133, position821, tokenIndex821, false)
+			position, tokenIndex = position821, tokenIndex821
+			return false
+		},
+		/* 134 DOT <- <('.' !'.' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{134, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position824, tokenIndex824 := position, tokenIndex
+			{
+				position825 := position
+				if buffer[position] != rune('.') {
+					goto l824
+				}
+				position++
+				{
+					position826, tokenIndex826 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l826
+					}
+					position++
+					goto l824
+				l826:
+					position, tokenIndex = position826, tokenIndex826
+				}
+				if !_rules[ruleSpacing]() {
+					goto l824
+				}
+				add(ruleDOT, position825)
+			}
+			memoize(134, position824, tokenIndex824, true)
+			return true
+		l824:
+			memoize(134, position824, tokenIndex824, false)
+			position, tokenIndex = position824, tokenIndex824
+			return false
+		},
+		/* 135 ELLIPSIS <- <('.' '.' '.' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{135, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position827, tokenIndex827 := position, tokenIndex
+			{
+				position828 := position
+				if buffer[position] != rune('.') {
+					goto l827
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l827
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l827
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l827
+				}
+				add(ruleELLIPSIS, position828)
+			}
+			memoize(135, position827, tokenIndex827, true)
+			return true
+		l827:
+			memoize(135, position827, tokenIndex827, false)
+			position, tokenIndex = position827, tokenIndex827
+			return false
+		},
+		/* 136 DEFINE <- <(':' '=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{136, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position829, tokenIndex829 := position, tokenIndex
+			{
+				position830 := position
+				if buffer[position] != rune(':') {
+					goto l829
+				}
+				position++
+				if buffer[position] != rune('=') {
+					goto l829
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l829
+				}
+				add(ruleDEFINE, position830)
+			}
+			memoize(136, position829, tokenIndex829, true)
+			return true
+		l829:
+			memoize(136, position829, tokenIndex829, false)
+			position, tokenIndex = position829, tokenIndex829
+			return false
+		},
+		/* 137 ASSIGN <- <('=' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{137, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position831, tokenIndex831 := position, tokenIndex
+			{
+				position832 := position
+				if buffer[position] != rune('=') {
+					goto l831
+				}
+				position++
+				{
+					position833, tokenIndex833 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l833
+					}
+					position++
+					goto l831
+				l833:
+					position, tokenIndex = position833, tokenIndex833
+				}
+				if !_rules[ruleSpacing]() {
+					goto l831
+				}
+				add(ruleASSIGN, position832)
+			}
+			memoize(137, position831, tokenIndex831, true)
+			return true
+		l831:
+			memoize(137, position831, tokenIndex831, false)
+			position, tokenIndex = position831, tokenIndex831
+			return false
+		},
+		/* 138 ARROW <- <('<' '-' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{138, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position834, tokenIndex834 := position, tokenIndex
+			{
+				position835 := position
+				if buffer[position] != rune('<') {
+					goto l834
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l834
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l834
+				}
+				add(ruleARROW, position835)
+			}
+			memoize(138, position834, tokenIndex834, true)
+			return true
+		l834:
+			memoize(138, position834, tokenIndex834, false)
+			position, tokenIndex = position834, tokenIndex834
+			return false
+		},
+		/* 139 TILDE <- <('~' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{139, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position836, tokenIndex836 := position, tokenIndex
+			{
+				position837 := position
+				if buffer[position] != rune('~') {
+					goto l836
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l836
+				}
+				add(ruleTILDE, position837)
+			}
+			memoize(139, position836, tokenIndex836, true)
+			return true
+		l836:
+			memoize(139, position836, tokenIndex836, false)
+			position, tokenIndex = position836, tokenIndex836
+			return false
+		},
+		/* 140 INC <- <('+' '+' Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{140, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position838, tokenIndex838 := position, tokenIndex
+			{
+				position839 := position
+				if buffer[position] != rune('+') {
+					goto l838
+				}
+				position++
+				if buffer[position] != rune('+') {
+					goto l838
+				}
+				position++
+				if !_rules[ruleBlank]() {
+					goto l838
+				}
+				add(ruleINC, position839)
+			}
+			memoize(140, position838, tokenIndex838, true)
+			return true
+		l838:
+			memoize(140, position838, tokenIndex838, false)
+			position, tokenIndex = position838, tokenIndex838
+			return false
+		},
+		/* 141 DEC <- <('-' '-' Blank)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{141, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position840, tokenIndex840 := position, tokenIndex
+			{
+				position841 := position
+				if buffer[position] != rune('-') {
+					goto l840
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l840
+				}
+				position++
+				if !_rules[ruleBlank]() {
+					goto l840
+				}
+				add(ruleDEC, position841)
+			}
+			memoize(141, position840, tokenIndex840, true)
+			return true
+		l840:
+			memoize(141, position840, tokenIndex840, false)
+			position, tokenIndex = position840, tokenIndex840
+			return false
+		},
+		/* 142 OROR <- <('|' '|' Spacing)> */
+		nil,
+		/* 143 ANDAND <- <('&' '&' Spacing)> */
+		nil,
+		/* 144 EQL <- <('=' '=' Spacing)> */
+		nil,
+		/* 145 NEQ <- <('!' '=' Spacing)> */
+		nil,
+		/* 146 LEQ <- <('<' '=' Spacing)> */
+		nil,
+		/* 147 GEQ <- <('>' '=' Spacing)> */
+		nil,
+		/* 148 LSS <- <('<' !((&('<') '<') | (&('=') '=') | (&('-') %fail('<' '=') '-')) Spacing)> */
+		nil,
+		/* 149 GTR <- <('>' !('>' / '=') Spacing)> */
+		nil,
+		/* 150 ADD <- <('+' !('+' / '=') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{150, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position850, tokenIndex850 := position, tokenIndex
+			{
+				position851 := position
+				if buffer[position] != rune('+') {
+					goto l850
+				}
+				position++
+				{
+					position852, tokenIndex852 := position, tokenIndex
+					{
+						position853, tokenIndex853 := position, tokenIndex
+						if buffer[position] != rune('+') {
+							goto l854
+						}
+						position++
+						goto l853
+					l854:
+						position, tokenIndex = position853, tokenIndex853
+						if buffer[position] != rune('=') {
+							goto l852
+						}
+						position++
+					}
+				l853:
+					goto l850
+				l852:
+					position, tokenIndex = position852, tokenIndex852
+				}
+				if !_rules[ruleSpacing]() {
+					goto l850
+				}
+				add(ruleADD, position851)
+			}
+			memoize(150, position850, tokenIndex850, true)
+			return true
+		l850:
+			memoize(150, position850, tokenIndex850, false)
+			position, tokenIndex = position850, tokenIndex850
+			return false
+		},
+		/* 151 SUB <- <('-' !('-' / '=') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{151, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position855, tokenIndex855 := position, tokenIndex
+			{
+				position856 := position
+				if buffer[position] != rune('-') {
+					goto l855
+				}
+				position++
+				{
+					position857, tokenIndex857 := position, tokenIndex
+					{
+						position858, tokenIndex858 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l859
+						}
+						position++
+						goto l858
+					l859:
+						position, tokenIndex = position858, tokenIndex858
+						if buffer[position] != rune('=') {
+							goto l857
+						}
+						position++
+					}
+				l858:
+					goto l855
+				l857:
+					position, tokenIndex = position857, tokenIndex857
+				}
+				if !_rules[ruleSpacing]() {
+					goto l855
+				}
+				add(ruleSUB, position856)
+			}
+			memoize(151, position855, tokenIndex855, true)
+			return true
+		l855:
+			memoize(151, position855, tokenIndex855, false)
+			position, tokenIndex = position855, tokenIndex855
+			return false
+		},
+		/* 152 OR <- <('|' !('|' / '=') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{152, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position860, tokenIndex860 := position, tokenIndex
+			{
+				position861 := position
+				if buffer[position] != rune('|') {
+					goto l860
+				}
+				position++
+				{
+					position862, tokenIndex862 := position, tokenIndex
+					{
+						position863, tokenIndex863 := position, tokenIndex
+						if buffer[position] != rune('|') {
+							goto l864
+						}
+						position++
+						goto l863
+					l864:
+						position, tokenIndex = position863, tokenIndex863
+						if buffer[position] != rune('=') {
+							goto l862
+						}
+						position++
+					}
+				l863:
+					goto l860
+				l862:
+					position, tokenIndex = position862, tokenIndex862
+				}
+				if !_rules[ruleSpacing]() {
+					goto l860
+				}
+				add(ruleOR, position861)
+			}
+			memoize(152, position860, tokenIndex860, true)
+			return true
+		l860:
+			memoize(152, position860, tokenIndex860, false)
+			position, tokenIndex = position860, tokenIndex860
+			return false
+		},
+		/* 153 XOR <- <('^' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{153, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position865, tokenIndex865 := position, tokenIndex
+			{
+				position866 := position
+				if buffer[position] != rune('^') {
+					goto l865
+				}
+				position++
+				{
+					position867, tokenIndex867 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l867
+					}
+					position++
+					goto l865
+				l867:
+					position, tokenIndex = position867, tokenIndex867
+				}
+				if !_rules[ruleSpacing]() {
+					goto l865
+				}
+				add(ruleXOR, position866)
+			}
+			memoize(153, position865, tokenIndex865, true)
+			return true
+		l865:
+			memoize(153, position865, tokenIndex865, false)
+			position, tokenIndex = position865, tokenIndex865
+			return false
+		},
+		/* 154 MUL <- <('*' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{154, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position868, tokenIndex868 := position, tokenIndex
+			{
+				position869 := position
+				if buffer[position] != rune('*') {
+					goto l868
+				}
+				position++
+				{
+					position870, tokenIndex870 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l870
+					}
+					position++
+					goto l868
+				l870:
+					position, tokenIndex = position870, tokenIndex870
+				}
+				if !_rules[ruleSpacing]() {
+					goto l868
+				}
+				add(ruleMUL, position869)
+			}
+			memoize(154, position868, tokenIndex868, true)
+			return true
+		l868:
+			memoize(154, position868, tokenIndex868, false)
+			position, tokenIndex = position868, tokenIndex868
+			return false
+		},
+		/* 155 QUO <- <('/' !((&('*') '*') | (&('=') '=') | (&('/') %fail('*' '=') '/')) Spacing)> */
+		nil,
+		/* 156 REM <- <('%' !'=' Spacing)> */
+		nil,
+		/* 157 SHL <- <('<' '<' !'=' Spacing)> */
+		nil,
+		/* 158 SHR <- <('>' '>' !'=' Spacing)> */
+		nil,
+		/* 159 ANDNOT <- <('&' '^' !'=' Spacing)> */
+		nil,
+		/* 160 AND <- <('&' !((&('=') '=') | (&('^') '^') | (&('&') %fail('=' '^') '&')) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{160, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position876, tokenIndex876 := position, tokenIndex
+			{
+				position877 := position
+				if buffer[position] != rune('&') {
+					goto l876
+				}
+				position++
+				{
+					position878, tokenIndex878 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '=':
+							position++
+						case '^':
+							position++
+						default:
+							if buffer[position] != rune('&') {
+								goto l878
+							}
+							position++
+						}
+					}
+
+					goto l876
+				l878:
+					position, tokenIndex = position878, tokenIndex878
+				}
+				if !_rules[ruleSpacing]() {
+					goto l876
+				}
+				add(ruleAND, position877)
+			}
+			memoize(160, position876, tokenIndex876, true)
+			return true
+		l876:
+			memoize(160, position876, tokenIndex876, false)
+			position, tokenIndex = position876, tokenIndex876
+			return false
+		},
+		/* 161 NOT <- <('!' !'=' Spacing)> */
+		nil,
+		/* 162 ADDASSIGN <- <('+' '=' Spacing)> */
+		nil,
+		/* 163 SUBASSIGN <- <('-' '=' Spacing)> */
+		nil,
+		/* 164 MULASSIGN <- <('*' '=' Spacing)> */
+		nil,
+		/* 165 QUOASSIGN <- <('/' '=' Spacing)> */
+		nil,
+		/* 166 REMASSIGN <- <('%' '=' Spacing)> */
+		nil,
+		/* 167 ANDASSIGN <- <('&' '=' Spacing)> */
+		nil,
+		/* 168 ORASSIGN <- <('|' '=' Spacing)> */
+		nil,
+		/* 169 XORASSIGN <- <('^' '=' Spacing)> */
+		nil,
+		/* 170 SHLASSIGN <- <('<' '<' '=' Spacing)> */
+		nil,
+		/* 171 SHRASSIGN <- <('>' '>' '=' Spacing)> */
+		nil,
+		/* 172 ANDNOTASSIGN <- <('&' '^' '=' Spacing)> */
+		nil,
+	}
+	if p.maxDepth > 0 {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule := rule
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package golang
+
+import (
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestGo(t *testing.T) {
+	sources := []string{
+		"package p",
+		"package p; import \"fmt\"; func main() { fmt.Println(\"a\"); }",
+		`package p
+
+import (
+	"fmt"
+	m "math" // comment
+)
+
+const (
+	A = iota
+	B
+	C, D int = 1.5e3, 0x_1F
+)
+
+type (
+	T[K comparable, V any] struct {
+		m map[K]V ` + "`json:\"m\"`" + `
+		*List[V]
+		fmt.Stringer
+		a, b []*[4]chan<- func(int, ...string) (x int, err error)
+	}
+	Number interface {
+		~int | ~float64
+		String() string
+	}
+	A = T[string, int]
+	N [4]int
+)
+
+func (t *T[K, V]) Get(k K) (v V, ok bool) {
+	v, ok = t.m[k]
+	return
+}
+
+func main() {
+	x := []int{1, 2, 3}
+	for i, v := range x {
+		if v > 1 && x[i:] != nil {
+			continue
+		}
+	}
+	if p := (Point{1, 2}); p.X == 1 {
+		fmt.Println(p, m.Pi, 'x', '\'', "\"", ` + "`raw\nstring`" + `)
+	}
+	for _, p := range []Point{{1, 2}} {
+		_ = p
+	}
+	switch y := interface{}(x).(type) {
+	case nil, []int:
+		fallthrough
+	default:
+		_ = y
+	}
+	ch := make(chan int, 1)
+	select {
+	case v, ok := <-ch:
+		_, _ = v, ok
+	case ch <- 1:
+	default:
+	}
+	go func() { defer close(ch) }()
+label:
+	for i := 0; i < 10; i++ {
+		break label
+	}
+	var f = Map[int, string]
+	f(x...)
+	/* multi
+	line */
+}
+`,
+	}
+	for _, source := range sources {
+		g := &Go{Buffer: source}
+		g.Init()
+		if err := g.Parse(); err != nil {
+			t.Errorf("%s: %v", source, err)
+		}
+	}
+}
+
+func TestGoInvalid(t *testing.T) {
+	sources := []string{
+		"package p; func main() { if x == T{} {} }",
+		"package p; func main() { a := 1 b := 2 }",
+		"package p; func main() {\n\tf(a\n\t)\n}",
+		"package p; func main() { x := 08 }",
+		"package p; var type int",
+	}
+	for _, source := range sources {
+		g := &Go{Buffer: source}
+		g.Init()
+		if err := g.Parse(); err == nil {
+			t.Errorf("%s: parsed", source)
+		}
+	}
+}
+
+// sources returns the Go files in root accepted by go/parser, which the
+// grammar must parse too.
+func sources(tb testing.TB, root string) map[string]string {
+	files := make(map[string]string)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && entry.Name() == "testdata" {
+			return filepath.SkipDir
+		}
+		if entry.IsDir() || !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if _, err := parser.ParseFile(token.NewFileSet(), path, source, parser.SkipObjectResolution); err == nil {
+			files[path] = string(source)
+		}
+		return nil
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return files
+}
+
+func testSources(t *testing.T, root string) {
+	g := &Go{}
+	g.Init()
+	for path, source := range sources(t, root) {
+		g.Buffer = source
+		g.Reset()
+		if err := g.Parse(); err != nil {
+			t.Errorf("%v: %v", path, err)
+		}
+	}
+}
+
+func TestGoRepository(t *testing.T) {
+	testSources(t, "../..")
+}
+
+func TestGoStandardLibrary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping parsing the standard library")
+	}
+	testSources(t, filepath.Join(runtime.GOROOT(), "src"))
+}
+
+func BenchmarkGo(b *testing.B) {
+	files := sources(b, "../..")
+	g := &Go{}
+	g.Init()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, source := range files {
+			g.Buffer = source
+			g.Reset()
+			if err := g.Parse(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkGoParser(b *testing.B) {
+	files := sources(b, "../..")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for path, source := range files {
+			if _, err := parser.ParseFile(token.NewFileSet(), path, source, parser.SkipObjectResolution); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run github.com/pointlander/peg -switch -inline -expected java.peg
+
+// Package java parses Java 17 source files with the parser generated from
+// java.peg.
+package java
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keywords are the reserved words of Java, which are not identifiers.
+var keywords = map[string]bool{
+	"abstract":     true,
+	"assert":       true,
+	"boolean":      true,
+	"break":        true,
+	"byte":         true,
+	"case":         true,
+	"catch":        true,
+	"char":         true,
+	"class":        true,
+	"const":        true,
+	"continue":     true,
+	"default":      true,
+	"do":           true,
+	"double":       true,
+	"else":         true,
+	"enum":         true,
+	"extends":      true,
+	"false":        true,
+	"final":        true,
+	"finally":      true,
+	"float":        true,
+	"for":          true,
+	"goto":         true,
+	"if":           true,
+	"implements":   true,
+	"import":       true,
+	"instanceof":   true,
+	"int":          true,
+	"interface":    true,
+	"long":         true,
+	"native":       true,
+	"new":          true,
+	"null":         true,
+	"package":      true,
+	"private":      true,
+	"protected":    true,
+	"public":       true,
+	"return":       true,
+	"short":        true,
+	"static":       true,
+	"strictfp":     true,
+	"super":        true,
+	"switch":       true,
+	"synchronized": true,
+	"this":         true,
+	"throw":        true,
+	"throws":       true,
+	"transient":    true,
+	"true":         true,
+	"try":          true,
+	"void":         true,
+	"volatile":     true,
+	"while":        true,
+}
+
+// Errors returns an error for every malformed member of a class body that was
+// skipped.
+func (j *Java) Errors() []error {
+	var errors []error
+	for _, token := range j.Tokens() {
+		if token.pegRule == ruleInvalidMember {
+			errors = append(errors, fmt.Errorf("invalid member %q at offset %v",
+				strings.TrimSpace(string(j.buffer[token.begin:token.end])), token.begin))
+		}
+	}
+	return errors
+}
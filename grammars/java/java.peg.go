@@ -0,0 +1,17401 @@
+// Code generated by peg -switch -inline -expected java.peg. DO NOT EDIT.
+
+//===========================================================================
+//
+//  Parsing Expression Grammar for Java 17, extending the grammar
+//  for Java 1.7 for Mouse 1.1 - 1.5.
+//  Based on Chapters 3 and 18 of Java Language Specification, Third Edition,
+//  at http://java.sun.com/docs/books/jls/third_edition/html/j3TOC.html,
+//  and description of Java SE 7 enhancements in
+//  http://download.java.net/jdk7/docs/technotes/guides/language/enhancements.html.
+//
+//---------------------------------------------------------------------------
+//
+//  Copyright (C) 2006, 2009, 2010, 2011
+//  by Roman R Redziejowski(www.romanredz.se).
+//
+//  The author gives unlimited permission to copy and distribute
+//  this file, with or without modifications, as long as this notice
+//  is preserved, and any changes are properly documented.
+//
+//  This file is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of
+//  MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.
+//
+//---------------------------------------------------------------------------
+//
+//  Latest update 2011-07-21
+//
+//---------------------------------------------------------------------------
+//
+//  Change log
+//    2006-12-06 Posted on Internet.
+//    2009-04-04 Modified to conform to Mouse syntax:
+//               Underscore removed from names
+//               \f in Space replaced by Unicode for FormFeed.
+//    2009-07-10 Unused rule THREADSAFE removed.
+//    2009-07-10 Copying and distribution conditions relaxed by the author.
+//    2010-07-01 Updated Mouse version in the comment.
+//    2010-09-15 Updated comment on Java release.
+//    2010-09-18 Updated list of reserved words ("keywords") according to
+//               JLS 3.9: added "const" and "goto", removed "threadsafe".
+//    2010-09-18 Removed superfluous "?" everywhere after "Spacing".
+//    2010-10-05 Removed erroneous "TypeArguments?" from "EnumConstant".
+//               See JLS 8.9, JLS 18.1.
+//               NB. "Annotations" are optional, but not shown as such in JLS.
+//    2010-10-20 Corrected "FormalParameterList" according to JLS 8.4.1.
+//               NB. "VariableModifiers" in "FormalParameter" and "LastFormalParameter"
+//               are optional, but not shown as such in JLS.
+//    2010-10-20 Corrected "Annotation" according to JLS 9.7.
+//               Is incorrect in JLS 18.1 (does not allow list of value pairs).
+//    2010-10-20 Corrected "LocalVariableDeclarationStatement".
+//               Is incorrect in JLS 18.1: only FINAL allowed as "VariableModifier".
+//               Is incorrect in JLS 14.4: "VariableModifiers" not shown as optional.
+//    2010-10-20 Corrected "AnnotationTypeElementRest": added SEMI as last alternative.
+//               See JLS 9.6. NB. Missing in JLS 18.1.
+//    2010-10-20 Moved "Identifier" from "AnnotationTypeElementRest" to
+//               "AnnotationMethodRest". Was incorrect in JLS 18.1.
+//    2010-10-21 Inverted order of alternatives in "HexSignificand".
+//    2010-10-24 Corrected previous correction: moved SEMI from
+//               "AnnotationTypeElementRest" to "AnnotationTypeElementDeclaration".
+//    2010-10-25 Repeated "u" allowed in UnicodeEscape (JLS 3.3).
+//               Line terminators not allowed in StringLiteral (JLS 3.10.5).
+//               (Found thanks to Java PEG for Parboiled, which in turn credits
+//               Reinier Zwitserloot for finding it.)
+//    2011-07-19 Added SEMI after "VariableDeclarators" in "MemberDecl" (JLS 8.3).
+//    2011-07-21 Corrected "ArrayInitializer" to allow for "{,}" (JLS 10.6).
+//
+//---------------------------------------------------------------------------
+//
+//  Changes for Java 1.7
+//    2011-07-18 Implemented Binary Literals: added "BinaryNumeral".
+//    2011-07-19 Implemented Underscores in Numerical Literals:
+//               Added "Digits" and "HexDigits". Removed "Digit".
+//               Modified "DecimalNumeral", "HexNumeral", "BinaryNumeral",
+//               "OctalNumeral", "DecimalFloat", "Exponent",
+//               "HexSignificand", and "BinaryExponent".
+//    2011-07-20 Implemented Type Inference for Generic Instance Creation:
+//               Added "Diamond".
+//               Modified "ClassCreatorRest" by adding "Diamond?".
+//    2011-07-20 Implemented try-with-resources Statement:
+//               Added try-with-resources as an alternative of "Statement".
+//               Added "Resource". (Based on comments to JavacParser).
+//    2011-07-20 Implemented catching of multiple exceptions:
+//               Modified "Catch" to allow multiple exception types.
+//               Based on a pure guess.
+//
+//---------------------------------------------------------------------------
+//
+//    2013-02-16 Modified to work with github.com/pointlander/peg
+//
+//---------------------------------------------------------------------------
+//
+//  Changes for Java 17
+//    Reserved words are looked up in the table keywords with %in, and the
+//    restricted identifiers "record", "yield", "sealed", "non-sealed" and
+//    "permits" are matched with %keyword only where they are keywords,
+//    so that they remain valid identifiers elsewhere. "var" is a Type.
+//    Implemented lambda expressions and method references (Java 8):
+//               Added "LambdaExpression", "LambdaParameters",
+//               "MethodReference" and "COLONCOLON" and the alternatives
+//               of "Expression", "ConditionalExpression", "UnaryExpression",
+//               "Selector" and "SuperSuffix".
+//    Implemented default, static and private interface methods (Java 8, 9):
+//               Method bodies in "InterfaceMethodDeclaratorRest" and
+//               "VoidInterfaceMethodDeclaratorRest", "default" Modifier.
+//    Implemented resources that are variables (Java 9) in "Resource".
+//    Implemented switch expressions and rules (Java 14):
+//               Added "SwitchBody", "SwitchRule" and "YIELD".
+//               Multiple constants in "SwitchLabel".
+//    Implemented text blocks (Java 15): added "TextBlock", \s escape.
+//    Implemented records and local classes and interfaces (Java 16):
+//               Added "RecordDeclaration", "RecordHeader",
+//               "RecordComponent", "RecordBody" and "CompactConstructor".
+//    Implemented pattern matching for instanceof (Java 16)
+//               in "RelationalExpression".
+//    Implemented sealed classes (Java 17): "sealed" and "non-sealed"
+//               Modifiers, "PERMITS" clauses.
+//    A malformed member of a class body is skipped up to the next ";"
+//    or balanced block by "InvalidMember", so that the rest is parsed.
+//
+//===========================================================================
+
+//-------------------------------------------------------------------------
+//  Compilation Unit
+//-------------------------------------------------------------------------
+
+package java
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint16
+
+const (
+	ruleUnknown pegRule = iota
+	ruleCompilationUnit
+	rulePackageDeclaration
+	ruleImportDeclaration
+	ruleTypeDeclaration
+	ruleClassDeclaration
+	ruleClassBody
+	ruleClassBodyDeclaration
+	ruleInvalidMember
+	ruleBalanced
+	ruleMemberDecl
+	ruleGenericMethodOrConstructorRest
+	ruleMethodDeclaratorRest
+	ruleVoidMethodDeclaratorRest
+	ruleConstructorDeclaratorRest
+	ruleMethodBody
+	ruleInterfaceDeclaration
+	ruleInterfaceBody
+	ruleInterfaceBodyDeclaration
+	ruleInterfaceMemberDecl
+	ruleInterfaceMethodOrFieldDecl
+	ruleInterfaceMethodOrFieldRest
+	ruleInterfaceMethodDeclaratorRest
+	ruleInterfaceGenericMethodDecl
+	ruleVoidInterfaceMethodDeclaratorRest
+	ruleConstantDeclaratorsRest
+	ruleConstantDeclarator
+	ruleConstantDeclaratorRest
+	ruleEnumDeclaration
+	ruleEnumBody
+	ruleEnumConstants
+	ruleEnumConstant
+	ruleEnumBodyDeclarations
+	ruleRecordDeclaration
+	ruleRecordHeader
+	ruleRecordComponent
+	ruleRecordBody
+	ruleCompactConstructor
+	ruleLocalVariableDeclarationStatement
+	ruleVariableDeclarators
+	ruleVariableDeclarator
+	ruleFormalParameters
+	ruleFormalParameter
+	ruleLastFormalParameter
+	ruleFormalParameterList
+	ruleVariableDeclaratorId
+	ruleBlock
+	ruleBlockStatements
+	ruleBlockStatement
+	ruleStatement
+	ruleResource
+	ruleCatch
+	ruleFinally
+	ruleSwitchBody
+	ruleSwitchRule
+	ruleSwitchBlockStatementGroup
+	ruleSwitchLabel
+	ruleForInit
+	ruleForUpdate
+	ruleStatementExpression
+	ruleExpression
+	ruleAssignmentOperator
+	ruleConditionalExpression
+	ruleLambdaExpression
+	ruleLambdaParameters
+	ruleConditionalOrExpression
+	ruleConditionalAndExpression
+	ruleInclusiveOrExpression
+	ruleExclusiveOrExpression
+	ruleAndExpression
+	ruleEqualityExpression
+	ruleRelationalExpression
+	ruleShiftExpression
+	ruleAdditiveExpression
+	ruleMultiplicativeExpression
+	ruleUnaryExpression
+	rulePrimary
+	ruleMethodReference
+	ruleIdentifierSuffix
+	ruleExplicitGenericInvocation
+	ruleNonWildcardTypeArguments
+	ruleExplicitGenericInvocationSuffix
+	rulePrefixOp
+	rulePostfixOp
+	ruleSelector
+	ruleSuperSuffix
+	ruleBasicType
+	ruleArguments
+	ruleCreator
+	ruleCreatedName
+	ruleInnerCreator
+	ruleArrayCreatorRest
+	ruleClassCreatorRest
+	ruleDiamond
+	ruleArrayInitializer
+	ruleVariableInitializer
+	ruleParExpression
+	ruleQualifiedIdentifier
+	ruleDim
+	ruleDimExpr
+	ruleType
+	ruleReferenceType
+	ruleClassType
+	ruleClassTypeList
+	ruleTypeArguments
+	ruleTypeArgument
+	ruleTypeParameters
+	ruleTypeParameter
+	ruleBound
+	ruleModifier
+	ruleAnnotationTypeDeclaration
+	ruleAnnotationTypeBody
+	ruleAnnotationTypeElementDeclaration
+	ruleAnnotationTypeElementRest
+	ruleAnnotationMethodOrConstantRest
+	ruleAnnotationMethodRest
+	ruleAnnotationConstantRest
+	ruleDefaultValue
+	ruleAnnotation
+	ruleNormalAnnotation
+	ruleSingleElementAnnotation
+	ruleMarkerAnnotation
+	ruleElementValuePairs
+	ruleElementValuePair
+	ruleElementValue
+	ruleElementValueArrayInitializer
+	ruleElementValues
+	ruleSpacing
+	ruleIdentifier
+	ruleLetter
+	ruleLetterOrDigit
+	ruleASSERT
+	ruleBREAK
+	ruleCASE
+	ruleCATCH
+	ruleCLASS
+	ruleCONTINUE
+	ruleDEFAULT
+	ruleDO
+	ruleELSE
+	ruleENUM
+	ruleEXTENDS
+	ruleFINALLY
+	ruleFINAL
+	ruleFOR
+	ruleIF
+	ruleIMPLEMENTS
+	ruleIMPORT
+	ruleINTERFACE
+	ruleINSTANCEOF
+	ruleNEW
+	rulePACKAGE
+	ruleRETURN
+	ruleSTATIC
+	ruleSUPER
+	ruleSWITCH
+	ruleSYNCHRONIZED
+	ruleTHIS
+	ruleTHROWS
+	ruleTHROW
+	ruleTRY
+	ruleVOID
+	ruleWHILE
+	rulePERMITS
+	ruleRECORD
+	ruleYIELD
+	ruleLiteral
+	ruleIntegerLiteral
+	ruleDecimalNumeral
+	ruleHexNumeral
+	ruleBinaryNumeral
+	ruleOctalNumeral
+	ruleFloatLiteral
+	ruleDecimalFloat
+	ruleExponent
+	ruleHexFloat
+	ruleHexSignificand
+	ruleBinaryExponent
+	ruleDigits
+	ruleHexDigits
+	ruleHexDigit
+	ruleCharLiteral
+	ruleStringLiteral
+	ruleTextBlock
+	ruleEscape
+	ruleOctalEscape
+	ruleUnicodeEscape
+	ruleAT
+	ruleAND
+	ruleANDAND
+	ruleANDEQU
+	ruleBANG
+	ruleBSR
+	ruleBSREQU
+	ruleARROW
+	ruleCOLON
+	ruleCOLONCOLON
+	ruleCOMMA
+	ruleDEC
+	ruleDIV
+	ruleDIVEQU
+	ruleDOT
+	ruleELLIPSIS
+	ruleEQU
+	ruleEQUAL
+	ruleGE
+	ruleGT
+	ruleHAT
+	ruleHATEQU
+	ruleINC
+	ruleLBRK
+	ruleLE
+	ruleLPAR
+	ruleLPOINT
+	ruleLT
+	ruleLWING
+	ruleMINUS
+	ruleMINUSEQU
+	ruleMOD
+	ruleMODEQU
+	ruleNOTEQUAL
+	ruleOR
+	ruleOREQU
+	ruleOROR
+	rulePLUS
+	rulePLUSEQU
+	ruleQUERY
+	ruleRBRK
+	ruleRPAR
+	ruleRPOINT
+	ruleRWING
+	ruleSEMI
+	ruleSL
+	ruleSLEQU
+	ruleSR
+	ruleSREQU
+	ruleSTAR
+	ruleSTAREQU
+	ruleTILDA
+	ruleEOT
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"CompilationUnit",
+	"PackageDeclaration",
+	"ImportDeclaration",
+	"TypeDeclaration",
+	"ClassDeclaration",
+	"ClassBody",
+	"ClassBodyDeclaration",
+	"InvalidMember",
+	"Balanced",
+	"MemberDecl",
+	"GenericMethodOrConstructorRest",
+	"MethodDeclaratorRest",
+	"VoidMethodDeclaratorRest",
+	"ConstructorDeclaratorRest",
+	"MethodBody",
+	"InterfaceDeclaration",
+	"InterfaceBody",
+	"InterfaceBodyDeclaration",
+	"InterfaceMemberDecl",
+	"InterfaceMethodOrFieldDecl",
+	"InterfaceMethodOrFieldRest",
+	"InterfaceMethodDeclaratorRest",
+	"InterfaceGenericMethodDecl",
+	"VoidInterfaceMethodDeclaratorRest",
+	"ConstantDeclaratorsRest",
+	"ConstantDeclarator",
+	"ConstantDeclaratorRest",
+	"EnumDeclaration",
+	"EnumBody",
+	"EnumConstants",
+	"EnumConstant",
+	"EnumBodyDeclarations",
+	"RecordDeclaration",
+	"RecordHeader",
+	"RecordComponent",
+	"RecordBody",
+	"CompactConstructor",
+	"LocalVariableDeclarationStatement",
+	"VariableDeclarators",
+	"VariableDeclarator",
+	"FormalParameters",
+	"FormalParameter",
+	"LastFormalParameter",
+	"FormalParameterList",
+	"VariableDeclaratorId",
+	"Block",
+	"BlockStatements",
+	"BlockStatement",
+	"Statement",
+	"Resource",
+	"Catch",
+	"Finally",
+	"SwitchBody",
+	"SwitchRule",
+	"SwitchBlockStatementGroup",
+	"SwitchLabel",
+	"ForInit",
+	"ForUpdate",
+	"StatementExpression",
+	"Expression",
+	"AssignmentOperator",
+	"ConditionalExpression",
+	"LambdaExpression",
+	"LambdaParameters",
+	"ConditionalOrExpression",
+	"ConditionalAndExpression",
+	"InclusiveOrExpression",
+	"ExclusiveOrExpression",
+	"AndExpression",
+	"EqualityExpression",
+	"RelationalExpression",
+	"ShiftExpression",
+	"AdditiveExpression",
+	"MultiplicativeExpression",
+	"UnaryExpression",
+	"Primary",
+	"MethodReference",
+	"IdentifierSuffix",
+	"ExplicitGenericInvocation",
+	"NonWildcardTypeArguments",
+	"ExplicitGenericInvocationSuffix",
+	"PrefixOp",
+	"PostfixOp",
+	"Selector",
+	"SuperSuffix",
+	"BasicType",
+	"Arguments",
+	"Creator",
+	"CreatedName",
+	"InnerCreator",
+	"ArrayCreatorRest",
+	"ClassCreatorRest",
+	"Diamond",
+	"ArrayInitializer",
+	"VariableInitializer",
+	"ParExpression",
+	"QualifiedIdentifier",
+	"Dim",
+	"DimExpr",
+	"Type",
+	"ReferenceType",
+	"ClassType",
+	"ClassTypeList",
+	"TypeArguments",
+	"TypeArgument",
+	"TypeParameters",
+	"TypeParameter",
+	"Bound",
+	"Modifier",
+	"AnnotationTypeDeclaration",
+	"AnnotationTypeBody",
+	"AnnotationTypeElementDeclaration",
+	"AnnotationTypeElementRest",
+	"AnnotationMethodOrConstantRest",
+	"AnnotationMethodRest",
+	"AnnotationConstantRest",
+	"DefaultValue",
+	"Annotation",
+	"NormalAnnotation",
+	"SingleElementAnnotation",
+	"MarkerAnnotation",
+	"ElementValuePairs",
+	"ElementValuePair",
+	"ElementValue",
+	"ElementValueArrayInitializer",
+	"ElementValues",
+	"Spacing",
+	"Identifier",
+	"Letter",
+	"LetterOrDigit",
+	"ASSERT",
+	"BREAK",
+	"CASE",
+	"CATCH",
+	"CLASS",
+	"CONTINUE",
+	"DEFAULT",
+	"DO",
+	"ELSE",
+	"ENUM",
+	"EXTENDS",
+	"FINALLY",
+	"FINAL",
+	"FOR",
+	"IF",
+	"IMPLEMENTS",
+	"IMPORT",
+	"INTERFACE",
+	"INSTANCEOF",
+	"NEW",
+	"PACKAGE",
+	"RETURN",
+	"STATIC",
+	"SUPER",
+	"SWITCH",
+	"SYNCHRONIZED",
+	"THIS",
+	"THROWS",
+	"THROW",
+	"TRY",
+	"VOID",
+	"WHILE",
+	"PERMITS",
+	"RECORD",
+	"YIELD",
+	"Literal",
+	"IntegerLiteral",
+	"DecimalNumeral",
+	"HexNumeral",
+	"BinaryNumeral",
+	"OctalNumeral",
+	"FloatLiteral",
+	"DecimalFloat",
+	"Exponent",
+	"HexFloat",
+	"HexSignificand",
+	"BinaryExponent",
+	"Digits",
+	"HexDigits",
+	"HexDigit",
+	"CharLiteral",
+	"StringLiteral",
+	"TextBlock",
+	"Escape",
+	"OctalEscape",
+	"UnicodeEscape",
+	"AT",
+	"AND",
+	"ANDAND",
+	"ANDEQU",
+	"BANG",
+	"BSR",
+	"BSREQU",
+	"ARROW",
+	"COLON",
+	"COLONCOLON",
+	"COMMA",
+	"DEC",
+	"DIV",
+	"DIVEQU",
+	"DOT",
+	"ELLIPSIS",
+	"EQU",
+	"EQUAL",
+	"GE",
+	"GT",
+	"HAT",
+	"HATEQU",
+	"INC",
+	"LBRK",
+	"LE",
+	"LPAR",
+	"LPOINT",
+	"LT",
+	"LWING",
+	"MINUS",
+	"MINUSEQU",
+	"MOD",
+	"MODEQU",
+	"NOTEQUAL",
+	"OR",
+	"OREQU",
+	"OROR",
+	"PLUS",
+	"PLUSEQU",
+	"QUERY",
+	"RBRK",
+	"RPAR",
+	"RPOINT",
+	"RWING",
+	"SEMI",
+	"SL",
+	"SLEQU",
+	"SR",
+	"SREQU",
+	"STAR",
+	"STAREQU",
+	"TILDA",
+	"EOT",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"CompilationUnit", "java.peg", 133, 133, false, false},
+	{"PackageDeclaration", "java.peg", 134, 134, false, true},
+	{"ImportDeclaration", "java.peg", 135, 135, false, true},
+	{"TypeDeclaration", "java.peg", 137, 142, false, true},
+	{"ClassDeclaration", "java.peg", 148, 148, false, false},
+	{"ClassBody", "java.peg", 150, 150, false, false},
+	{"ClassBodyDeclaration", "java.peg", 152, 156, false, false},
+	{"InvalidMember", "java.peg", 158, 160, false, true},
+	{"Balanced", "java.peg", 162, 162, false, false},
+	{"MemberDecl", "java.peg", 164, 174, false, true},
+	{"GenericMethodOrConstructorRest", "java.peg", 176, 178, false, true},
+	{"MethodDeclaratorRest", "java.peg", 180, 181, false, false},
+	{"VoidMethodDeclaratorRest", "java.peg", 183, 184, false, true},
+	{"ConstructorDeclaratorRest", "java.peg", 186, 187, false, false},
+	{"MethodBody", "java.peg", 189, 190, false, false},
+	{"InterfaceDeclaration", "java.peg", 196, 197, false, false},
+	{"InterfaceBody", "java.peg", 199, 200, false, true},
+	{"InterfaceBodyDeclaration", "java.peg", 202, 204, false, true},
+	{"InterfaceMemberDecl", "java.peg", 206, 214, false, true},
+	{"InterfaceMethodOrFieldDecl", "java.peg", 216, 217, false, false},
+	{"InterfaceMethodOrFieldRest", "java.peg", 219, 221, false, true},
+	{"InterfaceMethodDeclaratorRest", "java.peg", 223, 224, false, false},
+	{"InterfaceGenericMethodDecl", "java.peg", 226, 227, false, true},
+	{"VoidInterfaceMethodDeclaratorRest", "java.peg", 229, 230, false, true},
+	{"ConstantDeclaratorsRest", "java.peg", 232, 233, false, true},
+	{"ConstantDeclarator", "java.peg", 235, 236, false, true},
+	{"ConstantDeclaratorRest", "java.peg", 238, 239, false, false},
+	{"EnumDeclaration", "java.peg", 245, 246, false, false},
+	{"EnumBody", "java.peg", 248, 249, false, true},
+	{"EnumConstants", "java.peg", 251, 252, false, true},
+	{"EnumConstant", "java.peg", 254, 255, false, false},
+	{"EnumBodyDeclarations", "java.peg", 257, 258, false, true},
+	{"RecordDeclaration", "java.peg", 264, 265, false, false},
+	{"RecordHeader", "java.peg", 267, 268, false, true},
+	{"RecordComponent", "java.peg", 270, 271, false, false},
+	{"RecordBody", "java.peg", 273, 274, false, true},
+	{"CompactConstructor", "java.peg", 276, 277, false, true},
+	{"LocalVariableDeclarationStatement", "java.peg", 283, 284, false, true},
+	{"VariableDeclarators", "java.peg", 286, 287, false, false},
+	{"VariableDeclarator", "java.peg", 289, 290, false, false},
+	{"FormalParameters", "java.peg", 296, 297, false, false},
+	{"FormalParameter", "java.peg", 299, 300, false, false},
+	{"LastFormalParameter", "java.peg", 302, 303, false, false},
+	{"FormalParameterList", "java.peg", 305, 307, false, false},
+	{"VariableDeclaratorId", "java.peg", 309, 310, false, false},
+	{"Block", "java.peg", 316, 317, false, false},
+	{"BlockStatements", "java.peg", 319, 320, false, false},
+	{"BlockStatement", "java.peg", 322, 330, false, true},
+	{"Statement", "java.peg", 332, 351, false, false},
+	{"Resource", "java.peg", 353, 355, false, false},
+	{"Catch", "java.peg", 357, 358, false, false},
+	{"Finally", "java.peg", 360, 361, false, false},
+	{"SwitchBody", "java.peg", 363, 364, false, false},
+	{"SwitchRule", "java.peg", 366, 367, false, true},
+	{"SwitchBlockStatementGroup", "java.peg", 369, 370, false, true},
+	{"SwitchLabel", "java.peg", 375, 377, false, false},
+	{"ForInit", "java.peg", 379, 381, false, true},
+	{"ForUpdate", "java.peg", 383, 384, false, true},
+	{"StatementExpression", "java.peg", 390, 391, false, false},
+	{"Expression", "java.peg", 397, 399, false, false},
+	{"AssignmentOperator", "java.peg", 409, 421, false, true},
+	{"ConditionalExpression", "java.peg", 423, 424, false, false},
+	{"LambdaExpression", "java.peg", 426, 427, false, false},
+	{"LambdaParameters", "java.peg", 429, 431, false, true},
+	{"ConditionalOrExpression", "java.peg", 433, 434, false, false},
+	{"ConditionalAndExpression", "java.peg", 436, 437, false, false},
+	{"InclusiveOrExpression", "java.peg", 439, 440, false, false},
+	{"ExclusiveOrExpression", "java.peg", 442, 443, false, false},
+	{"AndExpression", "java.peg", 445, 446, false, false},
+	{"EqualityExpression", "java.peg", 448, 449, false, false},
+	{"RelationalExpression", "java.peg", 451, 452, false, false},
+	{"ShiftExpression", "java.peg", 454, 455, false, false},
+	{"AdditiveExpression", "java.peg", 457, 458, false, false},
+	{"MultiplicativeExpression", "java.peg", 460, 461, false, false},
+	{"UnaryExpression", "java.peg", 463, 466, false, false},
+	{"Primary", "java.peg", 468, 479, false, false},
+	{"MethodReference", "java.peg", 481, 482, false, false},
+	{"IdentifierSuffix", "java.peg", 484, 493, false, false},
+	{"ExplicitGenericInvocation", "java.peg", 495, 496, false, false},
+	{"NonWildcardTypeArguments", "java.peg", 498, 499, false, false},
+	{"ExplicitGenericInvocationSuffix", "java.peg", 501, 503, false, false},
+	{"PrefixOp", "java.peg", 505, 511, false, true},
+	{"PostfixOp", "java.peg", 513, 515, false, false},
+	{"Selector", "java.peg", 517, 524, false, false},
+	{"SuperSuffix", "java.peg", 526, 529, false, false},
+	{"BasicType", "java.peg", 531, 540, false, false},
+	{"Arguments", "java.peg", 542, 543, false, false},
+	{"Creator", "java.peg", 545, 547, false, true},
+	{"CreatedName", "java.peg", 549, 550, false, true},
+	{"InnerCreator", "java.peg", 552, 553, false, false},
+	{"ArrayCreatorRest", "java.peg", 555, 556, false, true},
+	{"ClassCreatorRest", "java.peg", 562, 563, false, false},
+	{"Diamond", "java.peg", 565, 566, false, true},
+	{"ArrayInitializer", "java.peg", 568, 569, false, false},
+	{"VariableInitializer", "java.peg", 571, 573, false, false},
+	{"ParExpression", "java.peg", 575, 576, false, false},
+	{"QualifiedIdentifier", "java.peg", 578, 579, false, false},
+	{"Dim", "java.peg", 581, 582, false, false},
+	{"DimExpr", "java.peg", 584, 585, false, false},
+	{"Type", "java.peg", 591, 592, false, false},
+	{"ReferenceType", "java.peg", 594, 596, false, false},
+	{"ClassType", "java.peg", 598, 599, false, false},
+	{"ClassTypeList", "java.peg", 601, 602, false, false},
+	{"TypeArguments", "java.peg", 604, 605, false, false},
+	{"TypeArgument", "java.peg", 607, 609, false, false},
+	{"TypeParameters", "java.peg", 611, 612, false, false},
+	{"TypeParameter", "java.peg", 614, 615, false, false},
+	{"Bound", "java.peg", 617, 618, false, true},
+	{"Modifier", "java.peg", 620, 636, false, false},
+	{"AnnotationTypeDeclaration", "java.peg", 646, 647, false, false},
+	{"AnnotationTypeBody", "java.peg", 649, 650, false, true},
+	{"AnnotationTypeElementDeclaration", "java.peg", 652, 654, false, true},
+	{"AnnotationTypeElementRest", "java.peg", 656, 661, false, true},
+	{"AnnotationMethodOrConstantRest", "java.peg", 663, 665, false, false},
+	{"AnnotationMethodRest", "java.peg", 667, 668, false, true},
+	{"AnnotationConstantRest", "java.peg", 670, 671, false, true},
+	{"DefaultValue", "java.peg", 673, 674, false, true},
+	{"Annotation", "java.peg", 676, 679, false, false},
+	{"NormalAnnotation", "java.peg", 681, 682, false, true},
+	{"SingleElementAnnotation", "java.peg", 684, 685, false, true},
+	{"MarkerAnnotation", "java.peg", 687, 688, false, true},
+	{"ElementValuePairs", "java.peg", 690, 691, false, true},
+	{"ElementValuePair", "java.peg", 693, 694, false, false},
+	{"ElementValue", "java.peg", 696, 699, false, false},
+	{"ElementValueArrayInitializer", "java.peg", 701, 702, false, true},
+	{"ElementValues", "java.peg", 704, 705, false, true},
+	{"Spacing", "java.peg", 715, 719, true, false},
+	{"Identifier", "java.peg", 725, 725, false, false},
+	{"Letter", "java.peg", 727, 727, true, true},
+	{"LetterOrDigit", "java.peg", 729, 729, true, false},
+	{"ASSERT", "java.peg", 745, 745, false, true},
+	{"BREAK", "java.peg", 746, 746, false, true},
+	{"CASE", "java.peg", 747, 747, false, true},
+	{"CATCH", "java.peg", 748, 748, false, true},
+	{"CLASS", "java.peg", 749, 749, false, false},
+	{"CONTINUE", "java.peg", 750, 750, false, true},
+	{"DEFAULT", "java.peg", 751, 751, false, false},
+	{"DO", "java.peg", 752, 752, false, true},
+	{"ELSE", "java.peg", 753, 753, false, true},
+	{"ENUM", "java.peg", 754, 754, false, true},
+	{"EXTENDS", "java.peg", 755, 755, false, false},
+	{"FINALLY", "java.peg", 756, 756, false, true},
+	{"FINAL", "java.peg", 757, 757, false, false},
+	{"FOR", "java.peg", 758, 758, false, true},
+	{"IF", "java.peg", 759, 759, false, true},
+	{"IMPLEMENTS", "java.peg", 760, 760, false, false},
+	{"IMPORT", "java.peg", 761, 761, false, true},
+	{"INTERFACE", "java.peg", 762, 762, false, false},
+	{"INSTANCEOF", "java.peg", 763, 763, false, true},
+	{"NEW", "java.peg", 764, 764, false, false},
+	{"PACKAGE", "java.peg", 765, 765, false, true},
+	{"RETURN", "java.peg", 766, 766, false, true},
+	{"STATIC", "java.peg", 767, 767, false, false},
+	{"SUPER", "java.peg", 768, 768, false, false},
+	{"SWITCH", "java.peg", 769, 769, false, false},
+	{"SYNCHRONIZED", "java.peg", 770, 770, false, true},
+	{"THIS", "java.peg", 771, 771, false, false},
+	{"THROWS", "java.peg", 772, 772, false, false},
+	{"THROW", "java.peg", 773, 773, false, false},
+	{"TRY", "java.peg", 774, 774, false, true},
+	{"VOID", "java.peg", 775, 775, false, false},
+	{"WHILE", "java.peg", 776, 776, false, false},
+	{"PERMITS", "java.peg", 778, 778, false, false},
+	{"RECORD", "java.peg", 779, 779, false, true},
+	{"YIELD", "java.peg", 780, 780, false, true},
+	{"Literal", "java.peg", 786, 795, false, false},
+	{"IntegerLiteral", "java.peg", 797, 802, false, true},
+	{"DecimalNumeral", "java.peg", 804, 804, true, true},
+	{"HexNumeral", "java.peg", 806, 806, false, false},
+	{"BinaryNumeral", "java.peg", 808, 808, true, true},
+	{"OctalNumeral", "java.peg", 810, 810, true, true},
+	{"FloatLiteral", "java.peg", 812, 812, false, false},
+	{"DecimalFloat", "java.peg", 814, 818, false, true},
+	{"Exponent", "java.peg", 820, 820, false, false},
+	{"HexFloat", "java.peg", 822, 822, false, true},
+	{"HexSignificand", "java.peg", 824, 826, false, true},
+	{"BinaryExponent", "java.peg", 828, 828, false, true},
+	{"Digits", "java.peg", 830, 830, true, false},
+	{"HexDigits", "java.peg", 832, 832, false, false},
+	{"HexDigit", "java.peg", 834, 834, true, false},
+	{"CharLiteral", "java.peg", 836, 836, false, false},
+	{"StringLiteral", "java.peg", 838, 838, false, false},
+	{"TextBlock", "java.peg", 840, 840, false, false},
+	{"Escape", "java.peg", 842, 842, false, false},
+	{"OctalEscape", "java.peg", 844, 847, true, true},
+	{"UnicodeEscape", "java.peg", 849, 850, false, true},
+	{"AT", "java.peg", 856, 856, false, false},
+	{"AND", "java.peg", 857, 857, false, false},
+	{"ANDAND", "java.peg", 858, 858, false, true},
+	{"ANDEQU", "java.peg", 859, 859, false, true},
+	{"BANG", "java.peg", 860, 860, false, true},
+	{"BSR", "java.peg", 861, 861, false, true},
+	{"BSREQU", "java.peg", 862, 862, false, true},
+	{"ARROW", "java.peg", 863, 863, false, false},
+	{"COLON", "java.peg", 864, 864, false, false},
+	{"COLONCOLON", "java.peg", 865, 865, false, false},
+	{"COMMA", "java.peg", 866, 866, false, false},
+	{"DEC", "java.peg", 867, 867, false, false},
+	{"DIV", "java.peg", 868, 868, false, true},
+	{"DIVEQU", "java.peg", 869, 869, false, true},
+	{"DOT", "java.peg", 870, 870, false, false},
+	{"ELLIPSIS", "java.peg", 871, 871, false, false},
+	{"EQU", "java.peg", 872, 872, false, false},
+	{"EQUAL", "java.peg", 873, 873, false, true},
+	{"GE", "java.peg", 874, 874, false, true},
+	{"GT", "java.peg", 875, 875, false, true},
+	{"HAT", "java.peg", 876, 876, false, true},
+	{"HATEQU", "java.peg", 877, 877, false, true},
+	{"INC", "java.peg", 878, 878, false, false},
+	{"LBRK", "java.peg", 879, 879, false, false},
+	{"LE", "java.peg", 880, 880, false, true},
+	{"LPAR", "java.peg", 881, 881, false, false},
+	{"LPOINT", "java.peg", 882, 882, false, false},
+	{"LT", "java.peg", 883, 883, false, true},
+	{"LWING", "java.peg", 884, 884, false, false},
+	{"MINUS", "java.peg", 885, 885, false, false},
+	{"MINUSEQU", "java.peg", 886, 886, false, true},
+	{"MOD", "java.peg", 887, 887, false, true},
+	{"MODEQU", "java.peg", 888, 888, false, true},
+	{"NOTEQUAL", "java.peg", 889, 889, false, true},
+	{"OR", "java.peg", 890, 890, false, false},
+	{"OREQU", "java.peg", 891, 891, false, true},
+	{"OROR", "java.peg", 892, 892, false, true},
+	{"PLUS", "java.peg", 893, 893, false, false},
+	{"PLUSEQU", "java.peg", 894, 894, false, true},
+	{"QUERY", "java.peg", 895, 895, false, false},
+	{"RBRK", "java.peg", 896, 896, false, false},
+	{"RPAR", "java.peg", 897, 897, false, false},
+	{"RPOINT", "java.peg", 898, 898, false, false},
+	{"RWING", "java.peg", 899, 899, false, false},
+	{"SEMI", "java.peg", 900, 900, false, false},
+	{"SL", "java.peg", 901, 901, false, true},
+	{"SLEQU", "java.peg", 902, 902, false, true},
+	{"SR", "java.peg", 903, 903, false, true},
+	{"SREQU", "java.peg", 904, 904, false, true},
+	{"STAR", "java.peg", 905, 905, false, false},
+	{"STAREQU", "java.peg", 906, 906, false, true},
+	{"TILDA", "java.peg", 907, 907, false, true},
+	{"EOT", "java.peg", 909, 909, true, true},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type Java struct {
+	Buffer         string
+	buffer         []rune
+	rules          [240]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*Java) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *Java) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *Java) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *Java) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *Java) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *Java) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *Java) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *Java) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *Java) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// Completions returns the terminals which could continue the first offset
+// runes of the buffer. The parser is reset afterwards.
+func (p *Java) Completions(offset int) []string {
+	buffer := p.Buffer
+	defer func() {
+		p.Buffer = buffer
+		p.Reset()
+	}()
+	if runes := []rune(buffer); offset < len(runes) {
+		p.Buffer = string(runes[:offset])
+	}
+	p.Reset()
+	_ = p.Parse()
+	if int(p.farthest) != len([]rune(p.Buffer)) {
+		return nil
+	}
+	return p.expectations()
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *Java) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// ParsePartial parses like Parse, but if the input is invalid or incomplete
+// the syntax tree holds the rules matched before the farthest failure, below
+// the start rule, and the terminals expected at the failure are returned.
+func (p *Java) ParsePartial(rule ...int) ([]string, error) {
+	p.partial = true
+	defer func() {
+		p.partial = false
+	}()
+	err := p.Parse(rule...)
+	if err == nil {
+		return nil, nil
+	}
+	r := 1
+	if len(rule) > 0 {
+		r = rule[0]
+	}
+	tokens, end := p.partialTokens, uint32(0)
+	for _, token := range tokens {
+		if token.end > end {
+			end = token.end
+		}
+	}
+	if end > 0 {
+		tokens = append(tokens, token32{pegRule: pegRule(r), begin: 0, end: end})
+	}
+	p.tokens32.tree, p.partialTokens = tokens, nil
+	return p.expectations(), err
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *Java
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *Java) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *Java
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *Java) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *Java) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *Java) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func Pretty(pretty bool) func(*Java) error {
+	return func(p *Java) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*Java) error {
+	return func(p *Java) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*Java) error {
+	return func(p *Java) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*Java) error {
+	return func(p *Java) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*Java) error {
+	return func(p *Java) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+// TrackRules records the rules being matched, so that the Rules of a
+// SyntaxError name the rules at the farthest failure. Like MaxDepth it
+// slows every rule down a little.
+func TrackRules() func(*Java) error {
+	return func(p *Java) error {
+		p.trackRules = true
+		return nil
+	}
+}
+func Size(size int) func(*Java) error {
+	return func(p *Java) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*Java) error {
+	return func(p *Java) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *Java) Init(options ...func(*Java) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	fail := func(expected string) {
+		if position > p.farthest {
+			p.farthest, p.expected = position, p.expected[:0]
+			p.farthestHint = ""
+		}
+		if position == p.farthest {
+			if p.trackRules && len(p.expected) == 0 {
+				p.farthestRules = append(p.farthestRules[:0], stack...)
+			}
+			p.expected = append(p.expected, expected)
+			if p.partial {
+				p.partialTokens = append(p.partialTokens[:0], tree.tree[:tokenIndex]...)
+			}
+		}
+	}
+	_ = fail
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	matchKeyword := func(s string) bool {
+		i := position
+		for _, c := range s {
+			if buffer[i] != c {
+				return false
+			}
+			i++
+		}
+		if c := buffer[i]; (c >= rune('a') && c <= rune('z')) || (c >= rune('A') && c <= rune('Z')) || (c >= rune('0') && c <= rune('9')) || c == rune('_') || c == rune('$') {
+			return false
+		}
+		position = i
+		return true
+	}
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 CompilationUnit <- <(Spacing PackageDeclaration? ImportDeclaration* TypeDeclaration* EOT)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[ruleSpacing]() {
+					goto l0
+				}
+				{
+					position2, tokenIndex2 := position, tokenIndex
+					{
+						position4 := position
+					l5:
+						{
+							position6, tokenIndex6 := position, tokenIndex
+							if !_rules[ruleAnnotation]() {
+								goto l6
+							}
+							goto l5
+						l6:
+							position, tokenIndex = position6, tokenIndex6
+						}
+						{
+							position7 := position
+							if buffer[position] != rune('p') {
+								fail("'package'")
+								goto l2
+							}
+							position++
+							if buffer[position] != rune('a') {
+								fail("'a'")
+								goto l2
+							}
+							position++
+							if buffer[position] != rune('c') {
+								fail("'c'")
+								goto l2
+							}
+							position++
+							if buffer[position] != rune('k') {
+								fail("'k'")
+								goto l2
+							}
+							position++
+							if buffer[position] != rune('a') {
+								fail("'a'")
+								goto l2
+							}
+							position++
+							if buffer[position] != rune('g') {
+								fail("'g'")
+								goto l2
+							}
+							position++
+							if buffer[position] != rune('e') {
+								fail("'e'")
+								goto l2
+							}
+							position++
+							{
+								position8, tokenIndex8 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '$':
+										position++
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									case '_':
+										position++
+									default:
+										fail("'$'")
+										fail("[0-9]")
+										fail("[A-Z]")
+										fail("'_'")
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											fail("[a-z]")
+											goto l8
+										}
+										position++
+									}
+								}
+
+								goto l2
+							l8:
+								position, tokenIndex = position8, tokenIndex8
+							}
+							{
+								position10, tokenIndex10 := position, tokenIndex
+								if !_rules[ruleLetterOrDigit]() {
+									goto l10
+								}
+								goto l2
+							l10:
+								position, tokenIndex = position10, tokenIndex10
+							}
+							if !_rules[ruleSpacing]() {
+								goto l2
+							}
+							add(rulePACKAGE, position7)
+						}
+						if !_rules[ruleQualifiedIdentifier]() {
+							goto l2
+						}
+						if !_rules[ruleSEMI]() {
+							goto l2
+						}
+						add(rulePackageDeclaration, position4)
+					}
+					goto l3
+				l2:
+					position, tokenIndex = position2, tokenIndex2
+				}
+			l3:
+			l11:
+				{
+					position12, tokenIndex12 := position, tokenIndex
+					{
+						position13 := position
+						{
+							position14 := position
+							if buffer[position] != rune('i') {
+								fail("'import'")
+								goto l12
+							}
+							position++
+							if buffer[position] != rune('m') {
+								fail("'m'")
+								goto l12
+							}
+							position++
+							if buffer[position] != rune('p') {
+								fail("'p'")
+								goto l12
+							}
+							position++
+							if buffer[position] != rune('o') {
+								fail("'o'")
+								goto l12
+							}
+							position++
+							if buffer[position] != rune('r') {
+								fail("'r'")
+								goto l12
+							}
+							position++
+							if buffer[position] != rune('t') {
+								fail("'t'")
+								goto l12
+							}
+							position++
+							{
+								position15, tokenIndex15 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '$':
+										position++
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									case '_':
+										position++
+									default:
+										fail("'$'")
+										fail("[0-9]")
+										fail("[A-Z]")
+										fail("'_'")
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											fail("[a-z]")
+											goto l15
+										}
+										position++
+									}
+								}
+
+								goto l12
+							l15:
+								position, tokenIndex = position15, tokenIndex15
+							}
+							{
+								position17, tokenIndex17 := position, tokenIndex
+								if !_rules[ruleLetterOrDigit]() {
+									goto l17
+								}
+								goto l12
+							l17:
+								position, tokenIndex = position17, tokenIndex17
+							}
+							if !_rules[ruleSpacing]() {
+								goto l12
+							}
+							add(ruleIMPORT, position14)
+						}
+						{
+							position18, tokenIndex18 := position, tokenIndex
+							if !_rules[ruleSTATIC]() {
+								goto l18
+							}
+							goto l19
+						l18:
+							position, tokenIndex = position18, tokenIndex18
+						}
+					l19:
+						if !_rules[ruleQualifiedIdentifier]() {
+							goto l12
+						}
+						{
+							position20, tokenIndex20 := position, tokenIndex
+							if !_rules[ruleDOT]() {
+								goto l20
+							}
+							if !_rules[ruleSTAR]() {
+								goto l20
+							}
+							goto l21
+						l20:
+							position, tokenIndex = position20, tokenIndex20
+						}
+					l21:
+						if !_rules[ruleSEMI]() {
+							goto l12
+						}
+						add(ruleImportDeclaration, position13)
+					}
+					goto l11
+				l12:
+					position, tokenIndex = position12, tokenIndex12
+				}
+			l22:
+				{
+					position23, tokenIndex23 := position, tokenIndex
+					{
+						position24 := position
+						{
+							position25, tokenIndex25 := position, tokenIndex
+						l27:
+							{
+								position28, tokenIndex28 := position, tokenIndex
+								if !_rules[ruleModifier]() {
+									goto l28
+								}
+								goto l27
+							l28:
+								position, tokenIndex = position28, tokenIndex28
+							}
+							{
+								switch buffer[position] {
+								case '@':
+									if !_rules[ruleAnnotationTypeDeclaration]() {
+										goto l26
+									}
+								case 'e':
+									if !_rules[ruleEnumDeclaration]() {
+										goto l26
+									}
+								case 'i':
+									if !_rules[ruleInterfaceDeclaration]() {
+										goto l26
+									}
+								case 'r':
+									if !_rules[ruleRecordDeclaration]() {
+										goto l26
+									}
+								default:
+									fail("'@'")
+									fail("'enum'")
+									fail("'interface'")
+									fail("'record'")
+									if !_rules[ruleClassDeclaration]() {
+										goto l26
+									}
+								}
+							}
+
+							goto l25
+						l26:
+							position, tokenIndex = position25, tokenIndex25
+							if !_rules[ruleSEMI]() {
+								goto l23
+							}
+						}
+					l25:
+						add(ruleTypeDeclaration, position24)
+					}
+					goto l22
+				l23:
+					position, tokenIndex = position23, tokenIndex23
+				}
+				{
+					position30 := position
+					{
+						position31, tokenIndex31 := position, tokenIndex
+						if !matchDot() {
+							fail(".")
+							goto l31
+						}
+						goto l0
+					l31:
+						position, tokenIndex = position31, tokenIndex31
+					}
+					add(ruleEOT, position30)
+				}
+				add(ruleCompilationUnit, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 PackageDeclaration <- <(Annotation* PACKAGE QualifiedIdentifier SEMI)> */
+		nil,
+		/* 2 ImportDeclaration <- <(IMPORT STATIC? QualifiedIdentifier (DOT STAR)? SEMI)> */
+		nil,
+		/* 3 TypeDeclaration <- <((Modifier* ((&('@') AnnotationTypeDeclaration) | (&('e') EnumDeclaration) | (&('i') InterfaceDeclaration) | (&('r') RecordDeclaration) | (&('c') %fail('@' 'enum' 'interface' 'record') ClassDeclaration))) / SEMI)> */
+		nil,
+		/* 4 ClassDeclaration <- <(CLASS Identifier TypeParameters? (EXTENDS ClassType)? (IMPLEMENTS ClassTypeList)? (PERMITS ClassTypeList)? ClassBody)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{4, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position35, tokenIndex35 := position, tokenIndex
+			{
+				position36 := position
+				if !_rules[ruleCLASS]() {
+					goto l35
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l35
+				}
+				{
+					position37, tokenIndex37 := position, tokenIndex
+					if !_rules[ruleTypeParameters]() {
+						goto l37
+					}
+					goto l38
+				l37:
+					position, tokenIndex = position37, tokenIndex37
+				}
+			l38:
+				{
+					position39, tokenIndex39 := position, tokenIndex
+					if !_rules[ruleEXTENDS]() {
+						goto l39
+					}
+					if !_rules[ruleClassType]() {
+						goto l39
+					}
+					goto l40
+				l39:
+					position, tokenIndex = position39, tokenIndex39
+				}
+			l40:
+				{
+					position41, tokenIndex41 := position, tokenIndex
+					if !_rules[ruleIMPLEMENTS]() {
+						goto l41
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l41
+					}
+					goto l42
+				l41:
+					position, tokenIndex = position41, tokenIndex41
+				}
+			l42:
+				{
+					position43, tokenIndex43 := position, tokenIndex
+					if !_rules[rulePERMITS]() {
+						goto l43
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l43
+					}
+					goto l44
+				l43:
+					position, tokenIndex = position43, tokenIndex43
+				}
+			l44:
+				if !_rules[ruleClassBody]() {
+					goto l35
+				}
+				add(ruleClassDeclaration, position36)
+			}
+			memoize(4, position35, tokenIndex35, true)
+			return true
+		l35:
+			memoize(4, position35, tokenIndex35, false)
+			position, tokenIndex = position35, tokenIndex35
+			return false
+		},
+		/* 5 ClassBody <- <(LWING ClassBodyDeclaration* RWING)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{5, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position45, tokenIndex45 := position, tokenIndex
+			{
+				position46 := position
+				if !_rules[ruleLWING]() {
+					goto l45
+				}
+			l47:
+				{
+					position48, tokenIndex48 := position, tokenIndex
+					if !_rules[ruleClassBodyDeclaration]() {
+						goto l48
+					}
+					goto l47
+				l48:
+					position, tokenIndex = position48, tokenIndex48
+				}
+				if !_rules[ruleRWING]() {
+					goto l45
+				}
+				add(ruleClassBody, position46)
+			}
+			memoize(5, position45, tokenIndex45, true)
+			return true
+		l45:
+			memoize(5, position45, tokenIndex45, false)
+			position, tokenIndex = position45, tokenIndex45
+			return false
+		},
+		/* 6 ClassBodyDeclaration <- <(SEMI / (STATIC? Block) / (Modifier* MemberDecl) / InvalidMember)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{6, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position49, tokenIndex49 := position, tokenIndex
+			{
+				position50 := position
+				{
+					position51, tokenIndex51 := position, tokenIndex
+					if !_rules[ruleSEMI]() {
+						goto l52
+					}
+					goto l51
+				l52:
+					position, tokenIndex = position51, tokenIndex51
+					{
+						position54, tokenIndex54 := position, tokenIndex
+						if !_rules[ruleSTATIC]() {
+							goto l54
+						}
+						goto l55
+					l54:
+						position, tokenIndex = position54, tokenIndex54
+					}
+				l55:
+					if !_rules[ruleBlock]() {
+						goto l53
+					}
+					goto l51
+				l53:
+					position, tokenIndex = position51, tokenIndex51
+				l57:
+					{
+						position58, tokenIndex58 := position, tokenIndex
+						if !_rules[ruleModifier]() {
+							goto l58
+						}
+						goto l57
+					l58:
+						position, tokenIndex = position58, tokenIndex58
+					}
+					{
+						position59 := position
+						{
+							switch buffer[position] {
+							case '<':
+								fail("'record'")
+								fail("'char'")
+								fail("'double'")
+								fail("'float'")
+								fail("'int'")
+								fail("'long'")
+								fail("'short'")
+								fail("'b'")
+								fail("'_'")
+								fail("'$'")
+								fail("[A-Z]")
+								fail("[a-z]")
+								fail("'void'")
+								fail("'interface'")
+								fail("'class'")
+								fail("'enum'")
+								fail("'@'")
+								if !_rules[ruleTypeParameters]() {
+									goto l56
+								}
+								{
+									position61 := position
+									{
+										position62, tokenIndex62 := position, tokenIndex
+										{
+											position64, tokenIndex64 := position, tokenIndex
+											if !_rules[ruleType]() {
+												goto l65
+											}
+											goto l64
+										l65:
+											position, tokenIndex = position64, tokenIndex64
+											if !_rules[ruleVOID]() {
+												goto l63
+											}
+										}
+									l64:
+										if !_rules[ruleIdentifier]() {
+											goto l63
+										}
+										if !_rules[ruleMethodDeclaratorRest]() {
+											goto l63
+										}
+										goto l62
+									l63:
+										position, tokenIndex = position62, tokenIndex62
+										if !_rules[ruleIdentifier]() {
+											goto l56
+										}
+										if !_rules[ruleConstructorDeclaratorRest]() {
+											goto l56
+										}
+									}
+								l62:
+									add(ruleGenericMethodOrConstructorRest, position61)
+								}
+							case '@':
+								fail("'record'")
+								fail("'<'")
+								fail("'char'")
+								fail("'double'")
+								fail("'float'")
+								fail("'int'")
+								fail("'long'")
+								fail("'short'")
+								fail("'b'")
+								fail("'_'")
+								fail("'$'")
+								fail("[A-Z]")
+								fail("[a-z]")
+								fail("'void'")
+								fail("'interface'")
+								fail("'class'")
+								fail("'enum'")
+								if !_rules[ruleAnnotationTypeDeclaration]() {
+									goto l56
+								}
+							case 'c':
+								fail("'record'")
+								fail("'<'")
+								fail("'void'")
+								fail("'interface'")
+								fail("'enum'")
+								fail("'@'")
+								{
+									position66, tokenIndex66 := position, tokenIndex
+									if !_rules[ruleType]() {
+										goto l67
+									}
+									{
+										position68, tokenIndex68 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l69
+										}
+										if !_rules[ruleMethodDeclaratorRest]() {
+											goto l69
+										}
+										goto l68
+									l69:
+										position, tokenIndex = position68, tokenIndex68
+										if !_rules[ruleVariableDeclarators]() {
+											goto l67
+										}
+										if !_rules[ruleSEMI]() {
+											goto l67
+										}
+									}
+								l68:
+									goto l66
+								l67:
+									position, tokenIndex = position66, tokenIndex66
+									if !_rules[ruleIdentifier]() {
+										goto l70
+									}
+									if !_rules[ruleConstructorDeclaratorRest]() {
+										goto l70
+									}
+									goto l66
+								l70:
+									position, tokenIndex = position66, tokenIndex66
+									if !_rules[ruleClassDeclaration]() {
+										goto l56
+									}
+								}
+							l66:
+								break
+							case 'e':
+								fail("'record'")
+								fail("'<'")
+								fail("'void'")
+								fail("'interface'")
+								fail("'class'")
+								fail("'@'")
+								{
+									position71, tokenIndex71 := position, tokenIndex
+									if !_rules[ruleType]() {
+										goto l72
+									}
+									{
+										position73, tokenIndex73 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l74
+										}
+										if !_rules[ruleMethodDeclaratorRest]() {
+											goto l74
+										}
+										goto l73
+									l74:
+										position, tokenIndex = position73, tokenIndex73
+										if !_rules[ruleVariableDeclarators]() {
+											goto l72
+										}
+										if !_rules[ruleSEMI]() {
+											goto l72
+										}
+									}
+								l73:
+									goto l71
+								l72:
+									position, tokenIndex = position71, tokenIndex71
+									if !_rules[ruleIdentifier]() {
+										goto l75
+									}
+									if !_rules[ruleConstructorDeclaratorRest]() {
+										goto l75
+									}
+									goto l71
+								l75:
+									position, tokenIndex = position71, tokenIndex71
+									if !_rules[ruleEnumDeclaration]() {
+										goto l56
+									}
+								}
+							l71:
+								break
+							case 'i':
+								fail("'record'")
+								fail("'<'")
+								fail("'void'")
+								fail("'class'")
+								fail("'enum'")
+								fail("'@'")
+								{
+									position76, tokenIndex76 := position, tokenIndex
+									if !_rules[ruleType]() {
+										goto l77
+									}
+									{
+										position78, tokenIndex78 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l79
+										}
+										if !_rules[ruleMethodDeclaratorRest]() {
+											goto l79
+										}
+										goto l78
+									l79:
+										position, tokenIndex = position78, tokenIndex78
+										if !_rules[ruleVariableDeclarators]() {
+											goto l77
+										}
+										if !_rules[ruleSEMI]() {
+											goto l77
+										}
+									}
+								l78:
+									goto l76
+								l77:
+									position, tokenIndex = position76, tokenIndex76
+									if !_rules[ruleIdentifier]() {
+										goto l80
+									}
+									if !_rules[ruleConstructorDeclaratorRest]() {
+										goto l80
+									}
+									goto l76
+								l80:
+									position, tokenIndex = position76, tokenIndex76
+									if !_rules[ruleInterfaceDeclaration]() {
+										goto l56
+									}
+								}
+							l76:
+								break
+							case 'r':
+								fail("'<'")
+								fail("'void'")
+								fail("'interface'")
+								fail("'class'")
+								fail("'enum'")
+								fail("'@'")
+								{
+									position81, tokenIndex81 := position, tokenIndex
+									if !_rules[ruleRecordDeclaration]() {
+										goto l82
+									}
+									goto l81
+								l82:
+									position, tokenIndex = position81, tokenIndex81
+									if !_rules[ruleType]() {
+										goto l83
+									}
+									{
+										position84, tokenIndex84 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l85
+										}
+										if !_rules[ruleMethodDeclaratorRest]() {
+											goto l85
+										}
+										goto l84
+									l85:
+										position, tokenIndex = position84, tokenIndex84
+										if !_rules[ruleVariableDeclarators]() {
+											goto l83
+										}
+										if !_rules[ruleSEMI]() {
+											goto l83
+										}
+									}
+								l84:
+									goto l81
+								l83:
+									position, tokenIndex = position81, tokenIndex81
+									if !_rules[ruleIdentifier]() {
+										goto l56
+									}
+									if !_rules[ruleConstructorDeclaratorRest]() {
+										goto l56
+									}
+								}
+							l81:
+								break
+							case 'v':
+								fail("'record'")
+								fail("'<'")
+								fail("'interface'")
+								fail("'class'")
+								fail("'enum'")
+								fail("'@'")
+								{
+									position86, tokenIndex86 := position, tokenIndex
+									if !_rules[ruleType]() {
+										goto l87
+									}
+									{
+										position88, tokenIndex88 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l89
+										}
+										if !_rules[ruleMethodDeclaratorRest]() {
+											goto l89
+										}
+										goto l88
+									l89:
+										position, tokenIndex = position88, tokenIndex88
+										if !_rules[ruleVariableDeclarators]() {
+											goto l87
+										}
+										if !_rules[ruleSEMI]() {
+											goto l87
+										}
+									}
+								l88:
+									goto l86
+								l87:
+									position, tokenIndex = position86, tokenIndex86
+									if !_rules[ruleVOID]() {
+										goto l90
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l90
+									}
+									{
+										position91 := position
+										if !_rules[ruleFormalParameters]() {
+											goto l90
+										}
+										{
+											position92, tokenIndex92 := position, tokenIndex
+											if !_rules[ruleTHROWS]() {
+												goto l92
+											}
+											if !_rules[ruleClassTypeList]() {
+												goto l92
+											}
+											goto l93
+										l92:
+											position, tokenIndex = position92, tokenIndex92
+										}
+									l93:
+										{
+											position94, tokenIndex94 := position, tokenIndex
+											if !_rules[ruleMethodBody]() {
+												goto l95
+											}
+											goto l94
+										l95:
+											position, tokenIndex = position94, tokenIndex94
+											if !_rules[ruleSEMI]() {
+												goto l90
+											}
+										}
+									l94:
+										add(ruleVoidMethodDeclaratorRest, position91)
+									}
+									goto l86
+								l90:
+									position, tokenIndex = position86, tokenIndex86
+									if !_rules[ruleIdentifier]() {
+										goto l56
+									}
+									if !_rules[ruleConstructorDeclaratorRest]() {
+										goto l56
+									}
+								}
+							l86:
+								break
+							default:
+								fail("'record'")
+								fail("'<'")
+								fail("'void'")
+								fail("'interface'")
+								fail("'class'")
+								fail("'enum'")
+								fail("'@'")
+								{
+									position96, tokenIndex96 := position, tokenIndex
+									if !_rules[ruleType]() {
+										goto l97
+									}
+									{
+										position98, tokenIndex98 := position, tokenIndex
+										if !_rules[ruleIdentifier]() {
+											goto l99
+										}
+										if !_rules[ruleMethodDeclaratorRest]() {
+											goto l99
+										}
+										goto l98
+									l99:
+										position, tokenIndex = position98, tokenIndex98
+										if !_rules[ruleVariableDeclarators]() {
+											goto l97
+										}
+										if !_rules[ruleSEMI]() {
+											goto l97
+										}
+									}
+								l98:
+									goto l96
+								l97:
+									position, tokenIndex = position96, tokenIndex96
+									if !_rules[ruleIdentifier]() {
+										goto l56
+									}
+									if !_rules[ruleConstructorDeclaratorRest]() {
+										goto l56
+									}
+								}
+							l96:
+								break
+							}
+						}
+
+						add(ruleMemberDecl, position59)
+					}
+					goto l51
+				l56:
+					position, tokenIndex = position51, tokenIndex51
+					{
+						position100 := position
+						{
+							position103, tokenIndex103 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '{':
+									if !_rules[ruleLWING]() {
+										goto l103
+									}
+								case '}':
+									if !_rules[ruleRWING]() {
+										goto l103
+									}
+								default:
+									fail("'{'")
+									fail("'}'")
+									if !_rules[ruleSEMI]() {
+										goto l103
+									}
+								}
+							}
+
+							goto l49
+						l103:
+							position, tokenIndex = position103, tokenIndex103
+						}
+						{
+							position105, tokenIndex105 := position, tokenIndex
+							if !_rules[ruleStringLiteral]() {
+								goto l106
+							}
+							goto l105
+						l106:
+							position, tokenIndex = position105, tokenIndex105
+							if !_rules[ruleCharLiteral]() {
+								goto l107
+							}
+							goto l105
+						l107:
+							position, tokenIndex = position105, tokenIndex105
+							if !matchDot() {
+								fail(".")
+								goto l49
+							}
+						}
+					l105:
+						if !_rules[ruleSpacing]() {
+							goto l49
+						}
+					l101:
+						{
+							position102, tokenIndex102 := position, tokenIndex
+							{
+								position108, tokenIndex108 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '{':
+										if !_rules[ruleLWING]() {
+											goto l108
+										}
+									case '}':
+										if !_rules[ruleRWING]() {
+											goto l108
+										}
+									default:
+										fail("'{'")
+										fail("'}'")
+										if !_rules[ruleSEMI]() {
+											goto l108
+										}
+									}
+								}
+
+								goto l102
+							l108:
+								position, tokenIndex = position108, tokenIndex108
+							}
+							{
+								position110, tokenIndex110 := position, tokenIndex
+								if !_rules[ruleStringLiteral]() {
+									goto l111
+								}
+								goto l110
+							l111:
+								position, tokenIndex = position110, tokenIndex110
+								if !_rules[ruleCharLiteral]() {
+									goto l112
+								}
+								goto l110
+							l112:
+								position, tokenIndex = position110, tokenIndex110
+								if !matchDot() {
+									fail(".")
+									goto l102
+								}
+							}
+						l110:
+							if !_rules[ruleSpacing]() {
+								goto l102
+							}
+							goto l101
+						l102:
+							position, tokenIndex = position102, tokenIndex102
+						}
+						{
+							position113, tokenIndex113 := position, tokenIndex
+							if !_rules[ruleSEMI]() {
+								goto l114
+							}
+							goto l113
+						l114:
+							position, tokenIndex = position113, tokenIndex113
+							if !_rules[ruleBalanced]() {
+								goto l49
+							}
+						}
+					l113:
+						add(ruleInvalidMember, position100)
+					}
+				}
+			l51:
+				add(ruleClassBodyDeclaration, position50)
+			}
+			memoize(6, position49, tokenIndex49, true)
+			return true
+		l49:
+			memoize(6, position49, tokenIndex49, false)
+			position, tokenIndex = position49, tokenIndex49
+			return false
+		},
+		/* 7 InvalidMember <- <((!((&('{') LWING) | (&('}') RWING) | (&(';') %fail('{' '}') SEMI)) (StringLiteral / CharLiteral / .) Spacing)+ (SEMI / Balanced))> */
+		nil,
+		/* 8 Balanced <- <(LWING (Balanced / (!RWING (TextBlock / StringLiteral / CharLiteral / .) Spacing))* RWING)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{8, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position116, tokenIndex116 := position, tokenIndex
+			{
+				position117 := position
+				if !_rules[ruleLWING]() {
+					goto l116
+				}
+			l118:
+				{
+					position119, tokenIndex119 := position, tokenIndex
+					{
+						position120, tokenIndex120 := position, tokenIndex
+						if !_rules[ruleBalanced]() {
+							goto l121
+						}
+						goto l120
+					l121:
+						position, tokenIndex = position120, tokenIndex120
+						{
+							position122, tokenIndex122 := position, tokenIndex
+							if !_rules[ruleRWING]() {
+								goto l122
+							}
+							goto l119
+						l122:
+							position, tokenIndex = position122, tokenIndex122
+						}
+						{
+							position123, tokenIndex123 := position, tokenIndex
+							if !_rules[ruleTextBlock]() {
+								goto l124
+							}
+							goto l123
+						l124:
+							position, tokenIndex = position123, tokenIndex123
+							if !_rules[ruleStringLiteral]() {
+								goto l125
+							}
+							goto l123
+						l125:
+							position, tokenIndex = position123, tokenIndex123
+							if !_rules[ruleCharLiteral]() {
+								goto l126
+							}
+							goto l123
+						l126:
+							position, tokenIndex = position123, tokenIndex123
+							if !matchDot() {
+								fail(".")
+								goto l119
+							}
+						}
+					l123:
+						if !_rules[ruleSpacing]() {
+							goto l119
+						}
+					}
+				l120:
+					goto l118
+				l119:
+					position, tokenIndex = position119, tokenIndex119
+				}
+				if !_rules[ruleRWING]() {
+					goto l116
+				}
+				add(ruleBalanced, position117)
+			}
+			memoize(8, position116, tokenIndex116, true)
+			return true
+		l116:
+			memoize(8, position116, tokenIndex116, false)
+			position, tokenIndex = position116, tokenIndex116
+			return false
+		},
+		/* 9 MemberDecl <- <((&('<') %fail('record' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'void' 'interface' 'class' 'enum' '@') (TypeParameters GenericMethodOrConstructorRest)) | (&('@') %fail('record' '<' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'void' 'interface' 'class' 'enum') AnnotationTypeDeclaration) | (&('c') %fail('record' '<' 'void' 'interface' 'enum' '@') ((Type ((Identifier MethodDeclaratorRest) / (VariableDeclarators SEMI))) / (Identifier ConstructorDeclaratorRest) / ClassDeclaration)) | (&('e') %fail('record' '<' 'void' 'interface' 'class' '@') ((Type ((Identifier MethodDeclaratorRest) / (VariableDeclarators SEMI))) / (Identifier ConstructorDeclaratorRest) / EnumDeclaration)) | (&('i') %fail('record' '<' 'void' 'class' 'enum' '@') ((Type ((Identifier MethodDeclaratorRest) / (VariableDeclarators SEMI))) / (Identifier ConstructorDeclaratorRest) / InterfaceDeclaration)) | (&('r') %fail('<' 'void' 'interface' 'class' 'enum' '@') (RecordDeclaration / (Type ((Identifier MethodDeclaratorRest) / (VariableDeclarators SEMI))) / (Identifier ConstructorDeclaratorRest))) | (&('v') %fail('record' '<' 'interface' 'class' 'enum' '@') ((Type ((Identifier MethodDeclaratorRest) / (VariableDeclarators SEMI))) / (VOID Identifier VoidMethodDeclaratorRest) / (Identifier ConstructorDeclaratorRest))) | (&('$' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'd' | 'f' | 'g' | 'h' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 's' | 't' | 'u' | 'w' | 'x' | 'y' | 'z') %fail('record' '<' 'void' 'interface' 'class' 'enum' '@') ((Type ((Identifier MethodDeclaratorRest) / (VariableDeclarators SEMI))) / (Identifier ConstructorDeclaratorRest))))> */
+		nil,
+		/* 10 GenericMethodOrConstructorRest <- <(((Type / VOID) Identifier MethodDeclaratorRest) / (Identifier ConstructorDeclaratorRest))> */
+		nil,
+		/* 11 MethodDeclaratorRest <- <(FormalParameters Dim* (THROWS ClassTypeList)? (MethodBody / SEMI))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{11, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position129, tokenIndex129 := position, tokenIndex
+			{
+				position130 := position
+				if !_rules[ruleFormalParameters]() {
+					goto l129
+				}
+			l131:
+				{
+					position132, tokenIndex132 := position, tokenIndex
+					if !_rules[ruleDim]() {
+						goto l132
+					}
+					goto l131
+				l132:
+					position, tokenIndex = position132, tokenIndex132
+				}
+				{
+					position133, tokenIndex133 := position, tokenIndex
+					if !_rules[ruleTHROWS]() {
+						goto l133
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l133
+					}
+					goto l134
+				l133:
+					position, tokenIndex = position133, tokenIndex133
+				}
+			l134:
+				{
+					position135, tokenIndex135 := position, tokenIndex
+					if !_rules[ruleMethodBody]() {
+						goto l136
+					}
+					goto l135
+				l136:
+					position, tokenIndex = position135, tokenIndex135
+					if !_rules[ruleSEMI]() {
+						goto l129
+					}
+				}
+			l135:
+				add(ruleMethodDeclaratorRest, position130)
+			}
+			memoize(11, position129, tokenIndex129, true)
+			return true
+		l129:
+			memoize(11, position129, tokenIndex129, false)
+			position, tokenIndex = position129, tokenIndex129
+			return false
+		},
+		/* 12 VoidMethodDeclaratorRest <- <(FormalParameters (THROWS ClassTypeList)? (MethodBody / SEMI))> */
+		nil,
+		/* 13 ConstructorDeclaratorRest <- <(FormalParameters (THROWS ClassTypeList)? MethodBody)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{13, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position138, tokenIndex138 := position, tokenIndex
+			{
+				position139 := position
+				if !_rules[ruleFormalParameters]() {
+					goto l138
+				}
+				{
+					position140, tokenIndex140 := position, tokenIndex
+					if !_rules[ruleTHROWS]() {
+						goto l140
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l140
+					}
+					goto l141
+				l140:
+					position, tokenIndex = position140, tokenIndex140
+				}
+			l141:
+				if !_rules[ruleMethodBody]() {
+					goto l138
+				}
+				add(ruleConstructorDeclaratorRest, position139)
+			}
+			memoize(13, position138, tokenIndex138, true)
+			return true
+		l138:
+			memoize(13, position138, tokenIndex138, false)
+			position, tokenIndex = position138, tokenIndex138
+			return false
+		},
+		/* 14 MethodBody <- <Block> */
+		func() bool {
+			memoized, ok := memoization[memoKey{14, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position142, tokenIndex142 := position, tokenIndex
+			{
+				position143 := position
+				if !_rules[ruleBlock]() {
+					goto l142
+				}
+				add(ruleMethodBody, position143)
+			}
+			memoize(14, position142, tokenIndex142, true)
+			return true
+		l142:
+			memoize(14, position142, tokenIndex142, false)
+			position, tokenIndex = position142, tokenIndex142
+			return false
+		},
+		/* 15 InterfaceDeclaration <- <(INTERFACE Identifier TypeParameters? (EXTENDS ClassTypeList)? (PERMITS ClassTypeList)? InterfaceBody)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{15, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position144, tokenIndex144 := position, tokenIndex
+			{
+				position145 := position
+				if !_rules[ruleINTERFACE]() {
+					goto l144
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l144
+				}
+				{
+					position146, tokenIndex146 := position, tokenIndex
+					if !_rules[ruleTypeParameters]() {
+						goto l146
+					}
+					goto l147
+				l146:
+					position, tokenIndex = position146, tokenIndex146
+				}
+			l147:
+				{
+					position148, tokenIndex148 := position, tokenIndex
+					if !_rules[ruleEXTENDS]() {
+						goto l148
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l148
+					}
+					goto l149
+				l148:
+					position, tokenIndex = position148, tokenIndex148
+				}
+			l149:
+				{
+					position150, tokenIndex150 := position, tokenIndex
+					if !_rules[rulePERMITS]() {
+						goto l150
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l150
+					}
+					goto l151
+				l150:
+					position, tokenIndex = position150, tokenIndex150
+				}
+			l151:
+				{
+					position152 := position
+					if !_rules[ruleLWING]() {
+						goto l144
+					}
+				l153:
+					{
+						position154, tokenIndex154 := position, tokenIndex
+						{
+							position155 := position
+							{
+								position156, tokenIndex156 := position, tokenIndex
+							l158:
+								{
+									position159, tokenIndex159 := position, tokenIndex
+									if !_rules[ruleModifier]() {
+										goto l159
+									}
+									goto l158
+								l159:
+									position, tokenIndex = position159, tokenIndex159
+								}
+								{
+									position160 := position
+									{
+										switch buffer[position] {
+										case '<':
+											fail("'record'")
+											fail("'char'")
+											fail("'double'")
+											fail("'float'")
+											fail("'int'")
+											fail("'long'")
+											fail("'short'")
+											fail("'b'")
+											fail("'_'")
+											fail("'$'")
+											fail("[A-Z]")
+											fail("[a-z]")
+											fail("'void'")
+											fail("'interface'")
+											fail("'@'")
+											fail("'class'")
+											fail("'enum'")
+											{
+												position162 := position
+												if !_rules[ruleTypeParameters]() {
+													goto l157
+												}
+												{
+													position163, tokenIndex163 := position, tokenIndex
+													if !_rules[ruleType]() {
+														goto l164
+													}
+													goto l163
+												l164:
+													position, tokenIndex = position163, tokenIndex163
+													if !_rules[ruleVOID]() {
+														goto l157
+													}
+												}
+											l163:
+												if !_rules[ruleIdentifier]() {
+													goto l157
+												}
+												if !_rules[ruleInterfaceMethodDeclaratorRest]() {
+													goto l157
+												}
+												add(ruleInterfaceGenericMethodDecl, position162)
+											}
+										case '@':
+											fail("'record'")
+											fail("'char'")
+											fail("'double'")
+											fail("'float'")
+											fail("'int'")
+											fail("'long'")
+											fail("'short'")
+											fail("'b'")
+											fail("'_'")
+											fail("'$'")
+											fail("[A-Z]")
+											fail("[a-z]")
+											fail("'<'")
+											fail("'void'")
+											fail("'interface'")
+											fail("'class'")
+											fail("'enum'")
+											if !_rules[ruleAnnotationTypeDeclaration]() {
+												goto l157
+											}
+										case 'c':
+											fail("'record'")
+											fail("'<'")
+											fail("'void'")
+											fail("'interface'")
+											fail("'@'")
+											fail("'enum'")
+											{
+												position165, tokenIndex165 := position, tokenIndex
+												if !_rules[ruleInterfaceMethodOrFieldDecl]() {
+													goto l166
+												}
+												goto l165
+											l166:
+												position, tokenIndex = position165, tokenIndex165
+												if !_rules[ruleClassDeclaration]() {
+													goto l157
+												}
+											}
+										l165:
+											break
+										case 'e':
+											fail("'record'")
+											fail("'<'")
+											fail("'void'")
+											fail("'interface'")
+											fail("'@'")
+											fail("'class'")
+											{
+												position167, tokenIndex167 := position, tokenIndex
+												if !_rules[ruleInterfaceMethodOrFieldDecl]() {
+													goto l168
+												}
+												goto l167
+											l168:
+												position, tokenIndex = position167, tokenIndex167
+												if !_rules[ruleEnumDeclaration]() {
+													goto l157
+												}
+											}
+										l167:
+											break
+										case 'i':
+											fail("'record'")
+											fail("'<'")
+											fail("'void'")
+											fail("'@'")
+											fail("'class'")
+											fail("'enum'")
+											{
+												position169, tokenIndex169 := position, tokenIndex
+												if !_rules[ruleInterfaceMethodOrFieldDecl]() {
+													goto l170
+												}
+												goto l169
+											l170:
+												position, tokenIndex = position169, tokenIndex169
+												if !_rules[ruleInterfaceDeclaration]() {
+													goto l157
+												}
+											}
+										l169:
+											break
+										case 'r':
+											fail("'<'")
+											fail("'void'")
+											fail("'interface'")
+											fail("'@'")
+											fail("'class'")
+											fail("'enum'")
+											{
+												position171, tokenIndex171 := position, tokenIndex
+												if !_rules[ruleRecordDeclaration]() {
+													goto l172
+												}
+												goto l171
+											l172:
+												position, tokenIndex = position171, tokenIndex171
+												if !_rules[ruleInterfaceMethodOrFieldDecl]() {
+													goto l157
+												}
+											}
+										l171:
+											break
+										case 'v':
+											fail("'record'")
+											fail("'<'")
+											fail("'interface'")
+											fail("'@'")
+											fail("'class'")
+											fail("'enum'")
+											{
+												position173, tokenIndex173 := position, tokenIndex
+												if !_rules[ruleInterfaceMethodOrFieldDecl]() {
+													goto l174
+												}
+												goto l173
+											l174:
+												position, tokenIndex = position173, tokenIndex173
+												if !_rules[ruleVOID]() {
+													goto l157
+												}
+												if !_rules[ruleIdentifier]() {
+													goto l157
+												}
+												{
+													position175 := position
+													if !_rules[ruleFormalParameters]() {
+														goto l157
+													}
+													{
+														position176, tokenIndex176 := position, tokenIndex
+														if !_rules[ruleTHROWS]() {
+															goto l176
+														}
+														if !_rules[ruleClassTypeList]() {
+															goto l176
+														}
+														goto l177
+													l176:
+														position, tokenIndex = position176, tokenIndex176
+													}
+												l177:
+													{
+														position178, tokenIndex178 := position, tokenIndex
+														if !_rules[ruleMethodBody]() {
+															goto l179
+														}
+														goto l178
+													l179:
+														position, tokenIndex = position178, tokenIndex178
+														if !_rules[ruleSEMI]() {
+															goto l157
+														}
+													}
+												l178:
+													add(ruleVoidInterfaceMethodDeclaratorRest, position175)
+												}
+											}
+										l173:
+											break
+										default:
+											fail("'record'")
+											fail("'<'")
+											fail("'void'")
+											fail("'interface'")
+											fail("'@'")
+											fail("'class'")
+											fail("'enum'")
+											if !_rules[ruleInterfaceMethodOrFieldDecl]() {
+												goto l157
+											}
+										}
+									}
+
+									add(ruleInterfaceMemberDecl, position160)
+								}
+								goto l156
+							l157:
+								position, tokenIndex = position156, tokenIndex156
+								if !_rules[ruleSEMI]() {
+									goto l154
+								}
+							}
+						l156:
+							add(ruleInterfaceBodyDeclaration, position155)
+						}
+						goto l153
+					l154:
+						position, tokenIndex = position154, tokenIndex154
+					}
+					if !_rules[ruleRWING]() {
+						goto l144
+					}
+					add(ruleInterfaceBody, position152)
+				}
+				add(ruleInterfaceDeclaration, position145)
+			}
+			memoize(15, position144, tokenIndex144, true)
+			return true
+		l144:
+			memoize(15, position144, tokenIndex144, false)
+			position, tokenIndex = position144, tokenIndex144
+			return false
+		},
+		/* 16 InterfaceBody <- <(LWING InterfaceBodyDeclaration* RWING)> */
+		nil,
+		/* 17 InterfaceBodyDeclaration <- <((Modifier* InterfaceMemberDecl) / SEMI)> */
+		nil,
+		/* 18 InterfaceMemberDecl <- <((&('<') %fail('record' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'void' 'interface' '@' 'class' 'enum') InterfaceGenericMethodDecl) | (&('@') %fail('record' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] '<' 'void' 'interface' 'class' 'enum') AnnotationTypeDeclaration) | (&('c') %fail('record' '<' 'void' 'interface' '@' 'enum') (InterfaceMethodOrFieldDecl / ClassDeclaration)) | (&('e') %fail('record' '<' 'void' 'interface' '@' 'class') (InterfaceMethodOrFieldDecl / EnumDeclaration)) | (&('i') %fail('record' '<' 'void' '@' 'class' 'enum') (InterfaceMethodOrFieldDecl / InterfaceDeclaration)) | (&('r') %fail('<' 'void' 'interface' '@' 'class' 'enum') (RecordDeclaration / InterfaceMethodOrFieldDecl)) | (&('v') %fail('record' '<' 'interface' '@' 'class' 'enum') (InterfaceMethodOrFieldDecl / (VOID Identifier VoidInterfaceMethodDeclaratorRest))) | (&('$' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'd' | 'f' | 'g' | 'h' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 's' | 't' | 'u' | 'w' | 'x' | 'y' | 'z') %fail('record' '<' 'void' 'interface' '@' 'class' 'enum') InterfaceMethodOrFieldDecl))> */
+		nil,
+		/* 19 InterfaceMethodOrFieldDecl <- <(Type Identifier InterfaceMethodOrFieldRest)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{19, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position183, tokenIndex183 := position, tokenIndex
+			{
+				position184 := position
+				if !_rules[ruleType]() {
+					goto l183
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l183
+				}
+				{
+					position185 := position
+					{
+						position186, tokenIndex186 := position, tokenIndex
+						{
+							position188 := position
+							if !_rules[ruleConstantDeclaratorRest]() {
+								goto l187
+							}
+						l189:
+							{
+								position190, tokenIndex190 := position, tokenIndex
+								if !_rules[ruleCOMMA]() {
+									goto l190
+								}
+								{
+									position191 := position
+									if !_rules[ruleIdentifier]() {
+										goto l190
+									}
+									if !_rules[ruleConstantDeclaratorRest]() {
+										goto l190
+									}
+									add(ruleConstantDeclarator, position191)
+								}
+								goto l189
+							l190:
+								position, tokenIndex = position190, tokenIndex190
+							}
+							add(ruleConstantDeclaratorsRest, position188)
+						}
+						if !_rules[ruleSEMI]() {
+							goto l187
+						}
+						goto l186
+					l187:
+						position, tokenIndex = position186, tokenIndex186
+						if !_rules[ruleInterfaceMethodDeclaratorRest]() {
+							goto l183
+						}
+					}
+				l186:
+					add(ruleInterfaceMethodOrFieldRest, position185)
+				}
+				add(ruleInterfaceMethodOrFieldDecl, position184)
+			}
+			memoize(19, position183, tokenIndex183, true)
+			return true
+		l183:
+			memoize(19, position183, tokenIndex183, false)
+			position, tokenIndex = position183, tokenIndex183
+			return false
+		},
+		/* 20 InterfaceMethodOrFieldRest <- <((ConstantDeclaratorsRest SEMI) / InterfaceMethodDeclaratorRest)> */
+		nil,
+		/* 21 InterfaceMethodDeclaratorRest <- <(FormalParameters Dim* (THROWS ClassTypeList)? (MethodBody / SEMI))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{21, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position193, tokenIndex193 := position, tokenIndex
+			{
+				position194 := position
+				if !_rules[ruleFormalParameters]() {
+					goto l193
+				}
+			l195:
+				{
+					position196, tokenIndex196 := position, tokenIndex
+					if !_rules[ruleDim]() {
+						goto l196
+					}
+					goto l195
+				l196:
+					position, tokenIndex = position196, tokenIndex196
+				}
+				{
+					position197, tokenIndex197 := position, tokenIndex
+					if !_rules[ruleTHROWS]() {
+						goto l197
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l197
+					}
+					goto l198
+				l197:
+					position, tokenIndex = position197, tokenIndex197
+				}
+			l198:
+				{
+					position199, tokenIndex199 := position, tokenIndex
+					if !_rules[ruleMethodBody]() {
+						goto l200
+					}
+					goto l199
+				l200:
+					position, tokenIndex = position199, tokenIndex199
+					if !_rules[ruleSEMI]() {
+						goto l193
+					}
+				}
+			l199:
+				add(ruleInterfaceMethodDeclaratorRest, position194)
+			}
+			memoize(21, position193, tokenIndex193, true)
+			return true
+		l193:
+			memoize(21, position193, tokenIndex193, false)
+			position, tokenIndex = position193, tokenIndex193
+			return false
+		},
+		/* 22 InterfaceGenericMethodDecl <- <(TypeParameters (Type / VOID) Identifier InterfaceMethodDeclaratorRest)> */
+		nil,
+		/* 23 VoidInterfaceMethodDeclaratorRest <- <(FormalParameters (THROWS ClassTypeList)? (MethodBody / SEMI))> */
+		nil,
+		/* 24 ConstantDeclaratorsRest <- <(ConstantDeclaratorRest (COMMA ConstantDeclarator)*)> */
+		nil,
+		/* 25 ConstantDeclarator <- <(Identifier ConstantDeclaratorRest)> */
+		nil,
+		/* 26 ConstantDeclaratorRest <- <(Dim* EQU VariableInitializer)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{26, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position205, tokenIndex205 := position, tokenIndex
+			{
+				position206 := position
+			l207:
+				{
+					position208, tokenIndex208 := position, tokenIndex
+					if !_rules[ruleDim]() {
+						goto l208
+					}
+					goto l207
+				l208:
+					position, tokenIndex = position208, tokenIndex208
+				}
+				if !_rules[ruleEQU]() {
+					goto l205
+				}
+				if !_rules[ruleVariableInitializer]() {
+					goto l205
+				}
+				add(ruleConstantDeclaratorRest, position206)
+			}
+			memoize(26, position205, tokenIndex205, true)
+			return true
+		l205:
+			memoize(26, position205, tokenIndex205, false)
+			position, tokenIndex = position205, tokenIndex205
+			return false
+		},
+		/* 27 EnumDeclaration <- <(ENUM Identifier (IMPLEMENTS ClassTypeList)? EnumBody)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{27, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position209, tokenIndex209 := position, tokenIndex
+			{
+				position210 := position
+				{
+					position211 := position
+					if buffer[position] != rune('e') {
+						fail("'enum'")
+						goto l209
+					}
+					position++
+					if buffer[position] != rune('n') {
+						fail("'n'")
+						goto l209
+					}
+					position++
+					if buffer[position] != rune('u') {
+						fail("'u'")
+						goto l209
+					}
+					position++
+					if buffer[position] != rune('m') {
+						fail("'m'")
+						goto l209
+					}
+					position++
+					{
+						position212, tokenIndex212 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '$':
+								position++
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("'$'")
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l212
+								}
+								position++
+							}
+						}
+
+						goto l209
+					l212:
+						position, tokenIndex = position212, tokenIndex212
+					}
+					{
+						position214, tokenIndex214 := position, tokenIndex
+						if !_rules[ruleLetterOrDigit]() {
+							goto l214
+						}
+						goto l209
+					l214:
+						position, tokenIndex = position214, tokenIndex214
+					}
+					if !_rules[ruleSpacing]() {
+						goto l209
+					}
+					add(ruleENUM, position211)
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l209
+				}
+				{
+					position215, tokenIndex215 := position, tokenIndex
+					if !_rules[ruleIMPLEMENTS]() {
+						goto l215
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l215
+					}
+					goto l216
+				l215:
+					position, tokenIndex = position215, tokenIndex215
+				}
+			l216:
+				{
+					position217 := position
+					if !_rules[ruleLWING]() {
+						goto l209
+					}
+					{
+						position218, tokenIndex218 := position, tokenIndex
+						{
+							position220 := position
+							if !_rules[ruleEnumConstant]() {
+								goto l218
+							}
+						l221:
+							{
+								position222, tokenIndex222 := position, tokenIndex
+								if !_rules[ruleCOMMA]() {
+									goto l222
+								}
+								if !_rules[ruleEnumConstant]() {
+									goto l222
+								}
+								goto l221
+							l222:
+								position, tokenIndex = position222, tokenIndex222
+							}
+							add(ruleEnumConstants, position220)
+						}
+						goto l219
+					l218:
+						position, tokenIndex = position218, tokenIndex218
+					}
+				l219:
+					{
+						position223, tokenIndex223 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l223
+						}
+						goto l224
+					l223:
+						position, tokenIndex = position223, tokenIndex223
+					}
+				l224:
+					{
+						position225, tokenIndex225 := position, tokenIndex
+						{
+							position227 := position
+							if !_rules[ruleSEMI]() {
+								goto l225
+							}
+						l228:
+							{
+								position229, tokenIndex229 := position, tokenIndex
+								if !_rules[ruleClassBodyDeclaration]() {
+									goto l229
+								}
+								goto l228
+							l229:
+								position, tokenIndex = position229, tokenIndex229
+							}
+							add(ruleEnumBodyDeclarations, position227)
+						}
+						goto l226
+					l225:
+						position, tokenIndex = position225, tokenIndex225
+					}
+				l226:
+					if !_rules[ruleRWING]() {
+						goto l209
+					}
+					add(ruleEnumBody, position217)
+				}
+				add(ruleEnumDeclaration, position210)
+			}
+			memoize(27, position209, tokenIndex209, true)
+			return true
+		l209:
+			memoize(27, position209, tokenIndex209, false)
+			position, tokenIndex = position209, tokenIndex209
+			return false
+		},
+		/* 28 EnumBody <- <(LWING EnumConstants? COMMA? EnumBodyDeclarations? RWING)> */
+		nil,
+		/* 29 EnumConstants <- <(EnumConstant (COMMA EnumConstant)*)> */
+		nil,
+		/* 30 EnumConstant <- <(Annotation* Identifier Arguments? ClassBody?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{30, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position232, tokenIndex232 := position, tokenIndex
+			{
+				position233 := position
+			l234:
+				{
+					position235, tokenIndex235 := position, tokenIndex
+					if !_rules[ruleAnnotation]() {
+						goto l235
+					}
+					goto l234
+				l235:
+					position, tokenIndex = position235, tokenIndex235
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l232
+				}
+				{
+					position236, tokenIndex236 := position, tokenIndex
+					if !_rules[ruleArguments]() {
+						goto l236
+					}
+					goto l237
+				l236:
+					position, tokenIndex = position236, tokenIndex236
+				}
+			l237:
+				{
+					position238, tokenIndex238 := position, tokenIndex
+					if !_rules[ruleClassBody]() {
+						goto l238
+					}
+					goto l239
+				l238:
+					position, tokenIndex = position238, tokenIndex238
+				}
+			l239:
+				add(ruleEnumConstant, position233)
+			}
+			memoize(30, position232, tokenIndex232, true)
+			return true
+		l232:
+			memoize(30, position232, tokenIndex232, false)
+			position, tokenIndex = position232, tokenIndex232
+			return false
+		},
+		/* 31 EnumBodyDeclarations <- <(SEMI ClassBodyDeclaration*)> */
+		nil,
+		/* 32 RecordDeclaration <- <(RECORD Identifier TypeParameters? RecordHeader (IMPLEMENTS ClassTypeList)? RecordBody)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{32, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position241, tokenIndex241 := position, tokenIndex
+			{
+				position242 := position
+				{
+					position243 := position
+					if !matchKeyword("record") {
+						fail("'record'")
+						goto l241
+					}
+					if !_rules[ruleSpacing]() {
+						goto l241
+					}
+					add(ruleRECORD, position243)
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l241
+				}
+				{
+					position244, tokenIndex244 := position, tokenIndex
+					if !_rules[ruleTypeParameters]() {
+						goto l244
+					}
+					goto l245
+				l244:
+					position, tokenIndex = position244, tokenIndex244
+				}
+			l245:
+				{
+					position246 := position
+					if !_rules[ruleLPAR]() {
+						goto l241
+					}
+					{
+						position247, tokenIndex247 := position, tokenIndex
+						if !_rules[ruleRecordComponent]() {
+							goto l247
+						}
+					l249:
+						{
+							position250, tokenIndex250 := position, tokenIndex
+							if !_rules[ruleCOMMA]() {
+								goto l250
+							}
+							if !_rules[ruleRecordComponent]() {
+								goto l250
+							}
+							goto l249
+						l250:
+							position, tokenIndex = position250, tokenIndex250
+						}
+						goto l248
+					l247:
+						position, tokenIndex = position247, tokenIndex247
+					}
+				l248:
+					if !_rules[ruleRPAR]() {
+						goto l241
+					}
+					add(ruleRecordHeader, position246)
+				}
+				{
+					position251, tokenIndex251 := position, tokenIndex
+					if !_rules[ruleIMPLEMENTS]() {
+						goto l251
+					}
+					if !_rules[ruleClassTypeList]() {
+						goto l251
+					}
+					goto l252
+				l251:
+					position, tokenIndex = position251, tokenIndex251
+				}
+			l252:
+				{
+					position253 := position
+					if !_rules[ruleLWING]() {
+						goto l241
+					}
+				l254:
+					{
+						position255, tokenIndex255 := position, tokenIndex
+						{
+							position256, tokenIndex256 := position, tokenIndex
+							{
+								position258 := position
+							l259:
+								{
+									position260, tokenIndex260 := position, tokenIndex
+									if !_rules[ruleModifier]() {
+										goto l260
+									}
+									goto l259
+								l260:
+									position, tokenIndex = position260, tokenIndex260
+								}
+								if !_rules[ruleIdentifier]() {
+									goto l257
+								}
+								if !_rules[ruleMethodBody]() {
+									goto l257
+								}
+								add(ruleCompactConstructor, position258)
+							}
+							goto l256
+						l257:
+							position, tokenIndex = position256, tokenIndex256
+							if !_rules[ruleClassBodyDeclaration]() {
+								goto l255
+							}
+						}
+					l256:
+						goto l254
+					l255:
+						position, tokenIndex = position255, tokenIndex255
+					}
+					if !_rules[ruleRWING]() {
+						goto l241
+					}
+					add(ruleRecordBody, position253)
+				}
+				add(ruleRecordDeclaration, position242)
+			}
+			memoize(32, position241, tokenIndex241, true)
+			return true
+		l241:
+			memoize(32, position241, tokenIndex241, false)
+			position, tokenIndex = position241, tokenIndex241
+			return false
+		},
+		/* 33 RecordHeader <- <(LPAR (RecordComponent (COMMA RecordComponent)*)? RPAR)> */
+		nil,
+		/* 34 RecordComponent <- <(Annotation* Type ELLIPSIS? Identifier)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{34, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position262, tokenIndex262 := position, tokenIndex
+			{
+				position263 := position
+			l264:
+				{
+					position265, tokenIndex265 := position, tokenIndex
+					if !_rules[ruleAnnotation]() {
+						goto l265
+					}
+					goto l264
+				l265:
+					position, tokenIndex = position265, tokenIndex265
+				}
+				if !_rules[ruleType]() {
+					goto l262
+				}
+				{
+					position266, tokenIndex266 := position, tokenIndex
+					if !_rules[ruleELLIPSIS]() {
+						goto l266
+					}
+					goto l267
+				l266:
+					position, tokenIndex = position266, tokenIndex266
+				}
+			l267:
+				if !_rules[ruleIdentifier]() {
+					goto l262
+				}
+				add(ruleRecordComponent, position263)
+			}
+			memoize(34, position262, tokenIndex262, true)
+			return true
+		l262:
+			memoize(34, position262, tokenIndex262, false)
+			position, tokenIndex = position262, tokenIndex262
+			return false
+		},
+		/* 35 RecordBody <- <(LWING (CompactConstructor / ClassBodyDeclaration)* RWING)> */
+		nil,
+		/* 36 CompactConstructor <- <(Modifier* Identifier MethodBody)> */
+		nil,
+		/* 37 LocalVariableDeclarationStatement <- <((FINAL / Annotation)* Type VariableDeclarators SEMI)> */
+		nil,
+		/* 38 VariableDeclarators <- <(VariableDeclarator (COMMA VariableDeclarator)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{38, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position271, tokenIndex271 := position, tokenIndex
+			{
+				position272 := position
+				if !_rules[ruleVariableDeclarator]() {
+					goto l271
+				}
+			l273:
+				{
+					position274, tokenIndex274 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l274
+					}
+					if !_rules[ruleVariableDeclarator]() {
+						goto l274
+					}
+					goto l273
+				l274:
+					position, tokenIndex = position274, tokenIndex274
+				}
+				add(ruleVariableDeclarators, position272)
+			}
+			memoize(38, position271, tokenIndex271, true)
+			return true
+		l271:
+			memoize(38, position271, tokenIndex271, false)
+			position, tokenIndex = position271, tokenIndex271
+			return false
+		},
+		/* 39 VariableDeclarator <- <(Identifier Dim* (EQU VariableInitializer)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{39, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position275, tokenIndex275 := position, tokenIndex
+			{
+				position276 := position
+				if !_rules[ruleIdentifier]() {
+					goto l275
+				}
+			l277:
+				{
+					position278, tokenIndex278 := position, tokenIndex
+					if !_rules[ruleDim]() {
+						goto l278
+					}
+					goto l277
+				l278:
+					position, tokenIndex = position278, tokenIndex278
+				}
+				{
+					position279, tokenIndex279 := position, tokenIndex
+					if !_rules[ruleEQU]() {
+						goto l279
+					}
+					if !_rules[ruleVariableInitializer]() {
+						goto l279
+					}
+					goto l280
+				l279:
+					position, tokenIndex = position279, tokenIndex279
+				}
+			l280:
+				add(ruleVariableDeclarator, position276)
+			}
+			memoize(39, position275, tokenIndex275, true)
+			return true
+		l275:
+			memoize(39, position275, tokenIndex275, false)
+			position, tokenIndex = position275, tokenIndex275
+			return false
+		},
+		/* 40 FormalParameters <- <(LPAR FormalParameterList? RPAR)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{40, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position281, tokenIndex281 := position, tokenIndex
+			{
+				position282 := position
+				if !_rules[ruleLPAR]() {
+					goto l281
+				}
+				{
+					position283, tokenIndex283 := position, tokenIndex
+					if !_rules[ruleFormalParameterList]() {
+						goto l283
+					}
+					goto l284
+				l283:
+					position, tokenIndex = position283, tokenIndex283
+				}
+			l284:
+				if !_rules[ruleRPAR]() {
+					goto l281
+				}
+				add(ruleFormalParameters, position282)
+			}
+			memoize(40, position281, tokenIndex281, true)
+			return true
+		l281:
+			memoize(40, position281, tokenIndex281, false)
+			position, tokenIndex = position281, tokenIndex281
+			return false
+		},
+		/* 41 FormalParameter <- <((FINAL / Annotation)* Type VariableDeclaratorId)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{41, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position285, tokenIndex285 := position, tokenIndex
+			{
+				position286 := position
+			l287:
+				{
+					position288, tokenIndex288 := position, tokenIndex
+					{
+						position289, tokenIndex289 := position, tokenIndex
+						if !_rules[ruleFINAL]() {
+							goto l290
+						}
+						goto l289
+					l290:
+						position, tokenIndex = position289, tokenIndex289
+						if !_rules[ruleAnnotation]() {
+							goto l288
+						}
+					}
+				l289:
+					goto l287
+				l288:
+					position, tokenIndex = position288, tokenIndex288
+				}
+				if !_rules[ruleType]() {
+					goto l285
+				}
+				if !_rules[ruleVariableDeclaratorId]() {
+					goto l285
+				}
+				add(ruleFormalParameter, position286)
+			}
+			memoize(41, position285, tokenIndex285, true)
+			return true
+		l285:
+			memoize(41, position285, tokenIndex285, false)
+			position, tokenIndex = position285, tokenIndex285
+			return false
+		},
+		/* 42 LastFormalParameter <- <((FINAL / Annotation)* Type ELLIPSIS VariableDeclaratorId)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{42, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position291, tokenIndex291 := position, tokenIndex
+			{
+				position292 := position
+			l293:
+				{
+					position294, tokenIndex294 := position, tokenIndex
+					{
+						position295, tokenIndex295 := position, tokenIndex
+						if !_rules[ruleFINAL]() {
+							goto l296
+						}
+						goto l295
+					l296:
+						position, tokenIndex = position295, tokenIndex295
+						if !_rules[ruleAnnotation]() {
+							goto l294
+						}
+					}
+				l295:
+					goto l293
+				l294:
+					position, tokenIndex = position294, tokenIndex294
+				}
+				if !_rules[ruleType]() {
+					goto l291
+				}
+				if !_rules[ruleELLIPSIS]() {
+					goto l291
+				}
+				if !_rules[ruleVariableDeclaratorId]() {
+					goto l291
+				}
+				add(ruleLastFormalParameter, position292)
+			}
+			memoize(42, position291, tokenIndex291, true)
+			return true
+		l291:
+			memoize(42, position291, tokenIndex291, false)
+			position, tokenIndex = position291, tokenIndex291
+			return false
+		},
+		/* 43 FormalParameterList <- <((FormalParameter (COMMA FormalParameter)* (COMMA LastFormalParameter)?) / LastFormalParameter)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{43, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position297, tokenIndex297 := position, tokenIndex
+			{
+				position298 := position
+				{
+					position299, tokenIndex299 := position, tokenIndex
+					if !_rules[ruleFormalParameter]() {
+						goto l300
+					}
+				l301:
+					{
+						position302, tokenIndex302 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l302
+						}
+						if !_rules[ruleFormalParameter]() {
+							goto l302
+						}
+						goto l301
+					l302:
+						position, tokenIndex = position302, tokenIndex302
+					}
+					{
+						position303, tokenIndex303 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l303
+						}
+						if !_rules[ruleLastFormalParameter]() {
+							goto l303
+						}
+						goto l304
+					l303:
+						position, tokenIndex = position303, tokenIndex303
+					}
+				l304:
+					goto l299
+				l300:
+					position, tokenIndex = position299, tokenIndex299
+					if !_rules[ruleLastFormalParameter]() {
+						goto l297
+					}
+				}
+			l299:
+				add(ruleFormalParameterList, position298)
+			}
+			memoize(43, position297, tokenIndex297, true)
+			return true
+		l297:
+			memoize(43, position297, tokenIndex297, false)
+			position, tokenIndex = position297, tokenIndex297
+			return false
+		},
+		/* 44 VariableDeclaratorId <- <(Identifier Dim*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{44, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position305, tokenIndex305 := position, tokenIndex
+			{
+				position306 := position
+				if !_rules[ruleIdentifier]() {
+					goto l305
+				}
+			l307:
+				{
+					position308, tokenIndex308 := position, tokenIndex
+					if !_rules[ruleDim]() {
+						goto l308
+					}
+					goto l307
+				l308:
+					position, tokenIndex = position308, tokenIndex308
+				}
+				add(ruleVariableDeclaratorId, position306)
+			}
+			memoize(44, position305, tokenIndex305, true)
+			return true
+		l305:
+			memoize(44, position305, tokenIndex305, false)
+			position, tokenIndex = position305, tokenIndex305
+			return false
+		},
+		/* 45 Block <- <(LWING BlockStatements RWING)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{45, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position309, tokenIndex309 := position, tokenIndex
+			{
+				position310 := position
+				if !_rules[ruleLWING]() {
+					goto l309
+				}
+				if !_rules[ruleBlockStatements]() {
+					goto l309
+				}
+				if !_rules[ruleRWING]() {
+					goto l309
+				}
+				add(ruleBlock, position310)
+			}
+			memoize(45, position309, tokenIndex309, true)
+			return true
+		l309:
+			memoize(45, position309, tokenIndex309, false)
+			position, tokenIndex = position309, tokenIndex309
+			return false
+		},
+		/* 46 BlockStatements <- <BlockStatement*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{46, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position311, tokenIndex311 := position, tokenIndex
+			{
+				position312 := position
+			l313:
+				{
+					position314, tokenIndex314 := position, tokenIndex
+					{
+						position315 := position
+						{
+							position316, tokenIndex316 := position, tokenIndex
+							{
+								position318 := position
+							l319:
+								{
+									position320, tokenIndex320 := position, tokenIndex
+									{
+										position321, tokenIndex321 := position, tokenIndex
+										if !_rules[ruleFINAL]() {
+											goto l322
+										}
+										goto l321
+									l322:
+										position, tokenIndex = position321, tokenIndex321
+										if !_rules[ruleAnnotation]() {
+											goto l320
+										}
+									}
+								l321:
+									goto l319
+								l320:
+									position, tokenIndex = position320, tokenIndex320
+								}
+								if !_rules[ruleType]() {
+									goto l317
+								}
+								if !_rules[ruleVariableDeclarators]() {
+									goto l317
+								}
+								if !_rules[ruleSEMI]() {
+									goto l317
+								}
+								add(ruleLocalVariableDeclarationStatement, position318)
+							}
+							goto l316
+						l317:
+							position, tokenIndex = position316, tokenIndex316
+						l324:
+							{
+								position325, tokenIndex325 := position, tokenIndex
+								if !_rules[ruleModifier]() {
+									goto l325
+								}
+								goto l324
+							l325:
+								position, tokenIndex = position325, tokenIndex325
+							}
+							{
+								switch buffer[position] {
+								case 'e':
+									if !_rules[ruleEnumDeclaration]() {
+										goto l323
+									}
+								case 'i':
+									if !_rules[ruleInterfaceDeclaration]() {
+										goto l323
+									}
+								case 'r':
+									if !_rules[ruleRecordDeclaration]() {
+										goto l323
+									}
+								default:
+									fail("'enum'")
+									fail("'interface'")
+									fail("'record'")
+									if !_rules[ruleClassDeclaration]() {
+										goto l323
+									}
+								}
+							}
+
+							goto l316
+						l323:
+							position, tokenIndex = position316, tokenIndex316
+							if !_rules[ruleStatement]() {
+								goto l314
+							}
+						}
+					l316:
+						add(ruleBlockStatement, position315)
+					}
+					goto l313
+				l314:
+					position, tokenIndex = position314, tokenIndex314
+				}
+				add(ruleBlockStatements, position312)
+			}
+			memoize(46, position311, tokenIndex311, true)
+			return true
+		},
+		/* 47 BlockStatement <- <(LocalVariableDeclarationStatement / (Modifier* ((&('e') EnumDeclaration) | (&('i') InterfaceDeclaration) | (&('r') RecordDeclaration) | (&('c') %fail('enum' 'interface' 'record') ClassDeclaration))) / Statement)> */
+		nil,
+		/* 48 Statement <- <((&('!' | '"' | '\'' | '(' | '+' | '-' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '<' | '~') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';' '_' '$' [A-Z] [a-z]) (StatementExpression SEMI)) | (&(';') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' '_' '$' [A-Z] [a-z] '(' '++' '+' '--' '-' '~' '!' '<' 'this' 'super' '0' [0-9] '.' [1-9] '\'' '"""' '"' 'true' 'false' 'null' 'new' 'char' 'double' 'float' 'int' 'long' 'short' 'b' 'void') SEMI) | (&('a') %fail('{' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';') ((ASSERT Expression (COLON Expression)? SEMI) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('b') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'continue' 'yield' ';') ((BREAK Identifier? SEMI) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('c') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'yield' ';') ((CONTINUE Identifier? SEMI) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('d') %fail('{' 'assert' 'if' 'for' 'while' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';') ((DO Statement WHILE ParExpression SEMI) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('f') %fail('{' 'assert' 'if' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';') ((FOR LPAR ((ForInit? SEMI Expression? SEMI ForUpdate? RPAR Statement) / (FormalParameter COLON Expression RPAR Statement))) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('i') %fail('{' 'assert' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';') ((IF ParExpression Statement (ELSE Statement)?) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('r') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'throw' 'break' 'continue' 'yield' ';') ((RETURN Expression? SEMI) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('s') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'return' 'throw' 'break' 'continue' 'yield' ';') ((SWITCH ParExpression SwitchBody) / (SYNCHRONIZED ParExpression Block) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('t') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'switch' 'synchronized' 'return' 'break' 'continue' 'yield' ';') ((TRY ((LPAR Resource (SEMI Resource)* SEMI? RPAR Block Catch* Finally?) / (Block ((Catch+ Finally?) / Finally)))) / (THROW Expression SEMI) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('w') %fail('{' 'assert' 'if' 'for' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';') ((WHILE ParExpression Statement) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('y') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' ';') ((YIELD Expression SEMI) / (StatementExpression SEMI) / (Identifier COLON Statement))) | (&('{') %fail('assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';' '_' '$' [A-Z] [a-z] '(' '++' '+' '--' '-' '~' '!' '<' 'this' 'super' '0' [0-9] '.' [1-9] '\'' '"""' '"' 'true' 'false' 'null' 'new' 'char' 'double' 'float' 'int' 'long' 'short' 'b' 'void') Block) | (&('$' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'e' | 'g' | 'h' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'u' | 'v' | 'x' | 'z') %fail('{' 'assert' 'if' 'for' 'while' 'do' 'try' 'switch' 'synchronized' 'return' 'throw' 'break' 'continue' 'yield' ';') ((StatementExpression SEMI) / (Identifier COLON Statement))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{48, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position328, tokenIndex328 := position, tokenIndex
+			{
+				position329 := position
+				{
+					switch buffer[position] {
+					case '!', '"', '\'', '(', '+', '-', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '<', '~':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						fail("'_'")
+						fail("'$'")
+						fail("[A-Z]")
+						fail("[a-z]")
+						if !_rules[ruleStatementExpression]() {
+							goto l328
+						}
+						if !_rules[ruleSEMI]() {
+							goto l328
+						}
+					case ';':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("'_'")
+						fail("'$'")
+						fail("[A-Z]")
+						fail("[a-z]")
+						fail("'('")
+						fail("'++'")
+						fail("'+'")
+						fail("'--'")
+						fail("'-'")
+						fail("'~'")
+						fail("'!'")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'0'")
+						fail("[0-9]")
+						fail("'.'")
+						fail("[1-9]")
+						fail("'\\''")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'true'")
+						fail("'false'")
+						fail("'null'")
+						fail("'new'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'void'")
+						if !_rules[ruleSEMI]() {
+							goto l328
+						}
+					case 'a':
+						fail("'{'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position331, tokenIndex331 := position, tokenIndex
+							{
+								position333 := position
+								if buffer[position] != rune('a') {
+									fail("'assert'")
+									goto l332
+								}
+								position++
+								if buffer[position] != rune('s') {
+									fail("'s'")
+									goto l332
+								}
+								position++
+								if buffer[position] != rune('s') {
+									fail("'s'")
+									goto l332
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l332
+								}
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l332
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l332
+								}
+								position++
+								{
+									position334, tokenIndex334 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l334
+											}
+											position++
+										}
+									}
+
+									goto l332
+								l334:
+									position, tokenIndex = position334, tokenIndex334
+								}
+								{
+									position336, tokenIndex336 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l336
+									}
+									goto l332
+								l336:
+									position, tokenIndex = position336, tokenIndex336
+								}
+								if !_rules[ruleSpacing]() {
+									goto l332
+								}
+								add(ruleASSERT, position333)
+							}
+							if !_rules[ruleExpression]() {
+								goto l332
+							}
+							{
+								position337, tokenIndex337 := position, tokenIndex
+								if !_rules[ruleCOLON]() {
+									goto l337
+								}
+								if !_rules[ruleExpression]() {
+									goto l337
+								}
+								goto l338
+							l337:
+								position, tokenIndex = position337, tokenIndex337
+							}
+						l338:
+							if !_rules[ruleSEMI]() {
+								goto l332
+							}
+							goto l331
+						l332:
+							position, tokenIndex = position331, tokenIndex331
+							if !_rules[ruleStatementExpression]() {
+								goto l339
+							}
+							if !_rules[ruleSEMI]() {
+								goto l339
+							}
+							goto l331
+						l339:
+							position, tokenIndex = position331, tokenIndex331
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l331:
+						break
+					case 'b':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position340, tokenIndex340 := position, tokenIndex
+							{
+								position342 := position
+								if buffer[position] != rune('b') {
+									fail("'break'")
+									goto l341
+								}
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l341
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l341
+								}
+								position++
+								if buffer[position] != rune('a') {
+									fail("'a'")
+									goto l341
+								}
+								position++
+								if buffer[position] != rune('k') {
+									fail("'k'")
+									goto l341
+								}
+								position++
+								{
+									position343, tokenIndex343 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l343
+											}
+											position++
+										}
+									}
+
+									goto l341
+								l343:
+									position, tokenIndex = position343, tokenIndex343
+								}
+								{
+									position345, tokenIndex345 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l345
+									}
+									goto l341
+								l345:
+									position, tokenIndex = position345, tokenIndex345
+								}
+								if !_rules[ruleSpacing]() {
+									goto l341
+								}
+								add(ruleBREAK, position342)
+							}
+							{
+								position346, tokenIndex346 := position, tokenIndex
+								if !_rules[ruleIdentifier]() {
+									goto l346
+								}
+								goto l347
+							l346:
+								position, tokenIndex = position346, tokenIndex346
+							}
+						l347:
+							if !_rules[ruleSEMI]() {
+								goto l341
+							}
+							goto l340
+						l341:
+							position, tokenIndex = position340, tokenIndex340
+							if !_rules[ruleStatementExpression]() {
+								goto l348
+							}
+							if !_rules[ruleSEMI]() {
+								goto l348
+							}
+							goto l340
+						l348:
+							position, tokenIndex = position340, tokenIndex340
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l340:
+						break
+					case 'c':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position349, tokenIndex349 := position, tokenIndex
+							{
+								position351 := position
+								if buffer[position] != rune('c') {
+									fail("'continue'")
+									goto l350
+								}
+								position++
+								if buffer[position] != rune('o') {
+									fail("'o'")
+									goto l350
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l350
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l350
+								}
+								position++
+								if buffer[position] != rune('i') {
+									fail("'i'")
+									goto l350
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l350
+								}
+								position++
+								if buffer[position] != rune('u') {
+									fail("'u'")
+									goto l350
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l350
+								}
+								position++
+								{
+									position352, tokenIndex352 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l352
+											}
+											position++
+										}
+									}
+
+									goto l350
+								l352:
+									position, tokenIndex = position352, tokenIndex352
+								}
+								{
+									position354, tokenIndex354 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l354
+									}
+									goto l350
+								l354:
+									position, tokenIndex = position354, tokenIndex354
+								}
+								if !_rules[ruleSpacing]() {
+									goto l350
+								}
+								add(ruleCONTINUE, position351)
+							}
+							{
+								position355, tokenIndex355 := position, tokenIndex
+								if !_rules[ruleIdentifier]() {
+									goto l355
+								}
+								goto l356
+							l355:
+								position, tokenIndex = position355, tokenIndex355
+							}
+						l356:
+							if !_rules[ruleSEMI]() {
+								goto l350
+							}
+							goto l349
+						l350:
+							position, tokenIndex = position349, tokenIndex349
+							if !_rules[ruleStatementExpression]() {
+								goto l357
+							}
+							if !_rules[ruleSEMI]() {
+								goto l357
+							}
+							goto l349
+						l357:
+							position, tokenIndex = position349, tokenIndex349
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l349:
+						break
+					case 'd':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position358, tokenIndex358 := position, tokenIndex
+							{
+								position360 := position
+								if buffer[position] != rune('d') {
+									fail("'do'")
+									goto l359
+								}
+								position++
+								if buffer[position] != rune('o') {
+									fail("'o'")
+									goto l359
+								}
+								position++
+								{
+									position361, tokenIndex361 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l361
+											}
+											position++
+										}
+									}
+
+									goto l359
+								l361:
+									position, tokenIndex = position361, tokenIndex361
+								}
+								{
+									position363, tokenIndex363 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l363
+									}
+									goto l359
+								l363:
+									position, tokenIndex = position363, tokenIndex363
+								}
+								if !_rules[ruleSpacing]() {
+									goto l359
+								}
+								add(ruleDO, position360)
+							}
+							if !_rules[ruleStatement]() {
+								goto l359
+							}
+							if !_rules[ruleWHILE]() {
+								goto l359
+							}
+							if !_rules[ruleParExpression]() {
+								goto l359
+							}
+							if !_rules[ruleSEMI]() {
+								goto l359
+							}
+							goto l358
+						l359:
+							position, tokenIndex = position358, tokenIndex358
+							if !_rules[ruleStatementExpression]() {
+								goto l364
+							}
+							if !_rules[ruleSEMI]() {
+								goto l364
+							}
+							goto l358
+						l364:
+							position, tokenIndex = position358, tokenIndex358
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l358:
+						break
+					case 'f':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position365, tokenIndex365 := position, tokenIndex
+							{
+								position367 := position
+								if buffer[position] != rune('f') {
+									fail("'for'")
+									goto l366
+								}
+								position++
+								if buffer[position] != rune('o') {
+									fail("'o'")
+									goto l366
+								}
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l366
+								}
+								position++
+								{
+									position368, tokenIndex368 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l368
+											}
+											position++
+										}
+									}
+
+									goto l366
+								l368:
+									position, tokenIndex = position368, tokenIndex368
+								}
+								{
+									position370, tokenIndex370 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l370
+									}
+									goto l366
+								l370:
+									position, tokenIndex = position370, tokenIndex370
+								}
+								if !_rules[ruleSpacing]() {
+									goto l366
+								}
+								add(ruleFOR, position367)
+							}
+							if !_rules[ruleLPAR]() {
+								goto l366
+							}
+							{
+								position371, tokenIndex371 := position, tokenIndex
+								{
+									position373, tokenIndex373 := position, tokenIndex
+									{
+										position375 := position
+										{
+											position376, tokenIndex376 := position, tokenIndex
+										l378:
+											{
+												position379, tokenIndex379 := position, tokenIndex
+												{
+													position380, tokenIndex380 := position, tokenIndex
+													if !_rules[ruleFINAL]() {
+														goto l381
+													}
+													goto l380
+												l381:
+													position, tokenIndex = position380, tokenIndex380
+													if !_rules[ruleAnnotation]() {
+														goto l379
+													}
+												}
+											l380:
+												goto l378
+											l379:
+												position, tokenIndex = position379, tokenIndex379
+											}
+											if !_rules[ruleType]() {
+												goto l377
+											}
+											if !_rules[ruleVariableDeclarators]() {
+												goto l377
+											}
+											goto l376
+										l377:
+											position, tokenIndex = position376, tokenIndex376
+											if !_rules[ruleStatementExpression]() {
+												goto l373
+											}
+										l382:
+											{
+												position383, tokenIndex383 := position, tokenIndex
+												if !_rules[ruleCOMMA]() {
+													goto l383
+												}
+												if !_rules[ruleStatementExpression]() {
+													goto l383
+												}
+												goto l382
+											l383:
+												position, tokenIndex = position383, tokenIndex383
+											}
+										}
+									l376:
+										add(ruleForInit, position375)
+									}
+									goto l374
+								l373:
+									position, tokenIndex = position373, tokenIndex373
+								}
+							l374:
+								if !_rules[ruleSEMI]() {
+									goto l372
+								}
+								{
+									position384, tokenIndex384 := position, tokenIndex
+									if !_rules[ruleExpression]() {
+										goto l384
+									}
+									goto l385
+								l384:
+									position, tokenIndex = position384, tokenIndex384
+								}
+							l385:
+								if !_rules[ruleSEMI]() {
+									goto l372
+								}
+								{
+									position386, tokenIndex386 := position, tokenIndex
+									{
+										position388 := position
+										if !_rules[ruleStatementExpression]() {
+											goto l386
+										}
+									l389:
+										{
+											position390, tokenIndex390 := position, tokenIndex
+											if !_rules[ruleCOMMA]() {
+												goto l390
+											}
+											if !_rules[ruleStatementExpression]() {
+												goto l390
+											}
+											goto l389
+										l390:
+											position, tokenIndex = position390, tokenIndex390
+										}
+										add(ruleForUpdate, position388)
+									}
+									goto l387
+								l386:
+									position, tokenIndex = position386, tokenIndex386
+								}
+							l387:
+								if !_rules[ruleRPAR]() {
+									goto l372
+								}
+								if !_rules[ruleStatement]() {
+									goto l372
+								}
+								goto l371
+							l372:
+								position, tokenIndex = position371, tokenIndex371
+								if !_rules[ruleFormalParameter]() {
+									goto l366
+								}
+								if !_rules[ruleCOLON]() {
+									goto l366
+								}
+								if !_rules[ruleExpression]() {
+									goto l366
+								}
+								if !_rules[ruleRPAR]() {
+									goto l366
+								}
+								if !_rules[ruleStatement]() {
+									goto l366
+								}
+							}
+						l371:
+							goto l365
+						l366:
+							position, tokenIndex = position365, tokenIndex365
+							if !_rules[ruleStatementExpression]() {
+								goto l391
+							}
+							if !_rules[ruleSEMI]() {
+								goto l391
+							}
+							goto l365
+						l391:
+							position, tokenIndex = position365, tokenIndex365
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l365:
+						break
+					case 'i':
+						fail("'{'")
+						fail("'assert'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position392, tokenIndex392 := position, tokenIndex
+							{
+								position394 := position
+								if buffer[position] != rune('i') {
+									fail("'if'")
+									goto l393
+								}
+								position++
+								if buffer[position] != rune('f') {
+									fail("'f'")
+									goto l393
+								}
+								position++
+								{
+									position395, tokenIndex395 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l395
+											}
+											position++
+										}
+									}
+
+									goto l393
+								l395:
+									position, tokenIndex = position395, tokenIndex395
+								}
+								{
+									position397, tokenIndex397 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l397
+									}
+									goto l393
+								l397:
+									position, tokenIndex = position397, tokenIndex397
+								}
+								if !_rules[ruleSpacing]() {
+									goto l393
+								}
+								add(ruleIF, position394)
+							}
+							if !_rules[ruleParExpression]() {
+								goto l393
+							}
+							if !_rules[ruleStatement]() {
+								goto l393
+							}
+							{
+								position398, tokenIndex398 := position, tokenIndex
+								{
+									position400 := position
+									if buffer[position] != rune('e') {
+										fail("'else'")
+										goto l398
+									}
+									position++
+									if buffer[position] != rune('l') {
+										fail("'l'")
+										goto l398
+									}
+									position++
+									if buffer[position] != rune('s') {
+										fail("'s'")
+										goto l398
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l398
+									}
+									position++
+									{
+										position401, tokenIndex401 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l401
+												}
+												position++
+											}
+										}
+
+										goto l398
+									l401:
+										position, tokenIndex = position401, tokenIndex401
+									}
+									{
+										position403, tokenIndex403 := position, tokenIndex
+										if !_rules[ruleLetterOrDigit]() {
+											goto l403
+										}
+										goto l398
+									l403:
+										position, tokenIndex = position403, tokenIndex403
+									}
+									if !_rules[ruleSpacing]() {
+										goto l398
+									}
+									add(ruleELSE, position400)
+								}
+								if !_rules[ruleStatement]() {
+									goto l398
+								}
+								goto l399
+							l398:
+								position, tokenIndex = position398, tokenIndex398
+							}
+						l399:
+							goto l392
+						l393:
+							position, tokenIndex = position392, tokenIndex392
+							if !_rules[ruleStatementExpression]() {
+								goto l404
+							}
+							if !_rules[ruleSEMI]() {
+								goto l404
+							}
+							goto l392
+						l404:
+							position, tokenIndex = position392, tokenIndex392
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l392:
+						break
+					case 'r':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position405, tokenIndex405 := position, tokenIndex
+							{
+								position407 := position
+								if buffer[position] != rune('r') {
+									fail("'return'")
+									goto l406
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l406
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l406
+								}
+								position++
+								if buffer[position] != rune('u') {
+									fail("'u'")
+									goto l406
+								}
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l406
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l406
+								}
+								position++
+								{
+									position408, tokenIndex408 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l408
+											}
+											position++
+										}
+									}
+
+									goto l406
+								l408:
+									position, tokenIndex = position408, tokenIndex408
+								}
+								{
+									position410, tokenIndex410 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l410
+									}
+									goto l406
+								l410:
+									position, tokenIndex = position410, tokenIndex410
+								}
+								if !_rules[ruleSpacing]() {
+									goto l406
+								}
+								add(ruleRETURN, position407)
+							}
+							{
+								position411, tokenIndex411 := position, tokenIndex
+								if !_rules[ruleExpression]() {
+									goto l411
+								}
+								goto l412
+							l411:
+								position, tokenIndex = position411, tokenIndex411
+							}
+						l412:
+							if !_rules[ruleSEMI]() {
+								goto l406
+							}
+							goto l405
+						l406:
+							position, tokenIndex = position405, tokenIndex405
+							if !_rules[ruleStatementExpression]() {
+								goto l413
+							}
+							if !_rules[ruleSEMI]() {
+								goto l413
+							}
+							goto l405
+						l413:
+							position, tokenIndex = position405, tokenIndex405
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l405:
+						break
+					case 's':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position414, tokenIndex414 := position, tokenIndex
+							if !_rules[ruleSWITCH]() {
+								goto l415
+							}
+							if !_rules[ruleParExpression]() {
+								goto l415
+							}
+							if !_rules[ruleSwitchBody]() {
+								goto l415
+							}
+							goto l414
+						l415:
+							position, tokenIndex = position414, tokenIndex414
+							{
+								position417 := position
+								if buffer[position] != rune('s') {
+									fail("'synchronized'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('y') {
+									fail("'y'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('c') {
+									fail("'c'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('h') {
+									fail("'h'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('o') {
+									fail("'o'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('i') {
+									fail("'i'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('z') {
+									fail("'z'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l416
+								}
+								position++
+								if buffer[position] != rune('d') {
+									fail("'d'")
+									goto l416
+								}
+								position++
+								{
+									position418, tokenIndex418 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l418
+											}
+											position++
+										}
+									}
+
+									goto l416
+								l418:
+									position, tokenIndex = position418, tokenIndex418
+								}
+								{
+									position420, tokenIndex420 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l420
+									}
+									goto l416
+								l420:
+									position, tokenIndex = position420, tokenIndex420
+								}
+								if !_rules[ruleSpacing]() {
+									goto l416
+								}
+								add(ruleSYNCHRONIZED, position417)
+							}
+							if !_rules[ruleParExpression]() {
+								goto l416
+							}
+							if !_rules[ruleBlock]() {
+								goto l416
+							}
+							goto l414
+						l416:
+							position, tokenIndex = position414, tokenIndex414
+							if !_rules[ruleStatementExpression]() {
+								goto l421
+							}
+							if !_rules[ruleSEMI]() {
+								goto l421
+							}
+							goto l414
+						l421:
+							position, tokenIndex = position414, tokenIndex414
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l414:
+						break
+					case 't':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position422, tokenIndex422 := position, tokenIndex
+							{
+								position424 := position
+								if buffer[position] != rune('t') {
+									fail("'try'")
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l423
+								}
+								position++
+								if buffer[position] != rune('y') {
+									fail("'y'")
+									goto l423
+								}
+								position++
+								{
+									position425, tokenIndex425 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l425
+											}
+											position++
+										}
+									}
+
+									goto l423
+								l425:
+									position, tokenIndex = position425, tokenIndex425
+								}
+								{
+									position427, tokenIndex427 := position, tokenIndex
+									if !_rules[ruleLetterOrDigit]() {
+										goto l427
+									}
+									goto l423
+								l427:
+									position, tokenIndex = position427, tokenIndex427
+								}
+								if !_rules[ruleSpacing]() {
+									goto l423
+								}
+								add(ruleTRY, position424)
+							}
+							{
+								position428, tokenIndex428 := position, tokenIndex
+								if !_rules[ruleLPAR]() {
+									goto l429
+								}
+								if !_rules[ruleResource]() {
+									goto l429
+								}
+							l430:
+								{
+									position431, tokenIndex431 := position, tokenIndex
+									if !_rules[ruleSEMI]() {
+										goto l431
+									}
+									if !_rules[ruleResource]() {
+										goto l431
+									}
+									goto l430
+								l431:
+									position, tokenIndex = position431, tokenIndex431
+								}
+								{
+									position432, tokenIndex432 := position, tokenIndex
+									if !_rules[ruleSEMI]() {
+										goto l432
+									}
+									goto l433
+								l432:
+									position, tokenIndex = position432, tokenIndex432
+								}
+							l433:
+								if !_rules[ruleRPAR]() {
+									goto l429
+								}
+								if !_rules[ruleBlock]() {
+									goto l429
+								}
+							l434:
+								{
+									position435, tokenIndex435 := position, tokenIndex
+									if !_rules[ruleCatch]() {
+										goto l435
+									}
+									goto l434
+								l435:
+									position, tokenIndex = position435, tokenIndex435
+								}
+								{
+									position436, tokenIndex436 := position, tokenIndex
+									if !_rules[ruleFinally]() {
+										goto l436
+									}
+									goto l437
+								l436:
+									position, tokenIndex = position436, tokenIndex436
+								}
+							l437:
+								goto l428
+							l429:
+								position, tokenIndex = position428, tokenIndex428
+								if !_rules[ruleBlock]() {
+									goto l423
+								}
+								{
+									position438, tokenIndex438 := position, tokenIndex
+									if !_rules[ruleCatch]() {
+										goto l439
+									}
+								l440:
+									{
+										position441, tokenIndex441 := position, tokenIndex
+										if !_rules[ruleCatch]() {
+											goto l441
+										}
+										goto l440
+									l441:
+										position, tokenIndex = position441, tokenIndex441
+									}
+									{
+										position442, tokenIndex442 := position, tokenIndex
+										if !_rules[ruleFinally]() {
+											goto l442
+										}
+										goto l443
+									l442:
+										position, tokenIndex = position442, tokenIndex442
+									}
+								l443:
+									goto l438
+								l439:
+									position, tokenIndex = position438, tokenIndex438
+									if !_rules[ruleFinally]() {
+										goto l423
+									}
+								}
+							l438:
+							}
+						l428:
+							goto l422
+						l423:
+							position, tokenIndex = position422, tokenIndex422
+							if !_rules[ruleTHROW]() {
+								goto l444
+							}
+							if !_rules[ruleExpression]() {
+								goto l444
+							}
+							if !_rules[ruleSEMI]() {
+								goto l444
+							}
+							goto l422
+						l444:
+							position, tokenIndex = position422, tokenIndex422
+							if !_rules[ruleStatementExpression]() {
+								goto l445
+							}
+							if !_rules[ruleSEMI]() {
+								goto l445
+							}
+							goto l422
+						l445:
+							position, tokenIndex = position422, tokenIndex422
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l422:
+						break
+					case 'w':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position446, tokenIndex446 := position, tokenIndex
+							if !_rules[ruleWHILE]() {
+								goto l447
+							}
+							if !_rules[ruleParExpression]() {
+								goto l447
+							}
+							if !_rules[ruleStatement]() {
+								goto l447
+							}
+							goto l446
+						l447:
+							position, tokenIndex = position446, tokenIndex446
+							if !_rules[ruleStatementExpression]() {
+								goto l448
+							}
+							if !_rules[ruleSEMI]() {
+								goto l448
+							}
+							goto l446
+						l448:
+							position, tokenIndex = position446, tokenIndex446
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l446:
+						break
+					case 'y':
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("';'")
+						{
+							position449, tokenIndex449 := position, tokenIndex
+							{
+								position451 := position
+								if !matchKeyword("yield") {
+									fail("'yield'")
+									goto l450
+								}
+								if !_rules[ruleSpacing]() {
+									goto l450
+								}
+								add(ruleYIELD, position451)
+							}
+							if !_rules[ruleExpression]() {
+								goto l450
+							}
+							if !_rules[ruleSEMI]() {
+								goto l450
+							}
+							goto l449
+						l450:
+							position, tokenIndex = position449, tokenIndex449
+							if !_rules[ruleStatementExpression]() {
+								goto l452
+							}
+							if !_rules[ruleSEMI]() {
+								goto l452
+							}
+							goto l449
+						l452:
+							position, tokenIndex = position449, tokenIndex449
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l449:
+						break
+					case '{':
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						fail("'_'")
+						fail("'$'")
+						fail("[A-Z]")
+						fail("[a-z]")
+						fail("'('")
+						fail("'++'")
+						fail("'+'")
+						fail("'--'")
+						fail("'-'")
+						fail("'~'")
+						fail("'!'")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'0'")
+						fail("[0-9]")
+						fail("'.'")
+						fail("[1-9]")
+						fail("'\\''")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'true'")
+						fail("'false'")
+						fail("'null'")
+						fail("'new'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'void'")
+						if !_rules[ruleBlock]() {
+							goto l328
+						}
+					default:
+						fail("'{'")
+						fail("'assert'")
+						fail("'if'")
+						fail("'for'")
+						fail("'while'")
+						fail("'do'")
+						fail("'try'")
+						fail("'switch'")
+						fail("'synchronized'")
+						fail("'return'")
+						fail("'throw'")
+						fail("'break'")
+						fail("'continue'")
+						fail("'yield'")
+						fail("';'")
+						{
+							position453, tokenIndex453 := position, tokenIndex
+							if !_rules[ruleStatementExpression]() {
+								goto l454
+							}
+							if !_rules[ruleSEMI]() {
+								goto l454
+							}
+							goto l453
+						l454:
+							position, tokenIndex = position453, tokenIndex453
+							if !_rules[ruleIdentifier]() {
+								goto l328
+							}
+							if !_rules[ruleCOLON]() {
+								goto l328
+							}
+							if !_rules[ruleStatement]() {
+								goto l328
+							}
+						}
+					l453:
+						break
+					}
+				}
+
+				add(ruleStatement, position329)
+			}
+			memoize(48, position328, tokenIndex328, true)
+			return true
+		l328:
+			memoize(48, position328, tokenIndex328, false)
+			position, tokenIndex = position328, tokenIndex328
+			return false
+		},
+		/* 49 Resource <- <((Modifier* Type VariableDeclaratorId EQU Expression) / QualifiedIdentifier)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{49, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position455, tokenIndex455 := position, tokenIndex
+			{
+				position456 := position
+				{
+					position457, tokenIndex457 := position, tokenIndex
+				l459:
+					{
+						position460, tokenIndex460 := position, tokenIndex
+						if !_rules[ruleModifier]() {
+							goto l460
+						}
+						goto l459
+					l460:
+						position, tokenIndex = position460, tokenIndex460
+					}
+					if !_rules[ruleType]() {
+						goto l458
+					}
+					if !_rules[ruleVariableDeclaratorId]() {
+						goto l458
+					}
+					if !_rules[ruleEQU]() {
+						goto l458
+					}
+					if !_rules[ruleExpression]() {
+						goto l458
+					}
+					goto l457
+				l458:
+					position, tokenIndex = position457, tokenIndex457
+					if !_rules[ruleQualifiedIdentifier]() {
+						goto l455
+					}
+				}
+			l457:
+				add(ruleResource, position456)
+			}
+			memoize(49, position455, tokenIndex455, true)
+			return true
+		l455:
+			memoize(49, position455, tokenIndex455, false)
+			position, tokenIndex = position455, tokenIndex455
+			return false
+		},
+		/* 50 Catch <- <(CATCH LPAR (FINAL / Annotation)* Type (OR Type)* VariableDeclaratorId RPAR Block)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{50, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position461, tokenIndex461 := position, tokenIndex
+			{
+				position462 := position
+				{
+					position463 := position
+					if buffer[position] != rune('c') {
+						fail("'catch'")
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('a') {
+						fail("'a'")
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('t') {
+						fail("'t'")
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('c') {
+						fail("'c'")
+						goto l461
+					}
+					position++
+					if buffer[position] != rune('h') {
+						fail("'h'")
+						goto l461
+					}
+					position++
+					{
+						position464, tokenIndex464 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '$':
+								position++
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("'$'")
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l464
+								}
+								position++
+							}
+						}
+
+						goto l461
+					l464:
+						position, tokenIndex = position464, tokenIndex464
+					}
+					{
+						position466, tokenIndex466 := position, tokenIndex
+						if !_rules[ruleLetterOrDigit]() {
+							goto l466
+						}
+						goto l461
+					l466:
+						position, tokenIndex = position466, tokenIndex466
+					}
+					if !_rules[ruleSpacing]() {
+						goto l461
+					}
+					add(ruleCATCH, position463)
+				}
+				if !_rules[ruleLPAR]() {
+					goto l461
+				}
+			l467:
+				{
+					position468, tokenIndex468 := position, tokenIndex
+					{
+						position469, tokenIndex469 := position, tokenIndex
+						if !_rules[ruleFINAL]() {
+							goto l470
+						}
+						goto l469
+					l470:
+						position, tokenIndex = position469, tokenIndex469
+						if !_rules[ruleAnnotation]() {
+							goto l468
+						}
+					}
+				l469:
+					goto l467
+				l468:
+					position, tokenIndex = position468, tokenIndex468
+				}
+				if !_rules[ruleType]() {
+					goto l461
+				}
+			l471:
+				{
+					position472, tokenIndex472 := position, tokenIndex
+					if !_rules[ruleOR]() {
+						goto l472
+					}
+					if !_rules[ruleType]() {
+						goto l472
+					}
+					goto l471
+				l472:
+					position, tokenIndex = position472, tokenIndex472
+				}
+				if !_rules[ruleVariableDeclaratorId]() {
+					goto l461
+				}
+				if !_rules[ruleRPAR]() {
+					goto l461
+				}
+				if !_rules[ruleBlock]() {
+					goto l461
+				}
+				add(ruleCatch, position462)
+			}
+			memoize(50, position461, tokenIndex461, true)
+			return true
+		l461:
+			memoize(50, position461, tokenIndex461, false)
+			position, tokenIndex = position461, tokenIndex461
+			return false
+		},
+		/* 51 Finally <- <(FINALLY Block)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{51, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position473, tokenIndex473 := position, tokenIndex
+			{
+				position474 := position
+				{
+					position475 := position
+					if buffer[position] != rune('f') {
+						fail("'finally'")
+						goto l473
+					}
+					position++
+					if buffer[position] != rune('i') {
+						fail("'i'")
+						goto l473
+					}
+					position++
+					if buffer[position] != rune('n') {
+						fail("'n'")
+						goto l473
+					}
+					position++
+					if buffer[position] != rune('a') {
+						fail("'a'")
+						goto l473
+					}
+					position++
+					if buffer[position] != rune('l') {
+						fail("'l'")
+						goto l473
+					}
+					position++
+					if buffer[position] != rune('l') {
+						fail("'l'")
+						goto l473
+					}
+					position++
+					if buffer[position] != rune('y') {
+						fail("'y'")
+						goto l473
+					}
+					position++
+					{
+						position476, tokenIndex476 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '$':
+								position++
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("'$'")
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l476
+								}
+								position++
+							}
+						}
+
+						goto l473
+					l476:
+						position, tokenIndex = position476, tokenIndex476
+					}
+					{
+						position478, tokenIndex478 := position, tokenIndex
+						if !_rules[ruleLetterOrDigit]() {
+							goto l478
+						}
+						goto l473
+					l478:
+						position, tokenIndex = position478, tokenIndex478
+					}
+					if !_rules[ruleSpacing]() {
+						goto l473
+					}
+					add(ruleFINALLY, position475)
+				}
+				if !_rules[ruleBlock]() {
+					goto l473
+				}
+				add(ruleFinally, position474)
+			}
+			memoize(51, position473, tokenIndex473, true)
+			return true
+		l473:
+			memoize(51, position473, tokenIndex473, false)
+			position, tokenIndex = position473, tokenIndex473
+			return false
+		},
+		/* 52 SwitchBody <- <(LWING (SwitchRule+ / SwitchBlockStatementGroup*) RWING)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{52, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position479, tokenIndex479 := position, tokenIndex
+			{
+				position480 := position
+				if !_rules[ruleLWING]() {
+					goto l479
+				}
+				{
+					position481, tokenIndex481 := position, tokenIndex
+					{
+						position485 := position
+						if !_rules[ruleSwitchLabel]() {
+							goto l482
+						}
+						if !_rules[ruleARROW]() {
+							goto l482
+						}
+						{
+							switch buffer[position] {
+							case 't':
+								fail("'{'")
+								{
+									position487, tokenIndex487 := position, tokenIndex
+									if !_rules[ruleTHROW]() {
+										goto l488
+									}
+									if !_rules[ruleExpression]() {
+										goto l488
+									}
+									if !_rules[ruleSEMI]() {
+										goto l488
+									}
+									goto l487
+								l488:
+									position, tokenIndex = position487, tokenIndex487
+									if !_rules[ruleExpression]() {
+										goto l482
+									}
+									if !_rules[ruleSEMI]() {
+										goto l482
+									}
+								}
+							l487:
+								break
+							case '{':
+								fail("'throw'")
+								fail("'_'")
+								fail("'$'")
+								fail("[A-Z]")
+								fail("[a-z]")
+								fail("'('")
+								fail("'++'")
+								fail("'+'")
+								fail("'--'")
+								fail("'-'")
+								fail("'~'")
+								fail("'!'")
+								fail("'<'")
+								fail("'this'")
+								fail("'super'")
+								fail("'0'")
+								fail("[0-9]")
+								fail("'.'")
+								fail("[1-9]")
+								fail("'\\''")
+								fail("'\"\"\"'")
+								fail("'\"'")
+								fail("'true'")
+								fail("'false'")
+								fail("'null'")
+								fail("'new'")
+								fail("'switch'")
+								fail("'char'")
+								fail("'double'")
+								fail("'float'")
+								fail("'int'")
+								fail("'long'")
+								fail("'short'")
+								fail("'b'")
+								fail("'void'")
+								if !_rules[ruleBlock]() {
+									goto l482
+								}
+							default:
+								fail("'{'")
+								fail("'throw'")
+								if !_rules[ruleExpression]() {
+									goto l482
+								}
+								if !_rules[ruleSEMI]() {
+									goto l482
+								}
+							}
+						}
+
+						add(ruleSwitchRule, position485)
+					}
+				l483:
+					{
+						position484, tokenIndex484 := position, tokenIndex
+						{
+							position489 := position
+							if !_rules[ruleSwitchLabel]() {
+								goto l484
+							}
+							if !_rules[ruleARROW]() {
+								goto l484
+							}
+							{
+								switch buffer[position] {
+								case 't':
+									fail("'{'")
+									{
+										position491, tokenIndex491 := position, tokenIndex
+										if !_rules[ruleTHROW]() {
+											goto l492
+										}
+										if !_rules[ruleExpression]() {
+											goto l492
+										}
+										if !_rules[ruleSEMI]() {
+											goto l492
+										}
+										goto l491
+									l492:
+										position, tokenIndex = position491, tokenIndex491
+										if !_rules[ruleExpression]() {
+											goto l484
+										}
+										if !_rules[ruleSEMI]() {
+											goto l484
+										}
+									}
+								l491:
+									break
+								case '{':
+									fail("'throw'")
+									fail("'_'")
+									fail("'$'")
+									fail("[A-Z]")
+									fail("[a-z]")
+									fail("'('")
+									fail("'++'")
+									fail("'+'")
+									fail("'--'")
+									fail("'-'")
+									fail("'~'")
+									fail("'!'")
+									fail("'<'")
+									fail("'this'")
+									fail("'super'")
+									fail("'0'")
+									fail("[0-9]")
+									fail("'.'")
+									fail("[1-9]")
+									fail("'\\''")
+									fail("'\"\"\"'")
+									fail("'\"'")
+									fail("'true'")
+									fail("'false'")
+									fail("'null'")
+									fail("'new'")
+									fail("'switch'")
+									fail("'char'")
+									fail("'double'")
+									fail("'float'")
+									fail("'int'")
+									fail("'long'")
+									fail("'short'")
+									fail("'b'")
+									fail("'void'")
+									if !_rules[ruleBlock]() {
+										goto l484
+									}
+								default:
+									fail("'{'")
+									fail("'throw'")
+									if !_rules[ruleExpression]() {
+										goto l484
+									}
+									if !_rules[ruleSEMI]() {
+										goto l484
+									}
+								}
+							}
+
+							add(ruleSwitchRule, position489)
+						}
+						goto l483
+					l484:
+						position, tokenIndex = position484, tokenIndex484
+					}
+					goto l481
+				l482:
+					position, tokenIndex = position481, tokenIndex481
+				l493:
+					{
+						position494, tokenIndex494 := position, tokenIndex
+						{
+							position495 := position
+							if !_rules[ruleSwitchLabel]() {
+								goto l494
+							}
+							if !_rules[ruleCOLON]() {
+								goto l494
+							}
+							if !_rules[ruleBlockStatements]() {
+								goto l494
+							}
+							add(ruleSwitchBlockStatementGroup, position495)
+						}
+						goto l493
+					l494:
+						position, tokenIndex = position494, tokenIndex494
+					}
+				}
+			l481:
+				if !_rules[ruleRWING]() {
+					goto l479
+				}
+				add(ruleSwitchBody, position480)
+			}
+			memoize(52, position479, tokenIndex479, true)
+			return true
+		l479:
+			memoize(52, position479, tokenIndex479, false)
+			position, tokenIndex = position479, tokenIndex479
+			return false
+		},
+		/* 53 SwitchRule <- <(SwitchLabel ARROW ((&('t') %fail('{') ((THROW Expression SEMI) / (Expression SEMI))) | (&('{') %fail('throw' '_' '$' [A-Z] [a-z] '(' '++' '+' '--' '-' '~' '!' '<' 'this' 'super' '0' [0-9] '.' [1-9] '\'' '"""' '"' 'true' 'false' 'null' 'new' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b' 'void') Block) | (&('!' | '"' | '$' | '\'' | '(' | '+' | '-' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '~') %fail('{' 'throw') (Expression SEMI))))> */
+		nil,
+		/* 54 SwitchBlockStatementGroup <- <(SwitchLabel COLON BlockStatements)> */
+		nil,
+		/* 55 SwitchLabel <- <((CASE ConditionalExpression (COMMA ConditionalExpression)*) / DEFAULT)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{55, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position498, tokenIndex498 := position, tokenIndex
+			{
+				position499 := position
+				{
+					position500, tokenIndex500 := position, tokenIndex
+					{
+						position502 := position
+						if buffer[position] != rune('c') {
+							fail("'case'")
+							goto l501
+						}
+						position++
+						if buffer[position] != rune('a') {
+							fail("'a'")
+							goto l501
+						}
+						position++
+						if buffer[position] != rune('s') {
+							fail("'s'")
+							goto l501
+						}
+						position++
+						if buffer[position] != rune('e') {
+							fail("'e'")
+							goto l501
+						}
+						position++
+						{
+							position503, tokenIndex503 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l503
+									}
+									position++
+								}
+							}
+
+							goto l501
+						l503:
+							position, tokenIndex = position503, tokenIndex503
+						}
+						{
+							position505, tokenIndex505 := position, tokenIndex
+							if !_rules[ruleLetterOrDigit]() {
+								goto l505
+							}
+							goto l501
+						l505:
+							position, tokenIndex = position505, tokenIndex505
+						}
+						if !_rules[ruleSpacing]() {
+							goto l501
+						}
+						add(ruleCASE, position502)
+					}
+					if !_rules[ruleConditionalExpression]() {
+						goto l501
+					}
+				l506:
+					{
+						position507, tokenIndex507 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l507
+						}
+						if !_rules[ruleConditionalExpression]() {
+							goto l507
+						}
+						goto l506
+					l507:
+						position, tokenIndex = position507, tokenIndex507
+					}
+					goto l500
+				l501:
+					position, tokenIndex = position500, tokenIndex500
+					if !_rules[ruleDEFAULT]() {
+						goto l498
+					}
+				}
+			l500:
+				add(ruleSwitchLabel, position499)
+			}
+			memoize(55, position498, tokenIndex498, true)
+			return true
+		l498:
+			memoize(55, position498, tokenIndex498, false)
+			position, tokenIndex = position498, tokenIndex498
+			return false
+		},
+		/* 56 ForInit <- <(((FINAL / Annotation)* Type VariableDeclarators) / (StatementExpression (COMMA StatementExpression)*))> */
+		nil,
+		/* 57 ForUpdate <- <(StatementExpression (COMMA StatementExpression)*)> */
+		nil,
+		/* 58 StatementExpression <- <Expression> */
+		func() bool {
+			memoized, ok := memoization[memoKey{58, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position510, tokenIndex510 := position, tokenIndex
+			{
+				position511 := position
+				if !_rules[ruleExpression]() {
+					goto l510
+				}
+				add(ruleStatementExpression, position511)
+			}
+			memoize(58, position510, tokenIndex510, true)
+			return true
+		l510:
+			memoize(58, position510, tokenIndex510, false)
+			position, tokenIndex = position510, tokenIndex510
+			return false
+		},
+		/* 59 Expression <- <(LambdaExpression / (ConditionalExpression (AssignmentOperator (LambdaExpression / ConditionalExpression))*))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{59, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position512, tokenIndex512 := position, tokenIndex
+			{
+				position513 := position
+				{
+					position514, tokenIndex514 := position, tokenIndex
+					if !_rules[ruleLambdaExpression]() {
+						goto l515
+					}
+					goto l514
+				l515:
+					position, tokenIndex = position514, tokenIndex514
+					if !_rules[ruleConditionalExpression]() {
+						goto l512
+					}
+				l516:
+					{
+						position517, tokenIndex517 := position, tokenIndex
+						{
+							position518 := position
+							{
+								switch buffer[position] {
+								case '&':
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position520 := position
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleANDEQU, position520)
+									}
+								case '*':
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position521 := position
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleSTAREQU, position521)
+									}
+								case '+':
+									fail("'='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position522 := position
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(rulePLUSEQU, position522)
+									}
+								case '-':
+									fail("'='")
+									fail("'+='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position523 := position
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleMINUSEQU, position523)
+									}
+								case '/':
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position524 := position
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleDIVEQU, position524)
+									}
+								case '<':
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position525 := position
+										position++
+										if buffer[position] != rune('<') {
+											fail("'<'")
+											goto l517
+										}
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleSLEQU, position525)
+									}
+								case '=':
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									if !_rules[ruleEQU]() {
+										goto l517
+									}
+								case '>':
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									{
+										position526, tokenIndex526 := position, tokenIndex
+										{
+											position528 := position
+											if buffer[position] != rune('>') {
+												fail("'>>='")
+												goto l527
+											}
+											position++
+											if buffer[position] != rune('>') {
+												fail("'>'")
+												goto l527
+											}
+											position++
+											if buffer[position] != rune('=') {
+												fail("'='")
+												goto l527
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l527
+											}
+											add(ruleSREQU, position528)
+										}
+										goto l526
+									l527:
+										position, tokenIndex = position526, tokenIndex526
+										{
+											position529 := position
+											if buffer[position] != rune('>') {
+												fail("'>>>='")
+												goto l517
+											}
+											position++
+											if buffer[position] != rune('>') {
+												fail("'>'")
+												goto l517
+											}
+											position++
+											if buffer[position] != rune('>') {
+												fail("'>'")
+												goto l517
+											}
+											position++
+											if buffer[position] != rune('=') {
+												fail("'='")
+												goto l517
+											}
+											position++
+											if !_rules[ruleSpacing]() {
+												goto l517
+											}
+											add(ruleBSREQU, position529)
+										}
+									}
+								l526:
+									break
+								case '^':
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position530 := position
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleHATEQU, position530)
+									}
+								case '|':
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'^='")
+									fail("'%='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position531 := position
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleOREQU, position531)
+									}
+								default:
+									fail("'='")
+									fail("'+='")
+									fail("'-='")
+									fail("'*='")
+									fail("'/='")
+									fail("'&='")
+									fail("'|='")
+									fail("'^='")
+									fail("'<<='")
+									fail("'>>='")
+									fail("'>>>='")
+									{
+										position532 := position
+										if buffer[position] != rune('%') {
+											fail("'%='")
+											goto l517
+										}
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l517
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l517
+										}
+										add(ruleMODEQU, position532)
+									}
+								}
+							}
+
+							add(ruleAssignmentOperator, position518)
+						}
+						{
+							position533, tokenIndex533 := position, tokenIndex
+							if !_rules[ruleLambdaExpression]() {
+								goto l534
+							}
+							goto l533
+						l534:
+							position, tokenIndex = position533, tokenIndex533
+							if !_rules[ruleConditionalExpression]() {
+								goto l517
+							}
+						}
+					l533:
+						goto l516
+					l517:
+						position, tokenIndex = position517, tokenIndex517
+					}
+				}
+			l514:
+				add(ruleExpression, position513)
+			}
+			memoize(59, position512, tokenIndex512, true)
+			return true
+		l512:
+			memoize(59, position512, tokenIndex512, false)
+			position, tokenIndex = position512, tokenIndex512
+			return false
+		},
+		/* 60 AssignmentOperator <- <((&('&') %fail('=' '+=' '-=' '*=' '/=' '|=' '^=' '%=' '<<=' '>>=' '>>>=') ANDEQU) | (&('*') %fail('=' '+=' '-=' '/=' '&=' '|=' '^=' '%=' '<<=' '>>=' '>>>=') STAREQU) | (&('+') %fail('=' '-=' '*=' '/=' '&=' '|=' '^=' '%=' '<<=' '>>=' '>>>=') PLUSEQU) | (&('-') %fail('=' '+=' '*=' '/=' '&=' '|=' '^=' '%=' '<<=' '>>=' '>>>=') MINUSEQU) | (&('/') %fail('=' '+=' '-=' '*=' '&=' '|=' '^=' '%=' '<<=' '>>=' '>>>=') DIVEQU) | (&('<') %fail('=' '+=' '-=' '*=' '/=' '&=' '|=' '^=' '%=' '>>=' '>>>=') SLEQU) | (&('=') %fail('+=' '-=' '*=' '/=' '&=' '|=' '^=' '%=' '<<=' '>>=' '>>>=') EQU) | (&('>') %fail('=' '+=' '-=' '*=' '/=' '&=' '|=' '^=' '%=' '<<=') (SREQU / BSREQU)) | (&('^') %fail('=' '+=' '-=' '*=' '/=' '&=' '|=' '%=' '<<=' '>>=' '>>>=') HATEQU) | (&('|') %fail('=' '+=' '-=' '*=' '/=' '&=' '^=' '%=' '<<=' '>>=' '>>>=') OREQU) | (&('%') %fail('=' '+=' '-=' '*=' '/=' '&=' '|=' '^=' '<<=' '>>=' '>>>=') MODEQU))> */
+		nil,
+		/* 61 ConditionalExpression <- <(ConditionalOrExpression (QUERY Expression COLON (LambdaExpression / ConditionalOrExpression))*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{61, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position536, tokenIndex536 := position, tokenIndex
+			{
+				position537 := position
+				if !_rules[ruleConditionalOrExpression]() {
+					goto l536
+				}
+			l538:
+				{
+					position539, tokenIndex539 := position, tokenIndex
+					if !_rules[ruleQUERY]() {
+						goto l539
+					}
+					if !_rules[ruleExpression]() {
+						goto l539
+					}
+					if !_rules[ruleCOLON]() {
+						goto l539
+					}
+					{
+						position540, tokenIndex540 := position, tokenIndex
+						if !_rules[ruleLambdaExpression]() {
+							goto l541
+						}
+						goto l540
+					l541:
+						position, tokenIndex = position540, tokenIndex540
+						if !_rules[ruleConditionalOrExpression]() {
+							goto l539
+						}
+					}
+				l540:
+					goto l538
+				l539:
+					position, tokenIndex = position539, tokenIndex539
+				}
+				add(ruleConditionalExpression, position537)
+			}
+			memoize(61, position536, tokenIndex536, true)
+			return true
+		l536:
+			memoize(61, position536, tokenIndex536, false)
+			position, tokenIndex = position536, tokenIndex536
+			return false
+		},
+		/* 62 LambdaExpression <- <(LambdaParameters ARROW (Block / Expression))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{62, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position542, tokenIndex542 := position, tokenIndex
+			{
+				position543 := position
+				{
+					position544 := position
+					{
+						position545, tokenIndex545 := position, tokenIndex
+						if !_rules[ruleIdentifier]() {
+							goto l546
+						}
+						goto l545
+					l546:
+						position, tokenIndex = position545, tokenIndex545
+						if !_rules[ruleLPAR]() {
+							goto l542
+						}
+						{
+							position547, tokenIndex547 := position, tokenIndex
+							{
+								position549, tokenIndex549 := position, tokenIndex
+								if !_rules[ruleFormalParameterList]() {
+									goto l550
+								}
+								goto l549
+							l550:
+								position, tokenIndex = position549, tokenIndex549
+								if !_rules[ruleIdentifier]() {
+									goto l547
+								}
+							l551:
+								{
+									position552, tokenIndex552 := position, tokenIndex
+									if !_rules[ruleCOMMA]() {
+										goto l552
+									}
+									if !_rules[ruleIdentifier]() {
+										goto l552
+									}
+									goto l551
+								l552:
+									position, tokenIndex = position552, tokenIndex552
+								}
+							}
+						l549:
+							goto l548
+						l547:
+							position, tokenIndex = position547, tokenIndex547
+						}
+					l548:
+						if !_rules[ruleRPAR]() {
+							goto l542
+						}
+					}
+				l545:
+					add(ruleLambdaParameters, position544)
+				}
+				if !_rules[ruleARROW]() {
+					goto l542
+				}
+				{
+					position553, tokenIndex553 := position, tokenIndex
+					if !_rules[ruleBlock]() {
+						goto l554
+					}
+					goto l553
+				l554:
+					position, tokenIndex = position553, tokenIndex553
+					if !_rules[ruleExpression]() {
+						goto l542
+					}
+				}
+			l553:
+				add(ruleLambdaExpression, position543)
+			}
+			memoize(62, position542, tokenIndex542, true)
+			return true
+		l542:
+			memoize(62, position542, tokenIndex542, false)
+			position, tokenIndex = position542, tokenIndex542
+			return false
+		},
+		/* 63 LambdaParameters <- <(Identifier / (LPAR (FormalParameterList / (Identifier (COMMA Identifier)*))? RPAR))> */
+		nil,
+		/* 64 ConditionalOrExpression <- <(ConditionalAndExpression (OROR ConditionalAndExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{64, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position556, tokenIndex556 := position, tokenIndex
+			{
+				position557 := position
+				if !_rules[ruleConditionalAndExpression]() {
+					goto l556
+				}
+			l558:
+				{
+					position559, tokenIndex559 := position, tokenIndex
+					{
+						position560 := position
+						if buffer[position] != rune('|') {
+							fail("'||'")
+							goto l559
+						}
+						position++
+						if buffer[position] != rune('|') {
+							fail("'|'")
+							goto l559
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l559
+						}
+						add(ruleOROR, position560)
+					}
+					if !_rules[ruleConditionalAndExpression]() {
+						goto l559
+					}
+					goto l558
+				l559:
+					position, tokenIndex = position559, tokenIndex559
+				}
+				add(ruleConditionalOrExpression, position557)
+			}
+			memoize(64, position556, tokenIndex556, true)
+			return true
+		l556:
+			memoize(64, position556, tokenIndex556, false)
+			position, tokenIndex = position556, tokenIndex556
+			return false
+		},
+		/* 65 ConditionalAndExpression <- <(InclusiveOrExpression (ANDAND InclusiveOrExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{65, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position561, tokenIndex561 := position, tokenIndex
+			{
+				position562 := position
+				if !_rules[ruleInclusiveOrExpression]() {
+					goto l561
+				}
+			l563:
+				{
+					position564, tokenIndex564 := position, tokenIndex
+					{
+						position565 := position
+						if buffer[position] != rune('&') {
+							fail("'&&'")
+							goto l564
+						}
+						position++
+						if buffer[position] != rune('&') {
+							fail("'&'")
+							goto l564
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l564
+						}
+						add(ruleANDAND, position565)
+					}
+					if !_rules[ruleInclusiveOrExpression]() {
+						goto l564
+					}
+					goto l563
+				l564:
+					position, tokenIndex = position564, tokenIndex564
+				}
+				add(ruleConditionalAndExpression, position562)
+			}
+			memoize(65, position561, tokenIndex561, true)
+			return true
+		l561:
+			memoize(65, position561, tokenIndex561, false)
+			position, tokenIndex = position561, tokenIndex561
+			return false
+		},
+		/* 66 InclusiveOrExpression <- <(ExclusiveOrExpression (OR ExclusiveOrExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{66, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position566, tokenIndex566 := position, tokenIndex
+			{
+				position567 := position
+				if !_rules[ruleExclusiveOrExpression]() {
+					goto l566
+				}
+			l568:
+				{
+					position569, tokenIndex569 := position, tokenIndex
+					if !_rules[ruleOR]() {
+						goto l569
+					}
+					if !_rules[ruleExclusiveOrExpression]() {
+						goto l569
+					}
+					goto l568
+				l569:
+					position, tokenIndex = position569, tokenIndex569
+				}
+				add(ruleInclusiveOrExpression, position567)
+			}
+			memoize(66, position566, tokenIndex566, true)
+			return true
+		l566:
+			memoize(66, position566, tokenIndex566, false)
+			position, tokenIndex = position566, tokenIndex566
+			return false
+		},
+		/* 67 ExclusiveOrExpression <- <(AndExpression (HAT AndExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{67, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position570, tokenIndex570 := position, tokenIndex
+			{
+				position571 := position
+				if !_rules[ruleAndExpression]() {
+					goto l570
+				}
+			l572:
+				{
+					position573, tokenIndex573 := position, tokenIndex
+					{
+						position574 := position
+						if buffer[position] != rune('^') {
+							fail("'^'")
+							goto l573
+						}
+						position++
+						{
+							position575, tokenIndex575 := position, tokenIndex
+							if buffer[position] != rune('=') {
+								fail("'='")
+								goto l575
+							}
+							position++
+							goto l573
+						l575:
+							position, tokenIndex = position575, tokenIndex575
+						}
+						if !_rules[ruleSpacing]() {
+							goto l573
+						}
+						add(ruleHAT, position574)
+					}
+					if !_rules[ruleAndExpression]() {
+						goto l573
+					}
+					goto l572
+				l573:
+					position, tokenIndex = position573, tokenIndex573
+				}
+				add(ruleExclusiveOrExpression, position571)
+			}
+			memoize(67, position570, tokenIndex570, true)
+			return true
+		l570:
+			memoize(67, position570, tokenIndex570, false)
+			position, tokenIndex = position570, tokenIndex570
+			return false
+		},
+		/* 68 AndExpression <- <(EqualityExpression (AND EqualityExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{68, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position576, tokenIndex576 := position, tokenIndex
+			{
+				position577 := position
+				if !_rules[ruleEqualityExpression]() {
+					goto l576
+				}
+			l578:
+				{
+					position579, tokenIndex579 := position, tokenIndex
+					if !_rules[ruleAND]() {
+						goto l579
+					}
+					if !_rules[ruleEqualityExpression]() {
+						goto l579
+					}
+					goto l578
+				l579:
+					position, tokenIndex = position579, tokenIndex579
+				}
+				add(ruleAndExpression, position577)
+			}
+			memoize(68, position576, tokenIndex576, true)
+			return true
+		l576:
+			memoize(68, position576, tokenIndex576, false)
+			position, tokenIndex = position576, tokenIndex576
+			return false
+		},
+		/* 69 EqualityExpression <- <(RelationalExpression ((EQUAL / NOTEQUAL) RelationalExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{69, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position580, tokenIndex580 := position, tokenIndex
+			{
+				position581 := position
+				if !_rules[ruleRelationalExpression]() {
+					goto l580
+				}
+			l582:
+				{
+					position583, tokenIndex583 := position, tokenIndex
+					{
+						position584, tokenIndex584 := position, tokenIndex
+						{
+							position586 := position
+							if buffer[position] != rune('=') {
+								fail("'=='")
+								goto l585
+							}
+							position++
+							if buffer[position] != rune('=') {
+								fail("'='")
+								goto l585
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l585
+							}
+							add(ruleEQUAL, position586)
+						}
+						goto l584
+					l585:
+						position, tokenIndex = position584, tokenIndex584
+						{
+							position587 := position
+							if buffer[position] != rune('!') {
+								fail("'!='")
+								goto l583
+							}
+							position++
+							if buffer[position] != rune('=') {
+								fail("'='")
+								goto l583
+							}
+							position++
+							if !_rules[ruleSpacing]() {
+								goto l583
+							}
+							add(ruleNOTEQUAL, position587)
+						}
+					}
+				l584:
+					if !_rules[ruleRelationalExpression]() {
+						goto l583
+					}
+					goto l582
+				l583:
+					position, tokenIndex = position583, tokenIndex583
+				}
+				add(ruleEqualityExpression, position581)
+			}
+			memoize(69, position580, tokenIndex580, true)
+			return true
+		l580:
+			memoize(69, position580, tokenIndex580, false)
+			position, tokenIndex = position580, tokenIndex580
+			return false
+		},
+		/* 70 RelationalExpression <- <(ShiftExpression ((((&('>') %fail('<=' '<') (GE / GT)) | (&('<') %fail('>=' '>') (LE / LT))) ShiftExpression) / (INSTANCEOF (FINAL / Annotation)* ReferenceType Identifier?))*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{70, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position588, tokenIndex588 := position, tokenIndex
+			{
+				position589 := position
+				if !_rules[ruleShiftExpression]() {
+					goto l588
+				}
+			l590:
+				{
+					position591, tokenIndex591 := position, tokenIndex
+					{
+						position592, tokenIndex592 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '>':
+								fail("'<='")
+								fail("'<'")
+								{
+									position595, tokenIndex595 := position, tokenIndex
+									{
+										position597 := position
+										if buffer[position] != rune('>') {
+											fail("'>='")
+											goto l596
+										}
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l596
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l596
+										}
+										add(ruleGE, position597)
+									}
+									goto l595
+								l596:
+									position, tokenIndex = position595, tokenIndex595
+									{
+										position598 := position
+										if buffer[position] != rune('>') {
+											fail("'>'")
+											goto l593
+										}
+										position++
+										{
+											position599, tokenIndex599 := position, tokenIndex
+											{
+												position600, tokenIndex600 := position, tokenIndex
+												if buffer[position] != rune('=') {
+													fail("'='")
+													goto l601
+												}
+												position++
+												goto l600
+											l601:
+												position, tokenIndex = position600, tokenIndex600
+												if buffer[position] != rune('>') {
+													fail("'>'")
+													goto l599
+												}
+												position++
+											}
+										l600:
+											goto l593
+										l599:
+											position, tokenIndex = position599, tokenIndex599
+										}
+										if !_rules[ruleSpacing]() {
+											goto l593
+										}
+										add(ruleGT, position598)
+									}
+								}
+							l595:
+								break
+							default:
+								fail("'>='")
+								fail("'>'")
+								{
+									position602, tokenIndex602 := position, tokenIndex
+									{
+										position604 := position
+										if buffer[position] != rune('<') {
+											fail("'<='")
+											goto l603
+										}
+										position++
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l603
+										}
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l603
+										}
+										add(ruleLE, position604)
+									}
+									goto l602
+								l603:
+									position, tokenIndex = position602, tokenIndex602
+									{
+										position605 := position
+										if buffer[position] != rune('<') {
+											fail("'<'")
+											goto l593
+										}
+										position++
+										{
+											position606, tokenIndex606 := position, tokenIndex
+											{
+												position607, tokenIndex607 := position, tokenIndex
+												if buffer[position] != rune('=') {
+													fail("'='")
+													goto l608
+												}
+												position++
+												goto l607
+											l608:
+												position, tokenIndex = position607, tokenIndex607
+												if buffer[position] != rune('<') {
+													fail("'<'")
+													goto l606
+												}
+												position++
+											}
+										l607:
+											goto l593
+										l606:
+											position, tokenIndex = position606, tokenIndex606
+										}
+										if !_rules[ruleSpacing]() {
+											goto l593
+										}
+										add(ruleLT, position605)
+									}
+								}
+							l602:
+								break
+							}
+						}
+
+						if !_rules[ruleShiftExpression]() {
+							goto l593
+						}
+						goto l592
+					l593:
+						position, tokenIndex = position592, tokenIndex592
+						{
+							position609 := position
+							if buffer[position] != rune('i') {
+								fail("'instanceof'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('n') {
+								fail("'n'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('s') {
+								fail("'s'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('t') {
+								fail("'t'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('a') {
+								fail("'a'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('n') {
+								fail("'n'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('c') {
+								fail("'c'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('e') {
+								fail("'e'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('o') {
+								fail("'o'")
+								goto l591
+							}
+							position++
+							if buffer[position] != rune('f') {
+								fail("'f'")
+								goto l591
+							}
+							position++
+							{
+								position610, tokenIndex610 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '$':
+										position++
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									case '_':
+										position++
+									default:
+										fail("'$'")
+										fail("[0-9]")
+										fail("[A-Z]")
+										fail("'_'")
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											fail("[a-z]")
+											goto l610
+										}
+										position++
+									}
+								}
+
+								goto l591
+							l610:
+								position, tokenIndex = position610, tokenIndex610
+							}
+							{
+								position612, tokenIndex612 := position, tokenIndex
+								if !_rules[ruleLetterOrDigit]() {
+									goto l612
+								}
+								goto l591
+							l612:
+								position, tokenIndex = position612, tokenIndex612
+							}
+							if !_rules[ruleSpacing]() {
+								goto l591
+							}
+							add(ruleINSTANCEOF, position609)
+						}
+					l613:
+						{
+							position614, tokenIndex614 := position, tokenIndex
+							{
+								position615, tokenIndex615 := position, tokenIndex
+								if !_rules[ruleFINAL]() {
+									goto l616
+								}
+								goto l615
+							l616:
+								position, tokenIndex = position615, tokenIndex615
+								if !_rules[ruleAnnotation]() {
+									goto l614
+								}
+							}
+						l615:
+							goto l613
+						l614:
+							position, tokenIndex = position614, tokenIndex614
+						}
+						if !_rules[ruleReferenceType]() {
+							goto l591
+						}
+						{
+							position617, tokenIndex617 := position, tokenIndex
+							if !_rules[ruleIdentifier]() {
+								goto l617
+							}
+							goto l618
+						l617:
+							position, tokenIndex = position617, tokenIndex617
+						}
+					l618:
+					}
+				l592:
+					goto l590
+				l591:
+					position, tokenIndex = position591, tokenIndex591
+				}
+				add(ruleRelationalExpression, position589)
+			}
+			memoize(70, position588, tokenIndex588, true)
+			return true
+		l588:
+			memoize(70, position588, tokenIndex588, false)
+			position, tokenIndex = position588, tokenIndex588
+			return false
+		},
+		/* 71 ShiftExpression <- <(AdditiveExpression (((&('>') %fail('<<') (SR / BSR)) | (&('<') %fail('>>' '>>>') SL)) AdditiveExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{71, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position619, tokenIndex619 := position, tokenIndex
+			{
+				position620 := position
+				if !_rules[ruleAdditiveExpression]() {
+					goto l619
+				}
+			l621:
+				{
+					position622, tokenIndex622 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '>':
+							fail("'<<'")
+							{
+								position624, tokenIndex624 := position, tokenIndex
+								{
+									position626 := position
+									if buffer[position] != rune('>') {
+										fail("'>>'")
+										goto l625
+									}
+									position++
+									if buffer[position] != rune('>') {
+										fail("'>'")
+										goto l625
+									}
+									position++
+									{
+										position627, tokenIndex627 := position, tokenIndex
+										{
+											position628, tokenIndex628 := position, tokenIndex
+											if buffer[position] != rune('=') {
+												fail("'='")
+												goto l629
+											}
+											position++
+											goto l628
+										l629:
+											position, tokenIndex = position628, tokenIndex628
+											if buffer[position] != rune('>') {
+												fail("'>'")
+												goto l627
+											}
+											position++
+										}
+									l628:
+										goto l625
+									l627:
+										position, tokenIndex = position627, tokenIndex627
+									}
+									if !_rules[ruleSpacing]() {
+										goto l625
+									}
+									add(ruleSR, position626)
+								}
+								goto l624
+							l625:
+								position, tokenIndex = position624, tokenIndex624
+								{
+									position630 := position
+									if buffer[position] != rune('>') {
+										fail("'>>>'")
+										goto l622
+									}
+									position++
+									if buffer[position] != rune('>') {
+										fail("'>'")
+										goto l622
+									}
+									position++
+									if buffer[position] != rune('>') {
+										fail("'>'")
+										goto l622
+									}
+									position++
+									{
+										position631, tokenIndex631 := position, tokenIndex
+										if buffer[position] != rune('=') {
+											fail("'='")
+											goto l631
+										}
+										position++
+										goto l622
+									l631:
+										position, tokenIndex = position631, tokenIndex631
+									}
+									if !_rules[ruleSpacing]() {
+										goto l622
+									}
+									add(ruleBSR, position630)
+								}
+							}
+						l624:
+							break
+						default:
+							fail("'>>'")
+							fail("'>>>'")
+							{
+								position632 := position
+								if buffer[position] != rune('<') {
+									fail("'<<'")
+									goto l622
+								}
+								position++
+								if buffer[position] != rune('<') {
+									fail("'<'")
+									goto l622
+								}
+								position++
+								{
+									position633, tokenIndex633 := position, tokenIndex
+									if buffer[position] != rune('=') {
+										fail("'='")
+										goto l633
+									}
+									position++
+									goto l622
+								l633:
+									position, tokenIndex = position633, tokenIndex633
+								}
+								if !_rules[ruleSpacing]() {
+									goto l622
+								}
+								add(ruleSL, position632)
+							}
+						}
+					}
+
+					if !_rules[ruleAdditiveExpression]() {
+						goto l622
+					}
+					goto l621
+				l622:
+					position, tokenIndex = position622, tokenIndex622
+				}
+				add(ruleShiftExpression, position620)
+			}
+			memoize(71, position619, tokenIndex619, true)
+			return true
+		l619:
+			memoize(71, position619, tokenIndex619, false)
+			position, tokenIndex = position619, tokenIndex619
+			return false
+		},
+		/* 72 AdditiveExpression <- <(MultiplicativeExpression ((PLUS / MINUS) MultiplicativeExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{72, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position634, tokenIndex634 := position, tokenIndex
+			{
+				position635 := position
+				if !_rules[ruleMultiplicativeExpression]() {
+					goto l634
+				}
+			l636:
+				{
+					position637, tokenIndex637 := position, tokenIndex
+					{
+						position638, tokenIndex638 := position, tokenIndex
+						if !_rules[rulePLUS]() {
+							goto l639
+						}
+						goto l638
+					l639:
+						position, tokenIndex = position638, tokenIndex638
+						if !_rules[ruleMINUS]() {
+							goto l637
+						}
+					}
+				l638:
+					if !_rules[ruleMultiplicativeExpression]() {
+						goto l637
+					}
+					goto l636
+				l637:
+					position, tokenIndex = position637, tokenIndex637
+				}
+				add(ruleAdditiveExpression, position635)
+			}
+			memoize(72, position634, tokenIndex634, true)
+			return true
+		l634:
+			memoize(72, position634, tokenIndex634, false)
+			position, tokenIndex = position634, tokenIndex634
+			return false
+		},
+		/* 73 MultiplicativeExpression <- <(UnaryExpression (((&('%') MOD) | (&('/') DIV) | (&('*') %fail('%' '/') STAR)) UnaryExpression)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{73, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position640, tokenIndex640 := position, tokenIndex
+			{
+				position641 := position
+				if !_rules[ruleUnaryExpression]() {
+					goto l640
+				}
+			l642:
+				{
+					position643, tokenIndex643 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '%':
+							{
+								position645 := position
+								position++
+								{
+									position646, tokenIndex646 := position, tokenIndex
+									if buffer[position] != rune('=') {
+										fail("'='")
+										goto l646
+									}
+									position++
+									goto l643
+								l646:
+									position, tokenIndex = position646, tokenIndex646
+								}
+								if !_rules[ruleSpacing]() {
+									goto l643
+								}
+								add(ruleMOD, position645)
+							}
+						case '/':
+							{
+								position647 := position
+								position++
+								{
+									position648, tokenIndex648 := position, tokenIndex
+									if buffer[position] != rune('=') {
+										fail("'='")
+										goto l648
+									}
+									position++
+									goto l643
+								l648:
+									position, tokenIndex = position648, tokenIndex648
+								}
+								if !_rules[ruleSpacing]() {
+									goto l643
+								}
+								add(ruleDIV, position647)
+							}
+						default:
+							fail("'%'")
+							fail("'/'")
+							if !_rules[ruleSTAR]() {
+								goto l643
+							}
+						}
+					}
+
+					if !_rules[ruleUnaryExpression]() {
+						goto l643
+					}
+					goto l642
+				l643:
+					position, tokenIndex = position643, tokenIndex643
+				}
+				add(ruleMultiplicativeExpression, position641)
+			}
+			memoize(73, position640, tokenIndex640, true)
+			return true
+		l640:
+			memoize(73, position640, tokenIndex640, false)
+			position, tokenIndex = position640, tokenIndex640
+			return false
+		},
+		/* 74 UnaryExpression <- <((&('!' | '+' | '-' | '~') %fail('(' '"""' '"' '\'' '0' '.' [0-9] 'false' 'null' 'true' [1-9] '<' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'new' 'super' 'switch' 'this' 'void') (PrefixOp UnaryExpression)) | (&('(') %fail('++' '+' '--' '-' '~' '!') ((LPAR Type RPAR (LambdaExpression / UnaryExpression)) / (Primary Selector* PostfixOp*))) | (&('"' | '$' | '\'' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('++' '+' '--' '-' '~' '!' '(') (Primary Selector* PostfixOp*)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{74, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position649, tokenIndex649 := position, tokenIndex
+			{
+				position650 := position
+				{
+					switch buffer[position] {
+					case '!', '+', '-', '~':
+						fail("'('")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'\\''")
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("'false'")
+						fail("'null'")
+						fail("'true'")
+						fail("[1-9]")
+						fail("'<'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'_'")
+						fail("'$'")
+						fail("[A-Z]")
+						fail("[a-z]")
+						fail("'new'")
+						fail("'super'")
+						fail("'switch'")
+						fail("'this'")
+						fail("'void'")
+						{
+							position652 := position
+							{
+								switch buffer[position] {
+								case '+':
+									fail("'--'")
+									fail("'!'")
+									fail("'~'")
+									fail("'-'")
+									{
+										position654, tokenIndex654 := position, tokenIndex
+										if !_rules[ruleINC]() {
+											goto l655
+										}
+										goto l654
+									l655:
+										position, tokenIndex = position654, tokenIndex654
+										if !_rules[rulePLUS]() {
+											goto l649
+										}
+									}
+								l654:
+									break
+								case '-':
+									fail("'++'")
+									fail("'!'")
+									fail("'~'")
+									fail("'+'")
+									{
+										position656, tokenIndex656 := position, tokenIndex
+										if !_rules[ruleDEC]() {
+											goto l657
+										}
+										goto l656
+									l657:
+										position, tokenIndex = position656, tokenIndex656
+										if !_rules[ruleMINUS]() {
+											goto l649
+										}
+									}
+								l656:
+									break
+								case '~':
+									fail("'++'")
+									fail("'--'")
+									fail("'!'")
+									fail("'+'")
+									fail("'-'")
+									{
+										position658 := position
+										position++
+										if !_rules[ruleSpacing]() {
+											goto l649
+										}
+										add(ruleTILDA, position658)
+									}
+								default:
+									fail("'++'")
+									fail("'--'")
+									fail("'~'")
+									fail("'+'")
+									fail("'-'")
+									{
+										position659 := position
+										if buffer[position] != rune('!') {
+											fail("'!'")
+											goto l649
+										}
+										position++
+										{
+											position660, tokenIndex660 := position, tokenIndex
+											if buffer[position] != rune('=') {
+												fail("'='")
+												goto l660
+											}
+											position++
+											goto l649
+										l660:
+											position, tokenIndex = position660, tokenIndex660
+										}
+										if !_rules[ruleSpacing]() {
+											goto l649
+										}
+										add(ruleBANG, position659)
+									}
+								}
+							}
+
+							add(rulePrefixOp, position652)
+						}
+						if !_rules[ruleUnaryExpression]() {
+							goto l649
+						}
+					case '(':
+						fail("'++'")
+						fail("'+'")
+						fail("'--'")
+						fail("'-'")
+						fail("'~'")
+						fail("'!'")
+						{
+							position661, tokenIndex661 := position, tokenIndex
+							if !_rules[ruleLPAR]() {
+								goto l662
+							}
+							if !_rules[ruleType]() {
+								goto l662
+							}
+							if !_rules[ruleRPAR]() {
+								goto l662
+							}
+							{
+								position663, tokenIndex663 := position, tokenIndex
+								if !_rules[ruleLambdaExpression]() {
+									goto l664
+								}
+								goto l663
+							l664:
+								position, tokenIndex = position663, tokenIndex663
+								if !_rules[ruleUnaryExpression]() {
+									goto l662
+								}
+							}
+						l663:
+							goto l661
+						l662:
+							position, tokenIndex = position661, tokenIndex661
+							if !_rules[rulePrimary]() {
+								goto l649
+							}
+						l665:
+							{
+								position666, tokenIndex666 := position, tokenIndex
+								if !_rules[ruleSelector]() {
+									goto l666
+								}
+								goto l665
+							l666:
+								position, tokenIndex = position666, tokenIndex666
+							}
+						l667:
+							{
+								position668, tokenIndex668 := position, tokenIndex
+								if !_rules[rulePostfixOp]() {
+									goto l668
+								}
+								goto l667
+							l668:
+								position, tokenIndex = position668, tokenIndex668
+							}
+						}
+					l661:
+						break
+					default:
+						fail("'++'")
+						fail("'+'")
+						fail("'--'")
+						fail("'-'")
+						fail("'~'")
+						fail("'!'")
+						fail("'('")
+						if !_rules[rulePrimary]() {
+							goto l649
+						}
+					l669:
+						{
+							position670, tokenIndex670 := position, tokenIndex
+							if !_rules[ruleSelector]() {
+								goto l670
+							}
+							goto l669
+						l670:
+							position, tokenIndex = position670, tokenIndex670
+						}
+					l671:
+						{
+							position672, tokenIndex672 := position, tokenIndex
+							if !_rules[rulePostfixOp]() {
+								goto l672
+							}
+							goto l671
+						l672:
+							position, tokenIndex = position672, tokenIndex672
+						}
+					}
+				}
+
+				add(ruleUnaryExpression, position650)
+			}
+			memoize(74, position649, tokenIndex649, true)
+			return true
+		l649:
+			memoize(74, position649, tokenIndex649, false)
+			position, tokenIndex = position649, tokenIndex649
+			return false
+		},
+		/* 75 Primary <- <((&('"' | '\'' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail('(' '<' 'this' 'super' 'new' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'void') Literal) | (&('(') %fail('<' 'this' 'super' '"""' '"' '\'' '0' '.' [0-9] 'false' 'null' 'true' [1-9] 'new' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'void') ParExpression) | (&('<') %fail('(' 'this' 'super' '"""' '"' '\'' '0' '.' [0-9] 'false' 'null' 'true' [1-9] 'new' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'void') (NonWildcardTypeArguments (ExplicitGenericInvocationSuffix / (THIS Arguments)))) | (&('b' | 'c' | 'd' | 'i' | 'l') %fail('(' '<' 'this' 'super' '"""' '"' '\'' '0' '.' [0-9] 'false' 'null' 'true' [1-9] 'new' 'switch' 'void') (MethodReference / (QualifiedIdentifier IdentifierSuffix?) / (BasicType Dim* DOT CLASS))) | (&('f') %fail('(' '<' 'this' 'super' 'new' 'switch' 'void') (Literal / MethodReference / (QualifiedIdentifier IdentifierSuffix?) / (BasicType Dim* DOT CLASS))) | (&('n') %fail('(' '<' 'this' 'super' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b' 'void') (Literal / (NEW Creator) / MethodReference / (QualifiedIdentifier IdentifierSuffix?))) | (&('s') %fail('(' '<' 'this' '"""' '"' '\'' '0' '.' [0-9] 'false' 'null' 'true' [1-9] 'new' 'void') ((SUPER SuperSuffix) / (SWITCH ParExpression SwitchBody) / MethodReference / (QualifiedIdentifier IdentifierSuffix?) / (BasicType Dim* DOT CLASS))) | (&('t') %fail('(' '<' 'super' 'new' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b' 'void') ((THIS Arguments?) / Literal / MethodReference / (QualifiedIdentifier IdentifierSuffix?))) | (&('v') %fail('(' '<' 'this' 'super' '"""' '"' '\'' '0' '.' [0-9] 'false' 'null' 'true' [1-9] 'new' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b') (MethodReference / (QualifiedIdentifier IdentifierSuffix?) / (VOID DOT CLASS))) | (&('$' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'e' | 'g' | 'h' | 'j' | 'k' | 'm' | 'o' | 'p' | 'q' | 'r' | 'u' | 'w' | 'x' | 'y' | 'z') %fail('(' '<' 'this' 'super' '"""' '"' '\'' '0' '.' [0-9] 'false' 'null' 'true' [1-9] 'new' 'switch' 'char' 'double' 'float' 'int' 'long' 'short' 'b' 'void') (MethodReference / (QualifiedIdentifier IdentifierSuffix?))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{75, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position673, tokenIndex673 := position, tokenIndex
+			{
+				position674 := position
+				{
+					switch buffer[position] {
+					case '"', '\'', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+						fail("'('")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'new'")
+						fail("'switch'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'_'")
+						fail("'$'")
+						fail("[A-Z]")
+						fail("[a-z]")
+						fail("'void'")
+						if !_rules[ruleLiteral]() {
+							goto l673
+						}
+					case '(':
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'\\''")
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("'false'")
+						fail("'null'")
+						fail("'true'")
+						fail("[1-9]")
+						fail("'new'")
+						fail("'switch'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'_'")
+						fail("'$'")
+						fail("[A-Z]")
+						fail("[a-z]")
+						fail("'void'")
+						if !_rules[ruleParExpression]() {
+							goto l673
+						}
+					case '<':
+						fail("'('")
+						fail("'this'")
+						fail("'super'")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'\\''")
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("'false'")
+						fail("'null'")
+						fail("'true'")
+						fail("[1-9]")
+						fail("'new'")
+						fail("'switch'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'_'")
+						fail("'$'")
+						fail("[A-Z]")
+						fail("[a-z]")
+						fail("'void'")
+						if !_rules[ruleNonWildcardTypeArguments]() {
+							goto l673
+						}
+						{
+							position676, tokenIndex676 := position, tokenIndex
+							if !_rules[ruleExplicitGenericInvocationSuffix]() {
+								goto l677
+							}
+							goto l676
+						l677:
+							position, tokenIndex = position676, tokenIndex676
+							if !_rules[ruleTHIS]() {
+								goto l673
+							}
+							if !_rules[ruleArguments]() {
+								goto l673
+							}
+						}
+					l676:
+						break
+					case 'b', 'c', 'd', 'i', 'l':
+						fail("'('")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'\\''")
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("'false'")
+						fail("'null'")
+						fail("'true'")
+						fail("[1-9]")
+						fail("'new'")
+						fail("'switch'")
+						fail("'void'")
+						{
+							position678, tokenIndex678 := position, tokenIndex
+							if !_rules[ruleMethodReference]() {
+								goto l679
+							}
+							goto l678
+						l679:
+							position, tokenIndex = position678, tokenIndex678
+							if !_rules[ruleQualifiedIdentifier]() {
+								goto l680
+							}
+							{
+								position681, tokenIndex681 := position, tokenIndex
+								if !_rules[ruleIdentifierSuffix]() {
+									goto l681
+								}
+								goto l682
+							l681:
+								position, tokenIndex = position681, tokenIndex681
+							}
+						l682:
+							goto l678
+						l680:
+							position, tokenIndex = position678, tokenIndex678
+							if !_rules[ruleBasicType]() {
+								goto l673
+							}
+						l683:
+							{
+								position684, tokenIndex684 := position, tokenIndex
+								if !_rules[ruleDim]() {
+									goto l684
+								}
+								goto l683
+							l684:
+								position, tokenIndex = position684, tokenIndex684
+							}
+							if !_rules[ruleDOT]() {
+								goto l673
+							}
+							if !_rules[ruleCLASS]() {
+								goto l673
+							}
+						}
+					l678:
+						break
+					case 'f':
+						fail("'('")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'new'")
+						fail("'switch'")
+						fail("'void'")
+						{
+							position685, tokenIndex685 := position, tokenIndex
+							if !_rules[ruleLiteral]() {
+								goto l686
+							}
+							goto l685
+						l686:
+							position, tokenIndex = position685, tokenIndex685
+							if !_rules[ruleMethodReference]() {
+								goto l687
+							}
+							goto l685
+						l687:
+							position, tokenIndex = position685, tokenIndex685
+							if !_rules[ruleQualifiedIdentifier]() {
+								goto l688
+							}
+							{
+								position689, tokenIndex689 := position, tokenIndex
+								if !_rules[ruleIdentifierSuffix]() {
+									goto l689
+								}
+								goto l690
+							l689:
+								position, tokenIndex = position689, tokenIndex689
+							}
+						l690:
+							goto l685
+						l688:
+							position, tokenIndex = position685, tokenIndex685
+							if !_rules[ruleBasicType]() {
+								goto l673
+							}
+						l691:
+							{
+								position692, tokenIndex692 := position, tokenIndex
+								if !_rules[ruleDim]() {
+									goto l692
+								}
+								goto l691
+							l692:
+								position, tokenIndex = position692, tokenIndex692
+							}
+							if !_rules[ruleDOT]() {
+								goto l673
+							}
+							if !_rules[ruleCLASS]() {
+								goto l673
+							}
+						}
+					l685:
+						break
+					case 'n':
+						fail("'('")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'switch'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'void'")
+						{
+							position693, tokenIndex693 := position, tokenIndex
+							if !_rules[ruleLiteral]() {
+								goto l694
+							}
+							goto l693
+						l694:
+							position, tokenIndex = position693, tokenIndex693
+							if !_rules[ruleNEW]() {
+								goto l695
+							}
+							{
+								position696 := position
+								{
+									position697, tokenIndex697 := position, tokenIndex
+									if !_rules[ruleNonWildcardTypeArguments]() {
+										goto l697
+									}
+									goto l698
+								l697:
+									position, tokenIndex = position697, tokenIndex697
+								}
+							l698:
+								{
+									position699, tokenIndex699 := position, tokenIndex
+									{
+										position701 := position
+										if !_rules[ruleIdentifier]() {
+											goto l700
+										}
+										{
+											position702, tokenIndex702 := position, tokenIndex
+											if !_rules[ruleNonWildcardTypeArguments]() {
+												goto l702
+											}
+											goto l703
+										l702:
+											position, tokenIndex = position702, tokenIndex702
+										}
+									l703:
+									l704:
+										{
+											position705, tokenIndex705 := position, tokenIndex
+											if !_rules[ruleDOT]() {
+												goto l705
+											}
+											if !_rules[ruleIdentifier]() {
+												goto l705
+											}
+											{
+												position706, tokenIndex706 := position, tokenIndex
+												if !_rules[ruleNonWildcardTypeArguments]() {
+													goto l706
+												}
+												goto l707
+											l706:
+												position, tokenIndex = position706, tokenIndex706
+											}
+										l707:
+											goto l704
+										l705:
+											position, tokenIndex = position705, tokenIndex705
+										}
+										add(ruleCreatedName, position701)
+									}
+									if !_rules[ruleClassCreatorRest]() {
+										goto l700
+									}
+									goto l699
+								l700:
+									position, tokenIndex = position699, tokenIndex699
+									{
+										position708, tokenIndex708 := position, tokenIndex
+										if !_rules[ruleClassType]() {
+											goto l709
+										}
+										goto l708
+									l709:
+										position, tokenIndex = position708, tokenIndex708
+										if !_rules[ruleBasicType]() {
+											goto l695
+										}
+									}
+								l708:
+									{
+										position710 := position
+										if !_rules[ruleLBRK]() {
+											goto l695
+										}
+										{
+											position711, tokenIndex711 := position, tokenIndex
+											if !_rules[ruleRBRK]() {
+												goto l712
+											}
+										l713:
+											{
+												position714, tokenIndex714 := position, tokenIndex
+												if !_rules[ruleDim]() {
+													goto l714
+												}
+												goto l713
+											l714:
+												position, tokenIndex = position714, tokenIndex714
+											}
+											if !_rules[ruleArrayInitializer]() {
+												goto l712
+											}
+											goto l711
+										l712:
+											position, tokenIndex = position711, tokenIndex711
+											if !_rules[ruleExpression]() {
+												goto l695
+											}
+											if !_rules[ruleRBRK]() {
+												goto l695
+											}
+										l715:
+											{
+												position716, tokenIndex716 := position, tokenIndex
+												if !_rules[ruleDimExpr]() {
+													goto l716
+												}
+												goto l715
+											l716:
+												position, tokenIndex = position716, tokenIndex716
+											}
+										l717:
+											{
+												position718, tokenIndex718 := position, tokenIndex
+												if !_rules[ruleDim]() {
+													goto l718
+												}
+												goto l717
+											l718:
+												position, tokenIndex = position718, tokenIndex718
+											}
+										}
+									l711:
+										add(ruleArrayCreatorRest, position710)
+									}
+								}
+							l699:
+								add(ruleCreator, position696)
+							}
+							goto l693
+						l695:
+							position, tokenIndex = position693, tokenIndex693
+							if !_rules[ruleMethodReference]() {
+								goto l719
+							}
+							goto l693
+						l719:
+							position, tokenIndex = position693, tokenIndex693
+							if !_rules[ruleQualifiedIdentifier]() {
+								goto l673
+							}
+							{
+								position720, tokenIndex720 := position, tokenIndex
+								if !_rules[ruleIdentifierSuffix]() {
+									goto l720
+								}
+								goto l721
+							l720:
+								position, tokenIndex = position720, tokenIndex720
+							}
+						l721:
+						}
+					l693:
+						break
+					case 's':
+						fail("'('")
+						fail("'<'")
+						fail("'this'")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'\\''")
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("'false'")
+						fail("'null'")
+						fail("'true'")
+						fail("[1-9]")
+						fail("'new'")
+						fail("'void'")
+						{
+							position722, tokenIndex722 := position, tokenIndex
+							if !_rules[ruleSUPER]() {
+								goto l723
+							}
+							if !_rules[ruleSuperSuffix]() {
+								goto l723
+							}
+							goto l722
+						l723:
+							position, tokenIndex = position722, tokenIndex722
+							if !_rules[ruleSWITCH]() {
+								goto l724
+							}
+							if !_rules[ruleParExpression]() {
+								goto l724
+							}
+							if !_rules[ruleSwitchBody]() {
+								goto l724
+							}
+							goto l722
+						l724:
+							position, tokenIndex = position722, tokenIndex722
+							if !_rules[ruleMethodReference]() {
+								goto l725
+							}
+							goto l722
+						l725:
+							position, tokenIndex = position722, tokenIndex722
+							if !_rules[ruleQualifiedIdentifier]() {
+								goto l726
+							}
+							{
+								position727, tokenIndex727 := position, tokenIndex
+								if !_rules[ruleIdentifierSuffix]() {
+									goto l727
+								}
+								goto l728
+							l727:
+								position, tokenIndex = position727, tokenIndex727
+							}
+						l728:
+							goto l722
+						l726:
+							position, tokenIndex = position722, tokenIndex722
+							if !_rules[ruleBasicType]() {
+								goto l673
+							}
+						l729:
+							{
+								position730, tokenIndex730 := position, tokenIndex
+								if !_rules[ruleDim]() {
+									goto l730
+								}
+								goto l729
+							l730:
+								position, tokenIndex = position730, tokenIndex730
+							}
+							if !_rules[ruleDOT]() {
+								goto l673
+							}
+							if !_rules[ruleCLASS]() {
+								goto l673
+							}
+						}
+					l722:
+						break
+					case 't':
+						fail("'('")
+						fail("'<'")
+						fail("'super'")
+						fail("'new'")
+						fail("'switch'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'void'")
+						{
+							position731, tokenIndex731 := position, tokenIndex
+							if !_rules[ruleTHIS]() {
+								goto l732
+							}
+							{
+								position733, tokenIndex733 := position, tokenIndex
+								if !_rules[ruleArguments]() {
+									goto l733
+								}
+								goto l734
+							l733:
+								position, tokenIndex = position733, tokenIndex733
+							}
+						l734:
+							goto l731
+						l732:
+							position, tokenIndex = position731, tokenIndex731
+							if !_rules[ruleLiteral]() {
+								goto l735
+							}
+							goto l731
+						l735:
+							position, tokenIndex = position731, tokenIndex731
+							if !_rules[ruleMethodReference]() {
+								goto l736
+							}
+							goto l731
+						l736:
+							position, tokenIndex = position731, tokenIndex731
+							if !_rules[ruleQualifiedIdentifier]() {
+								goto l673
+							}
+							{
+								position737, tokenIndex737 := position, tokenIndex
+								if !_rules[ruleIdentifierSuffix]() {
+									goto l737
+								}
+								goto l738
+							l737:
+								position, tokenIndex = position737, tokenIndex737
+							}
+						l738:
+						}
+					l731:
+						break
+					case 'v':
+						fail("'('")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'\\''")
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("'false'")
+						fail("'null'")
+						fail("'true'")
+						fail("[1-9]")
+						fail("'new'")
+						fail("'switch'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						{
+							position739, tokenIndex739 := position, tokenIndex
+							if !_rules[ruleMethodReference]() {
+								goto l740
+							}
+							goto l739
+						l740:
+							position, tokenIndex = position739, tokenIndex739
+							if !_rules[ruleQualifiedIdentifier]() {
+								goto l741
+							}
+							{
+								position742, tokenIndex742 := position, tokenIndex
+								if !_rules[ruleIdentifierSuffix]() {
+									goto l742
+								}
+								goto l743
+							l742:
+								position, tokenIndex = position742, tokenIndex742
+							}
+						l743:
+							goto l739
+						l741:
+							position, tokenIndex = position739, tokenIndex739
+							if !_rules[ruleVOID]() {
+								goto l673
+							}
+							if !_rules[ruleDOT]() {
+								goto l673
+							}
+							if !_rules[ruleCLASS]() {
+								goto l673
+							}
+						}
+					l739:
+						break
+					default:
+						fail("'('")
+						fail("'<'")
+						fail("'this'")
+						fail("'super'")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'\\''")
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("'false'")
+						fail("'null'")
+						fail("'true'")
+						fail("[1-9]")
+						fail("'new'")
+						fail("'switch'")
+						fail("'char'")
+						fail("'double'")
+						fail("'float'")
+						fail("'int'")
+						fail("'long'")
+						fail("'short'")
+						fail("'b'")
+						fail("'void'")
+						{
+							position744, tokenIndex744 := position, tokenIndex
+							if !_rules[ruleMethodReference]() {
+								goto l745
+							}
+							goto l744
+						l745:
+							position, tokenIndex = position744, tokenIndex744
+							if !_rules[ruleQualifiedIdentifier]() {
+								goto l673
+							}
+							{
+								position746, tokenIndex746 := position, tokenIndex
+								if !_rules[ruleIdentifierSuffix]() {
+									goto l746
+								}
+								goto l747
+							l746:
+								position, tokenIndex = position746, tokenIndex746
+							}
+						l747:
+						}
+					l744:
+						break
+					}
+				}
+
+				add(rulePrimary, position674)
+			}
+			memoize(75, position673, tokenIndex673, true)
+			return true
+		l673:
+			memoize(75, position673, tokenIndex673, false)
+			position, tokenIndex = position673, tokenIndex673
+			return false
+		},
+		/* 76 MethodReference <- <(ReferenceType COLONCOLON NonWildcardTypeArguments? (Identifier / NEW))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{76, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position748, tokenIndex748 := position, tokenIndex
+			{
+				position749 := position
+				if !_rules[ruleReferenceType]() {
+					goto l748
+				}
+				if !_rules[ruleCOLONCOLON]() {
+					goto l748
+				}
+				{
+					position750, tokenIndex750 := position, tokenIndex
+					if !_rules[ruleNonWildcardTypeArguments]() {
+						goto l750
+					}
+					goto l751
+				l750:
+					position, tokenIndex = position750, tokenIndex750
+				}
+			l751:
+				{
+					position752, tokenIndex752 := position, tokenIndex
+					if !_rules[ruleIdentifier]() {
+						goto l753
+					}
+					goto l752
+				l753:
+					position, tokenIndex = position752, tokenIndex752
+					if !_rules[ruleNEW]() {
+						goto l748
+					}
+				}
+			l752:
+				add(ruleMethodReference, position749)
+			}
+			memoize(76, position748, tokenIndex748, true)
+			return true
+		l748:
+			memoize(76, position748, tokenIndex748, false)
+			position, tokenIndex = position748, tokenIndex748
+			return false
+		},
+		/* 77 IdentifierSuffix <- <((&('(') Arguments) | (&('.') (DOT ((&('<') ExplicitGenericInvocation) | (&('n') (NEW NonWildcardTypeArguments? InnerCreator)) | (&('s') (SUPER Arguments)) | (&('t') THIS) | (&('c') %fail('<' 'new' 'super' 'this') CLASS)))) | (&('[') %fail('(' '.') (LBRK ((RBRK Dim* DOT CLASS) / (Expression RBRK)))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{77, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position754, tokenIndex754 := position, tokenIndex
+			{
+				position755 := position
+				{
+					switch buffer[position] {
+					case '(':
+						if !_rules[ruleArguments]() {
+							goto l754
+						}
+					case '.':
+						if !_rules[ruleDOT]() {
+							goto l754
+						}
+						{
+							switch buffer[position] {
+							case '<':
+								if !_rules[ruleExplicitGenericInvocation]() {
+									goto l754
+								}
+							case 'n':
+								if !_rules[ruleNEW]() {
+									goto l754
+								}
+								{
+									position758, tokenIndex758 := position, tokenIndex
+									if !_rules[ruleNonWildcardTypeArguments]() {
+										goto l758
+									}
+									goto l759
+								l758:
+									position, tokenIndex = position758, tokenIndex758
+								}
+							l759:
+								if !_rules[ruleInnerCreator]() {
+									goto l754
+								}
+							case 's':
+								if !_rules[ruleSUPER]() {
+									goto l754
+								}
+								if !_rules[ruleArguments]() {
+									goto l754
+								}
+							case 't':
+								if !_rules[ruleTHIS]() {
+									goto l754
+								}
+							default:
+								fail("'<'")
+								fail("'new'")
+								fail("'super'")
+								fail("'this'")
+								if !_rules[ruleCLASS]() {
+									goto l754
+								}
+							}
+						}
+
+					default:
+						fail("'('")
+						fail("'.'")
+						if !_rules[ruleLBRK]() {
+							goto l754
+						}
+						{
+							position760, tokenIndex760 := position, tokenIndex
+							if !_rules[ruleRBRK]() {
+								goto l761
+							}
+						l762:
+							{
+								position763, tokenIndex763 := position, tokenIndex
+								if !_rules[ruleDim]() {
+									goto l763
+								}
+								goto l762
+							l763:
+								position, tokenIndex = position763, tokenIndex763
+							}
+							if !_rules[ruleDOT]() {
+								goto l761
+							}
+							if !_rules[ruleCLASS]() {
+								goto l761
+							}
+							goto l760
+						l761:
+							position, tokenIndex = position760, tokenIndex760
+							if !_rules[ruleExpression]() {
+								goto l754
+							}
+							if !_rules[ruleRBRK]() {
+								goto l754
+							}
+						}
+					l760:
+						break
+					}
+				}
+
+				add(ruleIdentifierSuffix, position755)
+			}
+			memoize(77, position754, tokenIndex754, true)
+			return true
+		l754:
+			memoize(77, position754, tokenIndex754, false)
+			position, tokenIndex = position754, tokenIndex754
+			return false
+		},
+		/* 78 ExplicitGenericInvocation <- <(NonWildcardTypeArguments ExplicitGenericInvocationSuffix)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{78, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position764, tokenIndex764 := position, tokenIndex
+			{
+				position765 := position
+				if !_rules[ruleNonWildcardTypeArguments]() {
+					goto l764
+				}
+				if !_rules[ruleExplicitGenericInvocationSuffix]() {
+					goto l764
+				}
+				add(ruleExplicitGenericInvocation, position765)
+			}
+			memoize(78, position764, tokenIndex764, true)
+			return true
+		l764:
+			memoize(78, position764, tokenIndex764, false)
+			position, tokenIndex = position764, tokenIndex764
+			return false
+		},
+		/* 79 NonWildcardTypeArguments <- <(LPOINT ReferenceType (COMMA ReferenceType)* RPOINT)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{79, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position766, tokenIndex766 := position, tokenIndex
+			{
+				position767 := position
+				if !_rules[ruleLPOINT]() {
+					goto l766
+				}
+				if !_rules[ruleReferenceType]() {
+					goto l766
+				}
+			l768:
+				{
+					position769, tokenIndex769 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l769
+					}
+					if !_rules[ruleReferenceType]() {
+						goto l769
+					}
+					goto l768
+				l769:
+					position, tokenIndex = position769, tokenIndex769
+				}
+				if !_rules[ruleRPOINT]() {
+					goto l766
+				}
+				add(ruleNonWildcardTypeArguments, position767)
+			}
+			memoize(79, position766, tokenIndex766, true)
+			return true
+		l766:
+			memoize(79, position766, tokenIndex766, false)
+			position, tokenIndex = position766, tokenIndex766
+			return false
+		},
+		/* 80 ExplicitGenericInvocationSuffix <- <((SUPER SuperSuffix) / (Identifier Arguments))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{80, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position770, tokenIndex770 := position, tokenIndex
+			{
+				position771 := position
+				{
+					position772, tokenIndex772 := position, tokenIndex
+					if !_rules[ruleSUPER]() {
+						goto l773
+					}
+					if !_rules[ruleSuperSuffix]() {
+						goto l773
+					}
+					goto l772
+				l773:
+					position, tokenIndex = position772, tokenIndex772
+					if !_rules[ruleIdentifier]() {
+						goto l770
+					}
+					if !_rules[ruleArguments]() {
+						goto l770
+					}
+				}
+			l772:
+				add(ruleExplicitGenericInvocationSuffix, position771)
+			}
+			memoize(80, position770, tokenIndex770, true)
+			return true
+		l770:
+			memoize(80, position770, tokenIndex770, false)
+			position, tokenIndex = position770, tokenIndex770
+			return false
+		},
+		/* 81 PrefixOp <- <((&('+') %fail('--' '!' '~' '-') (INC / PLUS)) | (&('-') %fail('++' '!' '~' '+') (DEC / MINUS)) | (&('~') %fail('++' '--' '!' '+' '-') TILDA) | (&('!') %fail('++' '--' '~' '+' '-') BANG))> */
+		nil,
+		/* 82 PostfixOp <- <(INC / DEC)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{82, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position775, tokenIndex775 := position, tokenIndex
+			{
+				position776 := position
+				{
+					position777, tokenIndex777 := position, tokenIndex
+					if !_rules[ruleINC]() {
+						goto l778
+					}
+					goto l777
+				l778:
+					position, tokenIndex = position777, tokenIndex777
+					if !_rules[ruleDEC]() {
+						goto l775
+					}
+				}
+			l777:
+				add(rulePostfixOp, position776)
+			}
+			memoize(82, position775, tokenIndex775, true)
+			return true
+		l775:
+			memoize(82, position775, tokenIndex775, false)
+			position, tokenIndex = position775, tokenIndex775
+			return false
+		},
+		/* 83 Selector <- <((&(':') (COLONCOLON NonWildcardTypeArguments? Identifier)) | (&('[') DimExpr) | (&('.') %fail('::' '[') (DOT ((&('<') %fail('_' '$' [A-Z] [a-z] 'this' 'super' 'new') ExplicitGenericInvocation) | (&('n') %fail('<' 'this' 'super') ((Identifier Arguments?) / (NEW NonWildcardTypeArguments? InnerCreator))) | (&('s') %fail('<' 'this' 'new') ((Identifier Arguments?) / (SUPER SuperSuffix))) | (&('t') %fail('<' 'super' 'new') ((Identifier Arguments?) / THIS)) | (&('$' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'o' | 'p' | 'q' | 'r' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('<' 'this' 'super' 'new') (Identifier Arguments?))))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{83, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position779, tokenIndex779 := position, tokenIndex
+			{
+				position780 := position
+				{
+					switch buffer[position] {
+					case ':':
+						if !_rules[ruleCOLONCOLON]() {
+							goto l779
+						}
+						{
+							position782, tokenIndex782 := position, tokenIndex
+							if !_rules[ruleNonWildcardTypeArguments]() {
+								goto l782
+							}
+							goto l783
+						l782:
+							position, tokenIndex = position782, tokenIndex782
+						}
+					l783:
+						if !_rules[ruleIdentifier]() {
+							goto l779
+						}
+					case '[':
+						if !_rules[ruleDimExpr]() {
+							goto l779
+						}
+					default:
+						fail("'::'")
+						fail("'['")
+						if !_rules[ruleDOT]() {
+							goto l779
+						}
+						{
+							switch buffer[position] {
+							case '<':
+								fail("'_'")
+								fail("'$'")
+								fail("[A-Z]")
+								fail("[a-z]")
+								fail("'this'")
+								fail("'super'")
+								fail("'new'")
+								if !_rules[ruleExplicitGenericInvocation]() {
+									goto l779
+								}
+							case 'n':
+								fail("'<'")
+								fail("'this'")
+								fail("'super'")
+								{
+									position785, tokenIndex785 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l786
+									}
+									{
+										position787, tokenIndex787 := position, tokenIndex
+										if !_rules[ruleArguments]() {
+											goto l787
+										}
+										goto l788
+									l787:
+										position, tokenIndex = position787, tokenIndex787
+									}
+								l788:
+									goto l785
+								l786:
+									position, tokenIndex = position785, tokenIndex785
+									if !_rules[ruleNEW]() {
+										goto l779
+									}
+									{
+										position789, tokenIndex789 := position, tokenIndex
+										if !_rules[ruleNonWildcardTypeArguments]() {
+											goto l789
+										}
+										goto l790
+									l789:
+										position, tokenIndex = position789, tokenIndex789
+									}
+								l790:
+									if !_rules[ruleInnerCreator]() {
+										goto l779
+									}
+								}
+							l785:
+								break
+							case 's':
+								fail("'<'")
+								fail("'this'")
+								fail("'new'")
+								{
+									position791, tokenIndex791 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l792
+									}
+									{
+										position793, tokenIndex793 := position, tokenIndex
+										if !_rules[ruleArguments]() {
+											goto l793
+										}
+										goto l794
+									l793:
+										position, tokenIndex = position793, tokenIndex793
+									}
+								l794:
+									goto l791
+								l792:
+									position, tokenIndex = position791, tokenIndex791
+									if !_rules[ruleSUPER]() {
+										goto l779
+									}
+									if !_rules[ruleSuperSuffix]() {
+										goto l779
+									}
+								}
+							l791:
+								break
+							case 't':
+								fail("'<'")
+								fail("'super'")
+								fail("'new'")
+								{
+									position795, tokenIndex795 := position, tokenIndex
+									if !_rules[ruleIdentifier]() {
+										goto l796
+									}
+									{
+										position797, tokenIndex797 := position, tokenIndex
+										if !_rules[ruleArguments]() {
+											goto l797
+										}
+										goto l798
+									l797:
+										position, tokenIndex = position797, tokenIndex797
+									}
+								l798:
+									goto l795
+								l796:
+									position, tokenIndex = position795, tokenIndex795
+									if !_rules[ruleTHIS]() {
+										goto l779
+									}
+								}
+							l795:
+								break
+							default:
+								fail("'<'")
+								fail("'this'")
+								fail("'super'")
+								fail("'new'")
+								if !_rules[ruleIdentifier]() {
+									goto l779
+								}
+								{
+									position799, tokenIndex799 := position, tokenIndex
+									if !_rules[ruleArguments]() {
+										goto l799
+									}
+									goto l800
+								l799:
+									position, tokenIndex = position799, tokenIndex799
+								}
+							l800:
+								break
+							}
+						}
+
+					}
+				}
+
+				add(ruleSelector, position780)
+			}
+			memoize(83, position779, tokenIndex779, true)
+			return true
+		l779:
+			memoize(83, position779, tokenIndex779, false)
+			position, tokenIndex = position779, tokenIndex779
+			return false
+		},
+		/* 84 SuperSuffix <- <((&('.') (DOT Identifier Arguments?)) | (&(':') (COLONCOLON NonWildcardTypeArguments? Identifier)) | (&('(') %fail('.' '::') Arguments))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{84, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position801, tokenIndex801 := position, tokenIndex
+			{
+				position802 := position
+				{
+					switch buffer[position] {
+					case '.':
+						if !_rules[ruleDOT]() {
+							goto l801
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l801
+						}
+						{
+							position804, tokenIndex804 := position, tokenIndex
+							if !_rules[ruleArguments]() {
+								goto l804
+							}
+							goto l805
+						l804:
+							position, tokenIndex = position804, tokenIndex804
+						}
+					l805:
+						break
+					case ':':
+						if !_rules[ruleCOLONCOLON]() {
+							goto l801
+						}
+						{
+							position806, tokenIndex806 := position, tokenIndex
+							if !_rules[ruleNonWildcardTypeArguments]() {
+								goto l806
+							}
+							goto l807
+						l806:
+							position, tokenIndex = position806, tokenIndex806
+						}
+					l807:
+						if !_rules[ruleIdentifier]() {
+							goto l801
+						}
+					default:
+						fail("'.'")
+						fail("'::'")
+						if !_rules[ruleArguments]() {
+							goto l801
+						}
+					}
+				}
+
+				add(ruleSuperSuffix, position802)
+			}
+			memoize(84, position801, tokenIndex801, true)
+			return true
+		l801:
+			memoize(84, position801, tokenIndex801, false)
+			position, tokenIndex = position801, tokenIndex801
+			return false
+		},
+		/* 85 BasicType <- <(((&('c') %fail('byte' 'short' 'int' 'long' 'float' 'double' 'boolean') ('c' 'h' 'a' 'r' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('d') %fail('byte' 'short' 'char' 'int' 'long' 'float' 'boolean') ('d' 'o' 'u' 'b' 'l' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('f') %fail('byte' 'short' 'char' 'int' 'long' 'double' 'boolean') ('f' 'l' 'o' 'a' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('i') %fail('byte' 'short' 'char' 'long' 'float' 'double' 'boolean') ('i' 'n' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('l') %fail('byte' 'short' 'char' 'int' 'float' 'double' 'boolean') ('l' 'o' 'n' 'g' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('s') %fail('byte' 'char' 'int' 'long' 'float' 'double' 'boolean') ('s' 'h' 'o' 'r' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('b') %fail('short' 'char' 'int' 'long' 'float' 'double') ('b' (('y' 't' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('o' 'o' 'l' 'e' 'a' 'n' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))))))) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{85, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position808, tokenIndex808 := position, tokenIndex
+			{
+				position809 := position
+				{
+					switch buffer[position] {
+					case 'c':
+						fail("'byte'")
+						fail("'short'")
+						fail("'int'")
+						fail("'long'")
+						fail("'float'")
+						fail("'double'")
+						fail("'boolean'")
+						position++
+						if buffer[position] != rune('h') {
+							fail("'h'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('a') {
+							fail("'a'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('r') {
+							fail("'r'")
+							goto l808
+						}
+						position++
+						{
+							position811, tokenIndex811 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l811
+									}
+									position++
+								}
+							}
+
+							goto l808
+						l811:
+							position, tokenIndex = position811, tokenIndex811
+						}
+					case 'd':
+						fail("'byte'")
+						fail("'short'")
+						fail("'char'")
+						fail("'int'")
+						fail("'long'")
+						fail("'float'")
+						fail("'boolean'")
+						position++
+						if buffer[position] != rune('o') {
+							fail("'o'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('u') {
+							fail("'u'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('b') {
+							fail("'b'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('l') {
+							fail("'l'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('e') {
+							fail("'e'")
+							goto l808
+						}
+						position++
+						{
+							position813, tokenIndex813 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l813
+									}
+									position++
+								}
+							}
+
+							goto l808
+						l813:
+							position, tokenIndex = position813, tokenIndex813
+						}
+					case 'f':
+						fail("'byte'")
+						fail("'short'")
+						fail("'char'")
+						fail("'int'")
+						fail("'long'")
+						fail("'double'")
+						fail("'boolean'")
+						position++
+						if buffer[position] != rune('l') {
+							fail("'l'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('o') {
+							fail("'o'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('a') {
+							fail("'a'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('t') {
+							fail("'t'")
+							goto l808
+						}
+						position++
+						{
+							position815, tokenIndex815 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l815
+									}
+									position++
+								}
+							}
+
+							goto l808
+						l815:
+							position, tokenIndex = position815, tokenIndex815
+						}
+					case 'i':
+						fail("'byte'")
+						fail("'short'")
+						fail("'char'")
+						fail("'long'")
+						fail("'float'")
+						fail("'double'")
+						fail("'boolean'")
+						position++
+						if buffer[position] != rune('n') {
+							fail("'n'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('t') {
+							fail("'t'")
+							goto l808
+						}
+						position++
+						{
+							position817, tokenIndex817 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l817
+									}
+									position++
+								}
+							}
+
+							goto l808
+						l817:
+							position, tokenIndex = position817, tokenIndex817
+						}
+					case 'l':
+						fail("'byte'")
+						fail("'short'")
+						fail("'char'")
+						fail("'int'")
+						fail("'float'")
+						fail("'double'")
+						fail("'boolean'")
+						position++
+						if buffer[position] != rune('o') {
+							fail("'o'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('n') {
+							fail("'n'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('g') {
+							fail("'g'")
+							goto l808
+						}
+						position++
+						{
+							position819, tokenIndex819 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l819
+									}
+									position++
+								}
+							}
+
+							goto l808
+						l819:
+							position, tokenIndex = position819, tokenIndex819
+						}
+					case 's':
+						fail("'byte'")
+						fail("'char'")
+						fail("'int'")
+						fail("'long'")
+						fail("'float'")
+						fail("'double'")
+						fail("'boolean'")
+						position++
+						if buffer[position] != rune('h') {
+							fail("'h'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('o') {
+							fail("'o'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('r') {
+							fail("'r'")
+							goto l808
+						}
+						position++
+						if buffer[position] != rune('t') {
+							fail("'t'")
+							goto l808
+						}
+						position++
+						{
+							position821, tokenIndex821 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l821
+									}
+									position++
+								}
+							}
+
+							goto l808
+						l821:
+							position, tokenIndex = position821, tokenIndex821
+						}
+					default:
+						fail("'short'")
+						fail("'char'")
+						fail("'int'")
+						fail("'long'")
+						fail("'float'")
+						fail("'double'")
+						if buffer[position] != rune('b') {
+							fail("'b'")
+							goto l808
+						}
+						position++
+						{
+							position823, tokenIndex823 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								fail("'y'")
+								goto l824
+							}
+							position++
+							if buffer[position] != rune('t') {
+								fail("'t'")
+								goto l824
+							}
+							position++
+							if buffer[position] != rune('e') {
+								fail("'e'")
+								goto l824
+							}
+							position++
+							{
+								position825, tokenIndex825 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '$':
+										position++
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									case '_':
+										position++
+									default:
+										fail("'$'")
+										fail("[0-9]")
+										fail("[A-Z]")
+										fail("'_'")
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											fail("[a-z]")
+											goto l825
+										}
+										position++
+									}
+								}
+
+								goto l824
+							l825:
+								position, tokenIndex = position825, tokenIndex825
+							}
+							goto l823
+						l824:
+							position, tokenIndex = position823, tokenIndex823
+							if buffer[position] != rune('o') {
+								fail("'o'")
+								goto l808
+							}
+							position++
+							if buffer[position] != rune('o') {
+								fail("'o'")
+								goto l808
+							}
+							position++
+							if buffer[position] != rune('l') {
+								fail("'l'")
+								goto l808
+							}
+							position++
+							if buffer[position] != rune('e') {
+								fail("'e'")
+								goto l808
+							}
+							position++
+							if buffer[position] != rune('a') {
+								fail("'a'")
+								goto l808
+							}
+							position++
+							if buffer[position] != rune('n') {
+								fail("'n'")
+								goto l808
+							}
+							position++
+							{
+								position827, tokenIndex827 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '$':
+										position++
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									case '_':
+										position++
+									default:
+										fail("'$'")
+										fail("[0-9]")
+										fail("[A-Z]")
+										fail("'_'")
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											fail("[a-z]")
+											goto l827
+										}
+										position++
+									}
+								}
+
+								goto l808
+							l827:
+								position, tokenIndex = position827, tokenIndex827
+							}
+						}
+					l823:
+						break
+					}
+				}
+
+				{
+					position829, tokenIndex829 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l829
+					}
+					goto l808
+				l829:
+					position, tokenIndex = position829, tokenIndex829
+				}
+				if !_rules[ruleSpacing]() {
+					goto l808
+				}
+				add(ruleBasicType, position809)
+			}
+			memoize(85, position808, tokenIndex808, true)
+			return true
+		l808:
+			memoize(85, position808, tokenIndex808, false)
+			position, tokenIndex = position808, tokenIndex808
+			return false
+		},
+		/* 86 Arguments <- <(LPAR (Expression (COMMA Expression)*)? RPAR)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{86, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position830, tokenIndex830 := position, tokenIndex
+			{
+				position831 := position
+				if !_rules[ruleLPAR]() {
+					goto l830
+				}
+				{
+					position832, tokenIndex832 := position, tokenIndex
+					if !_rules[ruleExpression]() {
+						goto l832
+					}
+				l834:
+					{
+						position835, tokenIndex835 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l835
+						}
+						if !_rules[ruleExpression]() {
+							goto l835
+						}
+						goto l834
+					l835:
+						position, tokenIndex = position835, tokenIndex835
+					}
+					goto l833
+				l832:
+					position, tokenIndex = position832, tokenIndex832
+				}
+			l833:
+				if !_rules[ruleRPAR]() {
+					goto l830
+				}
+				add(ruleArguments, position831)
+			}
+			memoize(86, position830, tokenIndex830, true)
+			return true
+		l830:
+			memoize(86, position830, tokenIndex830, false)
+			position, tokenIndex = position830, tokenIndex830
+			return false
+		},
+		/* 87 Creator <- <(NonWildcardTypeArguments? ((CreatedName ClassCreatorRest) / ((ClassType / BasicType) ArrayCreatorRest)))> */
+		nil,
+		/* 88 CreatedName <- <(Identifier NonWildcardTypeArguments? (DOT Identifier NonWildcardTypeArguments?)*)> */
+		nil,
+		/* 89 InnerCreator <- <(Identifier ClassCreatorRest)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{89, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position838, tokenIndex838 := position, tokenIndex
+			{
+				position839 := position
+				if !_rules[ruleIdentifier]() {
+					goto l838
+				}
+				if !_rules[ruleClassCreatorRest]() {
+					goto l838
+				}
+				add(ruleInnerCreator, position839)
+			}
+			memoize(89, position838, tokenIndex838, true)
+			return true
+		l838:
+			memoize(89, position838, tokenIndex838, false)
+			position, tokenIndex = position838, tokenIndex838
+			return false
+		},
+		/* 90 ArrayCreatorRest <- <(LBRK ((RBRK Dim* ArrayInitializer) / (Expression RBRK DimExpr* Dim*)))> */
+		nil,
+		/* 91 ClassCreatorRest <- <(Diamond? Arguments ClassBody?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{91, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position841, tokenIndex841 := position, tokenIndex
+			{
+				position842 := position
+				{
+					position843, tokenIndex843 := position, tokenIndex
+					{
+						position845 := position
+						if !_rules[ruleLPOINT]() {
+							goto l843
+						}
+						if !_rules[ruleRPOINT]() {
+							goto l843
+						}
+						add(ruleDiamond, position845)
+					}
+					goto l844
+				l843:
+					position, tokenIndex = position843, tokenIndex843
+				}
+			l844:
+				if !_rules[ruleArguments]() {
+					goto l841
+				}
+				{
+					position846, tokenIndex846 := position, tokenIndex
+					if !_rules[ruleClassBody]() {
+						goto l846
+					}
+					goto l847
+				l846:
+					position, tokenIndex = position846, tokenIndex846
+				}
+			l847:
+				add(ruleClassCreatorRest, position842)
+			}
+			memoize(91, position841, tokenIndex841, true)
+			return true
+		l841:
+			memoize(91, position841, tokenIndex841, false)
+			position, tokenIndex = position841, tokenIndex841
+			return false
+		},
+		/* 92 Diamond <- <(LPOINT RPOINT)> */
+		nil,
+		/* 93 ArrayInitializer <- <(LWING (VariableInitializer (COMMA VariableInitializer)*)? COMMA? RWING)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{93, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position849, tokenIndex849 := position, tokenIndex
+			{
+				position850 := position
+				if !_rules[ruleLWING]() {
+					goto l849
+				}
+				{
+					position851, tokenIndex851 := position, tokenIndex
+					if !_rules[ruleVariableInitializer]() {
+						goto l851
+					}
+				l853:
+					{
+						position854, tokenIndex854 := position, tokenIndex
+						if !_rules[ruleCOMMA]() {
+							goto l854
+						}
+						if !_rules[ruleVariableInitializer]() {
+							goto l854
+						}
+						goto l853
+					l854:
+						position, tokenIndex = position854, tokenIndex854
+					}
+					goto l852
+				l851:
+					position, tokenIndex = position851, tokenIndex851
+				}
+			l852:
+				{
+					position855, tokenIndex855 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l855
+					}
+					goto l856
+				l855:
+					position, tokenIndex = position855, tokenIndex855
+				}
+			l856:
+				if !_rules[ruleRWING]() {
+					goto l849
+				}
+				add(ruleArrayInitializer, position850)
+			}
+			memoize(93, position849, tokenIndex849, true)
+			return true
+		l849:
+			memoize(93, position849, tokenIndex849, false)
+			position, tokenIndex = position849, tokenIndex849
+			return false
+		},
+		/* 94 VariableInitializer <- <(ArrayInitializer / Expression)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{94, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position857, tokenIndex857 := position, tokenIndex
+			{
+				position858 := position
+				{
+					position859, tokenIndex859 := position, tokenIndex
+					if !_rules[ruleArrayInitializer]() {
+						goto l860
+					}
+					goto l859
+				l860:
+					position, tokenIndex = position859, tokenIndex859
+					if !_rules[ruleExpression]() {
+						goto l857
+					}
+				}
+			l859:
+				add(ruleVariableInitializer, position858)
+			}
+			memoize(94, position857, tokenIndex857, true)
+			return true
+		l857:
+			memoize(94, position857, tokenIndex857, false)
+			position, tokenIndex = position857, tokenIndex857
+			return false
+		},
+		/* 95 ParExpression <- <(LPAR Expression RPAR)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{95, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position861, tokenIndex861 := position, tokenIndex
+			{
+				position862 := position
+				if !_rules[ruleLPAR]() {
+					goto l861
+				}
+				if !_rules[ruleExpression]() {
+					goto l861
+				}
+				if !_rules[ruleRPAR]() {
+					goto l861
+				}
+				add(ruleParExpression, position862)
+			}
+			memoize(95, position861, tokenIndex861, true)
+			return true
+		l861:
+			memoize(95, position861, tokenIndex861, false)
+			position, tokenIndex = position861, tokenIndex861
+			return false
+		},
+		/* 96 QualifiedIdentifier <- <(Identifier (DOT Identifier)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{96, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position863, tokenIndex863 := position, tokenIndex
+			{
+				position864 := position
+				if !_rules[ruleIdentifier]() {
+					goto l863
+				}
+			l865:
+				{
+					position866, tokenIndex866 := position, tokenIndex
+					if !_rules[ruleDOT]() {
+						goto l866
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l866
+					}
+					goto l865
+				l866:
+					position, tokenIndex = position866, tokenIndex866
+				}
+				add(ruleQualifiedIdentifier, position864)
+			}
+			memoize(96, position863, tokenIndex863, true)
+			return true
+		l863:
+			memoize(96, position863, tokenIndex863, false)
+			position, tokenIndex = position863, tokenIndex863
+			return false
+		},
+		/* 97 Dim <- <(LBRK RBRK)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{97, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position867, tokenIndex867 := position, tokenIndex
+			{
+				position868 := position
+				if !_rules[ruleLBRK]() {
+					goto l867
+				}
+				if !_rules[ruleRBRK]() {
+					goto l867
+				}
+				add(ruleDim, position868)
+			}
+			memoize(97, position867, tokenIndex867, true)
+			return true
+		l867:
+			memoize(97, position867, tokenIndex867, false)
+			position, tokenIndex = position867, tokenIndex867
+			return false
+		},
+		/* 98 DimExpr <- <(LBRK Expression RBRK)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{98, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position869, tokenIndex869 := position, tokenIndex
+			{
+				position870 := position
+				if !_rules[ruleLBRK]() {
+					goto l869
+				}
+				if !_rules[ruleExpression]() {
+					goto l869
+				}
+				if !_rules[ruleRBRK]() {
+					goto l869
+				}
+				add(ruleDimExpr, position870)
+			}
+			memoize(98, position869, tokenIndex869, true)
+			return true
+		l869:
+			memoize(98, position869, tokenIndex869, false)
+			position, tokenIndex = position869, tokenIndex869
+			return false
+		},
+		/* 99 Type <- <((BasicType / ClassType) Dim*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{99, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position871, tokenIndex871 := position, tokenIndex
+			{
+				position872 := position
+				{
+					position873, tokenIndex873 := position, tokenIndex
+					if !_rules[ruleBasicType]() {
+						goto l874
+					}
+					goto l873
+				l874:
+					position, tokenIndex = position873, tokenIndex873
+					if !_rules[ruleClassType]() {
+						goto l871
+					}
+				}
+			l873:
+			l875:
+				{
+					position876, tokenIndex876 := position, tokenIndex
+					if !_rules[ruleDim]() {
+						goto l876
+					}
+					goto l875
+				l876:
+					position, tokenIndex = position876, tokenIndex876
+				}
+				add(ruleType, position872)
+			}
+			memoize(99, position871, tokenIndex871, true)
+			return true
+		l871:
+			memoize(99, position871, tokenIndex871, false)
+			position, tokenIndex = position871, tokenIndex871
+			return false
+		},
+		/* 100 ReferenceType <- <((BasicType Dim+) / (ClassType Dim*))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{100, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position877, tokenIndex877 := position, tokenIndex
+			{
+				position878 := position
+				{
+					position879, tokenIndex879 := position, tokenIndex
+					if !_rules[ruleBasicType]() {
+						goto l880
+					}
+					if !_rules[ruleDim]() {
+						goto l880
+					}
+				l881:
+					{
+						position882, tokenIndex882 := position, tokenIndex
+						if !_rules[ruleDim]() {
+							goto l882
+						}
+						goto l881
+					l882:
+						position, tokenIndex = position882, tokenIndex882
+					}
+					goto l879
+				l880:
+					position, tokenIndex = position879, tokenIndex879
+					if !_rules[ruleClassType]() {
+						goto l877
+					}
+				l883:
+					{
+						position884, tokenIndex884 := position, tokenIndex
+						if !_rules[ruleDim]() {
+							goto l884
+						}
+						goto l883
+					l884:
+						position, tokenIndex = position884, tokenIndex884
+					}
+				}
+			l879:
+				add(ruleReferenceType, position878)
+			}
+			memoize(100, position877, tokenIndex877, true)
+			return true
+		l877:
+			memoize(100, position877, tokenIndex877, false)
+			position, tokenIndex = position877, tokenIndex877
+			return false
+		},
+		/* 101 ClassType <- <(Identifier TypeArguments? (DOT Identifier TypeArguments?)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{101, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position885, tokenIndex885 := position, tokenIndex
+			{
+				position886 := position
+				if !_rules[ruleIdentifier]() {
+					goto l885
+				}
+				{
+					position887, tokenIndex887 := position, tokenIndex
+					if !_rules[ruleTypeArguments]() {
+						goto l887
+					}
+					goto l888
+				l887:
+					position, tokenIndex = position887, tokenIndex887
+				}
+			l888:
+			l889:
+				{
+					position890, tokenIndex890 := position, tokenIndex
+					if !_rules[ruleDOT]() {
+						goto l890
+					}
+					if !_rules[ruleIdentifier]() {
+						goto l890
+					}
+					{
+						position891, tokenIndex891 := position, tokenIndex
+						if !_rules[ruleTypeArguments]() {
+							goto l891
+						}
+						goto l892
+					l891:
+						position, tokenIndex = position891, tokenIndex891
+					}
+				l892:
+					goto l889
+				l890:
+					position, tokenIndex = position890, tokenIndex890
+				}
+				add(ruleClassType, position886)
+			}
+			memoize(101, position885, tokenIndex885, true)
+			return true
+		l885:
+			memoize(101, position885, tokenIndex885, false)
+			position, tokenIndex = position885, tokenIndex885
+			return false
+		},
+		/* 102 ClassTypeList <- <(ClassType (COMMA ClassType)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{102, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position893, tokenIndex893 := position, tokenIndex
+			{
+				position894 := position
+				if !_rules[ruleClassType]() {
+					goto l893
+				}
+			l895:
+				{
+					position896, tokenIndex896 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l896
+					}
+					if !_rules[ruleClassType]() {
+						goto l896
+					}
+					goto l895
+				l896:
+					position, tokenIndex = position896, tokenIndex896
+				}
+				add(ruleClassTypeList, position894)
+			}
+			memoize(102, position893, tokenIndex893, true)
+			return true
+		l893:
+			memoize(102, position893, tokenIndex893, false)
+			position, tokenIndex = position893, tokenIndex893
+			return false
+		},
+		/* 103 TypeArguments <- <(LPOINT TypeArgument (COMMA TypeArgument)* RPOINT)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{103, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position897, tokenIndex897 := position, tokenIndex
+			{
+				position898 := position
+				if !_rules[ruleLPOINT]() {
+					goto l897
+				}
+				if !_rules[ruleTypeArgument]() {
+					goto l897
+				}
+			l899:
+				{
+					position900, tokenIndex900 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l900
+					}
+					if !_rules[ruleTypeArgument]() {
+						goto l900
+					}
+					goto l899
+				l900:
+					position, tokenIndex = position900, tokenIndex900
+				}
+				if !_rules[ruleRPOINT]() {
+					goto l897
+				}
+				add(ruleTypeArguments, position898)
+			}
+			memoize(103, position897, tokenIndex897, true)
+			return true
+		l897:
+			memoize(103, position897, tokenIndex897, false)
+			position, tokenIndex = position897, tokenIndex897
+			return false
+		},
+		/* 104 TypeArgument <- <(ReferenceType / (QUERY ((EXTENDS / SUPER) ReferenceType)?))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{104, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position901, tokenIndex901 := position, tokenIndex
+			{
+				position902 := position
+				{
+					position903, tokenIndex903 := position, tokenIndex
+					if !_rules[ruleReferenceType]() {
+						goto l904
+					}
+					goto l903
+				l904:
+					position, tokenIndex = position903, tokenIndex903
+					if !_rules[ruleQUERY]() {
+						goto l901
+					}
+					{
+						position905, tokenIndex905 := position, tokenIndex
+						{
+							position907, tokenIndex907 := position, tokenIndex
+							if !_rules[ruleEXTENDS]() {
+								goto l908
+							}
+							goto l907
+						l908:
+							position, tokenIndex = position907, tokenIndex907
+							if !_rules[ruleSUPER]() {
+								goto l905
+							}
+						}
+					l907:
+						if !_rules[ruleReferenceType]() {
+							goto l905
+						}
+						goto l906
+					l905:
+						position, tokenIndex = position905, tokenIndex905
+					}
+				l906:
+				}
+			l903:
+				add(ruleTypeArgument, position902)
+			}
+			memoize(104, position901, tokenIndex901, true)
+			return true
+		l901:
+			memoize(104, position901, tokenIndex901, false)
+			position, tokenIndex = position901, tokenIndex901
+			return false
+		},
+		/* 105 TypeParameters <- <(LPOINT TypeParameter (COMMA TypeParameter)* RPOINT)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{105, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position909, tokenIndex909 := position, tokenIndex
+			{
+				position910 := position
+				if !_rules[ruleLPOINT]() {
+					goto l909
+				}
+				if !_rules[ruleTypeParameter]() {
+					goto l909
+				}
+			l911:
+				{
+					position912, tokenIndex912 := position, tokenIndex
+					if !_rules[ruleCOMMA]() {
+						goto l912
+					}
+					if !_rules[ruleTypeParameter]() {
+						goto l912
+					}
+					goto l911
+				l912:
+					position, tokenIndex = position912, tokenIndex912
+				}
+				if !_rules[ruleRPOINT]() {
+					goto l909
+				}
+				add(ruleTypeParameters, position910)
+			}
+			memoize(105, position909, tokenIndex909, true)
+			return true
+		l909:
+			memoize(105, position909, tokenIndex909, false)
+			position, tokenIndex = position909, tokenIndex909
+			return false
+		},
+		/* 106 TypeParameter <- <(Identifier (EXTENDS Bound)?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{106, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position913, tokenIndex913 := position, tokenIndex
+			{
+				position914 := position
+				if !_rules[ruleIdentifier]() {
+					goto l913
+				}
+				{
+					position915, tokenIndex915 := position, tokenIndex
+					if !_rules[ruleEXTENDS]() {
+						goto l915
+					}
+					{
+						position917 := position
+						if !_rules[ruleClassType]() {
+							goto l915
+						}
+					l918:
+						{
+							position919, tokenIndex919 := position, tokenIndex
+							if !_rules[ruleAND]() {
+								goto l919
+							}
+							if !_rules[ruleClassType]() {
+								goto l919
+							}
+							goto l918
+						l919:
+							position, tokenIndex = position919, tokenIndex919
+						}
+						add(ruleBound, position917)
+					}
+					goto l916
+				l915:
+					position, tokenIndex = position915, tokenIndex915
+				}
+			l916:
+				add(ruleTypeParameter, position914)
+			}
+			memoize(106, position913, tokenIndex913, true)
+			return true
+		l913:
+			memoize(106, position913, tokenIndex913, false)
+			position, tokenIndex = position913, tokenIndex913
+			return false
+		},
+		/* 107 Bound <- <(ClassType (AND ClassType)*)> */
+		nil,
+		/* 108 Modifier <- <((&('@') %fail('default' 'final' 'native' 'p' 's' 'sealed' 'transient' 'volatile' 'abstract' 'non-sealed') Annotation) | (&('n') %fail('@') ((((&('d') %fail('p' 'static' 'abstract' 'final' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'sealed') ('d' 'e' 'f' 'a' 'u' 'l' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('f') %fail('p' 'static' 'abstract' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('f' 'i' 'n' 'a' 'l' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('n') %fail('p' 'static' 'abstract' 'final' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('n' 'a' 't' 'i' 'v' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('p') %fail('static' 'abstract' 'final' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('p' (('u' 'b' 'l' 'i' 'c' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('r' (('o' 't' 'e' 'c' 't' 'e' 'd' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('i' 'v' 'a' 't' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))))))) | (&('s') %fail('p' 'abstract' 'final' 'native' 'transient' 'volatile' 'default') (('s' ((&('y') %fail('t') ('y' 'n' 'c' 'h' 'r' 'o' 'n' 'i' 'z' 'e' 'd' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('t') %fail('y') ('t' (('a' 't' 'i' 'c' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('r' 'i' 'c' 't' 'f' 'p' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))))))) / %keyword('sealed'))) | (&('t') %fail('p' 'static' 'abstract' 'final' 'native' 'synchronized' 'volatile' 'strictfp' 'default' 'sealed') ('t' 'r' 'a' 'n' 's' 'i' 'e' 'n' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('v') %fail('p' 'static' 'abstract' 'final' 'native' 'synchronized' 'transient' 'strictfp' 'default' 'sealed') ('v' 'o' 'l' 'a' 't' 'i' 'l' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('a') %fail('p' 'static' 'final' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('a' 'b' 's' 't' 'r' 'a' 'c' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))))) !LetterOrDigit Spacing) / ('n' 'o' 'n' '-' 's' 'e' 'a' 'l' 'e' 'd' !LetterOrDigit Spacing))) | (&('a' | 'd' | 'f' | 'p' | 's' | 't' | 'v') %fail('@' 'non-sealed') (((&('d') %fail('p' 'static' 'abstract' 'final' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'sealed') ('d' 'e' 'f' 'a' 'u' 'l' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('f') %fail('p' 'static' 'abstract' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('f' 'i' 'n' 'a' 'l' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('n') %fail('p' 'static' 'abstract' 'final' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('n' 'a' 't' 'i' 'v' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('p') %fail('static' 'abstract' 'final' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('p' (('u' 'b' 'l' 'i' 'c' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('r' (('o' 't' 'e' 'c' 't' 'e' 'd' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('i' 'v' 'a' 't' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))))))) | (&('s') %fail('p' 'abstract' 'final' 'native' 'transient' 'volatile' 'default') (('s' ((&('y') %fail('t') ('y' 'n' 'c' 'h' 'r' 'o' 'n' 'i' 'z' 'e' 'd' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('t') %fail('y') ('t' (('a' 't' 'i' 'c' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('r' 'i' 'c' 't' 'f' 'p' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))))))) / %keyword('sealed'))) | (&('t') %fail('p' 'static' 'abstract' 'final' 'native' 'synchronized' 'volatile' 'strictfp' 'default' 'sealed') ('t' 'r' 'a' 'n' 's' 'i' 'e' 'n' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('v') %fail('p' 'static' 'abstract' 'final' 'native' 'synchronized' 'transient' 'strictfp' 'default' 'sealed') ('v' 'o' 'l' 'a' 't' 'i' 'l' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))) | (&('a') %fail('p' 'static' 'final' 'native' 'synchronized' 'transient' 'volatile' 'strictfp' 'default' 'sealed') ('a' 'b' 's' 't' 'r' 'a' 'c' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))))) !LetterOrDigit Spacing)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{108, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position921, tokenIndex921 := position, tokenIndex
+			{
+				position922 := position
+				{
+					switch buffer[position] {
+					case '@':
+						fail("'default'")
+						fail("'final'")
+						fail("'native'")
+						fail("'p'")
+						fail("'s'")
+						fail("'sealed'")
+						fail("'transient'")
+						fail("'volatile'")
+						fail("'abstract'")
+						fail("'non-sealed'")
+						if !_rules[ruleAnnotation]() {
+							goto l921
+						}
+					case 'n':
+						fail("'@'")
+						{
+							position924, tokenIndex924 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case 'd':
+									fail("'p'")
+									fail("'static'")
+									fail("'abstract'")
+									fail("'final'")
+									fail("'native'")
+									fail("'synchronized'")
+									fail("'transient'")
+									fail("'volatile'")
+									fail("'strictfp'")
+									fail("'sealed'")
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('f') {
+										fail("'f'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('u') {
+										fail("'u'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('l') {
+										fail("'l'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l925
+									}
+									position++
+									{
+										position927, tokenIndex927 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l927
+												}
+												position++
+											}
+										}
+
+										goto l925
+									l927:
+										position, tokenIndex = position927, tokenIndex927
+									}
+								case 'f':
+									fail("'p'")
+									fail("'static'")
+									fail("'abstract'")
+									fail("'native'")
+									fail("'synchronized'")
+									fail("'transient'")
+									fail("'volatile'")
+									fail("'strictfp'")
+									fail("'default'")
+									fail("'sealed'")
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('n') {
+										fail("'n'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('l') {
+										fail("'l'")
+										goto l925
+									}
+									position++
+									{
+										position929, tokenIndex929 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l929
+												}
+												position++
+											}
+										}
+
+										goto l925
+									l929:
+										position, tokenIndex = position929, tokenIndex929
+									}
+								case 'n':
+									fail("'p'")
+									fail("'static'")
+									fail("'abstract'")
+									fail("'final'")
+									fail("'synchronized'")
+									fail("'transient'")
+									fail("'volatile'")
+									fail("'strictfp'")
+									fail("'default'")
+									fail("'sealed'")
+									position++
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('v') {
+										fail("'v'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l925
+									}
+									position++
+									{
+										position931, tokenIndex931 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l931
+												}
+												position++
+											}
+										}
+
+										goto l925
+									l931:
+										position, tokenIndex = position931, tokenIndex931
+									}
+								case 'p':
+									fail("'static'")
+									fail("'abstract'")
+									fail("'final'")
+									fail("'native'")
+									fail("'synchronized'")
+									fail("'transient'")
+									fail("'volatile'")
+									fail("'strictfp'")
+									fail("'default'")
+									fail("'sealed'")
+									position++
+									{
+										position933, tokenIndex933 := position, tokenIndex
+										if buffer[position] != rune('u') {
+											fail("'u'")
+											goto l934
+										}
+										position++
+										if buffer[position] != rune('b') {
+											fail("'b'")
+											goto l934
+										}
+										position++
+										if buffer[position] != rune('l') {
+											fail("'l'")
+											goto l934
+										}
+										position++
+										if buffer[position] != rune('i') {
+											fail("'i'")
+											goto l934
+										}
+										position++
+										if buffer[position] != rune('c') {
+											fail("'c'")
+											goto l934
+										}
+										position++
+										{
+											position935, tokenIndex935 := position, tokenIndex
+											{
+												switch buffer[position] {
+												case '$':
+													position++
+												case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+													position++
+												case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+													position++
+												case '_':
+													position++
+												default:
+													fail("'$'")
+													fail("[0-9]")
+													fail("[A-Z]")
+													fail("'_'")
+													if c := buffer[position]; c < rune('a') || c > rune('z') {
+														fail("[a-z]")
+														goto l935
+													}
+													position++
+												}
+											}
+
+											goto l934
+										l935:
+											position, tokenIndex = position935, tokenIndex935
+										}
+										goto l933
+									l934:
+										position, tokenIndex = position933, tokenIndex933
+										if buffer[position] != rune('r') {
+											fail("'r'")
+											goto l925
+										}
+										position++
+										{
+											position937, tokenIndex937 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												fail("'o'")
+												goto l938
+											}
+											position++
+											if buffer[position] != rune('t') {
+												fail("'t'")
+												goto l938
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l938
+											}
+											position++
+											if buffer[position] != rune('c') {
+												fail("'c'")
+												goto l938
+											}
+											position++
+											if buffer[position] != rune('t') {
+												fail("'t'")
+												goto l938
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l938
+											}
+											position++
+											if buffer[position] != rune('d') {
+												fail("'d'")
+												goto l938
+											}
+											position++
+											{
+												position939, tokenIndex939 := position, tokenIndex
+												{
+													switch buffer[position] {
+													case '$':
+														position++
+													case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+														position++
+													case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+														position++
+													case '_':
+														position++
+													default:
+														fail("'$'")
+														fail("[0-9]")
+														fail("[A-Z]")
+														fail("'_'")
+														if c := buffer[position]; c < rune('a') || c > rune('z') {
+															fail("[a-z]")
+															goto l939
+														}
+														position++
+													}
+												}
+
+												goto l938
+											l939:
+												position, tokenIndex = position939, tokenIndex939
+											}
+											goto l937
+										l938:
+											position, tokenIndex = position937, tokenIndex937
+											if buffer[position] != rune('i') {
+												fail("'i'")
+												goto l925
+											}
+											position++
+											if buffer[position] != rune('v') {
+												fail("'v'")
+												goto l925
+											}
+											position++
+											if buffer[position] != rune('a') {
+												fail("'a'")
+												goto l925
+											}
+											position++
+											if buffer[position] != rune('t') {
+												fail("'t'")
+												goto l925
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l925
+											}
+											position++
+											{
+												position941, tokenIndex941 := position, tokenIndex
+												{
+													switch buffer[position] {
+													case '$':
+														position++
+													case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+														position++
+													case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+														position++
+													case '_':
+														position++
+													default:
+														fail("'$'")
+														fail("[0-9]")
+														fail("[A-Z]")
+														fail("'_'")
+														if c := buffer[position]; c < rune('a') || c > rune('z') {
+															fail("[a-z]")
+															goto l941
+														}
+														position++
+													}
+												}
+
+												goto l925
+											l941:
+												position, tokenIndex = position941, tokenIndex941
+											}
+										}
+									l937:
+									}
+								l933:
+									break
+								case 's':
+									fail("'p'")
+									fail("'abstract'")
+									fail("'final'")
+									fail("'native'")
+									fail("'transient'")
+									fail("'volatile'")
+									fail("'default'")
+									{
+										position943, tokenIndex943 := position, tokenIndex
+										if buffer[position] != rune('s') {
+											fail("'s'")
+											goto l944
+										}
+										position++
+										{
+											switch buffer[position] {
+											case 'y':
+												fail("'t'")
+												position++
+												if buffer[position] != rune('n') {
+													fail("'n'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('c') {
+													fail("'c'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('h') {
+													fail("'h'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('r') {
+													fail("'r'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('o') {
+													fail("'o'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('n') {
+													fail("'n'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('i') {
+													fail("'i'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('z') {
+													fail("'z'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('e') {
+													fail("'e'")
+													goto l944
+												}
+												position++
+												if buffer[position] != rune('d') {
+													fail("'d'")
+													goto l944
+												}
+												position++
+												{
+													position946, tokenIndex946 := position, tokenIndex
+													{
+														switch buffer[position] {
+														case '$':
+															position++
+														case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+															position++
+														case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+															position++
+														case '_':
+															position++
+														default:
+															fail("'$'")
+															fail("[0-9]")
+															fail("[A-Z]")
+															fail("'_'")
+															if c := buffer[position]; c < rune('a') || c > rune('z') {
+																fail("[a-z]")
+																goto l946
+															}
+															position++
+														}
+													}
+
+													goto l944
+												l946:
+													position, tokenIndex = position946, tokenIndex946
+												}
+											default:
+												fail("'y'")
+												if buffer[position] != rune('t') {
+													fail("'t'")
+													goto l944
+												}
+												position++
+												{
+													position948, tokenIndex948 := position, tokenIndex
+													if buffer[position] != rune('a') {
+														fail("'a'")
+														goto l949
+													}
+													position++
+													if buffer[position] != rune('t') {
+														fail("'t'")
+														goto l949
+													}
+													position++
+													if buffer[position] != rune('i') {
+														fail("'i'")
+														goto l949
+													}
+													position++
+													if buffer[position] != rune('c') {
+														fail("'c'")
+														goto l949
+													}
+													position++
+													{
+														position950, tokenIndex950 := position, tokenIndex
+														{
+															switch buffer[position] {
+															case '$':
+																position++
+															case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+																position++
+															case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+																position++
+															case '_':
+																position++
+															default:
+																fail("'$'")
+																fail("[0-9]")
+																fail("[A-Z]")
+																fail("'_'")
+																if c := buffer[position]; c < rune('a') || c > rune('z') {
+																	fail("[a-z]")
+																	goto l950
+																}
+																position++
+															}
+														}
+
+														goto l949
+													l950:
+														position, tokenIndex = position950, tokenIndex950
+													}
+													goto l948
+												l949:
+													position, tokenIndex = position948, tokenIndex948
+													if buffer[position] != rune('r') {
+														fail("'r'")
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('i') {
+														fail("'i'")
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('c') {
+														fail("'c'")
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('t') {
+														fail("'t'")
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('f') {
+														fail("'f'")
+														goto l944
+													}
+													position++
+													if buffer[position] != rune('p') {
+														fail("'p'")
+														goto l944
+													}
+													position++
+													{
+														position952, tokenIndex952 := position, tokenIndex
+														{
+															switch buffer[position] {
+															case '$':
+																position++
+															case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+																position++
+															case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+																position++
+															case '_':
+																position++
+															default:
+																fail("'$'")
+																fail("[0-9]")
+																fail("[A-Z]")
+																fail("'_'")
+																if c := buffer[position]; c < rune('a') || c > rune('z') {
+																	fail("[a-z]")
+																	goto l952
+																}
+																position++
+															}
+														}
+
+														goto l944
+													l952:
+														position, tokenIndex = position952, tokenIndex952
+													}
+												}
+											l948:
+												break
+											}
+										}
+
+										goto l943
+									l944:
+										position, tokenIndex = position943, tokenIndex943
+										if !matchKeyword("sealed") {
+											fail("'sealed'")
+											goto l925
+										}
+									}
+								l943:
+									break
+								case 't':
+									fail("'p'")
+									fail("'static'")
+									fail("'abstract'")
+									fail("'final'")
+									fail("'native'")
+									fail("'synchronized'")
+									fail("'volatile'")
+									fail("'strictfp'")
+									fail("'default'")
+									fail("'sealed'")
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('n') {
+										fail("'n'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('s') {
+										fail("'s'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('n') {
+										fail("'n'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l925
+									}
+									position++
+									{
+										position954, tokenIndex954 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l954
+												}
+												position++
+											}
+										}
+
+										goto l925
+									l954:
+										position, tokenIndex = position954, tokenIndex954
+									}
+								case 'v':
+									fail("'p'")
+									fail("'static'")
+									fail("'abstract'")
+									fail("'final'")
+									fail("'native'")
+									fail("'synchronized'")
+									fail("'transient'")
+									fail("'strictfp'")
+									fail("'default'")
+									fail("'sealed'")
+									position++
+									if buffer[position] != rune('o') {
+										fail("'o'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('l') {
+										fail("'l'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('l') {
+										fail("'l'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('e') {
+										fail("'e'")
+										goto l925
+									}
+									position++
+									{
+										position956, tokenIndex956 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l956
+												}
+												position++
+											}
+										}
+
+										goto l925
+									l956:
+										position, tokenIndex = position956, tokenIndex956
+									}
+								default:
+									fail("'p'")
+									fail("'static'")
+									fail("'final'")
+									fail("'native'")
+									fail("'synchronized'")
+									fail("'transient'")
+									fail("'volatile'")
+									fail("'strictfp'")
+									fail("'default'")
+									fail("'sealed'")
+									if buffer[position] != rune('a') {
+										fail("'abstract'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('b') {
+										fail("'b'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('s') {
+										fail("'s'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('a') {
+										fail("'a'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('c') {
+										fail("'c'")
+										goto l925
+									}
+									position++
+									if buffer[position] != rune('t') {
+										fail("'t'")
+										goto l925
+									}
+									position++
+									{
+										position958, tokenIndex958 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l958
+												}
+												position++
+											}
+										}
+
+										goto l925
+									l958:
+										position, tokenIndex = position958, tokenIndex958
+									}
+								}
+							}
+
+							{
+								position960, tokenIndex960 := position, tokenIndex
+								if !_rules[ruleLetterOrDigit]() {
+									goto l960
+								}
+								goto l925
+							l960:
+								position, tokenIndex = position960, tokenIndex960
+							}
+							if !_rules[ruleSpacing]() {
+								goto l925
+							}
+							goto l924
+						l925:
+							position, tokenIndex = position924, tokenIndex924
+							if buffer[position] != rune('n') {
+								fail("'non-sealed'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('o') {
+								fail("'o'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('n') {
+								fail("'n'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('-') {
+								fail("'-'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('s') {
+								fail("'s'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('e') {
+								fail("'e'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('a') {
+								fail("'a'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('l') {
+								fail("'l'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('e') {
+								fail("'e'")
+								goto l921
+							}
+							position++
+							if buffer[position] != rune('d') {
+								fail("'d'")
+								goto l921
+							}
+							position++
+							{
+								position961, tokenIndex961 := position, tokenIndex
+								if !_rules[ruleLetterOrDigit]() {
+									goto l961
+								}
+								goto l921
+							l961:
+								position, tokenIndex = position961, tokenIndex961
+							}
+							if !_rules[ruleSpacing]() {
+								goto l921
+							}
+						}
+					l924:
+						break
+					default:
+						fail("'@'")
+						fail("'non-sealed'")
+						{
+							switch buffer[position] {
+							case 'd':
+								fail("'p'")
+								fail("'static'")
+								fail("'abstract'")
+								fail("'final'")
+								fail("'native'")
+								fail("'synchronized'")
+								fail("'transient'")
+								fail("'volatile'")
+								fail("'strictfp'")
+								fail("'sealed'")
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('f') {
+									fail("'f'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('a') {
+									fail("'a'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('u') {
+									fail("'u'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('l') {
+									fail("'l'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l921
+								}
+								position++
+								{
+									position963, tokenIndex963 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l963
+											}
+											position++
+										}
+									}
+
+									goto l921
+								l963:
+									position, tokenIndex = position963, tokenIndex963
+								}
+							case 'f':
+								fail("'p'")
+								fail("'static'")
+								fail("'abstract'")
+								fail("'native'")
+								fail("'synchronized'")
+								fail("'transient'")
+								fail("'volatile'")
+								fail("'strictfp'")
+								fail("'default'")
+								fail("'sealed'")
+								position++
+								if buffer[position] != rune('i') {
+									fail("'i'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('a') {
+									fail("'a'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('l') {
+									fail("'l'")
+									goto l921
+								}
+								position++
+								{
+									position965, tokenIndex965 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l965
+											}
+											position++
+										}
+									}
+
+									goto l921
+								l965:
+									position, tokenIndex = position965, tokenIndex965
+								}
+							case 'n':
+								fail("'p'")
+								fail("'static'")
+								fail("'abstract'")
+								fail("'final'")
+								fail("'synchronized'")
+								fail("'transient'")
+								fail("'volatile'")
+								fail("'strictfp'")
+								fail("'default'")
+								fail("'sealed'")
+								position++
+								if buffer[position] != rune('a') {
+									fail("'a'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('i') {
+									fail("'i'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('v') {
+									fail("'v'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l921
+								}
+								position++
+								{
+									position967, tokenIndex967 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l967
+											}
+											position++
+										}
+									}
+
+									goto l921
+								l967:
+									position, tokenIndex = position967, tokenIndex967
+								}
+							case 'p':
+								fail("'static'")
+								fail("'abstract'")
+								fail("'final'")
+								fail("'native'")
+								fail("'synchronized'")
+								fail("'transient'")
+								fail("'volatile'")
+								fail("'strictfp'")
+								fail("'default'")
+								fail("'sealed'")
+								position++
+								{
+									position969, tokenIndex969 := position, tokenIndex
+									if buffer[position] != rune('u') {
+										fail("'u'")
+										goto l970
+									}
+									position++
+									if buffer[position] != rune('b') {
+										fail("'b'")
+										goto l970
+									}
+									position++
+									if buffer[position] != rune('l') {
+										fail("'l'")
+										goto l970
+									}
+									position++
+									if buffer[position] != rune('i') {
+										fail("'i'")
+										goto l970
+									}
+									position++
+									if buffer[position] != rune('c') {
+										fail("'c'")
+										goto l970
+									}
+									position++
+									{
+										position971, tokenIndex971 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l971
+												}
+												position++
+											}
+										}
+
+										goto l970
+									l971:
+										position, tokenIndex = position971, tokenIndex971
+									}
+									goto l969
+								l970:
+									position, tokenIndex = position969, tokenIndex969
+									if buffer[position] != rune('r') {
+										fail("'r'")
+										goto l921
+									}
+									position++
+									{
+										position973, tokenIndex973 := position, tokenIndex
+										if buffer[position] != rune('o') {
+											fail("'o'")
+											goto l974
+										}
+										position++
+										if buffer[position] != rune('t') {
+											fail("'t'")
+											goto l974
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l974
+										}
+										position++
+										if buffer[position] != rune('c') {
+											fail("'c'")
+											goto l974
+										}
+										position++
+										if buffer[position] != rune('t') {
+											fail("'t'")
+											goto l974
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l974
+										}
+										position++
+										if buffer[position] != rune('d') {
+											fail("'d'")
+											goto l974
+										}
+										position++
+										{
+											position975, tokenIndex975 := position, tokenIndex
+											{
+												switch buffer[position] {
+												case '$':
+													position++
+												case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+													position++
+												case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+													position++
+												case '_':
+													position++
+												default:
+													fail("'$'")
+													fail("[0-9]")
+													fail("[A-Z]")
+													fail("'_'")
+													if c := buffer[position]; c < rune('a') || c > rune('z') {
+														fail("[a-z]")
+														goto l975
+													}
+													position++
+												}
+											}
+
+											goto l974
+										l975:
+											position, tokenIndex = position975, tokenIndex975
+										}
+										goto l973
+									l974:
+										position, tokenIndex = position973, tokenIndex973
+										if buffer[position] != rune('i') {
+											fail("'i'")
+											goto l921
+										}
+										position++
+										if buffer[position] != rune('v') {
+											fail("'v'")
+											goto l921
+										}
+										position++
+										if buffer[position] != rune('a') {
+											fail("'a'")
+											goto l921
+										}
+										position++
+										if buffer[position] != rune('t') {
+											fail("'t'")
+											goto l921
+										}
+										position++
+										if buffer[position] != rune('e') {
+											fail("'e'")
+											goto l921
+										}
+										position++
+										{
+											position977, tokenIndex977 := position, tokenIndex
+											{
+												switch buffer[position] {
+												case '$':
+													position++
+												case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+													position++
+												case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+													position++
+												case '_':
+													position++
+												default:
+													fail("'$'")
+													fail("[0-9]")
+													fail("[A-Z]")
+													fail("'_'")
+													if c := buffer[position]; c < rune('a') || c > rune('z') {
+														fail("[a-z]")
+														goto l977
+													}
+													position++
+												}
+											}
+
+											goto l921
+										l977:
+											position, tokenIndex = position977, tokenIndex977
+										}
+									}
+								l973:
+								}
+							l969:
+								break
+							case 's':
+								fail("'p'")
+								fail("'abstract'")
+								fail("'final'")
+								fail("'native'")
+								fail("'transient'")
+								fail("'volatile'")
+								fail("'default'")
+								{
+									position979, tokenIndex979 := position, tokenIndex
+									if buffer[position] != rune('s') {
+										fail("'s'")
+										goto l980
+									}
+									position++
+									{
+										switch buffer[position] {
+										case 'y':
+											fail("'t'")
+											position++
+											if buffer[position] != rune('n') {
+												fail("'n'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('c') {
+												fail("'c'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('h') {
+												fail("'h'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('r') {
+												fail("'r'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('o') {
+												fail("'o'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('n') {
+												fail("'n'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('i') {
+												fail("'i'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('z') {
+												fail("'z'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('e') {
+												fail("'e'")
+												goto l980
+											}
+											position++
+											if buffer[position] != rune('d') {
+												fail("'d'")
+												goto l980
+											}
+											position++
+											{
+												position982, tokenIndex982 := position, tokenIndex
+												{
+													switch buffer[position] {
+													case '$':
+														position++
+													case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+														position++
+													case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+														position++
+													case '_':
+														position++
+													default:
+														fail("'$'")
+														fail("[0-9]")
+														fail("[A-Z]")
+														fail("'_'")
+														if c := buffer[position]; c < rune('a') || c > rune('z') {
+															fail("[a-z]")
+															goto l982
+														}
+														position++
+													}
+												}
+
+												goto l980
+											l982:
+												position, tokenIndex = position982, tokenIndex982
+											}
+										default:
+											fail("'y'")
+											if buffer[position] != rune('t') {
+												fail("'t'")
+												goto l980
+											}
+											position++
+											{
+												position984, tokenIndex984 := position, tokenIndex
+												if buffer[position] != rune('a') {
+													fail("'a'")
+													goto l985
+												}
+												position++
+												if buffer[position] != rune('t') {
+													fail("'t'")
+													goto l985
+												}
+												position++
+												if buffer[position] != rune('i') {
+													fail("'i'")
+													goto l985
+												}
+												position++
+												if buffer[position] != rune('c') {
+													fail("'c'")
+													goto l985
+												}
+												position++
+												{
+													position986, tokenIndex986 := position, tokenIndex
+													{
+														switch buffer[position] {
+														case '$':
+															position++
+														case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+															position++
+														case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+															position++
+														case '_':
+															position++
+														default:
+															fail("'$'")
+															fail("[0-9]")
+															fail("[A-Z]")
+															fail("'_'")
+															if c := buffer[position]; c < rune('a') || c > rune('z') {
+																fail("[a-z]")
+																goto l986
+															}
+															position++
+														}
+													}
+
+													goto l985
+												l986:
+													position, tokenIndex = position986, tokenIndex986
+												}
+												goto l984
+											l985:
+												position, tokenIndex = position984, tokenIndex984
+												if buffer[position] != rune('r') {
+													fail("'r'")
+													goto l980
+												}
+												position++
+												if buffer[position] != rune('i') {
+													fail("'i'")
+													goto l980
+												}
+												position++
+												if buffer[position] != rune('c') {
+													fail("'c'")
+													goto l980
+												}
+												position++
+												if buffer[position] != rune('t') {
+													fail("'t'")
+													goto l980
+												}
+												position++
+												if buffer[position] != rune('f') {
+													fail("'f'")
+													goto l980
+												}
+												position++
+												if buffer[position] != rune('p') {
+													fail("'p'")
+													goto l980
+												}
+												position++
+												{
+													position988, tokenIndex988 := position, tokenIndex
+													{
+														switch buffer[position] {
+														case '$':
+															position++
+														case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+															position++
+														case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+															position++
+														case '_':
+															position++
+														default:
+															fail("'$'")
+															fail("[0-9]")
+															fail("[A-Z]")
+															fail("'_'")
+															if c := buffer[position]; c < rune('a') || c > rune('z') {
+																fail("[a-z]")
+																goto l988
+															}
+															position++
+														}
+													}
+
+													goto l980
+												l988:
+													position, tokenIndex = position988, tokenIndex988
+												}
+											}
+										l984:
+											break
+										}
+									}
+
+									goto l979
+								l980:
+									position, tokenIndex = position979, tokenIndex979
+									if !matchKeyword("sealed") {
+										fail("'sealed'")
+										goto l921
+									}
+								}
+							l979:
+								break
+							case 't':
+								fail("'p'")
+								fail("'static'")
+								fail("'abstract'")
+								fail("'final'")
+								fail("'native'")
+								fail("'synchronized'")
+								fail("'volatile'")
+								fail("'strictfp'")
+								fail("'default'")
+								fail("'sealed'")
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('a') {
+									fail("'a'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('s') {
+									fail("'s'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('i') {
+									fail("'i'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('n') {
+									fail("'n'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l921
+								}
+								position++
+								{
+									position990, tokenIndex990 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l990
+											}
+											position++
+										}
+									}
+
+									goto l921
+								l990:
+									position, tokenIndex = position990, tokenIndex990
+								}
+							case 'v':
+								fail("'p'")
+								fail("'static'")
+								fail("'abstract'")
+								fail("'final'")
+								fail("'native'")
+								fail("'synchronized'")
+								fail("'transient'")
+								fail("'strictfp'")
+								fail("'default'")
+								fail("'sealed'")
+								position++
+								if buffer[position] != rune('o') {
+									fail("'o'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('l') {
+									fail("'l'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('a') {
+									fail("'a'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('i') {
+									fail("'i'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('l') {
+									fail("'l'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('e') {
+									fail("'e'")
+									goto l921
+								}
+								position++
+								{
+									position992, tokenIndex992 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l992
+											}
+											position++
+										}
+									}
+
+									goto l921
+								l992:
+									position, tokenIndex = position992, tokenIndex992
+								}
+							default:
+								fail("'p'")
+								fail("'static'")
+								fail("'final'")
+								fail("'native'")
+								fail("'synchronized'")
+								fail("'transient'")
+								fail("'volatile'")
+								fail("'strictfp'")
+								fail("'default'")
+								fail("'sealed'")
+								if buffer[position] != rune('a') {
+									fail("'abstract'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('b') {
+									fail("'b'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('s') {
+									fail("'s'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('r') {
+									fail("'r'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('a') {
+									fail("'a'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('c') {
+									fail("'c'")
+									goto l921
+								}
+								position++
+								if buffer[position] != rune('t') {
+									fail("'t'")
+									goto l921
+								}
+								position++
+								{
+									position994, tokenIndex994 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l994
+											}
+											position++
+										}
+									}
+
+									goto l921
+								l994:
+									position, tokenIndex = position994, tokenIndex994
+								}
+							}
+						}
+
+						{
+							position996, tokenIndex996 := position, tokenIndex
+							if !_rules[ruleLetterOrDigit]() {
+								goto l996
+							}
+							goto l921
+						l996:
+							position, tokenIndex = position996, tokenIndex996
+						}
+						if !_rules[ruleSpacing]() {
+							goto l921
+						}
+					}
+				}
+
+				add(ruleModifier, position922)
+			}
+			memoize(108, position921, tokenIndex921, true)
+			return true
+		l921:
+			memoize(108, position921, tokenIndex921, false)
+			position, tokenIndex = position921, tokenIndex921
+			return false
+		},
+		/* 109 AnnotationTypeDeclaration <- <(AT INTERFACE Identifier AnnotationTypeBody)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{109, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position997, tokenIndex997 := position, tokenIndex
+			{
+				position998 := position
+				if !_rules[ruleAT]() {
+					goto l997
+				}
+				if !_rules[ruleINTERFACE]() {
+					goto l997
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l997
+				}
+				{
+					position999 := position
+					if !_rules[ruleLWING]() {
+						goto l997
+					}
+				l1000:
+					{
+						position1001, tokenIndex1001 := position, tokenIndex
+						{
+							position1002 := position
+							{
+								position1003, tokenIndex1003 := position, tokenIndex
+							l1005:
+								{
+									position1006, tokenIndex1006 := position, tokenIndex
+									if !_rules[ruleModifier]() {
+										goto l1006
+									}
+									goto l1005
+								l1006:
+									position, tokenIndex = position1006, tokenIndex1006
+								}
+								{
+									position1007 := position
+									{
+										switch buffer[position] {
+										case '@':
+											fail("'char'")
+											fail("'double'")
+											fail("'float'")
+											fail("'int'")
+											fail("'long'")
+											fail("'short'")
+											fail("'b'")
+											fail("'_'")
+											fail("'$'")
+											fail("[A-Z]")
+											fail("[a-z]")
+											fail("'class'")
+											fail("'enum'")
+											fail("'interface'")
+											if !_rules[ruleAnnotationTypeDeclaration]() {
+												goto l1004
+											}
+										case 'c':
+											fail("'enum'")
+											fail("'interface'")
+											fail("'@'")
+											{
+												position1009, tokenIndex1009 := position, tokenIndex
+												if !_rules[ruleType]() {
+													goto l1010
+												}
+												if !_rules[ruleAnnotationMethodOrConstantRest]() {
+													goto l1010
+												}
+												if !_rules[ruleSEMI]() {
+													goto l1010
+												}
+												goto l1009
+											l1010:
+												position, tokenIndex = position1009, tokenIndex1009
+												if !_rules[ruleClassDeclaration]() {
+													goto l1004
+												}
+											}
+										l1009:
+											break
+										case 'e':
+											fail("'class'")
+											fail("'interface'")
+											fail("'@'")
+											{
+												position1011, tokenIndex1011 := position, tokenIndex
+												if !_rules[ruleType]() {
+													goto l1012
+												}
+												if !_rules[ruleAnnotationMethodOrConstantRest]() {
+													goto l1012
+												}
+												if !_rules[ruleSEMI]() {
+													goto l1012
+												}
+												goto l1011
+											l1012:
+												position, tokenIndex = position1011, tokenIndex1011
+												if !_rules[ruleEnumDeclaration]() {
+													goto l1004
+												}
+											}
+										l1011:
+											break
+										case 'i':
+											fail("'class'")
+											fail("'enum'")
+											fail("'@'")
+											{
+												position1013, tokenIndex1013 := position, tokenIndex
+												if !_rules[ruleType]() {
+													goto l1014
+												}
+												if !_rules[ruleAnnotationMethodOrConstantRest]() {
+													goto l1014
+												}
+												if !_rules[ruleSEMI]() {
+													goto l1014
+												}
+												goto l1013
+											l1014:
+												position, tokenIndex = position1013, tokenIndex1013
+												if !_rules[ruleInterfaceDeclaration]() {
+													goto l1004
+												}
+											}
+										l1013:
+											break
+										default:
+											fail("'class'")
+											fail("'enum'")
+											fail("'interface'")
+											fail("'@'")
+											if !_rules[ruleType]() {
+												goto l1004
+											}
+											if !_rules[ruleAnnotationMethodOrConstantRest]() {
+												goto l1004
+											}
+											if !_rules[ruleSEMI]() {
+												goto l1004
+											}
+										}
+									}
+
+									add(ruleAnnotationTypeElementRest, position1007)
+								}
+								goto l1003
+							l1004:
+								position, tokenIndex = position1003, tokenIndex1003
+								if !_rules[ruleSEMI]() {
+									goto l1001
+								}
+							}
+						l1003:
+							add(ruleAnnotationTypeElementDeclaration, position1002)
+						}
+						goto l1000
+					l1001:
+						position, tokenIndex = position1001, tokenIndex1001
+					}
+					if !_rules[ruleRWING]() {
+						goto l997
+					}
+					add(ruleAnnotationTypeBody, position999)
+				}
+				add(ruleAnnotationTypeDeclaration, position998)
+			}
+			memoize(109, position997, tokenIndex997, true)
+			return true
+		l997:
+			memoize(109, position997, tokenIndex997, false)
+			position, tokenIndex = position997, tokenIndex997
+			return false
+		},
+		/* 110 AnnotationTypeBody <- <(LWING AnnotationTypeElementDeclaration* RWING)> */
+		nil,
+		/* 111 AnnotationTypeElementDeclaration <- <((Modifier* AnnotationTypeElementRest) / SEMI)> */
+		nil,
+		/* 112 AnnotationTypeElementRest <- <((&('@') %fail('char' 'double' 'float' 'int' 'long' 'short' 'b' '_' '$' [A-Z] [a-z] 'class' 'enum' 'interface') AnnotationTypeDeclaration) | (&('c') %fail('enum' 'interface' '@') ((Type AnnotationMethodOrConstantRest SEMI) / ClassDeclaration)) | (&('e') %fail('class' 'interface' '@') ((Type AnnotationMethodOrConstantRest SEMI) / EnumDeclaration)) | (&('i') %fail('class' 'enum' '@') ((Type AnnotationMethodOrConstantRest SEMI) / InterfaceDeclaration)) | (&('$' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'd' | 'f' | 'g' | 'h' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('class' 'enum' 'interface' '@') (Type AnnotationMethodOrConstantRest SEMI)))> */
+		nil,
+		/* 113 AnnotationMethodOrConstantRest <- <(AnnotationMethodRest / AnnotationConstantRest)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{113, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1018, tokenIndex1018 := position, tokenIndex
+			{
+				position1019 := position
+				{
+					position1020, tokenIndex1020 := position, tokenIndex
+					{
+						position1022 := position
+						if !_rules[ruleIdentifier]() {
+							goto l1021
+						}
+						if !_rules[ruleLPAR]() {
+							goto l1021
+						}
+						if !_rules[ruleRPAR]() {
+							goto l1021
+						}
+						{
+							position1023, tokenIndex1023 := position, tokenIndex
+							{
+								position1025 := position
+								if !_rules[ruleDEFAULT]() {
+									goto l1023
+								}
+								if !_rules[ruleElementValue]() {
+									goto l1023
+								}
+								add(ruleDefaultValue, position1025)
+							}
+							goto l1024
+						l1023:
+							position, tokenIndex = position1023, tokenIndex1023
+						}
+					l1024:
+						add(ruleAnnotationMethodRest, position1022)
+					}
+					goto l1020
+				l1021:
+					position, tokenIndex = position1020, tokenIndex1020
+					{
+						position1026 := position
+						if !_rules[ruleVariableDeclarators]() {
+							goto l1018
+						}
+						add(ruleAnnotationConstantRest, position1026)
+					}
+				}
+			l1020:
+				add(ruleAnnotationMethodOrConstantRest, position1019)
+			}
+			memoize(113, position1018, tokenIndex1018, true)
+			return true
+		l1018:
+			memoize(113, position1018, tokenIndex1018, false)
+			position, tokenIndex = position1018, tokenIndex1018
+			return false
+		},
+		/* 114 AnnotationMethodRest <- <(Identifier LPAR RPAR DefaultValue?)> */
+		nil,
+		/* 115 AnnotationConstantRest <- <VariableDeclarators> */
+		nil,
+		/* 116 DefaultValue <- <(DEFAULT ElementValue)> */
+		nil,
+		/* 117 Annotation <- <(NormalAnnotation / SingleElementAnnotation / MarkerAnnotation)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{117, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1030, tokenIndex1030 := position, tokenIndex
+			{
+				position1031 := position
+				{
+					position1032, tokenIndex1032 := position, tokenIndex
+					{
+						position1034 := position
+						if !_rules[ruleAT]() {
+							goto l1033
+						}
+						if !_rules[ruleQualifiedIdentifier]() {
+							goto l1033
+						}
+						if !_rules[ruleLPAR]() {
+							goto l1033
+						}
+						{
+							position1035, tokenIndex1035 := position, tokenIndex
+							{
+								position1037 := position
+								if !_rules[ruleElementValuePair]() {
+									goto l1035
+								}
+							l1038:
+								{
+									position1039, tokenIndex1039 := position, tokenIndex
+									if !_rules[ruleCOMMA]() {
+										goto l1039
+									}
+									if !_rules[ruleElementValuePair]() {
+										goto l1039
+									}
+									goto l1038
+								l1039:
+									position, tokenIndex = position1039, tokenIndex1039
+								}
+								add(ruleElementValuePairs, position1037)
+							}
+							goto l1036
+						l1035:
+							position, tokenIndex = position1035, tokenIndex1035
+						}
+					l1036:
+						if !_rules[ruleRPAR]() {
+							goto l1033
+						}
+						add(ruleNormalAnnotation, position1034)
+					}
+					goto l1032
+				l1033:
+					position, tokenIndex = position1032, tokenIndex1032
+					{
+						position1041 := position
+						if !_rules[ruleAT]() {
+							goto l1040
+						}
+						if !_rules[ruleQualifiedIdentifier]() {
+							goto l1040
+						}
+						if !_rules[ruleLPAR]() {
+							goto l1040
+						}
+						if !_rules[ruleElementValue]() {
+							goto l1040
+						}
+						if !_rules[ruleRPAR]() {
+							goto l1040
+						}
+						add(ruleSingleElementAnnotation, position1041)
+					}
+					goto l1032
+				l1040:
+					position, tokenIndex = position1032, tokenIndex1032
+					{
+						position1042 := position
+						if !_rules[ruleAT]() {
+							goto l1030
+						}
+						if !_rules[ruleQualifiedIdentifier]() {
+							goto l1030
+						}
+						add(ruleMarkerAnnotation, position1042)
+					}
+				}
+			l1032:
+				add(ruleAnnotation, position1031)
+			}
+			memoize(117, position1030, tokenIndex1030, true)
+			return true
+		l1030:
+			memoize(117, position1030, tokenIndex1030, false)
+			position, tokenIndex = position1030, tokenIndex1030
+			return false
+		},
+		/* 118 NormalAnnotation <- <(AT QualifiedIdentifier LPAR ElementValuePairs? RPAR)> */
+		nil,
+		/* 119 SingleElementAnnotation <- <(AT QualifiedIdentifier LPAR ElementValue RPAR)> */
+		nil,
+		/* 120 MarkerAnnotation <- <(AT QualifiedIdentifier)> */
+		nil,
+		/* 121 ElementValuePairs <- <(ElementValuePair (COMMA ElementValuePair)*)> */
+		nil,
+		/* 122 ElementValuePair <- <(Identifier EQU ElementValue)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{122, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1047, tokenIndex1047 := position, tokenIndex
+			{
+				position1048 := position
+				if !_rules[ruleIdentifier]() {
+					goto l1047
+				}
+				if !_rules[ruleEQU]() {
+					goto l1047
+				}
+				if !_rules[ruleElementValue]() {
+					goto l1047
+				}
+				add(ruleElementValuePair, position1048)
+			}
+			memoize(122, position1047, tokenIndex1047, true)
+			return true
+		l1047:
+			memoize(122, position1047, tokenIndex1047, false)
+			position, tokenIndex = position1047, tokenIndex1047
+			return false
+		},
+		/* 123 ElementValue <- <((&('@') Annotation) | (&('{') ElementValueArrayInitializer) | (&('!' | '"' | '$' | '\'' | '(' | '+' | '-' | '.' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | '<' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '~') %fail('@' '{') ConditionalExpression))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{123, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1049, tokenIndex1049 := position, tokenIndex
+			{
+				position1050 := position
+				{
+					switch buffer[position] {
+					case '@':
+						if !_rules[ruleAnnotation]() {
+							goto l1049
+						}
+					case '{':
+						{
+							position1052 := position
+							if !_rules[ruleLWING]() {
+								goto l1049
+							}
+							{
+								position1053, tokenIndex1053 := position, tokenIndex
+								{
+									position1055 := position
+									if !_rules[ruleElementValue]() {
+										goto l1053
+									}
+								l1056:
+									{
+										position1057, tokenIndex1057 := position, tokenIndex
+										if !_rules[ruleCOMMA]() {
+											goto l1057
+										}
+										if !_rules[ruleElementValue]() {
+											goto l1057
+										}
+										goto l1056
+									l1057:
+										position, tokenIndex = position1057, tokenIndex1057
+									}
+									add(ruleElementValues, position1055)
+								}
+								goto l1054
+							l1053:
+								position, tokenIndex = position1053, tokenIndex1053
+							}
+						l1054:
+							{
+								position1058, tokenIndex1058 := position, tokenIndex
+								if !_rules[ruleCOMMA]() {
+									goto l1058
+								}
+								goto l1059
+							l1058:
+								position, tokenIndex = position1058, tokenIndex1058
+							}
+						l1059:
+							if !_rules[ruleRWING]() {
+								goto l1049
+							}
+							add(ruleElementValueArrayInitializer, position1052)
+						}
+					default:
+						fail("'@'")
+						fail("'{'")
+						if !_rules[ruleConditionalExpression]() {
+							goto l1049
+						}
+					}
+				}
+
+				add(ruleElementValue, position1050)
+			}
+			memoize(123, position1049, tokenIndex1049, true)
+			return true
+		l1049:
+			memoize(123, position1049, tokenIndex1049, false)
+			position, tokenIndex = position1049, tokenIndex1049
+			return false
+		},
+		/* 124 ElementValueArrayInitializer <- <(LWING ElementValues? COMMA? RWING)> */
+		nil,
+		/* 125 ElementValues <- <(ElementValue (COMMA ElementValue)*)> */
+		nil,
+		/* 126 Spacing <- <(((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&(' ') %fail('\t' '\n' '\r') ' '))+ / ('/' (('*' (!('*' '/') .)* '*' '/') / ('/' (!('\r' / '\n') .)* ('\r' / '\n')))))*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{126, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1062, tokenIndex1062 := position, tokenIndex
+			{
+				position1063 := position
+			l1064:
+				{
+					position1065, tokenIndex1065 := position, tokenIndex
+					{
+						position1066, tokenIndex1066 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '\t':
+								position++
+							case '\n':
+								position++
+							case '\r':
+								position++
+							default:
+								fail("'\\t'")
+								fail("'\\n'")
+								fail("'\\r'")
+								if buffer[position] != rune(' ') {
+									fail("' '")
+									goto l1067
+								}
+								position++
+							}
+						}
+
+					l1068:
+						{
+							position1069, tokenIndex1069 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '\t':
+									position++
+								case '\n':
+									position++
+								case '\r':
+									position++
+								default:
+									fail("'\\t'")
+									fail("'\\n'")
+									fail("'\\r'")
+									if buffer[position] != rune(' ') {
+										fail("' '")
+										goto l1069
+									}
+									position++
+								}
+							}
+
+							goto l1068
+						l1069:
+							position, tokenIndex = position1069, tokenIndex1069
+						}
+						goto l1066
+					l1067:
+						position, tokenIndex = position1066, tokenIndex1066
+						if buffer[position] != rune('/') {
+							fail("'/'")
+							goto l1065
+						}
+						position++
+						{
+							position1072, tokenIndex1072 := position, tokenIndex
+							if buffer[position] != rune('*') {
+								fail("'*'")
+								goto l1073
+							}
+							position++
+						l1074:
+							{
+								position1075, tokenIndex1075 := position, tokenIndex
+								{
+									position1076, tokenIndex1076 := position, tokenIndex
+									if buffer[position] != rune('*') {
+										fail("'*/'")
+										goto l1076
+									}
+									position++
+									if buffer[position] != rune('/') {
+										fail("'/'")
+										goto l1076
+									}
+									position++
+									goto l1075
+								l1076:
+									position, tokenIndex = position1076, tokenIndex1076
+								}
+								if !matchDot() {
+									fail(".")
+									goto l1075
+								}
+								goto l1074
+							l1075:
+								position, tokenIndex = position1075, tokenIndex1075
+							}
+							if buffer[position] != rune('*') {
+								fail("'*/'")
+								goto l1073
+							}
+							position++
+							if buffer[position] != rune('/') {
+								fail("'/'")
+								goto l1073
+							}
+							position++
+							goto l1072
+						l1073:
+							position, tokenIndex = position1072, tokenIndex1072
+							if buffer[position] != rune('/') {
+								fail("'/'")
+								goto l1065
+							}
+							position++
+						l1077:
+							{
+								position1078, tokenIndex1078 := position, tokenIndex
+								{
+									position1079, tokenIndex1079 := position, tokenIndex
+									{
+										position1080, tokenIndex1080 := position, tokenIndex
+										if buffer[position] != rune('\r') {
+											fail("'\\r'")
+											goto l1081
+										}
+										position++
+										goto l1080
+									l1081:
+										position, tokenIndex = position1080, tokenIndex1080
+										if buffer[position] != rune('\n') {
+											fail("'\\n'")
+											goto l1079
+										}
+										position++
+									}
+								l1080:
+									goto l1078
+								l1079:
+									position, tokenIndex = position1079, tokenIndex1079
+								}
+								if !matchDot() {
+									fail(".")
+									goto l1078
+								}
+								goto l1077
+							l1078:
+								position, tokenIndex = position1078, tokenIndex1078
+							}
+							{
+								position1082, tokenIndex1082 := position, tokenIndex
+								if buffer[position] != rune('\r') {
+									fail("'\\r'")
+									goto l1083
+								}
+								position++
+								goto l1082
+							l1083:
+								position, tokenIndex = position1082, tokenIndex1082
+								if buffer[position] != rune('\n') {
+									fail("'\\n'")
+									goto l1065
+								}
+								position++
+							}
+						l1082:
+						}
+					l1072:
+					}
+				l1066:
+					goto l1064
+				l1065:
+					position, tokenIndex = position1065, tokenIndex1065
+				}
+				add(ruleSpacing, position1063)
+			}
+			memoize(126, position1062, tokenIndex1062, true)
+			return true
+		},
+		/* 127 Identifier <- <(Letter LetterOrDigit* !%in(keywords) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{127, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1084, tokenIndex1084 := position, tokenIndex
+			{
+				position1085 := position
+				{
+					position1086 := position
+					{
+						switch buffer[position] {
+						case '$', '_':
+							{
+								position1088, tokenIndex1088 := position, tokenIndex
+								if buffer[position] != rune('_') {
+									fail("'_'")
+									goto l1089
+								}
+								position++
+								goto l1088
+							l1089:
+								position, tokenIndex = position1088, tokenIndex1088
+								if buffer[position] != rune('$') {
+									fail("'$'")
+									goto l1084
+								}
+								position++
+							}
+						l1088:
+							break
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						default:
+							fail("'_'")
+							fail("'$'")
+							fail("[A-Z]")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1084
+							}
+							position++
+						}
+					}
+
+					add(ruleLetter, position1086)
+				}
+			l1090:
+				{
+					position1091, tokenIndex1091 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1091
+					}
+					goto l1090
+				l1091:
+					position, tokenIndex = position1091, tokenIndex1091
+				}
+				{
+					position1092, tokenIndex1092 := position, tokenIndex
+					if _, ok := (keywords)[string(buffer[position1085:position])]; !ok {
+						goto l1092
+					}
+					goto l1084
+				l1092:
+					position, tokenIndex = position1092, tokenIndex1092
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1084
+				}
+				add(ruleIdentifier, position1085)
+			}
+			memoize(127, position1084, tokenIndex1084, true)
+			return true
+		l1084:
+			memoize(127, position1084, tokenIndex1084, false)
+			position, tokenIndex = position1084, tokenIndex1084
+			return false
+		},
+		/* 128 Letter <- <((&('$' | '_') ('_' / '$')) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('_' '$' [A-Z]) [a-z]))> */
+		nil,
+		/* 129 LetterOrDigit <- <((&('$' | '_') ('_' / '$')) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('_' '$' [0-9] [A-Z]) [a-z]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{129, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1094, tokenIndex1094 := position, tokenIndex
+			{
+				position1095 := position
+				{
+					switch buffer[position] {
+					case '$', '_':
+						{
+							position1097, tokenIndex1097 := position, tokenIndex
+							if buffer[position] != rune('_') {
+								fail("'_'")
+								goto l1098
+							}
+							position++
+							goto l1097
+						l1098:
+							position, tokenIndex = position1097, tokenIndex1097
+							if buffer[position] != rune('$') {
+								fail("'$'")
+								goto l1094
+							}
+							position++
+						}
+					l1097:
+						break
+					case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+						position++
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						position++
+					default:
+						fail("'_'")
+						fail("'$'")
+						fail("[0-9]")
+						fail("[A-Z]")
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							fail("[a-z]")
+							goto l1094
+						}
+						position++
+					}
+				}
+
+				add(ruleLetterOrDigit, position1095)
+			}
+			memoize(129, position1094, tokenIndex1094, true)
+			return true
+		l1094:
+			memoize(129, position1094, tokenIndex1094, false)
+			position, tokenIndex = position1094, tokenIndex1094
+			return false
+		},
+		/* 130 ASSERT <- <('a' 's' 's' 'e' 'r' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 131 BREAK <- <('b' 'r' 'e' 'a' 'k' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 132 CASE <- <('c' 'a' 's' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 133 CATCH <- <('c' 'a' 't' 'c' 'h' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 134 CLASS <- <('c' 'l' 'a' 's' 's' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{134, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1103, tokenIndex1103 := position, tokenIndex
+			{
+				position1104 := position
+				if buffer[position] != rune('c') {
+					fail("'class'")
+					goto l1103
+				}
+				position++
+				if buffer[position] != rune('l') {
+					fail("'l'")
+					goto l1103
+				}
+				position++
+				if buffer[position] != rune('a') {
+					fail("'a'")
+					goto l1103
+				}
+				position++
+				if buffer[position] != rune('s') {
+					fail("'s'")
+					goto l1103
+				}
+				position++
+				if buffer[position] != rune('s') {
+					fail("'s'")
+					goto l1103
+				}
+				position++
+				{
+					position1105, tokenIndex1105 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1105
+							}
+							position++
+						}
+					}
+
+					goto l1103
+				l1105:
+					position, tokenIndex = position1105, tokenIndex1105
+				}
+				{
+					position1107, tokenIndex1107 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1107
+					}
+					goto l1103
+				l1107:
+					position, tokenIndex = position1107, tokenIndex1107
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1103
+				}
+				add(ruleCLASS, position1104)
+			}
+			memoize(134, position1103, tokenIndex1103, true)
+			return true
+		l1103:
+			memoize(134, position1103, tokenIndex1103, false)
+			position, tokenIndex = position1103, tokenIndex1103
+			return false
+		},
+		/* 135 CONTINUE <- <('c' 'o' 'n' 't' 'i' 'n' 'u' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 136 DEFAULT <- <('d' 'e' 'f' 'a' 'u' 'l' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{136, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1109, tokenIndex1109 := position, tokenIndex
+			{
+				position1110 := position
+				if buffer[position] != rune('d') {
+					fail("'default'")
+					goto l1109
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1109
+				}
+				position++
+				if buffer[position] != rune('f') {
+					fail("'f'")
+					goto l1109
+				}
+				position++
+				if buffer[position] != rune('a') {
+					fail("'a'")
+					goto l1109
+				}
+				position++
+				if buffer[position] != rune('u') {
+					fail("'u'")
+					goto l1109
+				}
+				position++
+				if buffer[position] != rune('l') {
+					fail("'l'")
+					goto l1109
+				}
+				position++
+				if buffer[position] != rune('t') {
+					fail("'t'")
+					goto l1109
+				}
+				position++
+				{
+					position1111, tokenIndex1111 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1111
+							}
+							position++
+						}
+					}
+
+					goto l1109
+				l1111:
+					position, tokenIndex = position1111, tokenIndex1111
+				}
+				{
+					position1113, tokenIndex1113 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1113
+					}
+					goto l1109
+				l1113:
+					position, tokenIndex = position1113, tokenIndex1113
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1109
+				}
+				add(ruleDEFAULT, position1110)
+			}
+			memoize(136, position1109, tokenIndex1109, true)
+			return true
+		l1109:
+			memoize(136, position1109, tokenIndex1109, false)
+			position, tokenIndex = position1109, tokenIndex1109
+			return false
+		},
+		/* 137 DO <- <('d' 'o' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 138 ELSE <- <('e' 'l' 's' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 139 ENUM <- <('e' 'n' 'u' 'm' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 140 EXTENDS <- <('e' 'x' 't' 'e' 'n' 'd' 's' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{140, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1117, tokenIndex1117 := position, tokenIndex
+			{
+				position1118 := position
+				if buffer[position] != rune('e') {
+					fail("'extends'")
+					goto l1117
+				}
+				position++
+				if buffer[position] != rune('x') {
+					fail("'x'")
+					goto l1117
+				}
+				position++
+				if buffer[position] != rune('t') {
+					fail("'t'")
+					goto l1117
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1117
+				}
+				position++
+				if buffer[position] != rune('n') {
+					fail("'n'")
+					goto l1117
+				}
+				position++
+				if buffer[position] != rune('d') {
+					fail("'d'")
+					goto l1117
+				}
+				position++
+				if buffer[position] != rune('s') {
+					fail("'s'")
+					goto l1117
+				}
+				position++
+				{
+					position1119, tokenIndex1119 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1119
+							}
+							position++
+						}
+					}
+
+					goto l1117
+				l1119:
+					position, tokenIndex = position1119, tokenIndex1119
+				}
+				{
+					position1121, tokenIndex1121 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1121
+					}
+					goto l1117
+				l1121:
+					position, tokenIndex = position1121, tokenIndex1121
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1117
+				}
+				add(ruleEXTENDS, position1118)
+			}
+			memoize(140, position1117, tokenIndex1117, true)
+			return true
+		l1117:
+			memoize(140, position1117, tokenIndex1117, false)
+			position, tokenIndex = position1117, tokenIndex1117
+			return false
+		},
+		/* 141 FINALLY <- <('f' 'i' 'n' 'a' 'l' 'l' 'y' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 142 FINAL <- <('f' 'i' 'n' 'a' 'l' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{142, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1123, tokenIndex1123 := position, tokenIndex
+			{
+				position1124 := position
+				if buffer[position] != rune('f') {
+					fail("'final'")
+					goto l1123
+				}
+				position++
+				if buffer[position] != rune('i') {
+					fail("'i'")
+					goto l1123
+				}
+				position++
+				if buffer[position] != rune('n') {
+					fail("'n'")
+					goto l1123
+				}
+				position++
+				if buffer[position] != rune('a') {
+					fail("'a'")
+					goto l1123
+				}
+				position++
+				if buffer[position] != rune('l') {
+					fail("'l'")
+					goto l1123
+				}
+				position++
+				{
+					position1125, tokenIndex1125 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1125
+							}
+							position++
+						}
+					}
+
+					goto l1123
+				l1125:
+					position, tokenIndex = position1125, tokenIndex1125
+				}
+				{
+					position1127, tokenIndex1127 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1127
+					}
+					goto l1123
+				l1127:
+					position, tokenIndex = position1127, tokenIndex1127
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1123
+				}
+				add(ruleFINAL, position1124)
+			}
+			memoize(142, position1123, tokenIndex1123, true)
+			return true
+		l1123:
+			memoize(142, position1123, tokenIndex1123, false)
+			position, tokenIndex = position1123, tokenIndex1123
+			return false
+		},
+		/* 143 FOR <- <('f' 'o' 'r' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 144 IF <- <('i' 'f' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 145 IMPLEMENTS <- <('i' 'm' 'p' 'l' 'e' 'm' 'e' 'n' 't' 's' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{145, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1130, tokenIndex1130 := position, tokenIndex
+			{
+				position1131 := position
+				if buffer[position] != rune('i') {
+					fail("'implements'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('m') {
+					fail("'m'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('p') {
+					fail("'p'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('l') {
+					fail("'l'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('m') {
+					fail("'m'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('n') {
+					fail("'n'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('t') {
+					fail("'t'")
+					goto l1130
+				}
+				position++
+				if buffer[position] != rune('s') {
+					fail("'s'")
+					goto l1130
+				}
+				position++
+				{
+					position1132, tokenIndex1132 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1132
+							}
+							position++
+						}
+					}
+
+					goto l1130
+				l1132:
+					position, tokenIndex = position1132, tokenIndex1132
+				}
+				{
+					position1134, tokenIndex1134 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1134
+					}
+					goto l1130
+				l1134:
+					position, tokenIndex = position1134, tokenIndex1134
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1130
+				}
+				add(ruleIMPLEMENTS, position1131)
+			}
+			memoize(145, position1130, tokenIndex1130, true)
+			return true
+		l1130:
+			memoize(145, position1130, tokenIndex1130, false)
+			position, tokenIndex = position1130, tokenIndex1130
+			return false
+		},
+		/* 146 IMPORT <- <('i' 'm' 'p' 'o' 'r' 't' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 147 INTERFACE <- <('i' 'n' 't' 'e' 'r' 'f' 'a' 'c' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{147, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1136, tokenIndex1136 := position, tokenIndex
+			{
+				position1137 := position
+				if buffer[position] != rune('i') {
+					fail("'interface'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('n') {
+					fail("'n'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('t') {
+					fail("'t'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('r') {
+					fail("'r'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('f') {
+					fail("'f'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('a') {
+					fail("'a'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('c') {
+					fail("'c'")
+					goto l1136
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1136
+				}
+				position++
+				{
+					position1138, tokenIndex1138 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1138
+							}
+							position++
+						}
+					}
+
+					goto l1136
+				l1138:
+					position, tokenIndex = position1138, tokenIndex1138
+				}
+				{
+					position1140, tokenIndex1140 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1140
+					}
+					goto l1136
+				l1140:
+					position, tokenIndex = position1140, tokenIndex1140
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1136
+				}
+				add(ruleINTERFACE, position1137)
+			}
+			memoize(147, position1136, tokenIndex1136, true)
+			return true
+		l1136:
+			memoize(147, position1136, tokenIndex1136, false)
+			position, tokenIndex = position1136, tokenIndex1136
+			return false
+		},
+		/* 148 INSTANCEOF <- <('i' 'n' 's' 't' 'a' 'n' 'c' 'e' 'o' 'f' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 149 NEW <- <('n' 'e' 'w' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{149, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1142, tokenIndex1142 := position, tokenIndex
+			{
+				position1143 := position
+				if buffer[position] != rune('n') {
+					fail("'new'")
+					goto l1142
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1142
+				}
+				position++
+				if buffer[position] != rune('w') {
+					fail("'w'")
+					goto l1142
+				}
+				position++
+				{
+					position1144, tokenIndex1144 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1144
+							}
+							position++
+						}
+					}
+
+					goto l1142
+				l1144:
+					position, tokenIndex = position1144, tokenIndex1144
+				}
+				{
+					position1146, tokenIndex1146 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1146
+					}
+					goto l1142
+				l1146:
+					position, tokenIndex = position1146, tokenIndex1146
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1142
+				}
+				add(ruleNEW, position1143)
+			}
+			memoize(149, position1142, tokenIndex1142, true)
+			return true
+		l1142:
+			memoize(149, position1142, tokenIndex1142, false)
+			position, tokenIndex = position1142, tokenIndex1142
+			return false
+		},
+		/* 150 PACKAGE <- <('p' 'a' 'c' 'k' 'a' 'g' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 151 RETURN <- <('r' 'e' 't' 'u' 'r' 'n' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 152 STATIC <- <('s' 't' 'a' 't' 'i' 'c' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{152, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1149, tokenIndex1149 := position, tokenIndex
+			{
+				position1150 := position
+				if buffer[position] != rune('s') {
+					fail("'static'")
+					goto l1149
+				}
+				position++
+				if buffer[position] != rune('t') {
+					fail("'t'")
+					goto l1149
+				}
+				position++
+				if buffer[position] != rune('a') {
+					fail("'a'")
+					goto l1149
+				}
+				position++
+				if buffer[position] != rune('t') {
+					fail("'t'")
+					goto l1149
+				}
+				position++
+				if buffer[position] != rune('i') {
+					fail("'i'")
+					goto l1149
+				}
+				position++
+				if buffer[position] != rune('c') {
+					fail("'c'")
+					goto l1149
+				}
+				position++
+				{
+					position1151, tokenIndex1151 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1151
+							}
+							position++
+						}
+					}
+
+					goto l1149
+				l1151:
+					position, tokenIndex = position1151, tokenIndex1151
+				}
+				{
+					position1153, tokenIndex1153 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1153
+					}
+					goto l1149
+				l1153:
+					position, tokenIndex = position1153, tokenIndex1153
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1149
+				}
+				add(ruleSTATIC, position1150)
+			}
+			memoize(152, position1149, tokenIndex1149, true)
+			return true
+		l1149:
+			memoize(152, position1149, tokenIndex1149, false)
+			position, tokenIndex = position1149, tokenIndex1149
+			return false
+		},
+		/* 153 SUPER <- <('s' 'u' 'p' 'e' 'r' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{153, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1154, tokenIndex1154 := position, tokenIndex
+			{
+				position1155 := position
+				if buffer[position] != rune('s') {
+					fail("'super'")
+					goto l1154
+				}
+				position++
+				if buffer[position] != rune('u') {
+					fail("'u'")
+					goto l1154
+				}
+				position++
+				if buffer[position] != rune('p') {
+					fail("'p'")
+					goto l1154
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1154
+				}
+				position++
+				if buffer[position] != rune('r') {
+					fail("'r'")
+					goto l1154
+				}
+				position++
+				{
+					position1156, tokenIndex1156 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1156
+							}
+							position++
+						}
+					}
+
+					goto l1154
+				l1156:
+					position, tokenIndex = position1156, tokenIndex1156
+				}
+				{
+					position1158, tokenIndex1158 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1158
+					}
+					goto l1154
+				l1158:
+					position, tokenIndex = position1158, tokenIndex1158
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1154
+				}
+				add(ruleSUPER, position1155)
+			}
+			memoize(153, position1154, tokenIndex1154, true)
+			return true
+		l1154:
+			memoize(153, position1154, tokenIndex1154, false)
+			position, tokenIndex = position1154, tokenIndex1154
+			return false
+		},
+		/* 154 SWITCH <- <('s' 'w' 'i' 't' 'c' 'h' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{154, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1159, tokenIndex1159 := position, tokenIndex
+			{
+				position1160 := position
+				if buffer[position] != rune('s') {
+					fail("'switch'")
+					goto l1159
+				}
+				position++
+				if buffer[position] != rune('w') {
+					fail("'w'")
+					goto l1159
+				}
+				position++
+				if buffer[position] != rune('i') {
+					fail("'i'")
+					goto l1159
+				}
+				position++
+				if buffer[position] != rune('t') {
+					fail("'t'")
+					goto l1159
+				}
+				position++
+				if buffer[position] != rune('c') {
+					fail("'c'")
+					goto l1159
+				}
+				position++
+				if buffer[position] != rune('h') {
+					fail("'h'")
+					goto l1159
+				}
+				position++
+				{
+					position1161, tokenIndex1161 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1161
+							}
+							position++
+						}
+					}
+
+					goto l1159
+				l1161:
+					position, tokenIndex = position1161, tokenIndex1161
+				}
+				{
+					position1163, tokenIndex1163 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1163
+					}
+					goto l1159
+				l1163:
+					position, tokenIndex = position1163, tokenIndex1163
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1159
+				}
+				add(ruleSWITCH, position1160)
+			}
+			memoize(154, position1159, tokenIndex1159, true)
+			return true
+		l1159:
+			memoize(154, position1159, tokenIndex1159, false)
+			position, tokenIndex = position1159, tokenIndex1159
+			return false
+		},
+		/* 155 SYNCHRONIZED <- <('s' 'y' 'n' 'c' 'h' 'r' 'o' 'n' 'i' 'z' 'e' 'd' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 156 THIS <- <('t' 'h' 'i' 's' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{156, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1165, tokenIndex1165 := position, tokenIndex
+			{
+				position1166 := position
+				if buffer[position] != rune('t') {
+					fail("'this'")
+					goto l1165
+				}
+				position++
+				if buffer[position] != rune('h') {
+					fail("'h'")
+					goto l1165
+				}
+				position++
+				if buffer[position] != rune('i') {
+					fail("'i'")
+					goto l1165
+				}
+				position++
+				if buffer[position] != rune('s') {
+					fail("'s'")
+					goto l1165
+				}
+				position++
+				{
+					position1167, tokenIndex1167 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1167
+							}
+							position++
+						}
+					}
+
+					goto l1165
+				l1167:
+					position, tokenIndex = position1167, tokenIndex1167
+				}
+				{
+					position1169, tokenIndex1169 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1169
+					}
+					goto l1165
+				l1169:
+					position, tokenIndex = position1169, tokenIndex1169
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1165
+				}
+				add(ruleTHIS, position1166)
+			}
+			memoize(156, position1165, tokenIndex1165, true)
+			return true
+		l1165:
+			memoize(156, position1165, tokenIndex1165, false)
+			position, tokenIndex = position1165, tokenIndex1165
+			return false
+		},
+		/* 157 THROWS <- <('t' 'h' 'r' 'o' 'w' 's' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{157, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1170, tokenIndex1170 := position, tokenIndex
+			{
+				position1171 := position
+				if buffer[position] != rune('t') {
+					fail("'throws'")
+					goto l1170
+				}
+				position++
+				if buffer[position] != rune('h') {
+					fail("'h'")
+					goto l1170
+				}
+				position++
+				if buffer[position] != rune('r') {
+					fail("'r'")
+					goto l1170
+				}
+				position++
+				if buffer[position] != rune('o') {
+					fail("'o'")
+					goto l1170
+				}
+				position++
+				if buffer[position] != rune('w') {
+					fail("'w'")
+					goto l1170
+				}
+				position++
+				if buffer[position] != rune('s') {
+					fail("'s'")
+					goto l1170
+				}
+				position++
+				{
+					position1172, tokenIndex1172 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1172
+							}
+							position++
+						}
+					}
+
+					goto l1170
+				l1172:
+					position, tokenIndex = position1172, tokenIndex1172
+				}
+				{
+					position1174, tokenIndex1174 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1174
+					}
+					goto l1170
+				l1174:
+					position, tokenIndex = position1174, tokenIndex1174
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1170
+				}
+				add(ruleTHROWS, position1171)
+			}
+			memoize(157, position1170, tokenIndex1170, true)
+			return true
+		l1170:
+			memoize(157, position1170, tokenIndex1170, false)
+			position, tokenIndex = position1170, tokenIndex1170
+			return false
+		},
+		/* 158 THROW <- <('t' 'h' 'r' 'o' 'w' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{158, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1175, tokenIndex1175 := position, tokenIndex
+			{
+				position1176 := position
+				if buffer[position] != rune('t') {
+					fail("'throw'")
+					goto l1175
+				}
+				position++
+				if buffer[position] != rune('h') {
+					fail("'h'")
+					goto l1175
+				}
+				position++
+				if buffer[position] != rune('r') {
+					fail("'r'")
+					goto l1175
+				}
+				position++
+				if buffer[position] != rune('o') {
+					fail("'o'")
+					goto l1175
+				}
+				position++
+				if buffer[position] != rune('w') {
+					fail("'w'")
+					goto l1175
+				}
+				position++
+				{
+					position1177, tokenIndex1177 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1177
+							}
+							position++
+						}
+					}
+
+					goto l1175
+				l1177:
+					position, tokenIndex = position1177, tokenIndex1177
+				}
+				{
+					position1179, tokenIndex1179 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1179
+					}
+					goto l1175
+				l1179:
+					position, tokenIndex = position1179, tokenIndex1179
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1175
+				}
+				add(ruleTHROW, position1176)
+			}
+			memoize(158, position1175, tokenIndex1175, true)
+			return true
+		l1175:
+			memoize(158, position1175, tokenIndex1175, false)
+			position, tokenIndex = position1175, tokenIndex1175
+			return false
+		},
+		/* 159 TRY <- <('t' 'r' 'y' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		nil,
+		/* 160 VOID <- <('v' 'o' 'i' 'd' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{160, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1181, tokenIndex1181 := position, tokenIndex
+			{
+				position1182 := position
+				if buffer[position] != rune('v') {
+					fail("'void'")
+					goto l1181
+				}
+				position++
+				if buffer[position] != rune('o') {
+					fail("'o'")
+					goto l1181
+				}
+				position++
+				if buffer[position] != rune('i') {
+					fail("'i'")
+					goto l1181
+				}
+				position++
+				if buffer[position] != rune('d') {
+					fail("'d'")
+					goto l1181
+				}
+				position++
+				{
+					position1183, tokenIndex1183 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1183
+							}
+							position++
+						}
+					}
+
+					goto l1181
+				l1183:
+					position, tokenIndex = position1183, tokenIndex1183
+				}
+				{
+					position1185, tokenIndex1185 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1185
+					}
+					goto l1181
+				l1185:
+					position, tokenIndex = position1185, tokenIndex1185
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1181
+				}
+				add(ruleVOID, position1182)
+			}
+			memoize(160, position1181, tokenIndex1181, true)
+			return true
+		l1181:
+			memoize(160, position1181, tokenIndex1181, false)
+			position, tokenIndex = position1181, tokenIndex1181
+			return false
+		},
+		/* 161 WHILE <- <('w' 'h' 'i' 'l' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{161, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1186, tokenIndex1186 := position, tokenIndex
+			{
+				position1187 := position
+				if buffer[position] != rune('w') {
+					fail("'while'")
+					goto l1186
+				}
+				position++
+				if buffer[position] != rune('h') {
+					fail("'h'")
+					goto l1186
+				}
+				position++
+				if buffer[position] != rune('i') {
+					fail("'i'")
+					goto l1186
+				}
+				position++
+				if buffer[position] != rune('l') {
+					fail("'l'")
+					goto l1186
+				}
+				position++
+				if buffer[position] != rune('e') {
+					fail("'e'")
+					goto l1186
+				}
+				position++
+				{
+					position1188, tokenIndex1188 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '$':
+							position++
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							position++
+						case '_':
+							position++
+						default:
+							fail("'$'")
+							fail("[0-9]")
+							fail("[A-Z]")
+							fail("'_'")
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								fail("[a-z]")
+								goto l1188
+							}
+							position++
+						}
+					}
+
+					goto l1186
+				l1188:
+					position, tokenIndex = position1188, tokenIndex1188
+				}
+				{
+					position1190, tokenIndex1190 := position, tokenIndex
+					if !_rules[ruleLetterOrDigit]() {
+						goto l1190
+					}
+					goto l1186
+				l1190:
+					position, tokenIndex = position1190, tokenIndex1190
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1186
+				}
+				add(ruleWHILE, position1187)
+			}
+			memoize(161, position1186, tokenIndex1186, true)
+			return true
+		l1186:
+			memoize(161, position1186, tokenIndex1186, false)
+			position, tokenIndex = position1186, tokenIndex1186
+			return false
+		},
+		/* 162 PERMITS <- <(%keyword('permits') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{162, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1191, tokenIndex1191 := position, tokenIndex
+			{
+				position1192 := position
+				if !matchKeyword("permits") {
+					fail("'permits'")
+					goto l1191
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1191
+				}
+				add(rulePERMITS, position1192)
+			}
+			memoize(162, position1191, tokenIndex1191, true)
+			return true
+		l1191:
+			memoize(162, position1191, tokenIndex1191, false)
+			position, tokenIndex = position1191, tokenIndex1191
+			return false
+		},
+		/* 163 RECORD <- <(%keyword('record') Spacing)> */
+		nil,
+		/* 164 YIELD <- <(%keyword('yield') Spacing)> */
+		nil,
+		/* 165 Literal <- <(((&('"') %fail('0' '.' [0-9] [1-9] '\'' 'true' 'false' 'null') (TextBlock / StringLiteral)) | (&('\'') %fail('0' '.' [0-9] [1-9] '"""' '"' 'true' 'false' 'null') CharLiteral) | (&('.') %fail('0' [1-9] '\'' '"""' '"' 'true' 'false' 'null') FloatLiteral) | (&('f') %fail('0' '.' [0-9] [1-9] '\'' '"""' '"' 'true' 'null') ('f' 'a' 'l' 's' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit)) | (&('n') %fail('0' '.' [0-9] [1-9] '\'' '"""' '"' 'true' 'false') ('n' 'u' 'l' 'l' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit)) | (&('t') %fail('0' '.' [0-9] [1-9] '\'' '"""' '"' 'false' 'null') ('t' 'r' 'u' 'e' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) !LetterOrDigit)) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail('\'' '"""' '"' 'true' 'false' 'null') (FloatLiteral / IntegerLiteral))) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{165, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1195, tokenIndex1195 := position, tokenIndex
+			{
+				position1196 := position
+				{
+					switch buffer[position] {
+					case '"':
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("[1-9]")
+						fail("'\\''")
+						fail("'true'")
+						fail("'false'")
+						fail("'null'")
+						{
+							position1198, tokenIndex1198 := position, tokenIndex
+							if !_rules[ruleTextBlock]() {
+								goto l1199
+							}
+							goto l1198
+						l1199:
+							position, tokenIndex = position1198, tokenIndex1198
+							if !_rules[ruleStringLiteral]() {
+								goto l1195
+							}
+						}
+					l1198:
+						break
+					case '\'':
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("[1-9]")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'true'")
+						fail("'false'")
+						fail("'null'")
+						if !_rules[ruleCharLiteral]() {
+							goto l1195
+						}
+					case '.':
+						fail("'0'")
+						fail("[1-9]")
+						fail("'\\''")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'true'")
+						fail("'false'")
+						fail("'null'")
+						if !_rules[ruleFloatLiteral]() {
+							goto l1195
+						}
+					case 'f':
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("[1-9]")
+						fail("'\\''")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'true'")
+						fail("'null'")
+						position++
+						if buffer[position] != rune('a') {
+							fail("'a'")
+							goto l1195
+						}
+						position++
+						if buffer[position] != rune('l') {
+							fail("'l'")
+							goto l1195
+						}
+						position++
+						if buffer[position] != rune('s') {
+							fail("'s'")
+							goto l1195
+						}
+						position++
+						if buffer[position] != rune('e') {
+							fail("'e'")
+							goto l1195
+						}
+						position++
+						{
+							position1200, tokenIndex1200 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l1200
+									}
+									position++
+								}
+							}
+
+							goto l1195
+						l1200:
+							position, tokenIndex = position1200, tokenIndex1200
+						}
+						{
+							position1202, tokenIndex1202 := position, tokenIndex
+							if !_rules[ruleLetterOrDigit]() {
+								goto l1202
+							}
+							goto l1195
+						l1202:
+							position, tokenIndex = position1202, tokenIndex1202
+						}
+					case 'n':
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("[1-9]")
+						fail("'\\''")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'true'")
+						fail("'false'")
+						position++
+						if buffer[position] != rune('u') {
+							fail("'u'")
+							goto l1195
+						}
+						position++
+						if buffer[position] != rune('l') {
+							fail("'l'")
+							goto l1195
+						}
+						position++
+						if buffer[position] != rune('l') {
+							fail("'l'")
+							goto l1195
+						}
+						position++
+						{
+							position1203, tokenIndex1203 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l1203
+									}
+									position++
+								}
+							}
+
+							goto l1195
+						l1203:
+							position, tokenIndex = position1203, tokenIndex1203
+						}
+						{
+							position1205, tokenIndex1205 := position, tokenIndex
+							if !_rules[ruleLetterOrDigit]() {
+								goto l1205
+							}
+							goto l1195
+						l1205:
+							position, tokenIndex = position1205, tokenIndex1205
+						}
+					case 't':
+						fail("'0'")
+						fail("'.'")
+						fail("[0-9]")
+						fail("[1-9]")
+						fail("'\\''")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'false'")
+						fail("'null'")
+						position++
+						if buffer[position] != rune('r') {
+							fail("'r'")
+							goto l1195
+						}
+						position++
+						if buffer[position] != rune('u') {
+							fail("'u'")
+							goto l1195
+						}
+						position++
+						if buffer[position] != rune('e') {
+							fail("'e'")
+							goto l1195
+						}
+						position++
+						{
+							position1206, tokenIndex1206 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '$':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								case '_':
+									position++
+								default:
+									fail("'$'")
+									fail("[0-9]")
+									fail("[A-Z]")
+									fail("'_'")
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										fail("[a-z]")
+										goto l1206
+									}
+									position++
+								}
+							}
+
+							goto l1195
+						l1206:
+							position, tokenIndex = position1206, tokenIndex1206
+						}
+						{
+							position1208, tokenIndex1208 := position, tokenIndex
+							if !_rules[ruleLetterOrDigit]() {
+								goto l1208
+							}
+							goto l1195
+						l1208:
+							position, tokenIndex = position1208, tokenIndex1208
+						}
+					default:
+						fail("'\\''")
+						fail("'\"\"\"'")
+						fail("'\"'")
+						fail("'true'")
+						fail("'false'")
+						fail("'null'")
+						{
+							position1209, tokenIndex1209 := position, tokenIndex
+							if !_rules[ruleFloatLiteral]() {
+								goto l1210
+							}
+							goto l1209
+						l1210:
+							position, tokenIndex = position1209, tokenIndex1209
+							{
+								position1211 := position
+								{
+									position1212, tokenIndex1212 := position, tokenIndex
+									if !_rules[ruleHexNumeral]() {
+										goto l1213
+									}
+									goto l1212
+								l1213:
+									position, tokenIndex = position1212, tokenIndex1212
+									{
+										position1215 := position
+										if buffer[position] != rune('0') {
+											fail("'0'")
+											goto l1214
+										}
+										position++
+										{
+											position1216, tokenIndex1216 := position, tokenIndex
+											if buffer[position] != rune('b') {
+												fail("'b'")
+												goto l1217
+											}
+											position++
+											{
+												position1218, tokenIndex1218 := position, tokenIndex
+												{
+													switch buffer[position] {
+													case '$':
+														position++
+													case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+														position++
+													case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+														position++
+													case '_':
+														position++
+													default:
+														fail("'$'")
+														fail("[0-9]")
+														fail("[A-Z]")
+														fail("'_'")
+														if c := buffer[position]; c < rune('a') || c > rune('z') {
+															fail("[a-z]")
+															goto l1218
+														}
+														position++
+													}
+												}
+
+												goto l1217
+											l1218:
+												position, tokenIndex = position1218, tokenIndex1218
+											}
+											goto l1216
+										l1217:
+											position, tokenIndex = position1216, tokenIndex1216
+											if buffer[position] != rune('B') {
+												fail("'B'")
+												goto l1214
+											}
+											position++
+											{
+												position1220, tokenIndex1220 := position, tokenIndex
+												{
+													switch buffer[position] {
+													case '$':
+														position++
+													case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+														position++
+													case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+														position++
+													case '_':
+														position++
+													default:
+														fail("'$'")
+														fail("[0-9]")
+														fail("[A-Z]")
+														fail("'_'")
+														if c := buffer[position]; c < rune('a') || c > rune('z') {
+															fail("[a-z]")
+															goto l1220
+														}
+														position++
+													}
+												}
+
+												goto l1214
+											l1220:
+												position, tokenIndex = position1220, tokenIndex1220
+											}
+										}
+									l1216:
+										{
+											position1222, tokenIndex1222 := position, tokenIndex
+											if buffer[position] != rune('0') {
+												fail("'0'")
+												goto l1223
+											}
+											position++
+											goto l1222
+										l1223:
+											position, tokenIndex = position1222, tokenIndex1222
+											if buffer[position] != rune('1') {
+												fail("'1'")
+												goto l1214
+											}
+											position++
+										}
+									l1222:
+									l1224:
+										{
+											position1225, tokenIndex1225 := position, tokenIndex
+										l1226:
+											{
+												position1227, tokenIndex1227 := position, tokenIndex
+												if buffer[position] != rune('_') {
+													fail("'_'")
+													goto l1227
+												}
+												position++
+												goto l1226
+											l1227:
+												position, tokenIndex = position1227, tokenIndex1227
+											}
+											{
+												position1228, tokenIndex1228 := position, tokenIndex
+												if buffer[position] != rune('0') {
+													fail("'0'")
+													goto l1229
+												}
+												position++
+												goto l1228
+											l1229:
+												position, tokenIndex = position1228, tokenIndex1228
+												if buffer[position] != rune('1') {
+													fail("'1'")
+													goto l1225
+												}
+												position++
+											}
+										l1228:
+											goto l1224
+										l1225:
+											position, tokenIndex = position1225, tokenIndex1225
+										}
+										add(ruleBinaryNumeral, position1215)
+									}
+									goto l1212
+								l1214:
+									position, tokenIndex = position1212, tokenIndex1212
+									{
+										position1231 := position
+										if buffer[position] != rune('0') {
+											fail("'0'")
+											goto l1230
+										}
+										position++
+										{
+											position1232, tokenIndex1232 := position, tokenIndex
+											{
+												switch buffer[position] {
+												case '$':
+													position++
+												case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+													position++
+												case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+													position++
+												case '_':
+													position++
+												default:
+													fail("'$'")
+													fail("[0-9]")
+													fail("[A-Z]")
+													fail("'_'")
+													if c := buffer[position]; c < rune('a') || c > rune('z') {
+														fail("[a-z]")
+														goto l1232
+													}
+													position++
+												}
+											}
+
+											goto l1230
+										l1232:
+											position, tokenIndex = position1232, tokenIndex1232
+										}
+									l1236:
+										{
+											position1237, tokenIndex1237 := position, tokenIndex
+											if buffer[position] != rune('_') {
+												fail("'_'")
+												goto l1237
+											}
+											position++
+											goto l1236
+										l1237:
+											position, tokenIndex = position1237, tokenIndex1237
+										}
+										if c := buffer[position]; c < rune('0') || c > rune('7') {
+											fail("[0-7]")
+											goto l1230
+										}
+										position++
+									l1234:
+										{
+											position1235, tokenIndex1235 := position, tokenIndex
+										l1238:
+											{
+												position1239, tokenIndex1239 := position, tokenIndex
+												if buffer[position] != rune('_') {
+													fail("'_'")
+													goto l1239
+												}
+												position++
+												goto l1238
+											l1239:
+												position, tokenIndex = position1239, tokenIndex1239
+											}
+											if c := buffer[position]; c < rune('0') || c > rune('7') {
+												fail("[0-7]")
+												goto l1235
+											}
+											position++
+											goto l1234
+										l1235:
+											position, tokenIndex = position1235, tokenIndex1235
+										}
+										add(ruleOctalNumeral, position1231)
+									}
+									goto l1212
+								l1230:
+									position, tokenIndex = position1212, tokenIndex1212
+									{
+										position1240 := position
+										{
+											position1241, tokenIndex1241 := position, tokenIndex
+											if buffer[position] != rune('0') {
+												fail("'0'")
+												goto l1242
+											}
+											position++
+											{
+												position1243, tokenIndex1243 := position, tokenIndex
+												{
+													switch buffer[position] {
+													case '$':
+														position++
+													case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+														position++
+													case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+														position++
+													case '_':
+														position++
+													default:
+														fail("'$'")
+														fail("[0-9]")
+														fail("[A-Z]")
+														fail("'_'")
+														if c := buffer[position]; c < rune('a') || c > rune('z') {
+															fail("[a-z]")
+															goto l1243
+														}
+														position++
+													}
+												}
+
+												goto l1242
+											l1243:
+												position, tokenIndex = position1243, tokenIndex1243
+											}
+											goto l1241
+										l1242:
+											position, tokenIndex = position1241, tokenIndex1241
+											if c := buffer[position]; c < rune('1') || c > rune('9') {
+												fail("[1-9]")
+												goto l1195
+											}
+											position++
+										l1245:
+											{
+												position1246, tokenIndex1246 := position, tokenIndex
+											l1247:
+												{
+													position1248, tokenIndex1248 := position, tokenIndex
+													if buffer[position] != rune('_') {
+														fail("'_'")
+														goto l1248
+													}
+													position++
+													goto l1247
+												l1248:
+													position, tokenIndex = position1248, tokenIndex1248
+												}
+												if c := buffer[position]; c < rune('0') || c > rune('9') {
+													fail("[0-9]")
+													goto l1246
+												}
+												position++
+												goto l1245
+											l1246:
+												position, tokenIndex = position1246, tokenIndex1246
+											}
+										}
+									l1241:
+										add(ruleDecimalNumeral, position1240)
+									}
+								}
+							l1212:
+								{
+									position1249, tokenIndex1249 := position, tokenIndex
+									{
+										position1251, tokenIndex1251 := position, tokenIndex
+										if buffer[position] != rune('l') {
+											fail("'l'")
+											goto l1252
+										}
+										position++
+										goto l1251
+									l1252:
+										position, tokenIndex = position1251, tokenIndex1251
+										if buffer[position] != rune('L') {
+											fail("'L'")
+											goto l1249
+										}
+										position++
+									}
+								l1251:
+									goto l1250
+								l1249:
+									position, tokenIndex = position1249, tokenIndex1249
+								}
+							l1250:
+								add(ruleIntegerLiteral, position1211)
+							}
+						}
+					l1209:
+						break
+					}
+				}
+
+				if !_rules[ruleSpacing]() {
+					goto l1195
+				}
+				add(ruleLiteral, position1196)
+			}
+			memoize(165, position1195, tokenIndex1195, true)
+			return true
+		l1195:
+			memoize(165, position1195, tokenIndex1195, false)
+			position, tokenIndex = position1195, tokenIndex1195
+			return false
+		},
+		/* 166 IntegerLiteral <- <((HexNumeral / BinaryNumeral / OctalNumeral / DecimalNumeral) ('l' / 'L')?)> */
+		nil,
+		/* 167 DecimalNumeral <- <(('0' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ([1-9] ('_'* [0-9])*))> */
+		nil,
+		/* 168 HexNumeral <- <(('0' (('x' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('X' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))))) HexDigits)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{168, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1255, tokenIndex1255 := position, tokenIndex
+			{
+				position1256 := position
+				if buffer[position] != rune('0') {
+					fail("'0'")
+					goto l1255
+				}
+				position++
+				{
+					position1257, tokenIndex1257 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						fail("'x'")
+						goto l1258
+					}
+					position++
+					{
+						position1259, tokenIndex1259 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '$':
+								position++
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("'$'")
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l1259
+								}
+								position++
+							}
+						}
+
+						goto l1258
+					l1259:
+						position, tokenIndex = position1259, tokenIndex1259
+					}
+					goto l1257
+				l1258:
+					position, tokenIndex = position1257, tokenIndex1257
+					if buffer[position] != rune('X') {
+						fail("'X'")
+						goto l1255
+					}
+					position++
+					{
+						position1261, tokenIndex1261 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '$':
+								position++
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							case '_':
+								position++
+							default:
+								fail("'$'")
+								fail("[0-9]")
+								fail("[A-Z]")
+								fail("'_'")
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									fail("[a-z]")
+									goto l1261
+								}
+								position++
+							}
+						}
+
+						goto l1255
+					l1261:
+						position, tokenIndex = position1261, tokenIndex1261
+					}
+				}
+			l1257:
+				if !_rules[ruleHexDigits]() {
+					goto l1255
+				}
+				add(ruleHexNumeral, position1256)
+			}
+			memoize(168, position1255, tokenIndex1255, true)
+			return true
+		l1255:
+			memoize(168, position1255, tokenIndex1255, false)
+			position, tokenIndex = position1255, tokenIndex1255
+			return false
+		},
+		/* 169 BinaryNumeral <- <(('0' (('b' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('B' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))))) ('0' / '1') ('_'* ('0' / '1'))*)> */
+		nil,
+		/* 170 OctalNumeral <- <('0' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])) ('_'* [0-7])+)> */
+		nil,
+		/* 171 FloatLiteral <- <(HexFloat / DecimalFloat)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{171, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1265, tokenIndex1265 := position, tokenIndex
+			{
+				position1266 := position
+				{
+					position1267, tokenIndex1267 := position, tokenIndex
+					{
+						position1269 := position
+						{
+							position1270 := position
+							{
+								position1271, tokenIndex1271 := position, tokenIndex
+								if buffer[position] != rune('0') {
+									fail("'0'")
+									goto l1272
+								}
+								position++
+								{
+									position1273, tokenIndex1273 := position, tokenIndex
+									if buffer[position] != rune('x') {
+										fail("'x'")
+										goto l1274
+									}
+									position++
+									{
+										position1275, tokenIndex1275 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l1275
+												}
+												position++
+											}
+										}
+
+										goto l1274
+									l1275:
+										position, tokenIndex = position1275, tokenIndex1275
+									}
+									goto l1273
+								l1274:
+									position, tokenIndex = position1273, tokenIndex1273
+									if buffer[position] != rune('X') {
+										fail("'X'")
+										goto l1272
+									}
+									position++
+									{
+										position1277, tokenIndex1277 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '$':
+												position++
+											case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+												position++
+											case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+												position++
+											case '_':
+												position++
+											default:
+												fail("'$'")
+												fail("[0-9]")
+												fail("[A-Z]")
+												fail("'_'")
+												if c := buffer[position]; c < rune('a') || c > rune('z') {
+													fail("[a-z]")
+													goto l1277
+												}
+												position++
+											}
+										}
+
+										goto l1272
+									l1277:
+										position, tokenIndex = position1277, tokenIndex1277
+									}
+								}
+							l1273:
+								{
+									position1279, tokenIndex1279 := position, tokenIndex
+									if !_rules[ruleHexDigits]() {
+										goto l1279
+									}
+									goto l1280
+								l1279:
+									position, tokenIndex = position1279, tokenIndex1279
+								}
+							l1280:
+								if buffer[position] != rune('.') {
+									fail("'.'")
+									goto l1272
+								}
+								position++
+								if !_rules[ruleHexDigits]() {
+									goto l1272
+								}
+								goto l1271
+							l1272:
+								position, tokenIndex = position1271, tokenIndex1271
+								if !_rules[ruleHexNumeral]() {
+									goto l1268
+								}
+								{
+									position1281, tokenIndex1281 := position, tokenIndex
+									if buffer[position] != rune('.') {
+										fail("'.'")
+										goto l1281
+									}
+									position++
+									goto l1282
+								l1281:
+									position, tokenIndex = position1281, tokenIndex1281
+								}
+							l1282:
+							}
+						l1271:
+							add(ruleHexSignificand, position1270)
+						}
+						{
+							position1283 := position
+							{
+								position1284, tokenIndex1284 := position, tokenIndex
+								if buffer[position] != rune('p') {
+									fail("'p'")
+									goto l1285
+								}
+								position++
+								goto l1284
+							l1285:
+								position, tokenIndex = position1284, tokenIndex1284
+								if buffer[position] != rune('P') {
+									fail("'P'")
+									goto l1268
+								}
+								position++
+							}
+						l1284:
+							{
+								position1286, tokenIndex1286 := position, tokenIndex
+								{
+									position1288, tokenIndex1288 := position, tokenIndex
+									if buffer[position] != rune('+') {
+										fail("'+'")
+										goto l1289
+									}
+									position++
+									goto l1288
+								l1289:
+									position, tokenIndex = position1288, tokenIndex1288
+									if buffer[position] != rune('-') {
+										fail("'-'")
+										goto l1286
+									}
+									position++
+								}
+							l1288:
+								goto l1287
+							l1286:
+								position, tokenIndex = position1286, tokenIndex1286
+							}
+						l1287:
+							if !_rules[ruleDigits]() {
+								goto l1268
+							}
+							add(ruleBinaryExponent, position1283)
+						}
+						{
+							position1290, tokenIndex1290 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case 'D':
+									position++
+								case 'F':
+									position++
+								case 'd':
+									position++
+								default:
+									fail("'D'")
+									fail("'F'")
+									fail("'d'")
+									if buffer[position] != rune('f') {
+										fail("'f'")
+										goto l1290
+									}
+									position++
+								}
+							}
+
+							goto l1291
+						l1290:
+							position, tokenIndex = position1290, tokenIndex1290
+						}
+					l1291:
+						add(ruleHexFloat, position1269)
+					}
+					goto l1267
+				l1268:
+					position, tokenIndex = position1267, tokenIndex1267
+					{
+						position1293 := position
+						{
+							switch buffer[position] {
+							case '.':
+								fail("[0-9]")
+								position++
+								if !_rules[ruleDigits]() {
+									goto l1265
+								}
+								{
+									position1295, tokenIndex1295 := position, tokenIndex
+									if !_rules[ruleExponent]() {
+										goto l1295
+									}
+									goto l1296
+								l1295:
+									position, tokenIndex = position1295, tokenIndex1295
+								}
+							l1296:
+								{
+									position1297, tokenIndex1297 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case 'D':
+											position++
+										case 'F':
+											position++
+										case 'd':
+											position++
+										default:
+											fail("'D'")
+											fail("'F'")
+											fail("'d'")
+											if buffer[position] != rune('f') {
+												fail("'f'")
+												goto l1297
+											}
+											position++
+										}
+									}
+
+									goto l1298
+								l1297:
+									position, tokenIndex = position1297, tokenIndex1297
+								}
+							l1298:
+								break
+							default:
+								fail("'.'")
+								if !_rules[ruleDigits]() {
+									goto l1265
+								}
+								{
+									position1300, tokenIndex1300 := position, tokenIndex
+									if buffer[position] != rune('.') {
+										fail("'.'")
+										goto l1301
+									}
+									position++
+									{
+										position1302, tokenIndex1302 := position, tokenIndex
+										if !_rules[ruleDigits]() {
+											goto l1302
+										}
+										goto l1303
+									l1302:
+										position, tokenIndex = position1302, tokenIndex1302
+									}
+								l1303:
+									{
+										position1304, tokenIndex1304 := position, tokenIndex
+										if !_rules[ruleExponent]() {
+											goto l1304
+										}
+										goto l1305
+									l1304:
+										position, tokenIndex = position1304, tokenIndex1304
+									}
+								l1305:
+									{
+										position1306, tokenIndex1306 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case 'D':
+												position++
+											case 'F':
+												position++
+											case 'd':
+												position++
+											default:
+												fail("'D'")
+												fail("'F'")
+												fail("'d'")
+												if buffer[position] != rune('f') {
+													fail("'f'")
+													goto l1306
+												}
+												position++
+											}
+										}
+
+										goto l1307
+									l1306:
+										position, tokenIndex = position1306, tokenIndex1306
+									}
+								l1307:
+									goto l1300
+								l1301:
+									position, tokenIndex = position1300, tokenIndex1300
+									{
+										position1309, tokenIndex1309 := position, tokenIndex
+										if !_rules[ruleExponent]() {
+											goto l1310
+										}
+										{
+											position1311, tokenIndex1311 := position, tokenIndex
+											{
+												switch buffer[position] {
+												case 'D':
+													position++
+												case 'F':
+													position++
+												case 'd':
+													position++
+												default:
+													fail("'D'")
+													fail("'F'")
+													fail("'d'")
+													if buffer[position] != rune('f') {
+														fail("'f'")
+														goto l1311
+													}
+													position++
+												}
+											}
+
+											goto l1312
+										l1311:
+											position, tokenIndex = position1311, tokenIndex1311
+										}
+									l1312:
+										goto l1309
+									l1310:
+										position, tokenIndex = position1309, tokenIndex1309
+										{
+											position1314, tokenIndex1314 := position, tokenIndex
+											if !_rules[ruleExponent]() {
+												goto l1314
+											}
+											goto l1315
+										l1314:
+											position, tokenIndex = position1314, tokenIndex1314
+										}
+									l1315:
+										{
+											switch buffer[position] {
+											case 'D':
+												position++
+											case 'F':
+												position++
+											case 'd':
+												position++
+											default:
+												fail("'D'")
+												fail("'F'")
+												fail("'d'")
+												if buffer[position] != rune('f') {
+													fail("'f'")
+													goto l1265
+												}
+												position++
+											}
+										}
+
+									}
+								l1309:
+								}
+							l1300:
+								break
+							}
+						}
+
+						add(ruleDecimalFloat, position1293)
+					}
+				}
+			l1267:
+				add(ruleFloatLiteral, position1266)
+			}
+			memoize(171, position1265, tokenIndex1265, true)
+			return true
+		l1265:
+			memoize(171, position1265, tokenIndex1265, false)
+			position, tokenIndex = position1265, tokenIndex1265
+			return false
+		},
+		/* 172 DecimalFloat <- <((&('.') %fail([0-9]) ('.' Digits Exponent? ((&('D') 'D') | (&('F') 'F') | (&('d') 'd') | (&('f') %fail('D' 'F' 'd') 'f'))?)) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail('.') (Digits (('.' Digits? Exponent? ((&('D') 'D') | (&('F') 'F') | (&('d') 'd') | (&('f') %fail('D' 'F' 'd') 'f'))?) / ((Exponent ((&('D') 'D') | (&('F') 'F') | (&('d') 'd') | (&('f') %fail('D' 'F' 'd') 'f'))?) / (Exponent? ((&('D') 'D') | (&('F') 'F') | (&('d') 'd') | (&('f') %fail('D' 'F' 'd') 'f'))))))))> */
+		nil,
+		/* 173 Exponent <- <(('e' / 'E') ('+' / '-')? Digits)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{173, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1318, tokenIndex1318 := position, tokenIndex
+			{
+				position1319 := position
+				{
+					position1320, tokenIndex1320 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						fail("'e'")
+						goto l1321
+					}
+					position++
+					goto l1320
+				l1321:
+					position, tokenIndex = position1320, tokenIndex1320
+					if buffer[position] != rune('E') {
+						fail("'E'")
+						goto l1318
+					}
+					position++
+				}
+			l1320:
+				{
+					position1322, tokenIndex1322 := position, tokenIndex
+					{
+						position1324, tokenIndex1324 := position, tokenIndex
+						if buffer[position] != rune('+') {
+							fail("'+'")
+							goto l1325
+						}
+						position++
+						goto l1324
+					l1325:
+						position, tokenIndex = position1324, tokenIndex1324
+						if buffer[position] != rune('-') {
+							fail("'-'")
+							goto l1322
+						}
+						position++
+					}
+				l1324:
+					goto l1323
+				l1322:
+					position, tokenIndex = position1322, tokenIndex1322
+				}
+			l1323:
+				if !_rules[ruleDigits]() {
+					goto l1318
+				}
+				add(ruleExponent, position1319)
+			}
+			memoize(173, position1318, tokenIndex1318, true)
+			return true
+		l1318:
+			memoize(173, position1318, tokenIndex1318, false)
+			position, tokenIndex = position1318, tokenIndex1318
+			return false
+		},
+		/* 174 HexFloat <- <(HexSignificand BinaryExponent ((&('D') 'D') | (&('F') 'F') | (&('d') 'd') | (&('f') %fail('D' 'F' 'd') 'f'))?)> */
+		nil,
+		/* 175 HexSignificand <- <((('0' (('x' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))) / ('X' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z]))))) HexDigits? '.' HexDigits) / (HexNumeral '.'?))> */
+		nil,
+		/* 176 BinaryExponent <- <(('p' / 'P') ('+' / '-')? Digits)> */
+		nil,
+		/* 177 Digits <- <([0-9] ('_'* [0-9])*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{177, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1329, tokenIndex1329 := position, tokenIndex
+			{
+				position1330 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					fail("[0-9]")
+					goto l1329
+				}
+				position++
+			l1331:
+				{
+					position1332, tokenIndex1332 := position, tokenIndex
+				l1333:
+					{
+						position1334, tokenIndex1334 := position, tokenIndex
+						if buffer[position] != rune('_') {
+							fail("'_'")
+							goto l1334
+						}
+						position++
+						goto l1333
+					l1334:
+						position, tokenIndex = position1334, tokenIndex1334
+					}
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						fail("[0-9]")
+						goto l1332
+					}
+					position++
+					goto l1331
+				l1332:
+					position, tokenIndex = position1332, tokenIndex1332
+				}
+				add(ruleDigits, position1330)
+			}
+			memoize(177, position1329, tokenIndex1329, true)
+			return true
+		l1329:
+			memoize(177, position1329, tokenIndex1329, false)
+			position, tokenIndex = position1329, tokenIndex1329
+			return false
+		},
+		/* 178 HexDigits <- <(HexDigit ('_'* HexDigit)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{178, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1335, tokenIndex1335 := position, tokenIndex
+			{
+				position1336 := position
+				if !_rules[ruleHexDigit]() {
+					goto l1335
+				}
+			l1337:
+				{
+					position1338, tokenIndex1338 := position, tokenIndex
+				l1339:
+					{
+						position1340, tokenIndex1340 := position, tokenIndex
+						if buffer[position] != rune('_') {
+							fail("'_'")
+							goto l1340
+						}
+						position++
+						goto l1339
+					l1340:
+						position, tokenIndex = position1340, tokenIndex1340
+					}
+					if !_rules[ruleHexDigit]() {
+						goto l1338
+					}
+					goto l1337
+				l1338:
+					position, tokenIndex = position1338, tokenIndex1338
+				}
+				add(ruleHexDigits, position1336)
+			}
+			memoize(178, position1335, tokenIndex1335, true)
+			return true
+		l1335:
+			memoize(178, position1335, tokenIndex1335, false)
+			position, tokenIndex = position1335, tokenIndex1335
+			return false
+		},
+		/* 179 HexDigit <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-F] [a-f]) [0-9]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{179, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1341, tokenIndex1341 := position, tokenIndex
+			{
+				position1342 := position
+				{
+					switch buffer[position] {
+					case 'A', 'B', 'C', 'D', 'E', 'F':
+						position++
+					case 'a', 'b', 'c', 'd', 'e', 'f':
+						position++
+					default:
+						fail("[A-F]")
+						fail("[a-f]")
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							fail("[0-9]")
+							goto l1341
+						}
+						position++
+					}
+				}
+
+				add(ruleHexDigit, position1342)
+			}
+			memoize(179, position1341, tokenIndex1341, true)
+			return true
+		l1341:
+			memoize(179, position1341, tokenIndex1341, false)
+			position, tokenIndex = position1341, tokenIndex1341
+			return false
+		},
+		/* 180 CharLiteral <- <('\'' (Escape / (!('\'' / '\\') .)) '\'')> */
+		func() bool {
+			memoized, ok := memoization[memoKey{180, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1344, tokenIndex1344 := position, tokenIndex
+			{
+				position1345 := position
+				if buffer[position] != rune('\'') {
+					fail("'\\''")
+					goto l1344
+				}
+				position++
+				{
+					position1346, tokenIndex1346 := position, tokenIndex
+					if !_rules[ruleEscape]() {
+						goto l1347
+					}
+					goto l1346
+				l1347:
+					position, tokenIndex = position1346, tokenIndex1346
+					{
+						position1348, tokenIndex1348 := position, tokenIndex
+						{
+							position1349, tokenIndex1349 := position, tokenIndex
+							if buffer[position] != rune('\'') {
+								fail("'\\''")
+								goto l1350
+							}
+							position++
+							goto l1349
+						l1350:
+							position, tokenIndex = position1349, tokenIndex1349
+							if buffer[position] != rune('\\') {
+								fail("'\\\\'")
+								goto l1348
+							}
+							position++
+						}
+					l1349:
+						goto l1344
+					l1348:
+						position, tokenIndex = position1348, tokenIndex1348
+					}
+					if !matchDot() {
+						fail(".")
+						goto l1344
+					}
+				}
+			l1346:
+				if buffer[position] != rune('\'') {
+					fail("'\\''")
+					goto l1344
+				}
+				position++
+				add(ruleCharLiteral, position1345)
+			}
+			memoize(180, position1344, tokenIndex1344, true)
+			return true
+		l1344:
+			memoize(180, position1344, tokenIndex1344, false)
+			position, tokenIndex = position1344, tokenIndex1344
+			return false
+		},
+		/* 181 StringLiteral <- <('"' (Escape / (!((&('\n') '\n') | (&('\r') '\r') | (&('\\') '\\') | (&('"') %fail('\n' '\r' '\\') '"')) .))* '"')> */
+		func() bool {
+			memoized, ok := memoization[memoKey{181, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1351, tokenIndex1351 := position, tokenIndex
+			{
+				position1352 := position
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l1351
+				}
+				position++
+			l1353:
+				{
+					position1354, tokenIndex1354 := position, tokenIndex
+					{
+						position1355, tokenIndex1355 := position, tokenIndex
+						if !_rules[ruleEscape]() {
+							goto l1356
+						}
+						goto l1355
+					l1356:
+						position, tokenIndex = position1355, tokenIndex1355
+						{
+							position1357, tokenIndex1357 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '\n':
+									position++
+								case '\r':
+									position++
+								case '\\':
+									position++
+								default:
+									fail("'\\n'")
+									fail("'\\r'")
+									fail("'\\\\'")
+									if buffer[position] != rune('"') {
+										fail("'\"'")
+										goto l1357
+									}
+									position++
+								}
+							}
+
+							goto l1354
+						l1357:
+							position, tokenIndex = position1357, tokenIndex1357
+						}
+						if !matchDot() {
+							fail(".")
+							goto l1354
+						}
+					}
+				l1355:
+					goto l1353
+				l1354:
+					position, tokenIndex = position1354, tokenIndex1354
+				}
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l1351
+				}
+				position++
+				add(ruleStringLiteral, position1352)
+			}
+			memoize(181, position1351, tokenIndex1351, true)
+			return true
+		l1351:
+			memoize(181, position1351, tokenIndex1351, false)
+			position, tokenIndex = position1351, tokenIndex1351
+			return false
+		},
+		/* 182 TextBlock <- <('"' '"' '"' (' ' / '\t')* ('\r' / '\n') (('\\' ('\r' / '\n')) / Escape / (!('"' '"' '"') .))* ('"' '"' '"'))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{182, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1359, tokenIndex1359 := position, tokenIndex
+			{
+				position1360 := position
+				if buffer[position] != rune('"') {
+					fail("'\"\"\"'")
+					goto l1359
+				}
+				position++
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l1359
+				}
+				position++
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l1359
+				}
+				position++
+			l1361:
+				{
+					position1362, tokenIndex1362 := position, tokenIndex
+					{
+						position1363, tokenIndex1363 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							fail("' '")
+							goto l1364
+						}
+						position++
+						goto l1363
+					l1364:
+						position, tokenIndex = position1363, tokenIndex1363
+						if buffer[position] != rune('\t') {
+							fail("'\\t'")
+							goto l1362
+						}
+						position++
+					}
+				l1363:
+					goto l1361
+				l1362:
+					position, tokenIndex = position1362, tokenIndex1362
+				}
+				{
+					position1365, tokenIndex1365 := position, tokenIndex
+					if buffer[position] != rune('\r') {
+						fail("'\\r'")
+						goto l1366
+					}
+					position++
+					goto l1365
+				l1366:
+					position, tokenIndex = position1365, tokenIndex1365
+					if buffer[position] != rune('\n') {
+						fail("'\\n'")
+						goto l1359
+					}
+					position++
+				}
+			l1365:
+			l1367:
+				{
+					position1368, tokenIndex1368 := position, tokenIndex
+					{
+						position1369, tokenIndex1369 := position, tokenIndex
+						if buffer[position] != rune('\\') {
+							fail("'\\\\'")
+							goto l1370
+						}
+						position++
+						{
+							position1371, tokenIndex1371 := position, tokenIndex
+							if buffer[position] != rune('\r') {
+								fail("'\\r'")
+								goto l1372
+							}
+							position++
+							goto l1371
+						l1372:
+							position, tokenIndex = position1371, tokenIndex1371
+							if buffer[position] != rune('\n') {
+								fail("'\\n'")
+								goto l1370
+							}
+							position++
+						}
+					l1371:
+						goto l1369
+					l1370:
+						position, tokenIndex = position1369, tokenIndex1369
+						if !_rules[ruleEscape]() {
+							goto l1373
+						}
+						goto l1369
+					l1373:
+						position, tokenIndex = position1369, tokenIndex1369
+						{
+							position1374, tokenIndex1374 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								fail("'\"\"\"'")
+								goto l1374
+							}
+							position++
+							if buffer[position] != rune('"') {
+								fail("'\"'")
+								goto l1374
+							}
+							position++
+							if buffer[position] != rune('"') {
+								fail("'\"'")
+								goto l1374
+							}
+							position++
+							goto l1368
+						l1374:
+							position, tokenIndex = position1374, tokenIndex1374
+						}
+						if !matchDot() {
+							fail(".")
+							goto l1368
+						}
+					}
+				l1369:
+					goto l1367
+				l1368:
+					position, tokenIndex = position1368, tokenIndex1368
+				}
+				if buffer[position] != rune('"') {
+					fail("'\"\"\"'")
+					goto l1359
+				}
+				position++
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l1359
+				}
+				position++
+				if buffer[position] != rune('"') {
+					fail("'\"'")
+					goto l1359
+				}
+				position++
+				add(ruleTextBlock, position1360)
+			}
+			memoize(182, position1359, tokenIndex1359, true)
+			return true
+		l1359:
+			memoize(182, position1359, tokenIndex1359, false)
+			position, tokenIndex = position1359, tokenIndex1359
+			return false
+		},
+		/* 183 Escape <- <('\\' ((&('"') '"') | (&('\'') '\'') | (&('\\') '\\') | (&('b') 'b') | (&('f') 'f') | (&('n') 'n') | (&('r') 'r') | (&('s') 's') | (&('t') 't') | (&('u') UnicodeEscape) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7') %fail('"' '\'' '\\' 'b' 'f' 'n' 'r' 's' 't' 'u') OctalEscape)))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{183, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1375, tokenIndex1375 := position, tokenIndex
+			{
+				position1376 := position
+				if buffer[position] != rune('\\') {
+					fail("'\\\\'")
+					goto l1375
+				}
+				position++
+				{
+					switch buffer[position] {
+					case '"':
+						position++
+					case '\'':
+						position++
+					case '\\':
+						position++
+					case 'b':
+						position++
+					case 'f':
+						position++
+					case 'n':
+						position++
+					case 'r':
+						position++
+					case 's':
+						position++
+					case 't':
+						position++
+					case 'u':
+						{
+							position1378 := position
+							if buffer[position] != rune('u') {
+								fail("'u'")
+								goto l1375
+							}
+							position++
+							{
+								position1381, tokenIndex1381 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '$':
+										position++
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									case '_':
+										position++
+									default:
+										fail("'$'")
+										fail("[0-9]")
+										fail("[A-Z]")
+										fail("'_'")
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											fail("[a-z]")
+											goto l1381
+										}
+										position++
+									}
+								}
+
+								goto l1375
+							l1381:
+								position, tokenIndex = position1381, tokenIndex1381
+							}
+						l1379:
+							{
+								position1380, tokenIndex1380 := position, tokenIndex
+								if buffer[position] != rune('u') {
+									fail("'u'")
+									goto l1380
+								}
+								position++
+								{
+									position1383, tokenIndex1383 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '$':
+											position++
+										case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+											position++
+										case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+											position++
+										case '_':
+											position++
+										default:
+											fail("'$'")
+											fail("[0-9]")
+											fail("[A-Z]")
+											fail("'_'")
+											if c := buffer[position]; c < rune('a') || c > rune('z') {
+												fail("[a-z]")
+												goto l1383
+											}
+											position++
+										}
+									}
+
+									goto l1380
+								l1383:
+									position, tokenIndex = position1383, tokenIndex1383
+								}
+								goto l1379
+							l1380:
+								position, tokenIndex = position1380, tokenIndex1380
+							}
+							if !_rules[ruleHexDigit]() {
+								goto l1375
+							}
+							if !_rules[ruleHexDigit]() {
+								goto l1375
+							}
+							if !_rules[ruleHexDigit]() {
+								goto l1375
+							}
+							if !_rules[ruleHexDigit]() {
+								goto l1375
+							}
+							add(ruleUnicodeEscape, position1378)
+						}
+					default:
+						fail("'\"'")
+						fail("'\\''")
+						fail("'\\\\'")
+						fail("'b'")
+						fail("'f'")
+						fail("'n'")
+						fail("'r'")
+						fail("'s'")
+						fail("'t'")
+						fail("'u'")
+						{
+							position1385 := position
+							{
+								position1386, tokenIndex1386 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('3') {
+									fail("[0-3]")
+									goto l1387
+								}
+								position++
+								if c := buffer[position]; c < rune('0') || c > rune('7') {
+									fail("[0-7]")
+									goto l1387
+								}
+								position++
+								if c := buffer[position]; c < rune('0') || c > rune('7') {
+									fail("[0-7]")
+									goto l1387
+								}
+								position++
+								goto l1386
+							l1387:
+								position, tokenIndex = position1386, tokenIndex1386
+								if c := buffer[position]; c < rune('0') || c > rune('7') {
+									fail("[0-7]")
+									goto l1375
+								}
+								position++
+								{
+									position1388, tokenIndex1388 := position, tokenIndex
+									if c := buffer[position]; c < rune('0') || c > rune('7') {
+										fail("[0-7]")
+										goto l1389
+									}
+									position++
+									goto l1388
+								l1389:
+									position, tokenIndex = position1388, tokenIndex1388
+								}
+							l1388:
+							}
+						l1386:
+							add(ruleOctalEscape, position1385)
+						}
+					}
+				}
+
+				add(ruleEscape, position1376)
+			}
+			memoize(183, position1375, tokenIndex1375, true)
+			return true
+		l1375:
+			memoize(183, position1375, tokenIndex1375, false)
+			position, tokenIndex = position1375, tokenIndex1375
+			return false
+		},
+		/* 184 OctalEscape <- <(([0-3] [0-7] [0-7]) / ([0-7] ([0-7] / )))> */
+		nil,
+		/* 185 UnicodeEscape <- <(('u' !((&('$') '$') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('_') '_') | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('$' [0-9] [A-Z] '_') [a-z])))+ HexDigit HexDigit HexDigit HexDigit)> */
+		nil,
+		/* 186 AT <- <('@' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{186, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1392, tokenIndex1392 := position, tokenIndex
+			{
+				position1393 := position
+				if buffer[position] != rune('@') {
+					fail("'@'")
+					goto l1392
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1392
+				}
+				add(ruleAT, position1393)
+			}
+			memoize(186, position1392, tokenIndex1392, true)
+			return true
+		l1392:
+			memoize(186, position1392, tokenIndex1392, false)
+			position, tokenIndex = position1392, tokenIndex1392
+			return false
+		},
+		/* 187 AND <- <('&' !('=' / '&') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{187, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1394, tokenIndex1394 := position, tokenIndex
+			{
+				position1395 := position
+				if buffer[position] != rune('&') {
+					fail("'&'")
+					goto l1394
+				}
+				position++
+				{
+					position1396, tokenIndex1396 := position, tokenIndex
+					{
+						position1397, tokenIndex1397 := position, tokenIndex
+						if buffer[position] != rune('=') {
+							fail("'='")
+							goto l1398
+						}
+						position++
+						goto l1397
+					l1398:
+						position, tokenIndex = position1397, tokenIndex1397
+						if buffer[position] != rune('&') {
+							fail("'&'")
+							goto l1396
+						}
+						position++
+					}
+				l1397:
+					goto l1394
+				l1396:
+					position, tokenIndex = position1396, tokenIndex1396
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1394
+				}
+				add(ruleAND, position1395)
+			}
+			memoize(187, position1394, tokenIndex1394, true)
+			return true
+		l1394:
+			memoize(187, position1394, tokenIndex1394, false)
+			position, tokenIndex = position1394, tokenIndex1394
+			return false
+		},
+		/* 188 ANDAND <- <('&' '&' Spacing)> */
+		nil,
+		/* 189 ANDEQU <- <('&' '=' Spacing)> */
+		nil,
+		/* 190 BANG <- <('!' !'=' Spacing)> */
+		nil,
+		/* 191 BSR <- <('>' '>' '>' !'=' Spacing)> */
+		nil,
+		/* 192 BSREQU <- <('>' '>' '>' '=' Spacing)> */
+		nil,
+		/* 193 ARROW <- <('-' '>' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{193, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1404, tokenIndex1404 := position, tokenIndex
+			{
+				position1405 := position
+				if buffer[position] != rune('-') {
+					fail("'->'")
+					goto l1404
+				}
+				position++
+				if buffer[position] != rune('>') {
+					fail("'>'")
+					goto l1404
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1404
+				}
+				add(ruleARROW, position1405)
+			}
+			memoize(193, position1404, tokenIndex1404, true)
+			return true
+		l1404:
+			memoize(193, position1404, tokenIndex1404, false)
+			position, tokenIndex = position1404, tokenIndex1404
+			return false
+		},
+		/* 194 COLON <- <(':' !':' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{194, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1406, tokenIndex1406 := position, tokenIndex
+			{
+				position1407 := position
+				if buffer[position] != rune(':') {
+					fail("':'")
+					goto l1406
+				}
+				position++
+				{
+					position1408, tokenIndex1408 := position, tokenIndex
+					if buffer[position] != rune(':') {
+						fail("':'")
+						goto l1408
+					}
+					position++
+					goto l1406
+				l1408:
+					position, tokenIndex = position1408, tokenIndex1408
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1406
+				}
+				add(ruleCOLON, position1407)
+			}
+			memoize(194, position1406, tokenIndex1406, true)
+			return true
+		l1406:
+			memoize(194, position1406, tokenIndex1406, false)
+			position, tokenIndex = position1406, tokenIndex1406
+			return false
+		},
+		/* 195 COLONCOLON <- <(':' ':' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{195, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1409, tokenIndex1409 := position, tokenIndex
+			{
+				position1410 := position
+				if buffer[position] != rune(':') {
+					fail("'::'")
+					goto l1409
+				}
+				position++
+				if buffer[position] != rune(':') {
+					fail("':'")
+					goto l1409
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1409
+				}
+				add(ruleCOLONCOLON, position1410)
+			}
+			memoize(195, position1409, tokenIndex1409, true)
+			return true
+		l1409:
+			memoize(195, position1409, tokenIndex1409, false)
+			position, tokenIndex = position1409, tokenIndex1409
+			return false
+		},
+		/* 196 COMMA <- <(',' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{196, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1411, tokenIndex1411 := position, tokenIndex
+			{
+				position1412 := position
+				if buffer[position] != rune(',') {
+					fail("','")
+					goto l1411
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1411
+				}
+				add(ruleCOMMA, position1412)
+			}
+			memoize(196, position1411, tokenIndex1411, true)
+			return true
+		l1411:
+			memoize(196, position1411, tokenIndex1411, false)
+			position, tokenIndex = position1411, tokenIndex1411
+			return false
+		},
+		/* 197 DEC <- <('-' '-' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{197, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1413, tokenIndex1413 := position, tokenIndex
+			{
+				position1414 := position
+				if buffer[position] != rune('-') {
+					fail("'--'")
+					goto l1413
+				}
+				position++
+				if buffer[position] != rune('-') {
+					fail("'-'")
+					goto l1413
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1413
+				}
+				add(ruleDEC, position1414)
+			}
+			memoize(197, position1413, tokenIndex1413, true)
+			return true
+		l1413:
+			memoize(197, position1413, tokenIndex1413, false)
+			position, tokenIndex = position1413, tokenIndex1413
+			return false
+		},
+		/* 198 DIV <- <('/' !'=' Spacing)> */
+		nil,
+		/* 199 DIVEQU <- <('/' '=' Spacing)> */
+		nil,
+		/* 200 DOT <- <('.' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{200, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1417, tokenIndex1417 := position, tokenIndex
+			{
+				position1418 := position
+				if buffer[position] != rune('.') {
+					fail("'.'")
+					goto l1417
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1417
+				}
+				add(ruleDOT, position1418)
+			}
+			memoize(200, position1417, tokenIndex1417, true)
+			return true
+		l1417:
+			memoize(200, position1417, tokenIndex1417, false)
+			position, tokenIndex = position1417, tokenIndex1417
+			return false
+		},
+		/* 201 ELLIPSIS <- <('.' '.' '.' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{201, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1419, tokenIndex1419 := position, tokenIndex
+			{
+				position1420 := position
+				if buffer[position] != rune('.') {
+					fail("'...'")
+					goto l1419
+				}
+				position++
+				if buffer[position] != rune('.') {
+					fail("'.'")
+					goto l1419
+				}
+				position++
+				if buffer[position] != rune('.') {
+					fail("'.'")
+					goto l1419
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1419
+				}
+				add(ruleELLIPSIS, position1420)
+			}
+			memoize(201, position1419, tokenIndex1419, true)
+			return true
+		l1419:
+			memoize(201, position1419, tokenIndex1419, false)
+			position, tokenIndex = position1419, tokenIndex1419
+			return false
+		},
+		/* 202 EQU <- <('=' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{202, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1421, tokenIndex1421 := position, tokenIndex
+			{
+				position1422 := position
+				if buffer[position] != rune('=') {
+					fail("'='")
+					goto l1421
+				}
+				position++
+				{
+					position1423, tokenIndex1423 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						fail("'='")
+						goto l1423
+					}
+					position++
+					goto l1421
+				l1423:
+					position, tokenIndex = position1423, tokenIndex1423
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1421
+				}
+				add(ruleEQU, position1422)
+			}
+			memoize(202, position1421, tokenIndex1421, true)
+			return true
+		l1421:
+			memoize(202, position1421, tokenIndex1421, false)
+			position, tokenIndex = position1421, tokenIndex1421
+			return false
+		},
+		/* 203 EQUAL <- <('=' '=' Spacing)> */
+		nil,
+		/* 204 GE <- <('>' '=' Spacing)> */
+		nil,
+		/* 205 GT <- <('>' !('=' / '>') Spacing)> */
+		nil,
+		/* 206 HAT <- <('^' !'=' Spacing)> */
+		nil,
+		/* 207 HATEQU <- <('^' '=' Spacing)> */
+		nil,
+		/* 208 INC <- <('+' '+' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{208, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1429, tokenIndex1429 := position, tokenIndex
+			{
+				position1430 := position
+				if buffer[position] != rune('+') {
+					fail("'++'")
+					goto l1429
+				}
+				position++
+				if buffer[position] != rune('+') {
+					fail("'+'")
+					goto l1429
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1429
+				}
+				add(ruleINC, position1430)
+			}
+			memoize(208, position1429, tokenIndex1429, true)
+			return true
+		l1429:
+			memoize(208, position1429, tokenIndex1429, false)
+			position, tokenIndex = position1429, tokenIndex1429
+			return false
+		},
+		/* 209 LBRK <- <('[' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{209, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1431, tokenIndex1431 := position, tokenIndex
+			{
+				position1432 := position
+				if buffer[position] != rune('[') {
+					fail("'['")
+					goto l1431
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1431
+				}
+				add(ruleLBRK, position1432)
+			}
+			memoize(209, position1431, tokenIndex1431, true)
+			return true
+		l1431:
+			memoize(209, position1431, tokenIndex1431, false)
+			position, tokenIndex = position1431, tokenIndex1431
+			return false
+		},
+		/* 210 LE <- <('<' '=' Spacing)> */
+		nil,
+		/* 211 LPAR <- <('(' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{211, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1434, tokenIndex1434 := position, tokenIndex
+			{
+				position1435 := position
+				if buffer[position] != rune('(') {
+					fail("'('")
+					goto l1434
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1434
+				}
+				add(ruleLPAR, position1435)
+			}
+			memoize(211, position1434, tokenIndex1434, true)
+			return true
+		l1434:
+			memoize(211, position1434, tokenIndex1434, false)
+			position, tokenIndex = position1434, tokenIndex1434
+			return false
+		},
+		/* 212 LPOINT <- <('<' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{212, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1436, tokenIndex1436 := position, tokenIndex
+			{
+				position1437 := position
+				if buffer[position] != rune('<') {
+					fail("'<'")
+					goto l1436
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1436
+				}
+				add(ruleLPOINT, position1437)
+			}
+			memoize(212, position1436, tokenIndex1436, true)
+			return true
+		l1436:
+			memoize(212, position1436, tokenIndex1436, false)
+			position, tokenIndex = position1436, tokenIndex1436
+			return false
+		},
+		/* 213 LT <- <('<' !('=' / '<') Spacing)> */
+		nil,
+		/* 214 LWING <- <('{' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{214, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1439, tokenIndex1439 := position, tokenIndex
+			{
+				position1440 := position
+				if buffer[position] != rune('{') {
+					fail("'{'")
+					goto l1439
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1439
+				}
+				add(ruleLWING, position1440)
+			}
+			memoize(214, position1439, tokenIndex1439, true)
+			return true
+		l1439:
+			memoize(214, position1439, tokenIndex1439, false)
+			position, tokenIndex = position1439, tokenIndex1439
+			return false
+		},
+		/* 215 MINUS <- <('-' !((&('-') '-') | (&('>') '>') | (&('=') %fail('-' '>') '=')) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{215, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1441, tokenIndex1441 := position, tokenIndex
+			{
+				position1442 := position
+				if buffer[position] != rune('-') {
+					fail("'-'")
+					goto l1441
+				}
+				position++
+				{
+					position1443, tokenIndex1443 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '-':
+							position++
+						case '>':
+							position++
+						default:
+							fail("'-'")
+							fail("'>'")
+							if buffer[position] != rune('=') {
+								fail("'='")
+								goto l1443
+							}
+							position++
+						}
+					}
+
+					goto l1441
+				l1443:
+					position, tokenIndex = position1443, tokenIndex1443
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1441
+				}
+				add(ruleMINUS, position1442)
+			}
+			memoize(215, position1441, tokenIndex1441, true)
+			return true
+		l1441:
+			memoize(215, position1441, tokenIndex1441, false)
+			position, tokenIndex = position1441, tokenIndex1441
+			return false
+		},
+		/* 216 MINUSEQU <- <('-' '=' Spacing)> */
+		nil,
+		/* 217 MOD <- <('%' !'=' Spacing)> */
+		nil,
+		/* 218 MODEQU <- <('%' '=' Spacing)> */
+		nil,
+		/* 219 NOTEQUAL <- <('!' '=' Spacing)> */
+		nil,
+		/* 220 OR <- <('|' !('=' / '|') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{220, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1449, tokenIndex1449 := position, tokenIndex
+			{
+				position1450 := position
+				if buffer[position] != rune('|') {
+					fail("'|'")
+					goto l1449
+				}
+				position++
+				{
+					position1451, tokenIndex1451 := position, tokenIndex
+					{
+						position1452, tokenIndex1452 := position, tokenIndex
+						if buffer[position] != rune('=') {
+							fail("'='")
+							goto l1453
+						}
+						position++
+						goto l1452
+					l1453:
+						position, tokenIndex = position1452, tokenIndex1452
+						if buffer[position] != rune('|') {
+							fail("'|'")
+							goto l1451
+						}
+						position++
+					}
+				l1452:
+					goto l1449
+				l1451:
+					position, tokenIndex = position1451, tokenIndex1451
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1449
+				}
+				add(ruleOR, position1450)
+			}
+			memoize(220, position1449, tokenIndex1449, true)
+			return true
+		l1449:
+			memoize(220, position1449, tokenIndex1449, false)
+			position, tokenIndex = position1449, tokenIndex1449
+			return false
+		},
+		/* 221 OREQU <- <('|' '=' Spacing)> */
+		nil,
+		/* 222 OROR <- <('|' '|' Spacing)> */
+		nil,
+		/* 223 PLUS <- <('+' !('=' / '+') Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{223, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1456, tokenIndex1456 := position, tokenIndex
+			{
+				position1457 := position
+				if buffer[position] != rune('+') {
+					fail("'+'")
+					goto l1456
+				}
+				position++
+				{
+					position1458, tokenIndex1458 := position, tokenIndex
+					{
+						position1459, tokenIndex1459 := position, tokenIndex
+						if buffer[position] != rune('=') {
+							fail("'='")
+							goto l1460
+						}
+						position++
+						goto l1459
+					l1460:
+						position, tokenIndex = position1459, tokenIndex1459
+						if buffer[position] != rune('+') {
+							fail("'+'")
+							goto l1458
+						}
+						position++
+					}
+				l1459:
+					goto l1456
+				l1458:
+					position, tokenIndex = position1458, tokenIndex1458
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1456
+				}
+				add(rulePLUS, position1457)
+			}
+			memoize(223, position1456, tokenIndex1456, true)
+			return true
+		l1456:
+			memoize(223, position1456, tokenIndex1456, false)
+			position, tokenIndex = position1456, tokenIndex1456
+			return false
+		},
+		/* 224 PLUSEQU <- <('+' '=' Spacing)> */
+		nil,
+		/* 225 QUERY <- <('?' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{225, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1462, tokenIndex1462 := position, tokenIndex
+			{
+				position1463 := position
+				if buffer[position] != rune('?') {
+					fail("'?'")
+					goto l1462
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1462
+				}
+				add(ruleQUERY, position1463)
+			}
+			memoize(225, position1462, tokenIndex1462, true)
+			return true
+		l1462:
+			memoize(225, position1462, tokenIndex1462, false)
+			position, tokenIndex = position1462, tokenIndex1462
+			return false
+		},
+		/* 226 RBRK <- <(']' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{226, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1464, tokenIndex1464 := position, tokenIndex
+			{
+				position1465 := position
+				if buffer[position] != rune(']') {
+					fail("']'")
+					goto l1464
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1464
+				}
+				add(ruleRBRK, position1465)
+			}
+			memoize(226, position1464, tokenIndex1464, true)
+			return true
+		l1464:
+			memoize(226, position1464, tokenIndex1464, false)
+			position, tokenIndex = position1464, tokenIndex1464
+			return false
+		},
+		/* 227 RPAR <- <(')' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{227, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1466, tokenIndex1466 := position, tokenIndex
+			{
+				position1467 := position
+				if buffer[position] != rune(')') {
+					fail("')'")
+					goto l1466
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1466
+				}
+				add(ruleRPAR, position1467)
+			}
+			memoize(227, position1466, tokenIndex1466, true)
+			return true
+		l1466:
+			memoize(227, position1466, tokenIndex1466, false)
+			position, tokenIndex = position1466, tokenIndex1466
+			return false
+		},
+		/* 228 RPOINT <- <('>' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{228, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1468, tokenIndex1468 := position, tokenIndex
+			{
+				position1469 := position
+				if buffer[position] != rune('>') {
+					fail("'>'")
+					goto l1468
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1468
+				}
+				add(ruleRPOINT, position1469)
+			}
+			memoize(228, position1468, tokenIndex1468, true)
+			return true
+		l1468:
+			memoize(228, position1468, tokenIndex1468, false)
+			position, tokenIndex = position1468, tokenIndex1468
+			return false
+		},
+		/* 229 RWING <- <('}' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{229, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1470, tokenIndex1470 := position, tokenIndex
+			{
+				position1471 := position
+				if buffer[position] != rune('}') {
+					fail("'}'")
+					goto l1470
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1470
+				}
+				add(ruleRWING, position1471)
+			}
+			memoize(229, position1470, tokenIndex1470, true)
+			return true
+		l1470:
+			memoize(229, position1470, tokenIndex1470, false)
+			position, tokenIndex = position1470, tokenIndex1470
+			return false
+		},
+		/* 230 SEMI <- <(';' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{230, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1472, tokenIndex1472 := position, tokenIndex
+			{
+				position1473 := position
+				if buffer[position] != rune(';') {
+					fail("';'")
+					goto l1472
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l1472
+				}
+				add(ruleSEMI, position1473)
+			}
+			memoize(230, position1472, tokenIndex1472, true)
+			return true
+		l1472:
+			memoize(230, position1472, tokenIndex1472, false)
+			position, tokenIndex = position1472, tokenIndex1472
+			return false
+		},
+		/* 231 SL <- <('<' '<' !'=' Spacing)> */
+		nil,
+		/* 232 SLEQU <- <('<' '<' '=' Spacing)> */
+		nil,
+		/* 233 SR <- <('>' '>' !('=' / '>') Spacing)> */
+		nil,
+		/* 234 SREQU <- <('>' '>' '=' Spacing)> */
+		nil,
+		/* 235 STAR <- <('*' !'=' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{235, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position1478, tokenIndex1478 := position, tokenIndex
+			{
+				position1479 := position
+				if buffer[position] != rune('*') {
+					fail("'*'")
+					goto l1478
+				}
+				position++
+				{
+					position1480, tokenIndex1480 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						fail("'='")
+						goto l1480
+					}
+					position++
+					goto l1478
+				l1480:
+					position, tokenIndex = position1480, tokenIndex1480
+				}
+				if !_rules[ruleSpacing]() {
+					goto l1478
+				}
+				add(ruleSTAR, position1479)
+			}
+			memoize(235, position1478, tokenIndex1478, true)
+			return true
+		l1478:
+			memoize(235, position1478, tokenIndex1478, false)
+			position, tokenIndex = position1478, tokenIndex1478
+			return false
+		},
+		/* 236 STAREQU <- <('*' '=' Spacing)> */
+		nil,
+		/* 237 TILDA <- <('~' Spacing)> */
+		nil,
+		/* 238 EOT <- <!.> */
+		nil,
+	}
+	if p.maxDepth > 0 || p.trackRules {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule, name := rule, rul3s[i]
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				if p.trackRules {
+					stack = append(stack, name)
+					defer func() { stack = stack[:len(stack)-1] }()
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
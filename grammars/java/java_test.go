@@ -2,16 +2,14 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build grammars
-// +build grammars
-
-package main
+package java
 
 import (
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -32,10 +30,155 @@ func TestBasic(t *testing.T) {
 	}
 }
 
+var example17 = `package example;
+
+import java.util.*;
+import static java.util.stream.Collectors.toList;
+
+public sealed interface Shape permits Circle, Square, Shape.Empty {
+	double area();
+
+	default String describe() {
+		return switch ((int) area()) {
+			case 0 -> "empty";
+			default -> {
+				yield "shape " + area();
+			}
+		};
+	}
+
+	private static int zero() { return 0; }
+
+	record Empty() implements Shape {
+		public double area() { return zero(); }
+	}
+}
+
+record Circle(double radius) implements Shape {
+	Circle {
+		if (radius < 0) throw new IllegalArgumentException();
+	}
+
+	public double area() { return Math.PI * radius * radius; }
+}
+
+non-sealed class Square implements Shape {
+	private final double side = 1_000.5e-3;
+
+	public double area() { return side * side; }
+
+	static List<String> names(List<Shape> shapes) {
+		var record = 1;
+		var yield = record + 1;
+		Runnable r = () -> {};
+		Comparator<String> c = (a, b) -> a.compareTo(b);
+		Function<Integer, Integer> f = (var x) -> x + yield;
+		Object o = (Runnable) () -> System.out.println("cast");
+		if (o instanceof final Runnable runnable) {
+			runnable.run();
+		}
+		int day = 3;
+		switch (day) {
+			case 1, 7 -> System.out.println("weekend");
+			case 2 -> throw new IllegalStateException();
+			default -> System.out.println("weekday");
+		}
+		switch (day) {
+			case 1:
+			default:
+				break;
+		}
+		String text = """
+			Hello, \s
+			"World" \
+			""";
+		Supplier<List<String>> s = ArrayList::new;
+		IntFunction<String[]> array = String[]::new;
+		shapes.forEach(System.out::println);
+		return shapes.stream().map(Shape::describe).map(super::toString).collect(toList());
+	}
+}
+`
+
+func TestJava17(t *testing.T) {
+	java := &Java{Buffer: example17}
+	java.Init()
+	if err := java.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if errors := java.Errors(); len(errors) > 0 {
+		t.Fatal(errors)
+	}
+}
+
+func TestJavaRecovery(t *testing.T) {
+	java := &Java{Buffer: `class A {
+	int a = ;
+	void b() { if }
+	int c() { return "}"; }
+}`}
+	java.Init()
+	if err := java.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	errors := java.Errors()
+	if len(errors) != 2 {
+		t.Fatalf("got %v, expected 2 errors", errors)
+	}
+	if expected := `invalid member "int a = ;" at offset 11`; errors[0].Error() != expected {
+		t.Errorf("got %v, expected %v", errors[0], expected)
+	}
+}
+
+func TestJavaCompletions(t *testing.T) {
+	buffer := "class A { void b() { "
+	java := &Java{Buffer: buffer}
+	java.Init()
+	completions := java.Completions(len(buffer))
+	/* the keywords are offered whole, before the characters */
+	keywords := 0
+	for keywords < len(completions) && len([]rune(completions[keywords])) > 3 {
+		keywords++
+	}
+	for _, keyword := range []string{"'sealed'", "'while'", "'yield'"} {
+		if !slices.Contains(completions[:keywords], keyword) {
+			t.Fatalf("got %q, expected the keywords first", completions)
+		}
+	}
+	if last := completions[len(completions)-1]; last != "[a-z]" {
+		t.Fatalf("got %q, expected the character classes last", completions)
+	}
+	seen := make(map[string]bool)
+	for _, completion := range completions {
+		if seen[completion] {
+			t.Fatalf("got %q, expected %v once", completions, completion)
+		}
+		seen[completion] = true
+	}
+}
+
+func BenchmarkJava(b *testing.B) {
+	source := strings.Repeat(example1+example17[strings.Index(example17, "record Circle"):], 10)
+	java := &Java{Buffer: source}
+	java.Init()
+	b.SetBytes(int64(len(source)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		java.Reset()
+		if err := java.Parse(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestJava(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping java parsing long test")
 	}
+	if _, err := os.Stat("java/"); err != nil {
+		t.Skip("skipping java parsing long test without sources in java/")
+	}
 
 	var walk func(name string)
 	walk = func(name string) {
@@ -0,0 +1,88 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run github.com/pointlander/peg -switch -inline -incremental json.peg
+
+// Package json decodes JSON documents with the parser generated from
+// json.peg.
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Value returns the value of the parsed document, made of the same types as
+// encoding/json decodes into an interface, along with an error for every
+// malformed value skipped, which becomes nil.
+func (j *JSON) Value() (any, []error) {
+	var errors []error
+	value := j.value(j.AST().up, &errors)
+	return value, errors
+}
+
+func (j *JSON) text(node *node32) string {
+	return string(j.buffer[node.begin:node.end])
+}
+
+func (j *JSON) value(node *node32, errors *[]error) any {
+	for ; node != nil; node = node.next {
+		if node.pegRule != ruleValue {
+			continue
+		}
+		value := node.up
+		switch value.pegRule {
+		case ruleObject:
+			object := make(map[string]any)
+			for member := value.up; member != nil; member = member.next {
+				if member.pegRule != ruleMember {
+					continue
+				}
+				if member.up.pegRule == ruleInvalid {
+					j.invalid(member.up, errors)
+					continue
+				}
+				object[j.string(member.up)] = j.value(member.up.next, errors)
+			}
+			return object
+		case ruleArray:
+			array := []any{}
+			for element := value.up; element != nil; element = element.next {
+				if element.pegRule == ruleValue {
+					array = append(array, j.value(element, errors))
+				}
+			}
+			return array
+		case ruleString:
+			return j.string(value)
+		case ruleNumber:
+			number, err := strconv.ParseFloat(j.text(value), 64)
+			if err != nil {
+				*errors = append(*errors, err)
+			}
+			return number
+		case ruleTrue:
+			return true
+		case ruleFalse:
+			return false
+		case ruleInvalid:
+			j.invalid(value, errors)
+		}
+		return nil
+	}
+	return nil
+}
+
+func (j *JSON) string(node *node32) string {
+	var s string
+	if err := json.Unmarshal([]byte(j.text(node)), &s); err != nil {
+		return j.text(node)
+	}
+	return s
+}
+
+func (j *JSON) invalid(node *node32, errors *[]error) {
+	*errors = append(*errors, fmt.Errorf("invalid value %q at offset %v", j.text(node), node.begin))
+}
@@ -0,0 +1,1665 @@
+// Code generated by peg -switch -inline -incremental json.peg. DO NOT EDIT.
+
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package json
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	ruleDocument
+	ruleValue
+	ruleObject
+	ruleMember
+	ruleArray
+	ruleComma
+	ruleString
+	ruleCharacter
+	ruleHexDigit
+	ruleNumber
+	ruleTrue
+	ruleFalse
+	ruleNull
+	ruleInvalid
+	ruleSpacing
+	ruleEndOfFile
+	rulePegText
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"Document",
+	"Value",
+	"Object",
+	"Member",
+	"Array",
+	"Comma",
+	"String",
+	"Character",
+	"HexDigit",
+	"Number",
+	"True",
+	"False",
+	"Null",
+	"Invalid",
+	"Spacing",
+	"EndOfFile",
+	"PegText",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"Document", "json.peg", 10, 10, false, false},
+	{"Value", "json.peg", 11, 11, false, false},
+	{"Object", "json.peg", 12, 12, false, true},
+	{"Member", "json.peg", 13, 14, false, false},
+	{"Array", "json.peg", 15, 15, false, true},
+	{"Comma", "json.peg", 16, 16, false, false},
+	{"String", "json.peg", 17, 17, false, false},
+	{"Character", "json.peg", 18, 19, false, true},
+	{"HexDigit", "json.peg", 20, 20, true, false},
+	{"Number", "json.peg", 21, 21, true, true},
+	{"True", "json.peg", 22, 22, true, true},
+	{"False", "json.peg", 23, 23, true, true},
+	{"Null", "json.peg", 24, 24, true, true},
+	{"Invalid", "json.peg", 28, 28, true, false},
+	{"Spacing", "json.peg", 29, 29, true, false},
+	{"EndOfFile", "json.peg", 30, 30, true, true},
+	{"PegText", "", 0, 0, true, false},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type JSON struct {
+	Buffer         string
+	buffer         []rune
+	rules          [18]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*JSON) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	edit           func(offset, deleted int, inserted string) error
+	tokens32
+}
+
+func (p *JSON) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *JSON) Reset() {
+	p.reset()
+}
+
+// Edit replaces the deleted runes of Buffer at offset with inserted, like the
+// changes an editor sends, and parses it again with the rule of the last
+// Parse. The memoized matches of the last parse which read only runes before
+// the edit, or only runes after it, are reused at their new positions, so a
+// small edit mostly parses the rules around it again. The offsets count runes
+// like the positions of the tokens. When the input doesn't match, it's parsed
+// again from scratch, for the same error as Parse. The runes read by
+// predicates aren't tracked, and with NormalizeCRLF the whole buffer is
+// parsed again.
+func (p *JSON) Edit(offset, deleted int, inserted string) error {
+	return p.edit(offset, deleted, inserted)
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *JSON) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *JSON) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *JSON) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *JSON) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *JSON) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *JSON) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *JSON) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *JSON
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *JSON) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *JSON
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *JSON) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *JSON) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *JSON) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func Pretty(pretty bool) func(*JSON) error {
+	return func(p *JSON) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*JSON) error {
+	return func(p *JSON) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*JSON) error {
+	return func(p *JSON) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*JSON) error {
+	return func(p *JSON) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*JSON) error {
+	return func(p *JSON) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+func Size(size int) func(*JSON) error {
+	return func(p *JSON) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*JSON) error {
+	return func(p *JSON) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+	/* the end of the runes read until the match, which Edit checks */
+	Reach uint32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *JSON) Init(options ...func(*JSON) error) error {
+	var (
+		max                           token32
+		position, tokenIndex          uint32
+		depth                         int
+		buffer                        []rune
+		stack                         []string
+		memoization                   map[memoKey]memo
+		reach                         uint32
+		startRule                     int
+		edited                        map[memoKey]memo
+		editBegin, editEnd, editShift uint32
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		reach, edited = 0, nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		startRule = r
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.farthest = max.end
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.edit = func(offset, deleted int, inserted string) error {
+		runes := p.buffer[:len(p.buffer)-1]
+		if offset < 0 || deleted < 0 || offset+deleted > len(runes) {
+			return fmt.Errorf("edit of %d runes at %d is outside the buffer of %d runes", deleted, offset, len(runes))
+		}
+		p.Buffer = string(runes[:offset]) + inserted + string(runes[offset+deleted:])
+		memoized := memoization
+		p.reset()
+		if !p.crlf {
+			edited, editBegin, editEnd = memoized, uint32(offset), uint32(offset+deleted)
+			editShift = uint32(len([]rune(inserted))) - uint32(deleted)
+		}
+		err := p.parse(startRule)
+		edited = nil
+		/* the failures within the reused matches aren't seen again, so the
+		   errors come from a parse from scratch */
+		if err != nil {
+			p.reset()
+			err = p.parse(startRule)
+		}
+		return err
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		/* the rule may have looked at the rune after its end */
+		if position >= reach {
+			reach = position + 1
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false, Reach: reach}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy, Reach: reach}
+		}
+	}
+	_ = memoize
+
+	/* reuse looks a match up in the memoization of the parse before Edit,
+	   which held the matches before the edit, if they read only runes
+	   before it, and the matches after it, which move by editShift */
+	reuse := func(key memoKey) (memo, bool) {
+		switch {
+		case key.Position < editBegin:
+		case key.Position >= editEnd+editShift:
+			key.Position -= editShift
+		default:
+			return memo{}, false
+		}
+		m, ok := edited[key]
+		if !ok || key.Position < editBegin && m.Reach > editBegin {
+			return memo{}, false
+		}
+		if key.Position >= editEnd {
+			key.Position += editShift
+			m.Reach += editShift
+			for i := range m.Partial {
+				m.Partial[i].begin += editShift
+				m.Partial[i].end += editShift
+			}
+		}
+		memoization[key] = m
+		return m, true
+	}
+	_ = reuse
+
+	memoizedResult := func(m memo) bool {
+		if m.Reach > reach {
+			reach = m.Reach
+		}
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 Document <- <(Spacing Value EndOfFile)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{0, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[ruleSpacing]() {
+					goto l0
+				}
+				if !_rules[ruleValue]() {
+					goto l0
+				}
+				{
+					position2 := position
+					{
+						position3, tokenIndex3 := position, tokenIndex
+						if !matchDot() {
+							goto l3
+						}
+						goto l0
+					l3:
+						if position >= reach {
+							reach = position + 1
+						}
+						position, tokenIndex = position3, tokenIndex3
+					}
+					add(ruleEndOfFile, position2)
+				}
+				add(ruleDocument, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			if position >= reach {
+				reach = position + 1
+			}
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Value <- <((Object / Array / String / Number / True / False / Null / Invalid) Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{1, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{1, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position4, tokenIndex4 := position, tokenIndex
+			{
+				position5 := position
+				{
+					position6, tokenIndex6 := position, tokenIndex
+					{
+						position8 := position
+						if buffer[position] != rune('{') {
+							goto l7
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l7
+						}
+						{
+							position9, tokenIndex9 := position, tokenIndex
+							if !_rules[ruleMember]() {
+								goto l9
+							}
+						l11:
+							{
+								position12, tokenIndex12 := position, tokenIndex
+								if !_rules[ruleComma]() {
+									goto l12
+								}
+								if !_rules[ruleMember]() {
+									goto l12
+								}
+								goto l11
+							l12:
+								if position >= reach {
+									reach = position + 1
+								}
+								position, tokenIndex = position12, tokenIndex12
+							}
+							goto l10
+						l9:
+							if position >= reach {
+								reach = position + 1
+							}
+							position, tokenIndex = position9, tokenIndex9
+						}
+					l10:
+						if buffer[position] != rune('}') {
+							goto l7
+						}
+						position++
+						add(ruleObject, position8)
+					}
+					goto l6
+				l7:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position6, tokenIndex6
+					{
+						position14 := position
+						if buffer[position] != rune('[') {
+							goto l13
+						}
+						position++
+						if !_rules[ruleSpacing]() {
+							goto l13
+						}
+						{
+							position15, tokenIndex15 := position, tokenIndex
+							if !_rules[ruleValue]() {
+								goto l15
+							}
+						l17:
+							{
+								position18, tokenIndex18 := position, tokenIndex
+								if !_rules[ruleComma]() {
+									goto l18
+								}
+								if !_rules[ruleValue]() {
+									goto l18
+								}
+								goto l17
+							l18:
+								if position >= reach {
+									reach = position + 1
+								}
+								position, tokenIndex = position18, tokenIndex18
+							}
+							goto l16
+						l15:
+							if position >= reach {
+								reach = position + 1
+							}
+							position, tokenIndex = position15, tokenIndex15
+						}
+					l16:
+						if buffer[position] != rune(']') {
+							goto l13
+						}
+						position++
+						add(ruleArray, position14)
+					}
+					goto l6
+				l13:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position6, tokenIndex6
+					if !_rules[ruleString]() {
+						goto l19
+					}
+					goto l6
+				l19:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position6, tokenIndex6
+					{
+						position21 := position
+						{
+							position22, tokenIndex22 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l22
+							}
+							position++
+							goto l23
+						l22:
+							if position >= reach {
+								reach = position + 1
+							}
+							position, tokenIndex = position22, tokenIndex22
+						}
+					l23:
+						{
+							position24, tokenIndex24 := position, tokenIndex
+							if buffer[position] != rune('0') {
+								goto l25
+							}
+							position++
+							goto l24
+						l25:
+							if position >= reach {
+								reach = position + 1
+							}
+							position, tokenIndex = position24, tokenIndex24
+							if c := buffer[position]; c < rune('1') || c > rune('9') {
+								goto l20
+							}
+							position++
+						l26:
+							{
+								position27, tokenIndex27 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l27
+								}
+								position++
+								goto l26
+							l27:
+								if position >= reach {
+									reach = position + 1
+								}
+								position, tokenIndex = position27, tokenIndex27
+							}
+						}
+					l24:
+						{
+							position28, tokenIndex28 := position, tokenIndex
+							if buffer[position] != rune('.') {
+								goto l28
+							}
+							position++
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l28
+							}
+							position++
+						l30:
+							{
+								position31, tokenIndex31 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l31
+								}
+								position++
+								goto l30
+							l31:
+								if position >= reach {
+									reach = position + 1
+								}
+								position, tokenIndex = position31, tokenIndex31
+							}
+							goto l29
+						l28:
+							if position >= reach {
+								reach = position + 1
+							}
+							position, tokenIndex = position28, tokenIndex28
+						}
+					l29:
+						{
+							position32, tokenIndex32 := position, tokenIndex
+							{
+								position34, tokenIndex34 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l35
+								}
+								position++
+								goto l34
+							l35:
+								if position >= reach {
+									reach = position + 1
+								}
+								position, tokenIndex = position34, tokenIndex34
+								if buffer[position] != rune('E') {
+									goto l32
+								}
+								position++
+							}
+						l34:
+							{
+								position36, tokenIndex36 := position, tokenIndex
+								{
+									position38, tokenIndex38 := position, tokenIndex
+									if buffer[position] != rune('+') {
+										goto l39
+									}
+									position++
+									goto l38
+								l39:
+									if position >= reach {
+										reach = position + 1
+									}
+									position, tokenIndex = position38, tokenIndex38
+									if buffer[position] != rune('-') {
+										goto l36
+									}
+									position++
+								}
+							l38:
+								goto l37
+							l36:
+								if position >= reach {
+									reach = position + 1
+								}
+								position, tokenIndex = position36, tokenIndex36
+							}
+						l37:
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l32
+							}
+							position++
+						l40:
+							{
+								position41, tokenIndex41 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l41
+								}
+								position++
+								goto l40
+							l41:
+								if position >= reach {
+									reach = position + 1
+								}
+								position, tokenIndex = position41, tokenIndex41
+							}
+							goto l33
+						l32:
+							if position >= reach {
+								reach = position + 1
+							}
+							position, tokenIndex = position32, tokenIndex32
+						}
+					l33:
+						add(ruleNumber, position21)
+					}
+					goto l6
+				l20:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position6, tokenIndex6
+					{
+						position43 := position
+						if buffer[position] != rune('t') {
+							goto l42
+						}
+						position++
+						if buffer[position] != rune('r') {
+							goto l42
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l42
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l42
+						}
+						position++
+						add(ruleTrue, position43)
+					}
+					goto l6
+				l42:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position6, tokenIndex6
+					{
+						position45 := position
+						if buffer[position] != rune('f') {
+							goto l44
+						}
+						position++
+						if buffer[position] != rune('a') {
+							goto l44
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l44
+						}
+						position++
+						if buffer[position] != rune('s') {
+							goto l44
+						}
+						position++
+						if buffer[position] != rune('e') {
+							goto l44
+						}
+						position++
+						add(ruleFalse, position45)
+					}
+					goto l6
+				l44:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position6, tokenIndex6
+					{
+						position47 := position
+						if buffer[position] != rune('n') {
+							goto l46
+						}
+						position++
+						if buffer[position] != rune('u') {
+							goto l46
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l46
+						}
+						position++
+						if buffer[position] != rune('l') {
+							goto l46
+						}
+						position++
+						add(ruleNull, position47)
+					}
+					goto l6
+				l46:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position6, tokenIndex6
+					if !_rules[ruleInvalid]() {
+						goto l4
+					}
+				}
+			l6:
+				if !_rules[ruleSpacing]() {
+					goto l4
+				}
+				add(ruleValue, position5)
+			}
+			memoize(1, position4, tokenIndex4, true)
+			return true
+		l4:
+			memoize(1, position4, tokenIndex4, false)
+			if position >= reach {
+				reach = position + 1
+			}
+			position, tokenIndex = position4, tokenIndex4
+			return false
+		},
+		/* 2 Object <- <('{' Spacing (Member (Comma Member)*)? '}')> */
+		nil,
+		/* 3 Member <- <((String Spacing ':' Spacing Value) / (Invalid Spacing))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{3, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{3, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position49, tokenIndex49 := position, tokenIndex
+			{
+				position50 := position
+				{
+					position51, tokenIndex51 := position, tokenIndex
+					if !_rules[ruleString]() {
+						goto l52
+					}
+					if !_rules[ruleSpacing]() {
+						goto l52
+					}
+					if buffer[position] != rune(':') {
+						goto l52
+					}
+					position++
+					if !_rules[ruleSpacing]() {
+						goto l52
+					}
+					if !_rules[ruleValue]() {
+						goto l52
+					}
+					goto l51
+				l52:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position51, tokenIndex51
+					if !_rules[ruleInvalid]() {
+						goto l49
+					}
+					if !_rules[ruleSpacing]() {
+						goto l49
+					}
+				}
+			l51:
+				add(ruleMember, position50)
+			}
+			memoize(3, position49, tokenIndex49, true)
+			return true
+		l49:
+			memoize(3, position49, tokenIndex49, false)
+			if position >= reach {
+				reach = position + 1
+			}
+			position, tokenIndex = position49, tokenIndex49
+			return false
+		},
+		/* 4 Array <- <('[' Spacing (Value (Comma Value)*)? ']')> */
+		nil,
+		/* 5 Comma <- <(',' Spacing)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{5, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{5, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position54, tokenIndex54 := position, tokenIndex
+			{
+				position55 := position
+				if buffer[position] != rune(',') {
+					goto l54
+				}
+				position++
+				if !_rules[ruleSpacing]() {
+					goto l54
+				}
+				add(ruleComma, position55)
+			}
+			memoize(5, position54, tokenIndex54, true)
+			return true
+		l54:
+			memoize(5, position54, tokenIndex54, false)
+			if position >= reach {
+				reach = position + 1
+			}
+			position, tokenIndex = position54, tokenIndex54
+			return false
+		},
+		/* 6 String <- <('"' <Character*> '"')> */
+		func() bool {
+			memoized, ok := memoization[memoKey{6, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{6, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position56, tokenIndex56 := position, tokenIndex
+			{
+				position57 := position
+				if buffer[position] != rune('"') {
+					goto l56
+				}
+				position++
+				{
+					position58 := position
+				l59:
+					{
+						position60, tokenIndex60 := position, tokenIndex
+						{
+							position61 := position
+							{
+								switch buffer[position] {
+								case '\\':
+									position++
+									{
+										switch buffer[position] {
+										case '/':
+											position++
+										case '\\':
+											position++
+										case 'b':
+											position++
+										case 'f':
+											position++
+										case 'n':
+											position++
+										case 'r':
+											position++
+										case 't':
+											position++
+										case 'u':
+											position++
+											if !_rules[ruleHexDigit]() {
+												goto l60
+											}
+											if !_rules[ruleHexDigit]() {
+												goto l60
+											}
+											if !_rules[ruleHexDigit]() {
+												goto l60
+											}
+											if !_rules[ruleHexDigit]() {
+												goto l60
+											}
+										default:
+											if buffer[position] != rune('"') {
+												goto l60
+											}
+											position++
+										}
+									}
+
+								default:
+									if c := buffer[position]; c == endSymbol || c == rune('"') || c == rune('\\') || (c >= rune('\x00') && c <= rune('\x1f')) {
+										goto l60
+									}
+									position++
+								}
+							}
+
+							add(ruleCharacter, position61)
+						}
+						goto l59
+					l60:
+						if position >= reach {
+							reach = position + 1
+						}
+						position, tokenIndex = position60, tokenIndex60
+					}
+					add(rulePegText, position58)
+				}
+				if buffer[position] != rune('"') {
+					goto l56
+				}
+				position++
+				add(ruleString, position57)
+			}
+			memoize(6, position56, tokenIndex56, true)
+			return true
+		l56:
+			memoize(6, position56, tokenIndex56, false)
+			if position >= reach {
+				reach = position + 1
+			}
+			position, tokenIndex = position56, tokenIndex56
+			return false
+		},
+		/* 7 Character <- <((&('\\') %fail([^"\\\x00-\x1f]) ('\\' ((&('/') '/') | (&('\\') '\\') | (&('b') 'b') | (&('f') 'f') | (&('n') 'n') | (&('r') 'r') | (&('t') 't') | (&('u') ('u' HexDigit HexDigit HexDigit HexDigit)) | (&('"') %fail('/' '\\' 'b' 'f' 'n' 'r' 't' 'u') '"')))) | (&(' ' | '!' | '#' | '$' | '%' | '&' | '\'' | '(' | ')' | '*' | '+' | ',' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | ';' | '<' | '=' | '>' | '?' | '@' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '[' | []-\U0010ffff]) %fail('\\') [^"\\\x00-\x1f]))> */
+		nil,
+		/* 8 HexDigit <- <((&('A' | 'B' | 'C' | 'D' | 'E' | 'F') [A-F]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f') [a-f]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') %fail([A-F] [a-f]) [0-9]))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{8, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{8, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position65, tokenIndex65 := position, tokenIndex
+			{
+				position66 := position
+				{
+					switch buffer[position] {
+					case 'A', 'B', 'C', 'D', 'E', 'F':
+						position++
+					case 'a', 'b', 'c', 'd', 'e', 'f':
+						position++
+					default:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l65
+						}
+						position++
+					}
+				}
+
+				add(ruleHexDigit, position66)
+			}
+			memoize(8, position65, tokenIndex65, true)
+			return true
+		l65:
+			memoize(8, position65, tokenIndex65, false)
+			if position >= reach {
+				reach = position + 1
+			}
+			position, tokenIndex = position65, tokenIndex65
+			return false
+		},
+		/* 9 Number <- <('-'? ('0' / ([1-9] [0-9]*)) ('.' [0-9]+)? (('e' / 'E') ('+' / '-')? [0-9]+)?)> */
+		nil,
+		/* 10 True <- <('t' 'r' 'u' 'e')> */
+		nil,
+		/* 11 False <- <('f' 'a' 'l' 's' 'e')> */
+		nil,
+		/* 12 Null <- <('n' 'u' 'l' 'l')> */
+		nil,
+		/* 13 Invalid <- <(!((&(']') ']') | (&('}') '}') | (&(',') %fail(']' '}') ',')) .)+> */
+		func() bool {
+			memoized, ok := memoization[memoKey{13, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{13, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position72, tokenIndex72 := position, tokenIndex
+			{
+				position73 := position
+				{
+					position76, tokenIndex76 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case ']':
+							position++
+						case '}':
+							position++
+						default:
+							if buffer[position] != rune(',') {
+								goto l76
+							}
+							position++
+						}
+					}
+
+					goto l72
+				l76:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position76, tokenIndex76
+				}
+				if !matchDot() {
+					goto l72
+				}
+			l74:
+				{
+					position75, tokenIndex75 := position, tokenIndex
+					{
+						position78, tokenIndex78 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case ']':
+								position++
+							case '}':
+								position++
+							default:
+								if buffer[position] != rune(',') {
+									goto l78
+								}
+								position++
+							}
+						}
+
+						goto l75
+					l78:
+						if position >= reach {
+							reach = position + 1
+						}
+						position, tokenIndex = position78, tokenIndex78
+					}
+					if !matchDot() {
+						goto l75
+					}
+					goto l74
+				l75:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position75, tokenIndex75
+				}
+				add(ruleInvalid, position73)
+			}
+			memoize(13, position72, tokenIndex72, true)
+			return true
+		l72:
+			memoize(13, position72, tokenIndex72, false)
+			if position >= reach {
+				reach = position + 1
+			}
+			position, tokenIndex = position72, tokenIndex72
+			return false
+		},
+		/* 14 Spacing <- <((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&(' ') %fail('\t' '\n' '\r') ' '))*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{14, position}]
+			if !ok && edited != nil {
+				memoized, ok = reuse(memoKey{14, position})
+			}
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position80, tokenIndex80 := position, tokenIndex
+			{
+				position81 := position
+			l82:
+				{
+					position83, tokenIndex83 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '\t':
+							position++
+						case '\n':
+							position++
+						case '\r':
+							position++
+						default:
+							if buffer[position] != rune(' ') {
+								goto l83
+							}
+							position++
+						}
+					}
+
+					goto l82
+				l83:
+					if position >= reach {
+						reach = position + 1
+					}
+					position, tokenIndex = position83, tokenIndex83
+				}
+				add(ruleSpacing, position81)
+			}
+			memoize(14, position80, tokenIndex80, true)
+			return true
+		},
+		/* 15 EndOfFile <- <!.> */
+		nil,
+		nil,
+	}
+	if p.maxDepth > 0 {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule := rule
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	documents := []string{
+		`null`,
+		` true `,
+		`[]`,
+		`{}`,
+		`-12.5e3`,
+		`"a \"quoted\" é \/ string"`,
+		`{"a": [1, 2, {"b": null}], "c": "d", "e": false}`,
+		"[\n\t\"\",\n\t0,\n\t{\"\": []}\n]",
+	}
+	for _, document := range documents {
+		j := &JSON{Buffer: document}
+		j.Init()
+		if err := j.Parse(); err != nil {
+			t.Fatalf("%s: %v", document, err)
+		}
+		value, errors := j.Value()
+		if len(errors) > 0 {
+			t.Fatalf("%s: %v", document, errors)
+		}
+		var expected any
+		if err := json.Unmarshal([]byte(document), &expected); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("%s: got %#v, expected %#v", document, value, expected)
+		}
+	}
+}
+
+func TestJSONRecovery(t *testing.T) {
+	j := &JSON{Buffer: `[1, tru, {"a": x, 3: 4, "b": 2}, 3]`}
+	j.Init()
+	if err := j.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	value, errors := j.Value()
+	expected := []any{1.0, nil, map[string]any{"a": nil, "b": 2.0}, 3.0}
+	if !reflect.DeepEqual(value, expected) {
+		t.Errorf("got %#v, expected %#v", value, expected)
+	}
+	if len(errors) != 3 {
+		t.Errorf("got %v, expected 3 errors", errors)
+	}
+}
+
+func TestJSONEdit(t *testing.T) {
+	edits := []struct {
+		offset, deleted int
+		inserted        string
+	}{
+		{10, 1, "20"},
+		{0, 0, " "},
+		{21, 4, "true"},
+		{9, 0, ", 3"},
+		{1, 0, "x"},
+		{1, 1, ""},
+		{40, 0, "\n"},
+	}
+	j := &JSON{Buffer: `{"a": [1, 2, {"b": null}], "c": "d"}`}
+	j.Init()
+	if err := j.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	for _, edit := range edits {
+		runes := []rune(j.Buffer)
+		expected := string(runes[:edit.offset]) + edit.inserted + string(runes[edit.offset+edit.deleted:])
+		err := j.Edit(edit.offset, edit.deleted, edit.inserted)
+		if j.Buffer != expected {
+			t.Fatalf("got %q, expected %q", j.Buffer, expected)
+		}
+		parsed := &JSON{Buffer: expected}
+		parsed.Init()
+		if expectedErr := parsed.Parse(); fmt.Sprint(err) != fmt.Sprint(expectedErr) {
+			t.Fatalf("%s: got %v, expected %v", expected, err, expectedErr)
+		}
+		if err == nil && !reflect.DeepEqual(j.Tokens(), parsed.Tokens()) {
+			t.Errorf("%s: got %v, expected %v", expected, j.Tokens(), parsed.Tokens())
+		}
+	}
+	if err := j.Edit(100, 0, ""); err == nil {
+		t.Error("the edit outside the buffer was applied")
+	}
+}
+
+func BenchmarkJSON(b *testing.B) {
+	document := "[" + strings.Repeat(`{"name": "peg", "stars": 1000, "tags": ["go", "parser"], "fork": false}, `, 1000) + "null]"
+	j := &JSON{Buffer: document}
+	j.Init()
+	b.SetBytes(int64(len(document)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		j.Reset()
+		if err := j.Parse(); err != nil {
+			b.Fatal(err)
+		}
+		j.Value()
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run github.com/pointlander/peg -switch -inline long.peg
+
+// Package long parses long strings with the parser generated from long.peg,
+// to test the generated parsers on large inputs.
+package long
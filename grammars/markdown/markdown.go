@@ -0,0 +1,347 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run github.com/pointlander/peg -switch -inline markdown.peg
+
+// Package markdown converts Markdown to HTML with the parser generated from
+// markdown.peg.
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// column returns the column of position in its line, with tab stops every
+// four columns.
+func column(buffer []rune, position int) int {
+	start := position
+	for start > 0 && buffer[start-1] != '\n' && buffer[start-1] != '\r' {
+		start--
+	}
+	column := 0
+	for _, c := range buffer[start:position] {
+		if c == '\t' {
+			column += 4 - column%4
+		} else {
+			column++
+		}
+	}
+	return column
+}
+
+// itemIndent returns the indentation of the lines continuing a list item whose
+// first line starts at position, one column after the marker of an item that
+// starts with an empty line.
+func itemIndent(buffer []rune, position int) int {
+	if position == len(buffer) || buffer[position] == '\n' || buffer[position] == '\r' {
+		return column(buffer, position) + 1
+	}
+	return column(buffer, position)
+}
+
+// indented reports whether the line starting at position is indented by at
+// least indent columns.
+func indented(buffer []rune, position, indent int) bool {
+	column := 0
+	for ; position < len(buffer) && column < indent; position++ {
+		switch buffer[position] {
+		case ' ':
+			column++
+		case '\t':
+			column += 4 - column%4
+		default:
+			return false
+		}
+	}
+	return column >= indent
+}
+
+// spaceBefore reports whether the rune before position is a space or the start
+// of the input.
+func spaceBefore(buffer []rune, position int) bool {
+	return position == 0 || unicode.IsSpace(buffer[position-1])
+}
+
+// wordBefore reports whether the rune before position is a letter or a digit.
+func wordBefore(buffer []rune, position int) bool {
+	return position > 0 && (unicode.IsLetter(buffer[position-1]) || unicode.IsDigit(buffer[position-1]))
+}
+
+// dedent removes indent columns of white space from the start of line.
+func dedent(line string, indent int) string {
+	column := 0
+	for i, c := range line {
+		if column >= indent {
+			return line[i:]
+		}
+		switch c {
+		case ' ':
+			column++
+		case '\t':
+			column += 4 - column%4
+		default:
+			return line[i:]
+		}
+	}
+	return ""
+}
+
+// HTML renders the parsed document.
+func (m *Markdown) HTML() string {
+	out := &strings.Builder{}
+	m.blocks(out, m.AST().up, false)
+	return out.String()
+}
+
+func (m *Markdown) text(node *node32) string {
+	return string(m.buffer[node.begin:node.end])
+}
+
+// line returns the text of a node matching a line, without the line ending.
+func (m *Markdown) line(node *node32) string {
+	return strings.TrimRight(m.text(node), "\r\n")
+}
+
+// find returns the first node of rule in the children of node.
+func find(node *node32, rule pegRule) *node32 {
+	for child := node.up; child != nil; child = child.next {
+		if child.pegRule == rule {
+			return child
+		}
+	}
+	return nil
+}
+
+// island renders lines as a document of its own, such as the contents of a
+// block quote or a list item, and returns the rule of its last block.
+func island(out *strings.Builder, lines []string, tight bool) pegRule {
+	m := &Markdown{Buffer: strings.Join(lines, "\n")}
+	m.Init()
+	if err := m.Parse(); err != nil {
+		fmt.Fprintf(out, "<p>%s</p>\n", html.EscapeString(m.Buffer))
+		return ruleParagraph
+	}
+	return m.blocks(out, m.AST().up, tight)
+}
+
+// blocks renders the blocks in node and its siblings, and returns the rule of
+// the last one. In a tight list the paragraphs are rendered without p tags.
+func (m *Markdown) blocks(out *strings.Builder, node *node32, tight bool) (last pegRule) {
+	for ; node != nil; node = node.next {
+		if node.pegRule != ruleBlankLine && node.pegRule != ruleEOF {
+			last = node.pegRule
+		}
+		switch node.pegRule {
+		case ruleBlock:
+			last = m.blocks(out, node.up, tight)
+		case ruleHeading:
+			level := len(m.text(find(node, ruleHeadingLevel)))
+			fmt.Fprintf(out, "<h%d>", level)
+			m.inlines(out, node.up)
+			fmt.Fprintf(out, "</h%d>\n", level)
+		case ruleThematicBreak:
+			out.WriteString("<hr />\n")
+		case ruleFencedCode:
+			out.WriteString("<pre><code")
+			if info := find(node, ruleInfo); info != nil && info.end > info.begin {
+				fmt.Fprintf(out, " class=\"language-%s\"", html.EscapeString(m.text(info)))
+			}
+			out.WriteString(">")
+			for line := node.up; line != nil; line = line.next {
+				if line.pegRule == ruleCodeLine {
+					out.WriteString(html.EscapeString(m.line(line)) + "\n")
+				}
+			}
+			out.WriteString("</code></pre>\n")
+		case ruleIndentedCode:
+			out.WriteString("<pre><code>")
+			for line := node.up; line != nil; line = line.next {
+				out.WriteString(html.EscapeString(dedent(m.line(line), 4)) + "\n")
+			}
+			out.WriteString("</code></pre>\n")
+		case ruleParagraph:
+			if underline := find(node, ruleSetextUnderline); underline != nil {
+				level := 1
+				if strings.Contains(m.text(underline), "-") {
+					level = 2
+				}
+				fmt.Fprintf(out, "<h%d>", level)
+				m.inlines(out, node.up)
+				fmt.Fprintf(out, "</h%d>\n", level)
+				break
+			}
+			if !tight {
+				out.WriteString("<p>")
+			}
+			m.inlines(out, node.up)
+			if !tight {
+				out.WriteString("</p>")
+			}
+			out.WriteString("\n")
+		case ruleBlockQuote:
+			var lines []string
+			for line := node.up; line != nil; line = line.next {
+				if content := find(line, ruleQuoteContent); content != nil {
+					lines = append(lines, m.text(content))
+				} else {
+					lines = append(lines, m.line(line))
+				}
+			}
+			out.WriteString("<blockquote>\n")
+			island(out, lines, false)
+			out.WriteString("</blockquote>\n")
+		case ruleList:
+			m.list(out, node.up)
+		}
+	}
+	return last
+}
+
+// list renders a bullet or an ordered list, which is tight unless a blank line
+// separates its items or the lines of an item.
+func (m *Markdown) list(out *strings.Builder, node *node32) {
+	tag := "ul"
+	if node.pegRule == ruleOrderedList {
+		tag = "ol"
+	}
+	tight := true
+	var walk func(node *node32)
+	walk = func(node *node32) {
+		for ; node != nil; node = node.next {
+			if node.pegRule == ruleBlankLine {
+				tight = false
+			}
+			walk(node.up)
+		}
+	}
+	walk(node.up)
+	out.WriteString("<" + tag)
+	if marker := find(node.up, ruleOrderedMarker); marker != nil {
+		start := strings.TrimLeft(strings.TrimRight(m.text(marker), ".)"), "0")
+		if start != "1" {
+			if start == "" {
+				start = "0"
+			}
+			fmt.Fprintf(out, " start=\"%s\"", start)
+		}
+	}
+	out.WriteString(">\n")
+	for item := node.up; item != nil; item = item.next {
+		if item.pegRule != ruleBulletItem && item.pegRule != ruleOrderedItem {
+			continue
+		}
+		rest := find(item, ruleItemRest)
+		first := find(rest, ruleItemFirstLine)
+		indent := itemIndent(m.buffer, int(first.begin))
+		lines := []string{m.line(first)}
+		var collect func(node *node32)
+		collect = func(node *node32) {
+			for ; node != nil; node = node.next {
+				switch node.pegRule {
+				case ruleBlankLine:
+					lines = append(lines, "")
+				case ruleItemLine:
+					lines = append(lines, dedent(m.line(node), indent))
+				case ruleLazyItemLine:
+					lines = append(lines, m.line(node))
+				default:
+					collect(node.up)
+				}
+			}
+		}
+		collect(first.next)
+		out.WriteString("<li>")
+		if !tight {
+			out.WriteString("\n")
+		}
+		content := &strings.Builder{}
+		if island(content, lines, tight) == ruleParagraph && tight {
+			out.WriteString(strings.TrimSuffix(content.String(), "\n"))
+		} else {
+			out.WriteString(content.String())
+		}
+		out.WriteString("</li>\n")
+	}
+	out.WriteString("</" + tag + ">\n")
+}
+
+// inlines renders the inlines in node and its siblings.
+func (m *Markdown) inlines(out *strings.Builder, node *node32) {
+	for ; node != nil; node = node.next {
+		switch node.pegRule {
+		case ruleText, ruleSpace, ruleSymbol:
+			out.WriteString(html.EscapeString(m.text(node)))
+		case ruleEscaped:
+			out.WriteString(html.EscapeString(m.text(node)[1:]))
+		case ruleSoftBreak:
+			out.WriteString("\n")
+		case ruleHardBreak:
+			out.WriteString("<br />\n")
+		case ruleCodeSpan:
+			text := m.text(node)
+			ticks := len(text) - len(strings.TrimLeft(text, "`"))
+			code := strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text[ticks : len(text)-ticks])
+			if len(code) > 1 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+				code = code[1 : len(code)-1]
+			}
+			out.WriteString("<code>" + html.EscapeString(code) + "</code>")
+		case ruleStrong:
+			out.WriteString("<strong>")
+			m.inlines(out, node.up)
+			out.WriteString("</strong>")
+		case ruleEmphasis:
+			out.WriteString("<em>")
+			m.inlines(out, node.up)
+			out.WriteString("</em>")
+		case ruleLink:
+			fmt.Fprintf(out, "<a href=\"%s\"%s>", m.destination(node), m.title(node))
+			m.inlines(out, find(node, ruleLinkText).up)
+			out.WriteString("</a>")
+		case ruleImage:
+			alt := &strings.Builder{}
+			m.inlines(alt, find(node, ruleLinkText).up)
+			fmt.Fprintf(out, "<img src=\"%s\" alt=\"%s\"%s />", m.destination(node), html.EscapeString(stripTags(alt.String())), m.title(node))
+		case ruleAutoLink:
+			uri := html.EscapeString(m.text(find(node, ruleURI)))
+			fmt.Fprintf(out, "<a href=\"%s\">%s</a>", uri, uri)
+		case ruleInline, ruleInlineSpan:
+			m.inlines(out, node.up)
+		}
+	}
+}
+
+func (m *Markdown) destination(node *node32) string {
+	destination := m.text(find(node, ruleLinkDestination))
+	return html.EscapeString(strings.TrimSuffix(strings.TrimPrefix(destination, "<"), ">"))
+}
+
+func (m *Markdown) title(node *node32) string {
+	title := find(node, ruleLinkTitle)
+	if title == nil {
+		return ""
+	}
+	text := m.text(title)
+	return fmt.Sprintf(" title=\"%s\"", html.EscapeString(text[1:len(text)-1]))
+}
+
+// stripTags returns the text of rendered inlines, for the alternative text of
+// images.
+func stripTags(s string) string {
+	text := &strings.Builder{}
+	tag := false
+	for _, c := range s {
+		switch {
+		case c == '<':
+			tag = true
+		case c == '>':
+			tag = false
+		case !tag:
+			text.WriteRune(c)
+		}
+	}
+	return html.UnescapeString(text.String())
+}
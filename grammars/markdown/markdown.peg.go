@@ -0,0 +1,4650 @@
+// Code generated by peg -switch -inline markdown.peg. DO NOT EDIT.
+
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// A grammar of a subset of CommonMark (https://spec.commonmark.org): ATX and
+// setext headings, thematic breaks, fenced and indented code, block quotes,
+// bullet and ordered lists, paragraphs, and inline code spans, emphasis,
+// links, images, autolinks, hard breaks and backslash escapes.
+//
+// Text markup is parsed differently from programming languages:
+//
+// - The contents of block quotes and list items are islands: the grammar only
+//   matches their lines, and HTML parses the lines, stripped of the quote
+//   markers and the indentation, as documents of their own.
+//
+// - A list item continues on the lines indented at least as much as its first
+//   line, which a state change records after the list marker and a predicate
+//   checks at the start of every line.
+//
+// - Emphasis closes only after a non-space, and an underscore opens it only
+//   after a non-word character, which predicates check by looking behind the
+//   current position.
+//
+// - Every input is a document: markup that isn't closed, such as an asterisk
+//   without its pair, is matched as Symbol and rendered as text.
+
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	ruleDocument
+	ruleBlock
+	ruleHeading
+	ruleHeadingLevel
+	ruleHeadingEnd
+	ruleThematicBreak
+	ruleFencedCode
+	ruleBacktickFence
+	ruleTildeFence
+	ruleInfo
+	ruleCodeLine
+	ruleIndentedCode
+	ruleIndentedLine
+	ruleParagraph
+	ruleSetextUnderline
+	ruleBlockQuote
+	ruleQuoteLine
+	ruleQuoteContent
+	ruleLazyLine
+	ruleList
+	ruleBulletList
+	ruleOrderedList
+	ruleBulletItem
+	ruleOrderedItem
+	ruleBulletMarker
+	ruleOrderedMarker
+	ruleItemStart
+	ruleItemRest
+	ruleItemFirstLine
+	ruleItemContinuation
+	ruleItemLine
+	ruleLazyItemLine
+	ruleInterrupt
+	ruleInline
+	ruleInlineSpan
+	ruleText
+	ruleSpace
+	ruleHardBreak
+	ruleSoftBreak
+	ruleLineContinues
+	ruleCodeSpan
+	ruleStrong
+	ruleEmphasis
+	ruleWhitespace
+	ruleLink
+	ruleImage
+	ruleLinkText
+	ruleLinkDestination
+	ruleLinkTitle
+	ruleAutoLink
+	ruleURI
+	ruleEscaped
+	ruleSymbol
+	ruleBlankLine
+	ruleNonIndentSpace
+	ruleSp
+	ruleNL
+	ruleEOL
+	ruleEOF
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"Document",
+	"Block",
+	"Heading",
+	"HeadingLevel",
+	"HeadingEnd",
+	"ThematicBreak",
+	"FencedCode",
+	"BacktickFence",
+	"TildeFence",
+	"Info",
+	"CodeLine",
+	"IndentedCode",
+	"IndentedLine",
+	"Paragraph",
+	"SetextUnderline",
+	"BlockQuote",
+	"QuoteLine",
+	"QuoteContent",
+	"LazyLine",
+	"List",
+	"BulletList",
+	"OrderedList",
+	"BulletItem",
+	"OrderedItem",
+	"BulletMarker",
+	"OrderedMarker",
+	"ItemStart",
+	"ItemRest",
+	"ItemFirstLine",
+	"ItemContinuation",
+	"ItemLine",
+	"LazyItemLine",
+	"Interrupt",
+	"Inline",
+	"InlineSpan",
+	"Text",
+	"Space",
+	"HardBreak",
+	"SoftBreak",
+	"LineContinues",
+	"CodeSpan",
+	"Strong",
+	"Emphasis",
+	"Whitespace",
+	"Link",
+	"Image",
+	"LinkText",
+	"LinkDestination",
+	"LinkTitle",
+	"AutoLink",
+	"URI",
+	"Escaped",
+	"Symbol",
+	"BlankLine",
+	"NonIndentSpace",
+	"Sp",
+	"NL",
+	"EOL",
+	"EOF",
+}
+
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource{
+	{Name: "Unknown"},
+	{"Document", "markdown.peg", 33, 33, false, false},
+	{"Block", "markdown.peg", 35, 42, false, true},
+	{"Heading", "markdown.peg", 49, 49, false, false},
+	{"HeadingLevel", "markdown.peg", 50, 50, true, true},
+	{"HeadingEnd", "markdown.peg", 51, 51, false, false},
+	{"ThematicBreak", "markdown.peg", 53, 59, false, false},
+	{"FencedCode", "markdown.peg", 61, 63, false, true},
+	{"BacktickFence", "markdown.peg", 64, 64, false, false},
+	{"TildeFence", "markdown.peg", 65, 65, false, false},
+	{"Info", "markdown.peg", 66, 66, true, false},
+	{"CodeLine", "markdown.peg", 67, 67, false, false},
+	{"IndentedCode", "markdown.peg", 69, 69, false, true},
+	{"IndentedLine", "markdown.peg", 70, 70, false, false},
+	{"Paragraph", "markdown.peg", 72, 72, false, true},
+	{"SetextUnderline", "markdown.peg", 73, 73, false, false},
+	{"BlockQuote", "markdown.peg", 80, 80, false, true},
+	{"QuoteLine", "markdown.peg", 81, 81, false, false},
+	{"QuoteContent", "markdown.peg", 82, 82, false, true},
+	{"LazyLine", "markdown.peg", 83, 83, false, true},
+	{"List", "markdown.peg", 85, 85, false, true},
+	{"BulletList", "markdown.peg", 86, 86, false, true},
+	{"OrderedList", "markdown.peg", 87, 87, false, true},
+	{"BulletItem", "markdown.peg", 88, 88, false, false},
+	{"OrderedItem", "markdown.peg", 89, 89, false, false},
+	{"BulletMarker", "markdown.peg", 90, 90, true, false},
+	{"OrderedMarker", "markdown.peg", 91, 91, true, false},
+	{"ItemStart", "markdown.peg", 92, 92, false, true},
+	{"ItemRest", "markdown.peg", 94, 97, false, false},
+	{"ItemFirstLine", "markdown.peg", 98, 98, false, true},
+	{"ItemContinuation", "markdown.peg", 99, 99, false, true},
+	{"ItemLine", "markdown.peg", 100, 100, false, true},
+	{"LazyItemLine", "markdown.peg", 101, 101, false, true},
+	{"Interrupt", "markdown.peg", 104, 108, false, false},
+	{"Inline", "markdown.peg", 115, 115, false, false},
+	{"InlineSpan", "markdown.peg", 117, 127, false, false},
+	{"Text", "markdown.peg", 129, 129, true, true},
+	{"Space", "markdown.peg", 130, 130, false, true},
+	{"HardBreak", "markdown.peg", 132, 132, false, true},
+	{"SoftBreak", "markdown.peg", 133, 133, false, true},
+	{"LineContinues", "markdown.peg", 134, 134, false, false},
+	{"CodeSpan", "markdown.peg", 136, 138, false, true},
+	{"Strong", "markdown.peg", 140, 143, false, false},
+	{"Emphasis", "markdown.peg", 145, 148, false, true},
+	{"Whitespace", "markdown.peg", 150, 150, false, false},
+	{"Link", "markdown.peg", 152, 152, false, true},
+	{"Image", "markdown.peg", 153, 153, false, true},
+	{"LinkText", "markdown.peg", 154, 154, false, false},
+	{"LinkDestination", "markdown.peg", 155, 155, true, false},
+	{"LinkTitle", "markdown.peg", 156, 156, true, false},
+	{"AutoLink", "markdown.peg", 158, 158, false, true},
+	{"URI", "markdown.peg", 159, 159, true, true},
+	{"Escaped", "markdown.peg", 161, 161, true, true},
+	{"Symbol", "markdown.peg", 163, 163, true, true},
+	{"BlankLine", "markdown.peg", 170, 170, false, false},
+	{"NonIndentSpace", "markdown.peg", 171, 171, true, false},
+	{"Sp", "markdown.peg", 172, 172, true, false},
+	{"NL", "markdown.peg", 173, 173, true, false},
+	{"EOL", "markdown.peg", 174, 174, false, false},
+	{"EOF", "markdown.peg", 175, 175, true, false},
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+const endSymbol rune = 1114112
+
+type Markdown struct {
+	indent int
+
+	Buffer         string
+	buffer         []rune
+	rules          [60]func() bool
+	parse          func(rule ...int) error
+	find           func(rule pegRule) ([]token32, error)
+	options        []func(*Markdown) error
+	reset          func()
+	Pretty         bool
+	farthest       uint32
+	matched        uint32
+	expected       []string
+	maxDepth       int
+	trackRules     bool
+	farthestRules  []string
+	farthestHint   string
+	filename       string
+	offsets        []int
+	crlf           bool
+	tabWidth       int
+	byteColumns    bool
+	crlfs          []uint32
+	partial        bool
+	partialTokens  []token32
+	disableMemoize bool
+	tokens32
+}
+
+func (p *Markdown) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *Markdown) Reset() {
+	p.reset()
+}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *Markdown) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *Markdown) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *Markdown) ByteOffset(offset int) int {
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer)+1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *Markdown) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *Markdown) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *Markdown) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *Markdown) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
+type textPosition struct {
+	line, symbol, column int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		/* a newline is at the end of its line */
+		symbol, column = visual+1, bytes+1
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					break
+				}
+			}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line+1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual%tabWidth
+			bytes++
+		} else {
+			visual++
+			bytes += utf8.RuneLen(c)
+		}
+	}
+
+	return translations
+}
+
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p                            *Markdown
+	max                          token32
+	expected                     []string
+	rules                        []string
+	hint                         string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *Markdown) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line:     position.line,
+		Symbol:   position.symbol,
+		Column:   position.column,
+		Offset:   p.ByteOffset(int(p.farthest)),
+		p:        p,
+		max:      max,
+		expected: p.expectations(),
+		rules:    append([]string(nil), p.farthestRules...),
+		hint:     p.farthestHint,
+	}
+}
+
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			e.p.describe(translations[begin]),
+			e.p.describe(translations[end]),
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected)-1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
+
+	return err
+}
+
+type depthError struct {
+	p        *Markdown
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+
+func (p *Markdown) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *Markdown) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *Markdown) SprintSyntaxTree() string {
+	var b bytes.Buffer
+	p.WriteSyntaxTree(&b)
+	return b.String()
+}
+
+func Pretty(pretty bool) func(*Markdown) error {
+	return func(p *Markdown) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*Markdown) error {
+	return func(p *Markdown) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*Markdown) error {
+	return func(p *Markdown) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*Markdown) error {
+	return func(p *Markdown) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+func MaxDepth(depth int) func(*Markdown) error {
+	return func(p *Markdown) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+func Size(size int) func(*Markdown) error {
+	return func(p *Markdown) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*Markdown) error {
+	return func(p *Markdown) error {
+		p.disableMemoize = true
+		return nil
+	}
+}
+
+type memo struct {
+	Matched bool
+	Partial []token32
+}
+
+type memoKey struct {
+	Rule     uint32
+	Position uint32
+}
+
+func (p *Markdown) Init(options ...func(*Markdown) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		depth                int
+		buffer               []rune
+		stack                []string
+		memoization          map[memoKey]memo
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.options = options
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+		p.offsets = nil
+		memoization = make(map[memoKey]memo)
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make([]rune, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i+1 < len(p.buffer) && p.buffer[i+1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) (err error) {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		matches := p.rules[r]()
+		p.farthest = max.end
+		p.tokens32 = tree
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+		}
+		if matches {
+			p.matched = p.original(position)
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return p.syntaxError(max)
+	}
+
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) < len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position)})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+		if p.disableMemoize {
+			return
+		}
+		key := memoKey{rule, begin}
+		if !matched {
+			memoization[key] = memo{Matched: false}
+		} else {
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+		}
+	}
+	_ = memoize
+
+	memoizedResult := func(m memo) bool {
+		if !m.Matched {
+			return false
+		}
+		tree.tree = append(tree.tree[:tokenIndex], m.Partial...)
+		tokenIndex += uint32(len(m.Partial))
+		position = m.Partial[len(m.Partial)-1].end
+		if tree.tree[tokenIndex-1].begin != position && position > max.end {
+			max = tree.tree[tokenIndex-1]
+		}
+		return true
+	}
+	_ = memoizedResult
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 Document <- <((BlankLine / Block)* EOF)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{0, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					{
+						position4, tokenIndex4 := position, tokenIndex
+						if !_rules[ruleBlankLine]() {
+							goto l5
+						}
+						goto l4
+					l5:
+						position, tokenIndex = position4, tokenIndex4
+						{
+							position6 := position
+							{
+								position7, tokenIndex7 := position, tokenIndex
+								{
+									position9 := position
+									if !_rules[ruleNonIndentSpace]() {
+										goto l8
+									}
+									{
+										position10, tokenIndex10 := position, tokenIndex
+										if buffer[position] != rune('`') {
+											goto l11
+										}
+										position++
+										if buffer[position] != rune('`') {
+											goto l11
+										}
+										position++
+										if buffer[position] != rune('`') {
+											goto l11
+										}
+										position++
+									l12:
+										{
+											position13, tokenIndex13 := position, tokenIndex
+											if buffer[position] != rune('`') {
+												goto l13
+											}
+											position++
+											goto l12
+										l13:
+											position, tokenIndex = position13, tokenIndex13
+										}
+										if !_rules[ruleSp]() {
+											goto l11
+										}
+										if !_rules[ruleInfo]() {
+											goto l11
+										}
+										if !_rules[ruleSp]() {
+											goto l11
+										}
+										if !_rules[ruleEOL]() {
+											goto l11
+										}
+									l14:
+										{
+											position15, tokenIndex15 := position, tokenIndex
+											{
+												position16, tokenIndex16 := position, tokenIndex
+												if !_rules[ruleBacktickFence]() {
+													goto l16
+												}
+												goto l15
+											l16:
+												position, tokenIndex = position16, tokenIndex16
+											}
+											if !_rules[ruleCodeLine]() {
+												goto l15
+											}
+											goto l14
+										l15:
+											position, tokenIndex = position15, tokenIndex15
+										}
+										{
+											position17, tokenIndex17 := position, tokenIndex
+											if !_rules[ruleBacktickFence]() {
+												goto l18
+											}
+											goto l17
+										l18:
+											position, tokenIndex = position17, tokenIndex17
+											if !_rules[ruleEOF]() {
+												goto l11
+											}
+										}
+									l17:
+										goto l10
+									l11:
+										position, tokenIndex = position10, tokenIndex10
+										if buffer[position] != rune('~') {
+											goto l8
+										}
+										position++
+										if buffer[position] != rune('~') {
+											goto l8
+										}
+										position++
+										if buffer[position] != rune('~') {
+											goto l8
+										}
+										position++
+									l19:
+										{
+											position20, tokenIndex20 := position, tokenIndex
+											if buffer[position] != rune('~') {
+												goto l20
+											}
+											position++
+											goto l19
+										l20:
+											position, tokenIndex = position20, tokenIndex20
+										}
+										if !_rules[ruleSp]() {
+											goto l8
+										}
+										if !_rules[ruleInfo]() {
+											goto l8
+										}
+										if !_rules[ruleSp]() {
+											goto l8
+										}
+										if !_rules[ruleEOL]() {
+											goto l8
+										}
+									l21:
+										{
+											position22, tokenIndex22 := position, tokenIndex
+											{
+												position23, tokenIndex23 := position, tokenIndex
+												if !_rules[ruleTildeFence]() {
+													goto l23
+												}
+												goto l22
+											l23:
+												position, tokenIndex = position23, tokenIndex23
+											}
+											if !_rules[ruleCodeLine]() {
+												goto l22
+											}
+											goto l21
+										l22:
+											position, tokenIndex = position22, tokenIndex22
+										}
+										{
+											position24, tokenIndex24 := position, tokenIndex
+											if !_rules[ruleTildeFence]() {
+												goto l25
+											}
+											goto l24
+										l25:
+											position, tokenIndex = position24, tokenIndex24
+											if !_rules[ruleEOF]() {
+												goto l8
+											}
+										}
+									l24:
+									}
+								l10:
+									add(ruleFencedCode, position9)
+								}
+								goto l7
+							l8:
+								position, tokenIndex = position7, tokenIndex7
+								{
+									position27 := position
+									if !_rules[ruleIndentedLine]() {
+										goto l26
+									}
+								l28:
+									{
+										position29, tokenIndex29 := position, tokenIndex
+									l30:
+										{
+											position31, tokenIndex31 := position, tokenIndex
+											if !_rules[ruleBlankLine]() {
+												goto l31
+											}
+											goto l30
+										l31:
+											position, tokenIndex = position31, tokenIndex31
+										}
+										if !_rules[ruleIndentedLine]() {
+											goto l29
+										}
+										goto l28
+									l29:
+										position, tokenIndex = position29, tokenIndex29
+									}
+									add(ruleIndentedCode, position27)
+								}
+								goto l7
+							l26:
+								position, tokenIndex = position7, tokenIndex7
+								if !_rules[ruleHeading]() {
+									goto l32
+								}
+								goto l7
+							l32:
+								position, tokenIndex = position7, tokenIndex7
+								if !_rules[ruleThematicBreak]() {
+									goto l33
+								}
+								goto l7
+							l33:
+								position, tokenIndex = position7, tokenIndex7
+								{
+									position35 := position
+									if !_rules[ruleQuoteLine]() {
+										goto l34
+									}
+								l36:
+									{
+										position37, tokenIndex37 := position, tokenIndex
+										{
+											position38, tokenIndex38 := position, tokenIndex
+											if !_rules[ruleQuoteLine]() {
+												goto l39
+											}
+											goto l38
+										l39:
+											position, tokenIndex = position38, tokenIndex38
+											{
+												position40 := position
+												{
+													position41, tokenIndex41 := position, tokenIndex
+													if !_rules[ruleBlankLine]() {
+														goto l41
+													}
+													goto l37
+												l41:
+													position, tokenIndex = position41, tokenIndex41
+												}
+												{
+													position42, tokenIndex42 := position, tokenIndex
+													if !_rules[ruleInterrupt]() {
+														goto l42
+													}
+													goto l37
+												l42:
+													position, tokenIndex = position42, tokenIndex42
+												}
+												{
+													position45, tokenIndex45 := position, tokenIndex
+													if !_rules[ruleNL]() {
+														goto l45
+													}
+													goto l37
+												l45:
+													position, tokenIndex = position45, tokenIndex45
+												}
+												if !matchDot() {
+													goto l37
+												}
+											l43:
+												{
+													position44, tokenIndex44 := position, tokenIndex
+													{
+														position46, tokenIndex46 := position, tokenIndex
+														if !_rules[ruleNL]() {
+															goto l46
+														}
+														goto l44
+													l46:
+														position, tokenIndex = position46, tokenIndex46
+													}
+													if !matchDot() {
+														goto l44
+													}
+													goto l43
+												l44:
+													position, tokenIndex = position44, tokenIndex44
+												}
+												if !_rules[ruleEOL]() {
+													goto l37
+												}
+												add(ruleLazyLine, position40)
+											}
+										}
+									l38:
+										goto l36
+									l37:
+										position, tokenIndex = position37, tokenIndex37
+									}
+									add(ruleBlockQuote, position35)
+								}
+								goto l7
+							l34:
+								position, tokenIndex = position7, tokenIndex7
+								{
+									position48 := position
+									{
+										position49, tokenIndex49 := position, tokenIndex
+										{
+											position51 := position
+											if !_rules[ruleBulletItem]() {
+												goto l50
+											}
+										l52:
+											{
+												position53, tokenIndex53 := position, tokenIndex
+											l54:
+												{
+													position55, tokenIndex55 := position, tokenIndex
+													if !_rules[ruleBlankLine]() {
+														goto l55
+													}
+													goto l54
+												l55:
+													position, tokenIndex = position55, tokenIndex55
+												}
+												if !_rules[ruleBulletItem]() {
+													goto l53
+												}
+												goto l52
+											l53:
+												position, tokenIndex = position53, tokenIndex53
+											}
+											add(ruleBulletList, position51)
+										}
+										goto l49
+									l50:
+										position, tokenIndex = position49, tokenIndex49
+										{
+											position56 := position
+											if !_rules[ruleOrderedItem]() {
+												goto l47
+											}
+										l57:
+											{
+												position58, tokenIndex58 := position, tokenIndex
+											l59:
+												{
+													position60, tokenIndex60 := position, tokenIndex
+													if !_rules[ruleBlankLine]() {
+														goto l60
+													}
+													goto l59
+												l60:
+													position, tokenIndex = position60, tokenIndex60
+												}
+												if !_rules[ruleOrderedItem]() {
+													goto l58
+												}
+												goto l57
+											l58:
+												position, tokenIndex = position58, tokenIndex58
+											}
+											add(ruleOrderedList, position56)
+										}
+									}
+								l49:
+									add(ruleList, position48)
+								}
+								goto l7
+							l47:
+								position, tokenIndex = position7, tokenIndex7
+								{
+									position61 := position
+									if !_rules[ruleNonIndentSpace]() {
+										goto l3
+									}
+									if !_rules[ruleInline]() {
+										goto l3
+									}
+								l62:
+									{
+										position63, tokenIndex63 := position, tokenIndex
+										if !_rules[ruleInline]() {
+											goto l63
+										}
+										goto l62
+									l63:
+										position, tokenIndex = position63, tokenIndex63
+									}
+									if !_rules[ruleSp]() {
+										goto l3
+									}
+									{
+										position64, tokenIndex64 := position, tokenIndex
+										if !_rules[ruleNL]() {
+											goto l65
+										}
+										if !_rules[ruleSetextUnderline]() {
+											goto l65
+										}
+										goto l64
+									l65:
+										position, tokenIndex = position64, tokenIndex64
+										if !_rules[ruleEOL]() {
+											goto l3
+										}
+									}
+								l64:
+									add(ruleParagraph, position61)
+								}
+							}
+						l7:
+							add(ruleBlock, position6)
+						}
+					}
+				l4:
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				if !_rules[ruleEOF]() {
+					goto l0
+				}
+				add(ruleDocument, position1)
+			}
+			memoize(0, position0, tokenIndex0, true)
+			return true
+		l0:
+			memoize(0, position0, tokenIndex0, false)
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Block <- <(FencedCode / IndentedCode / Heading / ThematicBreak / BlockQuote / List / Paragraph)> */
+		nil,
+		/* 2 Heading <- <(NonIndentSpace HeadingLevel ((' ' / '\t')+ (!HeadingEnd InlineSpan)+)? HeadingEnd)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{2, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position67, tokenIndex67 := position, tokenIndex
+			{
+				position68 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l67
+				}
+				{
+					position69 := position
+					if buffer[position] != rune('#') {
+						goto l67
+					}
+					position++
+					{
+						position70, tokenIndex70 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l70
+						}
+						position++
+						goto l71
+					l70:
+						position, tokenIndex = position70, tokenIndex70
+					}
+				l71:
+					{
+						position72, tokenIndex72 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l72
+						}
+						position++
+						goto l73
+					l72:
+						position, tokenIndex = position72, tokenIndex72
+					}
+				l73:
+					{
+						position74, tokenIndex74 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l74
+						}
+						position++
+						goto l75
+					l74:
+						position, tokenIndex = position74, tokenIndex74
+					}
+				l75:
+					{
+						position76, tokenIndex76 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l76
+						}
+						position++
+						goto l77
+					l76:
+						position, tokenIndex = position76, tokenIndex76
+					}
+				l77:
+					{
+						position78, tokenIndex78 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l78
+						}
+						position++
+						goto l79
+					l78:
+						position, tokenIndex = position78, tokenIndex78
+					}
+				l79:
+					{
+						position80, tokenIndex80 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l80
+						}
+						position++
+						goto l67
+					l80:
+						position, tokenIndex = position80, tokenIndex80
+					}
+					add(ruleHeadingLevel, position69)
+				}
+				{
+					position81, tokenIndex81 := position, tokenIndex
+					{
+						position85, tokenIndex85 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l86
+						}
+						position++
+						goto l85
+					l86:
+						position, tokenIndex = position85, tokenIndex85
+						if buffer[position] != rune('\t') {
+							goto l81
+						}
+						position++
+					}
+				l85:
+				l83:
+					{
+						position84, tokenIndex84 := position, tokenIndex
+						{
+							position87, tokenIndex87 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l88
+							}
+							position++
+							goto l87
+						l88:
+							position, tokenIndex = position87, tokenIndex87
+							if buffer[position] != rune('\t') {
+								goto l84
+							}
+							position++
+						}
+					l87:
+						goto l83
+					l84:
+						position, tokenIndex = position84, tokenIndex84
+					}
+					{
+						position91, tokenIndex91 := position, tokenIndex
+						if !_rules[ruleHeadingEnd]() {
+							goto l91
+						}
+						goto l81
+					l91:
+						position, tokenIndex = position91, tokenIndex91
+					}
+					if !_rules[ruleInlineSpan]() {
+						goto l81
+					}
+				l89:
+					{
+						position90, tokenIndex90 := position, tokenIndex
+						{
+							position92, tokenIndex92 := position, tokenIndex
+							if !_rules[ruleHeadingEnd]() {
+								goto l92
+							}
+							goto l90
+						l92:
+							position, tokenIndex = position92, tokenIndex92
+						}
+						if !_rules[ruleInlineSpan]() {
+							goto l90
+						}
+						goto l89
+					l90:
+						position, tokenIndex = position90, tokenIndex90
+					}
+					goto l82
+				l81:
+					position, tokenIndex = position81, tokenIndex81
+				}
+			l82:
+				if !_rules[ruleHeadingEnd]() {
+					goto l67
+				}
+				add(ruleHeading, position68)
+			}
+			memoize(2, position67, tokenIndex67, true)
+			return true
+		l67:
+			memoize(2, position67, tokenIndex67, false)
+			position, tokenIndex = position67, tokenIndex67
+			return false
+		},
+		/* 3 HeadingLevel <- <('#' '#'? '#'? '#'? '#'? '#'? !'#')> */
+		nil,
+		/* 4 HeadingEnd <- <(((' ' / '\t')+ '#'+)? (' ' / '\t')* EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{4, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position94, tokenIndex94 := position, tokenIndex
+			{
+				position95 := position
+				{
+					position96, tokenIndex96 := position, tokenIndex
+					{
+						position100, tokenIndex100 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l101
+						}
+						position++
+						goto l100
+					l101:
+						position, tokenIndex = position100, tokenIndex100
+						if buffer[position] != rune('\t') {
+							goto l96
+						}
+						position++
+					}
+				l100:
+				l98:
+					{
+						position99, tokenIndex99 := position, tokenIndex
+						{
+							position102, tokenIndex102 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l103
+							}
+							position++
+							goto l102
+						l103:
+							position, tokenIndex = position102, tokenIndex102
+							if buffer[position] != rune('\t') {
+								goto l99
+							}
+							position++
+						}
+					l102:
+						goto l98
+					l99:
+						position, tokenIndex = position99, tokenIndex99
+					}
+					if buffer[position] != rune('#') {
+						goto l96
+					}
+					position++
+				l104:
+					{
+						position105, tokenIndex105 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l105
+						}
+						position++
+						goto l104
+					l105:
+						position, tokenIndex = position105, tokenIndex105
+					}
+					goto l97
+				l96:
+					position, tokenIndex = position96, tokenIndex96
+				}
+			l97:
+			l106:
+				{
+					position107, tokenIndex107 := position, tokenIndex
+					{
+						position108, tokenIndex108 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l109
+						}
+						position++
+						goto l108
+					l109:
+						position, tokenIndex = position108, tokenIndex108
+						if buffer[position] != rune('\t') {
+							goto l107
+						}
+						position++
+					}
+				l108:
+					goto l106
+				l107:
+					position, tokenIndex = position107, tokenIndex107
+				}
+				if !_rules[ruleEOL]() {
+					goto l94
+				}
+				add(ruleHeadingEnd, position95)
+			}
+			memoize(4, position94, tokenIndex94, true)
+			return true
+		l94:
+			memoize(4, position94, tokenIndex94, false)
+			position, tokenIndex = position94, tokenIndex94
+			return false
+		},
+		/* 5 ThematicBreak <- <(NonIndentSpace ((&('-') ('-' Sp '-' Sp '-' (Sp '-')*)) | (&('_') ('_' Sp '_' Sp '_' (Sp '_')*)) | (&('*') %fail('-' '_') ('*' Sp '*' Sp '*' (Sp '*')*))) Sp EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{5, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position110, tokenIndex110 := position, tokenIndex
+			{
+				position111 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l110
+				}
+				{
+					switch buffer[position] {
+					case '-':
+						position++
+						if !_rules[ruleSp]() {
+							goto l110
+						}
+						if buffer[position] != rune('-') {
+							goto l110
+						}
+						position++
+						if !_rules[ruleSp]() {
+							goto l110
+						}
+						if buffer[position] != rune('-') {
+							goto l110
+						}
+						position++
+					l113:
+						{
+							position114, tokenIndex114 := position, tokenIndex
+							if !_rules[ruleSp]() {
+								goto l114
+							}
+							if buffer[position] != rune('-') {
+								goto l114
+							}
+							position++
+							goto l113
+						l114:
+							position, tokenIndex = position114, tokenIndex114
+						}
+					case '_':
+						position++
+						if !_rules[ruleSp]() {
+							goto l110
+						}
+						if buffer[position] != rune('_') {
+							goto l110
+						}
+						position++
+						if !_rules[ruleSp]() {
+							goto l110
+						}
+						if buffer[position] != rune('_') {
+							goto l110
+						}
+						position++
+					l115:
+						{
+							position116, tokenIndex116 := position, tokenIndex
+							if !_rules[ruleSp]() {
+								goto l116
+							}
+							if buffer[position] != rune('_') {
+								goto l116
+							}
+							position++
+							goto l115
+						l116:
+							position, tokenIndex = position116, tokenIndex116
+						}
+					default:
+						if buffer[position] != rune('*') {
+							goto l110
+						}
+						position++
+						if !_rules[ruleSp]() {
+							goto l110
+						}
+						if buffer[position] != rune('*') {
+							goto l110
+						}
+						position++
+						if !_rules[ruleSp]() {
+							goto l110
+						}
+						if buffer[position] != rune('*') {
+							goto l110
+						}
+						position++
+					l117:
+						{
+							position118, tokenIndex118 := position, tokenIndex
+							if !_rules[ruleSp]() {
+								goto l118
+							}
+							if buffer[position] != rune('*') {
+								goto l118
+							}
+							position++
+							goto l117
+						l118:
+							position, tokenIndex = position118, tokenIndex118
+						}
+					}
+				}
+
+				if !_rules[ruleSp]() {
+					goto l110
+				}
+				if !_rules[ruleEOL]() {
+					goto l110
+				}
+				add(ruleThematicBreak, position111)
+			}
+			memoize(5, position110, tokenIndex110, true)
+			return true
+		l110:
+			memoize(5, position110, tokenIndex110, false)
+			position, tokenIndex = position110, tokenIndex110
+			return false
+		},
+		/* 6 FencedCode <- <(NonIndentSpace (('`' '`' '`' '`'* Sp Info Sp EOL (!BacktickFence CodeLine)* (BacktickFence / EOF)) / ('~' '~' '~' '~'* Sp Info Sp EOL (!TildeFence CodeLine)* (TildeFence / EOF))))> */
+		nil,
+		/* 7 BacktickFence <- <(NonIndentSpace ('`' '`' '`') '`'* Sp EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{7, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position120, tokenIndex120 := position, tokenIndex
+			{
+				position121 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l120
+				}
+				if buffer[position] != rune('`') {
+					goto l120
+				}
+				position++
+				if buffer[position] != rune('`') {
+					goto l120
+				}
+				position++
+				if buffer[position] != rune('`') {
+					goto l120
+				}
+				position++
+			l122:
+				{
+					position123, tokenIndex123 := position, tokenIndex
+					if buffer[position] != rune('`') {
+						goto l123
+					}
+					position++
+					goto l122
+				l123:
+					position, tokenIndex = position123, tokenIndex123
+				}
+				if !_rules[ruleSp]() {
+					goto l120
+				}
+				if !_rules[ruleEOL]() {
+					goto l120
+				}
+				add(ruleBacktickFence, position121)
+			}
+			memoize(7, position120, tokenIndex120, true)
+			return true
+		l120:
+			memoize(7, position120, tokenIndex120, false)
+			position, tokenIndex = position120, tokenIndex120
+			return false
+		},
+		/* 8 TildeFence <- <(NonIndentSpace ('~' '~' '~') '~'* Sp EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{8, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position124, tokenIndex124 := position, tokenIndex
+			{
+				position125 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l124
+				}
+				if buffer[position] != rune('~') {
+					goto l124
+				}
+				position++
+				if buffer[position] != rune('~') {
+					goto l124
+				}
+				position++
+				if buffer[position] != rune('~') {
+					goto l124
+				}
+				position++
+			l126:
+				{
+					position127, tokenIndex127 := position, tokenIndex
+					if buffer[position] != rune('~') {
+						goto l127
+					}
+					position++
+					goto l126
+				l127:
+					position, tokenIndex = position127, tokenIndex127
+				}
+				if !_rules[ruleSp]() {
+					goto l124
+				}
+				if !_rules[ruleEOL]() {
+					goto l124
+				}
+				add(ruleTildeFence, position125)
+			}
+			memoize(8, position124, tokenIndex124, true)
+			return true
+		l124:
+			memoize(8, position124, tokenIndex124, false)
+			position, tokenIndex = position124, tokenIndex124
+			return false
+		},
+		/* 9 Info <- <(!((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&('`') '`') | (&(' ') %fail('\t' '\n' '\r' '`') ' ')) .)*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{9, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position128, tokenIndex128 := position, tokenIndex
+			{
+				position129 := position
+			l130:
+				{
+					position131, tokenIndex131 := position, tokenIndex
+					{
+						position132, tokenIndex132 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '\t':
+								position++
+							case '\n':
+								position++
+							case '\r':
+								position++
+							case '`':
+								position++
+							default:
+								if buffer[position] != rune(' ') {
+									goto l132
+								}
+								position++
+							}
+						}
+
+						goto l131
+					l132:
+						position, tokenIndex = position132, tokenIndex132
+					}
+					if !matchDot() {
+						goto l131
+					}
+					goto l130
+				l131:
+					position, tokenIndex = position131, tokenIndex131
+				}
+				add(ruleInfo, position129)
+			}
+			memoize(9, position128, tokenIndex128, true)
+			return true
+		},
+		/* 10 CodeLine <- <(!EOF (!NL .)* EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{10, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position134, tokenIndex134 := position, tokenIndex
+			{
+				position135 := position
+				{
+					position136, tokenIndex136 := position, tokenIndex
+					if !_rules[ruleEOF]() {
+						goto l136
+					}
+					goto l134
+				l136:
+					position, tokenIndex = position136, tokenIndex136
+				}
+			l137:
+				{
+					position138, tokenIndex138 := position, tokenIndex
+					{
+						position139, tokenIndex139 := position, tokenIndex
+						if !_rules[ruleNL]() {
+							goto l139
+						}
+						goto l138
+					l139:
+						position, tokenIndex = position139, tokenIndex139
+					}
+					if !matchDot() {
+						goto l138
+					}
+					goto l137
+				l138:
+					position, tokenIndex = position138, tokenIndex138
+				}
+				if !_rules[ruleEOL]() {
+					goto l134
+				}
+				add(ruleCodeLine, position135)
+			}
+			memoize(10, position134, tokenIndex134, true)
+			return true
+		l134:
+			memoize(10, position134, tokenIndex134, false)
+			position, tokenIndex = position134, tokenIndex134
+			return false
+		},
+		/* 11 IndentedCode <- <(IndentedLine (BlankLine* IndentedLine)*)> */
+		nil,
+		/* 12 IndentedLine <- <(((' ' ' ' ' ' ' ') / '\t') (!NL .)* EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{12, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position141, tokenIndex141 := position, tokenIndex
+			{
+				position142 := position
+				{
+					position143, tokenIndex143 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l144
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l144
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l144
+					}
+					position++
+					if buffer[position] != rune(' ') {
+						goto l144
+					}
+					position++
+					goto l143
+				l144:
+					position, tokenIndex = position143, tokenIndex143
+					if buffer[position] != rune('\t') {
+						goto l141
+					}
+					position++
+				}
+			l143:
+			l145:
+				{
+					position146, tokenIndex146 := position, tokenIndex
+					{
+						position147, tokenIndex147 := position, tokenIndex
+						if !_rules[ruleNL]() {
+							goto l147
+						}
+						goto l146
+					l147:
+						position, tokenIndex = position147, tokenIndex147
+					}
+					if !matchDot() {
+						goto l146
+					}
+					goto l145
+				l146:
+					position, tokenIndex = position146, tokenIndex146
+				}
+				if !_rules[ruleEOL]() {
+					goto l141
+				}
+				add(ruleIndentedLine, position142)
+			}
+			memoize(12, position141, tokenIndex141, true)
+			return true
+		l141:
+			memoize(12, position141, tokenIndex141, false)
+			position, tokenIndex = position141, tokenIndex141
+			return false
+		},
+		/* 13 Paragraph <- <(NonIndentSpace Inline+ Sp ((NL SetextUnderline) / EOL))> */
+		nil,
+		/* 14 SetextUnderline <- <(NonIndentSpace ('='+ / '-'+) Sp EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{14, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position149, tokenIndex149 := position, tokenIndex
+			{
+				position150 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l149
+				}
+				{
+					position151, tokenIndex151 := position, tokenIndex
+					if buffer[position] != rune('=') {
+						goto l152
+					}
+					position++
+				l153:
+					{
+						position154, tokenIndex154 := position, tokenIndex
+						if buffer[position] != rune('=') {
+							goto l154
+						}
+						position++
+						goto l153
+					l154:
+						position, tokenIndex = position154, tokenIndex154
+					}
+					goto l151
+				l152:
+					position, tokenIndex = position151, tokenIndex151
+					if buffer[position] != rune('-') {
+						goto l149
+					}
+					position++
+				l155:
+					{
+						position156, tokenIndex156 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l156
+						}
+						position++
+						goto l155
+					l156:
+						position, tokenIndex = position156, tokenIndex156
+					}
+				}
+			l151:
+				if !_rules[ruleSp]() {
+					goto l149
+				}
+				if !_rules[ruleEOL]() {
+					goto l149
+				}
+				add(ruleSetextUnderline, position150)
+			}
+			memoize(14, position149, tokenIndex149, true)
+			return true
+		l149:
+			memoize(14, position149, tokenIndex149, false)
+			position, tokenIndex = position149, tokenIndex149
+			return false
+		},
+		/* 15 BlockQuote <- <(QuoteLine (QuoteLine / LazyLine)*)> */
+		nil,
+		/* 16 QuoteLine <- <(NonIndentSpace '>' ' '? QuoteContent EOL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{16, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position158, tokenIndex158 := position, tokenIndex
+			{
+				position159 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l158
+				}
+				if buffer[position] != rune('>') {
+					goto l158
+				}
+				position++
+				{
+					position160, tokenIndex160 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l160
+					}
+					position++
+					goto l161
+				l160:
+					position, tokenIndex = position160, tokenIndex160
+				}
+			l161:
+				{
+					position162 := position
+				l163:
+					{
+						position164, tokenIndex164 := position, tokenIndex
+						{
+							position165, tokenIndex165 := position, tokenIndex
+							if !_rules[ruleNL]() {
+								goto l165
+							}
+							goto l164
+						l165:
+							position, tokenIndex = position165, tokenIndex165
+						}
+						if !matchDot() {
+							goto l164
+						}
+						goto l163
+					l164:
+						position, tokenIndex = position164, tokenIndex164
+					}
+					add(ruleQuoteContent, position162)
+				}
+				if !_rules[ruleEOL]() {
+					goto l158
+				}
+				add(ruleQuoteLine, position159)
+			}
+			memoize(16, position158, tokenIndex158, true)
+			return true
+		l158:
+			memoize(16, position158, tokenIndex158, false)
+			position, tokenIndex = position158, tokenIndex158
+			return false
+		},
+		/* 17 QuoteContent <- <(!NL .)*> */
+		nil,
+		/* 18 LazyLine <- <(!BlankLine !Interrupt (!NL .)+ EOL)> */
+		nil,
+		/* 19 List <- <(BulletList / OrderedList)> */
+		nil,
+		/* 20 BulletList <- <(BulletItem (BlankLine* BulletItem)*)> */
+		nil,
+		/* 21 OrderedList <- <(OrderedItem (BlankLine* OrderedItem)*)> */
+		nil,
+		/* 22 BulletItem <- <(NonIndentSpace BulletMarker ItemRest)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{22, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position171, tokenIndex171 := position, tokenIndex
+			{
+				position172 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l171
+				}
+				if !_rules[ruleBulletMarker]() {
+					goto l171
+				}
+				if !_rules[ruleItemRest]() {
+					goto l171
+				}
+				add(ruleBulletItem, position172)
+			}
+			memoize(22, position171, tokenIndex171, true)
+			return true
+		l171:
+			memoize(22, position171, tokenIndex171, false)
+			position, tokenIndex = position171, tokenIndex171
+			return false
+		},
+		/* 23 OrderedItem <- <(NonIndentSpace OrderedMarker ItemRest)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{23, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position173, tokenIndex173 := position, tokenIndex
+			{
+				position174 := position
+				if !_rules[ruleNonIndentSpace]() {
+					goto l173
+				}
+				if !_rules[ruleOrderedMarker]() {
+					goto l173
+				}
+				if !_rules[ruleItemRest]() {
+					goto l173
+				}
+				add(ruleOrderedItem, position174)
+			}
+			memoize(23, position173, tokenIndex173, true)
+			return true
+		l173:
+			memoize(23, position173, tokenIndex173, false)
+			position, tokenIndex = position173, tokenIndex173
+			return false
+		},
+		/* 24 BulletMarker <- <((&('+') '+') | (&('-') '-') | (&('*') %fail('+' '-') '*'))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{24, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position175, tokenIndex175 := position, tokenIndex
+			{
+				position176 := position
+				{
+					switch buffer[position] {
+					case '+':
+						position++
+					case '-':
+						position++
+					default:
+						if buffer[position] != rune('*') {
+							goto l175
+						}
+						position++
+					}
+				}
+
+				add(ruleBulletMarker, position176)
+			}
+			memoize(24, position175, tokenIndex175, true)
+			return true
+		l175:
+			memoize(24, position175, tokenIndex175, false)
+			position, tokenIndex = position175, tokenIndex175
+			return false
+		},
+		/* 25 OrderedMarker <- <([0-9] [0-9]? [0-9]? [0-9]? [0-9]? [0-9]? [0-9]? [0-9]? [0-9]? ('.' / ')'))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{25, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position178, tokenIndex178 := position, tokenIndex
+			{
+				position179 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l178
+				}
+				position++
+				{
+					position180, tokenIndex180 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l180
+					}
+					position++
+					goto l181
+				l180:
+					position, tokenIndex = position180, tokenIndex180
+				}
+			l181:
+				{
+					position182, tokenIndex182 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l182
+					}
+					position++
+					goto l183
+				l182:
+					position, tokenIndex = position182, tokenIndex182
+				}
+			l183:
+				{
+					position184, tokenIndex184 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l184
+					}
+					position++
+					goto l185
+				l184:
+					position, tokenIndex = position184, tokenIndex184
+				}
+			l185:
+				{
+					position186, tokenIndex186 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l186
+					}
+					position++
+					goto l187
+				l186:
+					position, tokenIndex = position186, tokenIndex186
+				}
+			l187:
+				{
+					position188, tokenIndex188 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l188
+					}
+					position++
+					goto l189
+				l188:
+					position, tokenIndex = position188, tokenIndex188
+				}
+			l189:
+				{
+					position190, tokenIndex190 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l190
+					}
+					position++
+					goto l191
+				l190:
+					position, tokenIndex = position190, tokenIndex190
+				}
+			l191:
+				{
+					position192, tokenIndex192 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l192
+					}
+					position++
+					goto l193
+				l192:
+					position, tokenIndex = position192, tokenIndex192
+				}
+			l193:
+				{
+					position194, tokenIndex194 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l194
+					}
+					position++
+					goto l195
+				l194:
+					position, tokenIndex = position194, tokenIndex194
+				}
+			l195:
+				{
+					position196, tokenIndex196 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l197
+					}
+					position++
+					goto l196
+				l197:
+					position, tokenIndex = position196, tokenIndex196
+					if buffer[position] != rune(')') {
+						goto l178
+					}
+					position++
+				}
+			l196:
+				add(ruleOrderedMarker, position179)
+			}
+			memoize(25, position178, tokenIndex178, true)
+			return true
+		l178:
+			memoize(25, position178, tokenIndex178, false)
+			position, tokenIndex = position178, tokenIndex178
+			return false
+		},
+		/* 26 ItemStart <- <(NonIndentSpace (BulletMarker / OrderedMarker) ((&('\t') '\t') | (&(' ') ' ') | (&('\n' | '\r') %fail('\t' ' ') EOL)))> */
+		nil,
+		/* 27 ItemRest <- <((((' ' / '\t') ' '? ' '? ' '?) / &EOL) !{ p.indent = itemIndent(buffer, int(position)) } ItemFirstLine ItemContinuation*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{27, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position199, tokenIndex199 := position, tokenIndex
+			{
+				position200 := position
+				{
+					position201, tokenIndex201 := position, tokenIndex
+					{
+						position203, tokenIndex203 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l204
+						}
+						position++
+						goto l203
+					l204:
+						position, tokenIndex = position203, tokenIndex203
+						if buffer[position] != rune('\t') {
+							goto l202
+						}
+						position++
+					}
+				l203:
+					{
+						position205, tokenIndex205 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l205
+						}
+						position++
+						goto l206
+					l205:
+						position, tokenIndex = position205, tokenIndex205
+					}
+				l206:
+					{
+						position207, tokenIndex207 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l207
+						}
+						position++
+						goto l208
+					l207:
+						position, tokenIndex = position207, tokenIndex207
+					}
+				l208:
+					{
+						position209, tokenIndex209 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l209
+						}
+						position++
+						goto l210
+					l209:
+						position, tokenIndex = position209, tokenIndex209
+					}
+				l210:
+					goto l201
+				l202:
+					position, tokenIndex = position201, tokenIndex201
+					{
+						position211, tokenIndex211 := position, tokenIndex
+						if !_rules[ruleEOL]() {
+							goto l199
+						}
+						position, tokenIndex = position211, tokenIndex211
+					}
+				}
+			l201:
+				p.indent = itemIndent(buffer, int(position))
+				{
+					position212 := position
+				l213:
+					{
+						position214, tokenIndex214 := position, tokenIndex
+						{
+							position215, tokenIndex215 := position, tokenIndex
+							if !_rules[ruleNL]() {
+								goto l215
+							}
+							goto l214
+						l215:
+							position, tokenIndex = position215, tokenIndex215
+						}
+						if !matchDot() {
+							goto l214
+						}
+						goto l213
+					l214:
+						position, tokenIndex = position214, tokenIndex214
+					}
+					if !_rules[ruleEOL]() {
+						goto l199
+					}
+					add(ruleItemFirstLine, position212)
+				}
+			l216:
+				{
+					position217, tokenIndex217 := position, tokenIndex
+					{
+						position218 := position
+						{
+							position219, tokenIndex219 := position, tokenIndex
+						l221:
+							{
+								position222, tokenIndex222 := position, tokenIndex
+								if !_rules[ruleBlankLine]() {
+									goto l222
+								}
+								goto l221
+							l222:
+								position, tokenIndex = position222, tokenIndex222
+							}
+							{
+								position223 := position
+								if !(indented(buffer, int(position), p.indent)) {
+									goto l220
+								}
+								{
+									position226, tokenIndex226 := position, tokenIndex
+									if !_rules[ruleNL]() {
+										goto l226
+									}
+									goto l220
+								l226:
+									position, tokenIndex = position226, tokenIndex226
+								}
+								if !matchDot() {
+									goto l220
+								}
+							l224:
+								{
+									position225, tokenIndex225 := position, tokenIndex
+									{
+										position227, tokenIndex227 := position, tokenIndex
+										if !_rules[ruleNL]() {
+											goto l227
+										}
+										goto l225
+									l227:
+										position, tokenIndex = position227, tokenIndex227
+									}
+									if !matchDot() {
+										goto l225
+									}
+									goto l224
+								l225:
+									position, tokenIndex = position225, tokenIndex225
+								}
+								if !_rules[ruleEOL]() {
+									goto l220
+								}
+								add(ruleItemLine, position223)
+							}
+							goto l219
+						l220:
+							position, tokenIndex = position219, tokenIndex219
+							{
+								position228 := position
+								{
+									position229, tokenIndex229 := position, tokenIndex
+									if !_rules[ruleBlankLine]() {
+										goto l229
+									}
+									goto l217
+								l229:
+									position, tokenIndex = position229, tokenIndex229
+								}
+								{
+									position230, tokenIndex230 := position, tokenIndex
+									if !_rules[ruleInterrupt]() {
+										goto l230
+									}
+									goto l217
+								l230:
+									position, tokenIndex = position230, tokenIndex230
+								}
+								{
+									position231, tokenIndex231 := position, tokenIndex
+									{
+										position232 := position
+										if !_rules[ruleNonIndentSpace]() {
+											goto l231
+										}
+										{
+											position233, tokenIndex233 := position, tokenIndex
+											if !_rules[ruleBulletMarker]() {
+												goto l234
+											}
+											goto l233
+										l234:
+											position, tokenIndex = position233, tokenIndex233
+											if !_rules[ruleOrderedMarker]() {
+												goto l231
+											}
+										}
+									l233:
+										{
+											switch buffer[position] {
+											case '\t':
+												position++
+											case ' ':
+												position++
+											default:
+												if !_rules[ruleEOL]() {
+													goto l231
+												}
+											}
+										}
+
+										add(ruleItemStart, position232)
+									}
+									goto l217
+								l231:
+									position, tokenIndex = position231, tokenIndex231
+								}
+								{
+									position238, tokenIndex238 := position, tokenIndex
+									if !_rules[ruleNL]() {
+										goto l238
+									}
+									goto l217
+								l238:
+									position, tokenIndex = position238, tokenIndex238
+								}
+								if !matchDot() {
+									goto l217
+								}
+							l236:
+								{
+									position237, tokenIndex237 := position, tokenIndex
+									{
+										position239, tokenIndex239 := position, tokenIndex
+										if !_rules[ruleNL]() {
+											goto l239
+										}
+										goto l237
+									l239:
+										position, tokenIndex = position239, tokenIndex239
+									}
+									if !matchDot() {
+										goto l237
+									}
+									goto l236
+								l237:
+									position, tokenIndex = position237, tokenIndex237
+								}
+								if !_rules[ruleEOL]() {
+									goto l217
+								}
+								add(ruleLazyItemLine, position228)
+							}
+						}
+					l219:
+						add(ruleItemContinuation, position218)
+					}
+					goto l216
+				l217:
+					position, tokenIndex = position217, tokenIndex217
+				}
+				add(ruleItemRest, position200)
+			}
+			memoize(27, position199, tokenIndex199, true)
+			return true
+		l199:
+			memoize(27, position199, tokenIndex199, false)
+			position, tokenIndex = position199, tokenIndex199
+			return false
+		},
+		/* 28 ItemFirstLine <- <((!NL .)* EOL)> */
+		nil,
+		/* 29 ItemContinuation <- <((BlankLine* ItemLine) / LazyItemLine)> */
+		nil,
+		/* 30 ItemLine <- <(&{ indented(buffer, int(position), p.indent) } (!NL .)+ EOL)> */
+		nil,
+		/* 31 LazyItemLine <- <(!BlankLine !Interrupt !ItemStart (!NL .)+ EOL)> */
+		nil,
+		/* 32 Interrupt <- <(ThematicBreak / Heading / (NonIndentSpace (((&('>') '>') | (&('~') ('~' '~' '~')) | (&('`') %fail('>' '~~~') ('`' '`' '`'))) / ((BulletMarker / ('1' ('.' / ')'))) (' ' / '\t')+ !('\r' / '\n')))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{32, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position244, tokenIndex244 := position, tokenIndex
+			{
+				position245 := position
+				{
+					position246, tokenIndex246 := position, tokenIndex
+					if !_rules[ruleThematicBreak]() {
+						goto l247
+					}
+					goto l246
+				l247:
+					position, tokenIndex = position246, tokenIndex246
+					if !_rules[ruleHeading]() {
+						goto l248
+					}
+					goto l246
+				l248:
+					position, tokenIndex = position246, tokenIndex246
+					if !_rules[ruleNonIndentSpace]() {
+						goto l244
+					}
+					{
+						position249, tokenIndex249 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '>':
+								position++
+							case '~':
+								position++
+								if buffer[position] != rune('~') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('~') {
+									goto l250
+								}
+								position++
+							default:
+								if buffer[position] != rune('`') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('`') {
+									goto l250
+								}
+								position++
+								if buffer[position] != rune('`') {
+									goto l250
+								}
+								position++
+							}
+						}
+
+						goto l249
+					l250:
+						position, tokenIndex = position249, tokenIndex249
+						{
+							position252, tokenIndex252 := position, tokenIndex
+							if !_rules[ruleBulletMarker]() {
+								goto l253
+							}
+							goto l252
+						l253:
+							position, tokenIndex = position252, tokenIndex252
+							if buffer[position] != rune('1') {
+								goto l244
+							}
+							position++
+							{
+								position254, tokenIndex254 := position, tokenIndex
+								if buffer[position] != rune('.') {
+									goto l255
+								}
+								position++
+								goto l254
+							l255:
+								position, tokenIndex = position254, tokenIndex254
+								if buffer[position] != rune(')') {
+									goto l244
+								}
+								position++
+							}
+						l254:
+						}
+					l252:
+						{
+							position258, tokenIndex258 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l259
+							}
+							position++
+							goto l258
+						l259:
+							position, tokenIndex = position258, tokenIndex258
+							if buffer[position] != rune('\t') {
+								goto l244
+							}
+							position++
+						}
+					l258:
+					l256:
+						{
+							position257, tokenIndex257 := position, tokenIndex
+							{
+								position260, tokenIndex260 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l261
+								}
+								position++
+								goto l260
+							l261:
+								position, tokenIndex = position260, tokenIndex260
+								if buffer[position] != rune('\t') {
+									goto l257
+								}
+								position++
+							}
+						l260:
+							goto l256
+						l257:
+							position, tokenIndex = position257, tokenIndex257
+						}
+						{
+							position262, tokenIndex262 := position, tokenIndex
+							{
+								position263, tokenIndex263 := position, tokenIndex
+								if buffer[position] != rune('\r') {
+									goto l264
+								}
+								position++
+								goto l263
+							l264:
+								position, tokenIndex = position263, tokenIndex263
+								if buffer[position] != rune('\n') {
+									goto l262
+								}
+								position++
+							}
+						l263:
+							goto l244
+						l262:
+							position, tokenIndex = position262, tokenIndex262
+						}
+					}
+				l249:
+				}
+			l246:
+				add(ruleInterrupt, position245)
+			}
+			memoize(32, position244, tokenIndex244, true)
+			return true
+		l244:
+			memoize(32, position244, tokenIndex244, false)
+			position, tokenIndex = position244, tokenIndex244
+			return false
+		},
+		/* 33 Inline <- <(HardBreak / SoftBreak / InlineSpan)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{33, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position265, tokenIndex265 := position, tokenIndex
+			{
+				position266 := position
+				{
+					position267, tokenIndex267 := position, tokenIndex
+					{
+						position269 := position
+						{
+							position270, tokenIndex270 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l271
+							}
+							position++
+							if buffer[position] != rune(' ') {
+								goto l271
+							}
+							position++
+						l272:
+							{
+								position273, tokenIndex273 := position, tokenIndex
+								{
+									position274, tokenIndex274 := position, tokenIndex
+									if buffer[position] != rune(' ') {
+										goto l275
+									}
+									position++
+									goto l274
+								l275:
+									position, tokenIndex = position274, tokenIndex274
+									if buffer[position] != rune('\t') {
+										goto l273
+									}
+									position++
+								}
+							l274:
+								goto l272
+							l273:
+								position, tokenIndex = position273, tokenIndex273
+							}
+							goto l270
+						l271:
+							position, tokenIndex = position270, tokenIndex270
+							if buffer[position] != rune('\\') {
+								goto l268
+							}
+							position++
+						}
+					l270:
+						if !_rules[ruleNL]() {
+							goto l268
+						}
+						if !_rules[ruleLineContinues]() {
+							goto l268
+						}
+						if !_rules[ruleSp]() {
+							goto l268
+						}
+						add(ruleHardBreak, position269)
+					}
+					goto l267
+				l268:
+					position, tokenIndex = position267, tokenIndex267
+					{
+						position277 := position
+						if !_rules[ruleSp]() {
+							goto l276
+						}
+						if !_rules[ruleNL]() {
+							goto l276
+						}
+						if !_rules[ruleLineContinues]() {
+							goto l276
+						}
+						if !_rules[ruleSp]() {
+							goto l276
+						}
+						add(ruleSoftBreak, position277)
+					}
+					goto l267
+				l276:
+					position, tokenIndex = position267, tokenIndex267
+					if !_rules[ruleInlineSpan]() {
+						goto l265
+					}
+				}
+			l267:
+				add(ruleInline, position266)
+			}
+			memoize(33, position265, tokenIndex265, true)
+			return true
+		l265:
+			memoize(33, position265, tokenIndex265, false)
+			position, tokenIndex = position265, tokenIndex265
+			return false
+		},
+		/* 34 InlineSpan <- <(Text / Space / CodeSpan / Strong / Emphasis / Image / Link / AutoLink / Escaped / Symbol)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{34, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position278, tokenIndex278 := position, tokenIndex
+			{
+				position279 := position
+				{
+					position280, tokenIndex280 := position, tokenIndex
+					{
+						position282 := position
+						{
+							position285, tokenIndex285 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '\t':
+									position++
+								case '\n':
+									position++
+								case '\r':
+									position++
+								case ' ':
+									position++
+								case '<':
+									position++
+								case '[':
+									position++
+								case '\\':
+									position++
+								case ']':
+									position++
+								case '_':
+									position++
+								case '`':
+									position++
+								default:
+									if buffer[position] != rune('*') {
+										goto l285
+									}
+									position++
+								}
+							}
+
+							goto l281
+						l285:
+							position, tokenIndex = position285, tokenIndex285
+						}
+						{
+							position287, tokenIndex287 := position, tokenIndex
+							if buffer[position] != rune('!') {
+								goto l287
+							}
+							position++
+							if buffer[position] != rune('[') {
+								goto l287
+							}
+							position++
+							goto l281
+						l287:
+							position, tokenIndex = position287, tokenIndex287
+						}
+						if !matchDot() {
+							goto l281
+						}
+					l283:
+						{
+							position284, tokenIndex284 := position, tokenIndex
+							{
+								position288, tokenIndex288 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '\t':
+										position++
+									case '\n':
+										position++
+									case '\r':
+										position++
+									case ' ':
+										position++
+									case '<':
+										position++
+									case '[':
+										position++
+									case '\\':
+										position++
+									case ']':
+										position++
+									case '_':
+										position++
+									case '`':
+										position++
+									default:
+										if buffer[position] != rune('*') {
+											goto l288
+										}
+										position++
+									}
+								}
+
+								goto l284
+							l288:
+								position, tokenIndex = position288, tokenIndex288
+							}
+							{
+								position290, tokenIndex290 := position, tokenIndex
+								if buffer[position] != rune('!') {
+									goto l290
+								}
+								position++
+								if buffer[position] != rune('[') {
+									goto l290
+								}
+								position++
+								goto l284
+							l290:
+								position, tokenIndex = position290, tokenIndex290
+							}
+							if !matchDot() {
+								goto l284
+							}
+							goto l283
+						l284:
+							position, tokenIndex = position284, tokenIndex284
+						}
+						add(ruleText, position282)
+					}
+					goto l280
+				l281:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position292 := position
+						{
+							position295, tokenIndex295 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l296
+							}
+							position++
+							goto l295
+						l296:
+							position, tokenIndex = position295, tokenIndex295
+							if buffer[position] != rune('\t') {
+								goto l291
+							}
+							position++
+						}
+					l295:
+					l293:
+						{
+							position294, tokenIndex294 := position, tokenIndex
+							{
+								position297, tokenIndex297 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l298
+								}
+								position++
+								goto l297
+							l298:
+								position, tokenIndex = position297, tokenIndex297
+								if buffer[position] != rune('\t') {
+									goto l294
+								}
+								position++
+							}
+						l297:
+							goto l293
+						l294:
+							position, tokenIndex = position294, tokenIndex294
+						}
+						{
+							position299, tokenIndex299 := position, tokenIndex
+							if !_rules[ruleEOL]() {
+								goto l299
+							}
+							goto l291
+						l299:
+							position, tokenIndex = position299, tokenIndex299
+						}
+						add(ruleSpace, position292)
+					}
+					goto l280
+				l291:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position301 := position
+						if buffer[position] != rune('`') {
+							goto l300
+						}
+						position++
+						{
+							position302, tokenIndex302 := position, tokenIndex
+							if buffer[position] != rune('`') {
+								goto l303
+							}
+							position++
+							{
+								position304, tokenIndex304 := position, tokenIndex
+								if buffer[position] != rune('`') {
+									goto l304
+								}
+								position++
+								goto l303
+							l304:
+								position, tokenIndex = position304, tokenIndex304
+							}
+							{
+								position307, tokenIndex307 := position, tokenIndex
+								if buffer[position] != rune('`') {
+									goto l307
+								}
+								position++
+								if buffer[position] != rune('`') {
+									goto l307
+								}
+								position++
+								goto l303
+							l307:
+								position, tokenIndex = position307, tokenIndex307
+							}
+							{
+								position308, tokenIndex308 := position, tokenIndex
+								if !_rules[ruleNL]() {
+									goto l308
+								}
+								if !_rules[ruleSp]() {
+									goto l308
+								}
+								if !_rules[ruleNL]() {
+									goto l308
+								}
+								goto l303
+							l308:
+								position, tokenIndex = position308, tokenIndex308
+							}
+							if !matchDot() {
+								goto l303
+							}
+						l305:
+							{
+								position306, tokenIndex306 := position, tokenIndex
+								{
+									position309, tokenIndex309 := position, tokenIndex
+									if buffer[position] != rune('`') {
+										goto l309
+									}
+									position++
+									if buffer[position] != rune('`') {
+										goto l309
+									}
+									position++
+									goto l306
+								l309:
+									position, tokenIndex = position309, tokenIndex309
+								}
+								{
+									position310, tokenIndex310 := position, tokenIndex
+									if !_rules[ruleNL]() {
+										goto l310
+									}
+									if !_rules[ruleSp]() {
+										goto l310
+									}
+									if !_rules[ruleNL]() {
+										goto l310
+									}
+									goto l306
+								l310:
+									position, tokenIndex = position310, tokenIndex310
+								}
+								if !matchDot() {
+									goto l306
+								}
+								goto l305
+							l306:
+								position, tokenIndex = position306, tokenIndex306
+							}
+							if buffer[position] != rune('`') {
+								goto l303
+							}
+							position++
+							if buffer[position] != rune('`') {
+								goto l303
+							}
+							position++
+							goto l302
+						l303:
+							position, tokenIndex = position302, tokenIndex302
+							{
+								position311, tokenIndex311 := position, tokenIndex
+								if buffer[position] != rune('`') {
+									goto l311
+								}
+								position++
+								goto l300
+							l311:
+								position, tokenIndex = position311, tokenIndex311
+							}
+							{
+								position314, tokenIndex314 := position, tokenIndex
+								if buffer[position] != rune('`') {
+									goto l314
+								}
+								position++
+								goto l300
+							l314:
+								position, tokenIndex = position314, tokenIndex314
+							}
+							{
+								position315, tokenIndex315 := position, tokenIndex
+								if !_rules[ruleNL]() {
+									goto l315
+								}
+								if !_rules[ruleSp]() {
+									goto l315
+								}
+								if !_rules[ruleNL]() {
+									goto l315
+								}
+								goto l300
+							l315:
+								position, tokenIndex = position315, tokenIndex315
+							}
+							if !matchDot() {
+								goto l300
+							}
+						l312:
+							{
+								position313, tokenIndex313 := position, tokenIndex
+								{
+									position316, tokenIndex316 := position, tokenIndex
+									if buffer[position] != rune('`') {
+										goto l316
+									}
+									position++
+									goto l313
+								l316:
+									position, tokenIndex = position316, tokenIndex316
+								}
+								{
+									position317, tokenIndex317 := position, tokenIndex
+									if !_rules[ruleNL]() {
+										goto l317
+									}
+									if !_rules[ruleSp]() {
+										goto l317
+									}
+									if !_rules[ruleNL]() {
+										goto l317
+									}
+									goto l313
+								l317:
+									position, tokenIndex = position317, tokenIndex317
+								}
+								if !matchDot() {
+									goto l313
+								}
+								goto l312
+							l313:
+								position, tokenIndex = position313, tokenIndex313
+							}
+							if buffer[position] != rune('`') {
+								goto l300
+							}
+							position++
+						}
+					l302:
+						add(ruleCodeSpan, position301)
+					}
+					goto l280
+				l300:
+					position, tokenIndex = position280, tokenIndex280
+					if !_rules[ruleStrong]() {
+						goto l318
+					}
+					goto l280
+				l318:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position320 := position
+						{
+							position321, tokenIndex321 := position, tokenIndex
+							if buffer[position] != rune('*') {
+								goto l322
+							}
+							position++
+							{
+								position323, tokenIndex323 := position, tokenIndex
+								if !_rules[ruleWhitespace]() {
+									goto l323
+								}
+								goto l322
+							l323:
+								position, tokenIndex = position323, tokenIndex323
+							}
+							{
+								position326, tokenIndex326 := position, tokenIndex
+								if !_rules[ruleStrong]() {
+									goto l327
+								}
+								goto l326
+							l327:
+								position, tokenIndex = position326, tokenIndex326
+								{
+									position328, tokenIndex328 := position, tokenIndex
+									if buffer[position] != rune('*') {
+										goto l328
+									}
+									position++
+									goto l322
+								l328:
+									position, tokenIndex = position328, tokenIndex328
+								}
+								if !_rules[ruleInline]() {
+									goto l322
+								}
+							}
+						l326:
+						l324:
+							{
+								position325, tokenIndex325 := position, tokenIndex
+								{
+									position329, tokenIndex329 := position, tokenIndex
+									if !_rules[ruleStrong]() {
+										goto l330
+									}
+									goto l329
+								l330:
+									position, tokenIndex = position329, tokenIndex329
+									{
+										position331, tokenIndex331 := position, tokenIndex
+										if buffer[position] != rune('*') {
+											goto l331
+										}
+										position++
+										goto l325
+									l331:
+										position, tokenIndex = position331, tokenIndex331
+									}
+									if !_rules[ruleInline]() {
+										goto l325
+									}
+								}
+							l329:
+								goto l324
+							l325:
+								position, tokenIndex = position325, tokenIndex325
+							}
+							if !(!spaceBefore(buffer, int(position))) {
+								goto l322
+							}
+							if buffer[position] != rune('*') {
+								goto l322
+							}
+							position++
+							goto l321
+						l322:
+							position, tokenIndex = position321, tokenIndex321
+							if !(!wordBefore(buffer, int(position))) {
+								goto l319
+							}
+							if buffer[position] != rune('_') {
+								goto l319
+							}
+							position++
+							{
+								position332, tokenIndex332 := position, tokenIndex
+								if !_rules[ruleWhitespace]() {
+									goto l332
+								}
+								goto l319
+							l332:
+								position, tokenIndex = position332, tokenIndex332
+							}
+							{
+								position335, tokenIndex335 := position, tokenIndex
+								if !_rules[ruleStrong]() {
+									goto l336
+								}
+								goto l335
+							l336:
+								position, tokenIndex = position335, tokenIndex335
+								{
+									position337, tokenIndex337 := position, tokenIndex
+									if buffer[position] != rune('_') {
+										goto l337
+									}
+									position++
+									goto l319
+								l337:
+									position, tokenIndex = position337, tokenIndex337
+								}
+								if !_rules[ruleInline]() {
+									goto l319
+								}
+							}
+						l335:
+						l333:
+							{
+								position334, tokenIndex334 := position, tokenIndex
+								{
+									position338, tokenIndex338 := position, tokenIndex
+									if !_rules[ruleStrong]() {
+										goto l339
+									}
+									goto l338
+								l339:
+									position, tokenIndex = position338, tokenIndex338
+									{
+										position340, tokenIndex340 := position, tokenIndex
+										if buffer[position] != rune('_') {
+											goto l340
+										}
+										position++
+										goto l334
+									l340:
+										position, tokenIndex = position340, tokenIndex340
+									}
+									if !_rules[ruleInline]() {
+										goto l334
+									}
+								}
+							l338:
+								goto l333
+							l334:
+								position, tokenIndex = position334, tokenIndex334
+							}
+							if !(!spaceBefore(buffer, int(position))) {
+								goto l319
+							}
+							if buffer[position] != rune('_') {
+								goto l319
+							}
+							position++
+							{
+								position341, tokenIndex341 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									default:
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l341
+										}
+										position++
+									}
+								}
+
+								goto l319
+							l341:
+								position, tokenIndex = position341, tokenIndex341
+							}
+						}
+					l321:
+						add(ruleEmphasis, position320)
+					}
+					goto l280
+				l319:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position344 := position
+						if buffer[position] != rune('!') {
+							goto l343
+						}
+						position++
+						if buffer[position] != rune('[') {
+							goto l343
+						}
+						position++
+						if !_rules[ruleLinkText]() {
+							goto l343
+						}
+						if buffer[position] != rune(']') {
+							goto l343
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l343
+						}
+						position++
+						if !_rules[ruleSp]() {
+							goto l343
+						}
+						if !_rules[ruleLinkDestination]() {
+							goto l343
+						}
+						{
+							position345, tokenIndex345 := position, tokenIndex
+							{
+								position349, tokenIndex349 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l350
+								}
+								position++
+								goto l349
+							l350:
+								position, tokenIndex = position349, tokenIndex349
+								if buffer[position] != rune('\t') {
+									goto l345
+								}
+								position++
+							}
+						l349:
+						l347:
+							{
+								position348, tokenIndex348 := position, tokenIndex
+								{
+									position351, tokenIndex351 := position, tokenIndex
+									if buffer[position] != rune(' ') {
+										goto l352
+									}
+									position++
+									goto l351
+								l352:
+									position, tokenIndex = position351, tokenIndex351
+									if buffer[position] != rune('\t') {
+										goto l348
+									}
+									position++
+								}
+							l351:
+								goto l347
+							l348:
+								position, tokenIndex = position348, tokenIndex348
+							}
+							if !_rules[ruleLinkTitle]() {
+								goto l345
+							}
+							goto l346
+						l345:
+							position, tokenIndex = position345, tokenIndex345
+						}
+					l346:
+						if !_rules[ruleSp]() {
+							goto l343
+						}
+						if buffer[position] != rune(')') {
+							goto l343
+						}
+						position++
+						add(ruleImage, position344)
+					}
+					goto l280
+				l343:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position354 := position
+						if buffer[position] != rune('[') {
+							goto l353
+						}
+						position++
+						if !_rules[ruleLinkText]() {
+							goto l353
+						}
+						if buffer[position] != rune(']') {
+							goto l353
+						}
+						position++
+						if buffer[position] != rune('(') {
+							goto l353
+						}
+						position++
+						if !_rules[ruleSp]() {
+							goto l353
+						}
+						if !_rules[ruleLinkDestination]() {
+							goto l353
+						}
+						{
+							position355, tokenIndex355 := position, tokenIndex
+							{
+								position359, tokenIndex359 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l360
+								}
+								position++
+								goto l359
+							l360:
+								position, tokenIndex = position359, tokenIndex359
+								if buffer[position] != rune('\t') {
+									goto l355
+								}
+								position++
+							}
+						l359:
+						l357:
+							{
+								position358, tokenIndex358 := position, tokenIndex
+								{
+									position361, tokenIndex361 := position, tokenIndex
+									if buffer[position] != rune(' ') {
+										goto l362
+									}
+									position++
+									goto l361
+								l362:
+									position, tokenIndex = position361, tokenIndex361
+									if buffer[position] != rune('\t') {
+										goto l358
+									}
+									position++
+								}
+							l361:
+								goto l357
+							l358:
+								position, tokenIndex = position358, tokenIndex358
+							}
+							if !_rules[ruleLinkTitle]() {
+								goto l355
+							}
+							goto l356
+						l355:
+							position, tokenIndex = position355, tokenIndex355
+						}
+					l356:
+						if !_rules[ruleSp]() {
+							goto l353
+						}
+						if buffer[position] != rune(')') {
+							goto l353
+						}
+						position++
+						add(ruleLink, position354)
+					}
+					goto l280
+				l353:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position364 := position
+						if buffer[position] != rune('<') {
+							goto l363
+						}
+						position++
+						{
+							position365 := position
+							{
+								position366, tokenIndex366 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l367
+								}
+								position++
+								goto l366
+							l367:
+								position, tokenIndex = position366, tokenIndex366
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l363
+								}
+								position++
+							}
+						l366:
+							{
+								switch buffer[position] {
+								case '+':
+									position++
+								case '-':
+									position++
+								case '.':
+									position++
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									position++
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									position++
+								default:
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l363
+									}
+									position++
+								}
+							}
+
+						l368:
+							{
+								position369, tokenIndex369 := position, tokenIndex
+								{
+									switch buffer[position] {
+									case '+':
+										position++
+									case '-':
+										position++
+									case '.':
+										position++
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										position++
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										position++
+									default:
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l369
+										}
+										position++
+									}
+								}
+
+								goto l368
+							l369:
+								position, tokenIndex = position369, tokenIndex369
+							}
+							if buffer[position] != rune(':') {
+								goto l363
+							}
+							position++
+						l372:
+							{
+								position373, tokenIndex373 := position, tokenIndex
+								{
+									position374, tokenIndex374 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '\t':
+											position++
+										case '\n':
+											position++
+										case '\r':
+											position++
+										case '<':
+											position++
+										case '>':
+											position++
+										default:
+											if buffer[position] != rune(' ') {
+												goto l374
+											}
+											position++
+										}
+									}
+
+									goto l373
+								l374:
+									position, tokenIndex = position374, tokenIndex374
+								}
+								if !matchDot() {
+									goto l373
+								}
+								goto l372
+							l373:
+								position, tokenIndex = position373, tokenIndex373
+							}
+							add(ruleURI, position365)
+						}
+						if buffer[position] != rune('>') {
+							goto l363
+						}
+						position++
+						add(ruleAutoLink, position364)
+					}
+					goto l280
+				l363:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position377 := position
+						if buffer[position] != rune('\\') {
+							goto l376
+						}
+						position++
+						{
+							switch buffer[position] {
+							case '"':
+								position++
+							case '#':
+								position++
+							case '$':
+								position++
+							case '%':
+								position++
+							case '&':
+								position++
+							case '\'':
+								position++
+							case '(':
+								position++
+							case ')':
+								position++
+							case '*':
+								position++
+							case '+':
+								position++
+							case ',':
+								position++
+							case '-':
+								position++
+							case '.':
+								position++
+							case '/':
+								position++
+							case ':':
+								position++
+							case ';':
+								position++
+							case '<':
+								position++
+							case '=':
+								position++
+							case '>':
+								position++
+							case '?':
+								position++
+							case '@':
+								position++
+							case '[':
+								position++
+							case '\\':
+								position++
+							case ']':
+								position++
+							case '^':
+								position++
+							case '_':
+								position++
+							case '`':
+								position++
+							case '{':
+								position++
+							case '|':
+								position++
+							case '}':
+								position++
+							case '~':
+								position++
+							default:
+								if buffer[position] != rune('!') {
+									goto l376
+								}
+								position++
+							}
+						}
+
+						add(ruleEscaped, position377)
+					}
+					goto l280
+				l376:
+					position, tokenIndex = position280, tokenIndex280
+					{
+						position379 := position
+						{
+							switch buffer[position] {
+							case '!':
+								position++
+							case '<':
+								position++
+							case '[':
+								position++
+							case '\\':
+								position++
+							case ']':
+								position++
+							case '_':
+								position++
+							case '`':
+								position++
+							default:
+								if buffer[position] != rune('*') {
+									goto l278
+								}
+								position++
+							}
+						}
+
+						add(ruleSymbol, position379)
+					}
+				}
+			l280:
+				add(ruleInlineSpan, position279)
+			}
+			memoize(34, position278, tokenIndex278, true)
+			return true
+		l278:
+			memoize(34, position278, tokenIndex278, false)
+			position, tokenIndex = position278, tokenIndex278
+			return false
+		},
+		/* 35 Text <- <(!((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&(' ') ' ') | (&('<') '<') | (&('[') '[') | (&('\\') '\\') | (&(']') ']') | (&('_') '_') | (&('`') '`') | (&('*') %fail('\t' '\n' '\r' ' ' '<' '[' '\\' ']' '_' '`') '*')) !('!' '[') .)+> */
+		nil,
+		/* 36 Space <- <((' ' / '\t')+ !EOL)> */
+		nil,
+		/* 37 HardBreak <- <(((' ' ' ' (' ' / '\t')*) / '\\') NL LineContinues Sp)> */
+		nil,
+		/* 38 SoftBreak <- <(Sp NL LineContinues Sp)> */
+		nil,
+		/* 39 LineContinues <- <(!BlankLine !EOF !Interrupt !SetextUnderline)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{39, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position385, tokenIndex385 := position, tokenIndex
+			{
+				position386 := position
+				{
+					position387, tokenIndex387 := position, tokenIndex
+					if !_rules[ruleBlankLine]() {
+						goto l387
+					}
+					goto l385
+				l387:
+					position, tokenIndex = position387, tokenIndex387
+				}
+				{
+					position388, tokenIndex388 := position, tokenIndex
+					if !_rules[ruleEOF]() {
+						goto l388
+					}
+					goto l385
+				l388:
+					position, tokenIndex = position388, tokenIndex388
+				}
+				{
+					position389, tokenIndex389 := position, tokenIndex
+					if !_rules[ruleInterrupt]() {
+						goto l389
+					}
+					goto l385
+				l389:
+					position, tokenIndex = position389, tokenIndex389
+				}
+				{
+					position390, tokenIndex390 := position, tokenIndex
+					if !_rules[ruleSetextUnderline]() {
+						goto l390
+					}
+					goto l385
+				l390:
+					position, tokenIndex = position390, tokenIndex390
+				}
+				add(ruleLineContinues, position386)
+			}
+			memoize(39, position385, tokenIndex385, true)
+			return true
+		l385:
+			memoize(39, position385, tokenIndex385, false)
+			position, tokenIndex = position385, tokenIndex385
+			return false
+		},
+		/* 40 CodeSpan <- <('`' (('`' !'`' (!('`' '`') !(NL Sp NL) .)+ '`' '`') / (!'`' (!'`' !(NL Sp NL) .)+ '`')))> */
+		nil,
+		/* 41 Strong <- <(('*' '*' !Whitespace (!('*' '*') Inline)+ &{ !spaceBefore(buffer, int(position)) } ('*' '*')) / (&{ !wordBefore(buffer, int(position)) } ('_' '_') !Whitespace (!('_' '_') Inline)+ &{ !spaceBefore(buffer, int(position)) } ('_' '_') !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z]) [a-z]))))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{41, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position392, tokenIndex392 := position, tokenIndex
+			{
+				position393 := position
+				{
+					position394, tokenIndex394 := position, tokenIndex
+					if buffer[position] != rune('*') {
+						goto l395
+					}
+					position++
+					if buffer[position] != rune('*') {
+						goto l395
+					}
+					position++
+					{
+						position396, tokenIndex396 := position, tokenIndex
+						if !_rules[ruleWhitespace]() {
+							goto l396
+						}
+						goto l395
+					l396:
+						position, tokenIndex = position396, tokenIndex396
+					}
+					{
+						position399, tokenIndex399 := position, tokenIndex
+						if buffer[position] != rune('*') {
+							goto l399
+						}
+						position++
+						if buffer[position] != rune('*') {
+							goto l399
+						}
+						position++
+						goto l395
+					l399:
+						position, tokenIndex = position399, tokenIndex399
+					}
+					if !_rules[ruleInline]() {
+						goto l395
+					}
+				l397:
+					{
+						position398, tokenIndex398 := position, tokenIndex
+						{
+							position400, tokenIndex400 := position, tokenIndex
+							if buffer[position] != rune('*') {
+								goto l400
+							}
+							position++
+							if buffer[position] != rune('*') {
+								goto l400
+							}
+							position++
+							goto l398
+						l400:
+							position, tokenIndex = position400, tokenIndex400
+						}
+						if !_rules[ruleInline]() {
+							goto l398
+						}
+						goto l397
+					l398:
+						position, tokenIndex = position398, tokenIndex398
+					}
+					if !(!spaceBefore(buffer, int(position))) {
+						goto l395
+					}
+					if buffer[position] != rune('*') {
+						goto l395
+					}
+					position++
+					if buffer[position] != rune('*') {
+						goto l395
+					}
+					position++
+					goto l394
+				l395:
+					position, tokenIndex = position394, tokenIndex394
+					if !(!wordBefore(buffer, int(position))) {
+						goto l392
+					}
+					if buffer[position] != rune('_') {
+						goto l392
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l392
+					}
+					position++
+					{
+						position401, tokenIndex401 := position, tokenIndex
+						if !_rules[ruleWhitespace]() {
+							goto l401
+						}
+						goto l392
+					l401:
+						position, tokenIndex = position401, tokenIndex401
+					}
+					{
+						position404, tokenIndex404 := position, tokenIndex
+						if buffer[position] != rune('_') {
+							goto l404
+						}
+						position++
+						if buffer[position] != rune('_') {
+							goto l404
+						}
+						position++
+						goto l392
+					l404:
+						position, tokenIndex = position404, tokenIndex404
+					}
+					if !_rules[ruleInline]() {
+						goto l392
+					}
+				l402:
+					{
+						position403, tokenIndex403 := position, tokenIndex
+						{
+							position405, tokenIndex405 := position, tokenIndex
+							if buffer[position] != rune('_') {
+								goto l405
+							}
+							position++
+							if buffer[position] != rune('_') {
+								goto l405
+							}
+							position++
+							goto l403
+						l405:
+							position, tokenIndex = position405, tokenIndex405
+						}
+						if !_rules[ruleInline]() {
+							goto l403
+						}
+						goto l402
+					l403:
+						position, tokenIndex = position403, tokenIndex403
+					}
+					if !(!spaceBefore(buffer, int(position))) {
+						goto l392
+					}
+					if buffer[position] != rune('_') {
+						goto l392
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l392
+					}
+					position++
+					{
+						position406, tokenIndex406 := position, tokenIndex
+						{
+							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								position++
+							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+								position++
+							default:
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l406
+								}
+								position++
+							}
+						}
+
+						goto l392
+					l406:
+						position, tokenIndex = position406, tokenIndex406
+					}
+				}
+			l394:
+				add(ruleStrong, position393)
+			}
+			memoize(41, position392, tokenIndex392, true)
+			return true
+		l392:
+			memoize(41, position392, tokenIndex392, false)
+			position, tokenIndex = position392, tokenIndex392
+			return false
+		},
+		/* 42 Emphasis <- <(('*' !Whitespace (Strong / (!'*' Inline))+ &{ !spaceBefore(buffer, int(position)) } '*') / (&{ !wordBefore(buffer, int(position)) } '_' !Whitespace (Strong / (!'_' Inline))+ &{ !spaceBefore(buffer, int(position)) } '_' !((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail([0-9] [A-Z]) [a-z]))))> */
+		nil,
+		/* 43 Whitespace <- <(' ' / '\t' / '\r' / '\n' / EOF)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{43, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position409, tokenIndex409 := position, tokenIndex
+			{
+				position410 := position
+				{
+					position411, tokenIndex411 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l412
+					}
+					position++
+					goto l411
+				l412:
+					position, tokenIndex = position411, tokenIndex411
+					if buffer[position] != rune('\t') {
+						goto l413
+					}
+					position++
+					goto l411
+				l413:
+					position, tokenIndex = position411, tokenIndex411
+					if buffer[position] != rune('\r') {
+						goto l414
+					}
+					position++
+					goto l411
+				l414:
+					position, tokenIndex = position411, tokenIndex411
+					if buffer[position] != rune('\n') {
+						goto l415
+					}
+					position++
+					goto l411
+				l415:
+					position, tokenIndex = position411, tokenIndex411
+					if !_rules[ruleEOF]() {
+						goto l409
+					}
+				}
+			l411:
+				add(ruleWhitespace, position410)
+			}
+			memoize(43, position409, tokenIndex409, true)
+			return true
+		l409:
+			memoize(43, position409, tokenIndex409, false)
+			position, tokenIndex = position409, tokenIndex409
+			return false
+		},
+		/* 44 Link <- <('[' LinkText ']' '(' Sp LinkDestination ((' ' / '\t')+ LinkTitle)? Sp ')')> */
+		nil,
+		/* 45 Image <- <('!' '[' LinkText ']' '(' Sp LinkDestination ((' ' / '\t')+ LinkTitle)? Sp ')')> */
+		nil,
+		/* 46 LinkText <- <(!']' Inline)*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{46, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position418, tokenIndex418 := position, tokenIndex
+			{
+				position419 := position
+			l420:
+				{
+					position421, tokenIndex421 := position, tokenIndex
+					{
+						position422, tokenIndex422 := position, tokenIndex
+						if buffer[position] != rune(']') {
+							goto l422
+						}
+						position++
+						goto l421
+					l422:
+						position, tokenIndex = position422, tokenIndex422
+					}
+					if !_rules[ruleInline]() {
+						goto l421
+					}
+					goto l420
+				l421:
+					position, tokenIndex = position421, tokenIndex421
+				}
+				add(ruleLinkText, position419)
+			}
+			memoize(46, position418, tokenIndex418, true)
+			return true
+		},
+		/* 47 LinkDestination <- <(('<' (!((&('\n') '\n') | (&('\r') '\r') | (&('>') %fail('\n' '\r') '>')) .)* '>') / (!((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&('(') '(') | (&(')') ')') | (&(' ') %fail('\t' '\n' '\r' '(' ')') ' ')) .)*)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{47, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position423, tokenIndex423 := position, tokenIndex
+			{
+				position424 := position
+				{
+					position425, tokenIndex425 := position, tokenIndex
+					if buffer[position] != rune('<') {
+						goto l426
+					}
+					position++
+				l427:
+					{
+						position428, tokenIndex428 := position, tokenIndex
+						{
+							position429, tokenIndex429 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '\n':
+									position++
+								case '\r':
+									position++
+								default:
+									if buffer[position] != rune('>') {
+										goto l429
+									}
+									position++
+								}
+							}
+
+							goto l428
+						l429:
+							position, tokenIndex = position429, tokenIndex429
+						}
+						if !matchDot() {
+							goto l428
+						}
+						goto l427
+					l428:
+						position, tokenIndex = position428, tokenIndex428
+					}
+					if buffer[position] != rune('>') {
+						goto l426
+					}
+					position++
+					goto l425
+				l426:
+					position, tokenIndex = position425, tokenIndex425
+				l431:
+					{
+						position432, tokenIndex432 := position, tokenIndex
+						{
+							position433, tokenIndex433 := position, tokenIndex
+							{
+								switch buffer[position] {
+								case '\t':
+									position++
+								case '\n':
+									position++
+								case '\r':
+									position++
+								case '(':
+									position++
+								case ')':
+									position++
+								default:
+									if buffer[position] != rune(' ') {
+										goto l433
+									}
+									position++
+								}
+							}
+
+							goto l432
+						l433:
+							position, tokenIndex = position433, tokenIndex433
+						}
+						if !matchDot() {
+							goto l432
+						}
+						goto l431
+					l432:
+						position, tokenIndex = position432, tokenIndex432
+					}
+				}
+			l425:
+				add(ruleLinkDestination, position424)
+			}
+			memoize(47, position423, tokenIndex423, true)
+			return true
+		},
+		/* 48 LinkTitle <- <(('"' (!'"' .)* '"') / ('\'' (!'\'' .)* '\''))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{48, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position435, tokenIndex435 := position, tokenIndex
+			{
+				position436 := position
+				{
+					position437, tokenIndex437 := position, tokenIndex
+					if buffer[position] != rune('"') {
+						goto l438
+					}
+					position++
+				l439:
+					{
+						position440, tokenIndex440 := position, tokenIndex
+						{
+							position441, tokenIndex441 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l441
+							}
+							position++
+							goto l440
+						l441:
+							position, tokenIndex = position441, tokenIndex441
+						}
+						if !matchDot() {
+							goto l440
+						}
+						goto l439
+					l440:
+						position, tokenIndex = position440, tokenIndex440
+					}
+					if buffer[position] != rune('"') {
+						goto l438
+					}
+					position++
+					goto l437
+				l438:
+					position, tokenIndex = position437, tokenIndex437
+					if buffer[position] != rune('\'') {
+						goto l435
+					}
+					position++
+				l442:
+					{
+						position443, tokenIndex443 := position, tokenIndex
+						{
+							position444, tokenIndex444 := position, tokenIndex
+							if buffer[position] != rune('\'') {
+								goto l444
+							}
+							position++
+							goto l443
+						l444:
+							position, tokenIndex = position444, tokenIndex444
+						}
+						if !matchDot() {
+							goto l443
+						}
+						goto l442
+					l443:
+						position, tokenIndex = position443, tokenIndex443
+					}
+					if buffer[position] != rune('\'') {
+						goto l435
+					}
+					position++
+				}
+			l437:
+				add(ruleLinkTitle, position436)
+			}
+			memoize(48, position435, tokenIndex435, true)
+			return true
+		l435:
+			memoize(48, position435, tokenIndex435, false)
+			position, tokenIndex = position435, tokenIndex435
+			return false
+		},
+		/* 49 AutoLink <- <('<' URI '>')> */
+		nil,
+		/* 50 URI <- <(([a-z] / [A-Z]) ((&('+') '+') | (&('-') '-') | (&('.') '.') | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') %fail('+' '-' '.' [0-9] [A-Z]) [a-z]))+ ':' (!((&('\t') '\t') | (&('\n') '\n') | (&('\r') '\r') | (&('<') '<') | (&('>') '>') | (&(' ') %fail('\t' '\n' '\r' '<' '>') ' ')) .)*)> */
+		nil,
+		/* 51 Escaped <- <('\\' ((&('"') '"') | (&('#') '#') | (&('$') '$') | (&('%') '%') | (&('&') '&') | (&('\'') '\'') | (&('(') '(') | (&(')') ')') | (&('*') '*') | (&('+') '+') | (&(',') ',') | (&('-') '-') | (&('.') '.') | (&('/') '/') | (&(':') ':') | (&(';') ';') | (&('<') '<') | (&('=') '=') | (&('>') '>') | (&('?') '?') | (&('@') '@') | (&('[') '[') | (&('\\') '\\') | (&(']') ']') | (&('^') '^') | (&('_') '_') | (&('`') '`') | (&('{') '{') | (&('|') '|') | (&('}') '}') | (&('~') '~') | (&('!') %fail('"' '#' '$' '%' '&' '\'' '(' ')' '*' '+' ',' '-' '.' '/' ':' ';' '<' '=' '>' '?' '@' '[' '\\' ']' '^' '_' '`' '{' '|' '}' '~') '!')))> */
+		nil,
+		/* 52 Symbol <- <((&('!') '!') | (&('<') '<') | (&('[') '[') | (&('\\') '\\') | (&(']') ']') | (&('_') '_') | (&('`') '`') | (&('*') %fail('!' '<' '[' '\\' ']' '_' '`') '*'))> */
+		nil,
+		/* 53 BlankLine <- <((' ' / '\t')* NL)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{53, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position449, tokenIndex449 := position, tokenIndex
+			{
+				position450 := position
+			l451:
+				{
+					position452, tokenIndex452 := position, tokenIndex
+					{
+						position453, tokenIndex453 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l454
+						}
+						position++
+						goto l453
+					l454:
+						position, tokenIndex = position453, tokenIndex453
+						if buffer[position] != rune('\t') {
+							goto l452
+						}
+						position++
+					}
+				l453:
+					goto l451
+				l452:
+					position, tokenIndex = position452, tokenIndex452
+				}
+				if !_rules[ruleNL]() {
+					goto l449
+				}
+				add(ruleBlankLine, position450)
+			}
+			memoize(53, position449, tokenIndex449, true)
+			return true
+		l449:
+			memoize(53, position449, tokenIndex449, false)
+			position, tokenIndex = position449, tokenIndex449
+			return false
+		},
+		/* 54 NonIndentSpace <- <(' '? ' '? ' '?)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{54, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position455, tokenIndex455 := position, tokenIndex
+			{
+				position456 := position
+				{
+					position457, tokenIndex457 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l457
+					}
+					position++
+					goto l458
+				l457:
+					position, tokenIndex = position457, tokenIndex457
+				}
+			l458:
+				{
+					position459, tokenIndex459 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l459
+					}
+					position++
+					goto l460
+				l459:
+					position, tokenIndex = position459, tokenIndex459
+				}
+			l460:
+				{
+					position461, tokenIndex461 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l461
+					}
+					position++
+					goto l462
+				l461:
+					position, tokenIndex = position461, tokenIndex461
+				}
+			l462:
+				add(ruleNonIndentSpace, position456)
+			}
+			memoize(54, position455, tokenIndex455, true)
+			return true
+		},
+		/* 55 Sp <- <(' ' / '\t')*> */
+		func() bool {
+			memoized, ok := memoization[memoKey{55, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position463, tokenIndex463 := position, tokenIndex
+			{
+				position464 := position
+			l465:
+				{
+					position466, tokenIndex466 := position, tokenIndex
+					{
+						position467, tokenIndex467 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l468
+						}
+						position++
+						goto l467
+					l468:
+						position, tokenIndex = position467, tokenIndex467
+						if buffer[position] != rune('\t') {
+							goto l466
+						}
+						position++
+					}
+				l467:
+					goto l465
+				l466:
+					position, tokenIndex = position466, tokenIndex466
+				}
+				add(ruleSp, position464)
+			}
+			memoize(55, position463, tokenIndex463, true)
+			return true
+		},
+		/* 56 NL <- <((&('\r') %fail('\n') ('\r' ('\n' / ))) | (&('\n') %fail('\r\n' '\r') '\n'))> */
+		func() bool {
+			memoized, ok := memoization[memoKey{56, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position469, tokenIndex469 := position, tokenIndex
+			{
+				position470 := position
+				{
+					switch buffer[position] {
+					case '\r':
+						position++
+						{
+							position472, tokenIndex472 := position, tokenIndex
+							if buffer[position] != rune('\n') {
+								goto l473
+							}
+							position++
+							goto l472
+						l473:
+							position, tokenIndex = position472, tokenIndex472
+						}
+					l472:
+						break
+					default:
+						if buffer[position] != rune('\n') {
+							goto l469
+						}
+						position++
+					}
+				}
+
+				add(ruleNL, position470)
+			}
+			memoize(56, position469, tokenIndex469, true)
+			return true
+		l469:
+			memoize(56, position469, tokenIndex469, false)
+			position, tokenIndex = position469, tokenIndex469
+			return false
+		},
+		/* 57 EOL <- <(NL / EOF)> */
+		func() bool {
+			memoized, ok := memoization[memoKey{57, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position474, tokenIndex474 := position, tokenIndex
+			{
+				position475 := position
+				{
+					position476, tokenIndex476 := position, tokenIndex
+					if !_rules[ruleNL]() {
+						goto l477
+					}
+					goto l476
+				l477:
+					position, tokenIndex = position476, tokenIndex476
+					if !_rules[ruleEOF]() {
+						goto l474
+					}
+				}
+			l476:
+				add(ruleEOL, position475)
+			}
+			memoize(57, position474, tokenIndex474, true)
+			return true
+		l474:
+			memoize(57, position474, tokenIndex474, false)
+			position, tokenIndex = position474, tokenIndex474
+			return false
+		},
+		/* 58 EOF <- <!.> */
+		func() bool {
+			memoized, ok := memoization[memoKey{58, position}]
+			if ok {
+				return memoizedResult(memoized)
+			}
+			position478, tokenIndex478 := position, tokenIndex
+			{
+				position479 := position
+				{
+					position480, tokenIndex480 := position, tokenIndex
+					if !matchDot() {
+						goto l480
+					}
+					goto l478
+				l480:
+					position, tokenIndex = position480, tokenIndex480
+				}
+				add(ruleEOF, position479)
+			}
+			memoize(58, position478, tokenIndex478, true)
+			return true
+		l478:
+			memoize(58, position478, tokenIndex478, false)
+			position, tokenIndex = position478, tokenIndex478
+			return false
+		},
+	}
+	if p.maxDepth > 0 {
+		for i, rule := range _rules {
+			if rule == nil {
+				continue
+			}
+			rule := rule
+			_rules[i] = func() bool {
+				if depth++; p.maxDepth > 0 && depth > p.maxDepth {
+					panic(&depthError{p, position})
+				}
+				matches := rule()
+				depth--
+				return matches
+			}
+		}
+	}
+	p.rules = _rules
+	return nil
+}
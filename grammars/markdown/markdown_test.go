@@ -0,0 +1,75 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdown(t *testing.T) {
+	documents := []struct {
+		markdown, html string
+	}{
+		{"# Title\n", "<h1>Title</h1>\n"},
+		{"### Title ###\n", "<h3>Title</h3>\n"},
+		{"#Title\n", "<p>#Title</p>\n"},
+		{"Title\n=====\n\nSubtitle\n---\n", "<h1>Title</h1>\n<h2>Subtitle</h2>\n"},
+		{"***\n- - -\n", "<hr />\n<hr />\n"},
+		{"a\nb\n\nc", "<p>a\nb</p>\n<p>c</p>\n"},
+		{"a  \nb\\\nc", "<p>a<br />\nb<br />\nc</p>\n"},
+		{"```go\nif a < b {\n}\n```\n", "<pre><code class=\"language-go\">if a &lt; b {\n}\n</code></pre>\n"},
+		{"    code\n\n    more\n", "<pre><code>code\n\nmore\n</code></pre>\n"},
+		{"> # Quote\n> with *text*\nlazy\n", "<blockquote>\n<h1>Quote</h1>\n<p>with <em>text</em>\nlazy</p>\n</blockquote>\n"},
+		{"> > nested\n", "<blockquote>\n<blockquote>\n<p>nested</p>\n</blockquote>\n</blockquote>\n"},
+		{"- a\n- b\n", "<ul>\n<li>a</li>\n<li>b</li>\n</ul>\n"},
+		{"- a\n\n- b\n", "<ul>\n<li>\n<p>a</p>\n</li>\n<li>\n<p>b</p>\n</li>\n</ul>\n"},
+		{"3. a\n4. b\n", "<ol start=\"3\">\n<li>a</li>\n<li>b</li>\n</ol>\n"},
+		{"- a\n  - b\n    - c\n- d\n", "<ul>\n<li>a\n<ul>\n<li>b\n<ul>\n<li>c</li>\n</ul>\n</li>\n</ul>\n</li>\n<li>d</li>\n</ul>\n"},
+		{"1. a\n\n   b\n", "<ol>\n<li>\n<p>a</p>\n<p>b</p>\n</li>\n</ol>\n"},
+		{"- a\nlazy\n", "<ul>\n<li>a\nlazy</li>\n</ul>\n"},
+		{"*a* **b** _c_ __d__ *e **f** g*", "<p><em>a</em> <strong>b</strong> <em>c</em> <strong>d</strong> <em>e <strong>f</strong> g</em></p>\n"},
+		{"`a * b` `` ` ``", "<p><code>a * b</code> <code>`</code></p>\n"},
+		{"[a *b*](/url \"title\") ![c](d.png)", "<p><a href=\"/url\" title=\"title\">a <em>b</em></a> <img src=\"d.png\" alt=\"c\" /></p>\n"},
+		{"<https://example.com/a?b>", "<p><a href=\"https://example.com/a?b\">https://example.com/a?b</a></p>\n"},
+		{"\\*a\\* & <b>", "<p>*a* &amp; &lt;b&gt;</p>\n"},
+		// Lookbehind
+		{"snake_case_name", "<p>snake_case_name</p>\n"},
+		{"a * b *", "<p>a * b *</p>\n"},
+		{"*a *b*", "<p>*a <em>b</em></p>\n"},
+		// Error tolerance
+		{"*foo", "<p>*foo</p>\n"},
+		{"**foo*", "<p>*<em>foo</em></p>\n"},
+		{"[a](b", "<p>[a](b</p>\n"},
+		{"`a", "<p>`a</p>\n"},
+		{"```\nunclosed", "<pre><code>unclosed\n</code></pre>\n"},
+	}
+	for _, document := range documents {
+		m := &Markdown{Buffer: document.markdown}
+		m.Init()
+		if err := m.Parse(); err != nil {
+			t.Fatalf("%q: %v", document.markdown, err)
+		}
+		if html := m.HTML(); html != document.html {
+			t.Errorf("%q: got %q, expected %q", document.markdown, html, document.html)
+		}
+	}
+}
+
+func BenchmarkMarkdown(b *testing.B) {
+	document := strings.Repeat("# Heading\n\nSome *emphasis*, **strong** and `code` with a [link](/url).\n\n- item\n- item with _emphasis_\n\n> quote\n\n", 500)
+	m := &Markdown{Buffer: document}
+	m.Init()
+	b.SetBytes(int64(len(document)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		m.Reset()
+		if err := m.Parse(); err != nil {
+			b.Fatal(err)
+		}
+		m.HTML()
+	}
+}
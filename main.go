@@ -5,66 +5,259 @@
 package main
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
 	"runtime"
+	"strings"
 
+	"github.com/pointlander/peg/generator"
 	"github.com/pointlander/peg/tree"
 )
 
 //go:generate -command build go run build.go
-//go:generate build buildinfo
 //go:generate build peg
 
 var (
 	inline  = flag.Bool("inline", false, "parse rule inlining")
 	_switch = flag.Bool("switch", false, "replace if-else if-else like blocks with switch blocks")
 	// Avoid redefinition of built-in function print.
-	printFlag     = flag.Bool("print", false, "directly dump the syntax tree")
-	syntax        = flag.Bool("syntax", false, "print out the syntax tree")
-	noast         = flag.Bool("noast", false, "disable AST")
-	strict        = flag.Bool("strict", false, "treat compiler warnings as errors")
-	filename      = flag.String("output", "", "specify name of output file")
-	showVersion   = flag.Bool("version", false, "print the version and exit")
-	showBuildTime = flag.Bool("time", false, "show the last time `build.go buildinfo` was ran")
+	printFlag          = flag.Bool("print", false, "directly dump the syntax tree")
+	syntax             = flag.Bool("syntax", false, "print out the syntax tree")
+	noast              = flag.Bool("noast", false, "disable AST")
+	captures           = flag.Bool("captures", false, "record only the spans captured with < >, without the AST")
+	compactMemo        = flag.Bool("compact-memo", false, "store memoized failures as bit sets")
+	noMemoFail         = flag.Bool("nomemo-failures", false, "don't memoize rules failing to match")
+	noMemoSucc         = flag.Bool("nomemo-successes", false, "don't memoize rules matching")
+	memoRules          = flag.String("memo", "", "memoize the `rules`: all, marked with %memo, or none (default all, or marked if the grammar marks rules)")
+	dump               = flag.Bool("dump", false, "print the compiled grammar IR")
+	strict             = flag.Bool("strict", false, "treat compiler warnings as errors")
+	werror             = flag.Bool("Werror", false, "treat compiler warnings as errors, like -strict")
+	quiet              = flag.Bool("q", false, "don't print compiler warnings")
+	fix                = flag.Bool("fix", false, "fix the problems found by lint, or apply the changes of migrate, rewrite and reduce")
+	verbose            = flag.Bool("verbose", false, "report the optimizations made to the grammar")
+	ifChanged          = flag.Bool("if-changed", false, "don't write output files which didn't change")
+	force              = flag.Bool("force", false, "overwrite Go files which weren't generated")
+	filename           = flag.String("output", "", "specify name of output file")
+	packageName        = flag.String("package", "", "replace the package of the grammar with `name` in the generated files")
+	binary             = flag.String("o", os.DevNull, "the file written by the build command")
+	substituteRule     = flag.String("rule", "", "the `name` of the rule whose matches the rewrite command replaces")
+	substituteTemplate = flag.String("template", "$0", "the `text` replacing the matches of the rewrite command, with $0 for the match, $1 to $9 for its captures and ${Rule} for its first match of Rule")
+	slow               = flag.Duration("slow", 0, "the `duration` from which the reduce command counts a parse as a slowdown, instead of a failure")
+	stressDepth        = flag.Int("depth", 100, "the nesting depth of the input written by the stress command")
+	stressWidth        = flag.Int("width", 10, "the number of alternatives of the grammar written by the stress command")
+	stressSize         = flag.Int("size", 1<<20, "the size in bytes of the input written by the stress command")
+	checkSyntax        = flag.Bool("check-syntax", false, "only check the grammar, without generating code")
+	backend            = flag.String("backend", "", "generate the files with the backend `command` instead of Go")
+	license            = flag.String("license", "", "write the SPDX license `identifier` at the top of the generated files")
+	provenance         = flag.Bool("provenance", false, "record the version of peg, the hash of the grammar and the options in the generated files")
+	raceTest           = flag.Bool("race-test", false, "also write a test parsing the %sample, %accept and %reject inputs with parsers running in parallel, for go test -race")
+	cshared            = flag.Bool("cshared-wrapper", false, "also write a cgo wrapper exporting Parse for -buildmode=c-shared")
+	splitTokens        = flag.Bool("split-tokens", false, "write the rules and the tokens of the syntax tree to a _tokens.go file, apart from the parser")
+	bytesFlag          = flag.Bool("bytes", false, "generate a parser matching a []byte Buffer byte by byte, without converting it to runes")
+	noPrint            = flag.Bool("noprint", false, "leave out the methods printing the tokens and the syntax tree, and the imports only they use")
+	lineDirectives     = flag.Bool("line-directives", false, "mark the actions in the generated code with line directives, so that errors in actions point at the grammar")
+	lines              = flag.Bool("lines", false, "index the lines of the buffer, for Position and EndPosition of the tokens returning their lines and columns")
+	trace              = flag.Bool("trace", false, "generate the Trace and TraceWriter options reporting the rules entered and exited while parsing")
+	watchdogFlag       = flag.Bool("watchdog", false, "generate the Watchdog option reporting the slow rule invocations")
+	iterative          = flag.Bool("iterative", false, "match the rules in a loop with a stack of its own instead of Go calls, so that deep input can't exhaust the goroutine stack")
+	incremental        = flag.Bool("incremental", false, "generate Edit, parsing the buffer again after an edit while reusing the matches it didn't change")
+	streaming          = flag.Bool("streaming", false, "generate ParseReader, parsing an input read from an io.Reader as a series of matches of a rule")
+	concurrent         = flag.Bool("concurrent", false, "generate FindAllConcurrent, scanning parts of the buffer for the matches of a rule in parallel goroutines")
+	substitution       = flag.Bool("substitution", false, "generate Substitute, replacing the matches of a rule by a template like the rewrite command")
+	expected           = flag.Bool("expected", false, "track the terminals expected at the farthest failure for the syntax errors, and generate Completions and ParsePartial")
+	tolerant           = flag.Bool("tolerant", false, "generate Sanitize, editing invalid input at its failures until it parses")
+	typed              = flag.Bool("typed", false, "generate a struct for each rule with fields for the rules it references, and Typed building them from the syntax tree")
+	showVersion        = flag.Bool("version", false, "print the version and exit")
+	showBuildTime      = flag.Bool("time", false, "show the time of the commit peg was built from")
 )
 
+// disabledWarnings and errorWarnings are the warnings disabled, or treated as
+// errors, with -W and -Wno-<name>.
+var disabledWarnings, errorWarnings = make(map[string]bool), make(map[string]bool)
+
+// markers are the comments given with -marker.
+var markers []string
+
+// optimizationLevel is the level given with -O0, -O1 or -O2, or -1, and
+// disabledPasses are the optimization passes disabled with -fno-<pass>.
+var (
+	optimizationLevel = -1
+	disabledPasses    = make(map[string]bool)
+)
+
+func init() {
+	isWarning := func(name string) error {
+		for _, warning := range tree.Warnings {
+			if name == warning {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown warning %q, expected one of %v", name, strings.Join(tree.Warnings, ", "))
+	}
+	flag.Func("W", "enable the warning `name`, disable it with no-name, or treat it as an error with error=name", func(value string) error {
+		name, disable := strings.CutPrefix(value, "no-")
+		name, isError := strings.CutPrefix(name, "error=")
+		if err := isWarning(name); err != nil {
+			return err
+		}
+		switch {
+		case disable:
+			disabledWarnings[name] = true
+		case isError:
+			delete(disabledWarnings, name)
+			errorWarnings[name] = true
+		default:
+			delete(disabledWarnings, name)
+		}
+		return nil
+	})
+	flag.Func("marker", "also mark the generated files with the comment `text`, for tools not recognizing the marker of Go", func(text string) error {
+		markers = append(markers, text)
+		return nil
+	})
+	for _, warning := range tree.Warnings {
+		flag.BoolFunc("Wno-"+warning, "disable the warning "+warning, func(string) error {
+			disabledWarnings[warning] = true
+			return nil
+		})
+	}
+	for level, usage := range []string{
+		"disable all optimization passes",
+		"only run the passes which don't need -inline or -switch and keep the syntax tree, the default",
+		"run all optimization passes, like -inline -switch, and loop recursion",
+	} {
+		flag.BoolFunc(fmt.Sprintf("O%d", level), usage, func(string) error {
+			optimizationLevel = level
+			return nil
+		})
+	}
+	for _, pass := range tree.Passes {
+		flag.BoolFunc("fno-"+pass, "disable the optimization pass "+pass, func(string) error {
+			disabledPasses[pass] = true
+			return nil
+		})
+	}
+}
+
 func main() {
 	runtime.GOMAXPROCS(2)
 	flag.Parse()
 
 	if *showVersion {
-		if IS_TAGGED {
-			fmt.Println("version:", VERSION)
-		} else {
-			fmt.Printf("version: %s-%s\n", VERSION, COMMIT)
-		}
+		info := readBuildInfo()
+		fmt.Println("version:", info)
 		if *showBuildTime {
-			fmt.Println("time:", BUILDTIME)
+			if info.time == "" {
+				info.time = "unknown"
+			}
+			fmt.Println("time:", info.time)
 		}
 		return
 	}
 
-	if flag.NArg() != 1 {
+	c, args := lookupCommand(flag.Args())
+	if c == nil {
 		flag.Usage()
 		log.Fatalf("FILE: the peg file to compile")
 	}
-	file := flag.Arg(0)
+	c.run(args)
+}
+
+// generatorOptions returns the options of the generator given with the flags
+// for the grammar in file, with the optimization passes enabled by -inline,
+// -switch and -O, less those disabled by -O0 and -fno-<pass>.
+func generatorOptions(file string) generator.Options {
+	opts := generator.Options{
+		Inline:           *inline || optimizationLevel >= 2,
+		Switch:           *_switch || optimizationLevel >= 2,
+		NoAST:            *noast,
+		Captures:         *captures,
+		CompactMemo:      *compactMemo,
+		Bytes:            *bytesFlag,
+		Typed:            *typed,
+		NoPrint:          *noPrint,
+		Lines:            *lines,
+		Trace:            *trace,
+		Watchdog:         *watchdogFlag,
+		Iterative:        *iterative,
+		Incremental:      *incremental,
+		Streaming:        *streaming,
+		Concurrent:       *concurrent,
+		Substitution:     *substitution,
+		Tolerant:         *tolerant,
+		Expected:         *expected,
+		NoMemoFailures:   *noMemoFail,
+		NoMemoSuccesses:  *noMemoSucc,
+		Memo:             *memoRules,
+		Strict:           *strict || *werror,
+		Package:          *packageName,
+		DisabledWarnings: disabledWarnings,
+		ErrorWarnings:    errorWarnings,
+		DisabledPasses:   make(map[string]bool),
+		LoopRecursion:    optimizationLevel >= 2,
+		Dir:              filepath.Dir(file),
+		Grammar:          filepath.Base(file),
+	}
+	for _, pass := range tree.Passes {
+		opts.DisabledPasses[pass] = optimizationLevel == 0 || disabledPasses[pass]
+	}
+	if !*quiet {
+		opts.Warn = func(warning error) {
+			fmt.Fprintln(os.Stderr, warning)
+		}
+	}
+	return opts
+}
+
+// generate compiles the grammar in file, and writes the files of command.
+func generate(command, file string) {
+	if command == "stress" {
+		if err := writeStress(file, *stressDepth, *stressWidth, *stressSize, !*noast); err != nil {
+			log.Fatal(err)
+		}
+		file = filepath.Join(file, "stress.peg")
+	}
 
 	buffer, err := os.ReadFile(file)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if err = setGrammarFlags(flag.CommandLine, grammarFlags(string(buffer))); err != nil {
+		log.Fatalf("%v: %v", file, err)
+	}
 
-	p := &Peg{Tree: tree.New(*inline, *_switch, *noast), Buffer: string(buffer)}
-	_ = p.Init(Pretty(true), Size(1<<15))
-	if err := p.Parse(); err != nil {
-		log.Fatal(err)
+	p, err := generator.Parse(buffer, generatorOptions(file))
+	if err != nil {
+		if *checkSyntax {
+			fmt.Printf("%v: %v\n", file, err)
+			os.Exit(1)
+		}
+		log.Fatalf("%v: %v", file, err)
+	}
+	p.License, p.Markers = *license, markers
+	if *provenance {
+		p.Provenance = provenanceLine(file, buffer)
+	}
+
+	if *checkSyntax || command == "vet" {
+		check(p, file)
+		return
 	}
 
-	p.Execute()
+	if command == "fmt" {
+		formatGrammar(p, file)
+		return
+	}
 
 	if *printFlag {
 		p.Print()
@@ -73,18 +266,380 @@ func main() {
 		p.PrintSyntaxTree()
 	}
 
+	if command == "lint" {
+		lint(p, file)
+		return
+	}
+
+	if command == "migrate" {
+		migrate(p, file)
+		return
+	}
+
+	if command == "graph" {
+		if err := p.Graph(os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if command == "textmate" {
+		writeCompanion(strings.TrimSuffix(file, ".peg")+".tmLanguage.json", p.TextMate)
+		return
+	}
+
+	if *backend != "" {
+		runBackend(p, file)
+		return
+	}
+
 	if *filename == "" {
 		*filename = file + ".go"
 	}
-	out, err := os.OpenFile(*filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
-	if err != nil {
-		fmt.Printf("%v: %v\n", *filename, err)
+	p.Verbose = *verbose
+	p.SplitTokens = *splitTokens
+	if *lineDirectives {
+		p.LineFile = lineFile(file, *filename)
+	}
+	if command == "build" || command == "test" {
+		goCommand(p, file, command)
+		return
+	}
+
+	out := &bytes.Buffer{}
+	if err = p.Compile(*filename, os.Args, out); err != nil {
+		if out.Len() > 0 {
+			// The generated code is invalid, keep it for the error positions.
+			writeOutput(*filename, out.Bytes())
+		}
+		log.Fatal(err)
+	}
+	writeOutput(*filename, out.Bytes())
+	if *splitTokens {
+		writeCompanion(strings.TrimSuffix(*filename, ".go")+"_tokens.go", p.CompileTokens)
+	}
+
+	if *dump {
+		if err = p.Dump(os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if len(p.Benchmarks) > 0 || len(p.Samples) > 0 {
+		writeCompanion(strings.TrimSuffix(*filename, ".go")+"_bench_test.go", p.CompileBenchmarks)
+	}
+	if len(p.Tests) > 0 {
+		writeCompanion(strings.TrimSuffix(*filename, ".go")+"_rules_test.go", p.CompileTests)
+	}
+	if *raceTest {
+		writeCompanion(strings.TrimSuffix(*filename, ".go")+"_race_test.go", p.CompileRaceTest)
+	}
+	if *cshared {
+		writeCompanion(strings.TrimSuffix(*filename, ".go")+"_cshared.go", p.CompileCShared)
+	}
+	if command == "serve-api" {
+		writeCompanion(strings.TrimSuffix(*filename, ".go")+"_server.go", p.CompileServer)
+	}
+}
+
+// grammarFlags returns the options given in the header comments of the grammar
+// with //peg:flags or #peg:flags lines, before the package declaration.
+func grammarFlags(buffer string) []string {
+	var args []string
+	for _, line := range strings.Split(buffer, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		comment, ok := strings.CutPrefix(line, "//")
+		if !ok {
+			if comment, ok = strings.CutPrefix(line, "#"); !ok {
+				break
+			}
+		}
+		if options, ok := strings.CutPrefix(comment, "peg:flags"); ok && (options == "" || options[0] == ' ' || options[0] == '\t') {
+			args = append(args, strings.Fields(options)...)
+		}
+	}
+	return args
+}
+
+// setGrammarFlags sets the flags of set from args, the options given in the
+// grammar. Options given on the command line take precedence.
+func setGrammarFlags(set *flag.FlagSet, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	explicit := make(map[string]bool)
+	set.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+	grammar := flag.NewFlagSet("peg:flags", flag.ContinueOnError)
+	grammar.SetOutput(io.Discard)
+	set.VisitAll(func(f *flag.Flag) {
+		if explicit[f.Name] {
+			grammar.Var(ignoredValue{f.Value}, f.Name, f.Usage)
+		} else {
+			grammar.Var(f.Value, f.Name, f.Usage)
+		}
+	})
+	if err := grammar.Parse(args); err != nil {
+		return fmt.Errorf("peg:flags: %w", err)
+	}
+	if grammar.NArg() > 0 {
+		return fmt.Errorf("peg:flags: unexpected argument %q", grammar.Arg(0))
+	}
+	return nil
+}
+
+// ignoredValue is the value of a flag given on the command line, which
+// ignores the value given in the grammar.
+type ignoredValue struct {
+	flag.Value
+}
+
+func (ignoredValue) Set(string) error { return nil }
+
+func (v ignoredValue) IsBoolFlag() bool {
+	b, ok := v.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// provenanceLine describes how the files generated from the grammar in file
+// were generated: the version of peg, the hash of the grammar and the options
+// which differ from their defaults.
+func provenanceLine(file string, buffer []byte) string {
+	var opts []string
+	flag.VisitAll(func(f *flag.Flag) {
+		if f.Value.String() != f.DefValue {
+			opts = append(opts, "-"+f.Name+"="+f.Value.String())
+		}
+	})
+	line := fmt.Sprintf("Generated by peg %v from %v (sha256:%x)", readBuildInfo(), filepath.Base(file), sha256.Sum256(buffer))
+	if len(opts) > 0 {
+		line += " with " + strings.Join(opts, " ")
+	}
+	return line
+}
+
+// check reports the problems of the grammar in file found without compiling
+// it, and exits with status 1 if there are errors, or warnings treated as
+// errors.
+func check(p *generator.Peg, file string) {
+	failed := false
+	for _, problem := range p.Check() {
+		fails := p.Fails(problem)
+		if fails || !*quiet {
+			fmt.Printf("%v: %v\n", file, problem)
+		}
+		failed = failed || fails
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// lint reports the problems of the grammar in file, and fixes them with -fix.
+func lint(p *generator.Peg, file string) {
+	failed, fixed := false, false
+	for _, problem := range p.Lint() {
+		if *fix && errors.Is(problem, tree.ErrMissingEOF) {
+			p.FixMissingEOF()
+			fmt.Printf("%v: fixed: %v\n", file, problem)
+			fixed = true
+			continue
+		}
+		fmt.Printf("%v: %v\n", file, problem)
+		failed = true
+	}
+	if fixed {
+		if err := os.WriteFile(file, []byte(p.Buffer), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// formatGrammar rewrites the grammar in file as formatted by Format, and
+// prints the name of the file if it changed. The formatted grammar must
+// compile to the same rules.
+func formatGrammar(p *generator.Peg, file string) {
+	formatted := p.Format()
+	if formatted == p.Buffer {
 		return
 	}
-	defer out.Close()
+	q, err := generator.Parse([]byte(formatted), generator.Options{Dir: filepath.Dir(file)})
+	if err != nil {
+		log.Fatalf("%v: formatting broke the grammar: %v", file, err)
+	}
+	/* canonicalize changes the code of actions, and classes, but not what
+	   they do */
+	irs := []*tree.IR{p.IR(), q.IR()}
+	for _, ir := range irs {
+		canonicalIR(ir)
+	}
+	before, err := json.Marshal(irs[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+	after, err := json.Marshal(irs[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !bytes.Equal(before, after) {
+		log.Fatalf("%v: formatting changed the rules of the grammar", file)
+	}
+	if err = os.WriteFile(file, []byte(formatted), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(file)
+}
+
+// lineFile returns the path of the grammar file in the line directives of
+// the code generated from it in output, which is relative to output.
+func lineFile(file, output string) string {
+	grammar, err := filepath.Abs(file)
+	if err != nil {
+		return filepath.ToSlash(file)
+	}
+	dir, err := filepath.Abs(filepath.Dir(output))
+	if err != nil {
+		return filepath.ToSlash(grammar)
+	}
+	if rel, err := filepath.Rel(dir, grammar); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return filepath.ToSlash(grammar)
+}
+
+// goCommand runs go build or go test on the package of the parser generated
+// from file, without writing the parser or its benchmarks. Errors in actions
+// are reported at their lines in file.
+func goCommand(p *generator.Peg, file, command string) {
+	grammar, err := filepath.Abs(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+	output, err := filepath.Abs(*filename)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *binary != os.DevNull {
+		if *binary, err = filepath.Abs(*binary); err != nil {
+			log.Fatal(err)
+		}
+	}
 
-	p.Strict = *strict
+	p.LineFile = grammar
+	out := &bytes.Buffer{}
 	if err = p.Compile(*filename, os.Args, out); err != nil {
 		log.Fatal(err)
 	}
+
+	dir, err := os.MkdirTemp("", "peg")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	replace := make(map[string]string)
+	overlayFile := func(name string, content []byte) {
+		replacement := filepath.Join(dir, filepath.Base(name))
+		if err = os.WriteFile(replacement, content, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		replace[name] = replacement
+	}
+	overlayFile(output, out.Bytes())
+	if p.SplitTokens {
+		out.Reset()
+		if err = p.CompileTokens(out); err != nil {
+			log.Fatal(err)
+		}
+		overlayFile(strings.TrimSuffix(output, ".go")+"_tokens.go", out.Bytes())
+	}
+	if command == "test" && (len(p.Benchmarks) > 0 || len(p.Samples) > 0) {
+		out.Reset()
+		if err = p.CompileBenchmarks(out); err != nil {
+			log.Fatal(err)
+		}
+		overlayFile(strings.TrimSuffix(output, ".go")+"_bench_test.go", out.Bytes())
+	}
+	if command == "test" && len(p.Tests) > 0 {
+		out.Reset()
+		if err = p.CompileTests(out); err != nil {
+			log.Fatal(err)
+		}
+		overlayFile(strings.TrimSuffix(output, ".go")+"_rules_test.go", out.Bytes())
+	}
+	if command == "test" && *raceTest {
+		out.Reset()
+		if err = p.CompileRaceTest(out); err != nil {
+			log.Fatal(err)
+		}
+		overlayFile(strings.TrimSuffix(output, ".go")+"_race_test.go", out.Bytes())
+	}
+	overlay, err := json.Marshal(map[string]map[string]string{"Replace": replace})
+	if err != nil {
+		log.Fatal(err)
+	}
+	overlayJSON := filepath.Join(dir, "overlay.json")
+	if err = os.WriteFile(overlayJSON, overlay, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	args := []string{command, "-overlay", overlayJSON}
+	if command == "build" {
+		args = append(args, "-o", *binary)
+	}
+	build := exec.Command("go", append(args, ".")...)
+	build.Dir = filepath.Dir(output)
+	build.Stdout, build.Stderr = os.Stdout, os.Stderr
+	if err = build.Run(); err != nil {
+		os.RemoveAll(dir)
+		log.Fatal(err)
+	}
+}
+
+// runBackend generates the files of the grammar in file with the command given
+// with -backend, and writes them relative to the directory of the grammar.
+func runBackend(p *generator.Peg, file string) {
+	command := strings.Fields(*backend)
+	files, err := p.Backend(*filename, os.Args, command[0], command[1:]...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		writeOutput(filepath.Join(filepath.Dir(file), f.Name), []byte(f.Content))
+	}
+}
+
+// writeCompanion writes a file generated alongside the parser.
+func writeCompanion(name string, compile func(out io.Writer) error) {
+	out := &bytes.Buffer{}
+	if err := compile(out); err != nil {
+		log.Fatal(err)
+	}
+	writeOutput(name, out.Bytes())
+}
+
+// generated matches the comment marking generated Go files.
+var generated = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+// writeOutput writes a generated file. With -if-changed an identical file is
+// left alone, keeping its modification time. An existing Go file without the
+// marker of generated code is only replaced with -force, as it is likely
+// written by hand and named like the output by accident.
+func writeOutput(name string, content []byte) {
+	existing, err := os.ReadFile(name)
+	if err == nil && *ifChanged && bytes.Equal(existing, content) {
+		return
+	}
+	if err == nil && !*force && strings.HasSuffix(name, ".go") && !generated.Match(existing) {
+		log.Fatalf("%v: not overwriting a file which wasn't generated, use -force to replace it", name)
+	}
+	if err := os.WriteFile(name, content, 0o644); err != nil {
+		log.Fatalf("%v: %v", name, err)
+	}
 }
@@ -0,0 +1,140 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/pointlander/peg/generator"
+)
+
+// migration rewrites a grammar written for an older version of the syntax of
+// peg, whose meaning changed since, so that it keeps its meaning.
+type migration struct {
+	name        string
+	description string
+	apply       func(p *generator.Peg) string
+}
+
+var migrations = []migration{
+	{"literal-suffix", "separate literals from a following rule named s, now read as the suffix of case-sensitive literals", (*generator.Peg).MigrateLiteralSuffix},
+}
+
+// migrate prints the changes the migrations make to the grammar in file as a
+// unified diff, and writes them to file with -fix. The migrated grammar must
+// still parse.
+func migrate(p *generator.Peg, file string) {
+	original := p.Buffer
+	for _, m := range migrations {
+		migrated := m.apply(p)
+		if migrated == p.Buffer {
+			continue
+		}
+		fmt.Printf("%v: %v: %v\n", file, m.name, m.description)
+		q, err := generator.Parse([]byte(migrated), generator.Options{})
+		if err != nil {
+			log.Fatalf("%v: the grammar migrated by %v doesn't parse: %v", file, m.name, err)
+		}
+		p = q
+	}
+	if p.Buffer == original {
+		return
+	}
+	if err := writeDiff(os.Stdout, file, original, p.Buffer); err != nil {
+		log.Fatal(err)
+	}
+	if *fix {
+		if err := os.WriteFile(file, []byte(p.Buffer), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// writeDiff writes the changes from a to b as a unified diff with three lines
+// of context.
+func writeDiff(out io.Writer, name, a, b string) error {
+	x, y := strings.SplitAfter(a, "\n"), strings.SplitAfter(b, "\n")
+	if x[len(x)-1] == "" {
+		x = x[:len(x)-1]
+	}
+	if y[len(y)-1] == "" {
+		y = y[:len(y)-1]
+	}
+
+	/* lengths[i][j] is the length of the longest common subsequence of x[i:] and y[j:] */
+	lengths := make([][]int, len(x)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	type line struct {
+		kind byte
+		text string
+		i, j int
+	}
+	var lines []line
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			lines = append(lines, line{' ', x[i], i, j})
+			i, j = i+1, j+1
+		case i < len(x) && (j == len(y) || lengths[i+1][j] >= lengths[i][j+1]):
+			lines = append(lines, line{'-', x[i], i, j})
+			i++
+		default:
+			lines = append(lines, line{'+', y[j], i, j})
+			j++
+		}
+	}
+
+	w := &errWriter{w: out}
+	w.printf("--- %v\n+++ %v\n", name, name)
+	const context = 3
+	for begin := 0; begin < len(lines); {
+		if lines[begin].kind == ' ' {
+			begin++
+			continue
+		}
+		/* extend the hunk while changes are less than two contexts apart */
+		end := begin
+		for k := begin; k < len(lines) && k-end <= 2*context; k++ {
+			if lines[k].kind != ' ' {
+				end = k + 1
+			}
+		}
+		first, last := max(begin-context, 0), min(end+context, len(lines))
+		removed, added := 0, 0
+		for _, l := range lines[first:last] {
+			if l.kind != '+' {
+				removed++
+			}
+			if l.kind != '-' {
+				added++
+			}
+		}
+		w.printf("@@ -%v,%v +%v,%v @@\n", lines[first].i+1, removed, lines[first].j+1, added)
+		for _, l := range lines[first:last] {
+			w.printf("%c%v", l.kind, l.text)
+			if !strings.HasSuffix(l.text, "\n") {
+				w.printf("\n\\ No newline at end of file\n")
+			}
+		}
+		begin = last
+	}
+	return w.err
+}
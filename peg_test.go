@@ -2,9 +2,23 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/pointlander/peg/generator"
 	"github.com/pointlander/peg/tree"
 )
 
@@ -13,15 +27,15 @@ func TestCorrect(t *testing.T) {
 type T Peg {}
 Grammar <- !.
 `
-	p := &Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
 	_ = p.Init()
 	err := p.Parse()
 	if err != nil {
 		t.Error(err)
 	}
 
-	p = &Peg{Tree: tree.New(false, false, false), Buffer: buffer}
-	_ = p.Init(Size(1 << 15))
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
 	err = p.Parse()
 	if err != nil {
 		t.Error(err)
@@ -33,8 +47,8 @@ func TestNoSpacePackage(t *testing.T) {
 type T Peg {}
 Grammar <- !.
 `
-	p := &Peg{Tree: tree.New(false, false, false), Buffer: buffer}
-	_ = p.Init(Size(1 << 15))
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
 	err := p.Parse()
 	if err == nil {
 		t.Error("packagenospace was parsed without error")
@@ -47,8 +61,8 @@ package p
 typenospace Peg {}
 Grammar <- !.
 `
-	p := &Peg{Tree: tree.New(false, false, false), Buffer: buffer}
-	_ = p.Init(Size(1 << 15))
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
 	err := p.Parse()
 	if err == nil {
 		t.Error("typenospace was parsed without error")
@@ -61,18 +75,20 @@ func TestSame(t *testing.T) {
 		t.Error(err)
 	}
 
-	p := &Peg{Tree: tree.New(true, true, false), Buffer: string(buffer)}
-	_ = p.Init(Size(1 << 15))
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: string(buffer)}
+	_ = p.Init(generator.Size(1 << 15))
 	if err = p.Parse(); err != nil {
 		t.Error(err)
 	}
 
 	p.Execute()
+	p.GrammarFile = "peg.peg"
+	p.Expected = true
 
 	out := &bytes.Buffer{}
-	_ = p.Compile("peg.peg.go", []string{"./peg", "-inline", "-switch", "peg.peg"}, out)
+	_ = p.Compile("peg.peg.go", []string{"../peg", "-inline", "-switch", "-expected", "-output", "peg.peg.go", "../peg.peg"}, out)
 
-	bootstrap, err := os.ReadFile("peg.peg.go")
+	bootstrap, err := os.ReadFile("generator/peg.peg.go")
 	if err != nil {
 		t.Error(err)
 	}
@@ -113,8 +129,8 @@ Begin <- Begin 'x'
 	}
 
 	for i, buffer := range tt {
-		p := &Peg{Tree: tree.New(false, false, false), Buffer: buffer}
-		_ = p.Init(Size(1 << 15))
+		p := &generator.Peg{Tree: tree.New(false, false, true), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
 		if err := p.Parse(); err != nil {
 			t.Fatal(err)
 		}
@@ -134,6 +150,45 @@ Begin <- Begin 'x'
 	}
 }
 
+func TestWarningControls(t *testing.T) {
+	for _, test := range []struct {
+		name, rules string
+	}{
+		{tree.WarnUndefined, "Begin <- begin !.\n"},
+		{tree.WarnUnused, "Begin <- . !.\nunused <- 'unused'\n"},
+		{tree.WarnLeftRecursion, "Begin <- Begin 'x'\n"},
+	} {
+		compile := func(configure func(p *generator.Peg)) error {
+			p := &generator.Peg{Tree: tree.New(false, false, true), Buffer: "package main\ntype test Peg {}\n" + test.rules}
+			_ = p.Init(generator.Size(1 << 15))
+			if err := p.Parse(); err != nil {
+				t.Fatal(err)
+			}
+			p.Execute()
+			p.Quiet = true
+			configure(p)
+			return p.Compile("test.peg.go", []string{"peg"}, &bytes.Buffer{})
+		}
+
+		err := compile(func(p *generator.Peg) { p.ErrorWarnings = map[string]bool{test.name: true} })
+		var warning *tree.Warning
+		if !errors.As(err, &warning) || warning.Name != test.name {
+			t.Errorf("%v: got %v, expected the warning as an error", test.name, err)
+		}
+		err = compile(func(p *generator.Peg) {
+			p.Strict = true
+			p.DisabledWarnings = map[string]bool{test.name: true}
+		})
+		if err != nil {
+			t.Errorf("%v: unexpected error (%v) for a disabled warning", test.name, err)
+		}
+		err = compile(func(p *generator.Peg) { p.ErrorWarnings = map[string]bool{tree.WarnInternal: true} })
+		if err != nil {
+			t.Errorf("%v: unexpected error (%v) for another warning as an error", test.name, err)
+		}
+	}
+}
+
 func TestCJKCharacter(t *testing.T) {
 	buffer := `
 package main
@@ -143,132 +198,4354 @@ type DiceExprParser Peg {
 
 Expr <- 'CJK' / '汉字' / 'test'
 `
-	p := &Peg{Tree: tree.New(false, true, false), Buffer: buffer}
-	_ = p.Init(Size(1 << 15))
+	p := &generator.Peg{Tree: tree.New(false, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
 	err := p.Parse()
 	if err != nil {
 		t.Fatal("cjk character test failed")
 	}
 }
 
-var files = [...]string{
-	"peg.peg",
-	"grammars/c/c.peg",
-	"grammars/calculator/calculator.peg",
-	"grammars/fexl/fexl.peg",
-	"grammars/java/java_1_7.peg",
+func TestIn(t *testing.T) {
+	buffer := `
+package main
+
+type Keywords Peg {
+	Keywords map[string]bool
 }
 
-func BenchmarkInitOnly(b *testing.B) {
-	pegs := []string{}
-	for _, file := range files {
-		input, err := os.ReadFile(file)
-		if err != nil {
-			b.Error(err)
+Start <- Keyword / Identifier
+Keyword <- [a-z]+ &%in(p.Keywords)
+Identifier <- [a-z]+ !%in(p.Keywords)
+`
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+
+	out := &bytes.Buffer{}
+	p.Strict = true
+	if err := p.Compile("keywords.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	/* Keyword and Identifier are inlined into Start, each testing its own text */
+	begins := make(map[string]bool)
+	for _, match := range regexp.MustCompile(`\(p\.Keywords\)\[string\(buffer\[(position[0-9]+):position\]\)\]`).FindAllSubmatch(out.Bytes(), -1) {
+		begins[string(match[1])] = true
+	}
+	if len(begins) != 2 {
+		t.Fatalf("got the begin positions %v, expected one for each inlined rule", begins)
+	}
+	dump := &bytes.Buffer{}
+	if err := p.Dump(dump); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(dump.String(), "# inlined") != 2 {
+		t.Errorf("got\n%v\nexpected Keyword and Identifier to be inlined", dump)
+	}
+	runGenerated(t, map[string]string{
+		"keywords.peg.go": out.String(),
+		"keywords_test.go": `package main
+
+import "testing"
+
+func TestKeywords(t *testing.T) {
+	for _, test := range []struct {
+		keywords map[string]bool
+		input    string
+		expected pegRule
+	}{
+		{map[string]bool{"if": true}, "if", ruleKeyword},
+		{map[string]bool{"if": true}, "iff", ruleIdentifier},
+		{map[string]bool{"else": true}, "if", ruleIdentifier},
+		{nil, "if", ruleIdentifier},
+	} {
+		p := &Keywords{Buffer: test.input, Keywords: test.keywords}
+		p.Init()
+		if err := p.Parse(); err != nil {
+			t.Fatalf("%q: %v", test.input, err)
+		}
+		if got := p.AST().up.pegRule; got != test.expected {
+			t.Errorf("%q with %v: got %v, expected %v", test.input, test.keywords, rul3s[got], rul3s[test.expected])
 		}
-		pegs = append(pegs, string(input))
 	}
+}
+`,
+	}, nil)
+}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		for _, peg := range pegs {
-			p := &Peg{Tree: tree.New(true, true, false), Buffer: peg}
-			_ = p.Init(Size(1 << 15))
+func TestInlineActions(t *testing.T) {
+	buffer := `
+package main
+
+type Pairs Peg {
+	Pairs    [][2]string
+	Keywords map[string]bool
+}
+
+Start <- Pairs !.
+Pairs <- Pair (',' Pair)*
+Pair <- Key '=' Value { p.Pairs[len(p.Pairs)-1][1] = text }
+Key <- < [a-z]+ &%in(p.Keywords) > { p.Pairs = append(p.Pairs, [2]string{text}) }
+Value <- < [0-9]+ >
+`
+	for _, noast := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(true, false, noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		out := &bytes.Buffer{}
+		if err := p.Compile("pairs.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		dump := &bytes.Buffer{}
+		if err := p.Dump(dump); err != nil {
+			t.Fatal(err)
+		}
+		for _, rule := range []string{"Pairs", "Key", "Value", "Action0", "Action1"} {
+			if !regexp.MustCompile(`(?m)^[0-9]+ ` + rule + ` <- .* # inlined$`).MatchString(dump.String()) {
+				t.Errorf("noast %v: %v is not inlined in\n%v", noast, rule, dump)
+			}
 		}
 	}
 }
 
-func BenchmarkParse(b *testing.B) {
-	pegs := make([]*Peg, len(files))
-	for i, file := range files {
-		input, err := os.ReadFile(file)
-		if err != nil {
-			b.Error(err)
+func TestSemanticPredicates(t *testing.T) {
+	buffer := `
+package main
+
+type Nesting Peg {
+	depth, limit int
+}
+
+Start <- Group* !.
+Group <- Open Group* Close
+Open <- '(' &{ p.depth < p.limit } !{ p.depth++ }
+Close <- ')' !{ p.depth-- }
+`
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	p.Strict = true
+	if err := p.Compile("nesting.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"if !(p.depth < p.limit) {", "p.depth++", "p.depth--"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%q is missing", expected)
 		}
+	}
 
-		p := &Peg{Tree: tree.New(true, true, false), Buffer: string(input)}
-		_ = p.Init(Size(1 << 15))
-		pegs[i] = p
+	/* T * x; declares x if T is a typedef name, and multiplies otherwise */
+	buffer = `
+package main
+
+type Typedefs Peg {}
+
+%state { types string; begin uint32 }
+
+Start <- Spacing (Typedef / Declaration / Expression)* !.
+Typedef <- 'typedef' !IdChar Spacing Type !{ p.begin = position } Name !{ p.types += " " + string(buffer[p.begin:position]) + " " } Spacing ';' Spacing
+Declaration <- Type ('*' Spacing)? Name Spacing ';' Spacing
+Expression <- Name Spacing '*' Spacing Name Spacing ';' Spacing
+Type <- ('int' !IdChar / TypedefName) Spacing
+TypedefName <- !{ p.begin = position } Name &{ p.isType(string(buffer[p.begin:position])) }
+Name <- !Keyword [A-Za-z_] IdChar*
+Keyword <- ('int' / 'typedef') !IdChar
+IdChar <- [A-Za-z_0-9]
+Spacing <- ' '*
+`
+	p = &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
 	}
+	p.Execute()
+	out.Reset()
+	if err := p.Compile("typedefs.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	runGenerated(t, map[string]string{
+		"typedefs.peg.go": out.String(),
+		"typedefs_test.go": `package main
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		for _, peg := range pegs {
-			if err := peg.Parse(); err != nil {
-				b.Error(err)
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func (p *Typedefs) isType(name string) bool {
+	return strings.Contains(p.types, " "+name+" ")
+}
+
+func TestTypedefs(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		expected []pegRule
+	}{
+		{"typedef int T; T * x;", []pegRule{ruleTypedef, ruleDeclaration}},
+		{"int T; T * x;", []pegRule{ruleDeclaration, ruleExpression}},
+		{"typedef int T; T x; x * T;", []pegRule{ruleTypedef, ruleDeclaration, ruleExpression}},
+		{"typedef int T; typedef T U; U * x;", []pegRule{ruleTypedef, ruleTypedef, ruleDeclaration}},
+		{"T x;", nil},
+		{"typedef U T;", nil},
+	} {
+		p := &Typedefs{Buffer: test.input}
+		p.Init()
+		err := p.Parse()
+		if (err == nil) != (test.expected != nil) {
+			t.Errorf("%q: got %v", test.input, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		var statements []pegRule
+		for node := p.AST().up; node != nil; node = node.next {
+			if node.pegRule != ruleSpacing {
+				statements = append(statements, node.pegRule)
 			}
-			b.StopTimer()
-			peg.Reset()
-			b.StartTimer()
+		}
+		if !reflect.DeepEqual(statements, test.expected) {
+			t.Errorf("%q: got %v, expected %v", test.input, statements, test.expected)
 		}
 	}
 }
+`,
+	}, nil)
+}
 
-func BenchmarkResetAndParse(b *testing.B) {
-	pegs := make([]*Peg, len(files))
-	for i, file := range files {
-		input, err := os.ReadFile(file)
-		if err != nil {
-			b.Error(err)
-		}
+func TestState(t *testing.T) {
+	buffer := `
+package main
 
-		p := &Peg{Tree: tree.New(true, true, false), Buffer: string(input)}
-		_ = p.Init(Size(1 << 15))
-		pegs[i] = p
-	}
+type Nesting Peg {}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		for _, peg := range pegs {
-			if err := peg.Parse(); err != nil {
-				b.Error(err)
+%state { depth int }
+
+Start <- Group* Sum? !.
+Group <- '(' !{ p.depth++ } &{ p.depth <= 2 } Group* ')' !{ p.depth-- }
+Sum <- Sum '+' Number / Number
+Number <- [0-9]+
+`
+	for _, inline := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(inline, inline, false), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		out := &bytes.Buffer{}
+		if err := p.Compile("nesting.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		code := out.String()
+		for _, expected := range []string{
+			"type pegState struct {\n\tdepth int\n}",
+			"\tpegState\n",
+			"p.pegState = seed.State",
+		} {
+			if !strings.Contains(code, expected) {
+				t.Errorf("inline %v: %q is missing", inline, expected)
 			}
-			peg.Reset()
 		}
+		/* every saved state is restored */
+		saves := regexp.MustCompile(`(state[0-9]+) := p\.pegState`).FindAllStringSubmatch(code, -1)
+		if len(saves) == 0 {
+			t.Fatalf("inline %v: the state is never saved", inline)
+		}
+		for _, save := range saves {
+			if !strings.Contains(code, "p.pegState = "+save[1]+"\n") {
+				t.Errorf("inline %v: %v is never restored", inline, save[1])
+			}
+		}
+		runGenerated(t, map[string]string{
+			"nesting.peg.go": code,
+			"nesting_test.go": `package main
+
+import "testing"
+
+func TestNesting(t *testing.T) {
+	for input, valid := range map[string]bool{
+		"(())(())1+2": true,
+		"()(())":      true,
+		"((()))":      false,
+		"(()(()))":    false,
+	} {
+		p := &Nesting{Buffer: input}
+		p.Init()
+		if err := p.Parse(); (err == nil) != valid {
+			t.Errorf("%q: got %v, expected valid %v", input, err, valid)
+		}
+	}
+}
+`,
+		}, nil)
 	}
 }
 
-func BenchmarkInitAndParse(b *testing.B) {
-	strs := []string{}
-	for _, file := range files {
-		input, err := os.ReadFile(file)
-		if err != nil {
-			b.Error(err)
+func TestStateMemoized(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: `
+package p
+
+type T Peg {}
+
+%state { n int }
+
+Start <- A 'x' / A 'y' &{ p.n == 1 } !.
+A <- 'a' !{ p.n++ }
+`}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go": out.String(),
+		"t_test.go": `package p
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	p := &T{Buffer: "ay"}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if p.n != 1 {
+		t.Fatalf("got n == %v, expected 1", p.n)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestKeyword(t *testing.T) {
+	buffer := `
+package main
+
+type Keywords Peg {}
+
+Start <- Async / Identifier
+Async <- %keyword('async', "await")
+Identifier <- [a-z]+
+`
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+
+	out := &bytes.Buffer{}
+	p.Strict = true
+	if err := p.Compile("keywords.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, keyword := range []string{`matchKeyword("async")`, `matchKeyword("await")`, `"unicode"`} {
+		if !bytes.Contains(out.Bytes(), []byte(keyword)) {
+			t.Fatalf("%s missing from generated code", keyword)
 		}
-		strs = append(strs, string(input))
 	}
+	runGenerated(t, map[string]string{
+		"keywords.peg.go": out.String(),
+		"keywords_test.go": `package main
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		for _, str := range strs {
-			peg := &Peg{Tree: tree.New(true, true, false), Buffer: str}
-			_ = peg.Init(Size(1 << 15))
-			if err := peg.Parse(); err != nil {
-				b.Error(err)
+import "testing"
+
+func TestKeyword(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		expected pegRule
+	}{
+		{"async", ruleAsync},
+		{"await", ruleAsync},
+		{"async(", ruleAsync},
+		{"asyncx", ruleIdentifier},
+		{"async_", ruleIdentifier},
+		{"async1", ruleIdentifier},
+		{"asyncé", ruleIdentifier},
+		{"asy", ruleIdentifier},
+	} {
+		p := &Keywords{Buffer: test.input}
+		p.Init()
+		if err := p.Parse(); err != nil {
+			t.Fatalf("%q: %v", test.input, err)
+		}
+		if got := p.AST().up.pegRule; got != test.expected {
+			t.Errorf("%q: got %v, expected %v", test.input, rul3s[got], rul3s[test.expected])
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+func TestNotClass(t *testing.T) {
+	buffer := `
+package main
+
+type NotClass Peg {}
+
+Start <- '"' [^"\\a-c]* '"' / [^x]
+`
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+
+	out := &bytes.Buffer{}
+	p.Strict = true
+	if err := p.Compile("notclass.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	condition := `c == endSymbol || c == rune('"') || c == rune('\\') || (c >= rune('a') && c <= rune('c'))`
+	if !bytes.Contains(out.Bytes(), []byte(condition)) {
+		t.Fatal("negated class was not compiled to a single condition")
+	}
+	if bytes.Contains(out.Bytes(), []byte("matchDot")) {
+		t.Fatal("negated class should not use matchDot")
+	}
+	runGenerated(t, map[string]string{
+		"notclass.peg.go": out.String(),
+		"notclass_test.go": `package main
+
+import "testing"
+
+func TestNotClass(t *testing.T) {
+	for _, test := range []struct {
+		input string
+		end   int
+	}{
+		{"\"xyz\"", 5},
+		{"\"\"", 2},
+		{"\"xaz\"", 1},
+		{"\"x\\\\\"", 1},
+		{"é", 1},
+		{"a", 1},
+		{"x", -1},
+		{"", -1},
+	} {
+		p := &NotClass{Buffer: test.input}
+		p.Init()
+		if err := p.Parse(); err != nil {
+			if test.end != -1 {
+				t.Errorf("%q: %v", test.input, err)
 			}
+			continue
+		}
+		if end := int(p.AST().end); end != test.end {
+			t.Errorf("%q: matched up to %v, expected %v", test.input, end, test.end)
 		}
 	}
 }
+`,
+	}, nil)
+}
 
-func BenchmarkInitResetAndParse(b *testing.B) {
-	strs := []string{}
-	for _, file := range files {
-		input, err := os.ReadFile(file)
-		if err != nil {
-			b.Error(err)
+func TestUnicodeProperty(t *testing.T) {
+	buffer := `
+package main
+
+type Property Peg {}
+
+Start <- [\p{Uppercase_Letter}] / [\pN_] / [^\p{Greek}\p{Zs}] / [\P{Han}]
+`
+	p := &generator.Peg{Tree: tree.New(false, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Expected = true
+	out := &bytes.Buffer{}
+	if err := p.Compile("property.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, condition := range []string{
+		"!(unicode.Is(unicode.Lu, c))",
+		"!(unicode.Is(unicode.N, c))",
+		"c == endSymbol || unicode.Is(unicode.Greek, c) || unicode.Is(unicode.Zs, c)",
+		"!(c != endSymbol && !unicode.Is(unicode.Han, c))",
+		`fail("[\\p{Lu}]")`,
+		`"unicode"`,
+	} {
+		if !strings.Contains(out.String(), condition) {
+			t.Errorf("%s missing from generated code", condition)
 		}
-		strs = append(strs, string(input))
+	}
+	if strings.Contains(out.String(), "'Ā'") {
+		t.Error("the runes of a property should not be switch keys")
 	}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		for _, str := range strs {
-			peg := &Peg{Tree: tree.New(true, true, false), Buffer: str}
-			_ = peg.Init(Size(1 << 15))
-			if err := peg.Parse(); err != nil {
-				b.Error(err)
+	buffer = `
+package main
+
+type Property Peg {}
+
+Start <- Letter / NotLetter / NotGreek / NotHan
+Letter <- [\p{L}]
+NotLetter <- [\P{L}]
+NotGreek <- [^\p{Greek}\p{Zs}]
+NotHan <- [\P{Han}_]
+`
+	p = &generator.Peg{Tree: tree.New(false, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out.Reset()
+	if err := p.Compile("property.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	runGenerated(t, map[string]string{
+		"property.peg.go": out.String(),
+		"property_test.go": `package main
+
+import "testing"
+
+func TestProperty(t *testing.T) {
+	for _, test := range []struct {
+		rule    pegRule
+		matches string
+		fails   string
+	}{
+		{ruleLetter, "aé漢Ωß", "1 _-\u00a0"},
+		{ruleNotLetter, "1 _-\u00a0", "aé漢Ωß"},
+		{ruleNotGreek, "aé漢1_", "Ωα \u00a0"},
+		{ruleNotHan, "aéΩ1_", "漢字"},
+	} {
+		for _, c := range test.matches {
+			p := &Property{Buffer: string(c)}
+			p.Init()
+			if err := p.Parse(int(test.rule)); err != nil {
+				t.Errorf("%v should match %q: %v", rul3s[test.rule], c, err)
+			}
+		}
+		for _, c := range test.fails {
+			p := &Property{Buffer: string(c)}
+			p.Init()
+			if err := p.Parse(int(test.rule)); err == nil {
+				t.Errorf("%v should not match %q", rul3s[test.rule], c)
 			}
-			peg.Reset()
 		}
 	}
 }
+`,
+	}, nil)
+
+	buffer = `
+package main
+
+type Property Peg {}
+
+Start <- [\p{Klingon}]
+`
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	err := p.Compile("property.peg.go", []string{"peg"}, out)
+	if err == nil || !strings.Contains(err.Error(), "6:11: unknown Unicode property: \\p{Klingon}") {
+		t.Fatalf("got %v, expected an unknown property error", err)
+	}
+}
+
+func TestEscape(t *testing.T) {
+	buffer := `
+package main
+
+type Escape Peg {}
+
+Start <- '\x{1F600}' [\u00e9\x41] '\U0001F601' '\0' '\377' '\u00e9\x{2603}' !.
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("escape.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, character := range []string{"'😀'", "'é'", "'A'", "'😁'", `'\x00'`, "'ÿ'", "'☃'"} {
+		if !bytes.Contains(out.Bytes(), []byte(character)) {
+			t.Fatalf("%s missing from generated code", character)
+		}
+	}
+	runGenerated(t, map[string]string{
+		"escape.peg.go": out.String(),
+		"escape_test.go": `package main
+
+import "testing"
+
+func TestEscape(t *testing.T) {
+	for _, test := range []struct {
+		input string
+		ok    bool
+	}{
+		{"\U0001F600\u00e9\U0001F601\x00\u00ff\u00e9\u2603", true},
+		{"😀A😁\x00ÿé☃", true},
+		{"😀B😁\x00ÿé☃", false},
+		{"😀é😁0ÿé☃", false},
+		{"😀é😁\x00\xffé☃", false},
+		{"😀é😁\x00ÿe☃", false},
+		{"😀é😁\x00ÿé☃☃", false},
+	} {
+		p := &Escape{Buffer: test.input}
+		p.Init()
+		if err := p.Parse(); (err == nil) != test.ok {
+			t.Errorf("%q: got %v, expected a match %v", test.input, err, test.ok)
+		}
+	}
+}
+`,
+	}, nil)
+
+	buffer = `
+package main
+
+type Escape Peg {}
+
+Start <- '\x{110000}'
+	'\q'
+	'\uD800' [\U0000DFFF]
+`
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	err := p.Compile("escape.peg.go", []string{"peg"}, out)
+	if err == nil {
+		t.Fatal("expected invalid escape errors")
+	}
+	for _, message := range []string{
+		"6:14: invalid code point in escape sequence: 110000",
+		"7:3: unknown escape sequence: \\q",
+		"8:5: invalid code point in escape sequence: D800",
+		"8:14: invalid code point in escape sequence: 0000DFFF",
+	} {
+		if !strings.Contains(err.Error(), message) {
+			t.Fatalf("%q missing from %q", message, err)
+		}
+	}
+}
+
+func TestRawLiteral(t *testing.T) {
+	buffer := "package main\ntype Raw Peg {}\nStart <- `a\\\"'` `\\x41é` !.\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("raw.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte(`/* 0 Start <- <('a' '\\' '"' '\'' ('\\' 'x' '4' '1' 'é') !.)> */`)) {
+		t.Fatal("raw literal was not compiled")
+	}
+	runGenerated(t, map[string]string{
+		"raw.peg.go": out.String(),
+		"raw_test.go": `package main
+
+import "testing"
+
+func TestRaw(t *testing.T) {
+	for _, test := range []struct {
+		input string
+		ok    bool
+	}{
+		{"a\\\"'\\x41é", true},
+		{"a\\\"'\\x41\xc3\xa9", true},
+		{"a\"'\\x41é", false},
+		{"a\\\"'Aé", false},
+		{"a\\\"'\\x41e", false},
+	} {
+		p := &Raw{Buffer: test.input}
+		p.Init()
+		if err := p.Parse(); (err == nil) != test.ok {
+			t.Errorf("%q: got %v, expected a match %v", test.input, err, test.ok)
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	buffer := `
+package main
+
+type CaseInsensitive Peg {}
+
+%caseinsensitive
+
+Start <- 'as' 'if's "on"s ` + "`by`" + `
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("caseinsensitive.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	rule := `/* 0 Start <- <(('a' / 'A') ('s' / 'S' / 'ſ') ('i' 'f') ('o' 'n') ('b' 'y'))> */`
+	if !bytes.Contains(out.Bytes(), []byte(rule)) {
+		t.Fatal("literals were not compiled case-insensitive")
+	}
+
+	buffer = `
+package main
+
+type CaseInsensitive Peg {}
+
+%caseinsensitive Keyword Missing
+
+Start <- Keyword 'é' "ß" [[j-l]]
+Keyword <- 'é'
+`
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out.Reset()
+	var warnings []error
+	p.Report = func(warning error) { warnings = append(warnings, warning) }
+	if err := p.Compile("caseinsensitive.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, rule := range []string{
+		`/* 0 Start <- <(Keyword 'é' ('ß' / 'ẞ') ([j-l] / [J-L] / 'K'))> */`,
+		`/* 1 Keyword <- <('é' / 'É')> */`,
+	} {
+		if !bytes.Contains(out.Bytes(), []byte(rule)) {
+			t.Errorf("%s missing from generated code", rule)
+		}
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Error(), "unknown rule 'Missing' in %caseinsensitive") {
+		t.Errorf("got %v, expected the unknown rule", warnings)
+	}
+}
+
+func TestWord(t *testing.T) {
+	buffer := `
+package main
+
+type Word Peg {}
+
+%word [a-z_]
+
+Start <- ('in' / 'int' / '+') ' '? %keyword('x')
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("word.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	rule := `/* 0 Start <- <((('i' 'n' !([a-z] / '_')) / ('i' 'n' 't' !([a-z] / '_')) / '+') ' '? %keyword('x'))> */`
+	if !bytes.Contains(out.Bytes(), []byte(rule)) {
+		t.Fatal("word boundaries were not added to keyword literals")
+	}
+	if !bytes.Contains(out.Bytes(), []byte("(c >= rune('a') && c <= rune('z')) || c == rune('_')")) {
+		t.Fatal("%keyword does not use the word characters")
+	}
+	runGenerated(t, map[string]string{
+		"word.peg.go": out.String(),
+		"word_test.go": `package main
+
+import "testing"
+
+func TestWord(t *testing.T) {
+	for _, test := range []struct {
+		input string
+		ok    bool
+	}{
+		{"in x", true},
+		{"int x", true},
+		{"+x", true},
+		{"+x1", true},
+		{"+x(", true},
+		{"inx", false},
+		{"intx", false},
+		{"in_ x", false},
+		{"+xy", false},
+		{"+x_", false},
+		{"iffy x", false},
+	} {
+		p := &Word{Buffer: test.input}
+		p.Init()
+		if err := p.Parse(); (err == nil) != test.ok {
+			t.Errorf("%q: got %v, expected a match %v", test.input, err, test.ok)
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+func TestCShared(t *testing.T) {
+	for _, c := range []struct {
+		buffer string
+		noast  bool
+		ok     bool
+	}{
+		{"package main\ntype T Peg {}\nStart <- 'a'\n", false, true},
+		{"package main\ntype T Peg {}\nStart <- 'a'\n", true, false},
+		{"package p\ntype T Peg {}\nStart <- 'a'\n", false, false},
+	} {
+		p := &generator.Peg{Tree: tree.New(false, false, c.noast), Buffer: c.buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+		out := &bytes.Buffer{}
+		err := p.CompileCShared(out)
+		if c.ok != (err == nil) {
+			t.Fatalf("%q: unexpected result %v", c.buffer, err)
+		}
+		if c.ok && !bytes.Contains(out.Bytes(), []byte("//export Parse")) {
+			t.Fatal("Parse is not exported")
+		}
+	}
+}
+
+func TestServer(t *testing.T) {
+	for _, noast := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, noast), Buffer: "package p\ntype T Peg {}\nStart <- 'a'\n"}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+		out := &bytes.Buffer{}
+		err := p.CompileServer(out)
+		if noast != (err != nil) {
+			t.Fatalf("noast %v: unexpected result %v", noast, err)
+		}
+		if !noast && !bytes.Contains(out.Bytes(), []byte("func ParseHandler() http.Handler")) {
+			t.Fatal("ParseHandler is missing")
+		}
+	}
+}
+
+func TestTreeSchema(t *testing.T) {
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(tree.TreeSchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+	version := schema["properties"].(map[string]any)["version"].(map[string]any)["const"]
+	if version != float64(tree.TreeSchemaVersion) {
+		t.Fatalf("the schema has version %v, expected %v", version, tree.TreeSchemaVersion)
+	}
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\nStart <- Item+ !.\nItem <- [a-z] / 'é'\n"}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	parser, server := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, parser); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CompileServer(server); err != nil {
+		t.Fatal(err)
+	}
+	dir := runGenerated(t, map[string]string{
+		"t.peg.go":        parser.String(),
+		"t.peg_server.go": server.String(),
+		"t_test.go": `package p
+
+import (
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestResults(t *testing.T) {
+	var results []string
+	for _, input := range []string{"aé", "a1"} {
+		w := httptest.NewRecorder()
+		ParseHandler().ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(input)))
+		results = append(results, w.Body.String())
+	}
+	if err := os.WriteFile("results.json", []byte("["+strings.Join(results, ",")+"]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+`,
+	}, nil)
+	out, err := os.ReadFile(filepath.Join(dir, "results.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []any
+	if err := json.Unmarshal(out, &results); err != nil {
+		t.Fatal(err)
+	}
+	for i, result := range results {
+		if err := validateSchema(schema, schema, result); err != nil {
+			t.Errorf("result %d %v: %v", i, result, err)
+		}
+	}
+	if _, ok := results[0].(map[string]any)["tree"]; !ok {
+		t.Error("the tree of the first result is missing")
+	}
+	if _, ok := results[1].(map[string]any)["error"]; !ok {
+		t.Error("the error of the second result is missing")
+	}
+}
+
+// runGenerated runs go test on a module p, in a temporary directory which it
+// returns, holding files, the generated code and its tests by name. env is
+// added to the environment of go test, and flags to its arguments. The test
+// is skipped in short mode or without go.
+func runGenerated(t *testing.T, files map[string]string, env []string, flags ...string) string {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("the generated code is not run in short mode")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip(err)
+	}
+	dir := t.TempDir()
+	files["go.mod"] = "module p\n"
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command("go", append(append([]string{"test"}, flags...), ".")...)
+	cmd.Dir, cmd.Env = dir, append(os.Environ(), env...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+	return dir
+}
+
+// validateSchema returns an error if value doesn't match the JSON Schema s,
+// of which only the keywords used by tree.TreeSchema are checked. References
+// are resolved in root.
+func validateSchema(root, s map[string]any, value any) error {
+	if ref, ok := s["$ref"].(string); ok {
+		definition := root
+		for _, name := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			definition = definition[name].(map[string]any)
+		}
+		return validateSchema(root, definition, value)
+	}
+	if c, ok := s["const"]; ok && value != c {
+		return fmt.Errorf("%v is not %v", value, c)
+	}
+	switch s["type"] {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%v is not an object", value)
+		}
+		properties, _ := s["properties"].(map[string]any)
+		for _, name := range s["required"].([]any) {
+			if _, ok := object[name.(string)]; !ok {
+				return fmt.Errorf("%v is missing", name)
+			}
+		}
+		for name, v := range object {
+			property, ok := properties[name].(map[string]any)
+			if !ok {
+				if s["additionalProperties"] == false {
+					return fmt.Errorf("%v is unexpected", name)
+				}
+				continue
+			}
+			if err := validateSchema(root, property, v); err != nil {
+				return fmt.Errorf("%v: %w", name, err)
+			}
+		}
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%v is not an array", value)
+		}
+		for i, item := range array {
+			if err := validateSchema(root, s["items"].(map[string]any), item); err != nil {
+				return fmt.Errorf("%d: %w", i, err)
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%v is not a string", value)
+		}
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != float64(int64(number)) {
+			return fmt.Errorf("%v is not an integer", value)
+		}
+		if minimum, ok := s["minimum"].(float64); ok && number < minimum {
+			return fmt.Errorf("%v is less than %v", number, minimum)
+		}
+	}
+	return nil
+}
+
+func TestSplitTokens(t *testing.T) {
+	buffer := `
+package main
+
+import "go/token"
+
+type Tokens Peg {}
+
+%map Number = token.INT
+
+Start <- Number+ !.
+Number <- [0-9]+ ' '*
+`
+	for _, noast := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.SplitTokens = true
+		parser, tokens := &bytes.Buffer{}, &bytes.Buffer{}
+		if err := p.Compile("tokens.peg.go", []string{"peg"}, parser); err != nil {
+			t.Fatal(err)
+		}
+		if err := p.CompileTokens(tokens); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range []struct {
+			code             string
+			present, missing []string
+		}{
+			{parser.String(), []string{"type Tokens struct", `"sort"`}, []string{"type pegRule", "type token32", `"go/token"`}},
+			{tokens.String(), []string{"type pegRule", "type token32", "token.INT", `"go/token"`}, []string{"type Tokens struct", `"sort"`}},
+		} {
+			for _, expected := range c.present {
+				if !strings.Contains(c.code, expected) {
+					t.Errorf("noast %v: %q is missing", noast, expected)
+				}
+			}
+			for _, unexpected := range c.missing {
+				if strings.Contains(c.code, unexpected) {
+					t.Errorf("noast %v: %q is unexpected", noast, unexpected)
+				}
+			}
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\nStart <- 'a'\n"}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.CompileCompare(out); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "peg_compare_test.go", out.Bytes(), 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"func TestPegCompare(t *testing.T)", "p := &T{Buffer: string(buffer)}"} {
+		if !bytes.Contains(out.Bytes(), []byte(expected)) {
+			t.Fatalf("%s missing from the compare test", expected)
+		}
+	}
+}
+
+func TestSubstitute(t *testing.T) {
+	for _, noast := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, noast), Buffer: "package p\ntype T Peg {}\nStart <- 'a'\n"}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Substitution = !noast
+		if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+		out := &bytes.Buffer{}
+		err := p.CompileSubstitute(out)
+		if noast != (err != nil) {
+			t.Fatalf("noast %v: unexpected result %v", noast, err)
+		}
+		if noast {
+			continue
+		}
+		if _, err := parser.ParseFile(token.NewFileSet(), "peg_substitute_test.go", out.Bytes(), 0); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(out.Bytes(), []byte("p.Substitute(rule, os.Getenv(\"PEG_SUBSTITUTE_TEMPLATE\"))")) {
+			t.Fatal("the substitution is missing")
+		}
+	}
+}
+
+func TestReduce(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: `package p
+type T Peg {}
+Start <- List !.
+List <- '(' Item* ')'
+Item <- <[a-z]> { if text == "x" { panic("x") } } / List
+`}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Expected = true
+	out, test := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CompileReduce(test); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "peg_reduce_test.go", test.Bytes(), 0); err != nil {
+		t.Fatal(err)
+	}
+	for input, expected := range map[string]string{
+		"(ab(c)(de(f)": "(",
+		"(abxc)":       "(x)",
+	} {
+		dir := t.TempDir()
+		path, output := filepath.Join(dir, "input.txt"), filepath.Join(dir, "output.txt")
+		if err := os.WriteFile(path, []byte(input), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		runGenerated(t, map[string]string{
+			"t.peg.go":           out.String(),
+			"peg_reduce_test.go": test.String(),
+		}, []string{"PEG_REDUCE_INPUT=" + path, "PEG_REDUCE_OUTPUT=" + output})
+		reduced, err := os.ReadFile(output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(reduced) != expected {
+			t.Errorf("%q reduced to %q, expected %q", input, reduced, expected)
+		}
+	}
+}
+
+func TestTextMate(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- (Number / Comment / Identifier)* !.
+Number <- [0-9]+ ('.' [0-9]+)?
+Comment <- '#' [^\n]*
+Identifier <- [a-z] [a-z0-9]* { /* action */ }
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.TextMate(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`"scopeName": "source.lang"`,
+		`"match": "(?:[0-9])++(?:\\.(?:[0-9])++)?+"`,
+		`"name": "constant.numeric.lang"`,
+		`"match": "#(?:[^\\x{a}])*+"`,
+		`"name": "comment.line.lang"`,
+		`"match": "[a-z](?:[a-z0-9])*+"`,
+		`"name": "variable.other.lang"`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Fatalf("%s missing from %s", expected, out)
+		}
+	}
+	if strings.Contains(out.String(), `"#Start"`) {
+		t.Fatal("start rule is not lexical")
+	}
+}
+
+func TestMetaGrammar(t *testing.T) {
+	g := MetaGrammar()
+	rules := make(map[string]bool)
+	for _, n := range g.Slice() {
+		if n.GetType() == tree.TypeRule {
+			rules[n.String()] = true
+		}
+	}
+	for _, name := range []string{"Grammar", "Definition", "Expression", "Literal", "Class", "EndOfFile"} {
+		if !rules[name] {
+			t.Errorf("rule %s missing from the meta grammar", name)
+		}
+	}
+
+	if _, err := ParseGrammar("package main\ntype T Peg {}\nA <- 'a' /"); err != nil {
+		t.Error(err)
+	}
+	if _, err := ParseGrammar("package main\ntype T Peg {}\nA <- ("); err == nil {
+		t.Error("expected a parse error")
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	g, err := ParseGrammar(`
+package main
+
+type Lang Peg {}
+
+Start <- Number !.
+Number <- Digit
+Digit <- [0-9]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = g.RenameRule("Digit", "Digits"); err != nil {
+		t.Fatal(err)
+	}
+	if err = g.RenameRule("Number", "Start"); err == nil {
+		t.Error("expected an error renaming to an existing rule")
+	}
+	g.AddName("Digits")
+	g.AddPlus()
+	if err = g.ReplaceExpression("Number", g.PopFront()); err != nil {
+		t.Fatal(err)
+	}
+	g.AddCharacter("_")
+	if err = g.AppendRule("Separator", g.PopFront()); err != nil {
+		t.Fatal(err)
+	}
+	g.Rewrite(func(n tree.Node) tree.Node {
+		if n.GetType() == tree.TypePeekNot {
+			n.Front().SetType(tree.TypeName)
+			n.Front().SetString("Separator")
+		}
+		return n
+	})
+
+	names := 0
+	g.Walk(func(n tree.Node) bool {
+		if n.GetType() == tree.TypeName {
+			names++
+		}
+		return true
+	})
+	if names != 3 {
+		t.Errorf("got %d names, want 3", names)
+	}
+
+	out := &bytes.Buffer{}
+	if err = g.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"/* 0 Start <- <(Number !Separator)> */",
+		"/* 1 Number <- <Digits+> */",
+		"/* 2 Digits <- <[0-9]> */",
+		"/* 3 Separator <- <'_'> */",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from the generated parser", expected)
+		}
+	}
+}
+
+func TestDump(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- (Letter / Digit)+ Missing?
+Letter <- [a-z]
+Digit <- [0-9]
+Unused <- 'u'
+`
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if err := p.Dump(&bytes.Buffer{}); err == nil {
+		t.Error("expected an error dumping before Compile")
+	}
+	if err := p.Compile("", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.Dump(out); err != nil {
+		t.Fatal(err)
+	}
+	expected := `0 Start <- <((Letter / Digit)+ Missing?)>
+1 Letter <- <[a-z]> # inlined
+2 Digit <- <[0-9]> # inlined
+3 Unused <- <'u'> # unused
+5 Missing <- <> # undefined
+`
+	if out.String() != expected {
+		t.Errorf("got\n%s\nwant\n%s", out, expected)
+	}
+}
+
+func TestFoldPredicates(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- (A / B / C)* !.
+A <- !'a' 'b' [x-z]
+B <- &'a' 'c' / 'd'
+C <- &[0-9] [a-c] / &[0-9] 'x'
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if err := p.Compile("", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.Dump(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"1 A <- <('b' [x-z])>\n",
+		"2 B <- <'d'>\n",
+		"3 C <- <(&[0-9] 'x')>\n",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from\n%s", expected, out)
+		}
+	}
+}
+
+func TestLoopRightRecursion(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- List Tail Opt Ambiguous !.
+List <- Item ',' List / Item
+Item <- [0-9]+
+Tail <- 'a' Tail / 'b' Tail / 'c'
+Opt <- 'x' Opt /
+Ambiguous <- 'a' Ambiguous / 'ab'
+`
+	for _, loop := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+		p.LoopRecursion = loop
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		if err := p.Compile("", []string{"peg"}, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+		out := &bytes.Buffer{}
+		if err := p.Dump(out); err != nil {
+			t.Fatal(err)
+		}
+		expected := []string{
+			"1 List <- <((Item ',' List) / Item)>\n",
+			"3 Tail <- <(('a' Tail) / ('b' Tail) / 'c')>\n",
+		}
+		if loop {
+			expected = []string{
+				"1 List <- <(Item (',' Item)*)>\n",
+				"3 Tail <- <(('a' / 'b')* 'c')>\n",
+				"4 Opt <- <'x'*>\n",
+				"5 Ambiguous <- <(('a' Ambiguous) / ('a' 'b'))>\n",
+			}
+		}
+		for _, expected := range expected {
+			if !strings.Contains(out.String(), expected) {
+				t.Errorf("loop %v: %s missing from\n%s", loop, expected, out)
+			}
+		}
+	}
+}
+
+func TestLoopRecursionTree(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: `
+package p
+
+type T Peg {}
+
+Start <- List !.
+List <- Item ',' List / Item
+Item <- [0-9]+
+`}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go": out.String(),
+		"t_test.go": `package p
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	p := &T{Buffer: "1,2,3"}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	lists := 0
+	for _, token := range p.Tokens() {
+		if token.pegRule == ruleList {
+			lists++
+		}
+	}
+	if lists != 3 {
+		t.Fatalf("got %v List nodes, expected one per item", lists)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestLeftFactor(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- (Keyword / Number / Guarded)* !.
+Keyword <- 'foo' 'bar' / 'foo' 'baz' / 'for' / 'x'
+Number <- '0x' [0-9]+ / '0' / [1-9]
+Guarded <- &{ true } 'a' 'b' / &{ true } 'a' 'c'
+`
+	for _, disabled := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, true, false), Buffer: buffer}
+		p.DisabledPasses = map[string]bool{tree.PassLeftFactor: disabled}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		if err := p.Compile("", []string{"peg"}, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+		out := &bytes.Buffer{}
+		if err := p.Dump(out); err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []string{
+			"1 Keyword <- <(('f' 'o' (('o' 'b' 'a' ('r' / 'z')) / 'r')) / 'x')>\n",
+			"2 Number <- <(('0' (('x' [0-9]+) / )) / [1-9])>\n",
+		} {
+			if strings.Contains(out.String(), expected) == disabled {
+				t.Errorf("disabled %v: got\n%s", disabled, out)
+			}
+		}
+		if expected := "3 Guarded <- <((&{ true } 'a' 'b') / (&{ true } 'a' 'c'))>\n"; !strings.Contains(out.String(), expected) {
+			t.Errorf("disabled %v: %s missing from\n%s", disabled, expected, out)
+		}
+	}
+}
+
+func TestDisabledPasses(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- Tail (!'b' 'c' / 'd') !.
+Tail <- 'a' Tail / 'b'
+`
+	for _, c := range []struct {
+		disabled []string
+		expected []string
+	}{
+		{nil, []string{"0 Start <- <(Tail ('c' / 'd') !.)>\n", "1 Tail <- <('a'* 'b')>\n"}},
+		{[]string{tree.PassFoldPredicates}, []string{"0 Start <- <(Tail ((!'b' 'c') / 'd') !.)>\n", "1 Tail <- <('a'* 'b')>\n"}},
+		{[]string{tree.PassLoopRecursion}, []string{"0 Start <- <(Tail ('c' / 'd') !.)>\n", "1 Tail <- <(('a' Tail) / 'b')>\n"}},
+	} {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+		p.LoopRecursion = true
+		p.DisabledPasses = make(map[string]bool)
+		for _, pass := range c.disabled {
+			p.DisabledPasses[pass] = true
+		}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		if err := p.Compile("", []string{"peg"}, &bytes.Buffer{}); err != nil {
+			t.Fatal(err)
+		}
+		out := &bytes.Buffer{}
+		if err := p.Dump(out); err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range c.expected {
+			if !strings.Contains(out.String(), expected) {
+				t.Errorf("%v: %s missing from\n%s", c.disabled, expected, out)
+			}
+		}
+	}
+}
+
+func TestNoMemo(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+%nomemo failures A
+%nomemo successes B C
+
+Start <- A B C !.
+A <- 'a'
+B <- 'b'
+C <- 'c'
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	code := out.String()
+	for rule, memoized := range map[string]bool{
+		"0, position0, tokenIndex0, true":  true,
+		"0, position0, tokenIndex0, false": true,
+		"1, position3, tokenIndex3, true":  true,
+		"1, position3, tokenIndex3, false": false,
+		"2, position5, tokenIndex5, true":  false,
+		"2, position5, tokenIndex5, false": true,
+		"3, position7, tokenIndex7, true":  false,
+	} {
+		if strings.Contains(code, "memoize("+rule+")") != memoized {
+			t.Errorf("memoize(%s) should be generated: %v", rule, memoized)
+		}
+	}
+
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.NoMemoFailures, p.NoMemoSuccesses = true, true
+	out.Reset()
+	if err := p.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "memoize(0,") || strings.Contains(out.String(), "memoKey{0, position}") {
+		t.Error("memoization should be disabled")
+	}
+}
+
+func TestMemo(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+%memo A C
+
+Start <- A B C !.
+A <- 'a'
+B <- 'b'
+C <- 'c'
+`
+	for _, test := range []struct {
+		memo     string
+		memoized []bool
+	}{
+		{"", []bool{false, true, false, true}},
+		{"marked", []bool{false, true, false, true}},
+		{"all", []bool{true, true, true, true}},
+		{"none", []bool{false, false, false, false}},
+	} {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Memo = test.memo
+		out := &bytes.Buffer{}
+		if err := p.Compile("", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		for rule, memoized := range test.memoized {
+			if strings.Contains(out.String(), fmt.Sprintf("memoize(%d,", rule)) != memoized {
+				t.Errorf("%q: rule %d should be memoized: %v", test.memo, rule, memoized)
+			}
+		}
+	}
+
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Memo = "some"
+	if err := p.Compile("", []string{"peg"}, &bytes.Buffer{}); err == nil {
+		t.Error("unknown memoization accepted")
+	}
+
+	/* without memoization, a rule which can't fail doesn't save its position */
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: `
+package main
+
+type Lang Peg {}
+
+Start <- 'a'*
+`}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Memo = "none"
+	out := &bytes.Buffer{}
+	if err := p.Compile("lang.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	runGenerated(t, map[string]string{
+		"lang.peg.go": out.String(),
+		"lang_test.go": `package main
+
+import "testing"
+
+func TestLang(t *testing.T) {
+	p := &Lang{Buffer: "aaa"}
+	if err := p.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestMemoKey(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {
+	mode int
+}
+
+%memokey { p.mode } Word
+
+Start <- ( !{ p.mode = 0 } Word 'x' / !{ p.mode = 1 } Word 'y' ) !.
+Word <- &{ p.mode == 1 } 'a'
+`
+	for _, compact := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.CompactMemo = compact
+		out := &bytes.Buffer{}
+		if err := p.Compile("", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		code := out.String()
+		expected := []string{
+			"State    uint64",
+			"memoState := uint64(p.mode)",
+			"memoize(0, position0, tokenIndex0, true, 0)",
+			"memoize(1, position5, tokenIndex5, false, memoState)",
+		}
+		if compact {
+			expected = append(expected, "lookupMemo(1, memoState)", "lookupMemo(0, 0)")
+		} else {
+			expected = append(expected, "memoKey{1, position, memoState}", "memoKey{0, position, 0}")
+		}
+		for _, e := range expected {
+			if !strings.Contains(code, e) {
+				t.Errorf("compact %v: %q is missing", compact, e)
+			}
+		}
+	}
+
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: strings.Replace(buffer, "} Word\n", "} Word Missing\n", 1)}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	var warning *tree.Warning
+	if problems := p.Check(); len(problems) != 1 || !errors.As(problems[0], &warning) || warning.Name != tree.WarnNoMemo {
+		t.Errorf("got %v, expected the unknown rule in %%memokey", problems)
+	}
+}
+
+func TestKinds(t *testing.T) {
+	buffer := `
+package main
+
+import "example.com/lexer"
+
+type Lang Peg {}
+
+%map Identifier = lexer.IDENT
+%map Number = lexer.NUMBER
+
+Start <- (Identifier / Number)* !.
+Identifier <- [a-z]+
+Number <- [0-9]+
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"var ruleKind = pegRuleKinds(",
+		"[]pegRule{ruleIdentifier, ruleNumber},",
+		"lexer.IDENT, lexer.NUMBER,",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%q is missing", expected)
+		}
+	}
+
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: strings.Replace(buffer, "%map Number", "%map Missing", 1)}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	var warning *tree.Warning
+	if problems := p.Check(); len(problems) != 1 || !errors.As(problems[0], &warning) || warning.Name != tree.WarnUndefined {
+		t.Errorf("got %v, expected the unknown rule in %%map", problems)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	buffer := `
+package main
+
+import "go/ast"
+
+type Calc Peg {}
+
+Start <- Sum !.
+Sum <- Number Op Number -> *ast.BinaryExpr{X: $1.(ast.Expr), Op: op($2.(string)), Y: $3.(ast.Expr)}
+Op <- < [+\-] >
+Number <- [0-9]+ -> *ast.BasicLit{Kind: token.INT, Value: $0}
+`
+	parse := func(noast bool) *generator.Peg {
+		p := &generator.Peg{Tree: tree.New(true, true, noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		return p
+	}
+	p := parse(false)
+	if build := p.IR().Rules[3].Build; build != "&ast.BasicLit{Kind: token.INT, Value: $0}" {
+		t.Errorf("got %q, expected the value of Number", build)
+	}
+	out := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"func (p *Calc) Build() any {",
+		"case ruleSum:\n\t\treturn &ast.BinaryExpr{X: child(1).(ast.Expr), Op: op(child(2).(string)), Y: child(3).(ast.Expr)}",
+		"case ruleNumber:\n\t\treturn &ast.BasicLit{Kind: token.INT, Value: text}",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%q is missing", expected)
+		}
+	}
+
+	if err := parse(true).Compile("", []string{"peg"}, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "requires the AST") {
+		t.Errorf("got %v, expected -> to require the AST", err)
+	}
+}
+
+func TestInclude(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"common.peg":  "# shared rules\nSpacing <- (' ' / Comment)*\nComment <- '#' (!'\\n' .)* '\\n'\nString <- '\"' (!'\"' .)* '\"' Spacing\n",
+		"grammar.peg": "package main\ntype T Peg {}\nStart <- 'y'\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	load := func(buffer string) (*generator.Peg, error) {
+		return generator.Parse([]byte(buffer), generator.Options{Dir: dir})
+	}
+
+	p, err := load(`package main
+type T Peg {}
+%include "common.peg" String = Quoted
+Start <- Spacing (Quoted / String)+ !.
+String <- 'x' Spacing
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = p.Compile("", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err = p.Dump(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"0 Start <- <(Spacing (Quoted / String)+ !.)>\n",
+		"3 Comment <- <('#' (!'\\n' .)* '\\n')>\n",
+		"4 Quoted <- <('\"' (!'\"' .)* '\"' Spacing)>\n",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from\n%s", expected, out)
+		}
+	}
+
+	for buffer, expected := range map[string]string{
+		"package main\ntype T Peg {}\n%include \"common.peg\"\nString <- 'x'\n":                   "rename it with String = NewName",
+		"package main\ntype T Peg {}\n%include \"common.peg\" Missing = Other\nStart <- String\n": "rule Missing does not exist",
+		"package main\ntype T Peg {}\n%include \"grammar.peg\"\nStart <- 'x'\n":                   "without a package declaration",
+	} {
+		if _, err = load(buffer); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got %v, expected %v", err, expected)
+		}
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	buffer := `
+package main
+
+type Recovery Peg {}
+
+%recovery Missing Invalid
+
+Start <- (Statement / Invalid)* !.
+Statement <- [a-z]+ (';' / Missing)
+Missing <- !';'
+Invalid <- (![a-z] .)+
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"case ruleInvalid, ruleMissing:",
+		"func (p *Recovery) CheckRecovery(input string, expected []Diagnostic, present ...pegRule) error {",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%q is missing", expected)
+		}
+	}
+
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: strings.Replace(buffer, "%recovery Missing", "%recovery Absent", 1)}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	var warning *tree.Warning
+	if problems := p.Check(); len(problems) != 1 || !errors.As(problems[0], &warning) || warning.Name != tree.WarnUndefined {
+		t.Errorf("got %v, expected the unknown rule in %%recovery", problems)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	buffer := `
+package main
+
+type Recover Peg {}
+
+Start <- Statement* !.
+Statement <- %recover(Assign, ';') ';'
+Assign <- [a-z]+ '=' [0-9]+
+`
+	parse := func(noast bool) *generator.Peg {
+		p := &generator.Peg{Tree: tree.New(true, true, noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		return p
+	}
+	p := parse(false)
+	out := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"func (p *Recover) Recovered() SyntaxErrors {",
+		"add(rulePegRecovered, position",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%q is missing", expected)
+		}
+	}
+	dump := &bytes.Buffer{}
+	if err := p.Dump(dump); err != nil {
+		t.Fatal(err)
+	}
+	expected := `1 Statement <- <(!{beginRecovery()} ((Assign !{matchedRecovery()}) / (!{failedRecovery()} <(!';' .)*> &{skippedRecovery()})) ';')>`
+	if !strings.Contains(dump.String(), expected) {
+		t.Errorf("got\n%v\nexpected %v", dump, expected)
+	}
+
+	if err := parse(true).Compile("", []string{"peg"}, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "requires the AST") {
+		t.Errorf("got %v, expected %%recover to require the AST", err)
+	}
+}
+
+func TestCaptures(t *testing.T) {
+	buffer := `
+package main
+
+type Log Peg {}
+
+Line <- Field (' ' Field)* !.
+Field <- Key '=' Value
+Key <- < [a-z]+ >
+Value <- < [0-9]+ >
+`
+	for _, noast := range []bool{true, false} {
+		p := &generator.Peg{Tree: tree.New(true, false, noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Captures = true
+		out := &bytes.Buffer{}
+		err := p.Compile("", []string{"peg"}, out)
+		if !noast {
+			if err == nil {
+				t.Error("captures were recorded along with the AST")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []string{
+			"func (p *Log) Captures() []token32",
+			"capture(ruleKey, begin)",
+			"capture(ruleValue, begin)",
+		} {
+			if !strings.Contains(out.String(), expected) {
+				t.Errorf("%q is missing", expected)
+			}
+		}
+	}
+}
+
+func TestLeftRecursion(t *testing.T) {
+	buffer := `
+package main
+
+type Calculator Peg {}
+
+Start <- Expression !.
+Expression <- Expression '+' Term / Term
+Term <- Term '*' Factor / Factor
+Factor <- '(' Expression ')' / [0-9]+
+`
+	for _, noast := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(true, true, noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Quiet, p.Strict = true, true
+		out := &bytes.Buffer{}
+		err := p.Compile("", []string{"peg"}, out)
+		if noast {
+			if err == nil {
+				t.Error("left recursion without the AST isn't reported")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []string{
+			"growSeed := func(rule uint32, body func() bool) bool",
+			"if !growSeed(1, func() bool {",
+			"if !growSeed(2, func() bool {",
+		} {
+			if !strings.Contains(out.String(), expected) {
+				t.Errorf("%q is missing", expected)
+			}
+		}
+		if strings.Contains(out.String(), "if !growSeed(3,") {
+			t.Error("the rule Factor grows a seed")
+		}
+	}
+}
+
+func TestHint(t *testing.T) {
+	for _, test := range []struct {
+		rules string
+		ok    bool
+	}{
+		{`Start <- '(' Start %hint "unbalanced \"(\"" ')' / 'x'`, true},
+		{`Start <- 'x' %hint "\q" 'y'`, false},
+	} {
+		p := &generator.Peg{Tree: tree.New(false, true, false), Buffer: "package main\ntype T Peg {}\n" + test.rules}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Quiet = true
+		out := &bytes.Buffer{}
+		err := p.Compile("", []string{"peg"}, out)
+		if !test.ok {
+			if err == nil {
+				t.Errorf("%q: the invalid hint was accepted", test.rules)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []string{
+			`hint = "unbalanced \"(\""`,
+			"func (e *SyntaxError) Hint() string",
+		} {
+			if !strings.Contains(out.String(), expected) {
+				t.Errorf("%q is missing", expected)
+			}
+		}
+	}
+}
+
+func TestCompactMemo(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- A !.
+A <- 'a'
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.CompactMemo = true
+	out := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"failures[memoKey{rule, begin / 64}] |= 1 << (begin % 64)",
+		"if memoized, ok := lookupMemo(1); ok {",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from the generated parser", expected)
+		}
+	}
+	if strings.Contains(out.String(), "memo{Matched: false}\n") {
+		t.Error("failures should not be stored in the memo map")
+	}
+}
+
+func TestBench(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\n%bench Start `aab`\n%bench List file(\"testdata/list.txt\")\nStart <- 'a'* List\nList <- 'b'+\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.CompileBenchmarks(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"func BenchmarkStart(b *testing.B) {",
+		`buffer := "aab"`,
+		"func BenchmarkList(b *testing.B) {",
+		`os.ReadFile("testdata/list.txt")`,
+		"p.Parse(int(ruleList))",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from\n%s", expected, out)
+		}
+	}
+}
+
+func TestSample(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\n%sample `aab`\n%sample file(\"testdata/list.txt\")\nStart <- 'a'* List\nList <- 'b'+\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.CompileBenchmarks(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"//go:embed testdata/list.txt\nvar sample1 string",
+		`{"sample0", "aab"},`,
+		`{"list.txt", sample1},`,
+		"func BenchmarkParse(b *testing.B) {",
+		"func BenchmarkReset(b *testing.B) {",
+		"b.ReportAllocs()",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from\n%s", expected, out)
+		}
+	}
+	if _, err := format.Source(out.Bytes()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRuleTests(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\n%accept Start `aab`\n%reject Start `ac` 1:2\n%reject List `a`\nStart <- 'a'* List\nList <- 'b'+\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.CompileTests(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"func TestRules(t *testing.T) {",
+		`{"Start/0", "aab", ruleStart, false, 0, 0},`,
+		`{"Start/1", "ac", ruleStart, true, 1, 2},`,
+		`{"List/2", "a", ruleList, true, 0, 0},`,
+		"errors.As(err, &syntaxError)",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from\n%s", expected, out)
+		}
+	}
+	if _, err := format.Source(out.Bytes()); err != nil {
+		t.Error(err)
+	}
+
+	p = &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\n%reject Missing `a`\nStart <- 'a'\n"}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.CompileTests(&bytes.Buffer{}); err == nil || err.Error() != "unknown rule 'Missing' in %reject" {
+		t.Errorf("got %v, expected the unknown rule", err)
+	}
+}
+
+func TestGlobalState(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\n%map Start = kindStart\nStart <- < Word > { _ = text } !.\nWord <- [a-z]+ / '(' Start ')'\n"
+	shared := map[string]bool{"rul3s": true, "ruleSources": true, "ruleKind": true}
+	for _, options := range []struct{ inline, noast, bytes, lines, typed bool }{
+		{}, {inline: true}, {noast: true}, {bytes: true, lines: true}, {typed: true},
+	} {
+		p := &generator.Peg{Tree: tree.New(options.inline, options.inline, options.noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Bytes, p.Lines, p.Typed = options.bytes, options.lines, options.typed
+		out := &bytes.Buffer{}
+		if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), "t.peg.go", out.Bytes(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.VAR {
+				for _, spec := range decl.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						if !shared[name.Name] {
+							t.Errorf("package variable %v generated with %+v", name.Name, options)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestRaceTest(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\n%sample `ab\ncd`\n%accept Word `abc`\n%reject Start `ab1`\nStart <- (Word / '\\n')* !.\nWord <- < [a-z]+ >\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	code := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, code); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.CompileRaceTest(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"func TestRace(t *testing.T) {",
+		`{"sample0", "ab\ncd", "", ruleStart},`,
+		`{"Word/0", "abc", "", ruleWord},`,
+		`{"Start/1", "ab1", "", ruleStart},`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from\n%s", expected, out)
+		}
+	}
+	if _, err := format.Source(out.Bytes()); err != nil {
+		t.Error(err)
+	}
+
+	q := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\nStart <- 'a'\n"}
+	_ = q.Init(generator.Size(1 << 15))
+	if err := q.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	q.Execute()
+	if err := q.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.CompileRaceTest(&bytes.Buffer{}); err == nil || err.Error() != "the race test requires inputs given with %sample, %accept or %reject" {
+		t.Errorf("got %v, expected the missing inputs", err)
+	}
+
+	var flags []string
+	if cgo, err := exec.Command("go", "env", "CGO_ENABLED").Output(); err == nil && strings.TrimSpace(string(cgo)) == "1" {
+		flags = append(flags, "-race")
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go":           code.String(),
+		"t.peg_race_test.go": out.String(),
+	}, nil, flags...)
+}
+
+// TestBackendProcess is the backend run by TestBackend. It writes the names
+// of the rules and the types of their expressions.
+func TestBackendProcess(t *testing.T) {
+	if os.Getenv("PEG_TEST_BACKEND") != "1" {
+		t.Skip("run by TestBackend")
+	}
+	err := tree.ServeBackend(func(request *tree.BackendRequest) ([]tree.BackendFile, error) {
+		grammar := request.Grammar
+		if len(grammar.Rules) == 0 {
+			return nil, errors.New("no rules")
+		}
+		out := &strings.Builder{}
+		fmt.Fprintf(out, "%v %v %v\n", grammar.Package, grammar.Name, strings.TrimSpace(grammar.State))
+		for _, rule := range grammar.Rules {
+			fmt.Fprintf(out, "%v %v %v", rule.Name, rule.NoMemo, rule.Expression.Type)
+			for _, child := range rule.Expression.Children {
+				fmt.Fprintf(out, " %v:%v", child.Type, child.Text)
+			}
+			out.WriteString("\n")
+		}
+		return []tree.BackendFile{{Name: "t.txt", Content: out.String()}}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Exit(0)
+}
+
+func TestBackend(t *testing.T) {
+	buffer := "package p\ntype T Peg { n int }\n%nomemo successes List\nStart <- 'a'* List { p.n++ }\nList <- 'bc' &{ p.n > 0 }\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	t.Setenv("PEG_TEST_BACKEND", "1")
+	files, err := p.Backend("", []string{"peg"}, os.Args[0], "-test.run=^TestBackendProcess$")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "p T n int\nStart [] Sequence Star: Name:List Action: p.n++ \nList [successes] Sequence Character:b Character:c Predicate: p.n > 0 \n"
+	if len(files) != 1 || files[0].Name != "t.txt" || files[0].Content != expected {
+		t.Errorf("got %q, expected t.txt with %q", files, expected)
+	}
+}
+
+func TestLint(t *testing.T) {
+	for _, test := range []struct {
+		rules, fixed string
+	}{
+		{"Start <- A\nA <- 'a'\n", "Start <- A !.\nA <- 'a'\n"},
+		{"Start <- A / 'b' # comment\nA <- 'a'\n", "Start <- (A / 'b') !. # comment\nA <- 'a'\n"},
+		{"Start <- A !. { done() }\nA <- 'a'\n", ""},
+		{"Start <- A End\nEnd <- !.\nA <- 'a'\n", ""},
+		{"Start <- A !. / 'b' !.\nA <- 'a'\n", ""},
+	} {
+		header := "package p\ntype T Peg {}\n"
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: header + test.rules}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		problems := p.Lint()
+		if test.fixed == "" {
+			if len(problems) != 0 {
+				t.Errorf("%q: unexpected problems %v", test.rules, problems)
+			}
+			continue
+		}
+		if len(problems) != 1 || !errors.Is(problems[0], tree.ErrMissingEOF) {
+			t.Errorf("%q: got %v, expected a missing end of input", test.rules, problems)
+			continue
+		}
+		p.FixMissingEOF()
+		if p.Buffer != header+test.fixed {
+			t.Errorf("%q: got %q, expected %q", test.rules, p.Buffer, header+test.fixed)
+		}
+	}
+}
+
+func TestLintProblems(t *testing.T) {
+	for _, test := range []struct {
+		rules    string
+		problems []string
+	}{
+		{"Start <- ('a' ![a-z] / 'ab' / Word)* !.\nWord <- [a-z]+\n", nil},
+		{"Start <- (Line / '#')* !.\nLine <- !EOF (!'\\n' .)* EOL\nEOL <- '\\n' / EOF\nEOF <- !.\n", nil},
+		{"Start <- A !.\nA <- 'a'\nB <- C\nC <- 'c'\n", []string{
+			"rule 'B' is not used by the start rule",
+			"rule 'C' is not used by the start rule",
+		}},
+		{"Start <- A !.\nA <- '(' A ')'\n", []string{
+			"rule 'Start' can never match",
+			"rule 'A' can never match",
+		}},
+		{"Start <- ('=' / '==' / [a-z] / 'x' 'y' / Word / 'w')* !.\nWord <- [a-z]+\n", []string{
+			"alternative 2 of a choice in rule 'Start' is unreachable, alternative 1 matches first",
+			"alternative 4 of a choice in rule 'Start' is unreachable, alternative 3 matches first",
+			"alternative 5 of a choice in rule 'Start' is unreachable, alternative 3 matches first",
+			"alternative 6 of a choice in rule 'Start' is unreachable, alternative 3 matches first",
+		}},
+		{"Start <- ('a'* / 'b')+ !.\n", []string{
+			"a repetition in rule 'Start' can match the empty string, looping forever",
+			"alternative 2 of a choice in rule 'Start' is unreachable, alternative 1 matches first",
+		}},
+		{"Start <- ('a' / EOL)* !.\nEOL <- '\\n' / !.\n", []string{
+			"a repetition in rule 'Start' can match the empty string, looping forever",
+		}},
+	} {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\n" + test.rules}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		var problems []string
+		for _, problem := range p.Lint() {
+			problems = append(problems, problem.Error())
+		}
+		if strings.Join(problems, "\n") != strings.Join(test.problems, "\n") {
+			t.Errorf("%q: got %q, expected %q", test.rules, problems, test.problems)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	for _, test := range []struct {
+		rules    string
+		problems []string
+	}{
+		{"Start <- A !.\nA <- 'a'\n", nil},
+		{"Start <- (A / 'x\\q') !.\n", []string{
+			"3:17: unknown escape sequence: \\q",
+			"warning: rule 'A' used but not defined",
+		}},
+		{"Start <- A !.\nA <- B 'a'\nB <- A / 'b'\nC <- .\n", []string{
+			"warning: rule 'C' defined but not used",
+			"warning: possible infinite left recursion in rule 'A'",
+			"warning: possible infinite left recursion in rule 'B'",
+		}},
+		{"Start <- 'a'\n", []string{
+			"warning: start rule 'Start' doesn't end with end of input (!.)",
+		}},
+		{"Start <- ('a' / 'ab')* !.\n", []string{
+			"warning: alternative 2 of a choice in rule 'Start' is unreachable, alternative 1 matches first",
+		}},
+		{"Start <- Expresion !.\nExpression <- 'a'\n", []string{
+			"warning: rule 'Expresion' used but not defined, did you mean 'Expression'?",
+			"warning: rule 'Expression' defined but not used",
+		}},
+	} {
+		p := &generator.Peg{Tree: tree.New(false, false, true), Buffer: "package p\ntype T Peg {}\n" + test.rules}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		var problems []string
+		for _, problem := range p.Check() {
+			problems = append(problems, problem.Error())
+		}
+		if strings.Join(problems, "\n") != strings.Join(test.problems, "\n") {
+			t.Errorf("%q: got %q, expected %q", test.rules, problems, test.problems)
+		}
+	}
+}
+
+func TestIfChanged(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "t.peg.go")
+	marker := "// Code generated by peg t.peg. DO NOT EDIT.\n\n"
+	if err := os.WriteFile(name, []byte(marker+"package p\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	past := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(name, past, past); err != nil {
+		t.Fatal(err)
+	}
+	*ifChanged = true
+	defer func() {
+		*ifChanged = false
+	}()
+
+	writeOutput(name, []byte(marker+"package p\n"))
+	if info, err := os.Stat(name); err != nil || !info.ModTime().Equal(past) {
+		t.Fatalf("unchanged file was written: %v", err)
+	}
+	writeOutput(name, []byte(marker+"package q\n"))
+	if content, err := os.ReadFile(name); err != nil || string(content) != marker+"package q\n" {
+		t.Fatalf("changed file was not written: %v", err)
+	}
+}
+
+func TestForce(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "t.peg.go")
+	if err := os.WriteFile(name, []byte("package p\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		content   string
+		generated bool
+	}{
+		{"package p\n", false},
+		{"// Code generated by peg t.peg. DO NOT EDIT.\n\npackage p\n", true},
+		{"// SPDX-License-Identifier: MIT\n// Code generated by peg t.peg. DO NOT EDIT.\npackage p\n", true},
+		{"// DO NOT EDIT.\npackage p\n", false},
+	} {
+		if generated.MatchString(test.content) != test.generated {
+			t.Errorf("%q: expected generated to be %v", test.content, test.generated)
+		}
+	}
+
+	*force = true
+	defer func() {
+		*force = false
+	}()
+	writeOutput(name, []byte("package q\n"))
+	if content, err := os.ReadFile(name); err != nil || string(content) != "package q\n" {
+		t.Fatalf("the file was not replaced with -force: %v", err)
+	}
+}
+
+func TestInitBazel(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"lang", ".git", "bazel-out"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(root, dir, "my-lang.peg"), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	out := &bytes.Buffer{}
+	if err := initBazel(root, []string{"-switch=true"}, out); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# lang/BUILD.bazel
+load("//:peg.bzl", "peg_parser")
+
+peg_parser(
+    name = "my_lang_peg",
+    src = "my-lang.peg",
+    opts = ["-switch=true"],
+)
+
+`
+	if out.String() != expected {
+		t.Errorf("got\n%s\nexpected\n%s", out, expected)
+	}
+	if rule, err := os.ReadFile(filepath.Join(root, "peg.bzl")); err != nil || !strings.Contains(string(rule), "peg_parser = rule(") {
+		t.Errorf("peg.bzl is missing the peg_parser rule: %v", err)
+	}
+}
+
+var files = [...]string{
+	"peg.peg",
+	"grammars/c/c.peg",
+	"grammars/calculator/calculator.peg",
+	"grammars/fexl/fexl.peg",
+	"grammars/java/java.peg",
+}
+
+func BenchmarkInitOnly(b *testing.B) {
+	pegs := []string{}
+	for _, file := range files {
+		input, err := os.ReadFile(file)
+		if err != nil {
+			b.Error(err)
+		}
+		pegs = append(pegs, string(input))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, peg := range pegs {
+			p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: peg}
+			_ = p.Init(generator.Size(1 << 15))
+		}
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	pegs := make([]*generator.Peg, len(files))
+	for i, file := range files {
+		input, err := os.ReadFile(file)
+		if err != nil {
+			b.Error(err)
+		}
+
+		p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: string(input)}
+		_ = p.Init(generator.Size(1 << 15))
+		pegs[i] = p
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, peg := range pegs {
+			if err := peg.Parse(); err != nil {
+				b.Error(err)
+			}
+			b.StopTimer()
+			peg.Reset()
+			b.StartTimer()
+		}
+	}
+}
+
+func BenchmarkResetAndParse(b *testing.B) {
+	pegs := make([]*generator.Peg, len(files))
+	for i, file := range files {
+		input, err := os.ReadFile(file)
+		if err != nil {
+			b.Error(err)
+		}
+
+		p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: string(input)}
+		_ = p.Init(generator.Size(1 << 15))
+		pegs[i] = p
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, peg := range pegs {
+			if err := peg.Parse(); err != nil {
+				b.Error(err)
+			}
+			peg.Reset()
+		}
+	}
+}
+
+func BenchmarkInitAndParse(b *testing.B) {
+	strs := []string{}
+	for _, file := range files {
+		input, err := os.ReadFile(file)
+		if err != nil {
+			b.Error(err)
+		}
+		strs = append(strs, string(input))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, str := range strs {
+			peg := &generator.Peg{Tree: tree.New(true, true, false), Buffer: str}
+			_ = peg.Init(generator.Size(1 << 15))
+			if err := peg.Parse(); err != nil {
+				b.Error(err)
+			}
+		}
+	}
+}
+
+func BenchmarkInitResetAndParse(b *testing.B) {
+	strs := []string{}
+	for _, file := range files {
+		input, err := os.ReadFile(file)
+		if err != nil {
+			b.Error(err)
+		}
+		strs = append(strs, string(input))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, str := range strs {
+			peg := &generator.Peg{Tree: tree.New(true, true, false), Buffer: str}
+			_ = peg.Init(generator.Size(1 << 15))
+			if err := peg.Parse(); err != nil {
+				b.Error(err)
+			}
+			peg.Reset()
+		}
+	}
+}
+
+func TestCommands(t *testing.T) {
+	for _, test := range []struct {
+		args     []string
+		name     string
+		expected []string
+	}{
+		{[]string{"grammar.peg"}, "", []string{"grammar.peg"}},
+		{[]string{"lint", "grammar.peg"}, "lint", []string{"grammar.peg"}},
+		{[]string{"completion", "bash"}, "completion", []string{"bash"}},
+		{[]string{"man"}, "man", []string{}},
+		{[]string{"gen", "grammar.peg"}, "gen", []string{"grammar.peg"}},
+		{[]string{"help"}, "help", []string{}},
+		{[]string{"help", "fmt"}, "help", []string{"fmt"}},
+		{[]string{"help", "fmt", "vet"}, "", nil},
+		{[]string{"lint"}, "", []string{"lint"}},
+		{[]string{"unknown", "grammar.peg"}, "", nil},
+		{[]string{}, "", nil},
+	} {
+		c, args := lookupCommand(test.args)
+		if test.expected == nil {
+			if c != nil {
+				t.Errorf("%v: got command %q, expected none", test.args, c.name)
+			}
+			continue
+		}
+		if c == nil || c.name != test.name || strings.Join(args, " ") != strings.Join(test.expected, " ") {
+			t.Errorf("%v: got %v %v, expected %q %v", test.args, c, args, test.name, test.expected)
+		}
+	}
+}
+
+func TestCompletion(t *testing.T) {
+	for _, shell := range shells {
+		out := &bytes.Buffer{}
+		if err := writeCompletion(shell, out); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range commands[1:] {
+			if !strings.Contains(out.String(), c.name) {
+				t.Errorf("%v: the command %v is missing", shell, c.name)
+			}
+		}
+		for _, o := range options() {
+			if !strings.Contains(out.String(), "-"+o.name) && !strings.Contains(out.String(), "-o "+o.name) {
+				t.Errorf("%v: the option -%v is missing", shell, o.name)
+			}
+		}
+		if _, err := exec.LookPath(shell); err == nil {
+			check := exec.Command(shell, "-n")
+			check.Stdin = out
+			if output, err := check.CombinedOutput(); err != nil {
+				t.Errorf("%v: %v\n%s", shell, err, output)
+			}
+		}
+	}
+	if err := writeCompletion("ksh", &bytes.Buffer{}); err == nil {
+		t.Error("expected an error for an unknown shell")
+	}
+}
+
+func TestMan(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := writeMan(out); err != nil {
+		t.Fatal(err)
+	}
+	man := out.String()
+	if !strings.HasPrefix(man, ".TH PEG 1 ") {
+		t.Errorf("got %q, expected a man page", man)
+	}
+	for _, expected := range []string{`\fBserve\-api\fR \fIfile\fR`, `\fB\-output\fR \fIstring\fR`, `\fB\-inline\fR` + "\nparse rule inlining\n"} {
+		if !strings.Contains(man, expected) {
+			t.Errorf("%q is missing", expected)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	for _, test := range []struct {
+		rules    string
+		migrated string
+	}{
+		{"Start <- 'a's \"b\"s # 'c's\ns <- ' '*\n", "Start <- 'a' s \"b\" s # 'c's\ns <- ' '*\n"},
+		{"Start <- 'a's \"b\"s\n", "Start <- 'a's \"b\"s\n"},
+	} {
+		buffer := "package p\ntype T Peg {}\n"
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer + test.rules}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		if migrated := p.MigrateLiteralSuffix(); migrated != buffer+test.migrated {
+			t.Errorf("got %q, expected %q", migrated, buffer+test.migrated)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+	b := "1\n2\n3\nfour\n5\n6\n7\n8\n9\n10\n11\n12\nthirteen"
+	if err := writeDiff(out, "t.peg", a, b); err != nil {
+		t.Fatal(err)
+	}
+	expected := "--- t.peg\n+++ t.peg\n" +
+		"@@ -1,7 +1,7 @@\n 1\n 2\n 3\n-4\n+four\n 5\n 6\n 7\n" +
+		"@@ -10,3 +10,4 @@\n 10\n 11\n 12\n+thirteen\n\\ No newline at end of file\n"
+	if out.String() != expected {
+		t.Errorf("got %q, expected %q", out.String(), expected)
+	}
+}
+
+func TestFormatGrammar(t *testing.T) {
+	buffer := "\n\npackage main  \n\n\n\ntype Test Peg {\n}\t\n\n" +
+		"Begin <- 'a  \n b' Action \t\n\n\n\n# comment \nAction <- { x := `1  \n` } \n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	expected := "package main\n\ntype Test Peg {\n}\n\n" +
+		"Begin <- 'a  \n b' Action\n\n# comment\nAction <- { x := `1  \n` }\n"
+	if formatted := p.Format(); formatted != expected {
+		t.Errorf("got %q, expected %q", formatted, expected)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	buffer := "package main\n\ntype T Peg {\n  x int\n}\n\n" +
+		"Start <- A\n  / B {p.x++}\n\t/ [aab-bc] {\n      if p.x > 0 {\n          p.x--\n      }\n\n      p.x++ }\n" +
+		"A <- &{  p.x > 0  } [[q-qQ]] [^zz] { s := `\n  ` }\nB <- \"b\" { }\n"
+	expected := "package main\n\ntype T Peg {\n  x int\n}\n\n" +
+		"Start <- A\n       / B { p.x++ }\n       / [abc] {\n       \tif p.x > 0 {\n       \t    p.x--\n       \t}\n\n       \tp.x++\n       }\n" +
+		"A <- &{ p.x > 0 } [[qQ]] [^z] { s := `\n  ` }\nB <- \"b\" {}\n"
+	var irs []string
+	for i, input := range []string{buffer, expected} {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: input}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		if formatted := p.Format(); formatted != expected {
+			t.Errorf("%d: got %q, expected %q", i, formatted, expected)
+		}
+		p.Execute()
+		ir := p.IR()
+		canonicalIR(ir)
+		out, err := json.Marshal(ir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		irs = append(irs, string(out))
+	}
+	/* the check of peg fmt accepts the changes */
+	if irs[0] != irs[1] {
+		t.Errorf("the rules differ:\n%v\n%v", irs[0], irs[1])
+	}
+}
+
+func TestGraph(t *testing.T) {
+	buffer := `package main
+type Test Peg {}
+Begin <- A B !.
+A <- 'a' B / Missing
+B <- 'b'
+`
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Graph(out); err != nil {
+		t.Fatal(err)
+	}
+	expected := `digraph "Test" {
+	node [shape=box];
+	"Begin" [peripheries=2];
+	"A";
+	"B";
+	"Begin" -> "A";
+	"Begin" -> "B";
+	"A" -> "B";
+	"Missing" [style=dashed];
+	"A" -> "Missing";
+}
+`
+	if out.String() != expected {
+		t.Errorf("got %v, expected %v", out.String(), expected)
+	}
+}
+
+func TestGrammarFlags(t *testing.T) {
+	buffer := `# A grammar.
+//peg:flags -switch -output=out.go
+#peg:flags -inline
+//peg:flagsfoo -noast
+
+package main
+
+//peg:flags -noast
+type Test Peg {}
+`
+	args := grammarFlags(buffer)
+	if expected := "-switch -output=out.go -inline"; strings.Join(args, " ") != expected {
+		t.Fatalf("got %v, expected %v", args, expected)
+	}
+
+	set := flag.NewFlagSet("peg", flag.ContinueOnError)
+	inline := set.Bool("inline", false, "")
+	_switch := set.Bool("switch", false, "")
+	output := set.String("output", "", "")
+	if err := set.Parse([]string{"-switch=false", "-output", "cli.go"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := setGrammarFlags(set, args); err != nil {
+		t.Fatal(err)
+	}
+	if !*inline || *_switch || *output != "cli.go" {
+		t.Errorf("got -inline=%v -switch=%v -output=%v, expected the command line to take precedence", *inline, *_switch, *output)
+	}
+
+	for _, args := range [][]string{{"-unknown"}, {"-inline", "file.peg"}} {
+		if err := setGrammarFlags(set, args); err == nil {
+			t.Errorf("%v: expected an error", args)
+		}
+	}
+}
+
+func TestBuildInfo(t *testing.T) {
+	for _, test := range []struct {
+		info     buildInfo
+		expected string
+	}{
+		{buildInfo{version: "v1.2.3"}, "v1.2.3"},
+		{buildInfo{version: "devel"}, "devel"},
+		{buildInfo{version: "devel", commit: "0123456789abcdef", modified: true}, "devel-0123456789abcdef+dirty"},
+		{buildInfo{version: "v0.0.0-20240101000000-0123456789ab+dirty", commit: "0123456789abcdef", modified: true}, "v0.0.0-20240101000000-0123456789ab+dirty"},
+	} {
+		if version := test.info.String(); version != test.expected {
+			t.Errorf("got %v, expected %v", version, test.expected)
+		}
+	}
+	if readBuildInfo().version == "" {
+		t.Error("expected a version")
+	}
+}
+
+func TestLineFile(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\nStart <- 'a' { a() }\n  'b' { b() } !.\n"
+	for _, noast := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, noast), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.LineFile = "/src/t.peg"
+		out := &bytes.Buffer{}
+		if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []string{"//line /src/t.peg:3\n", "//line /src/t.peg:4\n"} {
+			if !strings.Contains(out.String(), expected) {
+				t.Errorf("noast=%v: %q missing from\n%s", noast, expected, out)
+			}
+		}
+		/* the code after the actions is at its lines in the generated file */
+		restored := 0
+		for i, line := range strings.Split(out.String(), "\n") {
+			if strings.HasPrefix(line, "//line t.peg.go:") {
+				if expected := fmt.Sprintf("//line t.peg.go:%d:1", i+2); line != expected {
+					t.Errorf("noast=%v: got %v, expected %v", noast, line, expected)
+				}
+				restored++
+			}
+		}
+		if restored != 2 {
+			t.Errorf("noast=%v: got %v line directives returning to t.peg.go, expected 2\n%s", noast, restored, out)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	compile := func(buffer string, rewrites ...func(*token.FileSet, *ast.File) error) (string, error) {
+		p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Rewrites = rewrites
+		out := &bytes.Buffer{}
+		err := p.Compile("t.peg.go", []string{"peg"}, out)
+		return out.String(), err
+	}
+
+	rename := func(fileSet *token.FileSet, code *ast.File) error {
+		ast.Inspect(code, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && ident.Name == "T" {
+				ident.Name = "Parser"
+			}
+			return true
+		})
+		return nil
+	}
+	out, err := compile("package p\nimport \"strings\"\ntype T Peg {}\nStart <- 'a' {   strings.ToUpper(text)   } !.\n", rename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	formatted, err := format.Source([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != string(formatted) {
+		t.Error("the generated code is not formatted")
+	}
+	if !strings.Contains(out, "type Parser struct {") || strings.Contains(out, "type T struct {") {
+		t.Error("the rewrite was not applied")
+	}
+
+	if _, err := compile("package p\ntype T Peg {}\nStart <- 'a' { x := } !.\n"); err == nil || !strings.Contains(err.Error(), "the generated code is invalid") {
+		t.Errorf("got %v, expected the error of the invalid action", err)
+	}
+}
+
+func TestHeader(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\nStart <- 'a' !.\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.License, p.Markers = "Apache-2.0", []string{"@generated"}
+	p.Provenance = provenanceLine("t.peg", []byte(buffer))
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg", "t.peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitN(out.String(), "\n", 5)
+	expected := []string{
+		"// SPDX-License-Identifier: Apache-2.0",
+		"// Code generated by peg t.peg. DO NOT EDIT.",
+		"// @generated",
+		fmt.Sprintf("// Generated by peg %v from t.peg (sha256:", readBuildInfo()),
+	}
+	for i, line := range expected {
+		if !strings.HasPrefix(lines[i], line) {
+			t.Errorf("got %q, expected %q", lines[i], line)
+		}
+	}
+	code, err := parser.ParseFile(token.NewFileSet(), "t.peg.go", out.Bytes(), parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ast.IsGenerated(code) {
+		t.Error("the generated code is not recognized as generated")
+	}
+}
+
+func TestImportDirective(t *testing.T) {
+	buffer := "package p\nimport \"strings\"\ntype T Peg {}\n%import ( \"strconv\"; \"strings\" )\n%import \"unicode\"\nStart <- 'a' { strings.TrimSpace(strconv.Quote(text)); unicode.IsUpper('a') } !.\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"fmt", "strconv", "strings", "unicode"} {
+		if count := strings.Count(out.String(), "\t\""+name+"\"\n"); count != 1 {
+			t.Errorf("%s imported %d times in\n%s", name, count, out)
+		}
+	}
+}
+
+func TestErrorType(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\n%error ParseError { Filename string; Hint string }\nStart <- 'a' !.\n"
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"type ParseError struct {",
+		"\tFilename string\n",
+		"\tErr      error\n",
+		"func (e *ParseError) Unwrap() error {",
+		"e := p.ParseError",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%q missing from\n%s", expected, out)
+		}
+	}
+}
+
+func TestStress(t *testing.T) {
+	dir := t.TempDir()
+	if err := writeStress(dir, 3, 12, 100, true); err != nil {
+		t.Fatal(err)
+	}
+	grammar, err := os.ReadFile(filepath.Join(dir, "stress.peg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := ParseGrammar(string(grammar))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Rule("Word11") == nil || g.Rule("Word12") != nil {
+		t.Errorf("expected 12 word rules in\n%s", grammar)
+	}
+	if err := g.Compile("stress.peg.go", []string{"peg"}, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	input, err := os.ReadFile(filepath.Join(dir, "stress.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(input) < 100 || !strings.HasPrefix(string(input), "(((w0:stress)))\n(((w1:stress)))\n") {
+		t.Errorf("unexpected input %q", input)
+	}
+	if err := writeStress(dir, 3, 0, 100, true); err == nil {
+		t.Error("expected an error for width 0")
+	}
+}
+
+func TestSwitchEmptyFirstSet(t *testing.T) {
+	buffer := "package p\ntype T Peg {}\nStart <- 'a' Separator\nSeparator <- ',' / '\\n' / !.\n"
+	p := &generator.Peg{Tree: tree.New(false, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "<nil>") {
+		t.Errorf("!. became a case of a switch in\n%s", out)
+	}
+}
+
+func TestSwitchOrder(t *testing.T) {
+	compile := func(alternatives string) string {
+		buffer := "package p\ntype T Peg {}\nStart <- (" + alternatives + ") !.\n"
+		p := &generator.Peg{Tree: tree.New(false, true, false), Buffer: buffer}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		out := &bytes.Buffer{}
+		if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		return out.String()
+	}
+
+	code := compile("'c' 'x' / 'a' 'y' / [d-z] / 'b' 'z'")
+	if again := compile("'c' 'x' / 'a' 'y' / [d-z] / 'b' 'z'"); again != code {
+		t.Error("regenerating the parser changed it")
+	}
+	if reordered := compile("'b' 'z' / 'a' 'y' / 'c' 'x' / [d-z]"); reordered != code {
+		t.Error("reordering disjoint alternatives changed the parser")
+	}
+	a, b, c := strings.Index(code, "case 'a':"), strings.Index(code, "case 'b':"), strings.Index(code, "case 'c':")
+	if a < 0 || a > b || b > c || strings.Contains(code, "case 'd'") {
+		t.Errorf("the cases aren't sorted, with [d-z] as the default, in\n%s", code)
+	}
+}
+
+func TestSwitchDispatch(t *testing.T) {
+	buffer := `
+package main
+
+type Lang Peg {}
+
+Start <- Statement* !.
+Statement <- 'if' ' ' / 'int' ' ' / 'for' ' ' / [a-z]+ ' ' / [0-9]+ ' '
+`
+	p := &generator.Peg{Tree: tree.New(false, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Expected = true
+	code := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, code); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	if err := p.Dump(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"(&('f') %fail('i' [0-9]) (('f' 'o' 'r' ' ') / ([a-z]+ ' ')))",
+		"(&('i') %fail('for' [0-9]) (('i' (('f' ' ') / ('n' 't' ' '))) / ([a-z]+ ' ')))",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("%s missing from\n%s", expected, out)
+		}
+	}
+	if !strings.Contains(code.String(), "case 'i':\n\t\t\t\t\t\tfail(\"'for'\")") {
+		t.Errorf("expected the skipped alternatives to fail in\n%s", code)
+	}
+}
+
+func TestSwitchExpected(t *testing.T) {
+	for _, _switch := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, _switch, false), Buffer: `
+package p
+
+type T Peg {}
+
+Start <- ('let' / 'var' / 'if' / [0-9]+) ' ' 'x' !.
+`}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Expected = true
+		out := &bytes.Buffer{}
+		if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		runGenerated(t, map[string]string{
+			"t.peg.go": out.String(),
+			"t_test.go": `package p
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestExpected(t *testing.T) {
+	expected := []string{"'if'", "'let'", "'var'", "[0-9]"}
+	p := &T{Buffer: "z"}
+	p.Init()
+	var syntaxErr *SyntaxError
+	if err := p.Parse(); !errors.As(err, &syntaxErr) {
+		t.Fatalf("got %v, expected a syntax error", err)
+	}
+	if got := syntaxErr.Expected(); !slices.Equal(got, expected) {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+	if got := p.Completions(0); !slices.Equal(got, expected) {
+		t.Errorf("got the completions %q, expected %q", got, expected)
+	}
+}
+`,
+		}, nil)
+	}
+}
+
+func TestWithoutExpected(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: `
+package p
+
+type T Peg {}
+
+Start <- Word (' ' Word)* !.
+Word <- [a-z]+
+`}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "fail(") {
+		t.Error("expected no tracking of the expected terminals")
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go": out.String(),
+		"t_test.go": `package p
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSyntaxError(t *testing.T) {
+	/* the error is located at the end of the last word matched, instead
+	   of after the space where a word is expected */
+	p := &T{Buffer: "ab cd 1"}
+	p.Init()
+	var syntaxErr *SyntaxError
+	if err := p.Parse(); !errors.As(err, &syntaxErr) {
+		t.Fatalf("got %v, expected a syntax error", err)
+	}
+	if syntaxErr.Offset != 5 || syntaxErr.Symbol != 6 || len(syntaxErr.Expected()) > 0 {
+		t.Errorf("got offset %v symbol %v expecting %q, expected offset 5 symbol 6 expecting nothing", syntaxErr.Offset, syntaxErr.Symbol, syntaxErr.Expected())
+	}
+}
+`,
+	}, nil)
+}
+
+func TestBytes(t *testing.T) {
+	compile := func(grammar string) (string, error) {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\n" + grammar}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Bytes = true
+		out := &bytes.Buffer{}
+		err := p.Compile("t.peg.go", []string{"peg"}, out)
+		return out.String(), err
+	}
+	for grammar, expected := range map[string]string{
+		"Start <- 'a' '\\u0100'\n": `character 'Ā' in rule 'Start' doesn't fit in a byte`,
+		"Start <- 'aĀ'\n":          `character 'Ā' in rule 'Start' doesn't fit in a byte`,
+		"Start <- [\\p{L}]\n":      `Unicode property \p{L} in rule 'Start' can't match bytes`,
+		"Start <- 'é'\n":           `character 'é' in rule 'Start' must be escaped, as '\xe9' for the byte or '\xc3\xa9' for its UTF-8 encoding`,
+		"Start <- \"aé\"\n":        `character 'é' in rule 'Start' must be escaped, as '\xe9' for the byte or '\xc3\xa9' for its UTF-8 encoding`,
+		"Start <- [a-zà-ÿ]\n":      `character 'à' in rule 'Start' must be escaped, as '\xe0' for the byte or '\xc3\xa0' for its UTF-8 encoding`,
+		"Start <- [[à-é]]\n":       `character 'à' in rule 'Start' must be escaped, as '\xe0' for the byte or '\xc3\xa0' for its UTF-8 encoding`,
+	} {
+		if _, err := compile(grammar); err == nil || err.Error() != expected {
+			t.Errorf("got %v, expected %v for %q", err, expected, grammar)
+		}
+	}
+	code, err := compile("Start <- < \"kelvin\" '\\xc3\\xa9'? [[\\xe0-\\xe9]]? [\\xca\\xfe]+ > !.\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"Buffer []byte", "buffer.at(position)"} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("%v missing from\n%s", expected, code)
+		}
+	}
+	if strings.Contains(code, "unicode/utf8") {
+		t.Errorf("unicode/utf8 imported in\n%s", code)
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go": code,
+		"t_test.go": `package p
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	p := &T{Buffer: []byte("KELVIN\xca\xfe\xca")}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p = &T{Buffer: []byte("kelvin\u00e9\xc5\xca")}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p = &T{Buffer: []byte("kelvin\xca\xff")}
+	p.Init()
+	if err := p.Parse(); err == nil {
+		t.Fatal("expected a syntax error")
+	} else if offset := err.(*SyntaxError).Offset; offset != 7 {
+		t.Fatalf("got the error at %v, expected 7", offset)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestOptIn(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		set      func(p *generator.Peg)
+		expected string
+	}{
+		{"incremental", func(p *generator.Peg) { p.Incremental = true }, "func (p *T) Edit("},
+		{"tolerant", func(p *generator.Peg) { p.Tolerant = true }, "func (p *T) Sanitize("},
+		{"substitution", func(p *generator.Peg) { p.Substitution = true }, "func (p *T) Substitute("},
+		{"concurrent", func(p *generator.Peg) { p.Concurrent = true }, "func (p *T) FindAllConcurrent("},
+		{"streaming", func(p *generator.Peg) { p.Streaming = true }, "func (p *T) ParseReader("},
+		{"watchdog", func(p *generator.Peg) { p.Watchdog = true }, "func Watchdog("},
+		{"expected", func(p *generator.Peg) { p.Expected = true }, "func (p *T) Completions("},
+		{"iterative", func(p *generator.Peg) { p.Iterative = true }, "match = func(rule uint32) bool {"},
+	} {
+		for _, enabled := range []bool{false, true} {
+			p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\nStart <- 'a' Start / 'b'\n"}
+			_ = p.Init(generator.Size(1 << 15))
+			if err := p.Parse(); err != nil {
+				t.Fatal(err)
+			}
+			p.Execute()
+			if enabled {
+				c.set(p)
+			}
+			out := &bytes.Buffer{}
+			if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(out.String(), c.expected) != enabled {
+				t.Errorf("%v %v: got\n%s", c.name, enabled, out)
+			}
+		}
+	}
+}
+
+func TestStreaming(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg { parses int }\nLine <- !{ p.parses++ } [a-z]* '\\n'\n"}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Streaming = true
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go": out.String(),
+		"t_test.go": `package p
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestParseReader(t *testing.T) {
+	/* the long line spans several reads of 64 KiB, and every read of
+	   iotest.HalfReader */
+	lines := []string{"a\n", strings.Repeat("b", 200000) + "\n", "c\n"}
+	p := &T{}
+	p.Init()
+	var got []string
+	offsets := []int{}
+	err := p.ParseReader(iotest.HalfReader(strings.NewReader(strings.Join(lines, ""))), ruleLine, func(offset int) error {
+		got = append(got, p.Buffer[:p.matched])
+		offsets = append(offsets, offset)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(lines) {
+		t.Fatalf("got %v matches, expected %v", len(got), len(lines))
+	}
+	offset := 0
+	for i, line := range lines {
+		if got[i] != line || offsets[i] != offset {
+			t.Errorf("got the match %.10q at %v, expected %.10q at %v", got[i], offsets[i], line, offset)
+		}
+		offset += len(line)
+	}
+}
+
+/* shortReader returns at most 1 KiB per read */
+type shortReader struct {
+	r *strings.Reader
+}
+
+func (s shortReader) Read(b []byte) (int, error) {
+	return s.r.Read(b[:min(len(b), 1<<10)])
+}
+
+func TestParseReaderLongMatch(t *testing.T) {
+	/* the line spans 1024 reads, and is parsed again each time the window
+	   doubles instead of after each of them */
+	line := strings.Repeat("b", 1<<20) + "\n"
+	p := &T{}
+	p.Init()
+	matches := 0
+	err := p.ParseReader(shortReader{strings.NewReader(line)}, ruleLine, func(offset int) error {
+		if p.Buffer[:p.matched] != line {
+			t.Errorf("got the match %.10q, expected %.10q", p.Buffer[:p.matched], line)
+		}
+		matches++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if matches != 1 || p.parses > 2*21 {
+		t.Errorf("got %v matches after %v parses, expected 1 after at most 42", matches, p.parses)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestStreamingImports(t *testing.T) {
+	/* io is only imported by the parsers using it */
+	for _, streaming := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, true), Buffer: "package p\ntype T Peg {}\nLine <- [a-z]* '\\n'\n"}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Streaming = streaming
+		out := &bytes.Buffer{}
+		if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		runGenerated(t, map[string]string{
+			"t.peg.go": out.String(),
+			"t_test.go": `package p
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	p := &T{Buffer: "abc\n"}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+}
+`,
+		}, nil)
+	}
+}
+
+func TestLines(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\nStart <- (Word / '\\n')* !.\nWord <- [a-zé]+\n"}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Lines = true
+	p.Incremental = true
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	code := out.String()
+	for _, expected := range []string{"func (t *token32) Position() (line, column int)", "func (t *token32) EndPosition() (line, column int)"} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("%v missing from\n%s", expected, code)
+		}
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go": code,
+		"t_test.go": `package p
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	p := &T{Buffer: "été\n\nun\r\nmot"}
+	p.Init(NormalizeCRLF())
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	var positions [][4]int
+	for _, token := range p.Tokens() {
+		if token.pegRule == ruleWord {
+			line, column := token.Position()
+			endLine, endColumn := token.EndPosition()
+			positions = append(positions, [4]int{line, column, endLine, endColumn})
+		}
+	}
+	expected := [][4]int{{1, 1, 1, 4}, {3, 1, 3, 3}, {4, 1, 4, 4}}
+	if len(positions) != len(expected) {
+		t.Fatalf("got %v, expected %v", positions, expected)
+	}
+	for i := range expected {
+		if positions[i] != expected[i] {
+			t.Fatalf("got %v, expected %v", positions, expected)
+		}
+	}
+	if err := p.Edit(0, 0, "\n"); err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range p.Tokens() {
+		if token.pegRule == ruleWord {
+			if line, column := token.Position(); line != 2 || column != 1 {
+				t.Fatalf("got %v:%v after the edit, expected 2:1", line, column)
+			}
+			break
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+func TestTrace(t *testing.T) {
+	p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\nStart <- Word (',' Word)* !.\nWord <- Letter+ / Digit+\nLetter <- [a-z]\nDigit <- [0-9]\n"}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Trace = true
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	code := out.String()
+	for _, expected := range []string{"func Trace(trace func(TraceEvent))", "func TraceWriter(w io.Writer, format string)", `"encoding/json"`} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("%v missing from\n%s", expected, code)
+		}
+	}
+	runGenerated(t, map[string]string{
+		"t.peg.go": code,
+		"t_test.go": `package p
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	var out bytes.Buffer
+	p := &T{Buffer: "1"}
+	if err := p.Init(TraceWriter(&out, "text")); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	expected := "> Start 0\n  > Word 0\n    > Letter 0\n    < Letter 0 failed\n    > Digit 0\n    < Digit 0-1\n    > Digit 1\n    < Digit 1 failed\n  < Word 0-1\n< Start 0-1\n"
+	if out.String() != expected {
+		t.Fatalf("got\n%v\nexpected\n%v", out.String(), expected)
+	}
+
+	out.Reset()
+	p = &T{Buffer: "a,1"}
+	if err := p.Init(TraceWriter(&out, "chrome")); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	var events []struct {
+		Name  string
+		Phase string ` + "`json:\"ph\"`" + `
+	}
+	if err := json.Unmarshal(append(out.Bytes(), ']'), &events); err != nil {
+		t.Fatalf("%v\n%s", err, out.Bytes())
+	}
+	if len(events) == 0 || events[0].Name != "Start" || events[0].Phase != "B" || events[len(events)-1].Phase != "E" {
+		t.Fatalf("got %v, expected Start to begin and end the trace", events)
+	}
+
+	if err := p.Init(TraceWriter(&out, "xml")); err == nil || err.Error() != "unknown trace format 'xml', expected text, json or chrome" {
+		t.Fatalf("got %v, expected the unknown format", err)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestTyped(t *testing.T) {
+	buffer := `
+package p
+
+type Calc Peg {}
+
+Expr <- Sum !.
+Sum <- Term (Op Term)*
+Term <- Number / '(' Sum ')'
+Op <- < [+\-] > { _ = text }
+Number <- < Digit+ > !Digit
+Digit <- [0-9]
+Text <- &Digit
+`
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: buffer}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	p.Quiet, p.Typed = true, true
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	fields := make(map[string]string)
+	for _, rule := range p.TypedRules {
+		var names []string
+		for _, field := range rule.Fields {
+			name := field.Name + " *" + field.Type
+			if field.Slice {
+				name = field.Name + " []*" + field.Type
+			}
+			names = append(names, name)
+		}
+		fields[rule.Type] = strings.Join(names, ", ")
+	}
+	for typ, expected := range map[string]string{
+		"ExprNode":   "Sum *SumNode",
+		"SumNode":    "Term []*TermNode, Op []*OpNode",
+		"TermNode":   "Number *NumberNode, Sum *SumNode",
+		"OpNode":     "",
+		"NumberNode": "Digit []*DigitNode",
+		"TextNode":   "",
+	} {
+		if got, ok := fields[typ]; !ok || got != expected {
+			t.Errorf("got the fields %q of %v, expected %q", got, typ, expected)
+		}
+	}
+
+	noast := &generator.Peg{Tree: tree.New(false, false, true), Buffer: buffer}
+	_ = noast.Init(generator.Size(1 << 15))
+	if err := noast.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	noast.Execute()
+	noast.Quiet, noast.Typed = true, true
+	if err := noast.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err == nil || err.Error() != "generating the typed AST requires the AST" {
+		t.Errorf("got %v, expected the typed AST to require the AST", err)
+	}
+
+	runGenerated(t, map[string]string{
+		"t.peg.go": out.String(),
+		"t_test.go": `package p
+
+import "testing"
+
+func TestTyped(t *testing.T) {
+	p := &Calc{Buffer: "1+(23-4)"}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	expr := p.Typed()
+	sum := expr.Sum.Term[1].Sum
+	if expr.Text != "1+(23-4)" || len(expr.Sum.Term) != 2 || expr.Sum.Op[0].Text != "+" {
+		t.Fatalf("got %+v", expr.Sum)
+	}
+	if number := sum.Term[0].Number; number.Text != "23" || len(number.Digit) != 2 || number.Begin != 3 || number.End != 5 {
+		t.Fatalf("got %+v", number)
+	}
+	if p.Reset(); p.Parse(int(ruleSum)) != nil || p.Typed() != nil {
+		t.Fatal("expected no typed AST of another rule")
+	}
+}
+`,
+	}, nil)
+}
+
+func TestRuleSources(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "rules"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "rules", "common.peg"), []byte("# shared rules\n\nSpacing <- ' '*\n\nString <- '\"' (!'\"' .)* '\"'\n         Spacing\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	p, err := generator.Parse([]byte(`package main
+type T Peg {}
+%include "rules/common.peg" String = Quoted
+Start <- Spacing < List > !.
+
+# a list of strings
+List <- Quoted (',' Spacing
+                Quoted)*   # more strings
+
+# unused
+Digit <- [0-9] { }
+`), generator.Options{Inline: true, Dir: dir, Grammar: "grammar.peg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := &bytes.Buffer{}
+	if err := p.Compile("", []string{"peg"}, code); err != nil {
+		t.Fatal(err)
+	}
+	sources := make(map[string]tree.RuleSource)
+	for _, source := range p.RuleSources {
+		sources[source.Name] = source
+	}
+	for _, expected := range []tree.RuleSource{
+		{Name: "Start", File: "grammar.peg", Line: 4, EndLine: 4},
+		{Name: "List", File: "grammar.peg", Line: 7, EndLine: 8, Inlined: true},
+		{Name: "Digit", File: "grammar.peg", Line: 11, EndLine: 11, Lexical: true},
+		{Name: "Spacing", File: "rules/common.peg", Line: 3, EndLine: 3, Lexical: true},
+		{Name: "Quoted", File: "rules/common.peg", Line: 5, EndLine: 6},
+		{Name: "PegText", Lexical: true},
+	} {
+		if sources[expected.Name] != expected {
+			t.Errorf("got %+v, expected %+v", sources[expected.Name], expected)
+		}
+	}
+	if !strings.Contains(code.String(), `{"List", "grammar.peg", 7, 8, false, true},`) {
+		t.Errorf("the rule List is missing from the table of\n%s", code)
+	}
+}
+
+func TestNoPrint(t *testing.T) {
+	for _, noast := range []bool{false, true} {
+		p := &generator.Peg{Tree: tree.New(false, false, noast), Buffer: "package p\ntype T Peg {}\nStart <- < 'a'+ > { _ = text } !.\n"}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.NoPrint = true
+		out := &bytes.Buffer{}
+		if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+			t.Fatal(err)
+		}
+		code := out.String()
+		for _, unexpected := range []string{"PrintSyntaxTree", "PrettyPrint", "func (t *token32) String", `"os"`} {
+			if strings.Contains(code, unexpected) {
+				t.Errorf("%v in the code generated with noast %v\n%s", unexpected, noast, code)
+			}
+		}
+		if !strings.Contains(code, "func (p *T) Parse(") {
+			t.Errorf("Parse missing from\n%s", code)
+		}
+	}
+}
+
+// expressions is the grammar of arithmetic expressions on which the methods
+// and options of the generated parsers are tested.
+const expressions = `
+package p
+
+type T Peg {}
+
+e <- sp e1 !.
+e1 <- e2 ( add e2 / minus e2 )*
+e2 <- e3 ( multiply e3 / divide e3 )*
+e3 <- minus value / value
+value <- < [0-9]+ > sp / open e1 %hint "unbalanced parentheses" close
+add <- '+' sp
+minus <- '-' sp
+multiply <- '*' sp
+divide <- '/' sp
+open <- '(' sp
+close <- ')' sp
+sp <- ( ' ' / '\t' )*
+`
+
+// generateExpressions returns the parser of expressions generated with
+// -switch -inline and the options of set, if it isn't nil.
+func generateExpressions(t *testing.T, set func(p *generator.Peg)) string {
+	t.Helper()
+	p := &generator.Peg{Tree: tree.New(true, true, false), Buffer: expressions}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if set != nil {
+		set(p)
+	}
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	return out.String()
+}
+
+func TestCompletions(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, nil),
+		"t_test.go": `package p
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompletions(t *testing.T) {
+	p := &T{Buffer: "( 1 + "}
+	p.Init()
+	expected := []string{"' '", "'('", "'-'", "'\\t'", "[0-9]"}
+	if completions := p.Completions(6); !slices.Equal(completions, expected) {
+		t.Fatalf("got %q, expected %q", completions, expected)
+	}
+	if completions := p.Completions(3); !slices.Contains(completions, "'/'") || slices.Contains(completions, "'('") {
+		t.Fatalf("got %q after a number", completions)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestParsePartial(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, nil),
+		"t_test.go": `package p
+
+import "testing"
+
+func TestParsePartial(t *testing.T) {
+	p := &T{Buffer: "( 1 + "}
+	p.Init()
+	expected, err := p.ParsePartial()
+	if err == nil {
+		t.Fatal("incomplete expression was parsed without error")
+	}
+	if len(expected) == 0 {
+		t.Fatal("no expected terminals")
+	}
+	ast := p.AST()
+	if ast == nil || ast.pegRule != rulee || ast.end != 6 {
+		t.Fatal("partial tree should span the input")
+	}
+	rules := []pegRule{}
+	for node := ast.up; node != nil; node = node.next {
+		rules = append(rules, node.pegRule)
+	}
+	if len(rules) != 3 || rules[0] != ruleopen || rules[1] != rulee2 || rules[2] != ruleadd {
+		t.Fatalf("unexpected partial tree %v", rules)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestMaxDepth(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, nil),
+		"t_test.go": `package p
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMaxDepth(t *testing.T) {
+	p := &T{Buffer: strings.Repeat("( ", 100) + "1" + strings.Repeat(" )", 100)}
+	p.Init(MaxDepth(1000))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	p = &T{Buffer: strings.Repeat("( ", 1000) + "1" + strings.Repeat(" )", 1000)}
+	p.Init(MaxDepth(1000))
+	err := p.Parse()
+	if err == nil || !strings.Contains(err.Error(), "nested deeper than 1000") {
+		t.Fatalf("got %v, expected a depth error", err)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestWatchdog(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, func(p *generator.Peg) { p.Watchdog = true }),
+		"t_test.go": `package p
+
+import "testing"
+
+func TestWatchdog(t *testing.T) {
+	expression := "1 + ( 2 * ( 3 + 4 ) )"
+	var slow []SlowRule
+	p := &T{Buffer: expression}
+	p.Init(Watchdog(0, 20, func(rule SlowRule) {
+		slow = append(slow, rule)
+	}))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	if len(slow) == 0 {
+		t.Fatal("no slow rules reported")
+	}
+	last := slow[len(slow)-1]
+	if last.Rule != "e" || last.Begin != 0 || last.End != len(expression) || last.Steps <= 20 {
+		t.Fatalf("got %+v, expected the start rule spanning the input", last)
+	}
+	for _, rule := range slow {
+		if rule.Steps <= 20 {
+			t.Fatalf("got %+v below the threshold", rule)
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+func TestErrorPosition(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, nil),
+		"t_test.go": `package p
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFilename(t *testing.T) {
+	p := &T{Buffer: "1 + )"}
+	p.Init()
+	p.SetFilename("input.calc")
+	err := p.Parse()
+	if err == nil || !strings.Contains(err.Error(), "input.calc:1:4: parse error") {
+		t.Fatalf("got %v, expected an error prefixed with the file name", err)
+	}
+}
+
+func TestTabWidth(t *testing.T) {
+	p := &T{Buffer: "\t\t)"}
+	p.Init()
+	if err := p.Parse(); err == nil || !strings.Contains(err.Error(), "at line 1 symbol 3\n") {
+		t.Fatalf("got %v, expected an error at symbol 3", err)
+	}
+
+	p = &T{Buffer: "\t\t)"}
+	p.Init(TabWidth(8), ByteColumns())
+	if err := p.Parse(); err == nil || !strings.Contains(err.Error(), "at line 1 symbol 17 byte 3\n") {
+		t.Fatalf("got %v, expected an error at symbol 17 and byte 3", err)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestSubstitution(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, func(p *generator.Peg) { p.Substitution = true }),
+		"t_test.go": `package p
+
+import "testing"
+
+func TestSubstitute(t *testing.T) {
+	p := &T{Buffer: "1 + (2 * 3)"}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	for template, expected := range map[string]string{
+		"[$1]":     "[1]+ [2]",
+		"$$<$0>":   "$<1 >+ $<(2 * 3)>",
+		"${sp}|$9": " |+  |",
+	} {
+		if substituted := p.Substitute(rulevalue, template); substituted != expected {
+			t.Errorf("%q: got %q, expected %q", template, substituted, expected)
+		}
+	}
+	if substituted := p.Substitute(rulee1, "x"); substituted != "x" {
+		t.Errorf("got %q, expected only the outermost match replaced", substituted)
+	}
+}
+`,
+	}, nil)
+}
+
+func TestFindAll(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, func(p *generator.Peg) { p.Concurrent = true }),
+		"t_test.go": `package p
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFindAll(t *testing.T) {
+	p := &T{Buffer: "x = 1 + 2; y = (3) * 2 !"}
+	p.Init()
+	matches, err := p.FindAll(rulee1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found []string
+	for _, match := range matches {
+		found = append(found, p.Buffer[match.begin:match.end])
+	}
+	if expected := []string{"1 + 2", "(3) * 2 "}; !reflect.DeepEqual(found, expected) {
+		t.Errorf("got %q, expected %q", found, expected)
+	}
+}
+
+func TestFindAllConcurrent(t *testing.T) {
+	p := &T{Buffer: strings.Repeat("x = 1 + 2;\ny = (3) * 2 !\n", 100)}
+	p.Init()
+	expected, err := p.FindAll(rulee1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{1, 4, 1000} {
+		matches, err := p.FindAllConcurrent(rulee1, '\n', n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(matches, expected) {
+			t.Errorf("%v goroutines: got %v matches, expected %v", n, len(matches), len(expected))
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+func TestSyntaxError(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, nil),
+		"t_test.go": `package p
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSyntaxError(t *testing.T) {
+	p := &T{Buffer: "1 + ( 2 * )"}
+	p.Init(TrackRules())
+	var syntaxErr *SyntaxError
+	if err := p.Parse(); !errors.As(err, &syntaxErr) {
+		t.Fatalf("got %v, expected a syntax error", err)
+	}
+	if syntaxErr.Line != 1 || syntaxErr.Symbol != 11 || syntaxErr.Offset != 10 {
+		t.Errorf("got line %v symbol %v offset %v, expected line 1 symbol 11 offset 10", syntaxErr.Line, syntaxErr.Symbol, syntaxErr.Offset)
+	}
+	if expected := syntaxErr.Expected(); len(expected) == 0 {
+		t.Error("no expected terminals")
+	}
+	if rules := syntaxErr.Rules(); len(rules) == 0 || rules[0] != "e" {
+		t.Errorf("got the rules %q, expected them to start with e", rules)
+	}
+}
+
+func TestHint(t *testing.T) {
+	for expression, hint := range map[string]string{
+		"( 1 + 2":   "unbalanced parentheses",
+		"( 1 + 2 ]": "unbalanced parentheses",
+		"( 1 + )":   "",
+	} {
+		p := &T{Buffer: expression}
+		p.Init()
+		var syntaxErr *SyntaxError
+		if err := p.Parse(); !errors.As(err, &syntaxErr) {
+			t.Fatalf("%q: got %v, expected a syntax error", expression, err)
+		}
+		if syntaxErr.Hint() != hint {
+			t.Errorf("%q: got the hint %q, expected %q", expression, syntaxErr.Hint(), hint)
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+func TestSanitize(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateExpressions(t, func(p *generator.Peg) { p.Tolerant = true }),
+		"t_test.go": `package p
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	for _, c := range []struct {
+		expression, sanitized string
+		edits                 []SanitizeEdit
+	}{
+		{"1 + 2", "1 + 2", nil},
+		{"1 + * 2", "1 +  2", []SanitizeEdit{{4, 1, ""}}},
+		{"( 1 + 2", "( 1 + 2)", []SanitizeEdit{{7, 0, ")"}}},
+		{"( 1 + * 2", "( 1 +  2)", []SanitizeEdit{{6, 1, ""}, {9, 0, ")"}}},
+		{"1 +#x 2", "1 + 2", []SanitizeEdit{{3, 2, ""}}},
+		{"1 +# x 2", "1 +  2", []SanitizeEdit{{3, 1, ""}, {5, 1, ""}}},
+		{"1 + * 2 ) * 3 3", "1 + ( 2 ) * 3 ", []SanitizeEdit{{4, 1, "("}, {14, 1, ""}}},
+	} {
+		p := &T{Buffer: c.expression}
+		p.Init()
+		edits, err := p.Sanitize()
+		if err != nil {
+			t.Fatalf("%q: %v", c.expression, err)
+		}
+		if !reflect.DeepEqual(edits, c.edits) || p.Buffer != c.sanitized {
+			t.Errorf("%q: got %q %+v, expected %q %+v", c.expression, p.Buffer, edits, c.sanitized, c.edits)
+		}
+	}
+}
+`,
+	}, nil)
+}
+
+// values is a grammar of nested lists, with the terminals, predicates,
+// pushes and actions which -iterative compiles.
+const values = `package p
+
+type T Peg {
+	numbers, strings, words int
+}
+
+Start <- sp Value+ !.
+Value <- open (Value (',' sp)?)* close
+       / < [0-9]+ ('.' [0-9]+)? > sp { p.numbers++ }
+       / '"' < (!'"' .)* > '"' sp { p.strings++ }
+       / Keyword sp
+       / < [a-z] [a-z0-9]* > sp &{ position > 0 } { p.words++ }
+Keyword <- ('true' / 'false' / 'null') ![a-z0-9]
+open <- '(' sp
+close <- ')' sp
+sp <- [ \t\n]*
+`
+
+// generateValues returns the parser of values generated with the options of
+// set, if it isn't nil.
+func generateValues(t *testing.T, noast bool, set func(p *generator.Peg)) string {
+	t.Helper()
+	p := &generator.Peg{Tree: tree.New(true, true, noast), Buffer: values}
+	_ = p.Init(generator.Size(1 << 15))
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if set != nil {
+		set(p)
+	}
+	out := &bytes.Buffer{}
+	if err := p.Compile("t.peg.go", []string{"peg"}, out); err != nil {
+		t.Fatal(err)
+	}
+	return out.String()
+}
+
+func TestIterative(t *testing.T) {
+	/* the parsers generated with and without -iterative write the same
+	   results, errors and syntax trees */
+	test := `package p
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	var results []string
+	for _, input := range []string{
+		"(1, (2.5, \"a\"), true, x1)",
+		"((((()))))",
+		"(1, 2",
+		"(true1 nullx) \"b\" 3",
+		"",
+		"( ) ) ",
+		strings.Repeat("(a ", 200) + strings.Repeat(")", 200),
+	} {
+		p := &T{Buffer: input}
+		p.Init()
+		err := p.Parse()
+		result := fmt.Sprint(err)
+		if err == nil {
+			%v
+		}
+		results = append(results, result)
+	}
+	if err := os.WriteFile("results.txt", []byte(strings.Join(results, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+`
+	for _, noast := range []bool{false, true} {
+		results := make([]string, 2)
+		for i, iterative := range []bool{false, true} {
+			execute := "p.Execute()\n\t\t\tresult += fmt.Sprint(p.numbers, p.strings, p.words, p.Tokens())"
+			if noast {
+				execute = "result += fmt.Sprint(p.numbers, p.strings, p.words)"
+			}
+			dir := runGenerated(t, map[string]string{
+				"t.peg.go":  generateValues(t, noast, func(p *generator.Peg) { p.Iterative = iterative }),
+				"t_test.go": fmt.Sprintf(test, execute),
+			}, nil)
+			content, err := os.ReadFile(filepath.Join(dir, "results.txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			results[i] = string(content)
+		}
+		if results[0] != results[1] {
+			t.Errorf("noast %v: got\n%v\nexpected\n%v", noast, results[1], results[0])
+		}
+	}
+
+	for _, c := range []struct {
+		grammar, expected string
+	}{
+		{"Start <- Start 'a' / 'b'", "-iterative doesn't support left recursion"},
+		{"Start <- 'a' %hint \"b\" 'b'", "-iterative doesn't support %hint"},
+		{"%state { n int }\nStart <- 'a'", "-iterative doesn't support %state"},
+	} {
+		p := &generator.Peg{Tree: tree.New(false, false, false), Buffer: "package p\ntype T Peg {}\n" + c.grammar + "\n"}
+		_ = p.Init(generator.Size(1 << 15))
+		if err := p.Parse(); err != nil {
+			t.Fatal(err)
+		}
+		p.Execute()
+		p.Iterative = true
+		if err := p.Compile("t.peg.go", []string{"peg"}, &bytes.Buffer{}); err == nil || err.Error() != c.expected {
+			t.Errorf("%q: got %v, expected %v", c.grammar, err, c.expected)
+		}
+	}
+}
+
+func TestIterativeDepth(t *testing.T) {
+	runGenerated(t, map[string]string{
+		"t.peg.go": generateValues(t, false, func(p *generator.Peg) { p.Iterative = true }),
+		"t_test.go": `package p
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+func TestDepth(t *testing.T) {
+	/* the nesting would need far more than 1 MB of goroutine stack with a
+	   Go call per rule, and memoizing its lists would copy their tokens
+	   for each level */
+	debug.SetMaxStack(1 << 20)
+	depth := 100000
+	p := &T{Buffer: strings.Repeat("(", depth) + strings.Repeat(")", depth)}
+	p.Init(DisableMemoize())
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	p = &T{Buffer: strings.Repeat("(", 1000) + strings.Repeat(")", 1000)}
+	p.Init(MaxDepth(1000))
+	err := p.Parse()
+	if err == nil || !strings.Contains(err.Error(), "nested deeper than 1000") {
+		t.Fatalf("got %v, expected a depth error", err)
+	}
+}
+`,
+	}, nil)
+}
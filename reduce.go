@@ -0,0 +1,38 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// reduce shrinks input, which the parser of the grammar in file fails to
+// parse, panics on, or parses slower than -slow, to a minimal input doing the
+// same, and prints it, or writes it back to input with -fix.
+func reduce(file, input string) {
+	path, err := filepath.Abs(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	env := []string{"PEG_REDUCE_INPUT=" + path}
+	if *slow > 0 {
+		env = append(env, "PEG_REDUCE_SLOW="+slow.String())
+	}
+	p := loadGrammar(file)
+	/* syntax errors fail the same way if they expect the same terminals */
+	p.Expected = true
+	reduced := runGeneratedTest(p, file, "", "reduce", p.CompileReduce, env...)
+	if *fix {
+		if err = os.WriteFile(input, reduced, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if _, err = os.Stdout.Write(reduced); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -187,19 +187,22 @@ func (s *Set) Complement(endSymbol rune) *Set {
 	}
 	a = a.Forward
 	for a.Forward != nil {
-		node := Node{
-			Backward: b,
-			Begin:    pre,
-			End:      a.Begin - 1,
+		/* adjacent ranges leave no gap to add */
+		if pre < a.Begin {
+			node := Node{
+				Backward: b,
+				Begin:    pre,
+				End:      a.Begin - 1,
+			}
+			b.Forward = &node
+			b = b.Forward
 		}
 		if a.End == endSymbol {
 			pre = endSymbol
 		} else {
 			pre = a.End + 1
 		}
-		b.Forward = &node
 		a = a.Forward
-		b = b.Forward
 	}
 	if pre < endSymbol {
 		node := Node{
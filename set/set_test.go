@@ -113,6 +113,19 @@ func TestComplement(t *testing.T) {
 	if !s.Equal(c2) {
 		t.Fatal("sets should be equal")
 	}
+
+	s = NewSet()
+	s.Add('a')
+	s.Add('b')
+	s.Add('d')
+	c := s.Complement('z')
+	if c.Has('a') || c.Has('b') || !c.Has('c') || c.Has('d') || c.Len() != 'z'-2 {
+		t.Fatalf("got the complement %v of %v", c, s)
+	}
+	c.AddRange('b', 'c')
+	if c.Len() != 'z'-1 {
+		t.Fatalf("got %v after adding b and c to the complement", c)
+	}
 }
 
 func TestUnion(t *testing.T) {
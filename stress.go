@@ -0,0 +1,124 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// stressGrammar returns a grammar of nested parentheses around words, which
+// are matched by width alternative rules tried in turn.
+func stressGrammar(width int) string {
+	grammar := &strings.Builder{}
+	grammar.WriteString("# Code generated by peg stress. DO NOT EDIT.\n\n")
+	grammar.WriteString("package stress\n\ntype Stress Peg {\n}\n\n")
+	grammar.WriteString("Start <- Spacing Node* !.\n")
+	grammar.WriteString("Node <- Open Node* Close")
+	for i := 0; i < width; i++ {
+		fmt.Fprintf(grammar, " / Word%d", i)
+	}
+	grammar.WriteString("\nOpen <- '(' Spacing\nClose <- ')' Spacing\n")
+	for i := 0; i < width; i++ {
+		fmt.Fprintf(grammar, "Word%d <- 'w%d:' [a-z]* Spacing\n", i, i)
+	}
+	grammar.WriteString("Spacing <- [ \\n]*\n")
+	return grammar.String()
+}
+
+// stressInput returns at least size bytes of input for the grammar returned by
+// stressGrammar, with every word nested depth parentheses deep.
+func stressInput(depth, width, size int) string {
+	input := &strings.Builder{}
+	for i := 0; input.Len() < size; i++ {
+		input.WriteString(strings.Repeat("(", depth))
+		fmt.Fprintf(input, "w%d:stress", i%width)
+		input.WriteString(strings.Repeat(")", depth))
+		input.WriteString("\n")
+	}
+	return input.String()
+}
+
+const stressTest = `// Code generated by peg stress. DO NOT EDIT.
+
+package stress
+
+import (
+	"os"
+	"testing"
+)
+
+func TestStress(t *testing.T) {
+	buffer, err := os.ReadFile("stress.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Stress{Buffer: string(buffer)}
+	if err := p.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+%s}
+
+func BenchmarkStress(b *testing.B) {
+	buffer, err := os.ReadFile("stress.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	p := &Stress{Buffer: string(buffer)}
+	if err := p.Init(); err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(buffer)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Reset()
+		if err := p.Parse(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+`
+
+// stressTokens checks the addressing of the tokens at the end of the input.
+const stressTokens = `	end := 0
+	for _, token := range p.Tokens() {
+		if int(token.end) > end {
+			end = int(token.end)
+		}
+	}
+	if runes := len([]rune(string(buffer))); end != runes {
+		t.Fatalf("the tokens end at %v, expected %v", end, runes)
+	}
+`
+
+// writeStress writes the grammar stress.peg, its input stress.txt and the test
+// stress_test.go, which parses the input, into dir.
+func writeStress(dir string, depth, width, size int, ast bool) error {
+	if depth < 0 || width < 1 || size < 0 {
+		return fmt.Errorf("invalid stress depth %v, width %v or size %v", depth, width, size)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tokens := ""
+	if ast {
+		tokens = stressTokens
+	}
+	files := map[string]string{
+		"stress.peg":     stressGrammar(width),
+		"stress.txt":     stressInput(depth, width, size),
+		"stress_test.go": fmt.Sprintf(stressTest, tokens),
+	}
+	for name, content := range files {
+		writeOutput(filepath.Join(dir, name), []byte(content))
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// substitute parses input with the parser of the grammar in file, and prints
+// it with the matches of -rule replaced by -template, or writes it back to
+// input with -fix.
+func substitute(file, input string) {
+	if *substituteRule == "" {
+		log.Fatal("rewrite requires -rule")
+	}
+	path, err := filepath.Abs(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	p := loadGrammar(file)
+	p.Substitution = true
+	substituted := runGeneratedTest(p, file, "", "substitute", p.CompileSubstitute,
+		"PEG_SUBSTITUTE_INPUT="+path,
+		"PEG_SUBSTITUTE_RULE="+*substituteRule,
+		"PEG_SUBSTITUTE_TEMPLATE="+*substituteTemplate)
+	if *fix {
+		if err = os.WriteFile(input, substituted, 0o644); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if _, err = os.Stdout.Write(substituted); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// BackendVersion is the version of the protocol spoken with backends. It
+// changes only when a change of the messages breaks existing backends.
+const BackendVersion = 1
+
+// IR is the grammar as it is given to a backend: the declarations of the
+// grammar and its rules, as parsed and before any optimization. Literals
+// matched case insensitively and %word boundaries are already expanded into
+// the expressions.
+type IR struct {
+	Package string   `json:"package"`
+	Imports []string `json:"imports,omitempty"`
+	Name    string   `json:"name"`
+	State   string   `json:"state,omitempty"`
+	Rules   []IRRule `json:"rules"`
+}
+
+// IRRule is a rule of the grammar. The first rule is the start rule. Memo is
+// set if the rule is marked with %memo, NoMemo lists "failures" or
+// "successes" if the rule is marked with %nomemo, MemoKey
+// is the state fingerprint given with %memokey, Kind is the constant the rule
+// is mapped to with %map, Recovery is set if the rule is marked with
+// %recovery, and Build is the Go expression building its value given with ->.
+type IRRule struct {
+	Name       string   `json:"name"`
+	Memo       bool     `json:"memo,omitempty"`
+	NoMemo     []string `json:"nomemo,omitempty"`
+	MemoKey    string   `json:"memokey,omitempty"`
+	Kind       string   `json:"kind,omitempty"`
+	Recovery   bool     `json:"recovery,omitempty"`
+	Build      string   `json:"build,omitempty"`
+	Expression *IRNode  `json:"expression"`
+}
+
+// IRNode is an expression of a rule. Type is the name of the node type
+// without the "Type" prefix, such as "Sequence", "Star" or "Character". Text
+// is the name of a referenced rule, the literal matched by a "Character" or a
+// "String", the escape sequence of a "Property", such as \p{L} or \P{Greek},
+// or the code of an "Action", a "Predicate", a "StateChange" or an "In". The
+// bounds of a "Range" are its two "Character" children.
+type IRNode struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	Children []*IRNode `json:"children,omitempty"`
+}
+
+// BackendRequest is written as JSON to the standard input of a backend.
+// Output is the file name given with -output, if any, and Args are the
+// arguments peg was run with.
+type BackendRequest struct {
+	Version int      `json:"version"`
+	Output  string   `json:"output,omitempty"`
+	Args    []string `json:"args"`
+	Grammar *IR      `json:"grammar"`
+}
+
+// BackendFile is a file written by peg for a backend, with a name relative to
+// the directory of the grammar.
+type BackendFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// BackendResponse is read as JSON from the standard output of a backend. A
+// backend failing to generate the files sets Error, which peg reports.
+type BackendResponse struct {
+	Files []BackendFile `json:"files"`
+	Error string        `json:"error,omitempty"`
+}
+
+// IR returns the grammar for a backend. It must be called before Compile.
+func (t *Tree) IR() *IR {
+	ir := &IR{}
+	var toIR func(n Node) *IRNode
+	toIR = func(n Node) *IRNode {
+		irNode := &IRNode{Type: strings.TrimPrefix(TypeMap[n.GetType()], "Type")}
+		switch n.GetType() {
+		case TypeName, TypeCharacter, TypeAction, TypePredicate, TypeStateChange, TypeIn, TypeKeyword, TypeHint, TypeProperty:
+			irNode.Text = n.String()
+		case TypeString:
+			irNode.Text = n.String()[1 : len(n.String())-1]
+		}
+		for _, child := range n.Slice() {
+			irNode.Children = append(irNode.Children, toIR(child))
+		}
+		return irNode
+	}
+	for _, n := range t.Slice() {
+		switch n.GetType() {
+		case TypePackage:
+			ir.Package = n.String()
+		case TypeImport:
+			ir.Imports = append(ir.Imports, n.String())
+		case TypePeg:
+			ir.Name = n.String()
+			if state := n.Front(); state != nil {
+				ir.State = state.String()
+			}
+		case TypeRule:
+			rule := IRRule{Name: n.String(), Memo: t.memo[n.String()], MemoKey: t.memoKeys[n.String()], Recovery: t.recovery[n.String()], Build: t.builds[n.String()], Expression: toIR(n.Front())}
+			for _, kind := range []string{"failures", "successes"} {
+				if t.noMemo[kind][n.String()] {
+					rule.NoMemo = append(rule.NoMemo, kind)
+				}
+			}
+			for _, kind := range t.Kinds {
+				if kind.Rule == n.String() {
+					rule.Kind = kind.Constant
+				}
+			}
+			ir.Rules = append(ir.Rules, rule)
+		}
+	}
+	return ir
+}
+
+// Backend runs the backend command name with arg, writing the request for
+// the grammar to its standard input, and returns the files of its response.
+// The standard error of the backend is passed through. It must be called
+// before Compile.
+func (t *Tree) Backend(output string, args []string, name string, arg ...string) ([]BackendFile, error) {
+	request, err := json.Marshal(&BackendRequest{
+		Version: BackendVersion,
+		Output:  output,
+		Args:    args,
+		Grammar: t.IR(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	stdout := &bytes.Buffer{}
+	cmd := exec.Command(name, arg...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = bytes.NewReader(request), stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("backend %v: %w", name, err)
+	}
+	var response BackendResponse
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("backend %v: invalid response: %w", name, err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("backend %v: %v", name, response.Error)
+	}
+	for _, file := range response.Files {
+		if !filepath.IsLocal(file.Name) {
+			return nil, fmt.Errorf("backend %v: file name %q is not local", name, file.Name)
+		}
+	}
+	return response.Files, nil
+}
+
+// ServeBackend implements a backend in Go: it reads the request from the
+// standard input, generates the files with generate and writes the response
+// to the standard output.
+func ServeBackend(generate func(request *BackendRequest) ([]BackendFile, error)) error {
+	var request BackendRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&request); err != nil {
+		return err
+	}
+	var response BackendResponse
+	if request.Version != BackendVersion {
+		response.Error = fmt.Sprintf("unsupported protocol version %v, expected %v", request.Version, BackendVersion)
+	} else if files, err := generate(&request); err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Files = files
+	}
+	return json.NewEncoder(os.Stdout).Encode(&response)
+}
@@ -0,0 +1,72 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree
+
+import (
+	"fmt"
+	"io"
+)
+
+// Graph writes the rules of the grammar and the rules they refer to as a
+// Graphviz digraph. The start rule is drawn with a double border, and rules
+// used but not defined are dashed. It must be called before Compile.
+func (t *Tree) Graph(out io.Writer) error {
+	name := ""
+	var rules []Node
+	defined := make(map[string]bool)
+	for _, n := range t.Slice() {
+		switch n.GetType() {
+		case TypePeg:
+			name = n.String()
+		case TypeRule:
+			rules = append(rules, n)
+			defined[n.String()] = true
+		}
+	}
+
+	w := &graphWriter{w: out}
+	w.printf("digraph %q {\n", name)
+	w.printf("\tnode [shape=box];\n")
+	for i, rule := range rules {
+		if i == 0 {
+			w.printf("\t%q [peripheries=2];\n", rule.String())
+		} else {
+			w.printf("\t%q;\n", rule.String())
+		}
+	}
+	undefined := make(map[string]bool)
+	for _, rule := range rules {
+		edges := make(map[string]bool)
+		var reference func(n Node)
+		reference = func(n Node) {
+			if n.GetType() == TypeName && !edges[n.String()] {
+				edges[n.String()] = true
+				if !defined[n.String()] && !undefined[n.String()] {
+					undefined[n.String()] = true
+					w.printf("\t%q [style=dashed];\n", n.String())
+				}
+				w.printf("\t%q -> %q;\n", rule.String(), n.String())
+			}
+			for _, element := range n.Slice() {
+				reference(element)
+			}
+		}
+		reference(rule.Front())
+	}
+	w.printf("}\n")
+	return w.err
+}
+
+// graphWriter keeps the first error of a sequence of writes.
+type graphWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *graphWriter) printf(format string, a ...any) {
+	if w.err == nil {
+		_, w.err = fmt.Fprintf(w.w, format, a...)
+	}
+}
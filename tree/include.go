@@ -0,0 +1,92 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// include is a file of rules given with %include, and the renames of its rules.
+type include struct {
+	file    string
+	renames [][2]string
+}
+
+// AddInclude includes the rules of file, relative to the grammar.
+func (t *Tree) AddInclude(file string) { t.includes = append(t.includes, include{file: file}) }
+
+// AddRename renames the rule name of the file included last, to the name set
+// by the following SetRename.
+func (t *Tree) AddRename(name string) {
+	last := &t.includes[len(t.includes)-1]
+	last.renames = append(last.renames, [2]string{name, ""})
+}
+
+// SetRename sets the new name of the rule given last to AddRename.
+func (t *Tree) SetRename(name string) {
+	renames := t.includes[len(t.includes)-1].renames
+	renames[len(renames)-1][1] = name
+}
+
+// Include appends the rules of the files given with %include after the rules of
+// the grammar. The files are relative to dir and hold only rules, without the
+// package and parser declarations, and parse turns their content into a tree.
+// A rule is renamed along with the references to it in its file, so that rules
+// of different files don't collide, and a file included again is skipped. It
+// must be called before Check and Compile.
+func (t *Tree) Include(dir string, parse func(buffer string) (*Tree, error)) error {
+	included := make(map[string]bool)
+	for _, i := range t.includes {
+		file := filepath.Join(dir, i.file)
+		if included[file] {
+			continue
+		}
+		included[file] = true
+		buffer, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("%%include: %w", err)
+		}
+		rules, err := parse(string(buffer))
+		if err != nil {
+			return fmt.Errorf("%%include %v: %w", i.file, err)
+		}
+		for _, element := range rules.Slice() {
+			if element.GetType() == TypePackage {
+				return fmt.Errorf("%%include %v: included files only define rules, without a package declaration", i.file)
+			}
+		}
+		for _, rename := range i.renames {
+			if err = rules.RenameRule(rename[0], rename[1]); err != nil {
+				return fmt.Errorf("%%include %v: %w", i.file, err)
+			}
+		}
+		/* the lines of the actions are in another file */
+		rules.Walk(func(n Node) bool {
+			n.(*node).line = 0
+			return true
+		})
+		for name, source := range rules.ruleLines() {
+			source.File = filepath.ToSlash(i.file)
+			t.sources[name] = source
+		}
+		for _, rule := range rules.Slice() {
+			if rule.GetType() != TypeRule {
+				continue
+			}
+			if err = t.AppendRule(rule.String(), rule.Front()); err != nil {
+				return fmt.Errorf("%%include %v: %w, rename it with %v = NewName", i.file, err, rule)
+			}
+			if build, ok := rules.builds[rule.String()]; ok {
+				t.builds[rule.String()] = build
+			}
+		}
+		for _, err := range rules.errors {
+			t.errors = append(t.errors, fmt.Errorf("%%include %v: %w", i.file, err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,757 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"unicode"
+
+	"github.com/pointlander/peg/set"
+)
+
+// ErrMissingEOF is reported by Lint if the start rule doesn't end with !., so
+// that the parser silently accepts trailing input.
+var ErrMissingEOF = errors.New("doesn't end with end of input (!.)")
+
+// The other problems reported by Lint: rules the start rule doesn't use,
+// rules which can never match, alternatives of a choice which are never tried
+// as an earlier alternative matches whenever they would, like 'a' / 'ab', and
+// repetitions of expressions matching the empty string, which loop forever.
+var (
+	ErrUnused       = errors.New("is not used by the start rule")
+	ErrNeverMatches = errors.New("can never match")
+	ErrUnreachable  = errors.New("is unreachable")
+	ErrEmptyLoop    = errors.New("can match the empty string, looping forever")
+)
+
+// Lint reports common mistakes in the grammar. It must be called before
+// Compile.
+func (t *Tree) Lint() []error {
+	var problems []error
+	var start Node
+	var ordered []Node
+	rules := make(map[string]Node)
+	for _, element := range t.Slice() {
+		if element.GetType() == TypeRule {
+			if start == nil {
+				start = element
+			}
+			ordered = append(ordered, element)
+			rules[element.String()] = element
+		}
+	}
+	if start == nil {
+		return nil
+	}
+
+	visiting := make(map[string]bool)
+	var endsWithEOF func(n Node) bool
+	endsWithEOF = func(n Node) bool {
+		switch n.GetType() {
+		case TypePeekNot:
+			return n.Front().GetType() == TypeDot
+		case TypeSequence:
+			elements := n.Slice()
+			for i := len(elements) - 1; i >= 0; i-- {
+				switch elements[i].GetType() {
+				case TypeAction, TypePredicate, TypeStateChange, TypeHint:
+					continue
+				}
+				return endsWithEOF(elements[i])
+			}
+		case TypeAlternate:
+			for _, element := range n.Slice() {
+				if !endsWithEOF(element) {
+					return false
+				}
+			}
+			return true
+		case TypePush:
+			return endsWithEOF(n.Front())
+		case TypeName:
+			rule, ok := rules[n.String()]
+			if !ok || visiting[n.String()] {
+				return false
+			}
+			visiting[n.String()] = true
+			defer delete(visiting, n.String())
+			return endsWithEOF(rule.Front())
+		}
+		return false
+	}
+	if !endsWithEOF(start.Front()) {
+		problems = append(problems, fmt.Errorf("start rule '%v' %w", start, ErrMissingEOF))
+	}
+
+	used := map[string]bool{start.String(): true}
+	var use func(n Node)
+	use = func(n Node) {
+		if n.GetType() == TypeName {
+			if rule, ok := rules[n.String()]; ok && !used[n.String()] {
+				used[n.String()] = true
+				use(rule.Front())
+			}
+		}
+		for _, element := range n.Slice() {
+			use(element)
+		}
+	}
+	use(start.Front())
+	for _, rule := range ordered {
+		if !used[rule.String()] {
+			problems = append(problems, fmt.Errorf("rule '%v' %w", rule, ErrUnused))
+		}
+	}
+
+	l := &linter{rules: rules, matches: make(map[string]bool), empty: make(map[string]int)}
+	l.fixedPoint()
+	for _, rule := range ordered {
+		if !l.matches[rule.String()] {
+			problems = append(problems, fmt.Errorf("rule '%v' %w", rule, ErrNeverMatches))
+		}
+	}
+	for _, rule := range ordered {
+		var walk func(n Node)
+		walk = func(n Node) {
+			switch n.GetType() {
+			case TypeAlternate:
+				elements := n.Slice()
+				for j := 1; j < len(elements); j++ {
+					for i := 0; i < j; i++ {
+						if l.covers(elements[i], elements[j]) {
+							problems = append(problems, fmt.Errorf("alternative %d of a choice in rule '%v' %w, alternative %d matches first", j+1, rule, ErrUnreachable, i+1))
+							break
+						}
+					}
+				}
+			case TypeStar, TypePlus:
+				if l.nullable(n.Front()) != emptyNowhere {
+					problems = append(problems, fmt.Errorf("a repetition in rule '%v' %w", rule, ErrEmptyLoop))
+				}
+			}
+			for _, element := range n.Slice() {
+				walk(element)
+			}
+		}
+		walk(rule.Front())
+	}
+	return problems
+}
+
+// A linter analyzes the expressions of the rules of a grammar. matches holds
+// the rules which can match some input, and empty where the rules can match
+// the empty string, both computed by fixedPoint.
+type linter struct {
+	rules    map[string]Node
+	matches  map[string]bool
+	empty    map[string]int
+	visiting map[string]bool
+}
+
+// fixedPoint computes matches and empty, starting from no rules and adding
+// rules until nothing changes, so that a rule only matches through itself if
+// it can match without itself as well.
+func (l *linter) fixedPoint() {
+	for changed := true; changed; {
+		changed = false
+		for name, rule := range l.rules {
+			if !l.matches[name] && l.canMatch(rule.Front()) {
+				l.matches[name], changed = true, true
+			}
+			if where := l.empty[name] | l.nullable(rule.Front()); where != l.empty[name] {
+				l.empty[name], changed = where, true
+			}
+		}
+	}
+}
+
+// canMatch reports whether n matches some input, as far as known by matches.
+func (l *linter) canMatch(n Node) bool {
+	switch n.GetType() {
+	case TypeName:
+		if _, ok := l.rules[n.String()]; !ok {
+			return true
+		}
+		return l.matches[n.String()]
+	case TypeSequence:
+		for _, element := range n.Slice() {
+			if !l.canMatch(element) {
+				return false
+			}
+		}
+	case TypeAlternate:
+		for _, element := range n.Slice() {
+			if l.canMatch(element) {
+				return true
+			}
+		}
+		return false
+	case TypePlus, TypePush, TypeImplicitPush, TypePeekFor:
+		return l.canMatch(n.Front())
+	case TypePeekNot:
+		return !l.infallible(n.Front())
+	}
+	return true
+}
+
+// The positions where an expression can match the empty string, given by
+// nullable: nowhere, at the end of the input, before the end of the input,
+// or anywhere. They are bits, so a sequence can match the empty string where
+// all its elements can, and a choice where any of them can.
+const (
+	emptyNowhere  = 0
+	emptyAtEnd    = 1
+	emptyNotAtEnd = 2
+	emptyAnywhere = emptyAtEnd | emptyNotAtEnd
+)
+
+// nullable returns where n can match the empty string, as far as known by
+// empty. Predicates like !. and !EOF, with EOF <- !., are taken into
+// account, so that repetitions like (!EOF Line)* don't match the empty
+// string even though Line does at the end of the input.
+func (l *linter) nullable(n Node) int {
+	switch n.GetType() {
+	case TypeName:
+		return l.empty[n.String()]
+	case TypeSequence:
+		where := emptyAnywhere
+		for _, element := range n.Slice() {
+			where &= l.nullable(element)
+		}
+		return where
+	case TypeAlternate:
+		where := emptyNowhere
+		for _, element := range n.Slice() {
+			where |= l.nullable(element)
+		}
+		return where
+	case TypePlus, TypePush, TypeImplicitPush:
+		return l.nullable(n.Front())
+	case TypePeekFor:
+		if l.isEnd(n.Front()) {
+			return emptyAtEnd
+		}
+	case TypePeekNot:
+		switch {
+		case l.infallible(n.Front()):
+			return emptyNowhere
+		case n.Front().GetType() == TypeDot:
+			return emptyAtEnd
+		case l.isEnd(n.Front()):
+			return emptyNotAtEnd
+		}
+	case TypeCharacter, TypeRange, TypeDot, TypeNotClass, TypeProperty, TypeKeyword:
+		return emptyNowhere
+	case TypeString:
+		if n.String() != "" {
+			return emptyNowhere
+		}
+	}
+	return emptyAnywhere
+}
+
+// isEnd reports whether n matches the end of the input, and nothing else,
+// like !. does.
+func (l *linter) isEnd(n Node) bool {
+	switch n.GetType() {
+	case TypePeekNot:
+		return n.Front().GetType() == TypeDot
+	case TypeSequence:
+		end := false
+		for _, element := range n.Slice() {
+			switch element.GetType() {
+			case TypeAction, TypeHint, TypeCommit:
+				continue
+			}
+			if end || !l.isEnd(element) {
+				return false
+			}
+			end = true
+		}
+		return end
+	case TypePush, TypeImplicitPush:
+		return l.isEnd(n.Front())
+	case TypeName:
+		return l.resolve(n, l.isEnd)
+	}
+	return false
+}
+
+// infallible reports whether n always matches, without consuming anything
+// it can't.
+func (l *linter) infallible(n Node) bool {
+	switch n.GetType() {
+	case TypeStar, TypeQuery, TypeNil, TypeAction, TypeHint, TypeCommit:
+		return true
+	case TypeSequence:
+		for _, element := range n.Slice() {
+			if !l.infallible(element) {
+				return false
+			}
+		}
+		return true
+	case TypeAlternate:
+		for _, element := range n.Slice() {
+			if l.infallible(element) {
+				return true
+			}
+		}
+	case TypePush, TypeImplicitPush, TypePeekFor:
+		return l.infallible(n.Front())
+	case TypeName:
+		return l.resolve(n, l.infallible)
+	}
+	return false
+}
+
+// resolve returns f of the rule n refers to, or false for an undefined or
+// recursive rule.
+func (l *linter) resolve(n Node, f func(n Node) bool) bool {
+	rule, ok := l.rules[n.String()]
+	if !ok || l.visiting[n.String()] {
+		return false
+	}
+	if l.visiting == nil {
+		l.visiting = make(map[string]bool)
+	}
+	l.visiting[n.String()] = true
+	defer delete(l.visiting, n.String())
+	return f(rule.Front())
+}
+
+// covers reports whether earlier matches whenever later matches, so that
+// later is never tried after earlier in a choice: the characters every match
+// of later starts with are enough for earlier to match.
+func (l *linter) covers(earlier, later Node) bool {
+	sufficient, ok := l.sufficient(earlier)
+	if !ok {
+		return false
+	}
+	prefix := l.prefix(later)
+	if len(prefix) < len(sufficient) {
+		return false
+	}
+	for i, s := range sufficient {
+		if !prefix[i].Union(s).Equal(s) {
+			return false
+		}
+	}
+	return true
+}
+
+// sufficient returns the sets of characters, such that n matches any input
+// starting with one character of each, one after the other. n always matches
+// if there are none.
+func (l *linter) sufficient(n Node) ([]*set.Set, bool) {
+	if fixed, ok := l.fixed(n); ok {
+		return fixed, true
+	}
+	if l.infallible(n) {
+		return nil, true
+	}
+	switch n.GetType() {
+	case TypeSequence:
+		var sets []*set.Set
+		elements := n.Slice()
+		for i, element := range elements {
+			switch element.GetType() {
+			case TypeAction, TypeHint, TypeCommit:
+				continue
+			}
+			if fixed, ok := l.fixed(element); ok {
+				sets = append(sets, fixed...)
+				continue
+			}
+			/* the rest must match wherever element ends */
+			for _, rest := range elements[i+1:] {
+				if !l.infallible(rest) {
+					return nil, false
+				}
+			}
+			sufficient, ok := l.sufficient(element)
+			return append(sets, sufficient...), ok
+		}
+		return sets, true
+	case TypePlus, TypePush, TypeImplicitPush:
+		return l.sufficient(n.Front())
+	case TypeName:
+		var sets []*set.Set
+		ok := l.resolve(n, func(n Node) bool {
+			var ok bool
+			sets, ok = l.sufficient(n)
+			return ok
+		})
+		return sets, ok
+	}
+	return nil, false
+}
+
+// fixed returns the sets of the characters n matches one after the other, if
+// n always matches exactly one character of each, and nothing else.
+func (l *linter) fixed(n Node) ([]*set.Set, bool) {
+	switch n.GetType() {
+	case TypeCharacter:
+		s := set.NewSet()
+		s.Add([]rune(n.String())[0])
+		return []*set.Set{s}, true
+	case TypeString:
+		var sets []*set.Set
+		for _, r := range n.String() {
+			s := set.NewSet()
+			s.Add(r)
+			sets = append(sets, s)
+		}
+		return sets, true
+	case TypeDot:
+		s := set.NewSet()
+		s.AddRange(0, unicode.MaxRune)
+		return []*set.Set{s}, true
+	case TypeRange, TypeNotClass, TypeProperty:
+		return []*set.Set{classSet(n)}, true
+	case TypeAlternate:
+		s := set.NewSet()
+		for _, element := range n.Slice() {
+			f, ok := l.fixed(element)
+			if !ok || len(f) != 1 {
+				return nil, false
+			}
+			s = s.Union(f[0])
+		}
+		return []*set.Set{s}, true
+	case TypeSequence:
+		var sets []*set.Set
+		for _, element := range n.Slice() {
+			switch element.GetType() {
+			case TypeAction, TypeHint, TypeCommit:
+				continue
+			}
+			f, ok := l.fixed(element)
+			if !ok {
+				return nil, false
+			}
+			sets = append(sets, f...)
+		}
+		return sets, true
+	case TypePush, TypeImplicitPush:
+		return l.fixed(n.Front())
+	case TypeName:
+		var sets []*set.Set
+		ok := l.resolve(n, func(n Node) bool {
+			var ok bool
+			sets, ok = l.fixed(n)
+			return ok
+		})
+		return sets, ok
+	}
+	return nil, false
+}
+
+// prefix returns the sets of the characters every match of n starts with,
+// one after the other. The predicates and actions of n are skipped, as they
+// only restrict the matches further.
+func (l *linter) prefix(n Node) []*set.Set {
+	if fixed, ok := l.fixed(n); ok {
+		return fixed
+	}
+	switch n.GetType() {
+	case TypeSequence:
+		var sets []*set.Set
+		for _, element := range n.Slice() {
+			switch element.GetType() {
+			case TypeAction, TypeHint, TypeCommit, TypePredicate, TypeStateChange, TypePeekFor, TypePeekNot:
+				continue
+			}
+			if fixed, ok := l.fixed(element); ok {
+				sets = append(sets, fixed...)
+				continue
+			}
+			return append(sets, l.prefix(element)...)
+		}
+		return sets
+	case TypeAlternate:
+		var sets []*set.Set
+		for i, element := range n.Slice() {
+			prefix := l.prefix(element)
+			if i == 0 {
+				sets = prefix
+				continue
+			}
+			sets = sets[:min(len(sets), len(prefix))]
+			for j := range sets {
+				sets[j] = sets[j].Union(prefix[j])
+			}
+		}
+		return sets
+	case TypePlus, TypePush, TypeImplicitPush:
+		return l.prefix(n.Front())
+	case TypeName:
+		var sets []*set.Set
+		l.resolve(n, func(n Node) bool {
+			sets = l.prefix(n)
+			return true
+		})
+		return sets
+	}
+	return nil
+}
+
+// The names of the warnings, which control them with DisabledWarnings and
+// ErrorWarnings.
+const (
+	WarnUndefined     = "undefined"
+	WarnUnused        = "unused"
+	WarnLeftRecursion = "left-recursion"
+	WarnNoMemo        = "nomemo"
+	WarnMissingEOF    = "missing-eof"
+	WarnNeverMatches  = "never-matches"
+	WarnUnreachable   = "unreachable"
+	WarnEmptyLoop     = "empty-loop"
+	WarnInternal      = "internal"
+)
+
+// Warnings are the names of all warnings.
+var Warnings = []string{WarnUndefined, WarnUnused, WarnLeftRecursion, WarnNoMemo, WarnMissingEOF, WarnNeverMatches, WarnUnreachable, WarnEmptyLoop, WarnInternal}
+
+// Warning is a problem of the grammar which Compile reports without failing,
+// unless Strict is set or its Name is in ErrorWarnings.
+type Warning struct {
+	Name string
+	Err  error
+}
+
+func (w *Warning) Error() string { return "warning: " + w.Err.Error() }
+
+func (w *Warning) Unwrap() error { return w.Err }
+
+// warning returns err as the warning name, or nil if it is disabled.
+func (t *Tree) warning(name string, err error) error {
+	if t.DisabledWarnings[name] {
+		return nil
+	}
+	return &Warning{Name: name, Err: err}
+}
+
+// Fails reports whether problem, returned by Check or Compile, is an error,
+// or a warning treated as an error.
+func (t *Tree) Fails(problem error) bool {
+	var warning *Warning
+	if !errors.As(problem, &warning) {
+		return true
+	}
+	return t.Strict || t.ErrorWarnings[warning.Name]
+}
+
+// Check validates the grammar without compiling it, which is much faster for
+// large grammars. It returns the errors Compile would fail with, followed by
+// the warnings it would report and the problems found by Lint as *Warning. It
+// must be called before Compile.
+func (t *Tree) Check() []error {
+	problems := append([]error(nil), t.errors...)
+	warn := func(name string, err error) {
+		if warning := t.warning(name, err); warning != nil {
+			problems = append(problems, warning)
+		}
+	}
+
+	var rules []Node
+	var names []string
+	defined := make(map[string]Node)
+	for _, element := range t.Slice() {
+		if element.GetType() == TypeRule {
+			rules = append(rules, element)
+			names = append(names, element.String())
+			defined[element.String()] = element
+		}
+	}
+
+	used := make(map[string]bool)
+	var reference func(n Node)
+	reference = func(n Node) {
+		if n.GetType() == TypeName {
+			if _, ok := defined[n.String()]; !ok && !used[n.String()] && n.String() != "PegText" {
+				warn(WarnUndefined, fmt.Errorf("rule '%v' used but not defined%v", n, suggestion(n.String(), names)))
+			}
+			used[n.String()] = true
+		}
+		for _, element := range n.Slice() {
+			reference(element)
+		}
+	}
+	for _, rule := range rules {
+		reference(rule.Front())
+	}
+	for i, rule := range rules {
+		if i > 0 && !used[rule.String()] {
+			warn(WarnUnused, fmt.Errorf("rule '%v' defined but not used", rule))
+		}
+	}
+
+	reached, recursive := make(map[string]bool), make(map[string]bool)
+	var consumes func(n Node) bool
+	consumes = func(n Node) bool {
+		switch n.GetType() {
+		case TypeRule:
+			if reached[n.String()] {
+				if !recursive[n.String()] && !t.Ast {
+					warn(WarnLeftRecursion, fmt.Errorf("possible infinite left recursion in rule '%v'", n))
+					recursive[n.String()] = true
+				}
+				return false
+			}
+			reached[n.String()] = true
+			defer delete(reached, n.String())
+			return consumes(n.Front())
+		case TypeAlternate:
+			for _, element := range n.Slice() {
+				if !consumes(element) {
+					return false
+				}
+			}
+			return true
+		case TypeSequence:
+			for _, element := range n.Slice() {
+				if consumes(element) {
+					return true
+				}
+			}
+		case TypeName:
+			if rule, ok := defined[n.String()]; ok {
+				return consumes(rule)
+			}
+		case TypePlus, TypePush, TypeImplicitPush:
+			return consumes(n.Front())
+		case TypeCharacter, TypeString, TypeKeyword:
+			return len(n.String()) > 0
+		case TypeDot, TypeRange, TypeNotClass, TypeProperty:
+			return true
+		}
+		return false
+	}
+	for _, rule := range rules {
+		consumes(rule)
+	}
+
+	for _, kind := range []string{"failures", "successes"} {
+		var unknown []string
+		for name := range t.noMemo[kind] {
+			if _, ok := defined[name]; !ok {
+				unknown = append(unknown, name)
+			}
+		}
+		sort.Strings(unknown)
+		for _, name := range unknown {
+			warn(WarnNoMemo, fmt.Errorf("unknown rule '%v' in %%nomemo %v", name, kind))
+		}
+	}
+	var unknown []string
+	for name := range t.memo {
+		if _, ok := defined[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		warn(WarnNoMemo, fmt.Errorf("unknown rule '%v' in %%memo", name))
+	}
+	unknown = unknown[:0]
+	for name := range t.memoKeys {
+		if _, ok := defined[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		warn(WarnNoMemo, fmt.Errorf("unknown rule '%v' in %%memokey", name))
+	}
+	unknown = unknown[:0]
+	for name := range t.recovery {
+		if _, ok := defined[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		warn(WarnUndefined, fmt.Errorf("unknown rule '%v' in %%recovery", name))
+	}
+	unknown = unknown[:0]
+	for name := range t.caseRules {
+		if _, ok := defined[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		warn(WarnUndefined, fmt.Errorf("unknown rule '%v' in %%caseinsensitive", name))
+	}
+	for _, kind := range t.Kinds {
+		if _, ok := defined[kind.Rule]; !ok {
+			warn(WarnUndefined, fmt.Errorf("unknown rule '%v' in %%map", kind.Rule))
+		}
+	}
+	for _, benchmark := range t.Benchmarks {
+		if _, ok := defined[benchmark.Rule]; !ok {
+			problems = append(problems, fmt.Errorf("unknown rule '%v' in %%bench", benchmark.Rule))
+		}
+	}
+	for _, test := range t.Tests {
+		if _, ok := defined[test.Rule]; !ok {
+			problems = append(problems, fmt.Errorf("unknown rule '%v' in %%%v", test.Rule, test.directive()))
+		}
+	}
+
+	for _, problem := range t.Lint() {
+		switch {
+		case errors.Is(problem, ErrUnused):
+			/* the rules not used by any rule are already reported */
+		case errors.Is(problem, ErrNeverMatches):
+			warn(WarnNeverMatches, problem)
+		case errors.Is(problem, ErrUnreachable):
+			warn(WarnUnreachable, problem)
+		case errors.Is(problem, ErrEmptyLoop):
+			warn(WarnEmptyLoop, problem)
+		default:
+			warn(WarnMissingEOF, problem)
+		}
+	}
+	return problems
+}
+
+// suggestion returns ", did you mean 'X'?" for the name closest to name by
+// edit distance, or "" if none is close enough to be a likely misspelling.
+func suggestion(name string, names []string) string {
+	best, distance := "", len([]rune(name))/3+1
+	for _, candidate := range names {
+		if d := editDistance(name, candidate); d < distance || d == distance && best != "" && candidate < best {
+			best, distance = candidate, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(", did you mean '%v'?", best)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	row := make([]int, len(t)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		previous := row[0]
+		row[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			previous, row[j] = row[j], min(row[j]+1, row[j-1]+1, previous+cost)
+		}
+	}
+	return row[len(t)]
+}
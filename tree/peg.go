@@ -6,24 +6,31 @@ package tree
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/format"
 	"go/parser"
-	"go/printer"
 	"go/token"
 	"io"
 	"math"
 	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"text/template"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/pointlander/peg/set"
 )
 
-const pegHeaderTemplate = `// Code generated by {{.Generator}}. DO NOT EDIT.
+const pegPackageTemplate = `{{.Header}}
 
 {{.Comments}}
 
@@ -33,9 +40,9 @@ import (
 	{{range .Imports}}"{{.}}"
 	{{end}}
 )
+`
 
-const endSymbol rune = {{.EndSymbol}}
-
+const pegTokensTemplate = `
 /* The rule types inferred from the grammar are below. */
 type pegRule {{.PegRuleType}}
 
@@ -51,22 +58,106 @@ var rul3s = [...]string {
 	{{end}}
 }
 
+// RuleSource is a rule of the grammar, for tools presenting their results in
+// terms of the grammar, such as coverage, profiles or editors. File is the
+// grammar the rule is defined in, and Line and EndLine are its first and last
+// lines, or 0 for the rules added by peg, such as those of actions and < >. A
+// Lexical rule references no other rules, and an Inlined rule has no function
+// of its own, being matched within the rules referencing it.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+var ruleSources = [...]RuleSource {
+	{Name: "Unknown"},
+	{{range .RuleSources}}{ {{- printf "%q" .Name}}, {{printf "%q" .File}}, {{.Line}}, {{.EndLine}}, {{.Lexical}}, {{.Inlined -}} },
+	{{end}}
+}
+
+// RuleSources returns the rules of the grammar, indexed by their rule
+// constants.
+func RuleSources() []RuleSource {
+	return append([]RuleSource(nil), ruleSources[:]...)
+}
+{{if .Kinds}}
+// ruleKind returns the constant a rule is mapped to with %map, and whether
+// it is mapped, so that tokens and nodes can be converted to the token kinds
+// of other packages.
+var ruleKind = pegRuleKinds(
+	[]pegRule{ {{- range .Kinds}}rule{{.Rule}}, {{end -}} },
+	{{range .Kinds}}{{.Constant}}, {{end}}
+)
+
+func pegRuleKinds[T any](rules []pegRule, kinds ...T) func(rule pegRule) (T, bool) {
+	mapped := make(map[pegRule]T, len(rules))
+	for i, rule := range rules {
+		mapped[rule] = kinds[i]
+	}
+	return func(rule pegRule) (T, bool) {
+		kind, ok := mapped[rule]
+		return kind, ok
+	}
+}
+{{end}}
+// token32 spans the runes [begin, end) of the buffer. ByteOffset converts
+// begin and end to byte offsets into Buffer.
 type token32 struct {
 	pegRule
 	begin, end uint32
+{{- if .Lines}}
+	lines      *lineIndex
+{{- end}}
+}
+{{if .Lines}}
+// lineIndex holds the line of each position of the buffer and the positions
+// at which the lines begin. It's built when the parser is reset, and shared by
+// the tokens, so that their lines and columns are found without scanning the
+// buffer.
+type lineIndex struct {
+	lines  []uint32
+	starts []uint32
+}
+
+// position returns the line and the column of a position, both counted from
+// 1, or 0, 0 without an index.
+func (l *lineIndex) position(offset uint32) (line, column int) {
+	if l == nil || len(l.lines) == 0 {
+		return 0, 0
+	}
+	if int(offset) >= len(l.lines) {
+		offset = uint32(len(l.lines) - 1)
+	}
+	i := l.lines[offset]
+	return int(i) + 1, int(offset-l.starts[i]) + 1
+}
+
+// Position returns the line and the column of the beginning of the token,
+// both counted from 1. Columns count {{if .Bytes}}bytes{{else}}runes{{end}} like begin and end.
+func (t *token32) Position() (line, column int) {
+	return t.lines.position(t.begin)
+}
+
+// EndPosition returns the line and the column of the end of the token, just
+// past its last {{if .Bytes}}byte{{else}}rune{{end}}.
+func (t *token32) EndPosition() (line, column int) {
+	return t.lines.position(t.end)
 }
+{{end}}
 
+{{if not .NoPrint}}
 func (t *token32) String() string {
 	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
 }
-
+{{end}}
 {{if .Ast}}
 type node32 struct {
 	token32
 	up, next *node32
 }
-
-func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+{{if not .NoPrint}}
+func (node *node32) print(w io.Writer, pretty bool, buffer {{.BufferType}}) {
 	var print func(node *node32, depth int)
 	print = func(node *node32, depth int) {
 		for node != nil {
@@ -74,7 +165,11 @@ func (node *node32) print(w io.Writer, pretty bool, buffer string) {
 				fmt.Fprintf(w, " ")
 			}
 			rule := rul3s[node.pegRule]
+{{- if .Bytes}}
+			quote := strconv.Quote(string(buffer[node.begin:node.end]))
+{{- else}}
 			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+{{- end}}
 			if !pretty {
 				fmt.Fprintf(w, "%v %v\n", rule, quote)
 			} else {
@@ -89,27 +184,33 @@ func (node *node32) print(w io.Writer, pretty bool, buffer string) {
 	print(node, 0)
 }
 
-func (node *node32) Print(w io.Writer, buffer string) {
+func (node *node32) Print(w io.Writer, buffer {{.BufferType}}) {
 	node.print(w, false, buffer)
 }
 
-func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+func (node *node32) PrettyPrint(w io.Writer, buffer {{.BufferType}}) {
 	node.print(w, true, buffer)
 }
+{{end}}
 
 type tokens32 struct {
 	tree		[]token32
+{{- if .Lines}}
+	lines		*lineIndex
+{{- end}}
 }
 
 func (t *tokens32) Trim(length uint32) {
 	t.tree = t.tree[:length]
 }
 
+{{if not .NoPrint}}
 func (t *tokens32) Print() {
 	for _, token := range t.tree {
 		fmt.Println(token.String())
 	}
 }
+{{end}}
 
 func (t *tokens32) AST() *node32 {
 	type element struct {
@@ -136,44 +237,104 @@ func (t *tokens32) AST() *node32 {
 	return nil
 }
 
-func (t *tokens32) PrintSyntaxTree(buffer string) {
+{{if not .NoPrint}}
+func (t *tokens32) PrintSyntaxTree(buffer {{.BufferType}}) {
 	t.AST().Print(os.Stdout, buffer)
 }
 
-func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer {{.BufferType}}) {
 	t.AST().Print(w, buffer)
 }
 
-func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+func (t *tokens32) PrettyPrintSyntaxTree(buffer {{.BufferType}}) {
 	t.AST().PrettyPrint(os.Stdout, buffer)
 }
+{{end}}
 
 func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
 	tree, i := t.tree, int(index)
 	if i >= len(tree) {
-		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end{{if .Lines}}, lines: t.lines{{end}}})
 		return
 	}
-	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end{{if .Lines}}, lines: t.lines{{end}}}
 }
 
 func (t *tokens32) Tokens() []token32 {
 	return t.tree
 }
 {{end}}
+`
+
+const pegHeaderTemplate = `
+const endSymbol rune = {{.EndSymbol}}
+{{if .Bytes}}
+// byteBuffer is the input of a parser matching bytes. Reading past its end
+// gives endSymbol, which no byte equals.
+type byteBuffer []byte
+
+func (b byteBuffer) at(i uint32) rune {
+	if int(i) < len(b) {
+		return rune(b[i])
+	}
+	return endSymbol
+}
+{{end}}
 
 type {{.StructName}} struct {
 	{{.StructVariables}}
-	Buffer          string
-	buffer	        []rune
+{{if .StateFields -}}
+	pegState
+{{end -}}
+	Buffer          {{.BufferType}}
+	buffer	        {{if .Bytes}}byteBuffer{{else}}[]rune{{end}}
 	rules	        [{{.RulesCount}}]func() bool
 	parse	        func(rule ...int) error
+	find	        func(rule pegRule) ([]token32, error)
+	options	        []func(*{{.StructName}}) error
 	reset	        func()
 	Pretty          bool
+	farthest        uint32
+	matched         uint32
+	expected        []string
+	maxDepth        int
+	trackRules      bool
+	farthestRules   []string
+	farthestHint    string
+{{if .Watchdog -}}
+	watchdog        *watchdog
+{{end -}}
+{{if .Trace -}}
+	trace           func(TraceEvent)
+{{end -}}
+	filename        string
+	offsets         []int
+	crlf            bool
+	tabWidth        int
+	byteColumns     bool
+	crlfs           []uint32
+{{if .Lines -}}
+	lines           *lineIndex
+{{end -}}
+{{if .ErrorType -}}
+	{{.ErrorType}} {{.ErrorType}}
+{{end -}}
 {{if .Ast -}}
+	partial         bool
+	partialTokens   []token32
 	disableMemoize  bool
+{{if .Incremental -}}
+	edit            func(offset, deleted int, inserted {{.BufferType}}) error
+{{end -}}
 	tokens32
 {{end -}}
+{{if .HasRecover -}}
+	recoveries      map[uint32]failure
+	recovered       SyntaxErrors
+{{end -}}
+{{if .Captures -}}
+	captures        []token32
+{{end -}}
 }
 
 func (p *{{.StructName}}) Parse(rule ...int) error {
@@ -184,58 +345,733 @@ func (p *{{.StructName}}) Reset() {
 	p.reset()
 }
 
+{{if .Incremental -}}
+// Edit replaces the deleted runes of Buffer at offset with inserted, like the
+// changes an editor sends, and parses it again with the rule of the last
+// Parse. The memoized matches of the last parse which read only runes before
+// the edit, or only runes after it, are reused at their new positions, so a
+// small edit mostly parses the rules around it again. The offsets count runes
+// like the positions of the tokens. When the input doesn't match, it's parsed
+// again from scratch, for the same error as Parse. The runes read by
+// predicates aren't tracked, and with NormalizeCRLF the whole buffer is
+// parsed again.
+func (p *{{.StructName}}) Edit(offset, deleted int, inserted {{.BufferType}}) error {
+	return p.edit(offset, deleted, inserted)
+}
+{{end -}}
+
+// FindAll scans Buffer for the matches of rule anywhere in the input, like a
+// regular expression, and returns them in order. After a match the scan goes
+// on at its end, so the matches don't overlap, and an empty match moves it
+// one rune ahead. The matches are tagged with rule, and their positions are
+// rune offsets. The syntax tree of the last parse is left undefined.
+func (p *{{.StructName}}) FindAll(rule pegRule) ([]token32, error) {
+	return p.find(rule)
+}
+
+{{if .Concurrent -}}
+// FindAllConcurrent finds the matches of rule like FindAll, but splits Buffer
+// after the boundary runes nearest to n equal parts and scans the parts in
+// parallel goroutines, which suits large inputs such as logs. Matches can't
+// span parts, so boundary should be a rune the matches of rule don't contain,
+// such as a newline for a rule matching within lines. Each part is scanned by
+// a new parser initialized with the options given to Init, without the state
+// of p.
+func (p *{{.StructName}}) FindAllConcurrent(rule pegRule, boundary rune, n int) ([]token32, error) {
+{{- if .Bytes}}
+	runes := p.buffer
+{{- else}}
+	runes := p.buffer[:len(p.buffer)-1]
+{{- end}}
+	if n <= 1 || len(runes) == 0 {
+		return p.FindAll(rule)
+	}
+	type part struct {
+		begin, end uint32
+		matches    []token32
+		err        error
+	}
+	var parts []*part
+	size := len(runes)/n + 1
+	for begin := 0; begin < len(runes); {
+		end := begin + size
+		for end < len(runes) && {{if .Bytes}}rune(runes[end-1]){{else}}runes[end-1]{{end}} != boundary {
+			end++
+		}
+		if end > len(runes) {
+			end = len(runes)
+		}
+		parts = append(parts, &part{begin: uint32(begin), end: uint32(end)})
+		begin = end
+	}
+
+	var wait sync.WaitGroup
+	for _, pt := range parts {
+		wait.Add(1)
+		go func(pt *part) {
+			defer wait.Done()
+			q := &{{.StructName}}{Buffer: {{if .Bytes}}[]byte{{else}}string{{end}}(runes[pt.begin:pt.end])}
+			if pt.err = q.Init(p.options...); pt.err == nil {
+				pt.matches, pt.err = q.FindAll(rule)
+			}
+		}(pt)
+	}
+	wait.Wait()
+
+	var matches []token32
+	for i, pt := range parts {
+		if pt.err != nil {
+			return nil, pt.err
+		}
+		for _, match := range pt.matches {
+			/* the end of a part is scanned again as the beginning of the next */
+			if i < len(parts)-1 && match.begin == pt.end-pt.begin {
+				continue
+			}
+			match.begin, match.end = match.begin+pt.begin, match.end+pt.begin
+{{- if .Lines}}
+			match.lines = p.lines
+{{- end}}
+			matches = append(matches, match)
+		}
+	}
+	return matches, nil
+}
+{{end -}}
+
+{{if .Streaming -}}
+// ParseReader parses the input read from r as a series of matches of rule,
+// for inputs too large to hold in memory such as multi-gigabyte logs. Buffer
+// holds a window of the input from the beginning of the current match, which
+// grows while the parser looks at its end, and slides past each match.
+// Memory is thus bounded only by the longest match, and a rule which never
+// stops before the end holds the whole input. While the farthest position
+// the parser reached is the end of the window, the window is parsed again
+// from scratch once it has doubled, so that a match spanning many reads is
+// parsed a number of times logarithmic in its length, in time linear in it,
+// at the cost of waiting for the input doubling the window. After each match
+// handle is called with the byte offset of the window into the input, while
+// Buffer, the syntax tree and the captures describe the match. Positions are
+// relative to the window, and a rule matching the empty input is an error.
+func (p *{{.StructName}}) ParseReader(r io.Reader, rule pegRule, handle func(offset int) error) error {
+	var window []byte
+	chunk := make([]byte, 1<<16)
+	/* want is the size of the window from which it is parsed next */
+	offset, eof, want := 0, false, 1
+	for {
+		for !eof && len(window) < want {
+			n, err := r.Read(chunk)
+			window = append(window, chunk[:n]...)
+			if err == io.EOF {
+				eof = true
+			} else if err != nil {
+				return err
+			}
+		}
+		complete := len(window)
+{{- if not .Bytes}}
+		if !eof {
+			/* leave a rune split by the read for the next one */
+			for i := len(window) - 1; i >= 0 && i >= len(window)-utf8.UTFMax; i-- {
+				if utf8.RuneStart(window[i]) {
+					if !utf8.FullRune(window[i:]) {
+						complete = i
+					}
+					break
+				}
+			}
+		}
+{{- end}}
+		if complete == 0 {
+			if eof {
+				return nil
+			}
+			want = len(window) + 1
+			continue
+		}
+
+		p.Buffer = {{if .Bytes}}window[:complete]{{else}}string(window[:complete]){{end}}
+		p.Reset()
+		err := p.Parse(int(rule))
+		/* the parser looked at the end of the window, which more input may change */
+		if !eof && int(p.farthest) >= len(p.buffer){{if not .Bytes}}-1{{end}} {
+			want = 2 * len(window)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("at byte offset %v: %w", offset, err)
+		}
+		end := p.ByteOffset(int(p.matched))
+		if end == 0 {
+			return fmt.Errorf("rule '%v' matched the empty input at byte offset %v", rul3s[rule], offset)
+		}
+		if err = handle(offset); err != nil {
+			return err
+		}
+		window = append(window[:0], window[end:]...)
+		offset, want = offset+end, 0
+	}
+}
+{{end -}}
+{{if .Captures}}
+// Captures returns the spans matched by < > in the last parse, in the order of
+// the input, each tagged with the rule the capture is written in.
+func (p *{{.StructName}}) Captures() []token32 {
+	return p.captures
+}
+{{end}}
+// SetFilename sets the name of the parsed file, which then prefixes the
+// positions in parse errors.
+func (p *{{.StructName}}) SetFilename(filename string) {
+	p.filename = filename
+}
+
+// ByteOffset returns the byte offset into Buffer of the rune offset into the
+// buffer, in which the positions of tokens, errors and completions are given.
+func (p *{{.StructName}}) ByteOffset(offset int) int {
+{{- if .Bytes}}
+	if offset > len(p.Buffer) {
+		return len(p.Buffer)
+	}
+	return offset
+{{- else}}
+	if p.offsets == nil {
+		p.offsets = make([]int, 0, len(p.buffer) + 1)
+		for i := range p.Buffer {
+			p.offsets = append(p.offsets, i)
+		}
+		p.offsets = append(p.offsets, len(p.Buffer))
+	}
+	if offset >= len(p.offsets) {
+		return len(p.Buffer)
+	}
+	return p.offsets[offset]
+{{- end}}
+}
+
+// original returns the offset into the input of a position in the input
+// matched with \r\n normalized to \n.
+func (p *{{.StructName}}) original(position uint32) uint32 {
+	return position + uint32(sort.Search(len(p.crlfs), func(i int) bool { return p.crlfs[i] >= position }))
+}
+
+// describe returns the position for diagnostics.
+func (p *{{.StructName}}) describe(position textPosition) string {
+	if p.byteColumns {
+		return fmt.Sprintf("line %v symbol %v byte %v", position.line, position.symbol, position.column)
+	}
+	return fmt.Sprintf("line %v symbol %v", position.line, position.symbol)
+}
+
+// location returns the prefix of diagnostics at line and symbol.
+func (p *{{.StructName}}) location(line, symbol int) string {
+	if p.filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%v:%v:%v: ", p.filename, line, symbol)
+}
+
+{{if .Expected -}}
+// Completions returns the terminals which could continue the first offset
+// runes of the buffer. The parser is reset afterwards.
+func (p *{{.StructName}}) Completions(offset int) []string {
+	buffer := p.Buffer
+	defer func() {
+		p.Buffer = buffer
+		p.Reset()
+	}()
+{{- if .Bytes}}
+	if offset < len(buffer) {
+		p.Buffer = buffer[:offset]
+	}
+	p.Reset()
+	_ = p.Parse()
+	if int(p.farthest) != len(p.Buffer) {
+{{- else}}
+	if runes := []rune(buffer); offset < len(runes) {
+		p.Buffer = string(runes[:offset])
+	}
+	p.Reset()
+	_ = p.Parse()
+	if int(p.farthest) != len([]rune(p.Buffer)) {
+{{- end}}
+		return nil
+	}
+	return p.expectations()
+}
+{{end -}}
+
+// expectations returns the terminals expected at the farthest failure once
+// each, with the same text written as a character or a string counted once,
+// ordered by expectationRank and then alphabetically.
+func (p *{{.StructName}}) expectations() []string {
+	expectations := make([]string, 0, len(p.expected))
+	seen := make(map[string]bool, len(p.expected))
+	for _, expected := range p.expected {
+		key := expected
+		if text, err := strconv.Unquote(expected); err == nil {
+			key = text
+		}
+		if !seen[key] {
+			seen[key] = true
+			expectations = append(expectations, expected)
+		}
+	}
+	sort.Slice(expectations, func(i, j int) bool {
+		a, b := expectationRank(expectations[i]), expectationRank(expectations[j])
+		if a != b {
+			return a < b
+		}
+		return expectations[i] < expectations[j]
+	})
+	return expectations
+}
+
+// expectationRank orders keywords first, then rule names, literals,
+// character classes and the dot.
+func expectationRank(expected string) int {
+	switch expected[0] {
+	case '\'':
+		if _, err := strconv.Unquote(expected); err != nil {
+			return 0
+		}
+		return 2
+	case '"':
+		return 2
+	case '[':
+		return 3
+	case '.':
+		return 4
+	}
+	return 1
+}
+
+{{if .Tolerant -}}
+// SanitizeEdit is an edit of the input made by Sanitize: the Deleted runes at
+// Offset are replaced with Inserted. Offset counts the runes of the input
+// given to Sanitize.
+type SanitizeEdit struct {
+	Offset, Deleted int
+	Inserted        string
+}
+
+// Sanitize parses like Parse, but instead of failing on invalid input it
+// edits the input at the farthest failure until it parses, for the tolerant
+// ingestion of semi-structured data, and returns the edits. At a failure it
+// deletes the rune there, inserts one of the literals expected there or
+// replaces the rune with one, trying them in this order, and keeps the first
+// edit after which the input parses, or else the edit getting the parser
+// farthest past the failure. Adjacent edits are merged. Buffer then holds the
+// sanitized input, which the syntax tree describes. Every edit tried parses
+// the input again, so inputs with many errors take long to sanitize. If no
+// edit gets the parser past a failure, the edits made before are returned
+// with its error.
+func (p *{{.StructName}}) Sanitize(rule ...int) ([]SanitizeEdit, error) {
+	var edits []SanitizeEdit
+	/* the runes the edits added to the input, which the positions of the
+	   edited buffer are ahead of the positions of the input */
+	shift := 0
+	for {
+		err := p.Parse(rule...)
+		if err == nil {
+			return edits, nil
+		}
+		runes, position := {{if .Bytes}}p.Buffer{{else}}[]rune(p.Buffer){{end}}, int(p.farthest)
+		type candidate struct {
+			deleted  int
+			inserted string
+		}
+		var literals []string
+		for _, expected := range p.expectations() {
+			text, err := strconv.Unquote(expected)
+			if err != nil && len(expected) > 2 && expected[0] == '\'' && expected[len(expected)-1] == '\'' {
+				/* a keyword */
+				text, err = expected[1:len(expected)-1], nil
+			}
+			if err == nil && text != "" {
+				literals = append(literals, text)
+			}
+		}
+		var candidates []candidate
+		if position < len(runes) {
+			candidates = append(candidates, candidate{1, ""})
+		}
+		for _, literal := range literals {
+			candidates = append(candidates, candidate{0, literal})
+		}
+		if position < len(runes) {
+			for _, literal := range literals {
+				candidates = append(candidates, candidate{1, literal})
+			}
+		}
+{{if .Bytes}}
+		edited := func(c candidate) []byte {
+			return append(append(append([]byte(nil), runes[:position]...), c.inserted...), runes[position+c.deleted:]...)
+		}
+{{- else}}
+		edited := func(c candidate) string {
+			return string(runes[:position]) + c.inserted + string(runes[position+c.deleted:])
+		}
+{{- end}}
+		best, farthest := -1, position
+		for i, c := range candidates {
+			p.Buffer = edited(c)
+			p.Reset()
+			if p.Parse(rule...) == nil {
+				best = i
+				break
+			}
+			/* the failure in the positions of the buffer before the edit */
+			if reached := int(p.farthest) - {{if .Bytes}}len(c.inserted){{else}}len([]rune(c.inserted)){{end}} + c.deleted; reached > farthest {
+				best, farthest = i, reached
+			}
+		}
+		if best < 0 {
+			p.Buffer = {{if .Bytes}}runes{{else}}string(runes){{end}}
+			p.Reset()
+			_ = p.Parse(rule...)
+			return edits, err
+		}
+
+		c := candidates[best]
+		p.Buffer = edited(c)
+		p.Reset()
+		edit := SanitizeEdit{Offset: position - shift, Deleted: c.deleted, Inserted: c.inserted}
+		if n := len(edits); n > 0 && edits[n-1].Offset+edits[n-1].Deleted == edit.Offset {
+			edits[n-1].Deleted += edit.Deleted
+			edits[n-1].Inserted += edit.Inserted
+		} else {
+			edits = append(edits, edit)
+		}
+		shift += {{if .Bytes}}len(c.inserted){{else}}len([]rune(c.inserted)){{end}} - c.deleted
+	}
+}
+{{end -}}
+{{if and .Ast .Expected}}
+// ParsePartial parses like Parse, but if the input is invalid or incomplete
+// the syntax tree holds the rules matched before the farthest failure, below
+// the start rule, and the terminals expected at the failure are returned.
+func (p *{{.StructName}}) ParsePartial(rule ...int) ([]string, error) {
+	p.partial = true
+	defer func() {
+		p.partial = false
+	}()
+	err := p.Parse(rule...)
+	if err == nil {
+		return nil, nil
+	}
+	r := 1
+	if len(rule) > 0 {
+		r = rule[0]
+	}
+	tokens, end := p.partialTokens, uint32(0)
+	for _, token := range tokens {
+		if token.end > end {
+			end = token.end
+		}
+	}
+	if end > 0 {
+		tokens = append(tokens, token32{pegRule: pegRule(r), begin: 0, end: end{{if .Lines}}, lines: p.lines{{end}}})
+	}
+	p.tokens32.tree, p.partialTokens = tokens, nil
+	return p.expectations(), err
+}
+{{end -}}
+{{if .Ast -}}
+{{if .Substitution}}
+// Substitute returns Buffer with the outermost matches of rule in the syntax
+// tree of the last parse replaced by template. In template, $0 is the text of
+// the match, $1 to $9 are the texts captured with < > in it, ${Name} is the
+// text of the first match of the rule Name in it, and $$ is a dollar sign.
+func (p *{{.StructName}}) Substitute(rule pegRule, template string) {{.BufferType}} {
+	buffer, last := {{if .Bytes}}p.buffer{{else}}[]rune(p.Buffer){{end}}, uint32(0)
+	out := &bytes.Buffer{}
+	var substitute func(node *node32)
+	substitute = func(node *node32) {
+		for ; node != nil; node = node.next {
+			if node.pegRule != rule {
+				substitute(node.up)
+				continue
+			}
+			out.WriteString(string(buffer[last:node.begin]))
+			expandTemplate(out, buffer, node, template)
+			last = node.end
+		}
+	}
+	substitute(p.AST())
+	out.WriteString(string(buffer[last:]))
+	return out.{{if .Bytes}}Bytes{{else}}String{{end}}()
+}
+
+// expandTemplate writes template with the references to match replaced, see
+// Substitute.
+func expandTemplate(out *bytes.Buffer, buffer {{if .Bytes}}byteBuffer{{else}}[]rune{{end}}, match *node32, template string) {
+	var nodes []*node32
+	var walk func(node *node32)
+	walk = func(node *node32) {
+		for ; node != nil; node = node.next {
+			nodes = append(nodes, node)
+			walk(node.up)
+		}
+	}
+	walk(match.up)
+	find := func(name string, n int) *node32 {
+		for _, node := range nodes {
+			if rul3s[node.pegRule] == name {
+				if n--; n <= 0 {
+					return node
+				}
+			}
+		}
+		return nil
+	}
+
+	for i := 0; i < len(template); i++ {
+		c := template[i]
+		if c != '$' || i+1 == len(template) {
+			out.WriteByte(c)
+			continue
+		}
+		var node *node32
+		switch next := template[i+1]; {
+		case next == '$':
+			out.WriteByte('$')
+		case next == '0':
+			node = match
+		case next >= '1' && next <= '9':
+			node = find("PegText", int(next-'0'))
+		case next == '{':
+			end := i + 2
+			for end < len(template) && template[end] != '}' {
+				end++
+			}
+			if end == len(template) {
+				out.WriteByte(c)
+				continue
+			}
+			node, i = find(template[i+2:end], 1), end-1
+		default:
+			out.WriteByte(c)
+			continue
+		}
+		if node != nil {
+			out.WriteString(string(buffer[node.begin:node.end]))
+		}
+		i++
+	}
+}
+{{end -}}
+{{end}}
+
+// textPosition is the line of a position, its symbol, which is the visual
+// column if tabs are expanded, and its byte column.
 type textPosition struct {
-	line, symbol int
+	line, symbol, column int
 }
 
 type textPositionMap map[int] textPosition
 
-func translatePositions(buffer []rune, positions []int) textPositionMap {
-	length, translations, j, line, symbol := len(positions), make(textPositionMap, len(positions)), 0, 1, 0
+func translatePositions(buffer {{if .Bytes}}byteBuffer{{else}}[]rune{{end}}, positions []int, tabWidth int) textPositionMap {
+	length, translations, j, line, symbol, column := len(positions), make(textPositionMap, len(positions)), 0, 1, 0, 0
+	visual, bytes := 0, 0
 	sort.Ints(positions)
-
+{{if .Bytes}}
+	search: for i := 0; i <= len(buffer); i++ {
+		c := buffer.at(uint32(i))
+{{- else}}
 	search: for i, c := range buffer {
-		if c == '\n' {line, symbol = line + 1, 0} else {symbol++}
+{{- end}}
+		/* a newline is at the end of its line */
+		symbol, column = visual + 1, bytes + 1
 		if i == positions[j] {
-			translations[positions[j]] = textPosition{line, symbol}
-			for j++; j < length; j++ {if i != positions[j] {continue search}}
-			break search
+			translations[positions[j]] = textPosition{line, symbol, column}
+			for j++; j < length; j++ {if i != positions[j] {break}}
+			if j == length {
+				break search
+			}
+		}
+		if c == '\n' {
+			line, visual, bytes = line + 1, 0, 0
+		} else if c == '\t' && tabWidth > 0 {
+			visual += tabWidth - visual % tabWidth
+			bytes++
+		} else {
+			visual++
+{{- if .Bytes}}
+			bytes++
+{{- else}}
+			bytes += utf8.RuneLen(c)
+{{- end}}
 		}
  	}
 
 	return translations
 }
 
-type parseError struct {
-	p *{{.StructName}}
-	max token32
+// SyntaxError is the error returned by Parse if the input doesn't match the
+// grammar. Line, Symbol and Column locate the farthest position the parser
+// reached, Symbol counting runes with tabs expanded and Column counting bytes
+// from 1, and Offset is its byte offset into Buffer.
+type SyntaxError struct {
+	Line, Symbol, Column, Offset int
+	p        *{{.StructName}}
+	max      token32
+	expected []string
+	rules    []string
+	hint     string
+}
+
+// syntaxError returns the error of the last parse, which failed after
+// matching the token max.
+func (p *{{.StructName}}) syntaxError(max token32) *SyntaxError {
+	position := translatePositions(p.buffer, []int{int(p.farthest)}, p.tabWidth)[int(p.farthest)]
+	return &SyntaxError{
+		Line: position.line,
+		Symbol: position.symbol,
+		Column: position.column,
+		Offset: p.ByteOffset(int(p.farthest)),
+		p: p,
+		max: max,
+		expected: p.expectations(),
+		rules: append([]string(nil), p.farthestRules...),
+		hint: p.farthestHint,
+	}
 }
 
-func (e *parseError) Error() string {
+// Expected returns the terminals expected at the farthest position, in the
+// order of Completions.
+func (e *SyntaxError) Expected() []string {
+	return e.expected
+}
+
+// Rules returns the rules which were being matched at the farthest position,
+// from the start rule inwards. They are only recorded with the TrackRules
+// option, and leave out inlined rules.
+func (e *SyntaxError) Rules() []string {
+	return e.rules
+}
+
+// Hint returns the hint given with %hint to the alternative which failed last
+// at the farthest position, if any.
+func (e *SyntaxError) Hint() string {
+	return e.hint
+}
+
+func (e *SyntaxError) Error() string {
 	tokens, err := []token32{e.max}, "\n"
 	positions, p := make([]int, 2 * len(tokens)), 0
 	for _, token := range tokens {
 		positions[p], p = int(token.begin), p + 1
 		positions[p], p = int(token.end), p + 1
 	}
-	translations := translatePositions(e.p.buffer, positions)
-	format := "parse error near %v (line %v symbol %v - line %v symbol %v):\n%v\n"
+	translations := translatePositions(e.p.buffer, positions, e.p.tabWidth)
+	format := "parse error near %v (%v - %v):\n%v\n"
 	if e.p.Pretty {
-		format = "parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n"
+		format = "parse error near \x1B[34m%v\x1B[m (%v - %v):\n%v\n"
 	}
 	for _, token := range tokens {
 		begin, end := int(token.begin), int(token.end)
+		err += e.p.location(translations[begin].line, translations[begin].symbol)
 		err += fmt.Sprintf(format,
                          rul3s[token.pegRule],
-                         translations[begin].line, translations[begin].symbol,
-                         translations[end].line, translations[end].symbol,
+                         e.p.describe(translations[begin]),
+                         e.p.describe(translations[end]),
                          strconv.Quote(string(e.p.buffer[begin:end])))
 	}
+	if len(e.expected) > 0 {
+		expected := ""
+		for i, terminal := range e.expected {
+			switch {
+			case i == 0:
+			case i == len(e.expected) - 1:
+				expected += " or "
+			default:
+				expected += ", "
+			}
+			expected += terminal
+		}
+		err += fmt.Sprintf("expected %v at %v\n", expected, e.p.describe(textPosition{e.Line, e.Symbol, e.Column}))
+	}
+	if e.hint != "" {
+		err += fmt.Sprintf("hint: %v\n", e.hint)
+	}
 
 	return err
 }
+{{if .HasRecover}}
+// SyntaxErrors is the error returned by Parse if the input matched only by
+// recovering from syntax errors with %recover, in the order of the input.
+type SyntaxErrors []*SyntaxError
+
+func (e SyntaxErrors) Error() string {
+	err := ""
+	for _, syntaxError := range e {
+		err += syntaxError.Error()
+	}
+	return err
+}
+
+func (e SyntaxErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, syntaxError := range e {
+		errs[i] = syntaxError
+	}
+	return errs
+}
 
+// failure is the farthest failure of the parser, which %recover saves to
+// report it as a syntax error. begin is the position %recover began at.
+type failure struct {
+	max      token32
+	begin    uint32
+	farthest uint32
+	expected []string
+	rules    []string
+	hint     string
+}
+
+// Recovered returns the syntax errors recovered from with %recover in the last
+// parse, which Parse also returns. It is empty if the input didn't match.
+func (p *{{.StructName}}) Recovered() SyntaxErrors {
+	return p.recovered
+}
+{{end}}
+type depthError struct {
+	p *{{.StructName}}
+	position uint32
+}
+
+func (e *depthError) Error() string {
+	translations := translatePositions(e.p.buffer, []int{int(e.position)}, e.p.tabWidth)
+	position := translations[int(e.position)]
+	return fmt.Sprintf("%vparse error: rules nested deeper than %v at %v\n",
+		e.p.location(position.line, position.symbol), e.p.maxDepth, e.p.describe(position))
+}
+{{if .ErrorType}}
+// {{.ErrorType}} is the error returned when parsing fails. Its fields are
+// copied from the {{.ErrorType}} field of the parser, and Err is the parse error.
+type {{.ErrorType}} struct {
+	{{.ErrorFields}}
+	Err error
+}
+
+func (e *{{.ErrorType}}) Error() string {
+	return e.Err.Error()
+}
+
+func (e *{{.ErrorType}}) Unwrap() error {
+	return e.Err
+}
+{{end}}
+{{if .StateFields}}
+// pegState is the state declared with %state, embedded into the parser. It is
+// saved wherever the parser may backtrack, and restored when it does.
+type pegState struct {
+	{{.StateFields}}
+}
+{{end}}
 {{if .Ast}}
+{{- if not .NoPrint}}
 func (p *{{.StructName}}) PrintSyntaxTree() {
 	if p.Pretty {
 		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
@@ -253,45 +1089,390 @@ func (p *{{.StructName}}) SprintSyntaxTree() string {
 	p.WriteSyntaxTree(&b)
 	return b.String()
 }
+{{end}}
 
 {{if .HasActions}}
 func (p *{{.StructName}}) Execute() {
-	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
+	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, {{if .Bytes}}[]byte(nil){{else}}""{{end}}, 0, 0
 	for _, token := range p.Tokens() {
 		switch (token.pegRule) {
 		{{if .HasPush}}
 		case rulePegText:
 			begin, end = int(token.begin), int(token.end)
-			text = string(_buffer[begin:end])
+			text = {{if .Bytes}}_buffer[begin:end]{{else}}string(_buffer[begin:end]){{end}}
 		{{end}}
 		{{range .Actions}}case ruleAction{{.GetID}}:
-			{{.String}}
-		{{end}}
+{{if and $.LineFile .Line}}//line {{$.LineFile}}:{{.Line}}
+{{end}}			{{.String}}
+{{if and $.LineFile .Line}}{{restore}}
+{{end}}		{{end}}
 		}
 	}
 	_, _, _, _, _ = buffer, _buffer, text, begin, end
 }
 {{end}}
-{{end}}
+{{if .RecoveryRules}}
+// Diagnostic is an error the grammar recovered from: a match of Label, a rule
+// marked with %recovery, beginning at the rune offset Position.
+type Diagnostic struct {
+	Position int
+	Label    pegRule
+}
 
-func Pretty(pretty bool) func(*{{.StructName}}) error {
-	return func(p *{{.StructName}}) error {
-		p.Pretty = pretty
-		return nil
-	}
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%v at %v", rul3s[d.Label], d.Position)
 }
 
-{{if .Ast -}}
-func Size(size int) func(*{{.StructName}}) error {
-	return func(p *{{.StructName}}) error {
-		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
-		return nil
+// Diagnostics returns the errors recovered from in the last parse, in the
+// order of the input. Unlike the syntax tree, they include empty matches, such
+// as those of a rule reporting a missing token.
+func (p *{{.StructName}}) Diagnostics() []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, token := range p.Tokens() {
+		switch token.pegRule {
+		case {{range $i, $rule := .RecoveryRules}}{{if $i}}, {{end}}rule{{$rule}}{{end}}:
+			diagnostics = append(diagnostics, Diagnostic{int(token.begin), token.pegRule})
+		}
 	}
+	sort.SliceStable(diagnostics, func(i, j int) bool { return diagnostics[i].Position < diagnostics[j].Position })
+	return diagnostics
 }
 
-func DisableMemoize() func(*{{.StructName}}) error {
-	return func(p *{{.StructName}}) error {
-		p.disableMemoize = true
+// CheckRecovery parses input, into which tests inject errors, and returns an
+// error describing how its diagnostics differ from expected, compared as
+// sets, and which rules of present are missing from its syntax tree, so that
+// tests can check how the grammar recovers. It returns the parse error if the
+// grammar doesn't recover.
+func (p *{{.StructName}}) CheckRecovery(input {{.BufferType}}, expected []Diagnostic, present ...pegRule) error {
+	p.Buffer = input
+	p.Reset()
+	if err := p.Parse(); err != nil {
+		return err
+	}
+	var problems []string
+	diagnostics, counts := p.Diagnostics(), make(map[Diagnostic]int)
+	for _, diagnostic := range expected {
+		counts[diagnostic]--
+	}
+	for _, diagnostic := range diagnostics {
+		counts[diagnostic]++
+	}
+	for _, diagnostic := range diagnostics {
+		if counts[diagnostic] > 0 {
+			problems = append(problems, fmt.Sprintf("unexpected %v", diagnostic))
+			counts[diagnostic]--
+		}
+	}
+	for _, diagnostic := range expected {
+		if counts[diagnostic] < 0 {
+			problems = append(problems, fmt.Sprintf("missing %v", diagnostic))
+			counts[diagnostic]++
+		}
+	}
+	matched := make(map[pegRule]bool)
+	for _, token := range p.Tokens() {
+		/* empty matches aren't in the syntax tree */
+		if token.begin < token.end {
+			matched[token.pegRule] = true
+		}
+	}
+	for _, rule := range present {
+		if !matched[rule] {
+			problems = append(problems, fmt.Sprintf("no %v in the syntax tree", rul3s[rule]))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	var message bytes.Buffer
+	for i, problem := range problems {
+		if i > 0 {
+			message.WriteString(", ")
+		}
+		message.WriteString(problem)
+	}
+	return fmt.Errorf("recovery: %v", message.String())
+}
+{{end}}
+{{if .Builds}}
+// Build returns the value of the syntax tree of the last parse. The rules
+// annotated with -> construct their values from $0, their text, and $1, $2,
+// ..., the values of their children, which are nil if missing. The value of
+// another rule is the value of its only child, or else its text.
+func (p *{{.StructName}}) Build() any {
+	return p.build(p.AST())
+}
+
+func (p *{{.StructName}}) build(node *node32) any {
+	if node == nil {
+		return nil
+	}
+	var children []*node32
+	for child := node.up; child != nil; child = child.next {
+		children = append(children, child)
+	}
+	child := func(n int) any {
+		if n > len(children) {
+			return nil
+		}
+		return p.build(children[n - 1])
+	}
+	text := {{if .Bytes}}[]byte{{else}}string{{end}}(p.buffer[node.begin:node.end])
+	switch node.pegRule {
+{{range .Builds}}	case rule{{.Rule}}:
+		return {{.Expression}}
+{{end}}	}
+	if len(children) == 1 {
+		return child(1)
+	}
+	return text
+}
+{{end}}
+{{- if .TypedRules}}
+
+// typedNode is the node of the syntax tree of a typed node, its positions and
+// text. Typed nodes of rules named Begin, End or Text shadow them.
+type typedNode struct {
+	Begin, End int
+	Text       {{.BufferType}}
+}
+
+func (p *{{.StructName}}) typedNode(node *node32) typedNode {
+	return typedNode{Begin: int(node.begin), End: int(node.end), Text: {{if .Bytes}}[]byte{{else}}string{{end}}(p.buffer[node.begin:node.end])}
+}
+{{range .TypedRules}}
+// {{.Type}} is a node of the rule {{.Rule}} in the typed AST.
+type {{.Type}} struct {
+	typedNode
+{{- range .Fields}}
+	{{.Name}} {{if .Slice}}[]{{end}}*{{.Type}}
+{{- end}}
+}
+{{end}}
+// Typed returns the syntax tree of the last parse of the rule {{(index .TypedRules 0).Rule}} as typed
+// nodes, or nil if the input was parsed with another rule or matched nothing.
+func (p *{{.StructName}}) Typed() *{{(index .TypedRules 0).Type}} {
+	node := p.AST()
+	if node == nil || node.pegRule != rule{{(index .TypedRules 0).Rule}} {
+		return nil
+	}
+	return p.typed{{(index .TypedRules 0).Rule}}(node)
+}
+
+// typedChildren calls f with the nodes of the rules below node, looking
+// through the nodes of < > and actions.
+func (p *{{.StructName}}) typedChildren(node *node32, f func(child *node32)) {
+	for child := node.up; child != nil; child = child.next {
+		switch child.pegRule {
+		case {{range $i, $rule := .TypedRules}}{{if $i}}, {{end}}rule{{$rule.Rule}}{{end}}:
+			f(child)
+		default:
+			p.typedChildren(child, f)
+		}
+	}
+}
+{{range .TypedRules}}
+func (p *{{$.StructName}}) typed{{.Rule}}(node *node32) *{{.Type}} {
+	typed := &{{.Type}}{typedNode: p.typedNode(node)}
+{{- if .Fields}}
+	p.typedChildren(node, func(child *node32) {
+		switch child.pegRule {
+{{- range .Fields}}
+		case rule{{.Rule}}:
+{{- if .Slice}}
+			typed.{{.Name}} = append(typed.{{.Name}}, p.typed{{.Rule}}(child))
+{{- else}}
+			typed.{{.Name}} = p.typed{{.Rule}}(child)
+{{- end}}
+{{- end}}
+		}
+	})
+{{- end}}
+	return typed
+}
+{{end}}
+{{- end}}
+{{end}}
+
+func Pretty(pretty bool) func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+{{if .Watchdog}}
+// SlowRule is a rule invocation reported by the watchdog. Begin and End are
+// rune offsets into the buffer, ByteBegin and ByteEnd byte offsets into Buffer.
+type SlowRule struct {
+	Rule               string
+	Begin, End         int
+	ByteBegin, ByteEnd int
+	Elapsed            time.Duration
+	Steps              int
+}
+
+type watchdog struct {
+	threshold time.Duration
+	steps     int
+	report    func(SlowRule)
+}
+
+// Watchdog reports the rule invocations which take longer than threshold or
+// make more than steps nested rule invocations, along with the span of input
+// they matched or failed at. A zero threshold or steps is ignored.
+func Watchdog(threshold time.Duration, steps int, report func(SlowRule)) func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.watchdog = &watchdog{threshold, steps, report}
+		return nil
+	}
+}
+{{end -}}
+{{if .Trace}}
+// TraceEvent is a rule entered or exited by the parser, reported by Trace.
+// Begin is the rune offset the rule is entered at, and on exit End is the
+// offset it matched to, or Begin if it failed. Depth counts the rules being
+// matched, including this one, and Time is when the event happened.
+type TraceEvent struct {
+	Rule       string    ` + "`" + `json:"rule"` + "`" + `
+	Exit       bool      ` + "`" + `json:"exit"` + "`" + `
+	Matched    bool      ` + "`" + `json:"matched"` + "`" + `
+	Begin      int       ` + "`" + `json:"begin"` + "`" + `
+	End        int       ` + "`" + `json:"end"` + "`" + `
+	Depth      int       ` + "`" + `json:"depth"` + "`" + `
+	Time       time.Time ` + "`" + `json:"time"` + "`" + `
+}
+
+// Trace reports every rule the parser enters and exits to trace, to see where
+// a grammar backtracks. Inlined rules are matched within the rules referencing
+// them, and aren't reported.
+func Trace(trace func(TraceEvent)) func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.trace = trace
+		return nil
+	}
+}
+
+// TraceWriter traces the rules like Trace, writing the events to w in format:
+// "text" for a line per event indented by its depth, "json" for a JSON object
+// per line, or "chrome" for the trace event format of chrome://tracing and
+// Perfetto. Write errors are ignored.
+func TraceWriter(w io.Writer, format string) func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		switch format {
+		case "text":
+			p.trace = func(event TraceEvent) {
+				switch {
+				case !event.Exit:
+					fmt.Fprintf(w, "%*s> %v %v\n", 2*(event.Depth-1), "", event.Rule, event.Begin)
+				case event.Matched:
+					fmt.Fprintf(w, "%*s< %v %v-%v\n", 2*(event.Depth-1), "", event.Rule, event.Begin, event.End)
+				default:
+					fmt.Fprintf(w, "%*s< %v %v failed\n", 2*(event.Depth-1), "", event.Rule, event.Begin)
+				}
+			}
+		case "json":
+			encoder := json.NewEncoder(w)
+			p.trace = func(event TraceEvent) {
+				_ = encoder.Encode(event)
+			}
+		case "chrome":
+			/* the closing bracket of the array is optional */
+			var start time.Time
+			p.trace = func(event TraceEvent) {
+				separator, phase := ",\n", "B"
+				if start.IsZero() {
+					separator, start = "[\n", event.Time
+				}
+				args := map[string]any{"begin": event.Begin}
+				if event.Exit {
+					phase, args["end"], args["matched"] = "E", event.End, event.Matched
+				}
+				line, _ := json.Marshal(map[string]any{
+					"name": event.Rule,
+					"ph":   phase,
+					"ts":   float64(event.Time.Sub(start).Nanoseconds()) / 1000,
+					"pid":  1,
+					"tid":  1,
+					"args": args,
+				})
+				fmt.Fprintf(w, "%v%s", separator, line)
+			}
+		default:
+			return fmt.Errorf("unknown trace format '%v', expected text, json or chrome", format)
+		}
+		return nil
+	}
+}
+{{end}}
+
+// NormalizeCRLF matches every \r\n of the input as \n, so that grammars
+// written for Unix line endings also parse Windows files. The positions of
+// tokens and errors are still offsets into the unchanged input.
+func NormalizeCRLF() func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.crlf = true
+		return nil
+	}
+}
+
+// TabWidth expands tabs to the next multiple of width when counting the
+// symbols of positions in errors, so that they match the columns of editors.
+func TabWidth(width int) func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		if width < 0 {
+			return fmt.Errorf("negative tab width %v", width)
+		}
+		p.tabWidth = width
+		return nil
+	}
+}
+
+// ByteColumns adds the byte column to the positions in errors.
+func ByteColumns() func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.byteColumns = true
+		return nil
+	}
+}
+
+{{if .Iterative -}}
+// MaxDepth makes Parse fail when rules are nested deeper than depth, instead
+// of growing the stack of the parser without bound, such as for deeply
+// nested untrusted input.
+{{else -}}
+// MaxDepth makes Parse fail instead of exhausting the stack when rules are
+// nested deeper than depth, such as for deeply nested untrusted input.
+{{end -}}
+func MaxDepth(depth int) func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.maxDepth = depth
+		return nil
+	}
+}
+
+{{if and .Expected (not .Iterative) -}}
+// TrackRules records the rules being matched, so that the Rules of a
+// SyntaxError name the rules at the farthest failure. Like MaxDepth it
+// slows every rule down a little.
+func TrackRules() func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.trackRules = true
+		return nil
+	}
+}
+{{end -}}
+
+{{if .Ast -}}
+func Size(size int) func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+
+func DisableMemoize() func(*{{.StructName}}) error {
+	return func(p *{{.StructName}}) error {
+		p.disableMemoize = true
 		return nil
 	}
 }
@@ -299,11 +1480,22 @@ func DisableMemoize() func(*{{.StructName}}) error {
 type memo struct {
 	Matched       bool
 	Partial       []token32
+{{- if .Incremental}}
+	/* the end of the runes read until the match, which Edit checks */
+	Reach         uint32
+{{- end}}
+{{- if and .HasLeftRecursion .StateFields}}
+	/* the state after the match of a seed */
+	State         pegState
+{{- end}}
 }
 
 type memoKey struct {
 	Rule     uint32
 	Position uint32
+{{- if .HasMemoKey}}
+	State    uint64
+{{- end}}
 }
 {{end -}}
 
@@ -311,34 +1503,116 @@ func (p *{{.StructName}}) Init(options ...func(*{{.StructName}}) error) error {
 	var (
 		max token32
 		position, tokenIndex uint32
-		buffer []rune
+		depth int
+{{if .Watchdog -}}
+		steps int
+{{end -}}
+		buffer {{if .Bytes}}byteBuffer{{else}}[]rune{{end}}
+		stack []string
+{{if .HasHint -}}
+		hint string
+{{end -}}
+{{if .HasRecover -}}
+		recovering []failure
+{{end -}}
 {{if .Ast -}}
 		memoization map[memoKey]memo
+{{if .Incremental -}}
+		reach uint32
+		startRule int
+		edited map[memoKey]memo
+		editBegin, editEnd, editShift uint32
+{{end -}}
+{{if .HasLeftRecursion -}}
+		seeds map[memoKey]memo
+{{end -}}
+{{if .CompactMemo -}}
+		failures map[memoKey]uint64
+{{end -}}
 {{end -}}
 {{if not .Ast -}}
 {{if .HasPush -}}
-		text string
+		text {{.BufferType}}
 {{end -}}
 {{end -}}
 	)
+{{if not .Ast -}}
+{{if .HasPush -}}
+	_ = text
+{{end -}}
+{{end -}}
 	for _, option := range options {
 		err := option(p)
 		if err != nil {
 			return err
 		}
 	}
+	p.options = options
+{{if .Lines -}}
+	p.lines = &lineIndex{}
+{{if .Ast -}}
+	p.tokens32.lines = p.lines
+{{end -}}
+{{end -}}
 	p.reset = func() {
 		max = token32{}
 		position, tokenIndex = 0, 0
+		p.farthest, p.expected = 0, p.expected[:0]
+		p.farthestRules, stack = p.farthestRules[:0], stack[:0]
+		p.farthestHint = ""
+{{if .HasHint -}}
+		hint = ""
+{{end -}}
+{{if .HasRecover -}}
+		recovering = recovering[:0]
+		p.recoveries, p.recovered = make(map[uint32]failure), nil
+{{end -}}
+		p.offsets = nil
 {{if .Ast -}}
+{{if .Incremental -}}
+		reach, edited = 0, nil
+{{end -}}
 		memoization = make(map[memoKey]memo)
+{{if .HasLeftRecursion -}}
+		seeds = make(map[memoKey]memo)
 {{end -}}
-
+{{if .CompactMemo -}}
+		failures = make(map[memoKey]uint64)
+{{end -}}
+{{end -}}
+{{if .Bytes -}}
+		p.buffer = p.Buffer
+{{- else -}}
 		p.buffer = []rune(p.Buffer)
 		if len(p.buffer) == 0 || p.buffer[len(p.buffer) - 1] != endSymbol {
 			p.buffer = append(p.buffer, endSymbol)
 		}
-		buffer = p.buffer
+{{- end}}
+		buffer, p.crlfs = p.buffer, p.crlfs[:0]
+		if p.crlf {
+			buffer = make({{if .Bytes}}byteBuffer{{else}}[]rune{{end}}, 0, len(p.buffer))
+			for i, c := range p.buffer {
+				if c == '\r' && i + 1 < len(p.buffer) && p.buffer[i + 1] == '\n' {
+					p.crlfs = append(p.crlfs, uint32(len(buffer)))
+					continue
+				}
+				buffer = append(buffer, c)
+			}
+		}
+{{- if .Lines}}
+		lines := p.lines
+		lines.lines, lines.starts = lines.lines[:0], append(lines.starts[:0], 0)
+		for i, c := range p.buffer {
+			lines.lines = append(lines.lines, uint32(len(lines.starts) - 1))
+			if c == '\n' {
+				lines.starts = append(lines.starts, uint32(i + 1))
+			}
+		}
+{{- if .Bytes}}
+		/* the end of the buffer */
+		lines.lines = append(lines.lines, uint32(len(lines.starts) - 1))
+{{- end}}
+{{- end}}
 	}
 	p.reset()
 
@@ -346,51 +1620,340 @@ func (p *{{.StructName}}) Init(options ...func(*{{.StructName}}) error) error {
 {{if .Ast -}}
 	tree := p.tokens32
 {{end -}}
-	p.parse = func(rule ...int) error {
+	p.parse = func(rule ...int) (err error) {
 		r := 1
 		if len(rule) > 0 {
 			r = rule[0]
 		}
+{{if .Incremental -}}
+		startRule = r
+{{end -}}
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+{{- if .ErrorType}}
+			if err != nil {
+				e := p.{{.ErrorType}}
+				e.Err = err
+				err = &e
+			}
+{{- end}}
+		}()
 		matches := p.rules[r]()
+{{if not .Expected -}}
+		p.farthest = max.end
+{{end -}}
 {{if .Ast -}}
 		p.tokens32 = tree
+{{end -}}
+{{if .Captures -}}
+		p.captures = p.captures[:tokenIndex]
+{{end -}}
+{{if .HasRecover -}}
+		var failed []failure
+		for _, token := range tree.tree[:tokenIndex] {
+			if token.pegRule == rulePegRecovered {
+				failed = append(failed, p.recoveries[token.begin])
+			}
+		}
+		sort.Slice(failed, func(i, j int) bool { return failed[i].begin < failed[j].begin })
+{{end -}}
+		if len(p.crlfs) > 0 {
+			p.farthest, max.begin, max.end = p.original(p.farthest), p.original(max.begin), p.original(max.end)
+{{if .Ast -}}
+			tokens := [][]token32{tree.tree[:tokenIndex]}
+			if p.partial {
+				tokens = append(tokens, p.partialTokens)
+			}
+			for _, tokens := range tokens {
+				for i := range tokens {
+					tokens[i].begin, tokens[i].end = p.original(tokens[i].begin), p.original(tokens[i].end)
+				}
+			}
+{{end -}}
+{{if .Captures -}}
+			for i := range p.captures {
+				p.captures[i].begin, p.captures[i].end = p.original(p.captures[i].begin), p.original(p.captures[i].end)
+			}
+{{end -}}
+{{if .HasRecover -}}
+			for i, f := range failed {
+				failed[i].farthest, failed[i].max.begin, failed[i].max.end = p.original(f.farthest), p.original(f.max.begin), p.original(f.max.end)
+			}
+{{end -}}
+		}
+{{if .HasRecover -}}
+		farthest, expected, farthestRules, farthestHint := p.farthest, p.expected, p.farthestRules, p.farthestHint
+		for _, f := range failed {
+			p.farthest, p.expected, p.farthestRules, p.farthestHint = f.farthest, f.expected, f.rules, f.hint
+			p.recovered = append(p.recovered, p.syntaxError(f.max))
+		}
+		p.farthest, p.expected, p.farthestRules, p.farthestHint = farthest, expected, farthestRules, farthestHint
 {{end -}}
 		if matches {
+			p.matched = p.original(position)
 {{if .Ast -}}
 			p.Trim(tokenIndex)
+{{end -}}
+{{if .HasRecover -}}
+			if len(p.recovered) > 0 {
+				return p.recovered
+			}
 {{end -}}
 			return nil
 		}
-		return &parseError{p, max}
+		return p.syntaxError(max)
+	}
+
+{{if .Incremental}}
+	p.edit = func(offset, deleted int, inserted {{.BufferType}}) error {
+{{- if .Bytes}}
+		runes := p.buffer
+{{- else}}
+		runes := p.buffer[:len(p.buffer) - 1]
+{{- end}}
+		if offset < 0 || deleted < 0 || offset + deleted > len(runes) {
+			return fmt.Errorf("edit of %d runes at %d is outside the buffer of %d runes", deleted, offset, len(runes))
+		}
+{{- if .Bytes}}
+		p.Buffer = append(append(append([]byte(nil), runes[:offset]...), inserted...), runes[offset + deleted:]...)
+{{- else}}
+		p.Buffer = string(runes[:offset]) + inserted + string(runes[offset + deleted:])
+{{- end}}
+		memoized := memoization
+		p.reset()
+		if !p.crlf {
+			edited, editBegin, editEnd = memoized, uint32(offset), uint32(offset + deleted)
+{{- if .Bytes}}
+			editShift = uint32(len(inserted)) - uint32(deleted)
+{{- else}}
+			editShift = uint32(len([]rune(inserted))) - uint32(deleted)
+{{- end}}
+		}
+		err := p.parse(startRule)
+		edited = nil
+		/* the failures within the reused matches aren't seen again, so the
+		   errors come from a parse from scratch */
+		if err != nil {
+			p.reset()
+			err = p.parse(startRule)
+		}
+		return err
+	}
+{{end}}
+	p.find = func(rule pegRule) (matches []token32, err error) {
+		if int(rule) >= len(p.rules) || p.rules[rule] == nil {
+			return nil, fmt.Errorf("rule '%v' is inlined or unused, and can't be searched for", rul3s[rule])
+		}
+		p.reset()
+		depth = 0
+		defer func() {
+			if e := recover(); e != nil {
+				depthErr, ok := e.(*depthError)
+				if !ok {
+					panic(e)
+				}
+				depthErr.position = p.original(depthErr.position)
+				err = depthErr
+			}
+		}()
+		for begin := uint32(0); int(begin) {{if .Bytes}}<={{else}}<{{end}} len(buffer); {
+			position, tokenIndex = begin, 0
+			if !p.rules[rule]() {
+				begin++
+				continue
+			}
+			matches = append(matches, token32{rule, p.original(begin), p.original(position){{if .Lines}}, p.lines{{end}}})
+			if position > begin {
+				begin = position
+			} else {
+				begin++
+			}
+		}
+		return matches, nil
 	}
 
 	add := func(rule pegRule, begin uint32) {
 {{if .Ast -}}
 		tree.Add(rule, begin, position, tokenIndex)
 {{end -}}
+{{if not .Captures -}}
 		tokenIndex++
+{{end -}}
 		if begin != position && position > max.end {
-			max = token32{rule, begin, position}
+			max = token32{rule, begin, position{{if .Lines}}, p.lines{{end}}}
 		}
 	}
-
+{{if .Captures}}
+	capture := func(rule pegRule, begin uint32) {
+		if token := (token32{rule, begin, position{{if .Lines}}, p.lines{{end}}}); int(tokenIndex) < len(p.captures) {
+			p.captures[tokenIndex] = token
+		} else {
+			p.captures = append(p.captures, token)
+		}
+		tokenIndex++
+	}
+	_ = capture
+{{end}}
+{{- if .Expected}}
+	fail := func(expected string) {
+		if position > p.farthest {
+			p.farthest, p.expected = position, p.expected[:0]
+			p.farthestHint = ""
+		}
+		if position == p.farthest {
+			if p.trackRules && len(p.expected) == 0 {
+				p.farthestRules = append(p.farthestRules[:0], stack...)
+			}
+{{if .HasHint -}}
+			if hint != "" {
+				p.farthestHint = hint
+			}
+{{end -}}
+			p.expected = append(p.expected, expected)
+{{if .Ast -}}
+			if p.partial {
+				p.partialTokens = append(p.partialTokens[:0], tree.tree[:tokenIndex]...)
+			}
+{{end -}}
+		}
+	}
+	_ = fail
+{{- end}}
+{{if .HasRecover}}
+	/* %recover tries its expression from a clean failure, whose farthest
+	   position is then the syntax error it reports if the expression fails */
+	saveFailure := func() failure {
+		return failure{max, position, p.farthest, p.expected, p.farthestRules, p.farthestHint}
+	}
+	restoreFailure := func(f failure) {
+		p.farthest, p.expected, p.farthestRules, p.farthestHint = f.farthest, f.expected, f.rules, f.hint
+	}
+	clearFailure := func() {
+		p.farthest, p.expected, p.farthestRules, p.farthestHint = position, nil, nil, ""
+	}
+	beginRecovery := func() {
+		recovering = append(recovering, saveFailure())
+		clearFailure()
+	}
+	/* the failures before %recover and in its expression are merged, as if
+	   it had not been there */
+	merge := func() {
+		saved := recovering[len(recovering) - 1]
+		recovering = recovering[:len(recovering) - 1]
+		switch {
+		case saved.farthest > p.farthest:
+			restoreFailure(saved)
+		case saved.farthest == p.farthest:
+			p.expected = append(saved.expected, p.expected...)
+		}
+	}
+	matchedRecovery := merge
+	failedRecovery := func() {
+		p.recoveries[position] = saveFailure()
+		clearFailure()
+	}
+	/* the failures while skipping are dropped, unless nothing was skipped
+	   and %recover fails like its expression */
+	skippedRecovery := func() bool {
+		saved := recovering[len(recovering) - 1]
+		if position > saved.begin {
+			recovering = recovering[:len(recovering) - 1]
+			restoreFailure(saved)
+			return true
+		}
+		restoreFailure(p.recoveries[saved.begin])
+		merge()
+		return false
+	}
+	_, _, _ = beginRecovery, matchedRecovery, failedRecovery
+	_ = skippedRecovery
+{{end}}
 {{if .Ast -}}
-	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool) {
+	memoize := func(rule uint32, begin uint32, tokenIndexStart uint32, matched bool{{if .HasMemoKey}}, state uint64{{end}}) {
 		if p.disableMemoize {
 			return
 		}
-		key := memoKey{rule, begin}
+{{if .Incremental -}}
+		/* the rule may have looked at the rune after its end */
+		if position >= reach {
+			reach = position + 1
+		}
+{{end -}}
+		key := memoKey{rule, begin{{if .HasMemoKey}}, state{{end}}}
 		if !matched {
-			memoization[key] = memo{Matched: false}
+{{if .CompactMemo -}}
+			failures[memoKey{rule, begin / 64{{if .HasMemoKey}}, state{{end}}}] |= 1 << (begin % 64)
+{{else -}}
+			memoization[key] = memo{Matched: false{{if .Incremental}}, Reach: reach{{end}}}
+{{end -}}
 		} else {
 			t := tree.tree[tokenIndexStart:tokenIndex]
 			tokenCopy := make([]token32, len(t))
 			copy(tokenCopy, t)
-			memoization[key] = memo{Matched: true, Partial: tokenCopy}
+			memoization[key] = memo{Matched: true, Partial: tokenCopy{{if .Incremental}}, Reach: reach{{end}}}
+		}
+	}
+	_ = memoize
+{{if .Incremental}}
+	/* reuse looks a match up in the memoization of the parse before Edit,
+	   which held the matches before the edit, if they read only runes
+	   before it, and the matches after it, which move by editShift */
+	reuse := func(key memoKey) (memo, bool) {
+		switch {
+		case key.Position < editBegin:
+		case key.Position >= editEnd + editShift:
+			key.Position -= editShift
+		default:
+			return memo{}, false
+		}
+		m, ok := edited[key]
+		if !ok || key.Position < editBegin && m.Reach > editBegin {
+			return memo{}, false
+		}
+		if key.Position >= editEnd {
+			key.Position += editShift
+			m.Reach += editShift
+			for i := range m.Partial {
+				m.Partial[i].begin += editShift
+				m.Partial[i].end += editShift
+			}
+		}
+		memoization[key] = m
+		return m, true
+	}
+	_ = reuse
+{{end}}
+{{if .CompactMemo}}
+	lookupMemo := func(rule uint32{{if .HasMemoKey}}, state uint64{{end}}) (memo, bool) {
+		if failures[memoKey{rule, position / 64{{if .HasMemoKey}}, state{{end}}}] & (1 << (position % 64)) != 0 {
+			return memo{Matched: false}, true
+		}
+		key := memoKey{rule, position{{if .HasMemoKey}}, state{{end}}}
+		m, ok := memoization[key]
+{{- if .Incremental}}
+		if !ok && edited != nil {
+			return reuse(key)
 		}
+{{- end}}
+		return m, ok
 	}
+	_ = lookupMemo
+{{end}}
 
 	memoizedResult := func(m memo) bool {
+{{- if .Incremental}}
+		if m.Reach > reach {
+			reach = m.Reach
+		}
+{{- end}}
 		if !m.Matched {
 			return false
 		}
@@ -402,11 +1965,64 @@ func (p *{{.StructName}}) Init(options ...func(*{{.StructName}}) error) error {
 		}
 		return true
 	}
+	_ = memoizedResult
+{{if .HasLeftRecursion}}
+	/* growSeed matches the left recursive rule by growing its match from a
+	   failing seed: the body is matched again with the previous match as
+	   the result of the left recursive calls, while it gets longer */
+	growSeed := func(rule uint32, body func() bool) bool {
+		key := memoKey{rule, position{{if .HasMemoKey}}, 0{{end}}}
+		if seed, ok := seeds[key]; ok {
+{{- if .StateFields}}
+			if seed.Matched {
+				p.pegState = seed.State
+			}
+{{- end}}
+			return memoizedResult(seed)
+		}
+		begin, tokenIndexStart := position, tokenIndex
+{{if .StateFields -}}
+		/* every growth starts from the state before the rule, and the left
+		   recursive calls continue from the state after the seed */
+		state := p.pegState
+{{end -}}
+		seed := memo{Matched: false}
+		seeds[key] = seed
+		for {
+			position, tokenIndex = begin, tokenIndexStart
+{{if .StateFields -}}
+			p.pegState = state
+{{end -}}
+			if !body() || seed.Matched && position <= seed.Partial[len(seed.Partial)-1].end {
+{{- if .Incremental}}
+				if position >= reach {
+					reach = position + 1
+				}
+{{- end}}
+				break
+			}
+			t := tree.tree[tokenIndexStart:tokenIndex]
+			tokenCopy := make([]token32, len(t))
+			copy(tokenCopy, t)
+			seed = memo{Matched: true, Partial: tokenCopy{{if .StateFields}}, State: p.pegState{{end}}}
+			seeds[key] = seed
+		}
+		delete(seeds, key)
+		position, tokenIndex = begin, tokenIndexStart
+{{if .StateFields -}}
+		p.pegState = state
+		if seed.Matched {
+			p.pegState = seed.State
+		}
+{{end -}}
+		return memoizedResult(seed)
+	}
+{{end -}}
 {{end -}}
 
 	{{if .HasDot}}
 	matchDot := func() bool {
-		if buffer[position] != endSymbol {
+		if {{if .Bytes}}buffer.at(position){{else}}buffer[position]{{end}} != endSymbol {
 			position++
 			return true
 		}
@@ -428,11 +2044,44 @@ func (p *{{.StructName}}) Init(options ...func(*{{.StructName}}) error) error {
 	matchString := func(s string) bool {
 		i := position
 		for _, c := range s {
-			if buffer[i] != c {
+			if {{if $.Bytes}}buffer.at(i){{else}}buffer[i]{{end}} != c {
+{{- if .Incremental}}
+				if i >= reach {
+					reach = i + 1
+				}
+{{- end}}
+				return false
+			}
+			i++
+		}
+		position = i
+		return true
+	}
+	{{end}}
+
+	{{if .HasKeyword}}
+	matchKeyword := func(s string) bool {
+		i := position
+		for _, c := range s {
+			if {{if $.Bytes}}buffer.at(i){{else}}buffer[i]{{end}} != c {
+{{- if .Incremental}}
+				if i >= reach {
+					reach = i + 1
+				}
+{{- end}}
 				return false
 			}
 			i++
 		}
+{{- if .Incremental}}
+		/* the rune after the keyword is read too */
+		if i >= reach {
+			reach = i + 1
+		}
+{{- end}}
+		if c := {{if .Bytes}}buffer.at(i){{else}}buffer[i]{{end}}; {{.WordCondition}} {
+			return false
+		}
 		position = i
 		return true
 	}
@@ -447,16 +2096,655 @@ func (p *{{.StructName}}) Init(options ...func(*{{.StructName}}) error) error {
 		return false
 	}*/
 	{{end}}
-
+{{if .Iterative}}
+	var match func(rule uint32) bool
+{{end}}
 	_rules = [...]func() bool {
 		nil,`
 
-type Type uint8
+const cSharedTemplate = `{{.Header}}
 
-const (
-	TypeUnknown Type = iota
-	TypeRule
-	TypeName
+package {{.PackageName}}
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"unsafe"
+)
+
+type cSharedNode struct {
+	Rule      string        ` + "`" + `json:"rule"` + "`" + `
+	Begin     uint32        ` + "`" + `json:"begin"` + "`" + `
+	End       uint32        ` + "`" + `json:"end"` + "`" + `
+	ByteBegin int           ` + "`" + `json:"byte_begin"` + "`" + `
+	ByteEnd   int           ` + "`" + `json:"byte_end"` + "`" + `
+	Children  []cSharedNode ` + "`" + `json:"children,omitempty"` + "`" + `
+}
+
+func (p *{{.StructName}}) cSharedTree(node *node32) []cSharedNode {
+	var nodes []cSharedNode
+	for ; node != nil; node = node.next {
+		nodes = append(nodes, cSharedNode{
+			Rule:      rul3s[node.pegRule],
+			Begin:     node.begin,
+			End:       node.end,
+			ByteBegin: p.ByteOffset(int(node.begin)),
+			ByteEnd:   p.ByteOffset(int(node.end)),
+			Children:  p.cSharedTree(node.up),
+		})
+	}
+	return nodes
+}
+
+// Parse parses the NUL terminated input and returns a JSON object holding
+// either the syntax tree or the parse error, as described by version
+// {{.SchemaVersion}} of the schema printed by peg tree-schema. The result must be
+// released with Free.
+//
+//export Parse
+func Parse(input *C.char) *C.char {
+	var result struct {
+		Version int           ` + "`" + `json:"version"` + "`" + `
+		Tree    []cSharedNode ` + "`" + `json:"tree,omitempty"` + "`" + `
+		Error   string        ` + "`" + `json:"error,omitempty"` + "`" + `
+	}
+	result.Version = {{.SchemaVersion}}
+	p := &{{.StructName}}{Buffer: {{if .Bytes}}[]byte(C.GoString(input)){{else}}C.GoString(input){{end}}}
+	if err := p.Init(); err != nil {
+		result.Error = err.Error()
+	} else if err := p.Parse(); err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Tree = p.cSharedTree(p.AST())
+	}
+	out, err := json.Marshal(result)
+	if err != nil {
+		out = []byte("{}")
+	}
+	return C.CString(string(out))
+}
+
+// Free releases a result returned by Parse.
+//
+//export Free
+func Free(result *C.char) {
+	C.free(unsafe.Pointer(result))
+}
+`
+
+const benchmarkTemplate = `{{.Header}}
+
+package {{.PackageName}}
+
+import (
+{{- range .Samples}}{{if .File}}
+	_ "embed"
+{{- break}}{{end}}{{end}}
+{{- range .Benchmarks}}{{if .File}}
+	"os"
+{{- break}}{{end}}{{end}}
+	"testing"
+)
+{{range $i, $sample := .Samples}}{{if .File}}
+//go:embed {{.File}}
+var sample{{$i}} string
+{{end}}{{end}}
+{{- if .Samples}}
+var samples = []struct {
+	name, buffer string
+}{
+{{- range $i, $sample := .Samples}}
+	{ {{- printf "%q" .Name}}, {{if .File}}sample{{$i}}{{else}}{{printf "%q" .Text}}{{end -}} },
+{{- end}}
+}
+
+// BenchmarkParse measures initializing a parser and parsing every sample.
+func BenchmarkParse(b *testing.B) {
+	for _, sample := range samples {
+		b.Run(sample.name, func(b *testing.B) {
+			b.SetBytes(int64(len(sample.buffer)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				p := &{{$.StructName}}{Buffer: {{if $.Bytes}}[]byte(sample.buffer){{else}}sample.buffer{{end}}}
+				if err := p.Init(); err != nil {
+					b.Fatal(err)
+				}
+				if err := p.Parse(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkReset measures parsing every sample again with a parser that is
+// reset, which reuses the memory of the previous parse.
+func BenchmarkReset(b *testing.B) {
+	for _, sample := range samples {
+		b.Run(sample.name, func(b *testing.B) {
+			p := &{{$.StructName}}{Buffer: {{if $.Bytes}}[]byte(sample.buffer){{else}}sample.buffer{{end}}}
+			if err := p.Init(); err != nil {
+				b.Fatal(err)
+			}
+			if err := p.Parse(); err != nil {
+				b.Fatal(err)
+			}
+			b.SetBytes(int64(len(sample.buffer)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				p.Reset()
+				if err := p.Parse(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+{{end}}
+{{- range .Benchmarks}}
+func Benchmark{{.Rule}}(b *testing.B) {
+{{- if .File}}
+	sample, err := os.ReadFile({{printf "%q" .File}})
+	if err != nil {
+		b.Fatal(err)
+	}
+	buffer := string(sample)
+{{- else}}
+	buffer := {{printf "%q" .Sample}}
+{{- end}}
+	p := &{{$.StructName}}{Buffer: {{if $.Bytes}}[]byte(buffer){{else}}buffer{{end}}}
+	if err := p.Init(); err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(buffer)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p.Reset()
+		if err := p.Parse(int(rule{{.Rule}})); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+{{end}}`
+
+const testTemplate = `{{.Header}}
+
+package {{.PackageName}}
+
+import (
+{{- range .Tests}}{{if .Reject}}
+	"errors"
+{{- break}}{{end}}{{end}}
+	"testing"
+)
+
+// TestRules checks that the rules match the inputs given with %accept, and
+// fail with a syntax error on the inputs given with %reject.
+func TestRules(t *testing.T) {
+	for _, test := range []struct {
+		name, buffer string
+		rule         pegRule
+		reject       bool
+		line, symbol int
+	}{
+{{- range $i, $test := .Tests}}
+		{ {{- printf "%v/%d" .Rule $i | printf "%q"}}, {{printf "%q" .Input}}, rule{{.Rule}}, {{.Reject}}, {{.Line}}, {{.Symbol -}} },
+{{- end}}
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			p := &{{$.StructName}}{Buffer: {{if $.Bytes}}[]byte(test.buffer){{else}}test.buffer{{end}}}
+			if err := p.Init(); err != nil {
+				t.Fatal(err)
+			}
+			err := p.Parse(int(test.rule))
+			if !test.reject {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+{{- range .Tests}}{{if .Reject}}
+			if err == nil {
+				t.Fatalf("%q matches, expected a syntax error", test.buffer)
+			}
+			var syntaxError *SyntaxError
+			if !errors.As(err, &syntaxError) {
+				t.Fatalf("got %v, expected a syntax error", err)
+			}
+			if test.line > 0 && (syntaxError.Line != test.line || syntaxError.Symbol != test.symbol) {
+				t.Fatalf("got a syntax error at %d:%d, expected it at %d:%d:%v", syntaxError.Line, syntaxError.Symbol, test.line, test.symbol, err)
+			}
+{{- break}}{{end}}{{end}}
+		})
+	}
+}
+`
+
+const raceTestTemplate = `{{.Header}}
+
+package {{.PackageName}}
+
+import (
+{{- if .Ast}}
+	"fmt"
+{{- end}}
+{{- range .Samples}}{{if .File}}
+	"os"
+{{- break}}{{end}}{{end}}
+{{- if .Ast}}
+	"strings"
+{{- end}}
+	"sync"
+	"testing"
+)
+
+// TestRace parses the inputs given with %sample, %accept and %reject with
+// parsers running in parallel goroutines, each reset for every input, and
+// checks that they get the results of parsers of their own. Run with go test
+// -race, it also reports any state the parsers share.
+func TestRace(t *testing.T) {
+	inputs := []struct {
+		name, buffer, file string
+		rule               pegRule
+	}{
+{{- range .Samples}}
+		{ {{- printf "%q" .Name}}, {{printf "%q" .Text}}, {{printf "%q" .File}}, rule{{(index $.RuleNames 0).String -}} },
+{{- end}}
+{{- range $i, $test := .Tests}}
+		{ {{- printf "%v/%d" .Rule $i | printf "%q"}}, {{printf "%q" .Input}}, "", rule{{.Rule -}} },
+{{- end}}
+	}
+{{- range .Samples}}{{if .File}}
+	for i, input := range inputs {
+		if input.file != "" {
+			buffer, err := os.ReadFile(input.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			inputs[i].buffer = string(buffer)
+		}
+	}
+{{- break}}{{end}}{{end}}
+	parse := func(p *{{.StructName}}, buffer string, rule pegRule) string {
+		p.Buffer = {{if .Bytes}}[]byte(buffer){{else}}buffer{{end}}
+		p.Reset()
+		if err := p.Parse(int(rule)); err != nil {
+			return err.Error()
+		}
+{{- if .Ast}}
+		var result strings.Builder
+		for _, token := range p.Tokens() {
+			fmt.Fprintf(&result, "%v %v %v\n", rul3s[token.pegRule], token.begin, token.end)
+		}
+		return result.String()
+{{- else}}
+		return ""
+{{- end}}
+	}
+	parser := func() *{{.StructName}} {
+		p := &{{.StructName}}{}
+		if err := p.Init(); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	expected := make([]string, len(inputs))
+	for i, input := range inputs {
+		expected[i] = parse(parser(), input.buffer, input.rule)
+	}
+	var wait sync.WaitGroup
+	for n := 0; n < 8; n++ {
+		p := parser()
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for j := 0; j < 4; j++ {
+				for i, input := range inputs {
+					if result := parse(p, input.buffer, input.rule); result != expected[i] {
+						t.Errorf("%v parsed in parallel gives\n%v\nexpected\n%v", input.name, result, expected[i])
+					}
+				}
+			}
+		}()
+	}
+	wait.Wait()
+}
+`
+
+const serverTemplate = `{{.Header}}
+
+package {{.PackageName}}
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+type serverNode struct {
+	Rule      string       ` + "`" + `json:"rule"` + "`" + `
+	Begin     uint32       ` + "`" + `json:"begin"` + "`" + `
+	End       uint32       ` + "`" + `json:"end"` + "`" + `
+	ByteBegin int          ` + "`" + `json:"byte_begin"` + "`" + `
+	ByteEnd   int          ` + "`" + `json:"byte_end"` + "`" + `
+	Children  []serverNode ` + "`" + `json:"children,omitempty"` + "`" + `
+}
+
+func (p *{{.StructName}}) serverTree(node *node32) []serverNode {
+	var nodes []serverNode
+	for ; node != nil; node = node.next {
+		nodes = append(nodes, serverNode{
+			Rule:      rul3s[node.pegRule],
+			Begin:     node.begin,
+			End:       node.end,
+			ByteBegin: p.ByteOffset(int(node.begin)),
+			ByteEnd:   p.ByteOffset(int(node.end)),
+			Children:  p.serverTree(node.up),
+		})
+	}
+	return nodes
+}
+
+// ParseHandler returns an HTTP handler which parses the body of POST requests,
+// starting with the rule named by the optional rule query parameter. It responds
+// with a JSON object holding either the syntax tree, or the parse error together
+// with the offset of the farthest failure and the terminals expected there, as
+// described by version {{.SchemaVersion}} of the schema printed by peg tree-schema.
+func ParseHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		rule := 1
+		if name := r.URL.Query().Get("rule"); name != "" {
+			rule = 0
+			for i, n := range rul3s {
+				if n == name {
+					rule = i
+				}
+			}
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var result struct {
+			Version    int          ` + "`" + `json:"version"` + "`" + `
+			Tree       []serverNode ` + "`" + `json:"tree,omitempty"` + "`" + `
+			Error      string       ` + "`" + `json:"error,omitempty"` + "`" + `
+			Offset     uint32       ` + "`" + `json:"offset,omitempty"` + "`" + `
+			ByteOffset int          ` + "`" + `json:"byte_offset,omitempty"` + "`" + `
+			Expected   []string     ` + "`" + `json:"expected,omitempty"` + "`" + `
+		}
+		result.Version = {{.SchemaVersion}}
+		status := http.StatusOK
+		p := &{{.StructName}}{Buffer: {{if .Bytes}}body{{else}}string(body){{end}}}
+		if err := p.Init(); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if rule <= 0 || rule >= len(p.rules) || p.rules[rule] == nil {
+			http.Error(w, "unknown rule", http.StatusBadRequest)
+			return
+		}
+		if err := p.Parse(rule); err != nil {
+			status = http.StatusUnprocessableEntity
+			result.Error, result.Offset, result.Expected = err.Error(), p.farthest, p.expectations()
+			result.ByteOffset = p.ByteOffset(int(p.farthest))
+		} else {
+			result.Tree = p.serverTree(p.AST())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
+`
+
+const compareTemplate = `{{.Header}}
+
+package {{.PackageName}}
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// TestPegCompare parses every file below the directory PEG_COMPARE_CORPUS,
+// and writes to PEG_COMPARE_OUTPUT a JSON object mapping the files to
+// "rejected" or to their syntax trees.
+func TestPegCompare(t *testing.T) {
+	corpus := os.Getenv("PEG_COMPARE_CORPUS")
+	if corpus == "" {
+		t.Skip("PEG_COMPARE_CORPUS is not set")
+	}
+	results := make(map[string]string)
+	err := filepath.WalkDir(corpus, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		buffer, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(corpus, path)
+		if err != nil {
+			return err
+		}
+		p := &{{.StructName}}{Buffer: {{if .Bytes}}buffer{{else}}string(buffer){{end}}}
+		if err := p.Init(); err != nil {
+			return err
+		}
+		if err := p.Parse(); err != nil {
+			results[name] = "rejected"
+			return nil
+		}
+		results[name] = pegCompareTree(p.AST())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(os.Getenv("PEG_COMPARE_OUTPUT"), out, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// pegCompareTree writes the nodes as Rule[begin:end] followed by their
+// children in parentheses.
+func pegCompareTree(node *node32) string {
+	s := ""
+	for ; node != nil; node = node.next {
+		if s != "" {
+			s += " "
+		}
+		s += rul3s[node.pegRule] + "[" + strconv.Itoa(int(node.begin)) + ":" + strconv.Itoa(int(node.end)) + "]"
+		if node.up != nil {
+			s += "(" + pegCompareTree(node.up) + ")"
+		}
+	}
+	return s
+}
+`
+
+const substituteTemplate = `{{.Header}}
+
+package {{.PackageName}}
+
+import (
+	"os"
+	"testing"
+)
+
+// TestPegSubstitute parses the file PEG_SUBSTITUTE_INPUT, and writes it to
+// PEG_SUBSTITUTE_OUTPUT with the matches of the rule PEG_SUBSTITUTE_RULE
+// replaced by PEG_SUBSTITUTE_TEMPLATE.
+func TestPegSubstitute(t *testing.T) {
+	input := os.Getenv("PEG_SUBSTITUTE_INPUT")
+	if input == "" {
+		t.Skip("PEG_SUBSTITUTE_INPUT is not set")
+	}
+	rule, name := pegRule(0), os.Getenv("PEG_SUBSTITUTE_RULE")
+	for i, r := range rul3s {
+		if r == name {
+			rule = pegRule(i)
+		}
+	}
+	if rule == 0 {
+		t.Fatalf("unknown rule '%v'", name)
+	}
+	buffer, err := os.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &{{.StructName}}{Buffer: {{if .Bytes}}buffer{{else}}string(buffer){{end}}}
+	if err := p.Init(); err != nil {
+		t.Fatal(err)
+	}
+	p.SetFilename(input)
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	substituted := p.Substitute(rule, os.Getenv("PEG_SUBSTITUTE_TEMPLATE"))
+	if err := os.WriteFile(os.Getenv("PEG_SUBSTITUTE_OUTPUT"), []byte(substituted), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+`
+
+const reduceTemplate = `{{.Header}}
+
+package {{.PackageName}}
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestPegReduce shrinks the file PEG_REDUCE_INPUT by delta debugging to an
+// input failing the same way, from which no rune and no run of runes can be
+// removed, and writes it to PEG_REDUCE_OUTPUT. Parsing takes at least
+// PEG_REDUCE_SLOW to count as a slowdown, if it is set.
+func TestPegReduce(t *testing.T) {
+	input := os.Getenv("PEG_REDUCE_INPUT")
+	if input == "" {
+		t.Skip("PEG_REDUCE_INPUT is not set")
+	}
+	var slow time.Duration
+	if s := os.Getenv("PEG_REDUCE_SLOW"); s != "" {
+		var err error
+		if slow, err = time.ParseDuration(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buffer, err := os.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failure := pegReduceFailure(string(buffer), slow)
+	if failure == "" {
+		t.Fatalf("%v parses", input)
+	}
+	fails := func(runes []rune) bool {
+		return pegReduceFailure(string(runes), slow) == failure
+	}
+
+	/* try the chunks of n, then their complements, and split them further
+	   when neither fails */
+	runes := []rune(string(buffer))
+	for n := 2; len(runes) > 1; {
+		size, reduced := (len(runes)+n-1)/n, false
+		for begin := 0; begin < len(runes) && !reduced; begin += size {
+			end := begin + size
+			if end > len(runes) {
+				end = len(runes)
+			}
+			complement := append(append([]rune(nil), runes[:begin]...), runes[end:]...)
+			switch {
+			case fails(runes[begin:end]):
+				runes, n, reduced = runes[begin:end], 2, true
+			case n > 2 && fails(complement):
+				runes, n, reduced = complement, n-1, true
+			}
+		}
+		if !reduced {
+			if n >= len(runes) {
+				break
+			}
+			n *= 2
+			if n > len(runes) {
+				n = len(runes)
+			}
+		}
+	}
+	if len(runes) == 1 && fails(nil) {
+		runes = nil
+	}
+	if err := os.WriteFile(os.Getenv("PEG_REDUCE_OUTPUT"), []byte(string(runes)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("reduced %v runes to %v, failing with %v", len([]rune(string(buffer))), len(runes), failure)
+}
+
+// pegReduceFailure parses input and returns how it fails: the value of a
+// panic, slow if it took at least slow, the terminals expected by a syntax
+// error, or another error. It returns "" if input parses.
+func pegReduceFailure(input string, slow time.Duration) (failure string) {
+	defer func() {
+		if r := recover(); r != nil {
+			failure = fmt.Sprint("panic: ", r)
+		}
+	}()
+	p := &{{.StructName}}{Buffer: {{if .Bytes}}[]byte(input){{else}}input{{end}}}
+	if err := p.Init(); err != nil {
+		return err.Error()
+	}
+	start := time.Now()
+	err := p.Parse()
+{{- if and .Ast .HasActions}}
+	if err == nil {
+		p.Execute()
+	}
+{{- end}}
+	var syntaxError *SyntaxError
+	switch {
+	case slow > 0 && time.Since(start) >= slow:
+		return "slow"
+	case errors.As(err, &syntaxError):
+		return "expected " + strings.Join(syntaxError.Expected(), ", ")
+	case err != nil:
+		return err.Error()
+	}
+	return ""
+}
+`
+
+type Type uint8
+
+const (
+	TypeUnknown Type = iota
+	TypeRule
+	TypeName
 	TypeDot
 	TypeCharacter
 	TypeRange
@@ -482,6 +2770,12 @@ const (
 	TypePush
 	TypeImplicitPush
 	TypeNil
+	TypeIn
+	TypeKeyword
+	TypeNotClass
+	TypeHint
+	TypeFail
+	TypeProperty
 	TypeLast
 )
 
@@ -514,6 +2808,12 @@ var TypeMap = [...]string{
 	"TypePush",
 	"TypeImplicitPush",
 	"TypeNil",
+	"TypeIn",
+	"TypeKeyword",
+	"TypeNotClass",
+	"TypeHint",
+	"TypeFail",
+	"TypeProperty",
 	"TypeLast",
 }
 
@@ -561,6 +2861,15 @@ type node struct {
 	/* use hash table here instead of Copy? */
 	next *node
 
+	/* the line of an action in the grammar */
+	line int
+
+	/* the literal whose first character this is, which its failures report */
+	literal string
+
+	/* the character is written as an escape sequence such as \xe9 */
+	escaped bool
+
 	parentDetect      bool
 	parentMultipleKey bool
 }
@@ -652,7 +2961,12 @@ func (n *node) Len() (c int) {
 }
 
 func (n *node) Copy() *node {
-	return &node{Type: n.Type, string: n.string, id: n.id, front: n.front, back: n.back, length: n.length}
+	return &node{Type: n.Type, string: n.string, id: n.id, front: n.front, back: n.back, length: n.length, line: n.line, literal: n.literal, escaped: n.escaped}
+}
+
+// Line returns the line of an action in the grammar, or 0 if it is unknown.
+func (n *node) Line() int {
+	return n.line
 }
 
 func (n *node) Slice() []*node {
@@ -663,6 +2977,13 @@ func (n *node) Slice() []*node {
 	return s
 }
 
+// replace turns n into the node element, keeping its place in the list it is in.
+func (n *node) replace(element *node) {
+	next := n.next
+	*n = *element
+	n.next = next
+}
+
 func (n *node) ParentDetect() bool {
 	return n.parentDetect
 }
@@ -679,187 +3000,2034 @@ func (n *node) SetParentMultipleKey(multipleKey bool) {
 	n.parentMultipleKey = multipleKey
 }
 
+// Benchmark is a rule marked with %bench, along with the sample input or the
+// file holding it.
+type Benchmark struct {
+	Rule, Sample, File string
+}
+
+// RuleTest is an input which Rule must match, given with %accept, or must
+// fail to match, given with %reject. If Line is set, the syntax error must be
+// at Line and Symbol, counted like those of SyntaxError.
+type RuleTest struct {
+	Rule, Input  string
+	Reject       bool
+	Line, Symbol int
+}
+
+// directive returns the directive the test is given with.
+func (r RuleTest) directive() string {
+	if r.Reject {
+		return "reject"
+	}
+	return "accept"
+}
+
+// RuleKind is a rule mapped with %map to a Go constant, such as a token kind
+// of another package.
+type RuleKind struct {
+	Rule, Constant string
+}
+
+// RuleBuild is a rule annotated with ->, and the Go expression constructing its
+// value, with $0 and $1, $2, ... replaced by its text and the values of its
+// children.
+type RuleBuild struct {
+	Rule, Expression string
+}
+
+// RuleSource is a rule in the table of rules of the generated code: the file
+// it is defined in and its first and last lines, which are 0 for the rules
+// added by peg, whether it references no other rules, and whether it is
+// inlined.
+type RuleSource struct {
+	Name, File       string
+	Line, EndLine    int
+	Lexical, Inlined bool
+}
+
+// TypedRule is a rule of the typed AST generated with Typed: the type of its
+// nodes, and their fields for the rules it references.
+type TypedRule struct {
+	Rule, Type string
+	Fields     []TypedField
+}
+
+// TypedField is the field of a typed node for the nodes of Rule below it, a
+// slice if the rule can match more than once.
+type TypedField struct {
+	Rule, Name, Type string
+	Slice            bool
+}
+
+// Sample is an input given with %sample for BenchmarkParse and BenchmarkReset,
+// either its text or the file embedded into the benchmarks.
+type Sample struct {
+	Name, Text, File string
+}
+
+// The names of the optimization passes of Compile, which DisabledPasses
+// turns off.
+const (
+	PassFoldPredicates = "fold-predicates"
+	PassLoopRecursion  = "loop-recursion"
+	PassLeftFactor     = "left-factor"
+	PassSwitch         = "switch"
+	PassInline         = "inline"
+)
+
+// Passes are the names of all optimization passes, in the order Compile runs
+// them. Switches and inlining also have to be enabled with New, left
+// factoring runs only if either of them is, and loop recursion only with
+// LoopRecursion.
+var Passes = []string{PassFoldPredicates, PassLoopRecursion, PassLeftFactor, PassSwitch, PassInline}
+
 /* A tree data structure into which a PEG can be parsed. */
 type Tree struct {
 	Rules      map[string]Node
 	rulesCount map[string]uint
+	// leftRecursive holds the rules of left recursive cycles, which grow
+	// their match from a seed with the AST.
+	leftRecursive map[string]bool
 	node
 	inline, _switch, Ast bool
 	Strict               bool
-
-	Generator       string
-	RuleNames       []Node
-	Comments        string
-	PackageName     string
-	Imports         []string
-	EndSymbol       rune
-	PegRuleType     string
-	StructName      string
-	StructVariables string
-	RulesCount      int
-	Bits            int
-	HasActions      bool
-	Actions         []Node
-	HasPush         bool
-	HasCommit       bool
-	HasDot          bool
-	HasCharacter    bool
-	HasString       bool
-	HasRange        bool
+	Quiet                bool
+	DisabledWarnings     map[string]bool
+	ErrorWarnings        map[string]bool
+	License              string
+	Provenance           string
+	Markers              []string
+	Verbose              bool
+	NoMemoFailures       bool
+	CompactMemo          bool
+	Captures             bool
+	NoMemoSuccesses      bool
+	// DisabledPasses are the names of the optimization passes Compile
+	// skips, to bisect miscompilations or to compile faster.
+	DisabledPasses map[string]bool
+	// LoopRecursion enables the loop-recursion pass, which changes the
+	// syntax tree of the rules it converts, like -O2.
+	LoopRecursion bool
+	// Report, if set, receives the warnings Compile doesn't fail with,
+	// instead of standard error.
+	Report func(warning error)
+	// Package, if set, replaces the package of the grammar in the
+	// generated code.
+	Package string
+	// SplitTokens leaves the rules and the tokens and nodes of the syntax
+	// tree out of the parser, for CompileTokens to write them to a file of
+	// their own.
+	SplitTokens bool
+	// Bytes generates a parser whose Buffer is a []byte, matched byte by
+	// byte without converting it to runes. Positions are byte offsets, and
+	// the text of the actions is a subslice of the buffer.
+	Bytes bool
+	// GrammarFile is the name of the grammar in the table of rules of the
+	// generated code, to which the files given with %include are relative.
+	GrammarFile string
+	// NoPrint leaves out the methods printing the tokens and the syntax
+	// tree, and the imports only they use.
+	NoPrint bool
+	// Lines generates an index of the lines of the buffer, built when the
+	// parser is reset, from which Position and EndPosition of the tokens
+	// return their lines and columns.
+	Lines bool
+	// Trace generates the Trace and TraceWriter options of Init, which
+	// report the rules entered and exited while parsing.
+	Trace bool
+	// Watchdog generates the Watchdog option of Init, which reports the
+	// slow rule invocations.
+	Watchdog bool
+	// Iterative matches the rules in a loop with a stack of its own instead
+	// of calling a Go function per rule, so that deeply nested input can't
+	// exhaust the goroutine stack. It doesn't support left recursion,
+	// %recover, %hint, %state, %memokey, Trace and Watchdog.
+	Iterative bool
+	// Incremental generates Edit, which parses the buffer again after an
+	// edit, reusing the memoized matches the edit didn't change.
+	Incremental bool
+	// Streaming generates ParseReader, which parses an input read from an
+	// io.Reader as a series of matches of a rule.
+	Streaming bool
+	// Concurrent generates FindAllConcurrent, which scans parts of the
+	// buffer for the matches of a rule in parallel goroutines.
+	Concurrent bool
+	// Substitution generates Substitute, which replaces the matches of a
+	// rule by a template like the rewrite command, which sets it.
+	Substitution bool
+	// Tolerant generates Sanitize, which edits invalid input at its
+	// failures until it parses, for the tolerant ingestion of
+	// semi-structured data.
+	Tolerant bool
+	// Expected tracks the terminals expected at the farthest failure, which
+	// syntax errors list, and generates Completions and ParsePartial, which
+	// return them. Tracking them slows parsing down by about a tenth, so
+	// without it a syntax error is located at the end of the farthest
+	// match instead. Compile turns it on for the grammars with %hint or
+	// %recover, and with Tolerant or Streaming, which need it.
+	Expected bool
+	// Typed generates a struct for each rule, with fields for the nodes of
+	// the rules it references, and Typed, which builds them from the syntax
+	// tree.
+	Typed bool
+	// Memo selects the rules memoized with the AST: "all", the rules
+	// "marked" with %memo, or "none". If empty, all rules are memoized
+	// unless the grammar marks rules with %memo.
+	Memo            string
+	memo            map[string]bool
+	noMemoKind      string
+	noMemo          map[string]map[string]bool
+	memoKey         string
+	memoKeys        map[string]string
+	recovery        map[string]bool
+	builds          map[string]string
+	caseInsensitive bool
+	caseRules       map[string]bool
+	rule            string
+	word            *node
+	errors          []error
+	includes        []include
+	ruleStatus      map[string]string
+	// source is the grammar the rules added with AddRuleAt begin in, at the
+	// rune offsets of definitions, and sources are the included rules.
+	source      string
+	definitions []definition
+	sources     map[string]RuleSource
+
+	Generator        string
+	RuleNames        []Node
+	Comments         string
+	PackageName      string
+	Imports          []string
+	EndSymbol        rune
+	BufferType       string
+	PegRuleType      string
+	StructName       string
+	StructVariables  string
+	RulesCount       int
+	Bits             int
+	HasActions       bool
+	Actions          []Node
+	HasPush          bool
+	HasCommit        bool
+	HasDot           bool
+	HasCharacter     bool
+	HasString        bool
+	HasRange         bool
+	HasKeyword       bool
+	HasMemoKey       bool
+	HasHint          bool
+	HasRecover       bool
+	HasLeftRecursion bool
+	WordCondition    string
+	Benchmarks       []Benchmark
+	Samples          []Sample
+	Tests            []RuleTest
+	Kinds            []RuleKind
+	RecoveryRules    []string
+	Builds           []RuleBuild
+	TypedRules       []TypedRule
+	RuleSources      []RuleSource
+	LineFile         string
+	ErrorType        string
+	ErrorFields      string
+	StateFields      string
+
+	// Rewrites post-process the syntax tree of the generated code, in order,
+	// before Compile formats it.
+	Rewrites []func(fileSet *token.FileSet, code *ast.File) error
 }
 
 func New(inline, _switch, noast bool) *Tree {
 	return &Tree{
-		Rules:      make(map[string]Node),
-		rulesCount: make(map[string]uint),
-		inline:     inline,
-		_switch:    _switch,
-		Ast:        !noast,
+		Rules:         make(map[string]Node),
+		rulesCount:    make(map[string]uint),
+		leftRecursive: make(map[string]bool),
+		memo:          make(map[string]bool),
+		noMemo:        map[string]map[string]bool{"failures": {}, "successes": {}},
+		memoKeys:      make(map[string]string),
+		recovery:      make(map[string]bool),
+		builds:        make(map[string]string),
+		caseRules:     make(map[string]bool),
+		sources:       make(map[string]RuleSource),
+		inline:        inline,
+		_switch:       _switch,
+		Ast:           !noast,
 	}
 }
 
 func (t *Tree) AddRule(name string) {
+	t.rule = name
 	t.PushFront(&node{Type: TypeRule, string: name, id: t.RulesCount})
 	t.RulesCount++
 }
 
-func (t *Tree) AddExpression() {
-	expression := t.PopFront()
-	rule := t.PopFront()
-	rule.PushBack(expression)
-	t.PushBack(rule)
+// definition is a rule added with AddRuleAt, beginning at the rune offset
+// begin of the grammar.
+type definition struct {
+	rule  Node
+	begin int
 }
 
-func (t *Tree) AddName(text string) {
-	t.PushFront(&node{Type: TypeName, string: text})
+// AddRuleAt adds the rule name, which begins at rune offset begin of the
+// grammar in buffer, for the table of rules of the generated code.
+func (t *Tree) AddRuleAt(buffer string, begin int, name string) {
+	t.AddRule(name)
+	t.source = buffer
+	t.definitions = append(t.definitions, definition{rule: t.Front(), begin: begin})
+}
+
+// ruleLines returns the first and last lines of the rules added with
+// AddRuleAt, keyed by their names, and the rules included from other files.
+// A rule ends with the last line before the next rule which isn't blank or a
+// comment.
+func (t *Tree) ruleLines() map[string]RuleSource {
+	sources := make(map[string]RuleSource, len(t.definitions)+len(t.sources))
+	for name, source := range t.sources {
+		sources[name] = source
+	}
+	runes, line, offset := []rune(t.source), 1, 0
+	for i, d := range t.definitions {
+		for ; offset < d.begin; offset++ {
+			if runes[offset] == '\n' {
+				line++
+			}
+		}
+		end := len(runes)
+		if i+1 < len(t.definitions) {
+			end = t.definitions[i+1].begin
+		}
+		lines := strings.Split(string(runes[d.begin:end]), "\n")
+		for len(lines) > 1 {
+			if last := strings.TrimSpace(lines[len(lines)-1]); last != "" && !strings.HasPrefix(last, "#") {
+				break
+			}
+			lines = lines[:len(lines)-1]
+		}
+		sources[d.rule.String()] = RuleSource{Name: d.rule.String(), Line: line, EndLine: line + len(lines) - 1}
+	}
+	return sources
+}
+
+func (t *Tree) AddExpression() {
+	expression := t.PopFront()
+	rule := t.PopFront()
+	rule.PushBack(expression)
+	t.PushBack(rule)
+}
+
+func (t *Tree) AddName(text string) {
+	t.PushFront(&node{Type: TypeName, string: text})
+}
+
+func (t *Tree) AddDot() { t.PushFront(&node{Type: TypeDot, string: "."}) }
+func (t *Tree) AddCharacter(text string) {
+	t.PushFront(&node{Type: TypeCharacter, string: text})
+}
+
+// foldCase returns the runes equal to c under Unicode simple case folding,
+// such as k, K and the Kelvin sign, with the lower and the upper case of c
+// first.
+func foldCase(c rune) []rune {
+	orbit := []rune{c}
+	for r := unicode.SimpleFold(c); r != c; r = unicode.SimpleFold(r) {
+		orbit = append(orbit, r)
+	}
+	slices.Sort(orbit)
+	runes := []rune{}
+	for _, r := range append([]rune{unicode.ToLower(c), unicode.ToUpper(c)}, orbit...) {
+		if slices.Contains(orbit, r) && !slices.Contains(runes, r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+// AddDoubleCharacter adds the character text matched in any case, as the
+// alternatives of its Unicode simple case folding.
+func (t *Tree) AddDoubleCharacter(text string) {
+	runes := foldCase([]rune(text)[0])
+	t.AddCharacter(string(runes[0]))
+	for _, r := range runes[1:] {
+		t.AddCharacter(string(r))
+		t.AddAlternate()
+	}
+}
+
+// AddLiteralCharacter adds a character of a single quoted literal, which
+// matches in any case if the grammar, or the rule it is in, is
+// case-insensitive.
+func (t *Tree) AddLiteralCharacter(text string) {
+	if t.caseInsensitive || t.caseRules[t.rule] {
+		t.AddDoubleCharacter(text)
+		return
+	}
+	t.AddCharacter(text)
+}
+
+// SetWord pops the character class of word characters. Literals made only of
+// word characters, such as keywords, must then not be followed by a word character.
+func (t *Tree) SetWord() { t.word = t.PopFront() }
+
+// AddLiteral marks the first character of the literal on top of the stack, or
+// each of its cases if it is matched in any case, so that the failures to
+// match it report the whole literal rather than its first character.
+func (t *Tree) AddLiteral() {
+	literal := t.Front()
+	if literal.GetType() != TypeSequence {
+		return
+	}
+	text := ""
+	for _, element := range literal.Slice() {
+		if element.GetType() == TypeAlternate {
+			element = element.Front()
+		}
+		text += element.String()
+	}
+	first := literal.Front()
+	if first.GetType() == TypeAlternate {
+		for _, element := range first.Slice() {
+			element.literal = text
+		}
+		return
+	}
+	first.literal = text
+}
+
+// AddWordBoundary appends a check for a following word character to the
+// literal on top of the stack if it consists only of word characters.
+func (t *Tree) AddWordBoundary() {
+	if t.word == nil {
+		return
+	}
+	words := classSet(t.word)
+	var isWord func(n Node) bool
+	isWord = func(n Node) bool {
+		switch n.GetType() {
+		case TypeCharacter:
+			return words.Has([]rune(n.String())[0])
+		case TypeAlternate:
+			/* a character matched in any case is a word character if one
+			   of its cases is, the Kelvin sign matching k or not */
+			for _, element := range n.Slice() {
+				if isWord(element) {
+					return true
+				}
+			}
+		case TypeSequence:
+			for _, element := range n.Slice() {
+				if !isWord(element) {
+					return false
+				}
+			}
+			return true
+		}
+		return false
+	}
+	if !isWord(t.Front()) {
+		return
+	}
+	t.PushFront(deepCopy(t.word))
+	t.AddPeekNot()
+	t.AddSequence()
+}
+
+// AddMemo marks a rule to be memoized if only the marked rules are.
+func (t *Tree) AddMemo(name string) { t.memo[name] = true }
+
+// SetNoMemo sets if the rules of the following %nomemo directive don't memoize
+// their "failures" or their "successes".
+func (t *Tree) SetNoMemo(kind string) { t.noMemoKind = kind }
+
+// AddNoMemo disables memoizing the failures or the successes of a rule.
+func (t *Tree) AddNoMemo(name string) { t.noMemo[t.noMemoKind][name] = true }
+
+// SetMemoKey sets the state fingerprint of the rules of the following %memokey
+// directive, a Go expression converted to uint64.
+func (t *Tree) SetMemoKey(text string) { t.memoKey = strings.TrimSpace(text) }
+
+// AddMemoKey adds the state fingerprint to the memoization key of a rule.
+func (t *Tree) AddMemoKey(name string) { t.memoKeys[name] = t.memoKey }
+
+// AddRecovery marks a rule as matching the errors the grammar recovers from.
+func (t *Tree) AddRecovery(name string) { t.recovery[name] = true }
+
+// AddKind maps a rule to the constant set by the following SetKindConstant.
+func (t *Tree) AddKind(name string) { t.Kinds = append(t.Kinds, RuleKind{Rule: name}) }
+
+// SetKindConstant sets the constant the last rule given with %map is mapped to.
+func (t *Tree) SetKindConstant(text string) { t.Kinds[len(t.Kinds)-1].Constant = text }
+
+// buildReferences are the references $0, $1, ... to the text and the children
+// of a rule in the expression building its value.
+var buildReferences = regexp.MustCompile(`\$[0-9]+`)
+
+// AddBuild annotates the last rule with the type of its value, which the
+// following SetBuildFields completes to a composite literal. A leading * makes
+// the literal a pointer.
+func (t *Tree) AddBuild(text string) {
+	if strings.HasPrefix(text, "*") {
+		text = "&" + text[1:]
+	}
+	t.builds[t.back.String()] = text
+}
+
+// SetBuildFields sets the fields of the composite literal of the last rule.
+func (t *Tree) SetBuildFields(text string) {
+	t.builds[t.back.String()] += "{" + strings.TrimSpace(text) + "}"
+}
+
+// AddBench marks a rule to be benchmarked.
+func (t *Tree) AddBench(name string) { t.Benchmarks = append(t.Benchmarks, Benchmark{Rule: name}) }
+
+// SetBenchSample sets the sample input of the last rule marked with %bench.
+func (t *Tree) SetBenchSample(text string) { t.Benchmarks[len(t.Benchmarks)-1].Sample = text }
+
+// SetBenchFile sets the file holding the input of the last rule marked with %bench.
+func (t *Tree) SetBenchFile(text string) { t.Benchmarks[len(t.Benchmarks)-1].File = text }
+
+// AddAccept adds a test of a rule matching an input.
+func (t *Tree) AddAccept(name string) { t.Tests = append(t.Tests, RuleTest{Rule: name}) }
+
+// AddReject adds a test of a rule failing to match an input.
+func (t *Tree) AddReject(name string) { t.Tests = append(t.Tests, RuleTest{Rule: name, Reject: true}) }
+
+// SetTestInput sets the input of the last test given with %accept or %reject.
+func (t *Tree) SetTestInput(text string) { t.Tests[len(t.Tests)-1].Input = text }
+
+// SetTestPosition sets the position of the syntax error expected by the last
+// test given with %reject, as line:symbol in text.
+func (t *Tree) SetTestPosition(text string) {
+	test := &t.Tests[len(t.Tests)-1]
+	line, symbol, _ := strings.Cut(text, ":")
+	test.Line, _ = strconv.Atoi(line)
+	test.Symbol, _ = strconv.Atoi(symbol)
+}
+
+// AddSample adds a sample input for the standard benchmarks.
+func (t *Tree) AddSample(text string) {
+	t.Samples = append(t.Samples, Sample{Name: fmt.Sprintf("sample%d", len(t.Samples)), Text: text})
+}
+
+// AddSampleFile adds a file holding a sample input for the standard benchmarks.
+func (t *Tree) AddSampleFile(file string) {
+	t.Samples = append(t.Samples, Sample{Name: path.Base(file), File: file})
+}
+
+// SetErrorType declares the type of the errors returned by the parser.
+func (t *Tree) SetErrorType(name string) { t.ErrorType = name }
+
+// SetErrorFields sets the fields of the error type declared with %error.
+func (t *Tree) SetErrorFields(text string) { t.ErrorFields = text }
+
+// SetStateFields sets the fields of the parser state declared with %state,
+// which backtracking restores.
+func (t *Tree) SetStateFields(text string) { t.StateFields = text }
+
+// SetCaseInsensitive makes single quoted literals case-insensitive.
+func (t *Tree) SetCaseInsensitive() { t.caseInsensitive = true }
+
+// AddCaseInsensitive makes the single quoted literals of a rule
+// case-insensitive.
+func (t *Tree) AddCaseInsensitive(name string) { t.caseRules[name] = true }
+
+func (t *Tree) AddHexaCharacter(text string) {
+	hexa, _ := strconv.ParseInt(text, 16, 32)
+	t.PushFront(&node{Type: TypeCharacter, string: string(rune(hexa)), escaped: true})
+}
+
+// AddUnicodeCharacter adds the character with the hexadecimal code point text,
+// which begins at rune offset begin of the grammar in buffer.
+func (t *Tree) AddUnicodeCharacter(buffer string, begin int, text string) {
+	code, err := strconv.ParseInt(text, 16, 32)
+	if err != nil || code > unicode.MaxRune || (code >= 0xD800 && code <= 0xDFFF) {
+		t.addError(buffer, begin, fmt.Errorf("invalid code point in escape sequence: %v", text))
+		code = unicode.ReplacementChar
+	}
+	t.PushFront(&node{Type: TypeCharacter, string: string(rune(code)), escaped: true})
+}
+
+// AddInvalidEscape records an unknown escape sequence of a backslash followed by text,
+// which begins at rune offset begin of the grammar in buffer.
+func (t *Tree) AddInvalidEscape(buffer string, begin int, text string) {
+	t.addError(buffer, begin-1, fmt.Errorf("unknown escape sequence: \\%v", text))
+	t.PushFront(&node{Type: TypeCharacter, string: text})
+}
+
+// AddProperty adds the Unicode property of the escape sequence \\p{Name}, or
+// \\pN for a one letter category, or its negation written with \\P, given as
+// text without the backslash, which begins at rune offset begin of the grammar
+// in buffer. Name is a category, a script or a property of the unicode
+// package, or the long name of a category such as Letter.
+func (t *Tree) AddProperty(buffer string, begin int, text string) {
+	name := strings.Trim(text[1:], "{}")
+	if category, ok := categoryNames[name]; ok {
+		name = category
+	}
+	if unicodeTable(name) == nil {
+		t.addError(buffer, begin-1, fmt.Errorf("unknown Unicode property: \\%v", text))
+	}
+	t.PushFront(&node{Type: TypeProperty, string: fmt.Sprintf("\\%c{%v}", text[0], name)})
+}
+
+func (t *Tree) addError(buffer string, begin int, err error) {
+	line, symbol := position(buffer, begin)
+	t.errors = append(t.errors, fmt.Errorf("%d:%d: %w", line, symbol, err))
+}
+
+// position returns the line and symbol of the rune at begin in buffer.
+func position(buffer string, begin int) (line, symbol int) {
+	line, symbol = 1, 1
+	for i, c := range []rune(buffer) {
+		if i == begin {
+			break
+		}
+		if c == '\n' {
+			line, symbol = line+1, 1
+		} else {
+			symbol++
+		}
+	}
+	return line, symbol
+}
+
+// AddActionAt adds the action text starting at begin in buffer, so that its
+// line can be referenced by a line directive.
+func (t *Tree) AddActionAt(buffer string, begin int, text string) {
+	line, _ := position(buffer, begin)
+	t.PushFront(&node{Type: TypeAction, string: text, line: line})
+}
+
+// AddHint adds the hint given with %hint as the Go string literal text, which
+// begins at rune offset begin of the grammar in buffer.
+func (t *Tree) AddHint(buffer string, begin int, text string) {
+	hint, err := strconv.Unquote(text)
+	if err != nil {
+		t.addError(buffer, begin, fmt.Errorf("invalid hint: %v", text))
+		hint = text
+	}
+	t.PushFront(&node{Type: TypeHint, string: hint})
+}
+
+func (t *Tree) AddOctalCharacter(text string) {
+	octal, _ := strconv.ParseInt(text, 8, 32)
+	t.PushFront(&node{Type: TypeCharacter, string: string(rune(octal)), escaped: true})
+}
+func (t *Tree) AddPredicate(text string)   { t.PushFront(&node{Type: TypePredicate, string: text}) }
+func (t *Tree) AddStateChange(text string) { t.PushFront(&node{Type: TypeStateChange, string: text}) }
+func (t *Tree) AddNil()                    { t.PushFront(&node{Type: TypeNil, string: "<nil>"}) }
+func (t *Tree) AddIn(text string)          { t.PushFront(&node{Type: TypeIn, string: text}) }
+func (t *Tree) AddKeyword(text string)     { t.PushFront(&node{Type: TypeKeyword, string: text}) }
+func (t *Tree) AddAction(text string)      { t.PushFront(&node{Type: TypeAction, string: text}) }
+func (t *Tree) AddPackage(text string)     { t.PushBack(&node{Type: TypePackage, string: text}) }
+func (t *Tree) AddSpace(text string)       { t.PushBack(&node{Type: TypeSpace, string: text}) }
+func (t *Tree) AddComment(text string)     { t.PushBack(&node{Type: TypeComment, string: text}) }
+func (t *Tree) AddImport(text string)      { t.PushBack(&node{Type: TypeImport, string: text}) }
+func (t *Tree) AddState(text string) {
+	peg := t.PopFront()
+	peg.PushBack(&node{Type: TypeState, string: text})
+	t.PushBack(peg)
+}
+
+func (t *Tree) addList(listType Type) {
+	a := t.PopFront()
+	b := t.PopFront()
+	var l *node
+	if b.GetType() == listType {
+		l = b
+	} else {
+		l = &node{Type: listType}
+		l.PushBack(b)
+	}
+	l.PushBack(a)
+	t.PushFront(l)
+}
+func (t *Tree) AddAlternate() { t.addList(TypeAlternate) }
+func (t *Tree) AddSequence()  { t.addList(TypeSequence) }
+func (t *Tree) AddRange()     { t.addList(TypeRange) }
+
+// AddDoubleRange adds the range matched in any case: the range in lower case,
+// the range in upper case, and the other runes the runes of the range fold to,
+// such as the Kelvin sign for a-z. Their characters are escaped if the bounds
+// are.
+func (t *Tree) AddDoubleRange() {
+	a := t.PopFront()
+	b := t.PopFront()
+	first, last := []rune(b.String())[0], []rune(a.String())[0]
+	character := func(c rune) {
+		t.AddCharacter(string(c))
+		t.Front().escaped = a.escaped && b.escaped
+	}
+
+	var ranges [][2]rune
+	for _, to := range []func(rune) rune{unicode.ToLower, unicode.ToUpper} {
+		character(to(first))
+		character(to(last))
+		t.addList(TypeRange)
+		ranges = append(ranges, [2]rune{to(first), to(last)})
+	}
+	t.AddAlternate()
+
+	others := set.NewSet()
+	for c := first; c <= last; c++ {
+	fold:
+		for _, r := range foldCase(c) {
+			for _, bounds := range ranges {
+				if r >= bounds[0] && r <= bounds[1] {
+					continue fold
+				}
+			}
+			others.Add(r)
+		}
+	}
+	for r := others.Head.Forward; r != nil && r.Forward != nil; r = r.Forward {
+		character(r.Begin)
+		if r.Begin < r.End {
+			character(r.End)
+			t.addList(TypeRange)
+		}
+		t.AddAlternate()
+	}
+}
+
+// AddRecover adds %recover(e, s), which matches e or, if e fails, records its
+// failure as a syntax error and skips the input up to s, which is left
+// unmatched. The skipped input is the text of a PegRecovered node. It fails if
+// e fails without anything to skip, so that repeating it always advances.
+func (t *Tree) AddRecover() {
+	sync := t.PopFront()
+	expression := t.PopFront()
+	list := func(listType Type, elements ...*node) *node {
+		l := &node{Type: listType}
+		for _, element := range elements {
+			l.PushBack(element)
+		}
+		return l
+	}
+	skip := list(TypeStar, list(TypeSequence, list(TypePeekNot, sync), &node{Type: TypeDot, string: "."}))
+	t.PushFront(list(TypeSequence,
+		&node{Type: TypeStateChange, string: "beginRecovery()"},
+		list(TypeAlternate,
+			list(TypeSequence, expression, &node{Type: TypeStateChange, string: "matchedRecovery()"}),
+			list(TypeSequence,
+				&node{Type: TypeStateChange, string: "failedRecovery()"},
+				list(TypeImplicitPush, skip, &node{Type: TypeRule, string: "PegRecovered"}),
+				&node{Type: TypePredicate, string: "skippedRecovery()"}))))
+}
+
+func (t *Tree) addFix(fixType Type) {
+	n := &node{Type: fixType}
+	n.PushBack(t.PopFront())
+	t.PushFront(n)
+}
+func (t *Tree) AddNotClass() { t.addFix(TypeNotClass) }
+func (t *Tree) AddPeekFor()  { t.addFix(TypePeekFor) }
+func (t *Tree) AddPeekNot()  { t.addFix(TypePeekNot) }
+func (t *Tree) AddQuery()    { t.addFix(TypeQuery) }
+func (t *Tree) AddStar()     { t.addFix(TypeStar) }
+func (t *Tree) AddPlus()     { t.addFix(TypePlus) }
+func (t *Tree) AddPush()     { t.addFix(TypePush) }
+
+func (t *Tree) AddPeg(text string) { t.PushFront(&node{Type: TypePeg, string: text}) }
+
+// maxSwitchKeys is the largest set of runes turned into a single switch case.
+const maxSwitchKeys = 1 << 16
+
+// maxCaseKeys is the largest number of keys of a switch case, above which the
+// alternative is tested on its own, as a Unicode property is, rather than by
+// listing its runes.
+const maxCaseKeys = 256
+
+// minCaseRange is the shortest run of runes written as a range in a switch
+// case, instead of as one key per rune.
+const minCaseRange = 64
+
+// maxDispatchCases is the largest number of cases of a switch on alternatives
+// whose first characters overlap, as each case copies the alternatives it
+// tries.
+const maxDispatchCases = 64
+
+// categoryNames maps the long names of the Unicode general categories to the
+// names of their tables in the unicode package.
+var categoryNames = map[string]string{
+	"Other":                 "C",
+	"Control":               "Cc",
+	"Format":                "Cf",
+	"Private_Use":           "Co",
+	"Surrogate":             "Cs",
+	"Letter":                "L",
+	"Lowercase_Letter":      "Ll",
+	"Modifier_Letter":       "Lm",
+	"Other_Letter":          "Lo",
+	"Titlecase_Letter":      "Lt",
+	"Uppercase_Letter":      "Lu",
+	"Mark":                  "M",
+	"Spacing_Mark":          "Mc",
+	"Enclosing_Mark":        "Me",
+	"Nonspacing_Mark":       "Mn",
+	"Number":                "N",
+	"Decimal_Number":        "Nd",
+	"Letter_Number":         "Nl",
+	"Other_Number":          "No",
+	"Punctuation":           "P",
+	"Connector_Punctuation": "Pc",
+	"Dash_Punctuation":      "Pd",
+	"Close_Punctuation":     "Pe",
+	"Final_Punctuation":     "Pf",
+	"Initial_Punctuation":   "Pi",
+	"Other_Punctuation":     "Po",
+	"Open_Punctuation":      "Ps",
+	"Symbol":                "S",
+	"Currency_Symbol":       "Sc",
+	"Modifier_Symbol":       "Sk",
+	"Math_Symbol":           "Sm",
+	"Other_Symbol":          "So",
+	"Separator":             "Z",
+	"Line_Separator":        "Zl",
+	"Paragraph_Separator":   "Zp",
+	"Space_Separator":       "Zs",
+}
+
+// unicodeTable returns the table of the unicode package with the name of a
+// category, a script or a property, or nil if there is none.
+func unicodeTable(name string) *unicode.RangeTable {
+	for _, tables := range []map[string]*unicode.RangeTable{unicode.Categories, unicode.Scripts, unicode.Properties} {
+		if table, ok := tables[name]; ok {
+			return table
+		}
+	}
+	return nil
+}
+
+// property returns the name of the table of the Unicode property n, and
+// whether n matches the runes which are not in the table.
+func property(n Node) (name string, negated bool) {
+	s := n.String()
+	return s[3 : len(s)-1], s[1] == 'P'
+}
+
+// hasProperty reports whether the character class n tests a Unicode property.
+func hasProperty(n Node) bool {
+	switch n.GetType() {
+	case TypeProperty:
+		return true
+	case TypeAlternate, TypeNotClass:
+		for _, element := range n.Slice() {
+			if hasProperty(element) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// classSet returns the set of runes matched by the elements of a character class.
+func classSet(n Node) *set.Set {
+	s := set.NewSet()
+	switch n.GetType() {
+	case TypeCharacter:
+		s.Add([]rune(n.String())[0])
+	case TypeRange:
+		element := n.Front()
+		s.AddRange([]rune(element.String())[0], []rune(element.Next().String())[0])
+	case TypeAlternate:
+		for _, element := range n.Slice() {
+			s = s.Union(classSet(element))
+		}
+	case TypeNotClass:
+		s = classSet(n.Front())
+		s.Add(unicode.MaxRune + 1)
+		s = s.Complement(unicode.MaxRune)
+	case TypeProperty:
+		name, negated := property(n)
+		if table := unicodeTable(name); table != nil {
+			add := func(lo, hi, stride rune) {
+				if stride == 1 {
+					s.AddRange(lo, hi)
+					return
+				}
+				for c := lo; c <= hi; c += stride {
+					s.Add(c)
+				}
+			}
+			for _, r := range table.R16 {
+				add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+			}
+			for _, r := range table.R32 {
+				add(rune(r.Lo), rune(r.Hi), rune(r.Stride))
+			}
+		}
+		if negated {
+			s.Add(unicode.MaxRune + 1)
+			s = s.Complement(unicode.MaxRune)
+		}
+	}
+	return s
+}
+
+// firstSet returns the characters that can start a match of n, if n always
+// consumes at least one character and only consists of terminals, or of
+// references to rules looked up with rule if it is not nil.
+func firstSet(n Node, rule func(name string) Node) (*set.Set, bool) {
+	terminals, ok := firstTerminals(n, rule)
+	if !ok {
+		return nil, false
+	}
+	return terminalSet(terminals), true
+}
+
+// terminalSet returns the characters that can start a match of the terminals.
+func terminalSet(terminals []Node) *set.Set {
+	s := set.NewSet()
+	for _, terminal := range terminals {
+		switch terminal.GetType() {
+		case TypeCharacter, TypeKeyword:
+			s.Add([]rune(terminal.String())[0])
+		default:
+			s = s.Union(classSet(terminal))
+		}
+	}
+	return s
+}
+
+// firstTerminals returns the terminals that can start a match of n, with the
+// conditions of firstSet. The predicates, hints and failures in front of a
+// sequence are skipped, as is the class in front of each case of an unordered
+// alternate.
+func firstTerminals(n Node, rule func(name string) Node) ([]Node, bool) {
+	visiting := make(map[string]bool)
+	var first func(n Node) ([]Node, bool)
+	first = func(n Node) ([]Node, bool) {
+		switch n.GetType() {
+		case TypeCharacter, TypeKeyword, TypeRange, TypeNotClass, TypeProperty:
+			return []Node{n}, true
+		case TypeSequence:
+			/* the predicates in front don't consume */
+			element := n.Front()
+			for element.Next() != nil {
+				switch element.GetType() {
+				case TypePeekFor, TypePeekNot, TypeHint, TypeFail:
+					element = element.Next()
+					continue
+				}
+				break
+			}
+			return first(element)
+		case TypePlus, TypePush, TypeImplicitPush:
+			return first(n.Front())
+		case TypeAlternate, TypeUnorderedAlternate:
+			var terminals []Node
+			for _, element := range n.Slice() {
+				f, ok := first(element)
+				if !ok {
+					return nil, false
+				}
+				terminals = append(terminals, f...)
+			}
+			return terminals, true
+		case TypeName:
+			if rule == nil || visiting[n.String()] {
+				return nil, false
+			}
+			r := rule(n.String())
+			if r == nil || r.Front() == nil {
+				return nil, false
+			}
+			visiting[n.String()] = true
+			defer delete(visiting, n.String())
+			return first(r.Front())
+		}
+		return nil, false
+	}
+	return first(n)
+}
+
+// checkBytes checks that the characters of the grammar fit in a byte, for a
+// parser matching bytes. The characters above \xff which case folding adds to
+// the alternatives of a character, such as the Kelvin sign of k, never match
+// and are dropped. A non-ASCII character must be escaped, as it would
+// otherwise match its Latin-1 byte rather than its UTF-8 encoding.
+func (t *Tree) checkBytes() error {
+	above := func(n *node) bool {
+		switch n.GetType() {
+		case TypeCharacter:
+			return []rune(n.String())[0] > 0xff
+		case TypeRange:
+			return []rune(n.Front().String())[0] > 0xff
+		}
+		return false
+	}
+	var check func(rule Node, n *node) error
+	check = func(rule Node, n *node) error {
+		switch n.GetType() {
+		case TypeCharacter:
+			if above(n) {
+				return fmt.Errorf("character '%v' in rule '%v' doesn't fit in a byte", escape(n.String()), rule)
+			}
+			if c := []rune(n.String())[0]; c >= utf8.RuneSelf && !n.escaped {
+				encoding := ""
+				for _, b := range []byte(n.String()) {
+					encoding += fmt.Sprintf("\\x%02x", b)
+				}
+				return fmt.Errorf("character '%v' in rule '%v' must be escaped, as '\\x%02x' for the byte or '%v' for its UTF-8 encoding", escape(n.String()), rule, c, encoding)
+			}
+		case TypeString, TypeKeyword:
+			for _, c := range n.String() {
+				if c > 0xff {
+					return fmt.Errorf("literal %v in rule '%v' doesn't fit in bytes", strconv.Quote(n.String()), rule)
+				}
+			}
+		case TypeProperty:
+			return fmt.Errorf("Unicode property %v in rule '%v' can't match bytes", n, rule)
+		case TypeAlternate:
+			elements := n.Slice()
+			if slices.ContainsFunc(elements, func(element *node) bool { return !above(element) }) {
+				n.Init()
+				for _, element := range elements {
+					element.next = nil
+					if !above(element) {
+						n.PushBack(element)
+					}
+				}
+				if n.Len() == 1 {
+					n.replace(n.Front())
+					return check(rule, n)
+				}
+			}
+		}
+		for _, element := range n.Slice() {
+			if err := check(rule, element); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, rule := range t.Slice() {
+		if rule.GetType() != TypeRule {
+			continue
+		}
+		if err := check(rule, rule); err != nil {
+			return err
+		}
+	}
+	if t.word != nil {
+		return check(&node{Type: TypeRule, string: "%word"}, t.word)
+	}
+	return nil
+}
+
+// typedRules sets TypedRules from the rules of the grammar, before the
+// optimization passes rewrite their expressions. A rule referenced twice in a
+// sequence or within * and + is a slice; the nodes of lookaheads are dropped.
+func (t *Tree) typedRules() error {
+	exported := func(name string) string {
+		return strings.ToUpper(name[:1]) + name[1:]
+	}
+	rules, types := make(map[string]bool), make(map[string]string)
+	for _, rule := range t.Slice() {
+		if rule.GetType() != TypeRule || rule.Front().GetType() == TypeNil {
+			continue
+		}
+		name := exported(rule.String())
+		if other, ok := types[name+"Node"]; ok {
+			return fmt.Errorf("rules '%v' and '%v' have the same type %vNode in the typed AST", other, rule, name)
+		}
+		rules[rule.String()], types[name+"Node"] = true, rule.String()
+	}
+	var count func(n Node) map[string]int
+	count = func(n Node) map[string]int {
+		counts := make(map[string]int)
+		switch n.GetType() {
+		case TypeName:
+			counts[n.String()] = 1
+		case TypePeekFor, TypePeekNot:
+		case TypeAlternate:
+			for _, element := range n.Slice() {
+				for name, c := range count(element) {
+					counts[name] = max(counts[name], c)
+				}
+			}
+		case TypeStar, TypePlus:
+			for name := range count(n.Front()) {
+				counts[name] = 2
+			}
+		default:
+			for _, element := range n.Slice() {
+				for name, c := range count(element) {
+					counts[name] = min(counts[name]+c, 2)
+				}
+			}
+		}
+		return counts
+	}
+	t.TypedRules = t.TypedRules[:0]
+	for _, rule := range t.Slice() {
+		if !rules[rule.String()] || rule.GetType() != TypeRule {
+			continue
+		}
+		typed := TypedRule{Rule: rule.String(), Type: exported(rule.String()) + "Node"}
+		counts, seen := count(rule.Front()), make(map[string]bool)
+		var fields func(n Node)
+		fields = func(n Node) {
+			if name := n.String(); n.GetType() == TypeName && rules[name] && counts[name] > 0 && !seen[name] {
+				seen[name] = true
+				typed.Fields = append(typed.Fields, TypedField{Rule: name, Name: exported(name), Type: exported(name) + "Node", Slice: counts[name] > 1})
+			}
+			for _, element := range n.Slice() {
+				fields(element)
+			}
+		}
+		fields(rule.Front())
+		t.TypedRules = append(t.TypedRules, typed)
+	}
+	return nil
+}
+
+// foldPredicates removes the predicates decided by the terminal following
+// them: !'a' always succeeds before 'b', and &'a' always fails before 'b',
+// so the alternatives containing it are removed as well.
+func (t *Tree) foldPredicates(log func(rule Node, format string, a ...any)) {
+	expression := func(n Node) string {
+		var b strings.Builder
+		_ = printExpression(&b, n)
+		return b.String()
+	}
+	var rule Node
+	var fold func(n *node) (fails bool)
+	fold = func(n *node) (fails bool) {
+		elements := n.Slice()
+		switch n.GetType() {
+		case TypeSequence:
+			n.Init()
+			for i, element := range elements {
+				if fold(element) {
+					fails = true
+				}
+				element.next = nil
+				if i+1 < len(elements) && (element.GetType() == TypePeekNot || element.GetType() == TypePeekFor) {
+					predicate, ok := firstSet(element.Front(), nil)
+					next, nextOK := firstSet(elements[i+1], nil)
+					if ok && nextOK && !predicate.Intersects(next) {
+						if element.GetType() == TypePeekFor {
+							log(rule, "%v before %v never matches", expression(element), expression(elements[i+1]))
+							fails = true
+						} else {
+							log(rule, "removed %v before %v", expression(element), expression(elements[i+1]))
+							continue
+						}
+					}
+				}
+				n.PushBack(element)
+			}
+			if n.Len() == 1 {
+				n.replace(n.Front())
+			}
+		case TypeAlternate:
+			n.Init()
+			var removed []*node
+			for _, element := range elements {
+				element.next = nil
+				if fold(element) {
+					removed = append(removed, element)
+					continue
+				}
+				n.PushBack(element)
+			}
+			if n.Len() == 0 {
+				last := len(removed) - 1
+				n.PushBack(removed[last])
+				removed, fails = removed[:last], true
+			}
+			for _, element := range removed {
+				log(rule, "removed alternative %v", expression(element))
+			}
+			if n.Len() == 1 {
+				n.replace(n.Front())
+			}
+		case TypePlus, TypePush:
+			fails = fold(n.Front())
+		case TypePeekFor, TypePeekNot, TypeQuery, TypeStar:
+			fold(n.Front())
+		}
+		return fails
+	}
+	for _, element := range t.Slice() {
+		if element.GetType() == TypeRule && element.Front() != nil {
+			rule = element
+			fold(element.Front())
+		}
+	}
+}
+
+// loopRightRecursion turns right recursive rules such as A <- x A / y into
+// loops such as A <- x* y, so that long inputs don't exhaust the stack. This
+// is only done if the alternatives start with distinct characters, or if y is
+// empty, as otherwise backtracking out of the recursion could match y.
+func (t *Tree) loopRightRecursion(log func(rule Node, format string, a ...any)) {
+	rules := make(map[string]Node)
+	for _, element := range t.Slice() {
+		if element.GetType() == TypeRule {
+			rules[element.String()] = element
+		}
+	}
+	lookup := func(name string) Node { return rules[name] }
+	for _, rule := range t.Slice() {
+		if rule.GetType() != TypeRule {
+			continue
+		}
+		expression := rule.Front()
+		if expression == nil || expression.GetType() != TypeAlternate {
+			continue
+		}
+		if loop := loopCommonPrefix(rule.String(), expression); loop != nil {
+			var b strings.Builder
+			_ = printExpression(&b, loop)
+			log(rule, "converted right recursion into %v", b.String())
+			rule.Init()
+			rule.PushBack(loop)
+			continue
+		}
+		recursive, other := &node{Type: TypeAlternate}, &node{Type: TypeAlternate}
+		deterministic, firsts := true, set.NewSet()
+		for _, alternative := range expression.Slice() {
+			if alternative.GetType() == TypeNil {
+				if other.Len() > 0 {
+					deterministic = false
+				}
+				other.PushBack(&node{Type: TypeNil, string: "<nil>"})
+				continue
+			}
+			first, ok := firstSet(alternative, lookup)
+			if !ok || first.Intersects(firsts) {
+				deterministic = false
+			} else {
+				firsts = firsts.Union(first)
+			}
+			last := alternative.back
+			if alternative.GetType() == TypeSequence && last.GetType() == TypeName && last.String() == rule.String() {
+				if other.Len() > 0 {
+					recursive.Init()
+					break
+				}
+				x := &node{Type: TypeSequence}
+				for _, element := range alternative.Slice()[:alternative.Len()-1] {
+					x.PushBack(deepCopy(element))
+				}
+				if x.Len() == 1 {
+					x = x.front
+				}
+				recursive.PushBack(x)
+				continue
+			}
+			other.PushBack(deepCopy(alternative))
+		}
+		if recursive.Len() == 0 || other.Len() == 0 {
+			continue
+		}
+		empty := other.Len() == 1 && other.front.GetType() == TypeNil
+		if !deterministic && !empty {
+			continue
+		}
+		if recursive.Len() == 1 {
+			recursive = recursive.front
+		}
+		loop := &node{Type: TypeStar}
+		loop.PushBack(recursive)
+		if !empty {
+			if other.Len() == 1 {
+				other = other.front
+			}
+			sequence := &node{Type: TypeSequence}
+			sequence.PushBack(loop)
+			sequence.PushBack(other)
+			loop = sequence
+		}
+		var b strings.Builder
+		_ = printExpression(&b, loop)
+		log(rule, "converted right recursion into %v", b.String())
+		rule.Init()
+		rule.PushBack(loop)
+	}
+}
+
+// leftFactor factors the common prefixes out of adjacent alternatives, such
+// as 'foo' 'bar' / 'foo' 'baz' into 'foo' 'ba' ('r' / 'z'), so that the prefix
+// is matched once instead of again after backtracking. The prefix matches the
+// same text in all the alternatives, as long as it has no predicates, state
+// changes, commits or hints, which are left alone.
+func (t *Tree) leftFactor(log func(rule Node, format string, a ...any)) {
+	expression := func(n Node) string {
+		var b strings.Builder
+		_ = printExpression(&b, n)
+		return b.String()
+	}
+	for _, element := range t.Slice() {
+		if element.GetType() == TypeRule && element.Front() != nil {
+			rule := element
+			factorAlternates(element.Front(), func(prefix Node, alternatives int) {
+				log(rule, "factored %v out of %v alternatives", expression(prefix), alternatives)
+			})
+		}
+	}
+}
+
+// factorAlternates factors the common prefixes out of the adjacent
+// alternatives of n and of its children, calling factored for each prefix.
+func factorAlternates(n *node, factored func(prefix Node, alternatives int)) {
+	var flatten func(n *node) []*node
+	flatten = func(n *node) []*node {
+		if n.GetType() != TypeSequence {
+			return []*node{n}
+		}
+		var elements []*node
+		for _, element := range n.Slice() {
+			elements = append(elements, flatten(element)...)
+		}
+		return elements
+	}
+	var factorable func(n Node) bool
+	factorable = func(n Node) bool {
+		switch n.GetType() {
+		case TypePredicate, TypeStateChange, TypeCommit, TypeHint:
+			return false
+		case TypeRule:
+			return true
+		}
+		for element := n.Front(); element != nil; element = element.Next() {
+			if !factorable(element) {
+				return false
+			}
+		}
+		return true
+	}
+	sequence := func(elements []*node) *node {
+		switch len(elements) {
+		case 0:
+			return &node{Type: TypeNil, string: "<nil>"}
+		case 1:
+			return deepCopy(elements[0])
+		}
+		n := &node{Type: TypeSequence}
+		for _, element := range elements {
+			n.PushBack(deepCopy(element))
+		}
+		return n
+	}
+
+	literal := func(n *node) string {
+		if n.GetType() == TypeAlternate {
+			n = n.Front()
+		}
+		return n.literal
+	}
+	var unmarked func(n *node) *node
+	unmarked = func(n *node) *node {
+		cp := &node{Type: n.Type, string: n.string, id: n.id, line: n.line}
+		for element := n.Front(); element != nil; element = element.Next() {
+			cp.PushBack(unmarked(element))
+		}
+		return cp
+	}
+
+	var factor func(n *node)
+	factor = func(n *node) {
+		if n.GetType() == TypeRule {
+			return
+		}
+		for _, element := range n.Slice() {
+			factor(element)
+		}
+		if n.GetType() != TypeAlternate {
+			return
+		}
+		alternatives := n.Slice()
+		var result []*node
+		for i := 0; i < len(alternatives); {
+			first := flatten(alternatives[i])
+			j, common := i+1, len(first)
+			for ; j < len(alternatives); j++ {
+				next, c := flatten(alternatives[j]), 0
+				for c < common && c < len(next) && equal(first[c], next[c]) && factorable(first[c]) {
+					c++
+				}
+				if c == 0 {
+					break
+				}
+				common = c
+			}
+			if j-i < 2 {
+				alternatives[i].next = nil
+				result = append(result, alternatives[i])
+				i++
+				continue
+			}
+			prefix := first[:common]
+			/* a prefix shared by different literals fails as the
+			   characters it matches */
+			for _, alternative := range alternatives[i+1 : j] {
+				if literal(flatten(alternative)[0]) != literal(prefix[0]) {
+					prefix = append([]*node{unmarked(prefix[0])}, prefix[1:]...)
+					break
+				}
+			}
+			rests := &node{Type: TypeAlternate}
+			for _, alternative := range alternatives[i:j] {
+				rests.PushBack(sequence(flatten(alternative)[common:]))
+			}
+			var f *node
+			if rests.front.GetType() == TypeNil {
+				/* the first alternative matches whenever the others do */
+				f = sequence(prefix)
+			} else {
+				factor(rests)
+				f = sequence(append(append([]*node(nil), prefix...), rests))
+			}
+			factored(sequence(prefix), j-i)
+			result = append(result, f)
+			i = j
+		}
+		n.Init()
+		for _, element := range result {
+			n.PushBack(element)
+		}
+		if n.Len() == 1 {
+			n.replace(n.Front())
+		}
+	}
+	factor(n)
+}
+
+// loopCommonPrefix turns the alternatives of a right recursive rule such as
+// A <- y s A / y into a loop such as y (s y)*, which matches the same because y
+// matches the same text in both alternatives.
+func loopCommonPrefix(name string, expression Node) *node {
+	alternatives := expression.Slice()
+	if len(alternatives) != 2 {
+		return nil
+	}
+	recursive, y := alternatives[0], alternatives[1]
+	if recursive.GetType() != TypeSequence || y.GetType() == TypeNil {
+		return nil
+	}
+	if last := recursive.back; last.GetType() != TypeName || last.String() != name {
+		return nil
+	}
+	prefix, ys := recursive.Slice()[:recursive.Len()-1], []*node{y}
+	if y.GetType() == TypeSequence {
+		ys = y.Slice()
+	}
+	if len(prefix) < len(ys) {
+		return nil
+	}
+	for i, element := range ys {
+		if !equal(prefix[i], element) {
+			return nil
+		}
+	}
+	iteration := &node{Type: TypeSequence}
+	for _, element := range prefix[len(ys):] {
+		iteration.PushBack(deepCopy(element))
+	}
+	iteration.PushBack(deepCopy(y))
+	if iteration.Len() == 1 {
+		iteration = iteration.front
+	}
+	loop, sequence := &node{Type: TypeStar}, &node{Type: TypeSequence}
+	loop.PushBack(iteration)
+	sequence.PushBack(deepCopy(y))
+	sequence.PushBack(loop)
+	return sequence
+}
+
+// equal reports whether the expressions a and b are the same.
+func equal(a, b Node) bool {
+	if a.GetType() != b.GetType() || a.String() != b.String() || a.Len() != b.Len() {
+		return false
+	}
+	if a.GetType() == TypeRule {
+		/* rules are compared by name, as they contain themselves once linked */
+		return true
+	}
+	for x, y := a.Front(), b.Front(); x != nil; x, y = x.Next(), y.Next() {
+		if !equal(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+// describe returns how a terminal is written in a grammar.
+func describe(n Node) string {
+	switch n.GetType() {
+	case TypeDot:
+		return "."
+	case TypeCharacter:
+		if n, ok := n.(*node); ok && n.literal != "" {
+			text := ""
+			for _, r := range n.literal {
+				text += escape(string(r))
+			}
+			return fmt.Sprintf("'%v'", text)
+		}
+		return fmt.Sprintf("'%v'", escape(n.String()))
+	case TypeString:
+		return strconv.Quote(n.String())
+	case TypeKeyword:
+		return fmt.Sprintf("'%v'", n)
+	case TypeRange:
+		element := n.Front()
+		return fmt.Sprintf("[%v-%v]", escape(element.String()), escape(element.Next().String()))
+	case TypeNotClass:
+		var class func(n Node) string
+		class = func(n Node) string {
+			switch n.GetType() {
+			case TypeCharacter:
+				return escape(n.String())
+			case TypeRange:
+				element := n.Front()
+				return fmt.Sprintf("%v-%v", escape(element.String()), escape(element.Next().String()))
+			case TypeProperty:
+				return n.String()
+			case TypeAlternate:
+				s := ""
+				for _, element := range n.Slice() {
+					s += class(element)
+				}
+				return s
+			}
+			return ""
+		}
+		return "[^" + class(n.Front()) + "]"
+	case TypeProperty:
+		return "[" + n.String() + "]"
+	}
+	return n.String()
+}
+
+// deepCopy copies a node and all of its children. A rule is shared rather
+// than copied, as the rule pushed after its expression contains the expression.
+func deepCopy(n *node) *node {
+	if n.GetType() == TypeRule {
+		return n.Copy()
+	}
+	cp := &node{Type: n.Type, string: n.string, id: n.id, line: n.line, literal: n.literal}
+	for element := n.Front(); element != nil; element = element.Next() {
+		cp.PushBack(deepCopy(element))
+	}
+	return cp
+}
+
+// classCondition returns a Go expression testing if c is matched by the elements of a character class.
+func classCondition(n Node) string {
+	switch n.GetType() {
+	case TypeCharacter:
+		return fmt.Sprintf("c == rune('%v')", escape(n.String()))
+	case TypeRange:
+		element := n.Front()
+		return fmt.Sprintf("(c >= rune('%v') && c <= rune('%v'))", escape(element.String()), escape(element.Next().String()))
+	case TypeProperty:
+		name, negated := property(n)
+		if negated {
+			return fmt.Sprintf("(c != endSymbol && !unicode.Is(unicode.%v, c))", name)
+		}
+		return fmt.Sprintf("unicode.Is(unicode.%v, c)", name)
+	case TypeAlternate:
+		conditions := []string{}
+		for _, element := range n.Slice() {
+			conditions = append(conditions, classCondition(element))
+		}
+		return strings.Join(conditions, " || ")
+	}
+	return "false"
+}
+
+func (t *Tree) requireImport(name string) {
+	for _, i := range t.Imports {
+		if i == name {
+			return
+		}
+	}
+	t.Imports = append(t.Imports, name)
+	sort.Strings(t.Imports)
+}
+
+// caseClass returns the keys of a switch case matching the runes of s, as
+// characters, and as ranges for the runs of at least minCaseRange runes.
+func caseClass(s *set.Set) *node {
+	class := &node{Type: TypeUnorderedAlternate}
+	add := func(begin, end rune) {
+		if end-begin+1 >= minCaseRange {
+			r := &node{Type: TypeRange}
+			r.PushBack(&node{Type: TypeCharacter, string: string(begin)})
+			r.PushBack(&node{Type: TypeCharacter, string: string(end)})
+			class.PushBack(r)
+			return
+		}
+		for c := begin; c <= end; c++ {
+			class.PushBack(&node{Type: TypeCharacter, string: string(c)})
+		}
+	}
+	begin, end := rune(-1), rune(-2)
+	for r := s.Head.Forward; r != nil && r.Forward != nil; r = r.Forward {
+		if r.Begin > end+1 {
+			if begin <= end {
+				add(begin, end)
+			}
+			begin = r.Begin
+		}
+		end = r.End
+	}
+	if begin <= end {
+		add(begin, end)
+	}
+	return class
+}
+
+// sortCases sorts the cases of an unordered alternate by their first
+// character, so that the order of the generated switch cases doesn't depend on
+// the order of the alternatives. The last case, which becomes the default, and
+// the cases without characters stay in place.
+func sortCases(unordered *node) {
+	cases := unordered.Slice()
+	first := func(n *node) rune {
+		class := n.Front().Front()
+		switch key := class.Front(); key.GetType() {
+		case TypeCharacter:
+			return []rune(key.String())[0]
+		case TypeRange:
+			return []rune(key.Front().String())[0]
+		}
+		return unicode.MaxRune + 1
+	}
+	sorted := cases[:len(cases)-1]
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return first(sorted[i]) < first(sorted[j])
+	})
+	unordered.Init()
+	for _, element := range cases {
+		unordered.PushBack(element)
+	}
+}
+
+func join(tasks []func()) {
+	wg := sync.WaitGroup{}
+	wg.Add(len(tasks))
+	for _, task := range tasks {
+		go func(task func()) {
+			task()
+			wg.Done()
+		}(task)
+	}
+	wg.Wait()
+}
+
+// Dump writes the grammar IR as compiled, after the -switch optimization, one
+// rule per line in the order of the rule ids. Inlined, unused and undefined
+// rules are marked by a trailing comment. It must be called after Compile.
+func (t *Tree) Dump(out io.Writer) error {
+	if t.ruleStatus == nil {
+		return errors.New("the grammar has not been compiled")
+	}
+	var buffer bytes.Buffer
+	for _, element := range t.Slice() {
+		if element.GetType() != TypeRule {
+			continue
+		}
+		_, _ = fmt.Fprintf(&buffer, "%v ", element.GetID())
+		if err := printExpression(&buffer, element); err != nil {
+			return err
+		}
+		if status := t.ruleStatus[element.String()]; status != "" {
+			_, _ = fmt.Fprintf(&buffer, " # %v", status)
+		}
+		buffer.WriteString("\n")
+	}
+	_, err := buffer.WriteTo(out)
+	return err
+}
+
+// printExpression writes the expression n in peg syntax, with '|' separating
+// unordered alternates and '<' and '>' around pushed expressions.
+func printExpression(out io.Writer, n Node) (err error) {
+	_print := func(format string, a ...any) { _, _ = fmt.Fprintf(out, format, a...) }
+	var printRule func(n Node)
+	printRule = func(n Node) {
+		switch n.GetType() {
+		case TypeRule:
+			_print("%v <- ", n)
+			printRule(n.Front())
+		case TypeDot:
+			_print(".")
+		case TypeName:
+			_print("%v", n)
+		case TypeCharacter:
+			_print("'%v'", escape(n.String()))
+		case TypeString:
+			s := escape(n.String())
+			_print("'%v'", s[1:len(s)-1])
+		case TypeRange:
+			element := n.Front()
+			lower := element
+			element = element.Next()
+			upper := element
+			_print("[%v-%v]", escape(lower.String()), escape(upper.String()))
+		case TypePredicate:
+			_print("&{%v}", n)
+		case TypeStateChange:
+			_print("!{%v}", n)
+		case TypeHint:
+			_print("%%hint %v", strconv.Quote(n.String()))
+		case TypeFail:
+			descriptions := make([]string, n.Len())
+			for i, element := range n.Slice() {
+				descriptions[i] = describe(element)
+			}
+			_print("%%fail(%v)", strings.Join(descriptions, " "))
+		case TypeNotClass, TypeProperty:
+			_print("%v", describe(n))
+		case TypeIn:
+			_print("%%in(%v)", n)
+		case TypeKeyword:
+			_print("%%keyword('%v')", n)
+		case TypeAction:
+			_print("{%v}", n)
+		case TypeCommit:
+			_print("commit")
+		case TypeAlternate:
+			_print("(")
+			elements := n.Slice()
+			printRule(elements[0])
+			for _, element := range elements[1:] {
+				_print(" / ")
+				printRule(element)
+			}
+			_print(")")
+		case TypeUnorderedAlternate:
+			_print("(")
+			elements := n.Slice()
+			printRule(elements[0])
+			for _, element := range elements[1:] {
+				_print(" | ")
+				printRule(element)
+			}
+			_print(")")
+		case TypeSequence:
+			_print("(")
+			elements := n.Slice()
+			printRule(elements[0])
+			for _, element := range elements[1:] {
+				_print(" ")
+				printRule(element)
+			}
+			_print(")")
+		case TypePeekFor:
+			_print("&")
+			printRule(n.Front())
+		case TypePeekNot:
+			_print("!")
+			printRule(n.Front())
+		case TypeQuery:
+			printRule(n.Front())
+			_print("?")
+		case TypeStar:
+			printRule(n.Front())
+			_print("*")
+		case TypePlus:
+			printRule(n.Front())
+			_print("+")
+		case TypePush, TypeImplicitPush:
+			_print("<")
+			printRule(n.Front())
+			_print(">")
+		case TypeComment:
+		case TypeNil:
+		default:
+			if err == nil {
+				err = fmt.Errorf("illegal node type: %v", n.GetType())
+			}
+		}
+	}
+	printRule(n)
+	return err
+}
+
+func escape(c string) string {
+	switch c {
+	case "'":
+		return "\\'"
+	case "\"":
+		return "\""
+	default:
+		c = strconv.Quote(c)
+		return c[1 : len(c)-1]
+	}
+}
+
+// CompileCShared writes a cgo wrapper exporting Parse and Free, so the parser
+// can be built with -buildmode=c-shared. It must be called after Compile.
+func (t *Tree) CompileCShared(out io.Writer) error {
+	if !t.Ast {
+		return errors.New("the c-shared wrapper requires the AST")
+	}
+	if t.PackageName != "main" {
+		return fmt.Errorf("the c-shared wrapper requires package main, not %v", t.PackageName)
+	}
+	return template.Must(template.New("cshared").Parse(cSharedTemplate)).Execute(out, t)
+}
+
+// CompileTokens writes the rules and the tokens and nodes of the syntax tree,
+// which Compile leaves out of the parser with SplitTokens, as a file of the
+// same package. It must be called after Compile.
+func (t *Tree) CompileTokens(out io.Writer) error {
+	var buffer bytes.Buffer
+	for _, s := range []string{pegPackageTemplate, pegTokensTemplate} {
+		if err := template.Must(template.New("tokens").Parse(s)).Execute(&buffer, t); err != nil {
+			return err
+		}
+	}
+	fileSet := token.NewFileSet()
+	code, err := parser.ParseFile(fileSet, "", buffer.Bytes(), parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("the generated code is invalid: %w", err)
+	}
+	pruneImports(code, false)
+	return format.Node(out, fileSet, code)
+}
+
+// pruneImports removes the imports code doesn't use, when the generated code
+// is split into files using only some of the imports, or leaves out the
+// printing helpers. An import is used if
+// its name, or else the last element of its path, qualifies an identifier
+// which isn't a local one.
+// With standard only the packages of the standard library are removed, as
+// the others may be named differently and used by the actions.
+func pruneImports(code *ast.File, standard bool) {
+	used := make(map[string]bool)
+	ast.Inspect(code, func(n ast.Node) bool {
+		if selector, ok := n.(*ast.SelectorExpr); ok {
+			/* the identifiers of packages are left unresolved by the parser */
+			if x, ok := selector.X.(*ast.Ident); ok && x.Obj == nil {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+	unused := func(spec *ast.ImportSpec) bool {
+		file, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return false
+		}
+		name := path.Base(file)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name == "_" || name == "." || standard && strings.Contains(strings.Split(file, "/")[0], ".") {
+			return false
+		}
+		return !used[name]
+	}
+	for _, decl := range code.Decls {
+		if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.IMPORT {
+			/* the imports kept move to the lines of the first ones, leaving
+			   no blank lines */
+			lines := make([]token.Pos, len(decl.Specs))
+			for i, spec := range decl.Specs {
+				lines[i] = spec.Pos()
+			}
+			specs := decl.Specs[:0]
+			for _, spec := range decl.Specs {
+				if spec := spec.(*ast.ImportSpec); !unused(spec) {
+					line := lines[len(specs)]
+					if spec.Name != nil {
+						spec.Name.NamePos, spec.Path.ValuePos = line, line+spec.Path.ValuePos-spec.Name.NamePos
+					} else {
+						spec.Path.ValuePos = line
+					}
+					specs = append(specs, spec)
+				}
+			}
+			decl.Specs = specs
+		}
+	}
+	imports := code.Imports[:0]
+	for _, spec := range code.Imports {
+		if !unused(spec) {
+			imports = append(imports, spec)
+		}
+	}
+	code.Imports = imports
 }
 
-func (t *Tree) AddDot() { t.PushFront(&node{Type: TypeDot, string: "."}) }
-func (t *Tree) AddCharacter(text string) {
-	t.PushFront(&node{Type: TypeCharacter, string: text})
+// CompileBenchmarks writes a Go benchmark for every rule marked with %bench,
+// and BenchmarkParse and BenchmarkReset over the inputs given with %sample.
+// It must be called after Compile.
+func (t *Tree) CompileBenchmarks(out io.Writer) error {
+	for _, benchmark := range t.Benchmarks {
+		if _, ok := t.Rules[benchmark.Rule]; !ok {
+			return fmt.Errorf("unknown rule '%v' in %%bench", benchmark.Rule)
+		}
+		if len(t.Samples) > 0 && (benchmark.Rule == "Parse" || benchmark.Rule == "Reset") {
+			return fmt.Errorf("%%bench %v conflicts with the benchmarks of %%sample", benchmark.Rule)
+		}
+	}
+	return template.Must(template.New("benchmark").Parse(benchmarkTemplate)).Execute(out, t)
 }
 
-func (t *Tree) AddDoubleCharacter(text string) {
-	t.PushFront(&node{Type: TypeCharacter, string: strings.ToLower(text)})
-	t.PushFront(&node{Type: TypeCharacter, string: strings.ToUpper(text)})
-	t.AddAlternate()
+// CompileTests writes a Go test checking that the rules match the inputs
+// given with %accept, and fail on the inputs given with %reject. It must be
+// called after Compile.
+func (t *Tree) CompileTests(out io.Writer) error {
+	for _, test := range t.Tests {
+		if _, ok := t.Rules[test.Rule]; !ok {
+			return fmt.Errorf("unknown rule '%v' in %%%v", test.Rule, test.directive())
+		}
+	}
+	return template.Must(template.New("test").Parse(testTemplate)).Execute(out, t)
 }
 
-func (t *Tree) AddHexaCharacter(text string) {
-	hexa, _ := strconv.ParseInt(text, 16, 32)
-	t.PushFront(&node{Type: TypeCharacter, string: string(rune(hexa))})
+// CompileRaceTest writes a Go test parsing the inputs given with %sample,
+// %accept and %reject with parsers running in parallel, for go test -race to
+// check that the parsers of a process share no state. It must be called after
+// Compile.
+func (t *Tree) CompileRaceTest(out io.Writer) error {
+	if len(t.Samples) == 0 && len(t.Tests) == 0 {
+		return errors.New("the race test requires inputs given with %sample, %accept or %reject")
+	}
+	for _, test := range t.Tests {
+		if _, ok := t.Rules[test.Rule]; !ok {
+			return fmt.Errorf("unknown rule '%v' in %%%v", test.Rule, test.directive())
+		}
+	}
+	return template.Must(template.New("race").Parse(raceTestTemplate)).Execute(out, t)
 }
 
-func (t *Tree) AddOctalCharacter(text string) {
-	octal, _ := strconv.ParseInt(text, 8, 8)
-	t.PushFront(&node{Type: TypeCharacter, string: string(rune(octal))})
-}
-func (t *Tree) AddPredicate(text string)   { t.PushFront(&node{Type: TypePredicate, string: text}) }
-func (t *Tree) AddStateChange(text string) { t.PushFront(&node{Type: TypeStateChange, string: text}) }
-func (t *Tree) AddNil()                    { t.PushFront(&node{Type: TypeNil, string: "<nil>"}) }
-func (t *Tree) AddAction(text string)      { t.PushFront(&node{Type: TypeAction, string: text}) }
-func (t *Tree) AddPackage(text string)     { t.PushBack(&node{Type: TypePackage, string: text}) }
-func (t *Tree) AddSpace(text string)       { t.PushBack(&node{Type: TypeSpace, string: text}) }
-func (t *Tree) AddComment(text string)     { t.PushBack(&node{Type: TypeComment, string: text}) }
-func (t *Tree) AddImport(text string)      { t.PushBack(&node{Type: TypeImport, string: text}) }
-func (t *Tree) AddState(text string) {
-	peg := t.PopFront()
-	peg.PushBack(&node{Type: TypeState, string: text})
-	t.PushBack(peg)
+// CompileServer writes an HTTP handler serving the parser, see ParseHandler
+// in the generated code. It must be called after Compile.
+func (t *Tree) CompileServer(out io.Writer) error {
+	if !t.Ast {
+		return errors.New("the parse service requires the AST")
+	}
+	return template.Must(template.New("server").Parse(serverTemplate)).Execute(out, t)
 }
 
-func (t *Tree) addList(listType Type) {
-	a := t.PopFront()
-	b := t.PopFront()
-	var l *node
-	if b.GetType() == listType {
-		l = b
-	} else {
-		l = &node{Type: listType}
-		l.PushBack(b)
+// CompileCompare writes a Go test which parses a corpus of inputs and records
+// which of them are accepted, and their syntax trees, for the compare-grammars
+// command. It must be called after Compile.
+func (t *Tree) CompileCompare(out io.Writer) error {
+	if !t.Ast {
+		return errors.New("comparing grammars requires the AST")
 	}
-	l.PushBack(a)
-	t.PushFront(l)
+	return template.Must(template.New("compare").Parse(compareTemplate)).Execute(out, t)
 }
-func (t *Tree) AddAlternate() { t.addList(TypeAlternate) }
-func (t *Tree) AddSequence()  { t.addList(TypeSequence) }
-func (t *Tree) AddRange()     { t.addList(TypeRange) }
-func (t *Tree) AddDoubleRange() {
-	a := t.PopFront()
-	b := t.PopFront()
 
-	t.AddCharacter(strings.ToLower(b.String()))
-	t.AddCharacter(strings.ToLower(a.String()))
-	t.addList(TypeRange)
-
-	t.AddCharacter(strings.ToUpper(b.String()))
-	t.AddCharacter(strings.ToUpper(a.String()))
-	t.addList(TypeRange)
-
-	t.AddAlternate()
+// CompileSubstitute writes a Go test which replaces the matches of a rule in
+// an input by a template with Substitute, for the rewrite command. It must be
+// called after Compile.
+func (t *Tree) CompileSubstitute(out io.Writer) error {
+	if !t.Ast {
+		return errors.New("substituting matches requires the AST")
+	}
+	if !t.Substitution {
+		return errors.New("substituting matches requires Substitution")
+	}
+	return template.Must(template.New("substitute").Parse(substituteTemplate)).Execute(out, t)
 }
 
-func (t *Tree) addFix(fixType Type) {
-	n := &node{Type: fixType}
-	n.PushBack(t.PopFront())
-	t.PushFront(n)
+// CompileReduce writes a Go test which shrinks an input failing to parse,
+// panicking or parsing slowly to a minimal input doing the same, for the reduce
+// command. It must be called after Compile.
+func (t *Tree) CompileReduce(out io.Writer) error {
+	return template.Must(template.New("reduce").Parse(reduceTemplate)).Execute(out, t)
 }
-func (t *Tree) AddPeekFor() { t.addFix(TypePeekFor) }
-func (t *Tree) AddPeekNot() { t.addFix(TypePeekNot) }
-func (t *Tree) AddQuery()   { t.addFix(TypeQuery) }
-func (t *Tree) AddStar()    { t.addFix(TypeStar) }
-func (t *Tree) AddPlus()    { t.addFix(TypePlus) }
-func (t *Tree) AddPush()    { t.addFix(TypePush) }
-
-func (t *Tree) AddPeg(text string) { t.PushFront(&node{Type: TypePeg, string: text}) }
 
-func join(tasks []func()) {
-	wg := sync.WaitGroup{}
-	wg.Add(len(tasks))
-	for _, task := range tasks {
-		go func(task func()) {
-			task()
-			wg.Done()
-		}(task)
+// Header returns the comments starting the generated files: the SPDX
+// identifier of License, the marker of generated code recognized by Go tools,
+// Markers for other tools and the Provenance line.
+func (t *Tree) Header() string {
+	var lines []string
+	if t.License != "" {
+		lines = append(lines, "// SPDX-License-Identifier: "+t.License)
 	}
-	wg.Wait()
-}
-
-func escape(c string) string {
-	switch c {
-	case "'":
-		return "\\'"
-	case "\"":
-		return "\""
-	default:
-		c = strconv.Quote(c)
-		return c[1 : len(c)-1]
+	lines = append(lines, "// Code generated by "+t.Generator+". DO NOT EDIT.")
+	for _, marker := range t.Markers {
+		lines = append(lines, "// "+marker)
+	}
+	if t.Provenance != "" {
+		lines = append(lines, "// "+t.Provenance)
 	}
+	return strings.Join(lines, "\n")
 }
 
 func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
+	if len(t.errors) > 0 {
+		return errors.Join(t.errors...)
+	}
+	if t.Captures && t.Ast {
+		return errors.New("recording only the captures requires disabling the AST")
+	}
+	switch t.Memo {
+	case "", "all", "marked", "none":
+	default:
+		return fmt.Errorf("unknown memoization '%v', expected all, marked or none", t.Memo)
+	}
 	t.AddImport("fmt")
-	if t.Ast {
+	if t.Ast || t.Streaming || t.Trace {
 		t.AddImport("io")
+	}
+	if t.Ast {
 		t.AddImport("os")
 		t.AddImport("bytes")
 	}
 	t.AddImport("sort")
 	t.AddImport("strconv")
+	if t.Concurrent {
+		t.AddImport("sync")
+	}
 	t.EndSymbol = 0x110000
+	t.BufferType = "string"
+	if t.Bytes {
+		t.BufferType = "[]byte"
+		if err := t.checkBytes(); err != nil {
+			return err
+		}
+	}
+	if t.Typed {
+		if !t.Ast {
+			return errors.New("generating the typed AST requires the AST")
+		}
+		if err := t.typedRules(); err != nil {
+			return err
+		}
+	}
 	t.RulesCount++
 
 	t.Generator = strings.Join(append([]string{"peg"}, args[1:]...), " ")
 
-	var werr error
-	warn := func(e error) {
-		if werr == nil {
-			werr = fmt.Errorf("warning: %w", e)
-		} else {
-			werr = fmt.Errorf("%w\nwarning: %w", werr, e)
+	var warnings []error
+	warn := func(name string, e error) {
+		if warning := t.warning(name, e); warning != nil {
+			warnings = append(warnings, warning)
 		}
 	}
 
+	optimized := func(rule Node, format string, a ...any) {
+		if t.Verbose {
+			fmt.Fprintf(os.Stderr, "rule '%v': %v\n", rule, fmt.Sprintf(format, a...))
+		}
+	}
+	if !t.DisabledPasses[PassFoldPredicates] {
+		t.foldPredicates(optimized)
+	}
+	if t.LoopRecursion && !t.DisabledPasses[PassLoopRecursion] {
+		t.loopRightRecursion(optimized)
+	}
+	if (t.inline || t._switch) && !t.DisabledPasses[PassLeftFactor] {
+		t.leftFactor(optimized)
+	}
+	t.inline = t.inline && !t.DisabledPasses[PassInline]
+	t._switch = t._switch && !t.DisabledPasses[PassSwitch]
+
 	counts := [TypeLast]uint{}
 	countsByRule := make([]*[TypeLast]uint, t.RulesCount)
 	{
@@ -907,9 +5075,13 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 					countsByRule = append(countsByRule, &[TypeLast]uint{})
 				}
 			case TypePush:
-				cp, name := rule.Copy(), "PegText"
-				cp.SetString(name)
-				if _, ok := t.Rules[name]; !ok {
+				cp := rule.Copy()
+				cp.SetString("PegText")
+				n.PushBack(cp)
+				fallthrough
+			case TypeImplicitPush:
+				/* the rules of the text of <>, and of the input skipped by %recover */
+				if name := n.Front().Next().String(); t.Rules[name] == nil {
 					emptyRule := &node{Type: TypeRule, string: name, id: t.RulesCount}
 					emptyRule.PushBack(&node{Type: TypeNil, string: "<nil>"})
 					t.PushBack(emptyRule)
@@ -919,15 +5091,17 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 					t.RuleNames = append(t.RuleNames, emptyRule)
 					countsByRule = append(countsByRule, &[TypeLast]uint{})
 				}
-				n.PushBack(cp)
-				fallthrough
-			case TypeImplicitPush:
 				link(countsForRule, n.Front())
 			case TypeRule, TypeAlternate, TypeUnorderedAlternate, TypeSequence,
 				TypePeekFor, TypePeekNot, TypeQuery, TypeStar, TypePlus:
 				for _, node := range n.Slice() {
 					link(countsForRule, node)
 				}
+			case TypeNotClass:
+				/* a negated class tests its properties like the others */
+				if hasProperty(n) {
+					countsForRule[TypeProperty]++
+				}
 			}
 		}
 		/* first pass */
@@ -935,8 +5109,11 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 			switch node.GetType() {
 			case TypePackage:
 				t.PackageName = node.String()
+				if t.Package != "" {
+					t.PackageName = t.Package
+				}
 			case TypeImport:
-				t.Imports = append(t.Imports, node.String())
+				t.requireImport(node.String())
 			case TypePeg:
 				t.StructName = node.String()
 				t.StructVariables = node.Front().String()
@@ -954,9 +5131,9 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 				}
 			}
 		}
-		/* sort imports to satisfy gofmt */
-		sort.Strings(t.Imports)
-
+		if t.PackageName == "" {
+			return errors.New("the grammar has no package declaration, which only files given to %include leave out")
+		}
 		/* second pass */
 		for _, node := range t.Slice() {
 			if node.GetType() == TypeRule {
@@ -1015,16 +5192,28 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 		func() {
 			var checkRecursion func(node Node) bool
 			ruleReached := make([]bool, t.RulesCount)
+			var stack []string
 			checkRecursion = func(node Node) bool {
 				switch node.GetType() {
 				case TypeRule:
 					id := node.GetID()
 					if ruleReached[id] {
-						warn(fmt.Errorf("possible infinite left recursion in rule '%v'", node))
+						/* the AST parser grows the rules of the cycle from a seed */
+						if !t.Ast {
+							warn(WarnLeftRecursion, fmt.Errorf("possible infinite left recursion in rule '%v'", node))
+						}
+						for i := len(stack) - 1; i >= 0; i-- {
+							t.leftRecursive[stack[i]] = true
+							if stack[i] == node.String() {
+								break
+							}
+						}
 						return false
 					}
 					ruleReached[id] = true
+					stack = append(stack, node.String())
 					consumes := checkRecursion(node.Front())
+					stack = stack[:len(stack)-1]
 					ruleReached[id] = false
 					return consumes
 				case TypeAlternate:
@@ -1044,9 +5233,9 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 					return checkRecursion(t.Rules[node.String()])
 				case TypePlus, TypePush, TypeImplicitPush:
 					return checkRecursion(node.Front())
-				case TypeCharacter, TypeString:
+				case TypeCharacter, TypeString, TypeKeyword:
 					return len(node.String()) > 0
-				case TypeDot, TypeRange:
+				case TypeDot, TypeRange, TypeNotClass, TypeProperty:
 					return true
 				}
 				return false
@@ -1061,6 +5250,208 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 
 	if t._switch {
 		var optimizeAlternates func(node Node) (consumes bool, s *set.Set)
+		lookup := func(name string) Node { return t.Rules[name] }
+		/* duplicated references to a rule keep it from being inlined into
+		   every copy */
+		var duplicate func(n Node)
+		duplicate = func(n Node) {
+			switch n.GetType() {
+			case TypeRule:
+				return
+			case TypeName:
+				if _, ok := t.rulesCount[n.String()]; ok {
+					t.rulesCount[n.String()]++
+				}
+			}
+			for _, element := range n.Slice() {
+				duplicate(element)
+			}
+		}
+		/* the default case fails for the first terminals of the other cases
+		   too, which it skips, so that the terminals expected at the failure
+		   are the same */
+		defaultFail := func(unordered *node) {
+			cases := unordered.Slice()
+			fail, seen := &node{Type: TypeFail}, make(map[string]bool)
+			for _, c := range cases[:len(cases)-1] {
+				predicate := c.Front()
+				terminals, ok := firstTerminals(predicate.Next(), lookup)
+				if !ok {
+					terminals = nil
+					for _, key := range predicate.Front().Slice() {
+						terminals = append(terminals, key)
+					}
+				}
+				for _, terminal := range terminals {
+					switch terminal.GetType() {
+					case TypeCharacter, TypeKeyword, TypeRange, TypeNotClass, TypeProperty:
+					default:
+						continue
+					}
+					if description := describe(terminal); !seen[description] {
+						seen[description] = true
+						fail.PushBack(deepCopy(terminal.(*node)))
+					}
+				}
+			}
+			if fail.Len() == 0 {
+				return
+			}
+			last := cases[len(cases)-1]
+			elements := last.Slice()
+			last.Init()
+			last.PushBack(elements[0].Copy())
+			last.PushBack(fail)
+			for _, element := range elements[1:] {
+				last.PushBack(element.Copy())
+			}
+		}
+		/* dispatch switches on the first character of alternatives whose
+		   first sets overlap: each case tries, in order, the alternatives
+		   which can start with its characters, after factoring out their
+		   common prefixes, so that keywords are matched through a trie of
+		   switches */
+		dispatch := func(n Node) bool {
+			alternatives := n.Slice()
+			last := alternatives[len(alternatives)-1]
+			fallback := last.GetType() == TypeNil
+			if fallback {
+				alternatives = alternatives[:len(alternatives)-1]
+			}
+			firsts, terminals, bounds := make([]*set.Set, len(alternatives)), make([][]Node, len(alternatives)), []rune{}
+			for i, alternative := range alternatives {
+				terminal, ok := firstTerminals(alternative, lookup)
+				if !ok {
+					return false
+				}
+				first := terminalSet(terminal)
+				firsts[i], terminals[i] = first, terminal
+				for r := first.Head.Forward; r != nil && r.Forward != nil; r = r.Forward {
+					bounds = append(bounds, r.Begin, r.End+1)
+				}
+			}
+			sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+
+			type group struct {
+				keys         *set.Set
+				alternatives []int
+			}
+			var groups []*group
+			index := make(map[string]*group)
+			for i := 0; i+1 < len(bounds); i++ {
+				begin, end := bounds[i], bounds[i+1]-1
+				if begin > end {
+					continue
+				}
+				var members []int
+				key := ""
+				for a, first := range firsts {
+					if first.Has(begin) {
+						members = append(members, a)
+						key += fmt.Sprintf("%d,", a)
+					}
+				}
+				if len(members) == 0 {
+					continue
+				}
+				if len(members) == len(alternatives) {
+					/* no alternative would be skipped */
+					return false
+				}
+				g := index[key]
+				if g == nil {
+					g = &group{keys: set.NewSet(), alternatives: members}
+					index[key] = g
+					groups = append(groups, g)
+				}
+				g.keys.AddRange(begin, end)
+			}
+			if len(groups) > maxDispatchCases {
+				return false
+			}
+			for _, g := range groups {
+				if caseClass(g.keys).Len() > maxCaseKeys {
+					return false
+				}
+			}
+
+			/* the alternatives skipped by a case still fail there, so
+			   that the terminals expected at the failure are the same */
+			skippedFail := func(members []int) *node {
+				fail, seen := &node{Type: TypeFail}, make(map[string]bool)
+				for a := range alternatives {
+					if len(members) > 0 && members[0] == a {
+						members = members[1:]
+						continue
+					}
+					for _, terminal := range terminals[a] {
+						if description := describe(terminal); !seen[description] {
+							seen[description] = true
+							fail.PushBack(deepCopy(terminal.(*node)))
+						}
+					}
+				}
+				return fail
+			}
+
+			copies := make([]int, len(alternatives))
+			unordered, largest := &node{Type: TypeUnorderedAlternate}, 0
+			for _, g := range groups {
+				var body *node
+				if a := g.alternatives[0]; len(g.alternatives) == 1 && !fallback && firsts[a].Equal(g.keys) {
+					body = alternatives[a].Copy()
+				} else {
+					/* the alternatives are copied, as each case optimizes
+					   them for its own characters */
+					body = &node{Type: TypeAlternate}
+					for _, a := range g.alternatives {
+						body.PushBack(deepCopy(alternatives[a]))
+					}
+					if fallback {
+						body.PushBack(&node{Type: TypeNil, string: "<nil>"})
+					}
+					if !t.DisabledPasses[PassLeftFactor] {
+						factorAlternates(body, func(Node, int) {})
+					}
+					optimizeAlternates(body)
+				}
+				for _, a := range g.alternatives {
+					if copies[a]++; copies[a] > 1 {
+						duplicate(alternatives[a])
+					}
+				}
+
+				sequence, predicate := &node{Type: TypeSequence}, &node{Type: TypePeekFor}
+				predicate.PushBack(caseClass(g.keys))
+				sequence.PushBack(predicate)
+				if skipped := skippedFail(g.alternatives); skipped.Len() > 0 {
+					sequence.PushBack(skipped)
+				}
+				sequence.PushBack(body)
+				if length := g.keys.Len(); !fallback && length > largest {
+					unordered.PushBack(sequence)
+					largest = length
+				} else {
+					unordered.PushFront(sequence)
+				}
+			}
+			if fallback {
+				sequence, predicate, class := &node{Type: TypeSequence}, &node{Type: TypePeekFor}, &node{Type: TypeUnorderedAlternate}
+				class.PushBack(&node{Type: TypeNil, string: "<nil>"})
+				predicate.PushBack(class)
+				sequence.PushBack(predicate)
+				sequence.PushBack(skippedFail(nil))
+				sequence.PushBack(&node{Type: TypeNil, string: "<nil>"})
+				unordered.PushBack(sequence)
+			}
+			sortCases(unordered)
+			n.Init()
+			n.SetType(TypeUnorderedAlternate)
+			for _, element := range unordered.Slice() {
+				n.PushBack(element.Copy())
+			}
+			return true
+		}
 		cache, firstPass := make([]struct {
 			reached, consumes bool
 			s                 *set.Set
@@ -1089,7 +5480,7 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 				/* TypeDot set doesn't include the EndSymbol */
 				s.Add(t.EndSymbol)
 				s = s.Complement(t.EndSymbol - 1)
-			case TypeString, TypeCharacter:
+			case TypeString, TypeCharacter, TypeKeyword:
 				consumes = true
 				s.Add([]rune(n.String())[0])
 			case TypeRange:
@@ -1099,6 +5490,14 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 				element = element.Next()
 				upper := []rune(element.String())[0]
 				s.AddRange(lower, upper)
+			case TypeNotClass:
+				consumes = true
+				s = classSet(n.Front())
+				s.Add(t.EndSymbol)
+				s = s.Complement(t.EndSymbol - 1)
+			case TypeProperty:
+				consumes = true
+				s = classSet(n)
 			case TypeAlternate:
 				consumes = true
 				properties, c := make([]struct {
@@ -1118,9 +5517,32 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 					break
 				}
 
+				/* an alternative matching without a first character, such
+				as !., can be neither a case nor the default */
+				empty := false
+				for i, element := range n.Slice() {
+					if properties[i].s.Len() == 0 && element.GetType() != TypeNil {
+						empty = true
+					}
+				}
+				if empty {
+					break
+				}
+
 				intersections := 2
+				for i := range properties {
+					/* too many keys for a switch, e.g. a negated class or a
+					   Unicode property */
+					if properties[i].s.Len() > maxSwitchKeys || caseClass(properties[i].s).Len() > maxCaseKeys {
+						properties[i].intersects = true
+						intersections++
+					}
+				}
 			compare:
 				for ai, a := range properties[0 : len(properties)-1] {
+					if a.intersects {
+						continue
+					}
 					for _, b := range properties[ai+1:] {
 						if a.s.Intersects(b.s) {
 							intersections++
@@ -1129,6 +5551,9 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 						}
 					}
 				}
+				if intersections > 2 && dispatch(n) {
+					break
+				}
 				if intersections >= len(properties) {
 					break
 				}
@@ -1138,12 +5563,7 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 					if properties[c].intersects {
 						ordered.PushBack(element.Copy())
 					} else {
-						class := &node{Type: TypeUnorderedAlternate}
-						for d := 0; d < unicode.MaxRune; d++ {
-							if properties[c].s.Has(rune(d)) {
-								class.PushBack(&node{Type: TypeCharacter, string: string(rune(d))})
-							}
-						}
+						class := caseClass(properties[c].s)
 
 						sequence, predicate, length := &node{Type: TypeSequence}, &node{Type: TypePeekFor}, properties[c].s.Len()
 						if length == 0 {
@@ -1164,6 +5584,8 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 					}
 					c++
 				}
+				sortCases(unordered)
+				defaultFail(unordered)
 				n.Init()
 				if ordered.Front() == nil {
 					n.SetType(TypeUnorderedAlternate)
@@ -1204,7 +5626,7 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 				_, s = optimizeAlternates(n.Front())
 			case TypePlus, TypePush, TypeImplicitPush:
 				consumes, s = optimizeAlternates(n.Front())
-			case TypeAction, TypeNil:
+			case TypeAction, TypeHint, TypeNil:
 				// empty
 			}
 			return
@@ -1228,46 +5650,186 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 		}
 	}
 
+	// restore is the line directive which returns the code after an action
+	// to the generated file, numbered once the code is formatted.
+	restore := fmt.Sprintf("//line %v:1:1", filepath.Base(file))
 	var buffer bytes.Buffer
 	defer func() {
-		if t.Strict && werr != nil && err == nil {
-			// Treat warnings as errors.
-			err = werr
+		var failures []error
+		for _, warning := range warnings {
+			if t.Fails(warning) {
+				failures = append(failures, warning)
+			} else if t.Report != nil {
+				t.Report(warning)
+			} else if !t.Quiet {
+				fmt.Fprintln(os.Stderr, warning)
+			}
 		}
-		if !t.Strict && werr != nil {
-			// Display warnings.
-			fmt.Fprintln(os.Stderr, werr)
+		if err == nil && len(failures) > 0 {
+			// Treat warnings as errors.
+			err = errors.Join(failures...)
 		}
 		if err != nil {
 			return
 		}
 		fileSet := token.NewFileSet()
-		code, err := parser.ParseFile(fileSet, file, &buffer, parser.ParseComments)
-		if err != nil {
+		code, perr := parser.ParseFile(fileSet, file, buffer.Bytes(), parser.ParseComments)
+		if perr != nil {
+			// Write the code anyway, for the positions of the error.
 			_, _ = buffer.WriteTo(out)
+			err = fmt.Errorf("the generated code is invalid: %w", perr)
 			return
 		}
-		formatter := printer.Config{Mode: printer.TabIndent | printer.UseSpaces, Tabwidth: 8}
-		err = formatter.Fprint(out, fileSet, code)
-		if err != nil {
-			_, _ = buffer.WriteTo(out)
-			return
+		if t.SplitTokens || t.NoPrint {
+			pruneImports(code, true)
+		}
+		for _, rewrite := range t.Rewrites {
+			if err = rewrite(fileSet, code); err != nil {
+				return
+			}
+		}
+		if t.LineFile == "" {
+			err = format.Node(out, fileSet, code)
+			return
+		}
+		var formatted bytes.Buffer
+		if err = format.Node(&formatted, fileSet, code); err != nil {
+			return
+		}
+		lines := strings.SplitAfter(formatted.String(), "\n")
+		for i, line := range lines {
+			if strings.TrimSuffix(line, "\n") == restore {
+				lines[i] = fmt.Sprintf("//line %v:%d:1\n", filepath.Base(file), i+2)
+			}
+		}
+		_, err = io.WriteString(out, strings.Join(lines, ""))
+	}()
+
+	_print := func(format string, a ...any) { _, _ = fmt.Fprintf(&buffer, format, a...) }
+	/* the state declared with %state is saved and restored along with the position */
+	printSaveState := func(n uint) {
+		if t.StateFields != "" {
+			_print("\n   state%d := p.pegState", n)
+		}
+	}
+	/* with -iterative the rules are compiled into one function, which
+	   declares their variables up front: declared holds them, locals those
+	   in scope in the rule compiled, which its calls of rules save on the
+	   stack of the parser, and scopes the lengths of locals at the blocks
+	   opened */
+	var declared, locals []string
+	var scopes []int
+	local := func(names ...string) {
+		declared, locals = append(declared, names...), append(locals, names...)
+	}
+	printSave := func(n uint) {
+		if t.Iterative {
+			local(fmt.Sprintf("position%d", n), fmt.Sprintf("tokenIndex%d", n))
+			_print("\n   position%d, tokenIndex%d = position, tokenIndex", n, n)
+		} else {
+			_print("\n   position%d, tokenIndex%d := position, tokenIndex", n, n)
+		}
+		printSaveState(n)
+	}
+	printRestore := func(n uint) {
+		/* the runes read before backtracking bound the memoized matches Edit reuses */
+		if t.Incremental {
+			_print("\n   if position >= reach {\n    reach = position + 1\n   }")
+		}
+		_print("\n   position, tokenIndex = position%d, tokenIndex%d", n, n)
+		if t.StateFields != "" {
+			_print("\n   p.pegState = state%d", n)
+		}
+	}
+	// changesState holds the rules which change the %state, themselves or
+	// through the rules they refer to, grown until no rule is added.
+	changesState := make(map[string]bool)
+	if t.StateFields != "" {
+		var changes func(node Node) bool
+		changes = func(node Node) bool {
+			switch node.GetType() {
+			case TypeStateChange:
+				return true
+			case TypeName:
+				return changesState[node.String()]
+			case TypeImplicitPush, TypePush:
+				return changes(node.Front())
+			case TypeAlternate, TypeUnorderedAlternate, TypeSequence,
+				TypePeekFor, TypePeekNot, TypeQuery, TypeStar, TypePlus:
+				for _, element := range node.Slice() {
+					if changes(element) {
+						return true
+					}
+				}
+			}
+			return false
+		}
+		for grown := true; grown; {
+			grown = false
+			for _, rule := range t.RuleNames {
+				if name := rule.String(); !changesState[name] && changes(rule.Front()) {
+					changesState[name], grown = true, true
+				}
+			}
+		}
+	}
+	marked := t.Memo == "marked" || t.Memo == "" && len(t.memo) > 0
+	memoizes := func(rule Node, ret bool) bool {
+		if t.Memo == "none" || marked && !t.memo[rule.String()] {
+			return false
+		}
+		if ret {
+			/* a reused match would skip the state changes of the rule */
+			if changesState[rule.String()] {
+				return false
+			}
+			return !t.NoMemoSuccesses && !t.noMemo["successes"][rule.String()]
+		}
+		return !t.NoMemoFailures && !t.noMemo["failures"][rule.String()]
+	}
+	// memoState is the state fingerprint argument of the memoization of a
+	// rule, if any rule has a %memokey.
+	memoState := func(rule Node) string {
+		switch {
+		case !t.HasMemoKey:
+			return ""
+		case t.memoKeys[rule.String()] != "":
+			return ", memoState"
+		}
+		return ", 0"
+	}
+	printMemoSave := func(rule Node, n uint, ret bool) {
+		if memoizes(rule, ret) {
+			_print("\n   memoize(%d, position%d, tokenIndex%d, %t%v)", rule.GetID(), n, n, ret, memoState(rule))
+		}
+	}
+	printMemoCheck := func(rule Node) {
+		if key := t.memoKeys[rule.String()]; key != "" {
+			_print("\n   memoState := uint64(%v)", key)
+		}
+		if t.CompactMemo {
+			_print("\n   if memoized, ok := lookupMemo(%d%v); ok {", rule.GetID(), memoState(rule))
+		} else {
+			_print("\n   memoized, ok := memoization[memoKey{%d, position%v}]", rule.GetID(), memoState(rule))
+			if t.Incremental {
+				_print("\n   if !ok && edited != nil {")
+				_print("\n       memoized, ok = reuse(memoKey{%d, position%v})", rule.GetID(), memoState(rule))
+				_print("\n   }")
+			}
+			_print("\n   if ok {")
+		}
+		if t.Iterative {
+			_print("\n       matched = memoizedResult(memoized)")
+			_print("\n       goto ret")
+		} else {
+			_print("\n       return memoizedResult(memoized)")
 		}
-	}()
-
-	_print := func(format string, a ...any) { _, _ = fmt.Fprintf(&buffer, format, a...) }
-	printSave := func(n uint) { _print("\n   position%d, tokenIndex%d := position, tokenIndex", n, n) }
-	printRestore := func(n uint) { _print("\n   position, tokenIndex = position%d, tokenIndex%d", n, n) }
-	printMemoSave := func(rule int, n uint, ret bool) {
-		_print("\n   memoize(%d, position%d, tokenIndex%d, %t)", rule, n, n, ret)
-	}
-	printMemoCheck := func(rule int) {
-		_print("\n   if memoized, ok := memoization[memoKey{%d, position}]; ok {", rule)
-		_print("\n       return memoizedResult(memoized)")
 		_print("\n   }")
 	}
 	printTemplate := func(s string) error {
-		return template.Must(template.New("peg").Parse(s)).Execute(&buffer, t)
+		return template.Must(template.New("peg").Funcs(template.FuncMap{
+			"restore": func() string { return restore },
+		}).Parse(s)).Execute(&buffer, t)
 	}
 
 	t.HasActions = usage[TypeAction] > 0
@@ -1277,13 +5839,164 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 	t.HasCharacter = usage[TypeCharacter] > 0
 	t.HasString = usage[TypeString] > 0
 	t.HasRange = usage[TypeRange] > 0
+	t.HasKeyword = usage[TypeKeyword] > 0
+	t.HasHint = usage[TypeHint] > 0
+	if usage[TypeProperty] > 0 {
+		t.requireImport("unicode")
+	}
+	_, t.HasRecover = t.Rules["PegRecovered"]
+	if t.HasRecover && !t.Ast {
+		return errors.New("%recover requires the AST")
+	}
+	t.Expected = t.Expected || t.HasHint || t.HasRecover || t.Tolerant || t.Streaming
+	if t.Incremental && !t.Ast {
+		return errors.New("-incremental requires the AST")
+	}
+	if t.Substitution && !t.Ast {
+		return errors.New("-substitution requires the AST")
+	}
+	t.HasLeftRecursion = t.Ast && len(t.leftRecursive) > 0
+	for kind, rules := range t.noMemo {
+		for name := range rules {
+			if _, ok := t.Rules[name]; !ok {
+				warn(WarnNoMemo, fmt.Errorf("unknown rule '%v' in %%nomemo %v", name, kind))
+			}
+		}
+	}
+	for name := range t.memo {
+		if _, ok := t.Rules[name]; !ok {
+			warn(WarnNoMemo, fmt.Errorf("unknown rule '%v' in %%memo", name))
+		}
+	}
+	for name := range t.memoKeys {
+		if _, ok := t.Rules[name]; !ok {
+			warn(WarnNoMemo, fmt.Errorf("unknown rule '%v' in %%memokey", name))
+			continue
+		}
+		t.HasMemoKey = true
+	}
+	for name := range t.caseRules {
+		if _, ok := t.Rules[name]; !ok {
+			warn(WarnUndefined, fmt.Errorf("unknown rule '%v' in %%caseinsensitive", name))
+		}
+	}
+	kinds := t.Kinds[:0:0]
+	for _, kind := range t.Kinds {
+		if _, ok := t.Rules[kind.Rule]; !ok {
+			warn(WarnUndefined, fmt.Errorf("unknown rule '%v' in %%map", kind.Rule))
+			continue
+		}
+		kinds = append(kinds, kind)
+	}
+	t.Kinds = kinds
+	t.RecoveryRules = t.RecoveryRules[:0]
+	for name := range t.recovery {
+		if _, ok := t.Rules[name]; !ok {
+			warn(WarnUndefined, fmt.Errorf("unknown rule '%v' in %%recovery", name))
+			continue
+		}
+		t.RecoveryRules = append(t.RecoveryRules, name)
+	}
+	sort.Strings(t.RecoveryRules)
+	t.Builds = t.Builds[:0]
+	for _, element := range t.Slice() {
+		expression, ok := t.builds[element.String()]
+		if !ok || element.GetType() != TypeRule {
+			continue
+		}
+		expression = buildReferences.ReplaceAllStringFunc(expression, func(reference string) string {
+			if reference == "$0" {
+				return "text"
+			}
+			return fmt.Sprintf("child(%v)", reference[1:])
+		})
+		t.Builds = append(t.Builds, RuleBuild{Rule: element.String(), Expression: expression})
+	}
+	if len(t.Builds) > 0 && !t.Ast {
+		return errors.New("building values with -> requires the AST")
+	}
+	if t.Iterative {
+		for _, feature := range []struct {
+			name string
+			used bool
+		}{
+			{"left recursion", len(t.leftRecursive) > 0},
+			{"%recover", t.HasRecover},
+			{"%hint", t.HasHint},
+			{"%state", t.StateFields != ""},
+			{"%memokey", t.HasMemoKey},
+			{"-trace", t.Trace},
+			{"-watchdog", t.Watchdog},
+		} {
+			if feature.used {
+				return fmt.Errorf("-iterative doesn't support %v", feature.name)
+			}
+		}
+	}
+	if t.Watchdog || t.Trace {
+		t.requireImport("time")
+	}
+	if t.Trace {
+		t.requireImport("encoding/json")
+	}
+	if !t.Bytes {
+		t.requireImport("unicode/utf8")
+	}
+	if t.HasKeyword {
+		switch {
+		case t.word == nil:
+			t.WordCondition = "c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c)"
+			t.requireImport("unicode")
+		case t.word.GetType() == TypeNotClass:
+			t.WordCondition = fmt.Sprintf("!(c == endSymbol || %v)", classCondition(t.word.Front()))
+		default:
+			t.WordCondition = classCondition(t.word)
+		}
+		if t.word != nil && hasProperty(t.word) {
+			t.requireImport("unicode")
+		}
+	}
+
+	inlined := func(name string) bool {
+		return t.inline && t.rulesCount[name] == 1 && !t.leftRecursive[name]
+	}
 
 	var printRule func(n Node)
 	var compile func(expression Node, ko uint) (labelLast bool)
+	var current string // the rule whose expression is compiled, for -captures
+	/* the variable holding the begin position of the current rule, which %in
+	   tests the text of, named after the push of the rule so that inlined
+	   rules don't share it */
+	var begin string
 	var label uint
 	labels := make(map[uint]bool)
-	printBegin := func() { _print("\n   {") }
-	printEnd := func() { _print("\n   }") }
+	printBegin := func() {
+		if t.Iterative {
+			scopes = append(scopes, len(locals))
+			return
+		}
+		_print("\n   {")
+	}
+	printEnd := func() {
+		if t.Iterative {
+			locals, scopes = locals[:scopes[len(scopes)-1]], scopes[:len(scopes)-1]
+			return
+		}
+		_print("\n   }")
+	}
+	/* calls counts the calls of rules with -iterative, each returning to a
+	   label of its own */
+	calls := 0
+	/* defined tells whether the rule has a body, which the calls with
+	   -iterative jump to */
+	defined := func(name string) bool {
+		rule, ok := t.Rules[name]
+		if !ok {
+			return false
+		}
+		expression := rule.Front()
+		return expression.GetType() != TypeNil && expression.Front().GetType() != TypeNil
+	}
 	printLabel := func(n uint) bool {
 		_print("\n")
 		if labels[n] {
@@ -1292,115 +6005,75 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 		}
 		return false
 	}
+	printFail := func(n Node) {
+		if !t.Expected {
+			return
+		}
+		_print("\n   fail(%v)", strconv.Quote(describe(n)))
+	}
 	printJump := func(n uint) {
 		_print("\n   goto l%d", n)
 		labels[n] = true
 	}
 	printRule = func(n Node) {
-		switch n.GetType() {
-		case TypeRule:
-			_print("%v <- ", n)
-			printRule(n.Front())
-		case TypeDot:
-			_print(".")
-		case TypeName:
-			_print("%v", n)
-		case TypeCharacter:
-			_print("'%v'", escape(n.String()))
-		case TypeString:
-			s := escape(n.String())
-			_print("'%v'", s[1:len(s)-1])
-		case TypeRange:
-			element := n.Front()
-			lower := element
-			element = element.Next()
-			upper := element
-			_print("[%v-%v]", escape(lower.String()), escape(upper.String()))
-		case TypePredicate:
-			_print("&{%v}", n)
-		case TypeStateChange:
-			_print("!{%v}", n)
-		case TypeAction:
-			_print("{%v}", n)
-		case TypeCommit:
-			_print("commit")
-		case TypeAlternate:
-			_print("(")
-			elements := n.Slice()
-			printRule(elements[0])
-			for _, element := range elements[1:] {
-				_print(" / ")
-				printRule(element)
-			}
-			_print(")")
-		case TypeUnorderedAlternate:
-			_print("(")
-			elements := n.Slice()
-			printRule(elements[0])
-			for _, element := range elements[1:] {
-				_print(" | ")
-				printRule(element)
-			}
-			_print(")")
-		case TypeSequence:
-			_print("(")
-			elements := n.Slice()
-			printRule(elements[0])
-			for _, element := range elements[1:] {
-				_print(" ")
-				printRule(element)
-			}
-			_print(")")
-		case TypePeekFor:
-			_print("&")
-			printRule(n.Front())
-		case TypePeekNot:
-			_print("!")
-			printRule(n.Front())
-		case TypeQuery:
-			printRule(n.Front())
-			_print("?")
-		case TypeStar:
-			printRule(n.Front())
-			_print("*")
-		case TypePlus:
-			printRule(n.Front())
-			_print("+")
-		case TypePush, TypeImplicitPush:
-			_print("<")
-			printRule(n.Front())
-			_print(">")
-		case TypeComment:
-		case TypeNil:
-		default:
-			warn(fmt.Errorf("illegal node type: %v", n.GetType()))
+		if err := printExpression(&buffer, n); err != nil {
+			warn(WarnInternal, err)
 		}
 	}
 	dryCompile := true
+	/* the symbol at the position, read past the end of bytes as endSymbol */
+	symbol := "buffer[position]"
+	if t.Bytes {
+		symbol = "buffer.at(position)"
+	}
 
 	compile = func(n Node, ko uint) (labelLast bool) {
 		switch n.GetType() {
 		case TypeRule:
-			warn(fmt.Errorf("internal error #1 (%v)", n))
+			warn(WarnInternal, fmt.Errorf("internal error #1 (%v)", n))
 		case TypeDot:
 			if n.ParentDetect() {
 				break
 			}
 			_print("\n   if !matchDot() {")
 			/*print("\n   if buffer[position] == endSymbol {")*/
+			printFail(n)
 			printJump(ko)
 			/*print("}\nposition++")*/
 			_print("}")
 		case TypeName:
 			name := n.String()
 			rule := t.Rules[name]
-			if t.inline && t.rulesCount[name] == 1 {
+			if inlined(name) {
 				element := rule.Front()
 				element.SetParentDetect(n.ParentDetect())
 				element.SetParentMultipleKey(n.ParentMultipleKey())
+				caller := current
+				current = name
 				compile(element, ko)
+				current = caller
 				return
 			}
+			if t.Iterative && defined(name) {
+				/* save the variables in scope with the label to return to,
+				   and jump to the rule, which jumps back with matched */
+				calls++
+				_print("\n   frames = append(frames, %v)", strings.Join(append(locals[:len(locals):len(locals)], strconv.Itoa(calls)), ", "))
+				_print("\n   goto r%d", rule.GetID())
+				_print("\n   c%d:", calls)
+				if len(locals) > 0 {
+					saved := make([]string, len(locals))
+					for i := range locals {
+						saved[i] = fmt.Sprintf("frames[len(frames)-%d]", len(locals)-i)
+					}
+					_print("\n   %v = %v", strings.Join(locals, ", "), strings.Join(saved, ", "))
+					_print("\n   frames = frames[:len(frames)-%d]", len(locals))
+				}
+				_print("\n   if !matched {")
+				printJump(ko)
+				_print("}")
+				break
+			}
 			_print("\n   if !_rules[rule%v]() {", name /*rule.GetID()*/)
 			printJump(ko)
 			_print("}")
@@ -1414,7 +6087,8 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 			element = element.Next()
 			upper := element
 			/*print("\n   if !matchRange('%v', '%v') {", escape(lower.String()), escape(upper.String()))*/
-			_print("\n   if c := buffer[position]; c < rune('%v') || c > rune('%v') {", escape(lower.String()), escape(upper.String()))
+			_print("\n   if c := %v; c < rune('%v') || c > rune('%v') {", symbol, escape(lower.String()), escape(upper.String()))
+			printFail(n)
 			printJump(ko)
 			_print("}\nposition++")
 		case TypeCharacter:
@@ -1423,11 +6097,13 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 				break
 			}
 			/*print("\n   if !matchChar('%v') {", escape(n.String()))*/
-			_print("\n   if buffer[position] != rune('%v') {", escape(n.String()))
+			_print("\n   if %v != rune('%v') {", symbol, escape(n.String()))
+			printFail(n)
 			printJump(ko)
 			_print("}\nposition++")
 		case TypeString:
 			_print("\n   if !matchString(%v) {", strconv.Quote(n.String()))
+			printFail(n)
 			printJump(ko)
 			_print("}")
 		case TypePredicate:
@@ -1435,7 +6111,39 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 			printJump(ko)
 			_print("}")
 		case TypeStateChange:
+			if t.Iterative {
+				/* the declarations of the code stay in its block */
+				_print("\n   {\n   %v\n   }", n)
+				break
+			}
 			_print("\n   %v", n)
+		case TypeNotClass:
+			if n.ParentDetect() && !n.ParentMultipleKey() {
+				_print("\nposition++")
+				break
+			}
+			_print("\n   if c := %v; c == endSymbol || %v {", symbol, classCondition(n.Front()))
+			printFail(n)
+			printJump(ko)
+			_print("}\nposition++")
+		case TypeProperty:
+			if n.ParentDetect() && !n.ParentMultipleKey() {
+				_print("\nposition++")
+				break
+			}
+			_print("\n   if c := %v; !(%v) {", symbol, classCondition(n))
+			printFail(n)
+			printJump(ko)
+			_print("}\nposition++")
+		case TypeKeyword:
+			_print("\n   if !matchKeyword(%v) {", strconv.Quote(n.String()))
+			printFail(n)
+			printJump(ko)
+			_print("}")
+		case TypeIn:
+			_print("\n   if _, ok := (%v)[string(buffer[%v:position])]; !ok {", n, begin)
+			printJump(ko)
+			_print("}")
 		case TypeAction:
 		case TypeCommit:
 		case TypePush:
@@ -1452,17 +6160,53 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 					_print("\nadd(rule%v, position)", rule)
 				} else {
 					// There is no AST support, so inline the rule code
+					if t.Iterative {
+						_print("\n{")
+					}
+					line := element.Line()
+					if t.LineFile != "" && line > 0 {
+						_print("\n//line %v:%d", t.LineFile, line)
+					}
 					_print("\n%v", element)
+					if t.LineFile != "" && line > 0 {
+						_print("\n%v", restore)
+					}
+					if t.Iterative {
+						_print("\n}")
+					}
 				}
 			} else {
-				_print("\nposition%d := position", ok)
+				if t.Iterative {
+					local(fmt.Sprintf("position%d", ok))
+					_print("\nposition%d = position", ok)
+				} else {
+					_print("\nposition%d := position", ok)
+				}
+				caller := begin
+				if rule.String() == current {
+					begin = fmt.Sprintf("position%d", ok)
+				}
 				compile(element, ko)
+				begin = caller
 				if n.GetType() == TypePush && !t.Ast {
 					// This is TypePush and there is no AST support,
 					// so inline capture to text right here
+					if t.Iterative {
+						_print("\n{")
+					}
 					_print("\nbegin := position%d", ok)
 					_print("\nend := position")
-					_print("\ntext = string(buffer[begin:end])")
+					if t.Bytes {
+						_print("\ntext = buffer[begin:end]")
+					} else {
+						_print("\ntext = string(buffer[begin:end])")
+					}
+					if t.Captures {
+						_print("\ncapture(rule%v, begin)", current)
+					}
+					if t.Iterative {
+						_print("\n}")
+					}
 				} else {
 					_print("\nadd(rule%v, position%d)", rule, ok)
 				}
@@ -1491,47 +6235,129 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 			done, ok := ko, label
 			label++
 			printBegin()
-			_print("\n   switch buffer[position] {")
 			elements := n.Slice()
 			elements, last := elements[:len(elements)-1], elements[len(elements)-1].Front().Next()
+			/* the failures of the alternatives a case skips come first */
+			skipped := func(element *node) *node {
+				if element.GetType() == TypeFail {
+					compile(element, ko)
+					element = element.Next()
+				}
+				return element
+			}
+			/* a switch with ranges of keys tests them as conditions */
+			ranges := false
 			for _, element := range elements {
+				for _, key := range element.Front().Front().Slice() {
+					ranges = ranges || key.GetType() == TypeRange
+				}
+			}
+			/* with -iterative the cases jump to their bodies after the
+			   switch, so that the calls of rules in them can jump back */
+			cases := make([]uint, len(elements)+1)
+			if t.Iterative {
+				for i := range cases {
+					cases[i] = label
+					label++
+				}
+			}
+			body := func(element *node) {
 				sequence := element.Front()
 				class := sequence.Front()
 				sequence = sequence.Next()
+				sequence = skipped(sequence)
+				if !dryCompile && sequence.GetType() != TypeAlternate {
+					sequence.SetParentDetect(true)
+					if len(class.Slice()) > 1 {
+						sequence.SetParentMultipleKey(true)
+					}
+				}
+				if t.Iterative {
+					compile(sequence, done)
+					printJump(ok)
+				} else if compile(sequence, done) {
+					_print("\nbreak")
+				}
+			}
+			if ranges {
+				_print("\n   switch c := %v; {", symbol)
+			} else {
+				_print("\n   switch %v {", symbol)
+			}
+			for i, element := range elements {
+				class := element.Front().Front()
 				_print("\n   case")
 				comma := false
-				for _, character := range class.Slice() {
+				for _, key := range class.Slice() {
 					if comma {
 						_print(",")
 					} else {
 						comma = true
 					}
-					_print(" '%s'", escape(character.String()))
-				}
-				_print(":")
-				if !dryCompile {
-					sequence.SetParentDetect(true)
-					if len(class.Slice()) > 1 {
-						sequence.SetParentMultipleKey(true)
+					if ranges {
+						_print(" %v", classCondition(key))
+					} else {
+						_print(" '%s'", escape(key.String()))
 					}
 				}
-				if compile(sequence, done) {
-					_print("\nbreak")
+				_print(":")
+				if t.Iterative {
+					printJump(cases[i])
+				} else {
+					body(element)
 				}
 			}
 			_print("\n   default:")
-			if compile(last, done) {
+			if t.Iterative {
+				printJump(cases[len(elements)])
+			} else if compile(skipped(last), done) {
 				_print("\nbreak")
 			}
 			_print("\n   }")
+			if t.Iterative {
+				for i, element := range elements {
+					printLabel(cases[i])
+					body(element)
+				}
+				printLabel(cases[len(elements)])
+				compile(skipped(last), done)
+			}
 			printEnd()
 			labelLast = printLabel(ok)
 		case TypeSequence:
 			elements := n.Slice()
-			elements[0].SetParentDetect(n.ParentDetect())
-			elements[0].SetParentMultipleKey(n.ParentMultipleKey())
-			for _, element := range elements {
-				labelLast = compile(element, ko)
+			first := elements[0]
+			if first.GetType() == TypeHint && len(elements) > 1 {
+				first = elements[1]
+			}
+			/* the character tested by a switch doesn't match a negative
+			   predicate in front of the sequence */
+			if first.GetType() != TypePeekNot {
+				first.SetParentDetect(n.ParentDetect())
+				first.SetParentMultipleKey(n.ParentMultipleKey())
+			}
+			for i, element := range elements {
+				if element.GetType() != TypeHint {
+					labelLast = compile(element, ko)
+					continue
+				}
+				/* the hint holds for the failures of the rest of the sequence */
+				failed, ok := label, label+1
+				label += 2
+				printBegin()
+				_print("\n   hint%d := hint", failed)
+				_print("\n   hint = %v", strconv.Quote(element.String()))
+				for _, element := range elements[i+1:] {
+					compile(element, failed)
+				}
+				_print("\n   hint = hint%d", failed)
+				printJump(ok)
+				printLabel(failed)
+				_print("\n   hint = hint%d", failed)
+				printJump(ko)
+				printEnd()
+				labelLast = printLabel(ok)
+				break
 			}
 		case TypePeekFor:
 			ok := label
@@ -1603,10 +6429,14 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 			printLabel(out)
 			printRestore(out)
 			printEnd()
+		case TypeFail:
+			for _, element := range n.Slice() {
+				printFail(element)
+			}
 		case TypeComment:
 		case TypeNil:
 		default:
-			warn(fmt.Errorf("illegal node type: %v", n.GetType()))
+			warn(WarnInternal, fmt.Errorf("illegal node type: %v", n.GetType()))
 		}
 		return labelLast
 	}
@@ -1628,15 +6458,16 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 		}
 		ko := label
 		label++
-		if count, ok := t.rulesCount[element.String()]; !ok {
+		if _, ok := t.rulesCount[element.String()]; !ok {
 			continue
-		} else if t.inline && count == 1 && ko != 0 {
+		} else if inlined(element.String()) && ko != 0 {
 			continue
 		}
 		compile(expression, ko)
 	}
 	_print, label = printTemp, 0
 	dryCompile = false
+	declared, locals, scopes, calls = nil, nil, nil, 0
 
 	/* now for the real compile pass */
 	t.PegRuleType = "uint8"
@@ -1647,8 +6478,75 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 	} else if length > math.MaxUint8 {
 		t.PegRuleType = "uint16"
 	}
-	if err = printTemplate(pegHeaderTemplate); err != nil {
-		return err
+	lines := t.ruleLines()
+	t.RuleSources = t.RuleSources[:0]
+	for _, rule := range t.RuleNames {
+		source := lines[rule.String()]
+		if source.Line > 0 && source.File == "" {
+			source.File = t.GrammarFile
+		} else if source.Line > 0 {
+			source.File = path.Join(path.Dir(t.GrammarFile), source.File)
+		}
+		/* the actions became names after they were counted */
+		source.Name, source.Lexical = rule.String(), countsByRule[rule.GetID()][TypeName] == 0
+		source.Inlined = inlined(rule.String()) && rule != t.RuleNames[0] && rule.Front().GetType() != TypeNil
+		t.RuleSources = append(t.RuleSources, source)
+	}
+	templates := []string{pegPackageTemplate, pegTokensTemplate, pegHeaderTemplate}
+	if t.SplitTokens {
+		templates = []string{pegPackageTemplate, pegHeaderTemplate}
+	}
+	for _, s := range templates {
+		if err = printTemplate(s); err != nil {
+			return err
+		}
+	}
+	/* with -iterative the bodies of the rules with their ids in entries are
+	   compiled into bodies, which printMatch prints as the body of match */
+	var bodies bytes.Buffer
+	var entries []int
+	printMatch := func() {
+		_print("\n match = func(rule uint32) bool {")
+		_print("\n  var matched bool")
+		if calls > 0 {
+			_print("\n  var frames []uint32")
+		}
+		if len(declared) > 0 {
+			_print("\n  var %v uint32", strings.Join(declared, ", "))
+		}
+		_print("\n  switch rule {")
+		for _, id := range entries {
+			_print("\n  case %d:\n   goto r%d", id, id)
+		}
+		_print("\n  }")
+		_print("\n  return false")
+		_print("%v", bodies.String())
+		/* return to the label saved by the call, or from match */
+		_print("\n ret:")
+		_print("\n  depth--")
+		if calls > 0 {
+			_print("\n  if len(frames) > 0 {")
+			_print("\n   call := frames[len(frames)-1]")
+			_print("\n   frames = frames[:len(frames)-1]")
+			_print("\n   switch call {")
+			for call := 1; call <= calls; call++ {
+				_print("\n   case %d:\n    goto c%d", call, call)
+			}
+			_print("\n   }")
+			_print("\n  }")
+		}
+		_print("\n  return matched")
+		_print("\n }")
+	}
+	t.ruleStatus = make(map[string]string)
+	var names []string
+	for _, element := range t.Slice() {
+		if element.GetType() != TypeRule {
+			continue
+		}
+		if expression := element.Front(); expression.GetType() != TypeNil && expression.Front().GetType() != TypeNil {
+			names = append(names, element.String())
+		}
 	}
 	for _, element := range t.Slice() {
 		if element.GetType() != TypeRule {
@@ -1656,8 +6554,9 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 		}
 		expression := element.Front()
 		if implicit := expression.Front(); expression.GetType() == TypeNil || implicit.GetType() == TypeNil {
-			if element.String() != "PegText" {
-				warn(fmt.Errorf("rule '%v' used but not defined", element))
+			if name := element.String(); name != "PegText" && name != "PegRecovered" {
+				warn(WarnUndefined, fmt.Errorf("rule '%v' used but not defined%v", element, suggestion(element.String(), names)))
+				t.ruleStatus[element.String()] = "undefined"
 			}
 			_print("\n  nil,")
 			continue
@@ -1667,38 +6566,190 @@ func (t *Tree) Compile(file string, args []string, out io.Writer) (err error) {
 		_print("\n  /* %v ", element.GetID())
 		printRule(element)
 		_print(" */")
-		if count, ok := t.rulesCount[element.String()]; !ok {
-			warn(fmt.Errorf("rule '%v' defined but not used", element))
+		if _, ok := t.rulesCount[element.String()]; !ok {
+			warn(WarnUnused, fmt.Errorf("rule '%v' defined but not used", element))
+			t.ruleStatus[element.String()] = "unused"
 			_print("\n  nil,")
 			continue
-		} else if t.inline && count == 1 && ko != 0 {
+		} else if inlined(element.String()) && ko != 0 {
+			t.ruleStatus[element.String()] = "inlined"
 			_print("\n  nil,")
 			continue
 		}
+		if t.Iterative {
+			/* the rule is compiled into the body of match */
+			_print("\n  func() bool { return match(%d) },", element.GetID())
+			printCode := _print
+			_print = func(format string, a ...any) { _, _ = fmt.Fprintf(&bodies, format, a...) }
+			entries = append(entries, element.GetID())
+			locals, scopes = locals[:0], scopes[:0]
+			_print("\n r%d:", element.GetID())
+			_print("\n   if depth++; p.maxDepth > 0 && depth > p.maxDepth {")
+			_print("\n    panic(&depthError{p, position})")
+			_print("\n   }")
+			memo := t.Ast && (memoizes(element, true) || memoizes(element, false))
+			if memo {
+				_print("\n   {")
+				printMemoCheck(element)
+				_print("\n   }")
+			}
+			/* the position is saved to memoize the rule or to backtrack */
+			if memo || labels[ko] {
+				local(fmt.Sprintf("position%d", ko), fmt.Sprintf("tokenIndex%d", ko))
+				_print("\n   position%d, tokenIndex%d = position, tokenIndex", ko, ko)
+			}
+			current = element.String()
+			compile(expression, ko)
+			if t.Ast {
+				printMemoSave(element, ko, true)
+			}
+			_print("\n   matched = true")
+			_print("\n   goto ret")
+			if labels[ko] {
+				printLabel(ko)
+				if t.Ast {
+					printMemoSave(element, ko, false)
+				}
+				printRestore(ko)
+				_print("\n   matched = false")
+				_print("\n   goto ret")
+			}
+			_print = printCode
+			continue
+		}
 		_print("\n  func() bool {")
-		if t.Ast {
-			printMemoCheck(element.GetID())
+		memo := t.Ast && (memoizes(element, true) || memoizes(element, false))
+		if memo {
+			printMemoCheck(element)
 		}
-		if t.Ast || labels[ko] {
-			printSave(ko)
+		/* the position is saved to memoize the rule or to backtrack */
+		if memo || labels[ko] {
+			_print("\n   position%d, tokenIndex%d := position, tokenIndex", ko, ko)
+		}
+		/* the state is only restored if the rule can fail */
+		if labels[ko] {
+			printSaveState(ko)
+		}
+		current = element.String()
+		if t.Ast && t.leftRecursive[element.String()] {
+			/* the rule grows its match from a seed and memoizes the final match only */
+			_print("\n   if !growSeed(%d, func() bool {", element.GetID())
+			compile(expression, ko)
+			_print("\n   return true")
+			if labels[ko] {
+				printLabel(ko)
+				printRestore(ko)
+				_print("\n   return false")
+			}
+			_print("\n   }) {")
+			_print("\n    if len(seeds) == 0 {")
+			printMemoSave(element, ko, false)
+			_print("\n    }")
+			_print("\n    return false")
+			_print("\n   }")
+			_print("\n   if len(seeds) == 0 {")
+			printMemoSave(element, ko, true)
+			_print("\n   }")
+			_print("\n   return true")
+			_print("\n  },")
+			continue
 		}
 		compile(expression, ko)
 		// print("\n  fmt.Printf(\"%v\\n\")", element.String())
 		if t.Ast {
-			printMemoSave(element.GetID(), ko, true)
+			printMemoSave(element, ko, true)
 		}
 		_print("\n   return true")
 		if labels[ko] {
 			printLabel(ko)
 			if t.Ast {
-				printMemoSave(element.GetID(), ko, false)
+				printMemoSave(element, ko, false)
 			}
 			printRestore(ko)
 			_print("\n   return false")
 		}
 		_print("\n  },")
 	}
-	_print("\n }\n p.rules = _rules")
+	_print("\n }")
+	if t.Iterative {
+		printMatch()
+		_print("\n p.rules = _rules")
+		_print("\n return nil")
+		_print("\n}\n")
+		return nil
+	}
+	_print("\n if p.maxDepth > 0")
+	if t.Expected {
+		_print(" || p.trackRules")
+	}
+	if t.Watchdog {
+		_print(" || p.watchdog != nil")
+	}
+	if t.Trace {
+		_print(" || p.trace != nil")
+	}
+	_print(" {")
+	_print("\n  for i, rule := range _rules {")
+	_print("\n   if rule == nil {")
+	_print("\n    continue")
+	_print("\n   }")
+	if t.Expected || t.Trace || t.Watchdog {
+		_print("\n   rule, name := rule, rul3s[i]")
+	} else {
+		_print("\n   rule := rule")
+	}
+	if t.Trace {
+		_print("\n   _rules[i] = func() (matched bool) {")
+	} else {
+		_print("\n   _rules[i] = func() bool {")
+	}
+	_print("\n    if depth++; p.maxDepth > 0 && depth > p.maxDepth {")
+	_print("\n     panic(&depthError{p, position})")
+	_print("\n    }")
+	if t.Trace {
+		_print("\n    if p.trace != nil {")
+		_print("\n     begin, level := p.original(position), depth")
+		_print("\n     p.trace(TraceEvent{Rule: name, Begin: int(begin), End: int(begin), Depth: level, Time: time.Now()})")
+		_print("\n     defer func() {")
+		_print("\n      end := begin")
+		_print("\n      if matched {")
+		_print("\n       end = p.original(position)")
+		_print("\n      }")
+		_print("\n      p.trace(TraceEvent{Rule: name, Exit: true, Matched: matched, Begin: int(begin), End: int(end), Depth: level, Time: time.Now()})")
+		_print("\n     }()")
+		_print("\n    }")
+	}
+	if t.Expected {
+		_print("\n    if p.trackRules {")
+		_print("\n     stack = append(stack, name)")
+		_print("\n     defer func() { stack = stack[:len(stack)-1] }()")
+		_print("\n    }")
+	}
+	if !t.Watchdog {
+		_print("\n    matches := rule()")
+		_print("\n    depth--")
+		_print("\n    return matches")
+	} else {
+		_print("\n    if p.watchdog == nil {")
+		_print("\n     matches := rule()")
+		_print("\n     depth--")
+		_print("\n     return matches")
+		_print("\n    }")
+		_print("\n    begin, start, calls := position, time.Now(), steps")
+		_print("\n    steps++")
+		_print("\n    matches := rule()")
+		_print("\n    depth--")
+		_print("\n    elapsed, made := time.Since(start), steps-calls-1")
+		_print("\n    if (p.watchdog.threshold > 0 && elapsed > p.watchdog.threshold) || (p.watchdog.steps > 0 && made > p.watchdog.steps) {")
+		_print("\n     begin, end := p.original(begin), p.original(position)")
+		_print("\n     p.watchdog.report(SlowRule{name, int(begin), int(end), p.ByteOffset(int(begin)), p.ByteOffset(int(end)), elapsed, made})")
+		_print("\n    }")
+		_print("\n    return matches")
+	}
+	_print("\n   }")
+	_print("\n  }")
+	_print("\n }")
+	_print("\n p.rules = _rules")
 	_print("\n return nil")
 	_print("\n}\n")
 	return nil
@@ -0,0 +1,117 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree
+
+import "fmt"
+
+// The methods below change the grammar IR of a parsed grammar, and must be
+// called before Compile. New expressions are built with the Add methods, which
+// leave the expression at the front of the tree, and then taken with PopFront:
+//
+//	t.AddName("Digit")
+//	t.AddPlus()
+//	err := t.ReplaceExpression("Number", t.PopFront())
+
+// Rule returns the rule called name, or nil if there is none.
+func (t *Tree) Rule(name string) Node {
+	for element := t.Front(); element != nil; element = element.Next() {
+		if element.GetType() == TypeRule && element.String() == name {
+			return element
+		}
+	}
+	return nil
+}
+
+// AppendRule adds the rule name with the given expression after the other
+// rules.
+func (t *Tree) AppendRule(name string, expression Node) error {
+	if t.Rule(name) != nil {
+		return fmt.Errorf("rule %s already exists", name)
+	}
+	t.AddRule(name)
+	t.PushFront(detach(expression))
+	t.AddExpression()
+	return nil
+}
+
+// ReplaceExpression replaces the expression of the rule called name.
+func (t *Tree) ReplaceExpression(name string, expression Node) error {
+	rule := t.Rule(name)
+	if rule == nil {
+		return fmt.Errorf("rule %s does not exist", name)
+	}
+	rule.Init()
+	rule.PushBack(detach(expression))
+	return nil
+}
+
+// RenameRule renames the rule called from to to, along with every reference to
+// it and the value it builds.
+func (t *Tree) RenameRule(from, to string) error {
+	if t.Rule(from) == nil {
+		return fmt.Errorf("rule %s does not exist", from)
+	}
+	if t.Rule(to) != nil {
+		return fmt.Errorf("rule %s already exists", to)
+	}
+	t.Walk(func(n Node) bool {
+		if (n.GetType() == TypeRule || n.GetType() == TypeName) && n.String() == from {
+			n.SetString(to)
+		}
+		return true
+	})
+	if build, ok := t.builds[from]; ok {
+		delete(t.builds, from)
+		t.builds[to] = build
+	}
+	return nil
+}
+
+// Walk calls visit for every node of the grammar in depth-first order, parents
+// before their children. The children of a node are skipped if visit returns
+// false.
+func (t *Tree) Walk(visit func(n Node) bool) {
+	var walk func(n *node)
+	walk = func(n *node) {
+		if !visit(n) {
+			return
+		}
+		for element := n.Front(); element != nil; element = element.Next() {
+			walk(element)
+		}
+	}
+	for element := t.Front(); element != nil; element = element.Next() {
+		walk(element)
+	}
+}
+
+// Rewrite replaces every node in the expressions of the rules with the node
+// returned by rewrite, which may be the node itself. The nodes are rewritten
+// bottom up, so rewrite sees the already rewritten children of a node.
+func (t *Tree) Rewrite(rewrite func(n Node) Node) {
+	var walk func(n *node) *node
+	walk = func(n *node) *node {
+		children := n.Slice()
+		n.Init()
+		for _, child := range children {
+			n.PushBack(detach(walk(child)))
+		}
+		return detach(rewrite(n))
+	}
+	for element := t.Front(); element != nil; element = element.Next() {
+		if element.GetType() != TypeRule || element.Front() == nil {
+			continue
+		}
+		expression := walk(element.PopFront())
+		element.PushBack(expression)
+	}
+}
+
+// detach unlinks the node n from the list it was part of.
+func detach(n Node) *node {
+	element := n.(*node)
+	element.next = nil
+	return element
+}
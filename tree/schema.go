@@ -0,0 +1,24 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree
+
+import (
+	_ "embed"
+)
+
+// TreeSchemaVersion is the version of TreeSchema, given as "version" by the
+// results of the c-shared wrapper and of the parse service. It changes only
+// when a change of the results breaks existing consumers.
+const TreeSchemaVersion = 1
+
+// TreeSchema is the JSON Schema of the syntax trees, or parse errors,
+// returned by Parse of the c-shared wrapper and by ParseHandler of the parse
+// service.
+//
+//go:embed tree.schema.json
+var TreeSchema string
+
+// SchemaVersion returns TreeSchemaVersion, for the generated code.
+func (t *Tree) SchemaVersion() int { return TreeSchemaVersion }
@@ -0,0 +1,272 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type textMatePattern struct {
+	Include string `json:"include,omitempty"`
+	Match   string `json:"match,omitempty"`
+	Name    string `json:"name,omitempty"`
+}
+
+type textMateGrammar struct {
+	Name       string                     `json:"name"`
+	ScopeName  string                     `json:"scopeName"`
+	Patterns   []textMatePattern          `json:"patterns"`
+	Repository map[string]textMatePattern `json:"repository"`
+}
+
+// textMateScopes maps parts of rule names to TextMate scopes.
+var textMateScopes = [...]struct {
+	part, scope string
+}{
+	{"comment", "comment.line"},
+	{"string", "string.quoted"},
+	{"char", "string.quoted.single"},
+	{"number", "constant.numeric"},
+	{"integer", "constant.numeric.integer"},
+	{"float", "constant.numeric.float"},
+	{"keyword", "keyword.control"},
+	{"operator", "keyword.operator"},
+	{"identifier", "variable.other"},
+	{"type", "entity.name.type"},
+}
+
+// TextMate writes an approximate TextMate grammar for the lexical rules of the
+// grammar. A rule is lexical if it only consists of terminals, character
+// classes, repetitions, predicates over those and references to other lexical
+// rules. Lexical rules used by the start rule or by other rules become the
+// patterns of the TextMate grammar, scoped by their names. It must be called
+// before Compile.
+func (t *Tree) TextMate(out io.Writer) error {
+	rules, name := make(map[string]Node), ""
+	var order []Node
+	for _, n := range t.Slice() {
+		switch n.GetType() {
+		case TypePeg:
+			name = n.String()
+		case TypeRule:
+			if _, ok := rules[n.String()]; !ok {
+				rules[n.String()] = n
+				order = append(order, n)
+			}
+		}
+	}
+
+	regexps, visiting := make(map[string]string), make(map[string]bool)
+	var convert func(n Node) (string, bool)
+	convertRule := func(name string) (string, bool) {
+		if r, ok := regexps[name]; ok {
+			return r, r != ""
+		}
+		rule, ok := rules[name]
+		if !ok || visiting[name] {
+			return "", false
+		}
+		visiting[name] = true
+		r, ok := convert(rule.Front())
+		visiting[name] = false
+		if !ok {
+			r = ""
+		}
+		regexps[name] = r
+		return r, ok
+	}
+	convert = func(n Node) (string, bool) {
+		switch n.GetType() {
+		case TypeDot:
+			return `[\s\S]`, true
+		case TypeCharacter:
+			return textMateQuote(n.String()), true
+		case TypeString:
+			return textMateQuote(n.String()), true
+		case TypeKeyword:
+			return textMateQuote(n.String()) + `(?![\w])`, true
+		case TypeRange, TypeNotClass, TypeProperty:
+			if class, ok := textMateClass(n); ok {
+				return class, true
+			}
+			return "", false
+		case TypeName:
+			return convertRule(n.String())
+		case TypeAlternate:
+			if class, ok := textMateClass(n); ok {
+				return class, true
+			}
+			var alternates []string
+			for _, element := range n.Slice() {
+				r, ok := convert(element)
+				if !ok {
+					return "", false
+				}
+				alternates = append(alternates, r)
+			}
+			return "(?>" + strings.Join(alternates, "|") + ")", true
+		case TypeSequence:
+			s := ""
+			for _, element := range n.Slice() {
+				r, ok := convert(element)
+				if !ok {
+					return "", false
+				}
+				s += r
+			}
+			return s, true
+		case TypeQuery, TypeStar, TypePlus:
+			r, ok := convert(n.Front())
+			suffix := map[Type]string{TypeQuery: "?+", TypeStar: "*+", TypePlus: "++"}[n.GetType()]
+			return "(?:" + r + ")" + suffix, ok
+		case TypePeekFor, TypePeekNot:
+			r, ok := convert(n.Front())
+			prefix := map[Type]string{TypePeekFor: "(?=", TypePeekNot: "(?!"}[n.GetType()]
+			return prefix + r + ")", ok
+		case TypePush:
+			return convert(n.Front())
+		case TypeAction, TypeHint, TypeNil:
+			return "", true
+		}
+		return "", false
+	}
+
+	var nullable func(n Node, seen map[string]bool) bool
+	nullable = func(n Node, seen map[string]bool) bool {
+		switch n.GetType() {
+		case TypeQuery, TypeStar, TypePeekFor, TypePeekNot, TypeAction, TypeHint, TypeNil:
+			return true
+		case TypeName:
+			rule, ok := rules[n.String()]
+			if !ok || seen[n.String()] {
+				return false
+			}
+			seen[n.String()] = true
+			return nullable(rule.Front(), seen)
+		case TypePlus, TypePush:
+			return nullable(n.Front(), seen)
+		case TypeSequence:
+			for _, element := range n.Slice() {
+				if !nullable(element, seen) {
+					return false
+				}
+			}
+			return true
+		case TypeAlternate:
+			for _, element := range n.Slice() {
+				if nullable(element, seen) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	used := make(map[string]bool)
+	var uses func(n Node)
+	uses = func(n Node) {
+		if n.GetType() == TypeName {
+			used[n.String()] = true
+		}
+		for element := n.Front(); element != nil; element = element.Next() {
+			uses(element)
+		}
+	}
+	for i, rule := range order {
+		if _, ok := convertRule(rule.String()); !ok || i == 0 {
+			uses(rule.Front())
+		}
+	}
+
+	scope := strings.ToLower(name)
+	grammar := textMateGrammar{
+		Name:       name,
+		ScopeName:  "source." + scope,
+		Patterns:   []textMatePattern{},
+		Repository: make(map[string]textMatePattern),
+	}
+	for _, rule := range order[1:] {
+		name := rule.String()
+		r, ok := convertRule(name)
+		if !ok || !used[name] || nullable(rule.Front(), map[string]bool{}) {
+			continue
+		}
+		grammar.Patterns = append(grammar.Patterns, textMatePattern{Include: "#" + name})
+		grammar.Repository[name] = textMatePattern{
+			Match: r,
+			Name:  textMateScope(name) + "." + scope,
+		}
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(grammar)
+}
+
+// textMateScope guesses the TextMate scope of a rule from its name.
+func textMateScope(name string) string {
+	lower := strings.ToLower(name)
+	for _, s := range textMateScopes {
+		if strings.Contains(lower, s.part) {
+			return s.scope
+		}
+	}
+	return "meta." + lower
+}
+
+// textMateQuote quotes the characters of s for an Oniguruma regular expression.
+func textMateQuote(s string) string {
+	quoted := ""
+	for _, c := range s {
+		if !unicode.IsPrint(c) || c == ' ' {
+			quoted += fmt.Sprintf(`\x{%x}`, c)
+		} else {
+			quoted += regexp.QuoteMeta(string(c))
+		}
+	}
+	return quoted
+}
+
+// textMateClass converts a character class to an Oniguruma character class.
+func textMateClass(n Node) (string, bool) {
+	var class func(n Node) (string, bool)
+	class = func(n Node) (string, bool) {
+		switch n.GetType() {
+		case TypeCharacter:
+			if n.String() == "-" {
+				return `\-`, true
+			}
+			return textMateQuote(n.String()), true
+		case TypeRange:
+			element := n.Front()
+			return textMateQuote(element.String()) + "-" + textMateQuote(element.Next().String()), true
+		case TypeProperty:
+			return n.String(), true
+		case TypeAlternate:
+			s := ""
+			for _, element := range n.Slice() {
+				c, ok := class(element)
+				if !ok {
+					return "", false
+				}
+				s += c
+			}
+			return s, true
+		}
+		return "", false
+	}
+	if n.GetType() == TypeNotClass {
+		c, ok := class(n.Front())
+		return "[^" + c + "]", ok
+	}
+	c, ok := class(n)
+	return "[" + c + "]", ok
+}
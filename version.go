@@ -0,0 +1,67 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"runtime/debug"
+	"strings"
+)
+
+// buildInfo is the version of peg, as recorded by the go command in the
+// binary: the module version when installed with go install, and the commit
+// when built in a git checkout.
+type buildInfo struct {
+	// version is the module version, or "devel" for a build of the main
+	// module without a version.
+	version string
+	// commit and time are the revision and the commit time of the checkout,
+	// if known. modified is set if the checkout had uncommitted changes.
+	commit, time string
+	modified     bool
+}
+
+// readBuildInfo returns the version of peg.
+func readBuildInfo() buildInfo {
+	info := buildInfo{version: "devel"}
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	if build.Main.Version != "" && build.Main.Version != "(devel)" {
+		info.version = build.Main.Version
+	}
+	for _, setting := range build.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.commit = setting.Value
+		case "vcs.time":
+			info.time = setting.Value
+		case "vcs.modified":
+			info.modified = setting.Value == "true"
+		}
+	}
+	return info
+}
+
+// String returns the version followed by the commit, unless the version
+// already names it, and +dirty for a modified checkout.
+func (b buildInfo) String() string {
+	version := b.version
+	if b.commit != "" && !strings.Contains(version, shortCommit(b.commit)) {
+		version += "-" + b.commit
+	}
+	if b.modified && !strings.HasSuffix(version, "+dirty") {
+		version += "+dirty"
+	}
+	return version
+}
+
+// shortCommit returns the prefix of commit used in pseudo-versions.
+func shortCommit(commit string) string {
+	if len(commit) > 12 {
+		return commit[:12]
+	}
+	return commit
+}